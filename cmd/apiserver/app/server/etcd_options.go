@@ -47,4 +47,9 @@ func NewEtcdOptions() *EtcdOptions {
 
 func (s *EtcdOptions) addFlags(flags *pflag.FlagSet) {
 	s.EtcdOptions.AddFlags(flags)
+	flags.StringVar(&s.EncryptionProviderConfigFilepath, "encryption-provider-config", s.EncryptionProviderConfigFilepath,
+		"The file containing the configuration of the encryption providers used to store resources in etcd, "+
+			"such as serviceinstances.servicecatalog.k8s.io. It has the format of the encryption provider "+
+			"configuration of the Kubernetes API server.")
+	flags.MarkDeprecated("experimental-encryption-provider-config", "use --encryption-provider-config instead")
 }
@@ -0,0 +1,96 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apiserver"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apiserver/options"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/migration"
+)
+
+// MigrateStorageOptions contains the configuration of the migration of the
+// objects stored by the API server to the storage version of their group.
+type MigrateStorageOptions struct {
+	// EtcdOptions are the options of the etcd the API server stores its
+	// objects in
+	EtcdOptions *EtcdOptions
+	// StorageSerializationOptions are the options of the versions the objects
+	// are migrated to
+	StorageSerializationOptions *options.StorageSerializationOptions
+	// ChunkSize is the number of objects read from etcd at a time
+	ChunkSize int64
+}
+
+// NewMigrateStorageOptions creates a new instance of MigrateStorageOptions
+// with the default values.
+func NewMigrateStorageOptions() *MigrateStorageOptions {
+	return &MigrateStorageOptions{
+		EtcdOptions:                 NewEtcdOptions(),
+		StorageSerializationOptions: options.NewStorageSerializationOptions(),
+		ChunkSize:                   migration.DefaultChunkSize,
+	}
+}
+
+// AddFlags adds to the flag set the flags to configure the storage migration.
+func (s *MigrateStorageOptions) AddFlags(flags *pflag.FlagSet) {
+	s.EtcdOptions.addFlags(flags)
+	s.StorageSerializationOptions.AddFlags(flags)
+	flags.Int64Var(
+		&s.ChunkSize,
+		"chunk-size",
+		s.ChunkSize,
+		"The number of objects read from etcd at a time",
+	)
+}
+
+// Validate checks that the storage migration options are consistent.
+func (s *MigrateStorageOptions) Validate() error {
+	errors := s.EtcdOptions.Validate()
+	if s.ChunkSize <= 0 {
+		errors = append(errors, fmt.Errorf("--chunk-size must be greater than 0"))
+	}
+	return utilerrors.NewAggregate(errors)
+}
+
+// RunMigrateStorage rewrites the objects stored by the API server in the
+// storage version of their group. The API server stores the objects it
+// creates and updates in that version, but the objects it does not write keep
+// the version they were stored in, which the API server must be able to read
+// until they are migrated.
+func RunMigrateStorage(s *MigrateStorageOptions) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	storageGroupsToEncodingVersion, err := s.StorageSerializationOptions.StorageGroupsToEncodingVersion()
+	if err != nil {
+		return fmt.Errorf("error generating storage version map: %s", err)
+	}
+	storageFactory, err := newStorageFactory(s.EtcdOptions, storageGroupsToEncodingVersion)
+	if err != nil {
+		return err
+	}
+
+	glog.Infof("Migrating the stored objects to the storage versions %v", storageGroupsToEncodingVersion)
+	return apiserver.MigrateStorage(storageFactory, s.ChunkSize)
+}
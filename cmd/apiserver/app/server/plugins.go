@@ -24,6 +24,11 @@ import (
 
 	// Admission controllers
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/broker/authsarcheck"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/broker/readonly"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/catalog/contentvalidator"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/quota/objectcount"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/servicebindings/bindable"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/servicebindings/instanceselector"
 	siclifecycle "github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/servicebindings/lifecycle"
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceplan/changevalidator"
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceplan/defaultserviceplan"
@@ -32,7 +37,12 @@ import (
 // registerAllAdmissionPlugins registers all admission plugins
 func registerAllAdmissionPlugins(plugins *admission.Plugins) {
 	defaultserviceplan.Register(plugins)
+	instanceselector.Register(plugins)
 	siclifecycle.Register(plugins)
+	bindable.Register(plugins)
 	changevalidator.Register(plugins)
+	readonly.Register(plugins)
 	authsarcheck.Register(plugins)
+	contentvalidator.Register(plugins)
+	objectcount.Register(plugins)
 }
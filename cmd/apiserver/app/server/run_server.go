@@ -21,11 +21,13 @@ import (
 	"net/http"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	genericapiserverstorage "k8s.io/apiserver/pkg/server/storage"
 	"k8s.io/apiserver/pkg/storage/etcd3/preflight"
 
 	"github.com/golang/glog"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apiserver"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apiserver/encryptionconfig"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apiserver/options"
 	registryserver "github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
 )
@@ -74,27 +76,13 @@ func runEtcdServer(opts *ServiceCatalogServerOptions, stopCh <-chan struct{}) er
 		return fmt.Errorf("error generating storage version map: %s", err)
 	}
 
-	// Build the default storage factory.
-	//
-	// The default storage factory returns the storage interface for a
-	// particular GroupResource (an (api-group, resource) tuple).
-	storageFactory, err := apiserver.NewStorageFactory(
-		etcdOpts.StorageConfig,
-		etcdOpts.DefaultStorageMediaType,
-		api.Codecs,
-		genericapiserverstorage.NewDefaultResourceEncodingConfig(api.Scheme),
-		storageGroupsToEncodingVersion,
-		nil, /* group storage version overrides */
-		apiserver.DefaultAPIResourceConfigSource(),
-		nil, /* resource config overrides */
-	)
+	storageFactory, err := newStorageFactory(etcdOpts, storageGroupsToEncodingVersion)
 	if err != nil {
-		glog.Errorf("error creating storage factory: %v", err)
 		return err
 	}
 
 	// // Set the finalized generic and storage configs
-	config := apiserver.NewEtcdConfig(genericConfig, 0 /* deleteCollectionWorkers */, storageFactory)
+	config := apiserver.NewEtcdConfig(genericConfig, 0 /* deleteCollectionWorkers */, storageFactory, scConfig.recorder)
 
 	// Fill in defaults not already set in the config
 	completed := config.Complete()
@@ -122,6 +110,44 @@ func runEtcdServer(opts *ServiceCatalogServerOptions, stopCh <-chan struct{}) er
 	return nil
 }
 
+// newStorageFactory builds the default storage factory, which returns the
+// storage interface for a particular GroupResource (an (api-group, resource)
+// tuple), storing each group in the given version and encrypting the
+// resources of the encryption provider configuration, if any.
+func newStorageFactory(etcdOpts *EtcdOptions, storageGroupsToEncodingVersion map[string]schema.GroupVersion) (*genericapiserverstorage.DefaultStorageFactory, error) {
+	storageFactory, err := apiserver.NewStorageFactory(
+		etcdOpts.StorageConfig,
+		etcdOpts.DefaultStorageMediaType,
+		api.Codecs,
+		genericapiserverstorage.NewDefaultResourceEncodingConfig(api.Scheme),
+		storageGroupsToEncodingVersion,
+		nil, /* group storage version overrides */
+		apiserver.DefaultAPIResourceConfigSource(),
+		nil, /* resource config overrides */
+	)
+	if err != nil {
+		glog.Errorf("error creating storage factory: %v", err)
+		return nil, err
+	}
+
+	// Encrypt the resources listed in the encryption provider configuration
+	// before they are stored in etcd.
+	if len(etcdOpts.EncryptionProviderConfigFilepath) != 0 {
+		transformerOverrides, err := encryptionconfig.GetTransformerOverrides(etcdOpts.EncryptionProviderConfigFilepath)
+		if err != nil {
+			return nil, err
+		}
+		for groupResource, transformer := range transformerOverrides {
+			if _, ok := storageGroupsToEncodingVersion[groupResource.Group]; !ok {
+				return nil, fmt.Errorf("the resource %v of the encryption provider configuration is not served by this API server", groupResource)
+			}
+			storageFactory.SetTransformer(groupResource, transformer)
+		}
+	}
+
+	return storageFactory, nil
+}
+
 // checkEtcdConnectable is a HealthzChecker that makes sure the
 // etcd storage backend is up and contactable.
 type checkEtcdConnectable struct {
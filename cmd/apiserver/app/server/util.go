@@ -19,12 +19,14 @@ package server
 import (
 	"fmt"
 	"net"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/go-openapi/spec"
 	"github.com/golang/glog"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
@@ -36,11 +38,15 @@ import (
 	genericserveroptions "k8s.io/apiserver/pkg/server/options"
 	kubeinformers "k8s.io/client-go/informers"
 	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
 	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apiserver/authenticator"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apiserver/filters"
 	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
 	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
 	"github.com/kubernetes-incubator/service-catalog/pkg/openapi"
@@ -58,6 +64,8 @@ type serviceCatalogConfig struct {
 	client internalclientset.Interface
 	// the configured client for kube apiserver
 	kubeClient kubeclientset.Interface
+	// the recorder of the events of the objects served, through kube apiserver
+	recorder record.EventRecorder
 }
 
 // buildGenericConfig takes the server options and produces the genericapiserver.RecommendedConfig associated with it
@@ -112,6 +120,10 @@ func buildGenericConfig(s *ServiceCatalogServerOptions) (*genericapiserver.Recom
 		glog.Warning("OpenAPI spec will not be served")
 	}
 
+	genericConfig.BuildHandlerChainFunc = func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		return genericapiserver.DefaultBuildHandlerChain(filters.WithDryRunRejected(apiHandler, c.Serializer), c)
+	}
+
 	genericConfig.SwaggerConfig = genericapiserver.DefaultSwaggerConfig()
 	// TODO: investigate if we need metrics unique to service catalog, but take defaults for now
 	// see https://github.com/kubernetes-incubator/service-catalog/issues/677
@@ -165,8 +177,12 @@ func buildGenericConfig(s *ServiceCatalogServerOptions) (*genericapiserver.Recom
 			return nil, nil, fmt.Errorf("failed to initialize admission: %v", err)
 		}
 
+		eventBroadcaster := record.NewBroadcaster()
+		eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
 		scConfig.kubeClient = kubeClient
 		scConfig.kubeSharedInformers = kubeSharedInformers
+		scConfig.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "service-catalog-apiserver"})
 	}
 
 	return genericConfig, scConfig, nil
@@ -192,7 +208,14 @@ func buildAdmission(c *genericapiserver.RecommendedConfig, s *ServiceCatalogServ
 	if err != nil {
 		return nil, fmt.Errorf("failed to read plugin config: %v", err)
 	}
-	return s.AdmissionOptions.Plugins.NewFromPlugins(pluginNames, pluginsConfigProvider, initializersChain, admission.DecoratorFunc(admissionmetrics.WithControllerMetrics))
+	admissionChain, err := s.AdmissionOptions.Plugins.NewFromPlugins(pluginNames, pluginsConfigProvider, initializersChain, admission.DecoratorFunc(admissionmetrics.WithControllerMetrics))
+	if err != nil {
+		return nil, err
+	}
+	// The latency of each admission plugin and of each admission webhook is
+	// observed on its own; also observe the latency of the whole mutating and
+	// validating steps that the requests to the catalog resources go through.
+	return admissionmetrics.WithStepMetrics(admissionChain), nil
 }
 
 // enabledPluginNames makes use of RecommendedPluginOrder, DefaultOffPlugins,
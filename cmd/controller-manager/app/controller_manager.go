@@ -19,6 +19,7 @@ package app
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/http/pprof"
@@ -27,8 +28,10 @@ import (
 	"strconv"
 	"time"
 
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
 
 	"k8s.io/api/core/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -45,6 +48,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/server/healthz"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
@@ -58,7 +62,9 @@ import (
 	servicecatalogv1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	settingsv1alpha1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1"
 	servicecataloginformers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
@@ -157,6 +163,20 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		return fmt.Errorf("failed to establish SecureServingOptions %v", err)
 	}
 
+	brokerDashboard := controller.NewBrokerDashboardHandler()
+	var brokerDashboardHandler http.Handler
+	if controllerManagerOptions.EnableBrokerDashboard {
+		brokerDashboardHandler, err = withDelegatedAuth(brokerDashboard, k8sKubeClient)
+		if err != nil {
+			return fmt.Errorf("unable to set up the authentication of the broker dashboard: %v", err)
+		}
+	}
+
+	var brokerRelistCallback *controller.BrokerRelistCallbackHandler
+	if controllerManagerOptions.EnableBrokerRelistCallback {
+		brokerRelistCallback = controller.NewBrokerRelistCallbackHandler()
+	}
+
 	glog.V(4).Info("Starting http server and mux")
 	// Start http server and handlers
 	go func() {
@@ -169,6 +189,12 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		healthz.InstallHandler(mux, healthz.PingHealthz, apiAvailableChecker)
 		configz.InstallHandler(mux)
 		metrics.RegisterMetricsAndInstallHandler(mux)
+		if brokerDashboardHandler != nil {
+			mux.Handle(controller.BrokerDashboardPath, brokerDashboardHandler)
+		}
+		if brokerRelistCallback != nil {
+			mux.Handle(controller.BrokerRelistCallbackPath, brokerRelistCallback)
+		}
 
 		if controllerManagerOptions.EnableProfiling {
 			mux.HandleFunc("/debug/pprof/", pprof.Index)
@@ -228,7 +254,7 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		// 	k8sClientBuilder = rootClientBuilder
 		// }
 
-		err := StartControllers(controllerManagerOptions, k8sKubeconfig, serviceCatalogClientBuilder, recorder, stop)
+		err := StartControllers(controllerManagerOptions, k8sKubeconfig, serviceCatalogClientBuilder, recorder, brokerDashboard, brokerRelistCallback, stop)
 		glog.Fatalf("error running controllers: %v", err)
 		panic("unreachable")
 	}
@@ -326,6 +352,8 @@ func StartControllers(s *options.ControllerManagerServer,
 	coreKubeconfig *rest.Config,
 	serviceCatalogClientBuilder controller.ClientBuilder,
 	recorder record.EventRecorder,
+	brokerDashboard *controller.BrokerDashboardHandler,
+	brokerRelistCallback *controller.BrokerRelistCallbackHandler,
 	stop <-chan struct{}) error {
 
 	// When Catalog Controller and Catalog API Server are started at the
@@ -366,6 +394,73 @@ func StartControllers(s *options.ControllerManagerServer,
 	// All shared informers are v1beta1 API level
 	serviceCatalogSharedInformers := informerFactory.Servicecatalog().V1beta1()
 
+	// An older API server may not serve every resource that the controller
+	// knows about. Informers of resources that are not served never sync, so
+	// the controller runs without them instead.
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) &&
+		!catalogResourcesAvailable(availableResources, "servicebrokers", "serviceclasses", "serviceplans") {
+		glog.Warningf("Disabling the %v feature, which the service-catalog API server does not support", scfeatures.NamespacedServiceBroker)
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker)); err != nil {
+			return err
+		}
+	}
+	var brokerTemplateInformer informers.BrokerTemplateInformer
+	var catalogProjectionInformer informers.CatalogProjectionInformer
+	var serviceBrokerSummaryInformer informers.ServiceBrokerSummaryInformer
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
+		if catalogResourcesAvailable(availableResources, "brokertemplates") {
+			brokerTemplateInformer = serviceCatalogSharedInformers.BrokerTemplates()
+		}
+		if catalogResourcesAvailable(availableResources, "catalogprojections") {
+			catalogProjectionInformer = serviceCatalogSharedInformers.CatalogProjections()
+		}
+		if catalogResourcesAvailable(availableResources, "servicebrokersummaries") {
+			serviceBrokerSummaryInformer = serviceCatalogSharedInformers.ServiceBrokerSummaries()
+		}
+	}
+
+	controller.SetHTTPSParameterSourceHosts(s.HTTPSParameterSourceHosts)
+
+	var parametersWebhook *controller.ParametersWebhook
+	if s.ParametersWebhookURL != "" {
+		var caBundle []byte
+		if s.ParametersWebhookCAFile != "" {
+			caBundle, err = ioutil.ReadFile(s.ParametersWebhookCAFile)
+			if err != nil {
+				return fmt.Errorf("unable to read the CA bundle of the parameters webhook: %v", err)
+			}
+		}
+		parametersWebhook, err = controller.NewParametersWebhook(
+			s.ParametersWebhookURL,
+			caBundle,
+			s.ParametersWebhookTimeout,
+			controller.ParametersWebhookFailurePolicy(s.ParametersWebhookFailurePolicy),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	var catalogWebhook *controller.CatalogWebhook
+	if s.CatalogWebhookURL != "" {
+		var caBundle []byte
+		if s.CatalogWebhookCAFile != "" {
+			caBundle, err = ioutil.ReadFile(s.CatalogWebhookCAFile)
+			if err != nil {
+				return fmt.Errorf("unable to read the CA bundle of the catalog webhook: %v", err)
+			}
+		}
+		catalogWebhook, err = controller.NewCatalogWebhook(
+			s.CatalogWebhookURL,
+			caBundle,
+			s.CatalogWebhookTimeout,
+			controller.CatalogWebhookFailurePolicy(s.CatalogWebhookFailurePolicy),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	glog.V(5).Infof("Creating controller; broker relist interval: %v", s.ServiceBrokerRelistInterval)
 	serviceCatalogController, err := controller.NewController(
 		coreClient,
@@ -378,6 +473,9 @@ func StartControllers(s *options.ControllerManagerServer,
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		brokerTemplateInformer,
+		catalogProjectionInformer,
+		serviceBrokerSummaryInformer,
 		osbclientproxy.NewClient,
 		s.ServiceBrokerRelistInterval,
 		s.OSBAPIPreferredVersion,
@@ -386,16 +484,56 @@ func StartControllers(s *options.ControllerManagerServer,
 		s.OperationPollingMaximumBackoffDuration,
 		s.ClusterIDConfigMapName,
 		s.ClusterIDConfigMapNamespace,
+		controller.Options{
+			StuckDeletionThreshold:                    s.StuckDeletionThreshold,
+			NamespaceDeletionMaxFailedAttempts:        s.NamespaceDeletionMaxFailedAttempts,
+			NamespaceDeletionBrokerUnreachableTimeout: s.NamespaceDeletionBrokerUnreachableTimeout,
+			InstanceTombstoneTTL:                      s.InstanceTombstoneTTL,
+			DeprovisionGracePeriod:                    s.DeprovisionGracePeriod,
+			SharedCatalogTTL:                          s.SharedCatalogTTL,
+			StorageMigration:                          s.StorageMigration,
+			ParametersWebhook:                         parametersWebhook,
+			CatalogWebhook:                            catalogWebhook,
+			MaxConcurrentDeprovisionsPerBroker:        s.MaxConcurrentDeprovisionsPerBroker,
+			DeprovisionBatchInterval:                  s.DeprovisionBatchInterval,
+			FailureWebhooks:                           s.EnableFailureWebhooks,
+			SecretPropagatedLabels:                    s.SecretPropagatedLabels,
+			ClusterID:                                 s.ClusterID,
+			ReadOnly:                                  s.ReadOnly,
+			NamespaceProvisionRateLimit:               s.NamespaceProvisionRateLimit,
+			NamespaceProvisionRateWindow:              s.NamespaceProvisionRateWindow,
+			SecretRotationPolicy:                      controller.SecretRotationPolicy(s.BindingSecretRotationPolicy),
+		},
 	)
 	if err != nil {
 		return err
 	}
 
+	// The relist callback reads the tokens of the brokers from the cache of
+	// their secrets, so that unauthenticated callers cannot make it call the
+	// API server.
+	var kubeInformerFactory kubeinformers.SharedInformerFactory
+	var secretLister corelisters.SecretLister
+	if brokerRelistCallback != nil {
+		kubeInformerFactory = kubeinformers.NewSharedInformerFactory(coreClient, s.ResyncInterval)
+		secretLister = kubeInformerFactory.Core().V1().Secrets().Lister()
+	}
+
 	glog.V(1).Info("Starting shared informers")
 	informerFactory.Start(stop)
+	if kubeInformerFactory != nil {
+		kubeInformerFactory.Start(stop)
+	}
 
 	glog.V(5).Info("Waiting for caches to sync")
 	informerFactory.WaitForCacheSync(stop)
+	if kubeInformerFactory != nil {
+		kubeInformerFactory.WaitForCacheSync(stop)
+	}
+	brokerDashboard.SetController(serviceCatalogController)
+	if brokerRelistCallback != nil {
+		brokerRelistCallback.SetController(serviceCatalogController, secretLister)
+	}
 
 	glog.V(5).Info("Running controller")
 	go serviceCatalogController.Run(s.ConcurrentSyncs, stop)
@@ -403,6 +541,20 @@ func StartControllers(s *options.ControllerManagerServer,
 	select {}
 }
 
+// catalogResourcesAvailable returns whether the service-catalog API server
+// serves all of the given resources, and warns about those it does not serve.
+func catalogResourcesAvailable(availableResources map[schema.GroupVersionResource]bool, resources ...string) bool {
+	available := true
+	for _, resource := range resources {
+		gvr := catalogGVR.GroupVersion().WithResource(resource)
+		if !availableResources[gvr] {
+			glog.Warningf("The service-catalog API server does not serve %v, probably because it is older than the controller manager; running without it", gvr)
+			available = false
+		}
+	}
+	return available
+}
+
 // checkAPIAvailableResourcesServer is a HealthzChecker that makes sure the
 // Service-Catalog APIServer is contactable.
 type checkAPIAvailableResources struct {
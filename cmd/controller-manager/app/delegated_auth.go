@@ -0,0 +1,82 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apiserver/pkg/authentication/authenticatorfactory"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/authorization/authorizerfactory"
+	"k8s.io/client-go/kubernetes"
+)
+
+// delegatedAuthCacheTTL is how long the answers of the Kubernetes API server
+// to the token and access reviews are cached.
+const delegatedAuthCacheTTL = 10 * time.Second
+
+// withDelegatedAuth wraps the given handler so that it only serves the
+// requests whose bearer token the Kubernetes API server authenticates with a
+// TokenReview, and whose user it authorizes to get the path of the request
+// with a SubjectAccessReview, as a non-resource URL.
+func withDelegatedAuth(handler http.Handler, kubeClient kubernetes.Interface) (http.Handler, error) {
+	authenticator, _, err := authenticatorfactory.DelegatingAuthenticatorConfig{
+		TokenAccessReviewClient: kubeClient.AuthenticationV1beta1().TokenReviews(),
+		CacheTTL:                delegatedAuthCacheTTL,
+	}.New()
+	if err != nil {
+		return nil, err
+	}
+	authz, err := authorizerfactory.DelegatingAuthorizerConfig{
+		SubjectAccessReviewClient: kubeClient.AuthorizationV1beta1().SubjectAccessReviews(),
+		AllowCacheTTL:             delegatedAuthCacheTTL,
+		DenyCacheTTL:              delegatedAuthCacheTTL,
+	}.New()
+	if err != nil {
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok, err := authenticator.AuthenticateRequest(r)
+		if err != nil {
+			glog.V(4).Infof("Unable to authenticate the request to %s: %v", r.URL.Path, err)
+		}
+		if !ok {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		decision, reason, err := authz.Authorize(authorizer.AttributesRecord{
+			User:            user,
+			Verb:            "get",
+			Path:            r.URL.Path,
+			ResourceRequest: false,
+		})
+		if err != nil {
+			glog.V(4).Infof("Unable to authorize %q to get %s: %v", user.GetName(), r.URL.Path, err)
+		}
+		if decision != authorizer.DecisionAllow {
+			glog.V(4).Infof("Forbidden: %q may not get %s: %s", user.GetName(), r.URL.Path, reason)
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	}), nil
+}
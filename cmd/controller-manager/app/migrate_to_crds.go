@@ -0,0 +1,133 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	servicecatalogclientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/crdmigration"
+)
+
+const migrateToCRDsAgentName = "service-catalog-migrate-to-crds"
+
+// MigrateToCRDsOptions contains the configuration of the migration of the
+// servicecatalog API group from the aggregated API server to
+// CustomResourceDefinitions.
+type MigrateToCRDsOptions struct {
+	// K8sAPIServerURL and K8sKubeconfigPath locate the Kubernetes API
+	// server. The in-cluster configuration is used if both are empty.
+	K8sAPIServerURL   string
+	K8sKubeconfigPath string
+	// ExportPath is the path of the file the objects are exported to
+	ExportPath string
+	// ControllerManagerNamespace and ControllerManagerDeployment identify the
+	// deployment of the controller manager, which is paused during the
+	// migration
+	ControllerManagerNamespace  string
+	ControllerManagerDeployment string
+	// Timeout is how long to wait for the controller manager to stop, and
+	// for the CustomResourceDefinitions to be served
+	Timeout time.Duration
+}
+
+// NewMigrateToCRDsOptions creates a new instance of MigrateToCRDsOptions with
+// the default values.
+func NewMigrateToCRDsOptions() *MigrateToCRDsOptions {
+	return &MigrateToCRDsOptions{
+		ControllerManagerNamespace: "catalog",
+		Timeout:                    5 * time.Minute,
+	}
+}
+
+// AddFlags adds to the flag set the flags to configure the migration.
+func (s *MigrateToCRDsOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.K8sAPIServerURL, "k8s-api-server-url", s.K8sAPIServerURL, "The URL for the k8s API server")
+	fs.StringVar(&s.K8sKubeconfigPath, "k8s-kubeconfig", s.K8sKubeconfigPath, "Path to k8s core kubeconfig")
+	fs.StringVar(&s.ExportPath, "export-file", s.ExportPath, "Path of the file the objects of the servicecatalog API group are exported to before they are migrated")
+	fs.StringVar(&s.ControllerManagerNamespace, "controller-manager-namespace", s.ControllerManagerNamespace, "The namespace of the deployment of the controller manager")
+	fs.StringVar(&s.ControllerManagerDeployment, "controller-manager-deployment", s.ControllerManagerDeployment, "The name of the deployment of the controller manager, which is scaled to zero during the migration")
+	fs.DurationVar(&s.Timeout, "timeout", s.Timeout, "How long to wait for the controller manager to stop, and for the CustomResourceDefinitions to be served")
+}
+
+// Validate checks that the migration options are consistent.
+func (s *MigrateToCRDsOptions) Validate() error {
+	var errors []error
+	if s.ExportPath == "" {
+		errors = append(errors, fmt.Errorf("--export-file is required"))
+	}
+	if s.ControllerManagerDeployment == "" {
+		errors = append(errors, fmt.Errorf("--controller-manager-deployment is required"))
+	}
+	if s.Timeout <= 0 {
+		errors = append(errors, fmt.Errorf("--timeout must be greater than 0"))
+	}
+	return utilerrors.NewAggregate(errors)
+}
+
+// RunMigrateToCRDs moves the objects of the servicecatalog API group from the
+// aggregated API server to CustomResourceDefinitions.
+func RunMigrateToCRDs(s *MigrateToCRDsOptions) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	var config *rest.Config
+	var err error
+	if s.K8sAPIServerURL == "" && s.K8sKubeconfigPath == "" {
+		config, err = rest.InClusterConfig()
+	} else {
+		config, err = clientcmd.BuildConfigFromFlags(s.K8sAPIServerURL, s.K8sKubeconfigPath)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client config: %v", err)
+	}
+	config = rest.AddUserAgent(config, migrateToCRDsAgentName)
+
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("invalid Kubernetes API configuration: %v", err)
+	}
+	catalogClient, err := servicecatalogclientset.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("invalid Service Catalog API configuration: %v", err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return fmt.Errorf("invalid Kubernetes API configuration: %v", err)
+	}
+
+	cutOver := crdmigration.NewRESTCutOver(discoveryClient.RESTClient(), time.Second, s.Timeout)
+	return crdmigration.NewMigrator(
+		kubeClient,
+		catalogClient,
+		cutOver,
+		s.ExportPath,
+		s.ControllerManagerNamespace,
+		s.ControllerManagerDeployment,
+		time.Second,
+		s.Timeout,
+	).Run()
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/componentconfig/v1alpha1"
+)
+
+// LoadConfigFile reads, defaults and validates the configuration file at the
+// given path.
+func LoadConfigFile(path string) (*v1alpha1.ControllerManagerConfiguration, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file %q: %v", path, err)
+	}
+	config, err := decodeConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration file %q: %v", path, err)
+	}
+	v1alpha1.SetDefaults_ControllerManagerConfiguration(config)
+	if errs := v1alpha1.ValidateControllerManagerConfiguration(config); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid configuration file %q: %v", path, errs.ToAggregate())
+	}
+	return config, nil
+}
+
+// decodeConfig decodes a YAML or JSON configuration file, rejecting unknown
+// fields so that a misspelled field is not silently ignored.
+func decodeConfig(data []byte) (*v1alpha1.ControllerManagerConfiguration, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	config := &v1alpha1.ControllerManagerConfiguration{}
+	if err := decoder.Decode(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ApplyConfigFile loads the configuration file given with --config, if any,
+// and uses its values for the flags of the given flag set that were not given
+// on the command line. It must be called after the flags are parsed.
+func (s *ControllerManagerServer) ApplyConfigFile(fs *pflag.FlagSet) error {
+	if s.ConfigFile == "" {
+		return nil
+	}
+	config, err := LoadConfigFile(s.ConfigFile)
+	if err != nil {
+		return err
+	}
+	return s.applyConfig(config, fs, utilfeature.DefaultFeatureGate)
+}
+
+// applyConfig uses the values of the given configuration file for the flags
+// of the given flag set that were not given on the command line, and sets its
+// feature gates on the given feature gate.
+func (s *ControllerManagerServer) applyConfig(config *v1alpha1.ControllerManagerConfiguration, fs *pflag.FlagSet, featureGate utilfeature.FeatureGate) error {
+	setDuration := func(flag string, d *metav1.Duration, dst *time.Duration) {
+		if d != nil && !fs.Changed(flag) {
+			*dst = d.Duration
+		}
+	}
+	setString := func(flag string, v string, dst *string) {
+		if v != "" && !fs.Changed(flag) {
+			*dst = v
+		}
+	}
+
+	timeouts := config.Timeouts
+	setDuration("resync-interval", timeouts.ResyncInterval, &s.ResyncInterval)
+	setDuration("broker-relist-interval", timeouts.BrokerRelistInterval, &s.ServiceBrokerRelistInterval)
+	setDuration("reconciliation-retry-duration", timeouts.ReconciliationRetryDuration, &s.ReconciliationRetryDuration)
+	setDuration("operation-polling-maximum-backoff-duration", timeouts.OperationPollingMaximumBackoffDuration, &s.OperationPollingMaximumBackoffDuration)
+	setDuration("stuck-deletion-threshold", timeouts.StuckDeletionThreshold, &s.StuckDeletionThreshold)
+	setDuration("namespace-deletion-broker-unreachable-timeout", timeouts.NamespaceDeletionBrokerUnreachableTimeout, &s.NamespaceDeletionBrokerUnreachableTimeout)
+	setDuration("instance-tombstone-ttl", timeouts.InstanceTombstoneTTL, &s.InstanceTombstoneTTL)
+	setDuration("deprovision-grace-period", timeouts.DeprovisionGracePeriod, &s.DeprovisionGracePeriod)
+	setDuration("shared-catalog-ttl", timeouts.SharedCatalogTTL, &s.SharedCatalogTTL)
+
+	concurrency := config.Concurrency
+	if v := concurrency.ConcurrentSyncs; v != nil && !fs.Changed("concurrent-syncs") {
+		s.ConcurrentSyncs = int(*v)
+	}
+	if v := concurrency.MaxConcurrentDeprovisionsPerBroker; v != nil && !fs.Changed("max-concurrent-deprovisions-per-broker") {
+		s.MaxConcurrentDeprovisionsPerBroker = int(*v)
+	}
+	setDuration("deprovision-batch-interval", concurrency.DeprovisionBatchInterval, &s.DeprovisionBatchInterval)
+	if v := concurrency.NamespaceDeletionMaxFailedAttempts; v != nil && !fs.Changed("namespace-deletion-max-failed-attempts") {
+		s.NamespaceDeletionMaxFailedAttempts = *v
+	}
+
+	osbClient := config.OSBClient
+	setString("osb-api-preferred-version", osbClient.PreferredVersion, &s.OSBAPIPreferredVersion)
+	setString("parameters-webhook-url", osbClient.ParametersWebhook.URL, &s.ParametersWebhookURL)
+	setString("parameters-webhook-ca-file", osbClient.ParametersWebhook.CAFile, &s.ParametersWebhookCAFile)
+	setDuration("parameters-webhook-timeout", osbClient.ParametersWebhook.Timeout, &s.ParametersWebhookTimeout)
+	setString("parameters-webhook-failure-policy", osbClient.ParametersWebhook.FailurePolicy, &s.ParametersWebhookFailurePolicy)
+	setString("catalog-webhook-url", osbClient.CatalogWebhook.URL, &s.CatalogWebhookURL)
+	setString("catalog-webhook-ca-file", osbClient.CatalogWebhook.CAFile, &s.CatalogWebhookCAFile)
+	setDuration("catalog-webhook-timeout", osbClient.CatalogWebhook.Timeout, &s.CatalogWebhookTimeout)
+	setString("catalog-webhook-failure-policy", osbClient.CatalogWebhook.FailurePolicy, &s.CatalogWebhookFailurePolicy)
+
+	return applyFeatureGates(featureGate, config.FeatureGates, fs)
+}
+
+// applyFeatureGates sets the given gates on the given feature gate, except for
+// those that were given with the --feature-gates flag.
+func applyFeatureGates(featureGate utilfeature.FeatureGate, gates map[string]bool, fs *pflag.FlagSet) error {
+	if len(gates) == 0 {
+		return nil
+	}
+	gates = copyFeatureGates(gates)
+	if flag := fs.Lookup("feature-gates"); flag != nil && flag.Changed {
+		// Only the gates given with the flag have been set so far.
+		for _, pair := range strings.Split(flag.Value.String(), ",") {
+			delete(gates, strings.SplitN(pair, "=", 2)[0])
+		}
+	}
+	if err := featureGate.SetFromMap(gates); err != nil {
+		return fmt.Errorf("invalid feature gates in configuration file: %v", err)
+	}
+	return nil
+}
+
+func copyFeatureGates(gates map[string]bool) map[string]bool {
+	c := make(map[string]bool, len(gates))
+	for k, v := range gates {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+)
+
+func writeConfigFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "controller-manager-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{
+			name: "valid",
+			content: `apiVersion: componentconfig.servicecatalog.k8s.io/v1alpha1
+kind: ControllerManagerConfiguration
+timeouts:
+  resyncInterval: 10m
+concurrency:
+  concurrentSyncs: 10
+osbClient:
+  preferredVersion: "2.13"
+`,
+		},
+		{
+			name:    "apiVersion and kind are defaulted",
+			content: "timeouts:\n  resyncInterval: 10m\n",
+		},
+		{
+			name:    "unknown field",
+			content: "timeouts:\n  resyncIntervall: 10m\n",
+			err:     "unknown field",
+		},
+		{
+			name:    "wrong kind",
+			content: "kind: Pod\n",
+			err:     "kind",
+		},
+		{
+			name:    "invalid value",
+			content: "concurrency:\n  concurrentSyncs: 0\n",
+			err:     "concurrency.concurrentSyncs",
+		},
+		{
+			name:    "invalid failure policy",
+			content: "osbClient:\n  parametersWebhook:\n    failurePolicy: Retry\n",
+			err:     "osbClient.parametersWebhook.failurePolicy",
+		},
+	}
+
+	for _, tc := range cases {
+		path, cleanup := writeConfigFile(t, tc.content)
+		config, err := LoadConfigFile(path)
+		cleanup()
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%v: unexpected error: %v", tc.name, err)
+			} else if config.Kind != "ControllerManagerConfiguration" {
+				t.Errorf("%v: expected the kind to be defaulted, got %q", tc.name, config.Kind)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%v: expected an error containing %q, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+// TestApplyConfig tests that the values of the configuration file are used
+// for the flags that are not given on the command line, and that the other
+// flags keep their defaults.
+func TestApplyConfig(t *testing.T) {
+	path, cleanup := writeConfigFile(t, `timeouts:
+  resyncInterval: 10m
+  brokerRelistInterval: 1h
+concurrency:
+  concurrentSyncs: 10
+osbClient:
+  parametersWebhook:
+    failurePolicy: Ignore
+featureGates:
+  PodPreset: true
+  OriginatingIdentity: true
+`)
+	defer cleanup()
+
+	s := NewControllerManagerServer()
+	featureGate := utilfeature.NewFeatureGate()
+	featureGate.Add(map[utilfeature.Feature]utilfeature.FeatureSpec{
+		"PodPreset":           {Default: false, PreRelease: utilfeature.Alpha},
+		"OriginatingIdentity": {Default: false, PreRelease: utilfeature.Alpha},
+	})
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.StringVar(&s.ConfigFile, "config", s.ConfigFile, "")
+	fs.DurationVar(&s.ServiceBrokerRelistInterval, "broker-relist-interval", s.ServiceBrokerRelistInterval, "")
+	fs.DurationVar(&s.ResyncInterval, "resync-interval", s.ResyncInterval, "")
+	fs.IntVar(&s.ConcurrentSyncs, "concurrent-syncs", s.ConcurrentSyncs, "")
+	featureGate.AddFlag(fs)
+	if err := fs.Parse([]string{"--config", path, "--broker-relist-interval", "2h", "--feature-gates", "OriginatingIdentity=false"}); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfigFile(s.ConfigFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.applyConfig(config, fs, featureGate); err != nil {
+		t.Fatal(err)
+	}
+
+	if e, a := 10*time.Minute, s.ResyncInterval; e != a {
+		t.Errorf("expected resync interval %v from the file, got %v", e, a)
+	}
+	if e, a := 2*time.Hour, s.ServiceBrokerRelistInterval; e != a {
+		t.Errorf("expected broker relist interval %v from the flag, got %v", e, a)
+	}
+	if e, a := 10, s.ConcurrentSyncs; e != a {
+		t.Errorf("expected %v concurrent syncs from the file, got %v", e, a)
+	}
+	if e, a := "Ignore", s.ParametersWebhookFailurePolicy; e != a {
+		t.Errorf("expected failure policy %v from the file, got %v", e, a)
+	}
+	if e, a := defaultOperationPollingMaximumBackoffDuration, s.OperationPollingMaximumBackoffDuration; e != a {
+		t.Errorf("expected the default operation polling maximum backoff %v, got %v", e, a)
+	}
+	if !featureGate.Enabled("PodPreset") {
+		t.Error("expected PodPreset to be enabled by the file")
+	}
+	if featureGate.Enabled("OriginatingIdentity") {
+		t.Error("expected OriginatingIdentity to stay disabled by the flag")
+	}
+}
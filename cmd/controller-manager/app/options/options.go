@@ -45,6 +45,10 @@ const (
 // manager.
 type ControllerManagerServer struct {
 	componentconfig.ControllerManagerConfiguration
+
+	// ConfigFile is the path to a configuration file whose values are used
+	// for the flags that are not given on the command line.
+	ConfigFile string
 }
 
 const (
@@ -60,6 +64,11 @@ const (
 	defaultLeaderElectionNamespace                = "kube-system"
 	defaultReconciliationRetryDuration            = 7 * 24 * time.Hour
 	defaultOperationPollingMaximumBackoffDuration = 20 * time.Minute
+	defaultStuckDeletionThreshold                 = 1 * time.Hour
+	defaultParametersWebhookTimeout               = 10 * time.Second
+	defaultCatalogWebhookTimeout                  = 10 * time.Second
+	defaultDeprovisionBatchInterval               = 10 * time.Second
+	defaultNamespaceProvisionRateWindow           = 1 * time.Hour
 )
 
 var defaultOSBAPIPreferredVersion = osb.LatestAPIVersion().HeaderValue()
@@ -85,6 +94,15 @@ func NewControllerManagerServer() *ControllerManagerServer {
 			EnableContentionProfiling:              false,
 			ReconciliationRetryDuration:            defaultReconciliationRetryDuration,
 			OperationPollingMaximumBackoffDuration: defaultOperationPollingMaximumBackoffDuration,
+			StuckDeletionThreshold:                 defaultStuckDeletionThreshold,
+			StorageMigration:                       true,
+			ParametersWebhookTimeout:               defaultParametersWebhookTimeout,
+			ParametersWebhookFailurePolicy:         string(controller.ParametersWebhookFail),
+			CatalogWebhookTimeout:                  defaultCatalogWebhookTimeout,
+			CatalogWebhookFailurePolicy:            string(controller.CatalogWebhookFail),
+			DeprovisionBatchInterval:               defaultDeprovisionBatchInterval,
+			NamespaceProvisionRateWindow:           defaultNamespaceProvisionRateWindow,
+			BindingSecretRotationPolicy:            string(controller.SecretRotationProceed),
 			SecureServingOptions:                   genericoptions.NewSecureServingOptions(),
 		},
 	}
@@ -97,11 +115,12 @@ func NewControllerManagerServer() *ControllerManagerServer {
 
 // AddFlags adds flags for a ControllerManagerServer to the specified FlagSet.
 func (s *ControllerManagerServer) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.ConfigFile, "config", s.ConfigFile, "Path to a ControllerManagerConfiguration file; flags given on the command line override its values")
 	fs.Var(k8scomponentconfig.IPVar{Val: &s.Address}, "address", "DEPRECATED: see --bind-address instead")
 	fs.MarkDeprecated("address", "see --bind-address instead")
 	fs.Int32Var(&s.Port, "port", 0, "DEPRECATED: see --secure-port instead")
 	fs.MarkDeprecated("port", "see --secure-port instead")
-	fs.StringVar(&s.ContentType, "api-content-type", s.ContentType, "Content type of requests sent to API servers")
+	fs.StringVar(&s.ContentType, "api-content-type", s.ContentType, "Content type of requests sent to the Kubernetes API server. Requests sent to the Service Catalog API server always use JSON, since protobuf encoding of its types is deferred")
 	fs.StringVar(&s.K8sAPIServerURL, "k8s-api-server-url", "", "The URL for the k8s API server")
 	fs.StringVar(&s.K8sKubeconfigPath, "k8s-kubeconfig", "", "Path to k8s core kubeconfig")
 	fs.StringVar(&s.ServiceCatalogAPIServerURL, "service-catalog-api-server-url", "", "The URL for the service-catalog API server")
@@ -112,14 +131,42 @@ func (s *ControllerManagerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.OSBAPIContextProfile, "enable-osb-api-context-profile", s.OSBAPIContextProfile, "This does nothing.")
 	fs.MarkHidden("enable-osb-api-context-profile")
 	fs.StringVar(&s.OSBAPIPreferredVersion, "osb-api-preferred-version", s.OSBAPIPreferredVersion, "The string to send as the version header.")
+	fs.IntVar(&s.ConcurrentSyncs, "concurrent-syncs", s.ConcurrentSyncs, "The number of resources of each type that are allowed to sync concurrently")
 	fs.BoolVar(&s.EnableProfiling, "profiling", s.EnableProfiling, "Enable profiling via web interface host:port/debug/pprof/")
 	fs.BoolVar(&s.EnableContentionProfiling, "contention-profiling", s.EnableContentionProfiling, "Enable lock contention profiling, if profiling is enabled")
+	fs.BoolVar(&s.EnableBrokerDashboard, "broker-dashboard", s.EnableBrokerDashboard, "Serve a read-only JSON summary of brokers, their health, instance counts and recent failures at host:port/brokers")
+	fs.BoolVar(&s.EnableBrokerRelistCallback, "broker-relist-callback", s.EnableBrokerRelistCallback, "Let brokers POST to host:port/relist/clusterservicebrokers/<name> or host:port/relist/namespaces/<namespace>/servicebrokers/<name> to request an immediate relist of their catalog, with the bearer token under the relistToken key of their auth secret")
 	leaderelectionconfig.BindFlags(&s.LeaderElection, fs)
 	fs.StringVar(&s.LeaderElectionNamespace, "leader-election-namespace", s.LeaderElectionNamespace, "Namespace to use for leader election lock")
 	fs.DurationVar(&s.ReconciliationRetryDuration, "reconciliation-retry-duration", s.ReconciliationRetryDuration, "The maximum amount of time to retry reconciliations on a resource before failing")
 	fs.DurationVar(&s.OperationPollingMaximumBackoffDuration, "operation-polling-maximum-backoff-duration", s.OperationPollingMaximumBackoffDuration, "The maximum amount of time to back-off while polling an OSB API operation")
+	fs.Int64Var(&s.NamespaceDeletionMaxFailedAttempts, "namespace-deletion-max-failed-attempts", s.NamespaceDeletionMaxFailedAttempts, "The number of failed deprovision or unbind requests after which an instance or binding in a namespace that is being deleted is abandoned at the broker; 0 disables the policy")
+	fs.DurationVar(&s.NamespaceDeletionBrokerUnreachableTimeout, "namespace-deletion-broker-unreachable-timeout", s.NamespaceDeletionBrokerUnreachableTimeout, "The amount of time after which an instance or binding in a namespace that is being deleted is abandoned if its broker is unreachable; 0 disables the policy")
+	fs.DurationVar(&s.StuckDeletionThreshold, "stuck-deletion-threshold", s.StuckDeletionThreshold, "The amount of time after which an instance or binding that is still being deleted is flagged as stuck; 0 disables the check")
+	fs.DurationVar(&s.InstanceTombstoneTTL, "instance-tombstone-ttl", s.InstanceTombstoneTTL, "The amount of time a tombstone recording a deprovisioned instance is kept in the namespace of the cluster-id configmap; 0 disables tombstones")
+	fs.DurationVar(&s.DeprovisionGracePeriod, "deprovision-grace-period", s.DeprovisionGracePeriod, "The amount of time to wait after an instance is deleted before deprovisioning it at the broker, during which the deletion can be cancelled; 0 deprovisions immediately")
+	fs.DurationVar(&s.SharedCatalogTTL, "shared-catalog-ttl", s.SharedCatalogTTL, "The amount of time the catalog fetched for a namespaced broker is reused by the other namespaced brokers with the same URL and credentials; 0 disables sharing")
+	fs.BoolVar(&s.StorageMigration, "storage-migration", s.StorageMigration, "Rewrite all stored service-catalog objects once after an upgrade, so that they are stored in the current storage version")
+	fs.StringVar(&s.ParametersWebhookURL, "parameters-webhook-url", s.ParametersWebhookURL, "The https URL of a webhook that is called with the parameters of each provision and update request, and returns the parameters to send to the broker instead")
+	fs.StringVar(&s.ParametersWebhookCAFile, "parameters-webhook-ca-file", s.ParametersWebhookCAFile, "Path to a PEM encoded CA bundle used to verify the certificate of the parameters webhook; the system trust roots are used if unset")
+	fs.DurationVar(&s.ParametersWebhookTimeout, "parameters-webhook-timeout", s.ParametersWebhookTimeout, "The maximum amount of time a call to the parameters webhook may take")
+	fs.IntVar(&s.MaxConcurrentDeprovisionsPerBroker, "max-concurrent-deprovisions-per-broker", s.MaxConcurrentDeprovisionsPerBroker, "The number of instances that may be deprovisioned at the same time at each broker; 0 disables the limit")
+	fs.DurationVar(&s.DeprovisionBatchInterval, "deprovision-batch-interval", s.DeprovisionBatchInterval, "The amount of time an instance waits before trying again to be deprovisioned when its broker is at the deprovision limit")
+	fs.StringVar(&s.CatalogWebhookURL, "catalog-webhook-url", s.CatalogWebhookURL, "The https URL of a webhook that is called with the catalog of each broker before it is synced, and returns the catalog to sync instead")
+	fs.StringVar(&s.CatalogWebhookCAFile, "catalog-webhook-ca-file", s.CatalogWebhookCAFile, "Path to a PEM encoded CA bundle used to verify the certificate of the catalog webhook; the system trust roots are used if unset")
+	fs.DurationVar(&s.CatalogWebhookTimeout, "catalog-webhook-timeout", s.CatalogWebhookTimeout, "The maximum amount of time a call to the catalog webhook may take")
+	fs.StringVar(&s.CatalogWebhookFailurePolicy, "catalog-webhook-failure-policy", s.CatalogWebhookFailurePolicy, "What to do when the catalog webhook cannot be called or returns an error: Fail the catalog sync, or Ignore the webhook and sync the catalog unmodified")
+	fs.BoolVar(&s.EnableFailureWebhooks, "failure-webhooks", s.EnableFailureWebhooks, "Notify the https URL in the servicecatalog.k8s.io/failure-webhook-url annotation of a namespace when an instance or binding in the namespace fails for good")
+	fs.StringSliceVar(&s.HTTPSParameterSourceHosts, "https-parameter-source-hosts", s.HTTPSParameterSourceHosts, "The host names, or host:port pairs, of the https URLs that the parametersFrom of instances and bindings may fetch parameters from; the requests are sent from the network of the controller manager, so fetching parameters over https is disabled if empty")
+	fs.StringSliceVar(&s.SecretPropagatedLabels, "secret-propagated-labels", s.SecretPropagatedLabels, "The keys of the labels of instances and bindings, such as an owner team or app, that are copied onto the secrets of bindings; a label of a binding takes precedence over the same label of its instance")
+	fs.BoolVar(&s.ReadOnly, "read-only", s.ReadOnly, "Make no provision, update, deprovision, bind or unbind requests to brokers while still polling the operations in progress and updating the status of resources, for example to freeze the system during an incident or a migration")
+	fs.IntVar(&s.NamespaceProvisionRateLimit, "namespace-provision-rate-limit", s.NamespaceProvisionRateLimit, "The number of instances that may start to be provisioned in each namespace within --namespace-provision-rate-window; instances over the limit wait with a Throttled condition; 0 disables the limit")
+	fs.DurationVar(&s.NamespaceProvisionRateWindow, "namespace-provision-rate-window", s.NamespaceProvisionRateWindow, "The time window of --namespace-provision-rate-limit")
+	fs.StringVar(&s.BindingSecretRotationPolicy, "binding-secret-rotation-policy", s.BindingSecretRotationPolicy, "What to do when new credentials of a binding would replace those of a secret that running pods consume: Proceed, WaitForDrain until no pod consumes it, or RequireAnnotation of the binding with servicecatalog.k8s.io/approve-secret-rotation=true while pods consume it")
+	fs.StringVar(&s.ParametersWebhookFailurePolicy, "parameters-webhook-failure-policy", s.ParametersWebhookFailurePolicy, "What to do when the parameters webhook cannot be called or returns an error: Fail the request to the broker, or Ignore the webhook and send the parameters unmodified")
 	s.SecureServingOptions.AddFlags(fs)
 	utilfeature.DefaultFeatureGate.AddFlag(fs)
 	fs.StringVar(&s.ClusterIDConfigMapName, "cluster-id-configmap-name", controller.DefaultClusterIDConfigMapName, "k8s name for clusterid configmap")
 	fs.StringVar(&s.ClusterIDConfigMapNamespace, "cluster-id-configmap-namespace", controller.DefaultClusterIDConfigMapNamespace, "k8s namespace for clusterid configmap")
+	fs.StringVar(&s.ClusterID, "cluster-id", s.ClusterID, "The cluster ID sent to brokers; if set, it replaces the one of the clusterid configmap, which rotates the cluster ID. Brokers may key billing off the cluster ID, so change it with care")
 }
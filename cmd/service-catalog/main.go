@@ -34,6 +34,8 @@ func main() {
 
 	hk.AddServer(server.NewAPIServer())
 	hk.AddServer(server.NewControllerManager())
+	hk.AddServer(server.NewMigrateStorage())
+	hk.AddServer(server.NewMigrateToCRDs())
 
 	hk.RunToExit(os.Args)
 }
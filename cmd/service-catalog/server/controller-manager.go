@@ -32,7 +32,10 @@ func NewControllerManager() *hyperkube.Server {
 		AlternativeName: "service-catalog-controller-manager",
 		SimpleUsage:     "controller-manager",
 		Long:            `The service-catalog controller manager is a daemon that embeds the core control loops shipped with the service catalog.`,
-		Run: func(_ *hyperkube.Server, args []string, stopCh <-chan struct{}) error {
+		Run: func(hks *hyperkube.Server, args []string, stopCh <-chan struct{}) error {
+			if err := s.ApplyConfigFile(hks.Flags()); err != nil {
+				return err
+			}
 			return app.Run(s)
 		},
 		RespectsStopCh: false,
@@ -0,0 +1,41 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/cmd/apiserver/app/server"
+	"github.com/kubernetes-incubator/service-catalog/pkg/hyperkube"
+)
+
+// NewMigrateStorage creates a new hyperkube Server object that includes the
+// description and flags.
+func NewMigrateStorage() *hyperkube.Server {
+	s := server.NewMigrateStorageOptions()
+
+	hks := hyperkube.Server{
+		PrimaryName:     "migrate-storage",
+		AlternativeName: "service-catalog-migrate-storage",
+		SimpleUsage:     "migrate-storage",
+		Long:            "Rewrites the objects stored in etcd by the API server in the storage version of their API group, so that the versions they were stored in before can be retired.",
+		Run: func(_ *hyperkube.Server, args []string, stopCh <-chan struct{}) error {
+			return server.RunMigrateStorage(s)
+		},
+		RespectsStopCh: false,
+	}
+	s.AddFlags(hks.Flags())
+	return &hks
+}
@@ -18,9 +18,11 @@ package broker
 
 import (
 	"fmt"
+	"io/ioutil"
 
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
 )
 
@@ -29,6 +31,8 @@ type RegisterCmd struct {
 	BrokerName string
 	Context    *command.Context
 	URL        string
+	File       string
+	DryRun     bool
 }
 
 // NewRegisterCmd builds a "svcat register" command
@@ -39,30 +43,55 @@ func NewRegisterCmd(cxt *command.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register NAME --url URL",
 		Short: "Registers a new broker with service catalog",
+		Long: `Register creates a cluster-scoped broker. With --file, it registers all of the
+brokers of a manifest instead: the brokers that do not exist yet are created,
+the ones that differ from the manifest are updated, and the existing brokers
+that are not in the manifest are listed but left as they are.`,
 		Example: command.NormalizeExamples(`
 		svcat register mysqlbroker --url http://mysqlbroker.com
+		svcat register -f brokers.yaml --dry-run
 		`),
 		PreRunE: command.PreRunE(registerCmd),
 		RunE:    command.RunE(registerCmd),
 	}
 	cmd.Flags().StringVar(&registerCmd.URL, "url", "",
-		"The broker URL (Required)")
-	cmd.MarkFlagRequired("url")
+		"The broker URL (Required unless --file is given)")
+	cmd.Flags().StringVarP(&registerCmd.File, "file", "f", "",
+		"A manifest of the brokers to register. Cannot be combined with a broker name and --url")
+	cmd.Flags().BoolVar(&registerCmd.DryRun, "dry-run", false,
+		"Only show the changes that registering the brokers of --file would make")
 	return cmd
 }
 
 // Validate checks that the required arguements have been provided
 func (c *RegisterCmd) Validate(args []string) error {
+	if c.File != "" {
+		if len(args) > 0 || c.URL != "" {
+			return fmt.Errorf("a broker name and --url cannot be combined with --file")
+		}
+		return nil
+	}
+	if c.DryRun {
+		return fmt.Errorf("--dry-run can only be used with --file")
+	}
+
 	if len(args) == 0 {
 		return fmt.Errorf("a broker name is required")
 	}
 	c.BrokerName = args[0]
 
+	if c.URL == "" {
+		return fmt.Errorf("a broker URL is required, specify it with --url")
+	}
+
 	return nil
 }
 
 // Run runs the command
 func (c *RegisterCmd) Run() error {
+	if c.File != "" {
+		return c.RegisterFile()
+	}
 	return c.Register()
 }
 
@@ -76,3 +105,25 @@ func (c *RegisterCmd) Register() error {
 	output.WriteBrokerDetails(c.Context.Output, broker)
 	return nil
 }
+
+// RegisterFile validates the broker manifest of the file, registers its
+// brokers and displays the changes made to them
+func (c *RegisterCmd) RegisterFile() error {
+	data, err := ioutil.ReadFile(c.File)
+	if err != nil {
+		return fmt.Errorf("unable to read the broker manifest (%s)", err)
+	}
+	manifest, err := servicecatalog.ParseBrokerManifest(data)
+	if err != nil {
+		return err
+	}
+	if err := manifest.Validate(); err != nil {
+		return fmt.Errorf("invalid broker manifest %s (%s)", c.File, err)
+	}
+
+	changes, err := c.Context.App.RegisterBrokers(manifest, c.DryRun)
+	if len(changes) > 0 {
+		output.WriteBrokerChanges(c.Context.Output, changes, c.DryRun)
+	}
+	return err
+}
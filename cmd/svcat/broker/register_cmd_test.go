@@ -18,12 +18,15 @@ package broker_test
 
 import (
 	"bytes"
+	"io/ioutil"
+	"os"
 
 	. "github.com/kubernetes-incubator/service-catalog/cmd/svcat/broker"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/test"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog/service-catalogfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -52,6 +55,21 @@ var _ = Describe("Register Command", func() {
 			err := cmd.Validate([]string{})
 			Expect(err).To(HaveOccurred())
 		})
+		It("errors if a broker URL is not provided", func() {
+			cmd := RegisterCmd{}
+			err := cmd.Validate([]string{"bananabroker"})
+			Expect(err).To(HaveOccurred())
+		})
+		It("errors if a broker name is combined with a file", func() {
+			cmd := RegisterCmd{File: "brokers.yaml"}
+			err := cmd.Validate([]string{"bananabroker"})
+			Expect(err).To(HaveOccurred())
+		})
+		It("errors if a dry run is requested without a file", func() {
+			cmd := RegisterCmd{URL: "http://bananabroker.com", DryRun: true}
+			err := cmd.Validate([]string{"bananabroker"})
+			Expect(err).To(HaveOccurred())
+		})
 	})
 	Describe("Register", func() {
 		It("Calls the pkg/svcat libs Register method with the passed in variables and prints output to the user", func() {
@@ -92,4 +110,70 @@ var _ = Describe("Register Command", func() {
 			Expect(output).To(ContainSubstring(brokerURL))
 		})
 	})
+	Describe("RegisterFile", func() {
+		var manifestFile string
+
+		BeforeEach(func() {
+			f, err := ioutil.TempFile("", "brokers")
+			Expect(err).NotTo(HaveOccurred())
+			manifestFile = f.Name()
+			f.Close()
+		})
+		AfterEach(func() {
+			os.Remove(manifestFile)
+		})
+
+		It("Calls the pkg/svcat libs RegisterBrokers method with the brokers of the file and prints the changes", func() {
+			err := ioutil.WriteFile(manifestFile, []byte(`
+brokers:
+- name: foobarbroker
+  url: http://foobar.com
+`), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			outputBuffer := &bytes.Buffer{}
+			fakeApp, _ := svcat.NewApp(nil, nil, "default")
+			fakeSDK := new(servicecatalogfakes.FakeSvcatClient)
+			fakeSDK.RegisterBrokersReturns([]servicecatalog.BrokerChange{
+				{Name: "foobarbroker", URL: "http://foobar.com", Action: servicecatalog.BrokerChangeCreate},
+			}, nil)
+			fakeApp.SvcatClient = fakeSDK
+			cmd := RegisterCmd{
+				Context: svcattest.NewContext(outputBuffer, fakeApp),
+				File:    manifestFile,
+				DryRun:  true,
+			}
+			err = cmd.RegisterFile()
+
+			Expect(err).NotTo(HaveOccurred())
+			manifest, dryRun := fakeSDK.RegisterBrokersArgsForCall(0)
+			Expect(manifest.Brokers).To(HaveLen(1))
+			Expect(manifest.Brokers[0].Name).To(Equal("foobarbroker"))
+			Expect(dryRun).To(BeTrue())
+
+			output := outputBuffer.String()
+			Expect(output).To(ContainSubstring("foobarbroker"))
+			Expect(output).To(ContainSubstring("1 to create"))
+		})
+		It("does not register the brokers of an invalid file", func() {
+			err := ioutil.WriteFile(manifestFile, []byte(`
+brokers:
+- name: foobarbroker
+`), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeApp, _ := svcat.NewApp(nil, nil, "default")
+			fakeSDK := new(servicecatalogfakes.FakeSvcatClient)
+			fakeApp.SvcatClient = fakeSDK
+			cmd := RegisterCmd{
+				Context: svcattest.NewContext(&bytes.Buffer{}, fakeApp),
+				File:    manifestFile,
+			}
+			err = cmd.RegisterFile()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("brokers[0].url: Required value"))
+			Expect(fakeSDK.RegisterBrokersCallCount()).To(Equal(0))
+		})
+	})
 })
@@ -70,6 +70,8 @@ func (c *CreateCmd) Run() error {
 	}
 
 	class.Name = c.Name
+	// The external name of a class is unique among the classes of its broker.
+	class.Spec.ExternalName = c.Name
 
 	createdClass, err := c.App.CreateClass(class)
 	if err != nil {
@@ -91,6 +91,7 @@ var _ = Describe("Create Command", func() {
 			Expect(err).NotTo(HaveOccurred())
 			class := fakeSDK.CreateClassArgsForCall(0)
 			Expect(class.Name).To(Equal(className))
+			Expect(class.Spec.ExternalName).To(Equal(className))
 
 			output := outputBuffer.String()
 			Expect(output).To(ContainSubstring(className))
@@ -0,0 +1,211 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/parameters"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type bulkCmd struct {
+	*command.Namespaced
+	*command.Waitable
+
+	selector    string
+	rawParams   []string
+	jsonParams  string
+	params      map[string]interface{}
+	planName    string
+	touch       bool
+	concurrency int
+}
+
+// NewBulkCmd builds a "svcat bulk instances" command.
+func NewBulkCmd(cxt *command.Context) *cobra.Command {
+	bulkCmd := &bulkCmd{
+		Namespaced: command.NewNamespaced(cxt),
+		Waitable:   command.NewWaitable(),
+	}
+	cmd := &cobra.Command{
+		Use:     "instances --selector SELECTOR",
+		Aliases: []string{"instance", "inst"},
+		Short:   "Update, upgrade or touch all instances matching a label selector",
+		Long: `Bulk instances applies the same change to every instance that matches the
+label selector, updating at most --concurrency instances at a time, and
+reports the result of each instance once all of them have been processed.
+The parameters given with --param or --params-json are merged into the
+parameters of each instance, --to-plan moves each instance to another plan
+of its class, and --touch makes service catalog process each instance again.`,
+		Example: command.NormalizeExamples(`
+  svcat bulk instances --selector app=shop --param tier=gold
+  svcat bulk instances --selector app=shop --to-plan premium --concurrency 2 --wait
+  svcat bulk instances --all-namespaces --selector team=payments --touch`),
+		PreRunE: command.PreRunE(bulkCmd),
+		RunE:    command.RunE(bulkCmd),
+	}
+	bulkCmd.AddNamespaceFlags(cmd.Flags(), true)
+	cmd.Flags().StringVarP(&bulkCmd.selector, "selector", "l", "",
+		"Selector (label query) of the instances to change, for example app=shop (Required)")
+	cmd.MarkFlagRequired("selector")
+	cmd.Flags().StringSliceVarP(&bulkCmd.rawParams, "param", "p", nil,
+		"Parameter to set on each instance, format: NAME=VALUE. Cannot be combined with --params-json")
+	cmd.Flags().StringVar(&bulkCmd.jsonParams, "params-json", "",
+		"Parameters to set on each instance in valid JSON format. Cannot be combined with --param")
+	cmd.Flags().StringVar(&bulkCmd.planName, "to-plan", "",
+		"The name of the plan to move each instance to")
+	cmd.Flags().BoolVar(&bulkCmd.touch, "touch", false,
+		"Make service catalog process each instance again")
+	cmd.Flags().IntVar(&bulkCmd.concurrency, "concurrency", 5,
+		"The maximum number of instances to change at the same time")
+	bulkCmd.AddWaitFlags(cmd)
+
+	return cmd
+}
+
+func (c *bulkCmd) Validate(args []string) error {
+	if c.concurrency < 1 {
+		return fmt.Errorf("--concurrency must be at least 1")
+	}
+
+	if c.jsonParams != "" && len(c.rawParams) > 0 {
+		return fmt.Errorf("--params-json cannot be used with --param")
+	}
+
+	var err error
+	if c.jsonParams != "" {
+		c.params, err = parameters.ParseVariableJSON(c.jsonParams)
+		if err != nil {
+			return fmt.Errorf("invalid --params-json value (%s)", err)
+		}
+	} else if len(c.rawParams) > 0 {
+		c.params, err = parameters.ParseVariableAssignments(c.rawParams)
+		if err != nil {
+			return fmt.Errorf("invalid --param value (%s)", err)
+		}
+	}
+
+	if c.params == nil && c.planName == "" && !c.touch {
+		return fmt.Errorf("at least one of --param, --params-json, --to-plan or --touch is required")
+	}
+
+	return nil
+}
+
+func (c *bulkCmd) Run() error {
+	return c.Bulk()
+}
+
+func (c *bulkCmd) Bulk() error {
+	instances, err := c.App.RetrieveInstancesBySelector(c.Namespace, c.selector)
+	if err != nil {
+		return err
+	}
+	total := len(instances.Items)
+	if total == 0 {
+		fmt.Fprintf(c.Output, "No instances match the selector %q\n", c.selector)
+		return nil
+	}
+
+	results := make([]v1beta1.ServiceInstance, total)
+	errs := make([]error, total)
+	var lock sync.Mutex
+	done := 0
+	workqueue.Parallelize(c.concurrency, total, func(i int) {
+		instance := &instances.Items[i]
+		updated, err := c.change(instance)
+		if updated != nil {
+			results[i] = *updated
+		} else {
+			results[i] = *instance
+		}
+		errs[i] = err
+
+		lock.Lock()
+		defer lock.Unlock()
+		done++
+		if err != nil {
+			fmt.Fprintf(c.Output, "[%d/%d] %s/%s: failed: %s\n", done, total, instance.Namespace, instance.Name, err)
+		} else {
+			fmt.Fprintf(c.Output, "[%d/%d] %s/%s: done\n", done, total, instance.Namespace, instance.Name)
+		}
+	})
+
+	output.WriteBulkInstanceResults(c.Output, results, errs)
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d instances failed", failed, total)
+	}
+	return nil
+}
+
+// change applies the requested changes to a single instance, waiting for
+// service catalog to process them if requested. It returns the latest known
+// state of the instance.
+func (c *bulkCmd) change(instance *v1beta1.ServiceInstance) (*v1beta1.ServiceInstance, error) {
+	const retries = 3
+	ns, name := instance.Namespace, instance.Name
+
+	if c.planName != "" {
+		plan, err := c.App.ValidatePlanUpgrade(instance, c.planName)
+		if err != nil {
+			return nil, err
+		}
+		instance, err = c.App.UpdateInstancePlan(ns, name, plan, retries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.params != nil {
+		var err error
+		instance, err = c.App.UpdateInstanceParameters(ns, name, c.params, retries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.touch {
+		if err := c.App.TouchInstance(ns, name, retries); err != nil {
+			return instance, err
+		}
+	}
+
+	if !c.Wait {
+		return instance, nil
+	}
+	finalInstance, err := c.App.WaitForInstance(ns, name, c.Interval, c.Timeout)
+	if err != nil {
+		return instance, err
+	}
+	if finalInstance != nil && c.App.IsInstanceFailed(finalInstance) {
+		return finalInstance, fmt.Errorf("the instance failed to be updated")
+	}
+	return finalInstance, nil
+}
@@ -21,12 +21,16 @@ import (
 
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 type describeCmd struct {
 	*command.Namespaced
-	name string
+	name   string
+	follow bool
 }
 
 // NewDescribeCmd builds a "svcat describe instance" command
@@ -38,11 +42,19 @@ func NewDescribeCmd(cxt *command.Context) *cobra.Command {
 		Short:   "Show details of a specific instance",
 		Example: command.NormalizeExamples(`
   svcat describe instance wordpress-mysql-instance
+  svcat describe instance wordpress-mysql-instance --follow
 `),
 		PreRunE: command.PreRunE(describeCmd),
 		RunE:    command.RunE(describeCmd),
 	}
 	describeCmd.AddNamespaceFlags(cmd.Flags(), false)
+	cmd.Flags().BoolVarP(
+		&describeCmd.follow,
+		"follow",
+		"f",
+		false,
+		"Print changes to the status of the instance until its current operation completes",
+	)
 	return cmd
 }
 
@@ -73,5 +85,55 @@ func (c *describeCmd) describe() error {
 	}
 	output.WriteAssociatedBindings(c.Output, bindings)
 
+	if c.follow {
+		return c.followInstance(instance)
+	}
 	return nil
 }
+
+// followInstance prints the changes to the status of the instance, as they
+// are seen by a watch, until its current operation completes or the instance
+// is deleted.
+func (c *describeCmd) followInstance(instance *v1beta1.ServiceInstance) error {
+	if c.isOperationDone(instance) {
+		return nil
+	}
+
+	fmt.Fprintln(c.Output, "\nProgress:")
+	output.WriteInstanceProgress(c.Output, nil, instance)
+
+	w, err := c.App.WatchInstance(c.Namespace, c.name)
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	for event := range w.ResultChan() {
+		switch event.Type {
+		case watch.Deleted:
+			output.WriteDeletedResourceName(c.Output, c.name)
+			return nil
+		case watch.Error:
+			return fmt.Errorf("error watching the instance: %v", apierrors.FromObject(event.Object))
+		}
+		updated, ok := event.Object.(*v1beta1.ServiceInstance)
+		if !ok {
+			continue
+		}
+		output.WriteInstanceProgress(c.Output, instance, updated)
+		instance = updated
+		if c.isOperationDone(instance) {
+			return nil
+		}
+	}
+	return fmt.Errorf("the watch of the instance was closed before its operation completed")
+}
+
+// isOperationDone returns whether the instance has no operation in progress
+// and is not being deleted.
+func (c *describeCmd) isOperationDone(instance *v1beta1.ServiceInstance) bool {
+	if instance.DeletionTimestamp != nil || instance.Status.CurrentOperation != "" || instance.Status.AsyncOpInProgress {
+		return false
+	}
+	return c.App.IsInstanceReady(instance) || c.App.IsInstanceFailed(instance)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/spf13/cobra"
+)
+
+type upgradeCmd struct {
+	*command.Namespaced
+	*command.Waitable
+
+	instanceName string
+	planName     string
+}
+
+// NewUpgradeCmd builds a "svcat upgrade instance" command.
+func NewUpgradeCmd(cxt *command.Context) *cobra.Command {
+	upgradeCmd := &upgradeCmd{
+		Namespaced: command.NewNamespaced(cxt),
+		Waitable:   command.NewWaitable(),
+	}
+	cmd := &cobra.Command{
+		Use:   "instance NAME --to-plan PLAN",
+		Short: "Move an instance to another plan of its class",
+		Long: `Upgrade instance checks that the plan the instance has declares the new plan
+as one of its upgrade paths, warns about any downtime that the new plan
+declares, and then updates the plan of the instance.`,
+		Example: command.NormalizeExamples(`
+  svcat upgrade instance wordpress-mysql-instance --to-plan premium
+  svcat upgrade instance wordpress-mysql-instance --to-plan premium --wait`),
+		PreRunE: command.PreRunE(upgradeCmd),
+		RunE:    command.RunE(upgradeCmd),
+	}
+	upgradeCmd.AddNamespaceFlags(cmd.Flags(), false)
+	cmd.Flags().StringVar(&upgradeCmd.planName, "to-plan", "",
+		"The name of the plan to move the instance to (Required)")
+	cmd.MarkFlagRequired("to-plan")
+	upgradeCmd.AddWaitFlags(cmd)
+
+	return cmd
+}
+
+func (c *upgradeCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("an instance name is required")
+	}
+	c.instanceName = args[0]
+
+	return nil
+}
+
+func (c *upgradeCmd) Run() error {
+	return c.Upgrade()
+}
+
+func (c *upgradeCmd) Upgrade() error {
+	instance, err := c.App.RetrieveInstance(c.Namespace, c.instanceName)
+	if err != nil {
+		return err
+	}
+
+	plan, err := c.App.ValidatePlanUpgrade(instance, c.planName)
+	if err != nil {
+		return err
+	}
+	if downtime := plan.Spec.GetUpgradeDowntime(); downtime != "" {
+		fmt.Fprintf(c.Output, "Warning: moving to plan %s may cause downtime: %s\n", c.planName, downtime)
+	}
+
+	const retries = 3
+	instance, err = c.App.UpdateInstancePlan(c.Namespace, c.instanceName, plan, retries)
+	if err != nil {
+		return err
+	}
+
+	if c.Wait {
+		fmt.Fprintln(c.Output, "Waiting for the instance to be updated...")
+		finalInstance, err := c.App.WaitForInstance(instance.Namespace, instance.Name, c.Interval, c.Timeout)
+		if err == nil {
+			instance = finalInstance
+		}
+
+		// Always print the instance because the update did succeed,
+		// and just print any errors that occurred while polling
+		output.WriteInstanceDetails(c.Output, instance)
+		return err
+	}
+
+	output.WriteInstanceDetails(c.Output, instance)
+	return nil
+}
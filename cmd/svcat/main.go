@@ -34,6 +34,7 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/instance"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/plan"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/plugin"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/preflight"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/versions"
 	svcatclient "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat"
@@ -70,8 +71,10 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 
 	// root command flags
 	var opts struct {
-		KubeConfig  string
-		KubeContext string
+		KubeConfig        string
+		KubeContext       string
+		Impersonate       string
+		ImpersonateGroups []string
 	}
 
 	cmd := &cobra.Command{
@@ -91,7 +94,11 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 
 			// Initialize the context if not already configured (by tests)
 			if cxt.App == nil {
-				k8sClient, svcatClient, namespace, err := getClients(opts.KubeConfig, opts.KubeContext)
+				impersonate := rest.ImpersonationConfig{
+					UserName: opts.Impersonate,
+					Groups:   opts.ImpersonateGroups,
+				}
+				k8sClient, svcatClient, namespace, err := getClients(opts.KubeConfig, opts.KubeContext, impersonate)
 				if err != nil {
 					return err
 				}
@@ -114,6 +121,8 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&opts.KubeContext, "context", "", "name of the kubeconfig context to use.")
 	cmd.PersistentFlags().StringVar(&opts.KubeConfig, "kubeconfig", "", "path to kubeconfig file. Overrides $KUBECONFIG")
+	cmd.PersistentFlags().StringVar(&opts.Impersonate, "as", "", "username to impersonate for the operation.")
+	cmd.PersistentFlags().StringArrayVar(&opts.ImpersonateGroups, "as-group", nil, "group to impersonate for the operation, this flag can be repeated to specify multiple groups.")
 
 	cmd.AddCommand(newCreateCmd(cxt))
 	cmd.AddCommand(newGetCmd(cxt))
@@ -128,7 +137,10 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 		cmd.AddCommand(newInstallCmd(cxt))
 	}
 	cmd.AddCommand(newTouchCmd(cxt))
+	cmd.AddCommand(newUpgradeCmd(cxt))
+	cmd.AddCommand(newBulkCmd(cxt))
 	cmd.AddCommand(versions.NewVersionCmd(cxt))
+	cmd.AddCommand(preflight.NewPreflightCmd(cxt))
 	cmd.AddCommand(newCompletionCmd(cxt))
 
 	return cmd
@@ -202,12 +214,32 @@ func newTouchCmd(cxt *command.Context) *cobra.Command {
 	return cmd
 }
 
+func newUpgradeCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Move a resource to another plan",
+	}
+	cmd.AddCommand(instance.NewUpgradeCmd(cxt))
+	return cmd
+}
+
+func newBulkCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk",
+		Short: "Change many resources at once",
+	}
+	cmd.AddCommand(instance.NewBulkCmd(cxt))
+	return cmd
+}
+
 func newCompletionCmd(ctx *command.Context) *cobra.Command {
 	return completion.NewCompletionCmd(ctx)
 }
 
 // getClients loads api clients based on the plugin context if present, otherwise the specified kube config.
-func getClients(kubeConfig, kubeContext string) (k8sClient k8sclient.Interface, svcatClient svcatclient.Interface, namespaces string, err error) {
+// The clients impersonate the given user and groups, if set, on top of the impersonation configured by the
+// kube config or by the --as and --as-group flags of kubectl.
+func getClients(kubeConfig, kubeContext string, impersonate rest.ImpersonationConfig) (k8sClient k8sclient.Interface, svcatClient svcatclient.Interface, namespaces string, err error) {
 	var restConfig *rest.Config
 	var config clientcmd.ClientConfig
 
@@ -224,6 +256,13 @@ func getClients(kubeConfig, kubeContext string) (k8sClient k8sclient.Interface,
 		}
 	}
 
+	if impersonate.UserName != "" {
+		restConfig.Impersonate.UserName = impersonate.UserName
+	}
+	if len(impersonate.Groups) > 0 {
+		restConfig.Impersonate.Groups = impersonate.Groups
+	}
+
 	namespace, _, err := config.Namespace()
 	k8sClient, err = k8sclient.NewForConfig(restConfig)
 	if err != nil {
@@ -85,12 +85,17 @@ func WriteBinding(w io.Writer, outputFormat string, binding v1beta1.ServiceBindi
 
 // WriteBindingDetails prints details for a single binding.
 func WriteBindingDetails(w io.Writer, binding *v1beta1.ServiceBinding) {
+	secretName := binding.Spec.SecretName
+	if binding.Status.SecretName != "" {
+		secretName = binding.Status.SecretName
+	}
+
 	t := NewDetailsTable(w)
 	t.AppendBulk([][]string{
 		{"Name:", binding.Name},
 		{"Namespace:", binding.Namespace},
 		{"Status:", getBindingStatusFull(binding.Status)},
-		{"Secret:", binding.Spec.SecretName},
+		{"Secret:", secretName},
 		{"Instance:", binding.Spec.ServiceInstanceRef.Name},
 	})
 	t.Render()
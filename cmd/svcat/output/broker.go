@@ -17,7 +17,10 @@ limitations under the License.
 package output
 
 import (
+	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
@@ -83,6 +86,37 @@ func WriteBroker(w io.Writer, outputFormat string, broker v1beta1.ClusterService
 	}
 }
 
+// WriteBrokerChanges prints the changes made to the brokers by registering a
+// manifest, or the changes that would be made with dryRun, followed by how
+// many brokers each change affects.
+func WriteBrokerChanges(w io.Writer, changes []servicecatalog.BrokerChange, dryRun bool) {
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Name",
+		"URL",
+		"Action",
+		"Changed Fields",
+	})
+	counts := map[servicecatalog.BrokerChangeAction]int{}
+	for _, change := range changes {
+		counts[change.Action]++
+		t.Append([]string{
+			change.Name,
+			change.URL,
+			string(change.Action),
+			strings.Join(change.Fields, ", "),
+		})
+	}
+	t.Render()
+	summary := "%d created, %d updated, %d unchanged, %d not in the manifest\n"
+	if dryRun {
+		summary = "%d to create, %d to update, %d unchanged, %d not in the manifest (dry run)\n"
+	}
+	fmt.Fprintf(w, summary,
+		counts[servicecatalog.BrokerChangeCreate], counts[servicecatalog.BrokerChangeUpdate],
+		counts[servicecatalog.BrokerChangeNone], counts[servicecatalog.BrokerChangeNotInManifest])
+}
+
 // WriteBrokerDetails prints details for a single broker.
 func WriteBrokerDetails(w io.Writer, broker servicecatalog.Broker) {
 	t := NewDetailsTable(w)
@@ -93,5 +127,16 @@ func WriteBrokerDetails(w io.Writer, broker servicecatalog.Broker) {
 		{"Status:", getBrokerStatusFull(broker.GetStatus())},
 	})
 
+	status := broker.GetStatus()
+	if status.LastRelistDuration != nil {
+		t.AppendBulk([][]string{
+			{"Classes:", strconv.Itoa(int(status.ClassCount))},
+			{"Plans:", strconv.Itoa(int(status.PlanCount))},
+			{"OSB API Version:", status.OSBAPIVersion},
+			{"Last Relist Duration:", status.LastRelistDuration.Duration.String()},
+			{"Catalog Checksum:", status.LastCatalogChecksum},
+		})
+	}
+
 	t.Render()
 }
@@ -87,5 +87,11 @@ func WriteClassDetails(w io.Writer, class *v1beta1.ClusterServiceClass) {
 		{"Tags:", strings.Join(class.Spec.Tags, ", ")},
 		{"Broker:", class.Spec.ClusterServiceBrokerName},
 	})
+	if class.Spec.DocumentationURL != "" {
+		t.Append([]string{"Documentation:", class.Spec.DocumentationURL})
+	}
+	if class.Spec.SupportURL != "" {
+		t.Append([]string{"Support:", class.Spec.SupportURL})
+	}
 	t.Render()
 }
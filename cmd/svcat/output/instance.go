@@ -19,12 +19,20 @@ package output
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/olekukonko/tablewriter"
 )
 
 func getInstanceStatusCondition(status v1beta1.ServiceInstanceStatus) v1beta1.ServiceInstanceCondition {
+	// An instance reported as unusable by the broker may need manual
+	// intervention, so surface that ahead of any other condition.
+	for _, cond := range status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionUnusable && cond.Status == v1beta1.ConditionTrue {
+			return cond
+		}
+	}
 	if len(status.Conditions) > 0 {
 		return status.Conditions[len(status.Conditions)-1]
 	}
@@ -136,6 +144,37 @@ func WriteAssociatedInstances(w io.Writer, instances []v1beta1.ServiceInstance)
 	t.Render()
 }
 
+// WriteBulkInstanceResults prints the outcome of a change applied to many
+// instances at once, followed by how many of them succeeded and failed. errs
+// holds the error, if any, of the instance at the same index.
+func WriteBulkInstanceResults(w io.Writer, instances []v1beta1.ServiceInstance, errs []error) {
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Name",
+		"Namespace",
+		"Plan",
+		"Status",
+		"Result",
+	})
+	failed := 0
+	for i, instance := range instances {
+		result := "Succeeded"
+		if errs[i] != nil {
+			result = fmt.Sprintf("Failed: %s", errs[i])
+			failed++
+		}
+		t.Append([]string{
+			instance.Name,
+			instance.Namespace,
+			instance.Spec.GetSpecifiedClusterServicePlan(),
+			getInstanceStatusShort(instance.Status),
+			result,
+		})
+	}
+	t.Render()
+	fmt.Fprintf(w, "%d succeeded, %d failed\n", len(instances)-failed, failed)
+}
+
 // WriteInstanceDetails prints an instance.
 func WriteInstanceDetails(w io.Writer, instance *v1beta1.ServiceInstance) {
 	t := NewDetailsTable(w)
@@ -153,4 +192,57 @@ func WriteInstanceDetails(w io.Writer, instance *v1beta1.ServiceInstance) {
 
 	writeParameters(w, instance.Spec.Parameters)
 	writeParametersFrom(w, instance.Spec.ParametersFrom)
+	writeParametersDiff(w, instance.Status.ParametersDiff)
+}
+
+// WriteInstanceProgress prints the conditions and the result of the last poll
+// of the broker of an instance that changed since the previous version of the
+// instance, which may be nil.
+func WriteInstanceProgress(w io.Writer, previous, instance *v1beta1.ServiceInstance) {
+	for _, cond := range instance.Status.Conditions {
+		if previous != nil && instanceHasCondition(previous, cond) {
+			continue
+		}
+		if status := formatStatusFull(string(cond.Type), cond.Status, cond.Reason, cond.Message, cond.LastTransitionTime); status != "" {
+			fmt.Fprintln(w, status)
+		}
+	}
+	if instance.Status.LastPollResult != "" && (previous == nil || previous.Status.LastPollResult != instance.Status.LastPollResult) {
+		pollTime := ""
+		if instance.Status.LastPollTime != nil {
+			pollTime = fmt.Sprintf(" @ %s", instance.Status.LastPollTime.UTC())
+		}
+		fmt.Fprintf(w, "Last operation - %s%s\n", instance.Status.LastPollResult, pollTime)
+	}
+}
+
+// instanceHasCondition returns whether the instance has a condition of the
+// same type, status, reason and message as the given one.
+func instanceHasCondition(instance *v1beta1.ServiceInstance, cond v1beta1.ServiceInstanceCondition) bool {
+	for _, c := range instance.Status.Conditions {
+		if c.Type == cond.Type && c.Status == cond.Status && c.Reason == cond.Reason && c.Message == cond.Message {
+			return true
+		}
+	}
+	return false
+}
+
+func writeParametersDiff(w io.Writer, diff *v1beta1.ParametersDiff) {
+	if diff == nil {
+		return
+	}
+
+	fmt.Fprintln(w, "\nLast Parameters Change:")
+	for _, change := range []struct {
+		name  string
+		paths []string
+	}{
+		{"Added", diff.Added},
+		{"Changed", diff.Changed},
+		{"Removed", diff.Removed},
+	} {
+		if len(change.paths) > 0 {
+			fmt.Fprintf(w, "  %s: %s\n", change.name, strings.Join(change.paths, ", "))
+		}
+	}
 }
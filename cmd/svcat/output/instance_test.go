@@ -19,9 +19,11 @@ package output
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/olekukonko/tablewriter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Test_appendInstanceDashboardURL(t *testing.T) {
@@ -53,3 +55,96 @@ func Test_appendInstanceDashboardURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_getInstanceStatusShort(t *testing.T) {
+	ready := v1beta1.ServiceInstanceCondition{
+		Type:   v1beta1.ServiceInstanceConditionReady,
+		Status: v1beta1.ConditionTrue,
+		Reason: "InstanceUpdatedSuccessfully",
+	}
+	notReady := v1beta1.ServiceInstanceCondition{
+		Type:   v1beta1.ServiceInstanceConditionReady,
+		Status: v1beta1.ConditionFalse,
+		Reason: "UpdateInstanceCallFailed",
+	}
+	unusable := v1beta1.ServiceInstanceCondition{
+		Type:   v1beta1.ServiceInstanceConditionUnusable,
+		Status: v1beta1.ConditionTrue,
+		Reason: "InstanceUnusable",
+	}
+
+	tests := []struct {
+		name           string
+		status         v1beta1.ServiceInstanceStatus
+		expectedStatus string
+	}{
+		{"noConditions", v1beta1.ServiceInstanceStatus{}, ""},
+		{"ready", v1beta1.ServiceInstanceStatus{
+			Conditions: []v1beta1.ServiceInstanceCondition{ready},
+		}, "Ready"},
+		{"notReady", v1beta1.ServiceInstanceStatus{
+			Conditions: []v1beta1.ServiceInstanceCondition{notReady},
+		}, "UpdateInstanceCallFailed"},
+		{"unusableBeforeReady", v1beta1.ServiceInstanceStatus{
+			Conditions: []v1beta1.ServiceInstanceCondition{unusable, notReady},
+		}, "Unusable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actualStatus := getInstanceStatusShort(tt.status); actualStatus != tt.expectedStatus {
+				t.Fatalf("%v failed; expected %v; got %v", tt.name, tt.expectedStatus, actualStatus)
+			}
+		})
+	}
+}
+
+func TestWriteInstanceProgress(t *testing.T) {
+	transitionTime := metav1.NewTime(time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC))
+	provisioning := v1beta1.ServiceInstanceCondition{
+		Type:               v1beta1.ServiceInstanceConditionReady,
+		Status:             v1beta1.ConditionFalse,
+		Reason:             "Provisioning",
+		Message:            "The instance is being provisioned asynchronously.",
+		LastTransitionTime: transitionTime,
+	}
+	ready := v1beta1.ServiceInstanceCondition{
+		Type:               v1beta1.ServiceInstanceConditionReady,
+		Status:             v1beta1.ConditionTrue,
+		Reason:             "ProvisionedSuccessfully",
+		Message:            "The instance was provisioned successfully",
+		LastTransitionTime: transitionTime,
+	}
+	previous := &v1beta1.ServiceInstance{
+		Status: v1beta1.ServiceInstanceStatus{
+			Conditions:     []v1beta1.ServiceInstanceCondition{provisioning},
+			LastPollResult: "in progress",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		previous       *v1beta1.ServiceInstance
+		status         v1beta1.ServiceInstanceStatus
+		expectedOutput string
+	}{
+		{"noPrevious", nil, previous.Status,
+			"Provisioning - The instance is being provisioned asynchronously @ 2018-01-02 03:04:05 +0000 UTC\n" +
+				"Last operation - in progress\n"},
+		{"unchanged", previous, previous.Status, ""},
+		{"changed", previous, v1beta1.ServiceInstanceStatus{
+			Conditions:     []v1beta1.ServiceInstanceCondition{ready},
+			LastPollResult: "succeeded",
+			LastPollTime:   &transitionTime,
+		}, "Ready - The instance was provisioned successfully @ 2018-01-02 03:04:05 +0000 UTC\n" +
+			"Last operation - succeeded @ 2018-01-02 03:04:05 +0000 UTC\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stringBuilder strings.Builder
+			WriteInstanceProgress(&stringBuilder, tt.previous, &v1beta1.ServiceInstance{Status: tt.status})
+			if actualOutput := stringBuilder.String(); actualOutput != tt.expectedOutput {
+				t.Fatalf("%v failed; expected %q; got %q", tt.name, tt.expectedOutput, actualOutput)
+			}
+		})
+	}
+}
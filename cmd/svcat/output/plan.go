@@ -149,6 +149,12 @@ func WritePlanDetails(w io.Writer, plan *v1beta1.ClusterServicePlan, class *v1be
 		{"Free:", strconv.FormatBool(plan.Spec.Free)},
 		{"Class:", class.Spec.ExternalName},
 	})
+	if plan.Spec.DocumentationURL != "" {
+		t.Append([]string{"Documentation:", plan.Spec.DocumentationURL})
+	}
+	if plan.Spec.SupportURL != "" {
+		t.Append([]string{"Support:", plan.Spec.SupportURL})
+	}
 
 	t.Render()
 }
@@ -0,0 +1,52 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+)
+
+// WritePreflightIssues prints the issues found by an upgrade preflight check.
+func WritePreflightIssues(w io.Writer, targetVersion string, issues []servicecatalog.PreflightIssue) {
+	if len(issues) == 0 {
+		fmt.Fprintf(w, "No issues found, ready to upgrade to %s\n", targetVersion)
+		return
+	}
+
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Severity",
+		"Kind",
+		"Namespace",
+		"Name",
+		"Message",
+	})
+	t.SetVariableColumn(5)
+	for _, issue := range issues {
+		t.Append([]string{
+			string(issue.Severity),
+			issue.Kind,
+			issue.Namespace,
+			issue.Name,
+			issue.Message,
+		})
+	}
+	t.Render()
+}
@@ -67,13 +67,23 @@ func writeParametersFrom(w io.Writer, parametersFrom []v1beta1.ParametersFromSou
 	}
 
 	headerPrinted := false
+	printHeader := func() {
+		if !headerPrinted {
+			fmt.Fprintln(w, "\nParameters From:")
+			headerPrinted = true
+		}
+	}
 	for _, p := range parametersFrom {
-		if p.SecretKeyRef != nil {
-			if !headerPrinted {
-				fmt.Fprintln(w, "\nParameters From:")
-				headerPrinted = true
-			}
+		switch {
+		case p.SecretKeyRef != nil:
+			printHeader()
 			fmt.Fprintf(w, "  Secret: %s.%s\n", p.SecretKeyRef.Name, p.SecretKeyRef.Key)
+		case p.ConfigMapKeyRef != nil:
+			printHeader()
+			fmt.Fprintf(w, "  ConfigMap: %s.%s\n", p.ConfigMapKeyRef.Name, p.ConfigMapKeyRef.Key)
+		case p.HTTPS != nil:
+			printHeader()
+			fmt.Fprintf(w, "  HTTPS: %s\n", p.HTTPS.URL)
 		}
 	}
 }
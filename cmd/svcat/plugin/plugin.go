@@ -78,7 +78,9 @@ func BindEnvironmentVariables(vip *viper.Viper, cmd *cobra.Command) {
 	vip.BindPFlags(cmd.Flags())
 	vip.AutomaticEnv()
 	cmd.Flags().VisitAll(func(f *pflag.Flag) {
-		if !f.Changed && vip.IsSet(f.Name) {
+		// Skip flags that are left at their default, setting an array
+		// flag to its default would append the default to it.
+		if !f.Changed && vip.IsSet(f.Name) && vip.GetString(f.Name) != f.DefValue {
 			cmd.Flags().Set(f.Name, vip.GetString(f.Name))
 		}
 	})
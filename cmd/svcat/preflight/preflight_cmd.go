@@ -0,0 +1,88 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+	"github.com/spf13/cobra"
+)
+
+type preflightCmd struct {
+	*command.Context
+	targetVersion string
+}
+
+// NewPreflightCmd builds a "svcat preflight" command
+func NewPreflightCmd(cxt *command.Context) *cobra.Command {
+	preflightCmd := &preflightCmd{Context: cxt}
+	cmd := &cobra.Command{
+		Use:   "preflight",
+		Short: "Checks the stored resources for problems that block an upgrade",
+		Long: `Checks the stored resources for problems that block an upgrade of Service Catalog
+to the target version: brokers whose auth secret is missing or incomplete,
+brokers on an unsupported Open Service Broker API version, instances that only
+have deprecated status fields set, and instances with parameters whose plan
+has no schema. The command fails if any blockers are found.`,
+		Example: command.NormalizeExamples(`
+  svcat preflight --target-version v0.2.0
+`),
+		PreRunE: command.PreRunE(preflightCmd),
+		RunE:    command.RunE(preflightCmd),
+	}
+	cmd.Flags().StringVar(
+		&preflightCmd.targetVersion,
+		"target-version",
+		"",
+		"The version of Service Catalog to upgrade to, for example v0.2.0",
+	)
+	return cmd
+}
+
+func (c *preflightCmd) Validate(args []string) error {
+	if c.targetVersion == "" {
+		return fmt.Errorf("--target-version is required")
+	}
+	return nil
+}
+
+func (c *preflightCmd) Run() error {
+	return c.preflight()
+}
+
+func (c *preflightCmd) preflight() error {
+	issues, err := c.App.Preflight(c.targetVersion)
+	if err != nil {
+		return err
+	}
+
+	output.WritePreflightIssues(c.Output, c.targetVersion, issues)
+
+	blockers := 0
+	for _, issue := range issues {
+		if issue.Severity == servicecatalog.PreflightBlocker {
+			blockers++
+		}
+	}
+	if blockers > 0 {
+		return fmt.Errorf("found %d blocker(s) for the upgrade to %s", blockers, c.targetVersion)
+	}
+	return nil
+}
@@ -152,6 +152,10 @@ func TestCommandValidation(t *testing.T) {
 		{"unbind requires arg", "unbind", "an instance or binding name is required"},
 		{"sync requires names", "sync broker", "a broker name is required"},
 		{"deprovision requires name", "deprovision", "an instance name is required"},
+		{"preflight requires target version", "preflight", "--target-version is required"},
+		{"upgrade instance requires name", "upgrade instance --to-plan premium", "an instance name is required"},
+		{"bulk instances requires a change", "bulk instances --selector app=shop", "at least one of --param, --params-json, --to-plan or --touch is required"},
+		{"bulk instances requires positive concurrency", "bulk instances --selector app=shop --touch --concurrency 0", "--concurrency must be at least 1"},
 		{"provision does not accept --param and --params-json",
 			`provision name --class class --plan plan --params-json '{}' --param k=v`,
 			"--params-json cannot be used with --param"},
@@ -237,6 +241,14 @@ func TestCommandOutput(t *testing.T) {
 		{name: "provision instance and wait", cmd: "provision ups-instance -n test-ns --class user-provided-service --plan default --wait", golden: "output/provision-instance-and-wait.txt"},
 		{name: "deprovision instance", cmd: "deprovision ups-instance -n test-ns", golden: "output/deprovision-instance.txt"},
 		{name: "deprovision instance and wait", cmd: "deprovision ups-instance -n test-ns --wait", golden: "output/deprovision-instance-and-wait.txt"},
+		{name: "describe ready instance and follow", cmd: "describe instance ups-instance -n test-ns --follow", golden: "output/describe-instance.txt"},
+		{name: "describe updating instance and follow", cmd: "describe instance updating-instance -n test-ns --follow", golden: "output/describe-instance-and-follow.txt"},
+		{name: "upgrade instance", cmd: "upgrade instance ups-instance -n test-ns --to-plan premium", golden: "output/upgrade-instance.txt"},
+		{name: "upgrade instance to unknown plan", cmd: "upgrade instance ups-instance -n test-ns --to-plan wrong", golden: "output/upgrade-instance-to-unknown-plan.txt", continueOnError: true},
+		{name: "bulk touch instances", cmd: "bulk instances -n test-ns --selector app=shop --touch", golden: "output/bulk-touch-instances.txt"},
+		{name: "bulk update instances", cmd: "bulk instances -n test-ns --selector app=shop --param tier=gold", golden: "output/bulk-update-instances.txt"},
+		{name: "bulk upgrade instances", cmd: "bulk instances -n test-ns --selector app=shop --to-plan premium", golden: "output/bulk-upgrade-instances.txt"},
+		{name: "bulk instances with no match", cmd: "bulk instances -n test-ns --selector app=none --touch", golden: "output/bulk-instances-no-match.txt"},
 
 		{name: "list all bindings in a namespace", cmd: "get bindings -n test-ns", golden: "output/get-bindings.txt"},
 		{name: "list all bindings in a namespace (json)", cmd: "get bindings -n test-ns -o json", golden: "output/get-bindings.json"},
@@ -428,6 +440,41 @@ func TestParametersForBinding(t *testing.T) {
 	}
 }
 
+// TestImpersonation ensures that the --as and --as-group flags are sent to the api server
+// as impersonation headers.
+func TestImpersonation(t *testing.T) {
+	var gotUser string
+	var gotGroups []string
+	apisvr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser = r.Header.Get("Impersonate-User")
+		gotGroups = r.Header["Impersonate-Group"]
+		apihandler(w, r)
+	}))
+	defer apisvr.Close()
+
+	kubeconfig, err := writeTestKubeconfig(apisvr.URL)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.Remove(kubeconfig)
+
+	svcat, _, err := buildCommand("get brokers --as alice --as-group dev --as-group ops", newContext(), kubeconfig)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	svcat.SetOutput(&bytes.Buffer{})
+	if err := svcat.Execute(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if gotUser != "alice" {
+		t.Fatalf("unexpected impersonated user\n\nWANT:\n%q\n\nGOT:\n%q\n", "alice", gotUser)
+	}
+	if wantGroups := []string{"dev", "ops"}; !reflect.DeepEqual(wantGroups, gotGroups) {
+		t.Fatalf("unexpected impersonated groups\n\nWANT:\n%q\n\nGOT:\n%q\n", wantGroups, gotGroups)
+	}
+}
+
 // TestPluginFlags ensures that flags are parsed the same in both standalone and plugin mode.
 func TestPluginFlags(t *testing.T) {
 	testcases := []struct {
@@ -103,10 +103,135 @@ type ControllerManagerConfiguration struct {
 	// backoff for polling OSB API operations will use.
 	OperationPollingMaximumBackoffDuration time.Duration
 
+	// StuckDeletionThreshold is how long an instance or binding may be
+	// terminating before the controller flags it as stuck. Zero disables
+	// the check.
+	StuckDeletionThreshold time.Duration
+
+	// NamespaceDeletionMaxFailedAttempts is the number of failed deprovision
+	// or unbind requests after which an instance or binding in a namespace
+	// that is being deleted is abandoned. Zero disables the policy.
+	NamespaceDeletionMaxFailedAttempts int64
+
+	// NamespaceDeletionBrokerUnreachableTimeout is how long the broker of an
+	// instance or binding in a namespace that is being deleted may be
+	// unreachable before the instance or binding is abandoned. Zero disables
+	// the policy.
+	NamespaceDeletionBrokerUnreachableTimeout time.Duration
+
+	// InstanceTombstoneTTL is how long the tombstone recorded for a
+	// deprovisioned instance is kept. Zero disables tombstones.
+	InstanceTombstoneTTL time.Duration
+
+	// DeprovisionGracePeriod is how long the controller waits after an
+	// instance is deleted before deprovisioning it at the broker. Zero
+	// deprovisions immediately.
+	DeprovisionGracePeriod time.Duration
+
+	// SharedCatalogTTL is how long the catalog fetched for a namespaced
+	// broker is reused by the other namespaced brokers with the same URL and
+	// credentials. Zero disables sharing.
+	SharedCatalogTTL time.Duration
+
+	// StorageMigration is whether the controller rewrites every stored
+	// catalog object after an upgrade, so that they are all stored in the
+	// storage version of the running release.
+	StorageMigration bool
+
+	// ParametersWebhookURL is the https URL of a webhook that is called with
+	// the parameters of each provision and update request before it is sent
+	// to the broker. Empty disables the webhook.
+	ParametersWebhookURL string
+
+	// ParametersWebhookCAFile is the path to a PEM encoded CA bundle used to
+	// verify the certificate of the parameters webhook.
+	ParametersWebhookCAFile string
+
+	// ParametersWebhookTimeout is how long a call to the parameters webhook
+	// may take.
+	ParametersWebhookTimeout time.Duration
+
+	// ParametersWebhookFailurePolicy is either Fail, to fail the request to
+	// the broker, or Ignore, to send the unmodified parameters, when the
+	// parameters webhook cannot be called or returns an error.
+	ParametersWebhookFailurePolicy string
+
+	// CatalogWebhookURL is the https URL of a webhook that is called with
+	// the catalog of each broker before it is synced. Empty disables the
+	// webhook.
+	CatalogWebhookURL string
+
+	// CatalogWebhookCAFile is the path to a PEM encoded CA bundle used to
+	// verify the certificate of the catalog webhook.
+	CatalogWebhookCAFile string
+
+	// CatalogWebhookTimeout is how long a call to the catalog webhook may
+	// take.
+	CatalogWebhookTimeout time.Duration
+
+	// CatalogWebhookFailurePolicy is either Fail, to fail the catalog sync,
+	// or Ignore, to sync the unmodified catalog, when the catalog webhook
+	// cannot be called or returns an error.
+	CatalogWebhookFailurePolicy string
+
+	// MaxConcurrentDeprovisionsPerBroker is the number of instances that may
+	// be deprovisioned at the same time at each broker. Zero disables the
+	// limit.
+	MaxConcurrentDeprovisionsPerBroker int
+
+	// DeprovisionBatchInterval is how long an instance waits to be
+	// deprovisioned when its broker is at the deprovision limit.
+	DeprovisionBatchInterval time.Duration
+
+	// EnableFailureWebhooks enables notifying the webhooks that namespaces
+	// configure of the terminal failures of their instances and bindings.
+	EnableFailureWebhooks bool
+
+	// HTTPSParameterSourceHosts are the host names, or host:port pairs, of
+	// the URLs that the parametersFrom of instances and bindings may fetch
+	// parameters from over https. Empty disables fetching parameters over
+	// https.
+	HTTPSParameterSourceHosts []string
+
+	// SecretPropagatedLabels are the keys of the labels of instances and
+	// bindings that are copied onto the secrets of bindings.
+	SecretPropagatedLabels []string
+
+	// ReadOnly stops the controller from making requests to brokers that
+	// change instances or bindings, while it keeps polling the operations
+	// in progress and updating the status of resources.
+	ReadOnly bool
+
+	// NamespaceProvisionRateLimit is the number of instances that may start
+	// to be provisioned in each namespace within
+	// NamespaceProvisionRateWindow. Zero disables the limit.
+	NamespaceProvisionRateLimit int
+
+	// NamespaceProvisionRateWindow is the time window of
+	// NamespaceProvisionRateLimit.
+	NamespaceProvisionRateWindow time.Duration
+
+	// BindingSecretRotationPolicy specifies how new credentials of a binding
+	// are handled when running pods consume the secret they would replace:
+	// Proceed, WaitForDrain or RequireAnnotation.
+	BindingSecretRotationPolicy string
+
+	// EnableBrokerDashboard enables the read-only endpoint that summarizes
+	// brokers, their health and their instances in JSON.
+	EnableBrokerDashboard bool
+
+	// EnableBrokerRelistCallback enables the endpoint with which brokers
+	// request an immediate relist of their catalog, authenticated with a
+	// token read from the auth secret of each broker.
+	EnableBrokerRelistCallback bool
+
 	SecureServingOptions *genericoptions.SecureServingOptions
 
 	// ClusterIDConfigMapName is the k8s name that the clusterid configmap will have
 	ClusterIDConfigMapName string
 	// ClusterIDConfigMapNamespace is the k8s namespace that the clusterid configmap will be stored in.
 	ClusterIDConfigMapNamespace string
+	// ClusterID is the cluster ID sent to brokers. If set, it replaces the
+	// one of the clusterid configmap; otherwise the configmap's is used.
+	ClusterID string
 }
@@ -0,0 +1,29 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// SetDefaults_ControllerManagerConfiguration sets the apiVersion and kind of a
+// configuration file that omits them. The other fields are not defaulted
+// here: a field that is not set keeps the default of its flag.
+func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfiguration) {
+	if obj.APIVersion == "" {
+		obj.APIVersion = SchemeGroupVersion.String()
+	}
+	if obj.Kind == "" {
+		obj.Kind = ControllerManagerConfigurationKind
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GroupName is the group name of the configuration file.
+const GroupName = "componentconfig.servicecatalog.k8s.io"
+
+// SchemeGroupVersion is the group version of the configuration file.
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+// ControllerManagerConfigurationKind is the kind of the configuration file.
+const ControllerManagerConfigurationKind = "ControllerManagerConfiguration"
+
+// ControllerManagerConfiguration is the configuration file of the controller
+// manager. Every field is optional; a field that is not set keeps the value
+// of its command line flag, which is its default unless the flag is given.
+// A flag that is given on the command line overrides the file.
+type ControllerManagerConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Timeouts configures how often and for how long the controller
+	// reconciles resources.
+	// +optional
+	Timeouts TimeoutConfiguration `json:"timeouts,omitempty"`
+
+	// Concurrency configures how much work the controller does at the same
+	// time.
+	// +optional
+	Concurrency ConcurrencyConfiguration `json:"concurrency,omitempty"`
+
+	// OSBClient configures the requests the controller sends to brokers.
+	// +optional
+	OSBClient OSBClientConfiguration `json:"osbClient,omitempty"`
+
+	// FeatureGates enables or disables alpha and beta features, as the
+	// --feature-gates flag does.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
+
+// TimeoutConfiguration configures how often and for how long the controller
+// reconciles resources. Each field corresponds to the flag named in its
+// comment.
+type TimeoutConfiguration struct {
+	// ResyncInterval is --resync-interval.
+	// +optional
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+	// BrokerRelistInterval is --broker-relist-interval.
+	// +optional
+	BrokerRelistInterval *metav1.Duration `json:"brokerRelistInterval,omitempty"`
+	// ReconciliationRetryDuration is --reconciliation-retry-duration.
+	// +optional
+	ReconciliationRetryDuration *metav1.Duration `json:"reconciliationRetryDuration,omitempty"`
+	// OperationPollingMaximumBackoffDuration is
+	// --operation-polling-maximum-backoff-duration.
+	// +optional
+	OperationPollingMaximumBackoffDuration *metav1.Duration `json:"operationPollingMaximumBackoffDuration,omitempty"`
+	// StuckDeletionThreshold is --stuck-deletion-threshold.
+	// +optional
+	StuckDeletionThreshold *metav1.Duration `json:"stuckDeletionThreshold,omitempty"`
+	// NamespaceDeletionBrokerUnreachableTimeout is
+	// --namespace-deletion-broker-unreachable-timeout.
+	// +optional
+	NamespaceDeletionBrokerUnreachableTimeout *metav1.Duration `json:"namespaceDeletionBrokerUnreachableTimeout,omitempty"`
+	// InstanceTombstoneTTL is --instance-tombstone-ttl.
+	// +optional
+	InstanceTombstoneTTL *metav1.Duration `json:"instanceTombstoneTTL,omitempty"`
+	// DeprovisionGracePeriod is --deprovision-grace-period.
+	// +optional
+	DeprovisionGracePeriod *metav1.Duration `json:"deprovisionGracePeriod,omitempty"`
+	// SharedCatalogTTL is --shared-catalog-ttl.
+	// +optional
+	SharedCatalogTTL *metav1.Duration `json:"sharedCatalogTTL,omitempty"`
+}
+
+// ConcurrencyConfiguration configures how much work the controller does at
+// the same time. Each field corresponds to the flag named in its comment.
+type ConcurrencyConfiguration struct {
+	// ConcurrentSyncs is --concurrent-syncs.
+	// +optional
+	ConcurrentSyncs *int32 `json:"concurrentSyncs,omitempty"`
+	// MaxConcurrentDeprovisionsPerBroker is
+	// --max-concurrent-deprovisions-per-broker.
+	// +optional
+	MaxConcurrentDeprovisionsPerBroker *int32 `json:"maxConcurrentDeprovisionsPerBroker,omitempty"`
+	// DeprovisionBatchInterval is --deprovision-batch-interval.
+	// +optional
+	DeprovisionBatchInterval *metav1.Duration `json:"deprovisionBatchInterval,omitempty"`
+	// NamespaceDeletionMaxFailedAttempts is
+	// --namespace-deletion-max-failed-attempts.
+	// +optional
+	NamespaceDeletionMaxFailedAttempts *int64 `json:"namespaceDeletionMaxFailedAttempts,omitempty"`
+}
+
+// OSBClientConfiguration configures the requests the controller sends to
+// brokers. Each field corresponds to the flag named in its comment.
+type OSBClientConfiguration struct {
+	// PreferredVersion is --osb-api-preferred-version.
+	// +optional
+	PreferredVersion string `json:"preferredVersion,omitempty"`
+	// ParametersWebhook configures the webhook that rewrites the parameters
+	// of provision and update requests.
+	// +optional
+	ParametersWebhook WebhookConfiguration `json:"parametersWebhook,omitempty"`
+	// CatalogWebhook configures the webhook that rewrites the catalogs of
+	// brokers before they are synced.
+	// +optional
+	CatalogWebhook WebhookConfiguration `json:"catalogWebhook,omitempty"`
+}
+
+// WebhookConfiguration configures a webhook. Each field corresponds to the
+// flag named in its comment, where <webhook> is parameters or catalog.
+type WebhookConfiguration struct {
+	// URL is --<webhook>-webhook-url.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// CAFile is --<webhook>-webhook-ca-file.
+	// +optional
+	CAFile string `json:"caFile,omitempty"`
+	// Timeout is --<webhook>-webhook-timeout.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// FailurePolicy is --<webhook>-webhook-failure-policy.
+	// +optional
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+)
+
+var validOSBAPIVersions = []string{
+	osb.Version2_11().HeaderValue(),
+	osb.Version2_12().HeaderValue(),
+	osb.Version2_13().HeaderValue(),
+}
+
+var validWebhookFailurePolicies = []string{"Fail", "Ignore"}
+
+// ValidateControllerManagerConfiguration validates a configuration file of
+// the controller manager.
+func ValidateControllerManagerConfiguration(config *ControllerManagerConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if e, a := SchemeGroupVersion.String(), config.APIVersion; e != a {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("apiVersion"), a, []string{e}))
+	}
+	if e, a := ControllerManagerConfigurationKind, config.Kind; e != a {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("kind"), a, []string{e}))
+	}
+
+	timeoutsPath := field.NewPath("timeouts")
+	allErrs = append(allErrs, validatePositiveDuration(config.Timeouts.ResyncInterval, timeoutsPath.Child("resyncInterval"))...)
+	allErrs = append(allErrs, validatePositiveDuration(config.Timeouts.BrokerRelistInterval, timeoutsPath.Child("brokerRelistInterval"))...)
+	allErrs = append(allErrs, validatePositiveDuration(config.Timeouts.ReconciliationRetryDuration, timeoutsPath.Child("reconciliationRetryDuration"))...)
+	allErrs = append(allErrs, validatePositiveDuration(config.Timeouts.OperationPollingMaximumBackoffDuration, timeoutsPath.Child("operationPollingMaximumBackoffDuration"))...)
+	allErrs = append(allErrs, validateNonNegativeDuration(config.Timeouts.StuckDeletionThreshold, timeoutsPath.Child("stuckDeletionThreshold"))...)
+	allErrs = append(allErrs, validateNonNegativeDuration(config.Timeouts.NamespaceDeletionBrokerUnreachableTimeout, timeoutsPath.Child("namespaceDeletionBrokerUnreachableTimeout"))...)
+	allErrs = append(allErrs, validateNonNegativeDuration(config.Timeouts.InstanceTombstoneTTL, timeoutsPath.Child("instanceTombstoneTTL"))...)
+	allErrs = append(allErrs, validateNonNegativeDuration(config.Timeouts.DeprovisionGracePeriod, timeoutsPath.Child("deprovisionGracePeriod"))...)
+	allErrs = append(allErrs, validateNonNegativeDuration(config.Timeouts.SharedCatalogTTL, timeoutsPath.Child("sharedCatalogTTL"))...)
+
+	concurrencyPath := field.NewPath("concurrency")
+	if v := config.Concurrency.ConcurrentSyncs; v != nil && *v <= 0 {
+		allErrs = append(allErrs, field.Invalid(concurrencyPath.Child("concurrentSyncs"), *v, "must be greater than zero"))
+	}
+	if v := config.Concurrency.MaxConcurrentDeprovisionsPerBroker; v != nil && *v < 0 {
+		allErrs = append(allErrs, field.Invalid(concurrencyPath.Child("maxConcurrentDeprovisionsPerBroker"), *v, "must not be negative"))
+	}
+	allErrs = append(allErrs, validatePositiveDuration(config.Concurrency.DeprovisionBatchInterval, concurrencyPath.Child("deprovisionBatchInterval"))...)
+	if v := config.Concurrency.NamespaceDeletionMaxFailedAttempts; v != nil && *v < 0 {
+		allErrs = append(allErrs, field.Invalid(concurrencyPath.Child("namespaceDeletionMaxFailedAttempts"), *v, "must not be negative"))
+	}
+
+	osbClientPath := field.NewPath("osbClient")
+	if v := config.OSBClient.PreferredVersion; v != "" && !contains(validOSBAPIVersions, v) {
+		allErrs = append(allErrs, field.NotSupported(osbClientPath.Child("preferredVersion"), v, validOSBAPIVersions))
+	}
+	allErrs = append(allErrs, validateWebhook(&config.OSBClient.ParametersWebhook, osbClientPath.Child("parametersWebhook"))...)
+	allErrs = append(allErrs, validateWebhook(&config.OSBClient.CatalogWebhook, osbClientPath.Child("catalogWebhook"))...)
+
+	return allErrs
+}
+
+func validateWebhook(webhook *WebhookConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := validatePositiveDuration(webhook.Timeout, fldPath.Child("timeout"))
+	if v := webhook.FailurePolicy; v != "" && !contains(validWebhookFailurePolicies, v) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("failurePolicy"), v, validWebhookFailurePolicies))
+	}
+	return allErrs
+}
+
+func validatePositiveDuration(d *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	if d != nil && d.Duration <= 0 {
+		return field.ErrorList{field.Invalid(fldPath, d.Duration.String(), "must be greater than zero")}
+	}
+	return nil
+}
+
+func validateNonNegativeDuration(d *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	if d != nil && d.Duration < 0 {
+		return field.ErrorList{field.Invalid(fldPath, d.Duration.String(), "must not be negative")}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
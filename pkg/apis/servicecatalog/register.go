@@ -52,6 +52,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterServiceBrokerList{},
 		&ServiceBroker{},
 		&ServiceBrokerList{},
+		&BrokerTemplate{},
+		&BrokerTemplateList{},
+		&CatalogProjection{},
+		&CatalogProjectionList{},
+		&ServiceBrokerSummary{},
+		&ServiceBrokerSummaryList{},
 		&ClusterServiceClass{},
 		&ClusterServiceClassList{},
 		&ServiceClass{},
@@ -64,6 +70,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ServiceInstanceList{},
 		&ServiceBinding{},
 		&ServiceBindingList{},
+		&AdminUnbindRequest{},
+		&ForceDeleteRequest{},
+		&RollbackRequest{},
+		&RelistRequest{},
+		&BrokerCatalog{},
+		&ServiceInstanceOperations{},
 	)
 	return nil
 }
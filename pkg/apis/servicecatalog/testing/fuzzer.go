@@ -90,6 +90,19 @@ func createPlanMetadata(c fuzz.Continue) (*runtime.RawExtension, error) {
 	return &runtime.RawExtension{Raw: b}, nil
 }
 
+func createCatalog(c fuzz.Continue) (*runtime.RawExtension, error) {
+	services := []map[string]string{}
+	for i := 0; i < c.Rand.Intn(10); i++ {
+		services = append(services, map[string]string{"id": c.RandString(), "name": c.RandString()})
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"services": services})
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: b}, nil
+}
+
 // servicecatalogFuncs defines fuzzer funcs for Service Catalog types
 func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 	return []interface{}{
@@ -111,6 +124,13 @@ func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 				panic(fmt.Sprintf("Failed to create parameter object: %v", err))
 			}
 			is.Parameters = parameters
+			if is.AutoBind != nil {
+				parameters, err := createParameter(c)
+				if err != nil {
+					panic(fmt.Sprintf("Failed to create parameter object: %v", err))
+				}
+				is.AutoBind.Parameters = parameters
+			}
 		},
 		func(bs *servicecatalog.ServiceBindingSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(bs)
@@ -135,6 +155,11 @@ func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 				panic(fmt.Sprintf("Failed to create parameter object: %v", err))
 			}
 			bs.Parameters = parameters
+			context, err := createParameter(c)
+			if err != nil {
+				panic(fmt.Sprintf("Failed to create context object: %v", err))
+			}
+			bs.Context = context
 		},
 		func(bs *servicecatalog.ServiceBindingPropertiesState, c fuzz.Continue) {
 			c.FuzzNoCustom(bs)
@@ -144,6 +169,14 @@ func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 			}
 			bs.Parameters = parameters
 		},
+		func(bs *servicecatalog.ServiceBindingStatus, c fuzz.Continue) {
+			c.FuzzNoCustom(bs)
+			parameters, err := createParameter(c)
+			if err != nil {
+				panic(fmt.Sprintf("Failed to create parameter object: %v", err))
+			}
+			bs.AcceptedParameters = parameters
+		},
 		func(sc *servicecatalog.ClusterServiceClass, c fuzz.Continue) {
 			c.FuzzNoCustom(sc)
 			metadata, err := createServiceMetadata(c)
@@ -184,6 +217,14 @@ func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 			sp.Spec.ServiceInstanceCreateParameterSchema = metadata
 			sp.Spec.ServiceInstanceUpdateParameterSchema = metadata
 		},
+		func(bc *servicecatalog.BrokerCatalog, c fuzz.Continue) {
+			c.FuzzNoCustom(bc)
+			catalog, err := createCatalog(c)
+			if err != nil {
+				panic(fmt.Sprintf("Failed to create catalog object: %v", err))
+			}
+			bc.Catalog = catalog
+		},
 	}
 }
 
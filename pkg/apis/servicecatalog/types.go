@@ -100,11 +100,90 @@ type CommonServiceBrokerSpec struct {
 
 	// RelistRequests is a strictly increasing, non-negative integer counter that
 	// can be manually incremented by a user to manually trigger a relist.
+	//
+	// Deprecated: set the servicecatalog.k8s.io/relist-requested-at
+	// annotation to the current time instead, which does not conflict with
+	// other changes to the spec. The value a relist honored is recorded in
+	// status.observedRelistRequestedAt.
 	RelistRequests int64
 
 	// CatalogRestrictions is a set of restrictions on which of a broker's services
 	// and plans have resources created for them.
 	CatalogRestrictions *CatalogRestrictions
+
+	// CatalogRemovalPolicy specifies what the controller does with the
+	// ServiceInstances of a class or plan that has been removed from the
+	// broker's catalog. Defaults to CatalogRemovalPolicyKeep.
+	CatalogRemovalPolicy CatalogRemovalPolicy
+
+	// CatalogRemovalDeprovisionDelay is how long the controller waits after
+	// a class or plan was removed from the broker's catalog before
+	// deprovisioning its ServiceInstances when the CatalogRemovalPolicy is
+	// set to CatalogRemovalPolicyScheduledDeprovision.
+	CatalogRemovalDeprovisionDelay *metav1.Duration
+
+	// CatalogRemovalGracePeriod is how long the controller keeps the classes
+	// and plans that were removed from the broker's catalog, or that belong
+	// to a broker being deleted, before deleting them. During the grace
+	// period they are marked as pending removal. If unset, they are deleted
+	// as soon as they are no longer in use.
+	CatalogRemovalGracePeriod *metav1.Duration
+
+	// MirrorURL is the address of a second broker to which a copy of each
+	// provision request sent to this broker is also sent, for example to
+	// test a replacement broker with real traffic before switching over to
+	// it. The copies are sent in the background with the same credentials
+	// and TLS settings as the requests to this broker, and the responses of
+	// the mirror are only logged: they never affect the instances.
+	MirrorURL string
+
+	// FailoverURLs are the addresses of standby endpoints of the same
+	// broker, such as its deployments in other regions, which serve the same
+	// catalog and instances as URL. When an endpoint cannot be reached or
+	// answers that it is unavailable, requests are sent to the next one, in
+	// the order URL, then FailoverURLs, and the endpoint is skipped for a
+	// while.
+	FailoverURLs []string
+
+	// ReadOnly marks the broker as deprecated, for example while it is being
+	// decommissioned. New ServiceInstances of its classes are rejected,
+	// while the existing ones keep working and can be unbound and
+	// deprovisioned as usual.
+	ReadOnly bool
+
+	// CustomHeaders are HTTP headers sent on every request to the broker,
+	// for example the tenant or routing headers required by some brokers.
+	// The keys are the names of the headers, which must not be the name of
+	// a header set by the controller itself, such as Authorization or
+	// X-Broker-API-Version.
+	// +optional
+	CustomHeaders map[string]BrokerHeaderValue
+}
+
+// BrokerHeaderValue is the value of a custom header sent to a broker. Exactly
+// one of Value and SecretKeyRef must be set.
+type BrokerHeaderValue struct {
+	// Value is the value of the header.
+	// +optional
+	Value string
+
+	// SecretKeyRef references the key of a Secret that holds the value of
+	// the header, for values that must be kept secret.
+	// +optional
+	SecretKeyRef *BrokerSecretKeyReference
+}
+
+// BrokerSecretKeyReference references a key of a Secret used to communicate
+// with a broker.
+type BrokerSecretKeyReference struct {
+	// Namespace of the Secret. It is required for a ClusterServiceBroker,
+	// and defaults to the namespace of a ServiceBroker.
+	// +optional
+	Namespace string
+	// Name of the Secret.
+	Name string
+	// Key of the Secret to select from.
+	Key string
 }
 
 // CatalogRestrictions is a set of restrictions on which of a broker's services
@@ -158,6 +237,15 @@ type CatalogRestrictions struct {
 	ServicePlan []string
 	// ServicePlan represents a selector for classes, used to filter catalog re-lists.
 	ServiceClass []string
+	// ServiceClassExternalIDs is an allow-list of the external IDs of the
+	// services of the broker's catalog to create classes for. External IDs
+	// are stable across renames of the services by the broker. An empty list
+	// allows every service.
+	ServiceClassExternalIDs []string
+	// ServicePlanExternalIDs is an allow-list of the external IDs of the
+	// plans of the broker's catalog to create plans for. An empty list allows
+	// every plan.
+	ServicePlanExternalIDs []string
 }
 
 // ClusterServiceBrokerSpec represents a description of a Broker.
@@ -176,6 +264,17 @@ type ServiceBrokerSpec struct {
 	// AuthInfo contains the data that the service catalog should use to authenticate
 	// with the Service Broker.
 	AuthInfo *ServiceBrokerAuthInfo
+
+	// TargetNamespaces are other namespaces into which the ServiceClasses
+	// and ServicePlans of the ServiceBroker are published, so that one
+	// namespaced broker can serve several tenant namespaces. Instances in a
+	// target namespace are provisioned through the ServiceBroker in its own
+	// namespace, with its credentials. Only the target namespaces that list
+	// the namespace of the ServiceBroker in their
+	// servicecatalog.k8s.io/accept-catalogs-from annotation receive the
+	// catalog. Requires the CrossNamespaceCatalogSharing feature.
+	// +optional
+	TargetNamespaces []string
 }
 
 // ServiceBrokerRelistBehavior represents a type of broker relist behavior.
@@ -191,6 +290,30 @@ const (
 	ServiceBrokerRelistBehaviorManual ServiceBrokerRelistBehavior = "Manual"
 )
 
+// CatalogRemovalPolicy represents what is done with the ServiceInstances of a
+// class or plan that has been removed from a broker's catalog.
+type CatalogRemovalPolicy string
+
+const (
+	// CatalogRemovalPolicyKeep indicates that the ServiceInstances are left
+	// untouched.
+	CatalogRemovalPolicyKeep CatalogRemovalPolicy = "Keep"
+
+	// CatalogRemovalPolicyWarn indicates that the ServiceInstances are marked
+	// with a RemovedFromCatalog condition.
+	CatalogRemovalPolicyWarn CatalogRemovalPolicy = "Warn"
+
+	// CatalogRemovalPolicyBlockNewBindings indicates that the ServiceInstances
+	// are marked with a RemovedFromCatalog condition and that no new
+	// ServiceBindings are created for them.
+	CatalogRemovalPolicyBlockNewBindings CatalogRemovalPolicy = "BlockNewBindings"
+
+	// CatalogRemovalPolicyScheduledDeprovision indicates that the
+	// ServiceInstances are marked with a RemovedFromCatalog condition and
+	// deleted once the CatalogRemovalDeprovisionDelay has passed.
+	CatalogRemovalPolicyScheduledDeprovision CatalogRemovalPolicy = "ScheduledDeprovision"
+)
+
 // ClusterServiceBrokerAuthInfo is a union type that contains information on
 // one of the authentication methods the the service catalog and brokers may
 // support, according to the OpenServiceBroker API specification
@@ -250,6 +373,12 @@ type BasicAuthConfig struct {
 	// - Secret.Data["username"] - username used for authentication
 	// - Secret.Data["password"] - password or token needed for authentication
 	SecretRef *LocalObjectReference
+
+	// SecretNamespace is the namespace of the Secret referenced by
+	// SecretRef. Defaults to the namespace of the ServiceBroker. A Secret
+	// in another namespace may only be referenced by users that are
+	// allowed to get it.
+	SecretNamespace string
 }
 
 // BearerTokenAuthConfig provides config for the bearer token
@@ -261,6 +390,12 @@ type BearerTokenAuthConfig struct {
 	// Required field:
 	// - Secret.Data["token"] - bearer token for authentication
 	SecretRef *LocalObjectReference
+
+	// SecretNamespace is the namespace of the Secret referenced by
+	// SecretRef. Defaults to the namespace of the ServiceBroker. A Secret
+	// in another namespace may only be referenced by users that are
+	// allowed to get it.
+	SecretNamespace string
 }
 
 const (
@@ -288,6 +423,41 @@ type CommonServiceBrokerStatus struct {
 	// LastCatalogRetrievalTime is the time the Catalog was last fetched from
 	// the Service Broker
 	LastCatalogRetrievalTime *metav1.Time
+
+	// ClassCount is the number of service classes that resulted from the
+	// last successful relist of the broker's catalog.
+	ClassCount int32
+
+	// PlanCount is the number of service plans that resulted from the last
+	// successful relist of the broker's catalog.
+	PlanCount int32
+
+	// LastCatalogChecksum is the checksum of the catalog payload returned by
+	// the broker on the last successful relist.
+	LastCatalogChecksum string
+
+	// OSBAPIVersion is the version of the Open Service Broker API that was
+	// negotiated with the broker on the last successful relist.
+	OSBAPIVersion string
+
+	// LastRelistDuration is the time it took to fetch and reconcile the
+	// broker's catalog on the last successful relist.
+	LastRelistDuration *metav1.Duration
+
+	// ObservedRelistRequests is the value of RelistRequests that the last
+	// successful relist honored.
+	ObservedRelistRequests int64
+
+	// ObservedRelistRequestedAt is the value of the
+	// servicecatalog.k8s.io/relist-requested-at annotation that the last
+	// successful relist honored.
+	ObservedRelistRequestedAt string
+
+	// AsyncBindingOperationsRequired is set once the broker has rejected a
+	// binding request without accepts_incomplete=true with the AsyncRequired
+	// error. The controller then sends accepts_incomplete=true with every
+	// binding request to the broker.
+	AsyncBindingOperationsRequired bool
 }
 
 // ClusterServiceBrokerStatus represents the current status of a
@@ -335,6 +505,156 @@ const (
 	ServiceBrokerConditionFailed ServiceBrokerConditionType = "Failed"
 )
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BrokerTemplate creates a ServiceBroker in every namespace that matches its
+// namespace selector, for platforms that give each tenant its own broker
+// endpoint.
+type BrokerTemplate struct {
+	metav1.TypeMeta
+
+	// Non-namespaced.  The name of this resource in etcd is in ObjectMeta.Name.
+	metav1.ObjectMeta
+
+	// Spec defines the ServiceBrokers created from the template.
+	Spec BrokerTemplateSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BrokerTemplateList is a list of BrokerTemplates.
+type BrokerTemplateList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []BrokerTemplate
+}
+
+// BrokerTemplateSpec represents a description of a BrokerTemplate.
+type BrokerTemplateSpec struct {
+	// NamespaceSelector selects the namespaces in which a ServiceBroker is
+	// created. An empty selector matches every namespace.
+	NamespaceSelector *metav1.LabelSelector
+
+	// BrokerName is the name of the ServiceBrokers created from the
+	// template. Defaults to the name of the template.
+	BrokerName string
+
+	// Template is the spec of the ServiceBrokers created from the template.
+	// Every occurrence of $(namespace) in the URL and in the name and
+	// namespace of the auth secret is replaced with the name of the
+	// namespace the ServiceBroker is created in.
+	Template ServiceBrokerSpec
+}
+
+// BrokerTemplateNamespaceVariable is replaced with the name of the namespace
+// of a ServiceBroker created from a BrokerTemplate.
+const BrokerTemplateNamespaceVariable = "$(namespace)"
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CatalogProjection copies the ClusterServiceClasses and ClusterServicePlans
+// it selects into every namespace that matches its namespace selector, as
+// ServiceClasses and ServicePlans, for tools that only read namespaced
+// resources.
+type CatalogProjection struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Spec defines the classes and plans that are projected, and where.
+	Spec CatalogProjectionSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CatalogProjectionList is a list of CatalogProjections.
+type CatalogProjectionList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []CatalogProjection
+}
+
+// CatalogProjectionSpec represents a description of a CatalogProjection.
+type CatalogProjectionSpec struct {
+	// NamespaceSelector selects the namespaces the classes and plans are
+	// projected into. An empty selector matches every namespace.
+	NamespaceSelector *metav1.LabelSelector
+
+	// CatalogRestrictions selects the ClusterServiceClasses and
+	// ClusterServicePlans that are projected, in the same way as the catalog
+	// restrictions of a ClusterServiceBroker. Every class and plan is
+	// projected if it is empty.
+	CatalogRestrictions *CatalogRestrictions
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceBrokerSummary summarizes the ServiceBrokers in a namespace, for
+// dashboards that should not have to list every ServiceBroker. It is
+// maintained by the controller and named "brokers".
+type ServiceBrokerSummary struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// TotalBrokers is the number of ServiceBrokers in the namespace.
+	TotalBrokers int32
+
+	// ReadyBrokers is the number of ServiceBrokers in the namespace whose
+	// catalog was last fetched successfully.
+	ReadyBrokers int32
+
+	// Brokers summarizes each ServiceBroker in the namespace.
+	Brokers []ServiceBrokerSummaryEntry
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceBrokerSummaryList is a list of ServiceBrokerSummaries.
+type ServiceBrokerSummaryList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []ServiceBrokerSummary
+}
+
+// ServiceBrokerSummaryEntry summarizes a ServiceBroker.
+type ServiceBrokerSummaryEntry struct {
+	// Name is the name of the ServiceBroker.
+	Name string
+
+	// Ready is the status of the Ready condition of the ServiceBroker, which
+	// is true when its catalog was last fetched successfully.
+	Ready ConditionStatus
+
+	// Reason is the reason of the Ready condition of the ServiceBroker.
+	Reason string
+
+	// ServiceClasses is the number of ServiceClasses offered by the
+	// ServiceBroker.
+	ServiceClasses int32
+
+	// ServicePlans is the number of ServicePlans offered by the
+	// ServiceBroker.
+	ServicePlans int32
+
+	// LastCatalogRetrievalTime is when the catalog of the ServiceBroker was
+	// last fetched successfully.
+	LastCatalogRetrievalTime *metav1.Time
+}
+
+// ServiceBrokerSummaryName is the name of the ServiceBrokerSummary in each
+// namespace.
+const ServiceBrokerSummaryName = "brokers"
+
 // ConditionStatus represents a condition's status.
 type ConditionStatus string
 
@@ -417,6 +737,15 @@ type CommonServiceClassStatus struct {
 	// RemovedFromBrokerCatalog indicates that the broker removed the service from its
 	// catalog.
 	RemovedFromBrokerCatalog bool
+
+	// PendingRemoval indicates that the class will be deleted once the
+	// RemovalTime has passed, because it was removed from the broker's
+	// catalog or its broker is being deleted.
+	PendingRemoval bool
+
+	// RemovalTime is when the class will be deleted, if it is pending
+	// removal.
+	RemovalTime *metav1.Time
 }
 
 // CommonServiceClassSpec represents details about a ServiceClass
@@ -445,6 +774,11 @@ type CommonServiceClassSpec struct {
 	// its endpoint is supported for all plans.
 	BindingRetrievable bool
 
+	// InstancesRetrievable indicates whether fetching an instance via a GET
+	// on its endpoint is supported, which is required to adopt an existing
+	// instance of the class.
+	InstancesRetrievable bool
+
 	// PlanUpdatable indicates whether instances provisioned from this
 	// ServiceClass may change ServicePlans after being provisioned.
 	PlanUpdatable bool
@@ -454,6 +788,14 @@ type CommonServiceClassSpec struct {
 	// contain platform-specific conventional values.
 	ExternalMetadata *runtime.RawExtension
 
+	// DocumentationURL is the link to the documentation of the service, as
+	// given by the documentationUrl value of its external metadata.
+	DocumentationURL string
+
+	// SupportURL is the link to the support of the service, as given by the
+	// supportUrl value of its external metadata.
+	SupportURL string
+
 	// Currently, this field is ALPHA: it may change or disappear at any time
 	// and its data will not be migrated.
 	//
@@ -493,6 +835,24 @@ type ServiceClassSpec struct {
 	//
 	// Immutable.
 	ServiceBrokerName string
+
+	// ServiceBrokerNamespace is the namespace of the ServiceBroker that
+	// provides this ServiceClass when it is not the namespace of the
+	// ServiceClass, which is the case of the classes that a broker publishes
+	// into its TargetNamespaces.
+	//
+	// Immutable.
+	// +optional
+	ServiceBrokerNamespace string
+
+	// ClusterServiceBroker is set when ServiceBrokerName is the name of the
+	// ClusterServiceBroker that provides this ServiceClass, which is the case
+	// of the classes that a CatalogProjection copies from
+	// ClusterServiceClasses.
+	//
+	// Immutable.
+	// +optional
+	ClusterServiceBroker bool
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -545,6 +905,14 @@ type CommonServicePlanSpec struct {
 	// platform-specific conventional values.
 	ExternalMetadata *runtime.RawExtension
 
+	// DocumentationURL is the link to the documentation of the plan, as
+	// given by the documentationUrl value of its external metadata.
+	DocumentationURL string
+
+	// SupportURL is the link to the support of the plan, as given by the
+	// supportUrl value of its external metadata.
+	SupportURL string
+
 	// Currently, this field is ALPHA: it may change or disappear at any time
 	// and its data will not be migrated.
 	//
@@ -603,6 +971,15 @@ type CommonServicePlanStatus struct {
 	// RemovedFromBrokerCatalog indicates that the broker removed the plan
 	// from its catalog.
 	RemovedFromBrokerCatalog bool
+
+	// PendingRemoval indicates that the plan will be deleted once the
+	// RemovalTime has passed, because it was removed from the broker's
+	// catalog or its broker is being deleted.
+	PendingRemoval bool
+
+	// RemovalTime is when the plan will be deleted, if it is pending
+	// removal.
+	RemovalTime *metav1.Time
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -638,6 +1015,23 @@ type ServicePlanSpec struct {
 	// ServiceClassRef is a reference to the service class that
 	// owns this plan.
 	ServiceClassRef LocalObjectReference
+
+	// ServiceBrokerNamespace is the namespace of the ServiceBroker that
+	// offers this ServicePlan when it is not the namespace of the
+	// ServicePlan, which is the case of the plans that a broker publishes
+	// into its TargetNamespaces.
+	//
+	// Immutable.
+	// +optional
+	ServiceBrokerNamespace string
+
+	// ClusterServiceBroker is set when ServiceBrokerName is the name of the
+	// ClusterServiceBroker that offers this ServicePlan, which is the case of
+	// the plans that a CatalogProjection copies from ClusterServicePlans.
+	//
+	// Immutable.
+	// +optional
+	ClusterServiceBroker bool
 }
 
 // ServicePlanStatus represents status information about a
@@ -809,6 +1203,16 @@ type ServiceInstanceSpec struct {
 	// Immutable.
 	ExternalID string
 
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// Adopt makes the controller take over the instance with ExternalID that
+	// already exists at the broker, for example one created by another
+	// platform, instead of provisioning a new one. ExternalID must be set.
+	//
+	// Immutable.
+	Adopt bool
+
 	// Currently, this field is ALPHA: it may change or disappear at any time
 	// and its data will not be migrated.
 	//
@@ -823,8 +1227,83 @@ type ServiceInstanceSpec struct {
 	// allows for parameters to be updated with any out-of-band changes that have
 	// been made to the secrets from which the parameters are sourced.
 	UpdateRequests int64
+
+	// DeletionPolicy specifies whether the broker is asked to deprovision the
+	// instance when it is deleted. Defaults to Delete. Set it to Abandon to
+	// remove the instance from Kubernetes without calling the broker, for
+	// example when the broker-side resource has already been removed. It may
+	// be changed while the instance is being deleted.
+	DeletionPolicy DeletionPolicy
+
+	// BindingDeletionPolicy specifies what happens to the ServiceBindings of
+	// the instance when it is deleted. Defaults to Block, which keeps the
+	// instance from being deprovisioned until all of its bindings have been
+	// deleted. Set it to Cascade to have the bindings deleted, and unbound,
+	// before the instance is deprovisioned. It may be changed while the
+	// instance is being deleted.
+	BindingDeletionPolicy BindingDeletionPolicy
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// AutoBind makes the controller create a ServiceBinding for the instance,
+	// with the same name as the instance, once it has been provisioned. The
+	// binding is owned by the instance and is deleted with it, whatever the
+	// BindingDeletionPolicy.
+	// +optional
+	AutoBind *AutoBind
+}
+
+// AutoBind describes the ServiceBinding that the controller creates for a
+// ServiceInstance once it has been provisioned.
+type AutoBind struct {
+	// SecretName is the name of the secret to create in the namespace of
+	// the instance that will hold the credentials of the binding. Defaults
+	// to the name of the instance.
+	// +optional
+	SecretName string
+
+	// Parameters is a set of the parameters to be passed to the broker
+	// when binding, like the Parameters of a ServiceBinding.
+	// +optional
+	Parameters *runtime.RawExtension
+
+	// ParametersFrom is a list of sources to populate the parameters of the
+	// binding, like the ParametersFrom of a ServiceBinding.
+	// +optional
+	ParametersFrom []ParametersFromSource
 }
 
+// DeletionPolicy specifies what Service Catalog does at the broker when a
+// ServiceInstance or ServiceBinding is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete indicates that the instance is deprovisioned, or
+	// the binding unbound, at the broker before the resource is removed.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyAbandon indicates that the resource is removed without
+	// sending any request to the broker, leaving the broker-side resource
+	// untouched.
+	DeletionPolicyAbandon DeletionPolicy = "Abandon"
+)
+
+// BindingDeletionPolicy specifies what happens to the ServiceBindings of a
+// ServiceInstance that is deleted.
+type BindingDeletionPolicy string
+
+const (
+	// BindingDeletionPolicyBlock indicates that the instance is not
+	// deprovisioned until all of its bindings have been deleted by the user.
+	BindingDeletionPolicyBlock BindingDeletionPolicy = "Block"
+
+	// BindingDeletionPolicyCascade indicates that the bindings of the
+	// instance are deleted, and unbound at the broker, before the instance
+	// is deprovisioned.
+	BindingDeletionPolicyCascade BindingDeletionPolicy = "Cascade"
+)
+
 // ServiceInstanceStatus represents the current status of an Instance.
 type ServiceInstanceStatus struct {
 	// Conditions is an array of ServiceInstanceConditions capturing aspects of an
@@ -881,6 +1360,132 @@ type ServiceInstanceStatus struct {
 	// DeprovisionStatus describes what has been done to deprovision the
 	// ServiceInstance.
 	DeprovisionStatus ServiceInstanceDeprovisionStatus
+
+	// FailedDeprovisionAttempts is the number of deprovision requests for
+	// the ServiceInstance that have failed.
+	FailedDeprovisionAttempts int64
+
+	// ProvisionStartedAt is the time at which the controller first sent a
+	// provision request for the ServiceInstance to the broker.
+	ProvisionStartedAt *metav1.Time
+
+	// ReadyAt is the time at which the ServiceInstance was first
+	// successfully provisioned.
+	ReadyAt *metav1.Time
+
+	// LastPollTime is the time at which the controller last recorded a poll
+	// of the broker for the status of an asynchronous operation. It is only
+	// refreshed periodically while the result of the poll stays the same.
+	LastPollTime *metav1.Time
+
+	// LastPollResult is a short description of the result of the last poll
+	// of the broker for the status of an asynchronous operation.
+	LastPollResult string
+
+	// ParametersDiff describes how the parameters sent to the broker by the
+	// current or last update differ from the parameters the broker had. It
+	// is nil if the update did not change the parameters.
+	// +optional
+	ParametersDiff *ParametersDiff
+
+	// PropertiesHistory is the properties state of the ServiceInstance
+	// after each of its last successful provisions and updates, oldest
+	// first. At most MaxServiceInstancePropertiesHistory are kept.
+	// +optional
+	PropertiesHistory []ServiceInstancePropertiesSnapshot
+
+	// AutoBindingCreated is true once the controller has created the
+	// ServiceBinding requested by the AutoBind of the instance. The binding
+	// is not created again if it is deleted afterwards.
+	// +optional
+	AutoBindingCreated bool
+
+	// Operations are the last provision, update and deprovision operations
+	// of the ServiceInstance that the broker completed, oldest first. At
+	// most MaxServiceInstanceOperations are kept. They are served, with the
+	// current operation, by the operations subresource.
+	Operations []ServiceInstanceOperationRecord
+}
+
+// MaxServiceInstancePropertiesHistory is the number of snapshots kept in the
+// PropertiesHistory of a ServiceInstance.
+const MaxServiceInstancePropertiesHistory = 5
+
+// ServiceInstancePropertiesSnapshot is the properties state of a
+// ServiceInstance after an operation succeeded at the broker. Like in
+// ExternalProperties, the values of parameters that came from a secret are
+// redacted.
+type ServiceInstancePropertiesSnapshot struct {
+	// Operation is the operation that succeeded.
+	Operation ServiceInstanceOperation
+
+	// Time is the time at which the operation succeeded.
+	Time metav1.Time
+
+	// Properties is the properties state of the ServiceInstance which the
+	// broker knew about after the operation.
+	Properties ServiceInstancePropertiesState
+}
+
+// MaxServiceInstanceOperations is the number of completed operations kept
+// in the Operations of a ServiceInstance.
+const MaxServiceInstanceOperations = 10
+
+// ServiceInstanceOperationResult is the result of an operation of a
+// ServiceInstance at the broker.
+type ServiceInstanceOperationResult string
+
+const (
+	// ServiceInstanceOperationResultInProgress is the result of the current
+	// operation of a ServiceInstance.
+	ServiceInstanceOperationResultInProgress ServiceInstanceOperationResult = "InProgress"
+
+	// ServiceInstanceOperationResultSucceeded is the result of an operation
+	// that succeeded.
+	ServiceInstanceOperationResultSucceeded ServiceInstanceOperationResult = "Succeeded"
+
+	// ServiceInstanceOperationResultFailed is the result of an operation
+	// that failed for good.
+	ServiceInstanceOperationResultFailed ServiceInstanceOperationResult = "Failed"
+)
+
+// ServiceInstanceOperationRecord records an operation of a ServiceInstance
+// at the broker.
+type ServiceInstanceOperationRecord struct {
+	// Operation is the type of the operation.
+	Operation ServiceInstanceOperation
+
+	// StartTime is the time at which the operation began.
+	StartTime metav1.Time
+
+	// CompletionTime is the time at which the operation completed. It is
+	// not set for the current operation.
+	CompletionTime *metav1.Time
+
+	// Result is the result of the operation.
+	Result ServiceInstanceOperationResult
+
+	// OperationKey is the operation key returned by the broker for an
+	// asynchronous operation.
+	OperationKey *string
+}
+
+// ParametersDiff lists the parameters that an update adds, changes and
+// removes. Parameters are identified by their path in the parameters object,
+// with the keys of nested objects separated by dots. Values are never
+// recorded, so a diff does not expose secrets.
+type ParametersDiff struct {
+	// Added are the parameters that the broker did not have.
+	// +optional
+	Added []string
+
+	// Changed are the parameters whose value is changed.
+	// +optional
+	Changed []string
+
+	// Removed are the parameters that are no longer sent to the broker.
+	// +optional
+	Removed []string
 }
 
 // ServiceInstanceCondition contains condition information about an Instance.
@@ -919,6 +1524,44 @@ const (
 	// ServiceInstanceConditionOrphanMitigation represents information about an
 	// orphan mitigation that is required after failed provisioning.
 	ServiceInstanceConditionOrphanMitigation ServiceInstanceConditionType = "OrphanMitigation"
+
+	// ServiceInstanceConditionUnusable represents that the broker reported
+	// the instance as no longer usable after a failed update or deprovision,
+	// so that it may need manual intervention at the broker.
+	ServiceInstanceConditionUnusable ServiceInstanceConditionType = "Unusable"
+
+	// ServiceInstanceConditionRemovedFromCatalog represents that the class or
+	// plan of the instance has been removed from the broker's catalog.
+	ServiceInstanceConditionRemovedFromCatalog ServiceInstanceConditionType = "RemovedFromCatalog"
+
+	// ServiceInstanceConditionReferencedCatalogEntryRemoved represents that
+	// the class or plan referenced by the instance has been removed from the
+	// broker's catalog. Unlike RemovedFromCatalog, it is set regardless of
+	// the CatalogRemovalPolicy of the broker.
+	ServiceInstanceConditionReferencedCatalogEntryRemoved ServiceInstanceConditionType = "ReferencedCatalogEntryRemoved"
+
+	// ServiceInstanceConditionStuckDeleting represents that the instance has
+	// been terminating for longer than expected. The reason and message of
+	// the condition describe what is blocking the deletion.
+	ServiceInstanceConditionStuckDeleting ServiceInstanceConditionType = "StuckDeleting"
+
+	// ServiceInstanceConditionQueued represents that the provision of the
+	// instance is waiting for other instances of its class or plan to finish
+	// provisioning, because the class or plan limits how many instances may be
+	// provisioned at the same time.
+	ServiceInstanceConditionQueued ServiceInstanceConditionType = "Queued"
+
+	// ServiceInstanceConditionThrottled represents that the provision of the
+	// instance is waiting because its namespace has reached the limit on how
+	// many instances may start to be provisioned within a time window.
+	ServiceInstanceConditionThrottled ServiceInstanceConditionType = "Throttled"
+
+	// ServiceInstanceConditionPendingChanges represents that the spec of the
+	// instance has changed while an operation of the instance is in progress.
+	// The changes are applied by an operation that starts once the current
+	// one completes, so that the instance never has overlapping operations
+	// at the broker.
+	ServiceInstanceConditionPendingChanges ServiceInstanceConditionType = "PendingChanges"
 )
 
 // ServiceInstanceOperation represents a type of operation the controller can
@@ -968,6 +1611,10 @@ type ServiceInstancePropertiesState struct {
 
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo
+
+	// Context is the OSB context object that was sent to the broker, such as
+	// the platform, the cluster ID and the namespace of the ServiceInstance.
+	Context *runtime.RawExtension
 }
 
 // ServiceInstanceDeprovisionStatus is the status of deprovisioning a
@@ -1040,6 +1687,17 @@ type ServiceBindingSpec struct {
 	// Immutable.
 	ServiceInstanceRef LocalObjectReference
 
+	// InstanceSelector selects the ServiceInstance this ServiceBinding is to
+	// by its labels instead of by name. When it is set and ServiceInstanceRef
+	// is not, the ServiceBindingInstanceSelector admission plugin sets
+	// ServiceInstanceRef to the only instance in the namespace of the
+	// ServiceBinding that it selects when the ServiceBinding is created. The
+	// binding then stays with that instance.
+	//
+	// Immutable.
+	// +optional
+	InstanceSelector *metav1.LabelSelector
+
 	// Parameters is a set of the parameters to be passed to the underlying
 	// broker. The inline YAML/JSON payload to be translated into equivalent
 	// JSON object. If a top-level parameter name exists in multiples sources
@@ -1069,6 +1727,32 @@ type ServiceBindingSpec struct {
 	// by the broker before they are inserted into the Secret
 	SecretTransforms []SecretTransform
 
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// ImmutableSecret makes the controller create the secret holding the
+	// credentials as an immutable Secret. When the credentials change, a new
+	// secret is created and the previous one is deleted, instead of the
+	// secret being updated. The name of the current secret is recorded in
+	// the SecretName of the status.
+	//
+	// Immutable.
+	// +optional
+	ImmutableSecret bool
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// AppRef is the workload, in the namespace of the ServiceBinding, that
+	// consumes the credentials of the binding. The UID of the workload is
+	// sent to the broker as the app identifier of the bind request, so that
+	// brokers can scope credentials to that workload. If it is not set, the
+	// UID of the namespace is sent.
+	//
+	// Immutable.
+	// +optional
+	AppRef *AppReference
+
 	// ExternalID is the identity of this object for use with the OSB API.
 	//
 	// Immutable.
@@ -1082,6 +1766,13 @@ type ServiceBindingSpec struct {
 	// settable by the end-user. User-provided values for this field are not saved.
 	// +optional
 	UserInfo *UserInfo
+
+	// DeletionPolicy specifies whether the broker is asked to unbind the
+	// binding when it is deleted. Defaults to Delete. Set it to Abandon to
+	// remove the binding from Kubernetes without calling the broker. Unlike
+	// the rest of the spec, it may be changed after the binding is created,
+	// including while the binding is being deleted.
+	DeletionPolicy DeletionPolicy
 }
 
 // ServiceBindingStatus represents the current status of a ServiceBinding.
@@ -1131,6 +1822,46 @@ type ServiceBindingStatus struct {
 
 	// UnbindStatus describes what has been done to unbind a ServiceBinding
 	UnbindStatus ServiceBindingUnbindStatus
+
+	// FailedUnbindAttempts is the number of unbind requests for the
+	// ServiceBinding that have failed.
+	FailedUnbindAttempts int64
+
+	// BindStartedAt is the time at which the controller first sent a bind
+	// request for the ServiceBinding to the broker.
+	BindStartedAt *metav1.Time
+
+	// ReadyAt is the time at which the ServiceBinding was first successfully
+	// bound and had its credentials injected.
+	ReadyAt *metav1.Time
+
+	// LastPollTime is the time at which the controller last recorded a poll
+	// of the broker for the status of an asynchronous operation. It is only
+	// refreshed periodically while the result of the poll stays the same.
+	LastPollTime *metav1.Time
+
+	// LastPollResult is a short description of the result of the last poll
+	// of the broker for the status of an asynchronous operation.
+	LastPollResult string
+
+	// SecretName is the name of the secret that holds the credentials of
+	// a ServiceBinding with ImmutableSecret. It is empty for other bindings,
+	// whose secret is always the one named in the spec.
+	// +optional
+	SecretName string
+
+	// AcceptedParameters are the parameters of the ServiceBinding that the
+	// broker accepted, as echoed by the broker when the binding is fetched.
+	// A parameter that was sourced from a secret has the value "<redacted>".
+	// +optional
+	AcceptedParameters *runtime.RawExtension
+
+	// CredentialsChecksum is the checksum of the credentials in the secret
+	// of the ServiceBinding, which is also set as the
+	// CredentialsChecksumAnnotation of the secret. It changes whenever the
+	// credentials do.
+	// +optional
+	CredentialsChecksum string
 }
 
 // ServiceBindingCondition condition information for a ServiceBinding.
@@ -1164,6 +1895,11 @@ const (
 	// ServiceBindingConditionFailed represents a ServiceBindingCondition that has failed
 	// completely and should not be retried.
 	ServiceBindingConditionFailed ServiceBindingConditionType = "Failed"
+
+	// ServiceBindingConditionStuckDeleting represents that the binding has
+	// been terminating for longer than expected. The reason and message of
+	// the condition describe what is blocking the deletion.
+	ServiceBindingConditionStuckDeleting ServiceBindingConditionType = "StuckDeleting"
 )
 
 // ServiceBindingOperation represents a type of operation
@@ -1197,8 +1933,32 @@ type ServiceBindingPropertiesState struct {
 
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo
+
+	// AppGUID is the app identifier that was sent to the broker.
+	AppGUID string
 }
 
+// AppReference identifies the workload that consumes the credentials of a
+// ServiceBinding.
+type AppReference struct {
+	// Kind of the workload, either Deployment or StatefulSet.
+	Kind AppReferenceKind
+
+	// Name of the workload.
+	Name string
+}
+
+// AppReferenceKind is the kind of the workload an AppReference identifies.
+type AppReferenceKind string
+
+const (
+	// AppReferenceKindDeployment identifies an apps/v1 Deployment.
+	AppReferenceKindDeployment AppReferenceKind = "Deployment"
+
+	// AppReferenceKindStatefulSet identifies an apps/v1 StatefulSet.
+	AppReferenceKindStatefulSet AppReferenceKind = "StatefulSet"
+)
+
 // ServiceBindingUnbindStatus is the status of unbinding a Binding
 type ServiceBindingUnbindStatus string
 
@@ -1226,6 +1986,16 @@ type ParametersFromSource struct {
 	// The value must be a JSON object.
 	// +optional
 	SecretKeyRef *SecretKeyReference
+
+	// The ConfigMap key to select from.
+	// The value must be a JSON object.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeyReference
+
+	// The HTTPS endpoint to fetch from.
+	// The response body must be a JSON object.
+	// +optional
+	HTTPS *HTTPSParametersSource
 }
 
 // SecretKeyReference references a key of a Secret.
@@ -1236,6 +2006,35 @@ type SecretKeyReference struct {
 	Key string
 }
 
+// ConfigMapKeyReference references a key of a ConfigMap.
+type ConfigMapKeyReference struct {
+	// The name of the config map in the pod's namespace to select from.
+	Name string
+	// The key of the config map to select from.  Must be a valid config map
+	// key.
+	Key string
+}
+
+// HTTPSParametersSource references parameters served by an HTTPS endpoint.
+// The controller fetches them with a GET request each time it sends the
+// parameters to the broker, so they are never stored by the API server.
+type HTTPSParametersSource struct {
+	// URL of the endpoint. The scheme must be https.
+	URL string
+
+	// CABundle is a PEM encoded CA bundle which will be used to validate the
+	// certificate of the endpoint. If unspecified, the system trust roots
+	// are used.
+	// +optional
+	CABundle []byte
+
+	// BearerTokenSecretKeyRef references the key of a Secret, in the
+	// namespace of the resource, that holds the bearer token sent to the
+	// endpoint in the Authorization header.
+	// +optional
+	BearerTokenSecretKeyRef *SecretKeyReference
+}
+
 // ObjectReference contains enough information to let you locate the
 // referenced object.
 type ObjectReference struct {
@@ -1295,3 +2094,103 @@ type AddKeysFromTransform struct {
 type RemoveKeyTransform struct {
 	Key string
 }
+
+// AdminUnbindRequest is posted to the adminunbind subresource of a
+// ServiceBinding that is being deleted, to remove the finalizers that keep it
+// waiting to be unbound at the broker, for instance when the broker is
+// permanently gone. The abandonment is recorded as an event of the binding;
+// its credentials are left untouched at the broker.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AdminUnbindRequest struct {
+	metav1.TypeMeta
+
+	// Confirm must be set to the name of the binding being abandoned.
+	Confirm string
+
+	// Reason is an explanation of why the binding is being abandoned. It is
+	// recorded in the event of the abandonment and in the audit log of the
+	// request.
+	Reason string
+}
+
+// ForceDeleteRequest is posted to the forcedelete subresource of a
+// ServiceInstance or ServiceBinding to remove its finalizers and delete it
+// without waiting for the broker. This is an escape hatch for cluster
+// administrators; the broker-side resource is left untouched.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ForceDeleteRequest struct {
+	metav1.TypeMeta
+
+	// Confirm must be set to the name of the resource being deleted.
+	Confirm string
+
+	// Reason is an explanation of why the resource is being force deleted.
+	// It is recorded in the audit log of the request.
+	Reason string
+}
+
+// ServiceInstanceOperations is returned by the operations subresource of a
+// ServiceInstance. It lists the recorded operations of the instance at the
+// broker, oldest first, followed by its current operation, if any.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ServiceInstanceOperations struct {
+	metav1.TypeMeta
+
+	// The name and namespace are those of the ServiceInstance.
+	metav1.ObjectMeta
+
+	// Items are the operations of the ServiceInstance.
+	Items []ServiceInstanceOperationRecord
+}
+
+// RollbackRequest is posted to the rollback subresource of a ServiceInstance
+// to revert its plan and parameters to those last accepted by the broker, as
+// recorded in its ExternalProperties. The controller then sends an update
+// request for them to the broker.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RollbackRequest struct {
+	metav1.TypeMeta
+
+	// Reason is an explanation of why the instance is being rolled back.
+	// It is recorded in the audit log of the request.
+	Reason string
+}
+
+// BrokerCatalog is the catalog last fetched from a broker, as served by the
+// catalog subresource of ClusterServiceBrokers and ServiceBrokers. It is
+// stored apart from the broker, so that a large catalog does not grow every
+// read and write of the broker.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BrokerCatalog struct {
+	metav1.TypeMeta
+
+	// The name and namespace are those of the broker.
+	metav1.ObjectMeta
+
+	// RetrievalTime is when the catalog was fetched from the broker.
+	RetrievalTime metav1.Time
+
+	// Catalog is the catalog response of the broker, before it was filtered
+	// by the catalog restrictions of the broker, mutated by the catalog
+	// webhook or converted into classes and plans. It holds the fields that
+	// the controller decodes.
+	Catalog *runtime.RawExtension
+}
+
+// RelistRequest is posted to the relist subresource of a ClusterServiceBroker
+// to increment its RelistRequests, so that the controller fetches its catalog
+// again, without reading and writing its spec.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RelistRequest struct {
+	metav1.TypeMeta
+
+	// Reason is an explanation of why the broker is being relisted. It is
+	// recorded in the audit log of the request.
+	Reason string
+}
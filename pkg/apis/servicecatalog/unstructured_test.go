@@ -64,6 +64,9 @@ func doUnstructuredRoundTrip(t *testing.T, group testapi.TestGroup, kind string)
 			c.FuzzNoCustom(is)
 			is.ExternalID = string(uuid.NewUUID())
 			is.Parameters = nil
+			if is.AutoBind != nil {
+				is.AutoBind.Parameters = nil
+			}
 		},
 		func(bs *servicecatalog.ServiceBindingSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(bs)
@@ -77,9 +80,14 @@ func doUnstructuredRoundTrip(t *testing.T, group testapi.TestGroup, kind string)
 			}
 			bs.Parameters = nil
 		},
+		func(bs *servicecatalog.ServiceBindingStatus, c fuzz.Continue) {
+			c.FuzzNoCustom(bs)
+			bs.AcceptedParameters = nil
+		},
 		func(ps *servicecatalog.ServiceInstancePropertiesState, c fuzz.Continue) {
 			c.FuzzNoCustom(ps)
 			ps.Parameters = nil
+			ps.Context = nil
 		},
 		func(ps *servicecatalog.ServiceBindingPropertiesState, c fuzz.Continue) {
 			c.FuzzNoCustom(ps)
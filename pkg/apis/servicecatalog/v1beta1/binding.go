@@ -0,0 +1,24 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// CredentialsChecksumAnnotation is the annotation of the secret of a binding
+// that holds the checksum of the credentials in the secret. It changes
+// whenever the credentials do, so deployment tooling can watch it or template
+// it into a pod to roll out the new credentials. The same checksum is recorded
+// in status.credentialsChecksum of the binding.
+const CredentialsChecksumAnnotation = "servicecatalog.k8s.io/credentials-checksum"
@@ -16,6 +16,25 @@ limitations under the License.
 
 package v1beta1
 
+// RelistRequestedAtAnnotation is the annotation of a broker that requests a
+// relist of its catalog whenever its value changes. It is conventionally set
+// to the current time in RFC 3339 format. The value honored by the last
+// successful relist is recorded in status.observedRelistRequestedAt.
+const RelistRequestedAtAnnotation = "servicecatalog.k8s.io/relist-requested-at"
+
+// AcceptCatalogsFromAnnotation is the annotation of a namespace that lists,
+// separated by commas, the namespaces whose ServiceBrokers may share their
+// catalog with it through their targetNamespaces. A namespace without it
+// accepts no shared catalog.
+const AcceptCatalogsFromAnnotation = "servicecatalog.k8s.io/accept-catalogs-from"
+
+// RelistRequested returns whether a relist has been requested through the
+// RelistRequestedAtAnnotation and has not been honored yet.
+func (s *CommonServiceBrokerStatus) RelistRequested(annotations map[string]string) bool {
+	requestedAt := annotations[RelistRequestedAtAnnotation]
+	return requestedAt != "" && requestedAt != s.ObservedRelistRequestedAt
+}
+
 // GetName returns the broker's name.
 func (b *ClusterServiceBroker) GetName() string {
 	return b.Name
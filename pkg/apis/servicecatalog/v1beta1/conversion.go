@@ -25,6 +25,21 @@ import (
 // ServicePlan and ServiceClass. While they are identical, it's clearer to
 // use different functions from the get go.
 
+// ClusterServiceBrokerFieldLabelConversionFunc does not convert anything, just
+// returns what it's given for the supported fields, and errors for
+// unsupported.
+func ClusterServiceBrokerFieldLabelConversionFunc(label, value string) (string, string, error) {
+	switch label {
+	case "metadata.name",
+		"spec.url",
+		"spec.relistBehavior",
+		"status.condition":
+		return label, value, nil
+	default:
+		return "", "", fmt.Errorf("field label not supported: %s", label)
+	}
+}
+
 // ClusterServicePlanFieldLabelConversionFunc does not convert anything, just returns
 // what it's given for the supported fields, and errors for unsupported.
 func ClusterServicePlanFieldLabelConversionFunc(label, value string) (string, string, error) {
@@ -106,6 +121,7 @@ func ServiceBindingFieldLabelConversionFunc(label, value string) (string, string
 	switch label {
 	case "metadata.name",
 		"metadata.namespace",
+		"spec.instanceRef.name",
 		"spec.externalID":
 		return label, value, nil
 	default:
@@ -33,6 +33,46 @@ type testcase struct {
 	expectedError string
 }
 
+func TestClusterServiceBrokerFieldLabelConversionFunc(t *testing.T) {
+	cases := []testcase{
+		{
+			name:     "spec.url works",
+			inLabel:  "spec.url",
+			inValue:  "https://broker.example.com",
+			outLabel: "spec.url",
+			outValue: "https://broker.example.com",
+			success:  true,
+		},
+		{
+			name:     "spec.relistBehavior works",
+			inLabel:  "spec.relistBehavior",
+			inValue:  "Manual",
+			outLabel: "spec.relistBehavior",
+			outValue: "Manual",
+			success:  true,
+		},
+		{
+			name:     "status.condition works",
+			inLabel:  "status.condition",
+			inValue:  "Ready",
+			outLabel: "status.condition",
+			outValue: "Ready",
+			success:  true,
+		},
+		{
+			name:          "random fails",
+			inLabel:       "spec.random",
+			inValue:       "randomvalue",
+			outLabel:      "",
+			outValue:      "",
+			success:       false,
+			expectedError: "field label not supported: spec.random",
+		},
+	}
+
+	runTestCases(t, cases, "ClusterServiceBrokerFieldLabelConversionFunc", ClusterServiceBrokerFieldLabelConversionFunc)
+}
+
 func TestClusterServicePlanFieldLabelConversionFunc(t *testing.T) {
 	cases := []testcase{
 		{
@@ -219,6 +259,14 @@ func TestServiceInstanceFieldLabelConversionFunc(t *testing.T) {
 
 func TestServiceBindingFieldLabelConversionFunc(t *testing.T) {
 	cases := []testcase{
+		{
+			name:     "spec.instanceRef.name works",
+			inLabel:  "spec.instanceRef.name",
+			inValue:  "instance",
+			outLabel: "spec.instanceRef.name",
+			outValue: "instance",
+			success:  true,
+		},
 		{
 			name:     "spec.externalID works",
 			inLabel:  "spec.externalID",
@@ -16,6 +16,10 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	"encoding/json"
+)
+
 // GetName returns the plan's name.
 func (p *ClusterServicePlan) GetName() string {
 	return p.Name
@@ -45,3 +49,66 @@ func (p *ClusterServicePlan) GetDescription() string {
 func (p *ServicePlan) GetDescription() string {
 	return p.Spec.Description
 }
+
+const (
+	// PlanUpgradePathsMetadataKey is the key of the external metadata of a
+	// plan that lists the external names or IDs of the plans that instances
+	// of the plan may be moved to.
+	PlanUpgradePathsMetadataKey = "upgradePaths"
+
+	// PlanUpgradeDowntimeMetadataKey is the key of the external metadata of a
+	// plan that describes the downtime of moving an instance to the plan.
+	PlanUpgradeDowntimeMetadataKey = "upgradeDowntime"
+)
+
+// GetUpgradePaths returns the external names or IDs of the plans that
+// instances of the plan may be moved to. The second return value is false if
+// the metadata of the plan does not declare upgrade paths, in which case
+// instances may be moved to any plan.
+func (s *CommonServicePlanSpec) GetUpgradePaths() ([]string, bool) {
+	if s.ExternalMetadata == nil {
+		return nil, false
+	}
+	metadata := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(s.ExternalMetadata.Raw, &metadata); err != nil {
+		return nil, false
+	}
+	raw, ok := metadata[PlanUpgradePathsMetadataKey]
+	if !ok {
+		return nil, false
+	}
+	var paths []string
+	if err := json.Unmarshal(raw, &paths); err != nil {
+		return nil, false
+	}
+	return paths, true
+}
+
+// GetUpgradeDowntime returns the description of the downtime of moving an
+// instance to the plan, or "" if its metadata does not describe any.
+func (s *CommonServicePlanSpec) GetUpgradeDowntime() string {
+	if s.ExternalMetadata == nil {
+		return ""
+	}
+	metadata := make(map[string]interface{})
+	if err := json.Unmarshal(s.ExternalMetadata.Raw, &metadata); err != nil {
+		return ""
+	}
+	downtime, _ := metadata[PlanUpgradeDowntimeMetadataKey].(string)
+	return downtime
+}
+
+// AllowsUpgradeTo returns whether instances of the plan may be moved to the
+// given plan, according to the upgrade paths declared by the plan.
+func (s *CommonServicePlanSpec) AllowsUpgradeTo(target *CommonServicePlanSpec) bool {
+	paths, declared := s.GetUpgradePaths()
+	if !declared || s.ExternalID == target.ExternalID {
+		return true
+	}
+	for _, path := range paths {
+		if path == target.ExternalName || path == target.ExternalID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAllowsUpgradeTo(t *testing.T) {
+	target := &CommonServicePlanSpec{ExternalName: "large", ExternalID: "large-id"}
+
+	cases := []struct {
+		name     string
+		metadata string
+		allowed  bool
+	}{
+		{
+			name:    "no metadata",
+			allowed: true,
+		},
+		{
+			name:     "no upgrade paths",
+			metadata: `{"bullets": ["fast"]}`,
+			allowed:  true,
+		},
+		{
+			name:     "path by external name",
+			metadata: `{"upgradePaths": ["medium", "large"]}`,
+			allowed:  true,
+		},
+		{
+			name:     "path by external ID",
+			metadata: `{"upgradePaths": ["large-id"]}`,
+			allowed:  true,
+		},
+		{
+			name:     "no path",
+			metadata: `{"upgradePaths": ["medium"]}`,
+		},
+		{
+			name:     "no upgrades",
+			metadata: `{"upgradePaths": []}`,
+		},
+		{
+			name:     "malformed upgrade paths",
+			metadata: `{"upgradePaths": "medium"}`,
+			allowed:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		plan := &CommonServicePlanSpec{ExternalName: "small", ExternalID: "small-id"}
+		if tc.metadata != "" {
+			plan.ExternalMetadata = &runtime.RawExtension{Raw: []byte(tc.metadata)}
+		}
+		if e, a := tc.allowed, plan.AllowsUpgradeTo(target); e != a {
+			t.Errorf("%v: expected allowed to be %v, got %v", tc.name, e, a)
+		}
+	}
+}
+
+func TestGetUpgradeDowntime(t *testing.T) {
+	plan := &CommonServicePlanSpec{
+		ExternalMetadata: &runtime.RawExtension{Raw: []byte(`{"upgradeDowntime": "up to 5 minutes"}`)},
+	}
+	if e, a := "up to 5 minutes", plan.GetUpgradeDowntime(); e != a {
+		t.Errorf("expected downtime %q, got %q", e, a)
+	}
+	if e, a := "", (&CommonServicePlanSpec{}).GetUpgradeDowntime(); e != a {
+		t.Errorf("expected downtime %q, got %q", e, a)
+	}
+}
@@ -54,6 +54,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterServiceBrokerList{},
 		&ServiceBroker{},
 		&ServiceBrokerList{},
+		&BrokerTemplate{},
+		&BrokerTemplateList{},
+		&CatalogProjection{},
+		&CatalogProjectionList{},
+		&ServiceBrokerSummary{},
+		&ServiceBrokerSummaryList{},
 		&ClusterServiceClass{},
 		&ClusterServiceClassList{},
 		&ServiceClass{},
@@ -66,9 +72,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ServiceInstanceList{},
 		&ServiceBinding{},
 		&ServiceBindingList{},
+		&AdminUnbindRequest{},
+		&ForceDeleteRequest{},
+		&RollbackRequest{},
+		&RelistRequest{},
+		&BrokerCatalog{},
+		&ServiceInstanceOperations{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	scheme.AddKnownTypes(schema.GroupVersion{Version: "v1"}, &metav1.Status{})
+	scheme.AddFieldLabelConversionFunc("servicecatalog.k8s.io/v1beta1", "ClusterServiceBroker", ClusterServiceBrokerFieldLabelConversionFunc)
 	scheme.AddFieldLabelConversionFunc("servicecatalog.k8s.io/v1beta1", "ClusterServiceClass", ClusterServiceClassFieldLabelConversionFunc)
 	scheme.AddFieldLabelConversionFunc("servicecatalog.k8s.io/v1beta1", "ServiceClass", ServiceClassFieldLabelConversionFunc)
 	scheme.AddFieldLabelConversionFunc("servicecatalog.k8s.io/v1beta1", "ClusterServicePlan", ClusterServicePlanFieldLabelConversionFunc)
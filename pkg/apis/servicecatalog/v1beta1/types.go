@@ -119,6 +119,11 @@ type CommonServiceBrokerSpec struct {
 
 	// RelistRequests is a strictly increasing, non-negative integer counter that
 	// can be manually incremented by a user to manually trigger a relist.
+	//
+	// Deprecated: set the servicecatalog.k8s.io/relist-requested-at
+	// annotation to the current time instead, which does not conflict with
+	// other changes to the spec. The value a relist honored is recorded in
+	// status.observedRelistRequestedAt.
 	// +optional
 	RelistRequests int64 `json:"relistRequests"`
 
@@ -126,6 +131,86 @@ type CommonServiceBrokerSpec struct {
 	// and plans have resources created for them.
 	// +optional
 	CatalogRestrictions *CatalogRestrictions `json:"catalogRestrictions,omitempty"`
+
+	// CatalogRemovalPolicy specifies what the controller does with the
+	// ServiceInstances of a class or plan that has been removed from the
+	// broker's catalog. Defaults to CatalogRemovalPolicyKeep.
+	// +optional
+	CatalogRemovalPolicy CatalogRemovalPolicy `json:"catalogRemovalPolicy,omitempty"`
+
+	// CatalogRemovalDeprovisionDelay is how long the controller waits after
+	// a class or plan was removed from the broker's catalog before
+	// deprovisioning its ServiceInstances when the CatalogRemovalPolicy is
+	// set to CatalogRemovalPolicyScheduledDeprovision.
+	// +optional
+	CatalogRemovalDeprovisionDelay *metav1.Duration `json:"catalogRemovalDeprovisionDelay,omitempty"`
+
+	// CatalogRemovalGracePeriod is how long the controller keeps the classes
+	// and plans that were removed from the broker's catalog, or that belong
+	// to a broker being deleted, before deleting them. During the grace
+	// period they are marked as pending removal. If unset, they are deleted
+	// as soon as they are no longer in use.
+	// +optional
+	CatalogRemovalGracePeriod *metav1.Duration `json:"catalogRemovalGracePeriod,omitempty"`
+
+	// MirrorURL is the address of a second broker to which a copy of each
+	// provision request sent to this broker is also sent, for example to
+	// test a replacement broker with real traffic before switching over to
+	// it. The copies are sent in the background with the same credentials
+	// and TLS settings as the requests to this broker, and the responses of
+	// the mirror are only logged: they never affect the instances.
+	// +optional
+	MirrorURL string `json:"mirrorURL,omitempty"`
+
+	// FailoverURLs are the addresses of standby endpoints of the same
+	// broker, such as its deployments in other regions, which serve the same
+	// catalog and instances as URL. When an endpoint cannot be reached or
+	// answers that it is unavailable, requests are sent to the next one, in
+	// the order URL, then FailoverURLs, and the endpoint is skipped for a
+	// while.
+	// +optional
+	FailoverURLs []string `json:"failoverURLs,omitempty"`
+
+	// ReadOnly marks the broker as deprecated, for example while it is being
+	// decommissioned. New ServiceInstances of its classes are rejected,
+	// while the existing ones keep working and can be unbound and
+	// deprovisioned as usual.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// CustomHeaders are HTTP headers sent on every request to the broker,
+	// for example the tenant or routing headers required by some brokers.
+	// The keys are the names of the headers, which must not be the name of
+	// a header set by the controller itself, such as Authorization or
+	// X-Broker-API-Version.
+	// +optional
+	CustomHeaders map[string]BrokerHeaderValue `json:"customHeaders,omitempty"`
+}
+
+// BrokerHeaderValue is the value of a custom header sent to a broker. Exactly
+// one of Value and SecretKeyRef must be set.
+type BrokerHeaderValue struct {
+	// Value is the value of the header.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// SecretKeyRef references the key of a Secret that holds the value of
+	// the header, for values that must be kept secret.
+	// +optional
+	SecretKeyRef *BrokerSecretKeyReference `json:"secretKeyRef,omitempty"`
+}
+
+// BrokerSecretKeyReference references a key of a Secret used to communicate
+// with a broker.
+type BrokerSecretKeyReference struct {
+	// Namespace of the Secret. It is required for a ClusterServiceBroker,
+	// and defaults to the namespace of a ServiceBroker.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the Secret.
+	Name string `json:"name"`
+	// Key of the Secret to select from.
+	Key string `json:"key"`
 }
 
 // CatalogRestrictions is a set of restrictions on which of a broker's services
@@ -179,6 +264,15 @@ type CatalogRestrictions struct {
 	ServiceClass []string `json:"serviceClass,omitempty"`
 	// ServicePlan represents a selector for classes, used to filter catalog re-lists.
 	ServicePlan []string `json:"servicePlan,omitempty"`
+	// ServiceClassExternalIDs is an allow-list of the external IDs of the
+	// services of the broker's catalog to create classes for. External IDs
+	// are stable across renames of the services by the broker. An empty list
+	// allows every service.
+	ServiceClassExternalIDs []string `json:"serviceClassExternalIDs,omitempty"`
+	// ServicePlanExternalIDs is an allow-list of the external IDs of the
+	// plans of the broker's catalog to create plans for. An empty list allows
+	// every plan.
+	ServicePlanExternalIDs []string `json:"servicePlanExternalIDs,omitempty"`
 }
 
 // ClusterServiceBrokerSpec represents a description of a Broker.
@@ -197,6 +291,17 @@ type ServiceBrokerSpec struct {
 	// AuthInfo contains the data that the service catalog should use to authenticate
 	// with the ServiceBroker.
 	AuthInfo *ServiceBrokerAuthInfo `json:"authInfo,omitempty"`
+
+	// TargetNamespaces are other namespaces into which the ServiceClasses
+	// and ServicePlans of the ServiceBroker are published, so that one
+	// namespaced broker can serve several tenant namespaces. Instances in a
+	// target namespace are provisioned through the ServiceBroker in its own
+	// namespace, with its credentials. Only the target namespaces that list
+	// the namespace of the ServiceBroker in their
+	// servicecatalog.k8s.io/accept-catalogs-from annotation receive the
+	// catalog. Requires the CrossNamespaceCatalogSharing feature.
+	// +optional
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
 }
 
 // ServiceBrokerRelistBehavior represents a type of broker relist behavior.
@@ -212,6 +317,30 @@ const (
 	ServiceBrokerRelistBehaviorManual ServiceBrokerRelistBehavior = "Manual"
 )
 
+// CatalogRemovalPolicy represents what is done with the ServiceInstances of a
+// class or plan that has been removed from a broker's catalog.
+type CatalogRemovalPolicy string
+
+const (
+	// CatalogRemovalPolicyKeep indicates that the ServiceInstances are left
+	// untouched.
+	CatalogRemovalPolicyKeep CatalogRemovalPolicy = "Keep"
+
+	// CatalogRemovalPolicyWarn indicates that the ServiceInstances are marked
+	// with a RemovedFromCatalog condition.
+	CatalogRemovalPolicyWarn CatalogRemovalPolicy = "Warn"
+
+	// CatalogRemovalPolicyBlockNewBindings indicates that the ServiceInstances
+	// are marked with a RemovedFromCatalog condition and that no new
+	// ServiceBindings are created for them.
+	CatalogRemovalPolicyBlockNewBindings CatalogRemovalPolicy = "BlockNewBindings"
+
+	// CatalogRemovalPolicyScheduledDeprovision indicates that the
+	// ServiceInstances are marked with a RemovedFromCatalog condition and
+	// deleted once the CatalogRemovalDeprovisionDelay has passed.
+	CatalogRemovalPolicyScheduledDeprovision CatalogRemovalPolicy = "ScheduledDeprovision"
+)
+
 // ClusterServiceBrokerAuthInfo is a union type that contains information on
 // one of the authentication methods the the service catalog and brokers may
 // support, according to the OpenServiceBroker API specification
@@ -271,6 +400,13 @@ type BasicAuthConfig struct {
 	// - Secret.Data["username"] - username used for authentication
 	// - Secret.Data["password"] - password or token needed for authentication
 	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+
+	// SecretNamespace is the namespace of the Secret referenced by
+	// SecretRef. Defaults to the namespace of the ServiceBroker. A Secret
+	// in another namespace may only be referenced by users that are
+	// allowed to get it.
+	// +optional
+	SecretNamespace string `json:"secretNamespace,omitempty"`
 }
 
 // BearerTokenAuthConfig provides config for the bearer token
@@ -282,6 +418,13 @@ type BearerTokenAuthConfig struct {
 	// Required field:
 	// - Secret.Data["token"] - bearer token for authentication
 	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+
+	// SecretNamespace is the namespace of the Secret referenced by
+	// SecretRef. Defaults to the namespace of the ServiceBroker. A Secret
+	// in another namespace may only be referenced by users that are
+	// allowed to get it.
+	// +optional
+	SecretNamespace string `json:"secretNamespace,omitempty"`
 }
 
 const (
@@ -309,6 +452,49 @@ type CommonServiceBrokerStatus struct {
 	// LastCatalogRetrievalTime is the time the Catalog was last fetched from
 	// the Service Broker
 	LastCatalogRetrievalTime *metav1.Time `json:"lastCatalogRetrievalTime,omitempty"`
+
+	// ClassCount is the number of service classes that resulted from the
+	// last successful relist of the broker's catalog.
+	// +optional
+	ClassCount int32 `json:"classCount,omitempty"`
+
+	// PlanCount is the number of service plans that resulted from the last
+	// successful relist of the broker's catalog.
+	// +optional
+	PlanCount int32 `json:"planCount,omitempty"`
+
+	// LastCatalogChecksum is the checksum of the catalog payload returned by
+	// the broker on the last successful relist.
+	// +optional
+	LastCatalogChecksum string `json:"lastCatalogChecksum,omitempty"`
+
+	// OSBAPIVersion is the version of the Open Service Broker API that was
+	// negotiated with the broker on the last successful relist.
+	// +optional
+	OSBAPIVersion string `json:"osbAPIVersion,omitempty"`
+
+	// LastRelistDuration is the time it took to fetch and reconcile the
+	// broker's catalog on the last successful relist.
+	// +optional
+	LastRelistDuration *metav1.Duration `json:"lastRelistDuration,omitempty"`
+
+	// ObservedRelistRequests is the value of spec.relistRequests that the
+	// last successful relist honored.
+	// +optional
+	ObservedRelistRequests int64 `json:"observedRelistRequests,omitempty"`
+
+	// ObservedRelistRequestedAt is the value of the
+	// servicecatalog.k8s.io/relist-requested-at annotation that the last
+	// successful relist honored.
+	// +optional
+	ObservedRelistRequestedAt string `json:"observedRelistRequestedAt,omitempty"`
+
+	// AsyncBindingOperationsRequired is set once the broker has rejected a
+	// binding request without accepts_incomplete=true with the AsyncRequired
+	// error. The controller then sends accepts_incomplete=true with every
+	// binding request to the broker.
+	// +optional
+	AsyncBindingOperationsRequired bool `json:"asyncBindingOperationsRequired,omitempty"`
 }
 
 // ClusterServiceBrokerStatus represents the current status of a
@@ -356,6 +542,172 @@ const (
 	ServiceBrokerConditionFailed ServiceBrokerConditionType = "Failed"
 )
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BrokerTemplate creates a ServiceBroker in every namespace that matches its
+// namespace selector, for platforms that give each tenant its own broker
+// endpoint.
+type BrokerTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Non-namespaced.  The name of this resource in etcd is in ObjectMeta.Name.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the ServiceBrokers created from the template.
+	// +optional
+	Spec BrokerTemplateSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BrokerTemplateList is a list of BrokerTemplates.
+type BrokerTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BrokerTemplate `json:"items"`
+}
+
+// BrokerTemplateSpec represents a description of a BrokerTemplate.
+type BrokerTemplateSpec struct {
+	// NamespaceSelector selects the namespaces in which a ServiceBroker is
+	// created. An empty selector matches every namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// BrokerName is the name of the ServiceBrokers created from the
+	// template. Defaults to the name of the template.
+	// +optional
+	BrokerName string `json:"brokerName,omitempty"`
+
+	// Template is the spec of the ServiceBrokers created from the template.
+	// Every occurrence of $(namespace) in the URL and in the name and
+	// namespace of the auth secret is replaced with the name of the
+	// namespace the ServiceBroker is created in.
+	Template ServiceBrokerSpec `json:"template"`
+}
+
+// BrokerTemplateNamespaceVariable is replaced with the name of the namespace
+// of a ServiceBroker created from a BrokerTemplate.
+const BrokerTemplateNamespaceVariable = "$(namespace)"
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CatalogProjection copies the ClusterServiceClasses and ClusterServicePlans
+// it selects into every namespace that matches its namespace selector, as
+// ServiceClasses and ServicePlans, for tools that only read namespaced
+// resources.
+type CatalogProjection struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Non-namespaced.  The name of this resource in etcd is in ObjectMeta.Name.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the classes and plans that are projected, and where.
+	// +optional
+	Spec CatalogProjectionSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CatalogProjectionList is a list of CatalogProjections.
+type CatalogProjectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []CatalogProjection `json:"items"`
+}
+
+// CatalogProjectionSpec represents a description of a CatalogProjection.
+type CatalogProjectionSpec struct {
+	// NamespaceSelector selects the namespaces the classes and plans are
+	// projected into. An empty selector matches every namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// CatalogRestrictions selects the ClusterServiceClasses and
+	// ClusterServicePlans that are projected, in the same way as the catalog
+	// restrictions of a ClusterServiceBroker. Every class and plan is
+	// projected if it is empty.
+	// +optional
+	CatalogRestrictions *CatalogRestrictions `json:"catalogRestrictions,omitempty"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceBrokerSummary summarizes the ServiceBrokers in a namespace, for
+// dashboards that should not have to list every ServiceBroker. It is
+// maintained by the controller and named "brokers".
+type ServiceBrokerSummary struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// TotalBrokers is the number of ServiceBrokers in the namespace.
+	TotalBrokers int32 `json:"totalBrokers"`
+
+	// ReadyBrokers is the number of ServiceBrokers in the namespace whose
+	// catalog was last fetched successfully.
+	ReadyBrokers int32 `json:"readyBrokers"`
+
+	// Brokers summarizes each ServiceBroker in the namespace.
+	// +optional
+	Brokers []ServiceBrokerSummaryEntry `json:"brokers,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceBrokerSummaryList is a list of ServiceBrokerSummaries.
+type ServiceBrokerSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceBrokerSummary `json:"items"`
+}
+
+// ServiceBrokerSummaryEntry summarizes a ServiceBroker.
+type ServiceBrokerSummaryEntry struct {
+	// Name is the name of the ServiceBroker.
+	Name string `json:"name"`
+
+	// Ready is the status of the Ready condition of the ServiceBroker, which
+	// is true when its catalog was last fetched successfully.
+	Ready ConditionStatus `json:"ready"`
+
+	// Reason is the reason of the Ready condition of the ServiceBroker.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ServiceClasses is the number of ServiceClasses offered by the
+	// ServiceBroker.
+	ServiceClasses int32 `json:"serviceClasses"`
+
+	// ServicePlans is the number of ServicePlans offered by the
+	// ServiceBroker.
+	ServicePlans int32 `json:"servicePlans"`
+
+	// LastCatalogRetrievalTime is when the catalog of the ServiceBroker was
+	// last fetched successfully.
+	// +optional
+	LastCatalogRetrievalTime *metav1.Time `json:"lastCatalogRetrievalTime,omitempty"`
+}
+
+// ServiceBrokerSummaryName is the name of the ServiceBrokerSummary in each
+// namespace.
+const ServiceBrokerSummaryName = "brokers"
+
 // ConditionStatus represents a condition's status.
 type ConditionStatus string
 
@@ -456,6 +808,17 @@ type CommonServiceClassStatus struct {
 	// RemovedFromBrokerCatalog indicates that the broker removed the service from its
 	// catalog.
 	RemovedFromBrokerCatalog bool `json:"removedFromBrokerCatalog"`
+
+	// PendingRemoval indicates that the class will be deleted once the
+	// RemovalTime has passed, because it was removed from the broker's
+	// catalog or its broker is being deleted.
+	// +optional
+	PendingRemoval bool `json:"pendingRemoval,omitempty"`
+
+	// RemovalTime is when the class will be deleted, if it is pending
+	// removal.
+	// +optional
+	RemovalTime *metav1.Time `json:"removalTime,omitempty"`
 }
 
 // CommonServiceClassSpec represents details about a ServiceClass
@@ -485,6 +848,11 @@ type CommonServiceClassSpec struct {
 	// its endpoint is supported for all plans.
 	BindingRetrievable bool `json:"bindingRetrievable"`
 
+	// InstancesRetrievable indicates whether fetching an instance via a GET
+	// on its endpoint is supported, which is required to adopt an existing
+	// instance of the class.
+	InstancesRetrievable bool `json:"instancesRetrievable,omitempty"`
+
 	// PlanUpdatable indicates whether instances provisioned from this
 	// ServiceClass may change ServicePlans after being
 	// provisioned.
@@ -496,6 +864,16 @@ type CommonServiceClassSpec struct {
 	// values.
 	ExternalMetadata *runtime.RawExtension `json:"externalMetadata,omitempty"`
 
+	// DocumentationURL is the link to the documentation of the service, as
+	// given by the documentationUrl value of its external metadata.
+	// +optional
+	DocumentationURL string `json:"documentationURL,omitempty"`
+
+	// SupportURL is the link to the support of the service, as given by the
+	// supportUrl value of its external metadata.
+	// +optional
+	SupportURL string `json:"supportURL,omitempty"`
+
 	// Currently, this field is ALPHA: it may change or disappear at any time
 	// and its data will not be migrated.
 	//
@@ -536,6 +914,24 @@ type ServiceClassSpec struct {
 	//
 	// Immutable.
 	ServiceBrokerName string `json:"serviceBrokerName"`
+
+	// ServiceBrokerNamespace is the namespace of the ServiceBroker that
+	// provides this ServiceClass when it is not the namespace of the
+	// ServiceClass, which is the case of the classes that a broker publishes
+	// into its TargetNamespaces.
+	//
+	// Immutable.
+	// +optional
+	ServiceBrokerNamespace string `json:"serviceBrokerNamespace,omitempty"`
+
+	// ClusterServiceBroker is set when ServiceBrokerName is the name of the
+	// ClusterServiceBroker that provides this ServiceClass, which is the case
+	// of the classes that a CatalogProjection copies from
+	// ClusterServiceClasses.
+	//
+	// Immutable.
+	// +optional
+	ClusterServiceBroker bool `json:"clusterServiceBroker,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -599,6 +995,16 @@ type CommonServicePlanSpec struct {
 	// platform-specific conventional values.
 	ExternalMetadata *runtime.RawExtension `json:"externalMetadata,omitempty"`
 
+	// DocumentationURL is the link to the documentation of the plan, as
+	// given by the documentationUrl value of its external metadata.
+	// +optional
+	DocumentationURL string `json:"documentationURL,omitempty"`
+
+	// SupportURL is the link to the support of the plan, as given by the
+	// supportUrl value of its external metadata.
+	// +optional
+	SupportURL string `json:"supportURL,omitempty"`
+
 	// Currently, this field is ALPHA: it may change or disappear at any time
 	// and its data will not be migrated.
 	//
@@ -662,6 +1068,17 @@ type CommonServicePlanStatus struct {
 	// RemovedFromBrokerCatalog indicates that the broker removed the plan
 	// from its catalog.
 	RemovedFromBrokerCatalog bool `json:"removedFromBrokerCatalog"`
+
+	// PendingRemoval indicates that the plan will be deleted once the
+	// RemovalTime has passed, because it was removed from the broker's
+	// catalog or its broker is being deleted.
+	// +optional
+	PendingRemoval bool `json:"pendingRemoval,omitempty"`
+
+	// RemovalTime is when the plan will be deleted, if it is pending
+	// removal.
+	// +optional
+	RemovalTime *metav1.Time `json:"removalTime,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -708,6 +1125,23 @@ type ServicePlanSpec struct {
 	// ServiceClassRef is a reference to the service class that
 	// owns this plan.
 	ServiceClassRef LocalObjectReference `json:"serviceClassRef"`
+
+	// ServiceBrokerNamespace is the namespace of the ServiceBroker that
+	// offers this ServicePlan when it is not the namespace of the
+	// ServicePlan, which is the case of the plans that a broker publishes
+	// into its TargetNamespaces.
+	//
+	// Immutable.
+	// +optional
+	ServiceBrokerNamespace string `json:"serviceBrokerNamespace,omitempty"`
+
+	// ClusterServiceBroker is set when ServiceBrokerName is the name of the
+	// ClusterServiceBroker that offers this ServicePlan, which is the case of
+	// the plans that a CatalogProjection copies from ClusterServicePlans.
+	//
+	// Immutable.
+	// +optional
+	ClusterServiceBroker bool `json:"clusterServiceBroker,omitempty"`
 }
 
 // ServicePlanStatus represents status information about a
@@ -897,6 +1331,17 @@ type ServiceInstanceSpec struct {
 	// +optional
 	ExternalID string `json:"externalID"`
 
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// Adopt makes the controller take over the instance with ExternalID that
+	// already exists at the broker, for example one created by another
+	// platform, instead of provisioning a new one. ExternalID must be set.
+	//
+	// Immutable.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+
 	// Currently, this field is ALPHA: it may change or disappear at any time
 	// and its data will not be migrated.
 	//
@@ -912,8 +1357,85 @@ type ServiceInstanceSpec struct {
 	// been made to the secrets from which the parameters are sourced.
 	// +optional
 	UpdateRequests int64 `json:"updateRequests"`
+
+	// DeletionPolicy specifies whether the broker is asked to deprovision the
+	// instance when it is deleted. Defaults to Delete. Set it to Abandon to
+	// remove the instance from Kubernetes without calling the broker, for
+	// example when the broker-side resource has already been removed. It may
+	// be changed while the instance is being deleted.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// BindingDeletionPolicy specifies what happens to the ServiceBindings of
+	// the instance when it is deleted. Defaults to Block, which keeps the
+	// instance from being deprovisioned until all of its bindings have been
+	// deleted. Set it to Cascade to have the bindings deleted, and unbound,
+	// before the instance is deprovisioned. It may be changed while the
+	// instance is being deleted.
+	// +optional
+	BindingDeletionPolicy BindingDeletionPolicy `json:"bindingDeletionPolicy,omitempty"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// AutoBind makes the controller create a ServiceBinding for the instance,
+	// with the same name as the instance, once it has been provisioned. The
+	// binding is owned by the instance and is deleted with it, whatever the
+	// BindingDeletionPolicy.
+	// +optional
+	AutoBind *AutoBind `json:"autoBind,omitempty"`
 }
 
+// AutoBind describes the ServiceBinding that the controller creates for a
+// ServiceInstance once it has been provisioned.
+type AutoBind struct {
+	// SecretName is the name of the secret to create in the namespace of
+	// the instance that will hold the credentials of the binding. Defaults
+	// to the name of the instance.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Parameters is a set of the parameters to be passed to the broker
+	// when binding, like the Parameters of a ServiceBinding.
+	// +optional
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+
+	// ParametersFrom is a list of sources to populate the parameters of the
+	// binding, like the ParametersFrom of a ServiceBinding.
+	// +optional
+	ParametersFrom []ParametersFromSource `json:"parametersFrom,omitempty"`
+}
+
+// DeletionPolicy specifies what Service Catalog does at the broker when a
+// ServiceInstance or ServiceBinding is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete indicates that the instance is deprovisioned, or
+	// the binding unbound, at the broker before the resource is removed.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyAbandon indicates that the resource is removed without
+	// sending any request to the broker, leaving the broker-side resource
+	// untouched.
+	DeletionPolicyAbandon DeletionPolicy = "Abandon"
+)
+
+// BindingDeletionPolicy specifies what happens to the ServiceBindings of a
+// ServiceInstance that is deleted.
+type BindingDeletionPolicy string
+
+const (
+	// BindingDeletionPolicyBlock indicates that the instance is not
+	// deprovisioned until all of its bindings have been deleted by the user.
+	BindingDeletionPolicyBlock BindingDeletionPolicy = "Block"
+
+	// BindingDeletionPolicyCascade indicates that the bindings of the
+	// instance are deleted, and unbound at the broker, before the instance
+	// is deprovisioned.
+	BindingDeletionPolicyCascade BindingDeletionPolicy = "Cascade"
+)
+
 // ServiceInstanceStatus represents the current status of an Instance.
 type ServiceInstanceStatus struct {
 	// Conditions is an array of ServiceInstanceConditions capturing aspects of an
@@ -970,6 +1492,136 @@ type ServiceInstanceStatus struct {
 	// DeprovisionStatus describes what has been done to deprovision the
 	// ServiceInstance.
 	DeprovisionStatus ServiceInstanceDeprovisionStatus `json:"deprovisionStatus"`
+
+	// FailedDeprovisionAttempts is the number of deprovision requests for
+	// the ServiceInstance that have failed.
+	// +optional
+	FailedDeprovisionAttempts int64 `json:"failedDeprovisionAttempts,omitempty"`
+
+	// ProvisionStartedAt is the time at which the controller first sent a
+	// provision request for the ServiceInstance to the broker.
+	ProvisionStartedAt *metav1.Time `json:"provisionStartedAt,omitempty"`
+
+	// ReadyAt is the time at which the ServiceInstance was first
+	// successfully provisioned.
+	ReadyAt *metav1.Time `json:"readyAt,omitempty"`
+
+	// LastPollTime is the time at which the controller last recorded a poll
+	// of the broker for the status of an asynchronous operation. It is only
+	// refreshed periodically while the result of the poll stays the same.
+	LastPollTime *metav1.Time `json:"lastPollTime,omitempty"`
+
+	// LastPollResult is a short description of the result of the last poll
+	// of the broker for the status of an asynchronous operation.
+	LastPollResult string `json:"lastPollResult,omitempty"`
+
+	// ParametersDiff describes how the parameters sent to the broker by the
+	// current or last update differ from the parameters the broker had. It
+	// is nil if the update did not change the parameters.
+	// +optional
+	ParametersDiff *ParametersDiff `json:"parametersDiff,omitempty"`
+
+	// PropertiesHistory is the properties state of the ServiceInstance
+	// after each of its last successful provisions and updates, oldest
+	// first. At most MaxServiceInstancePropertiesHistory are kept.
+	// +optional
+	PropertiesHistory []ServiceInstancePropertiesSnapshot `json:"propertiesHistory,omitempty"`
+
+	// AutoBindingCreated is true once the controller has created the
+	// ServiceBinding requested by the AutoBind of the instance. The binding
+	// is not created again if it is deleted afterwards.
+	// +optional
+	AutoBindingCreated bool `json:"autoBindingCreated,omitempty"`
+
+	// Operations are the last provision, update and deprovision operations
+	// of the ServiceInstance that the broker completed, oldest first. At
+	// most MaxServiceInstanceOperations are kept. They are served, with the
+	// current operation, by the operations subresource.
+	// +optional
+	Operations []ServiceInstanceOperationRecord `json:"operations,omitempty"`
+}
+
+// MaxServiceInstancePropertiesHistory is the number of snapshots kept in the
+// PropertiesHistory of a ServiceInstance.
+const MaxServiceInstancePropertiesHistory = 5
+
+// ServiceInstancePropertiesSnapshot is the properties state of a
+// ServiceInstance after an operation succeeded at the broker. Like in
+// ExternalProperties, the values of parameters that came from a secret are
+// redacted.
+type ServiceInstancePropertiesSnapshot struct {
+	// Operation is the operation that succeeded.
+	Operation ServiceInstanceOperation `json:"operation"`
+
+	// Time is the time at which the operation succeeded.
+	Time metav1.Time `json:"time"`
+
+	// Properties is the properties state of the ServiceInstance which the
+	// broker knew about after the operation.
+	Properties ServiceInstancePropertiesState `json:"properties"`
+}
+
+// MaxServiceInstanceOperations is the number of completed operations kept
+// in the Operations of a ServiceInstance.
+const MaxServiceInstanceOperations = 10
+
+// ServiceInstanceOperationResult is the result of an operation of a
+// ServiceInstance at the broker.
+type ServiceInstanceOperationResult string
+
+const (
+	// ServiceInstanceOperationResultInProgress is the result of the current
+	// operation of a ServiceInstance.
+	ServiceInstanceOperationResultInProgress ServiceInstanceOperationResult = "InProgress"
+
+	// ServiceInstanceOperationResultSucceeded is the result of an operation
+	// that succeeded.
+	ServiceInstanceOperationResultSucceeded ServiceInstanceOperationResult = "Succeeded"
+
+	// ServiceInstanceOperationResultFailed is the result of an operation
+	// that failed for good.
+	ServiceInstanceOperationResultFailed ServiceInstanceOperationResult = "Failed"
+)
+
+// ServiceInstanceOperationRecord records an operation of a ServiceInstance
+// at the broker.
+type ServiceInstanceOperationRecord struct {
+	// Operation is the type of the operation.
+	Operation ServiceInstanceOperation `json:"operation"`
+
+	// StartTime is the time at which the operation began.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time at which the operation completed. It is
+	// not set for the current operation.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Result is the result of the operation.
+	Result ServiceInstanceOperationResult `json:"result"`
+
+	// OperationKey is the operation key returned by the broker for an
+	// asynchronous operation.
+	// +optional
+	OperationKey *string `json:"operationKey,omitempty"`
+}
+
+// ParametersDiff lists the parameters that an update adds, changes and
+// removes. Parameters are identified by their path in the parameters object,
+// with the keys of nested objects separated by dots. Values are never
+// recorded, so a diff does not expose secrets.
+type ParametersDiff struct {
+	// Added are the parameters that the broker did not have.
+	// +optional
+	Added []string `json:"added,omitempty"`
+
+	// Changed are the parameters whose value is changed.
+	// +optional
+	Changed []string `json:"changed,omitempty"`
+
+	// Removed are the parameters that are no longer sent to the broker.
+	// +optional
+	Removed []string `json:"removed,omitempty"`
 }
 
 // ServiceInstanceCondition contains condition information about an Instance.
@@ -1008,6 +1660,44 @@ const (
 	// ServiceInstanceConditionOrphanMitigation represents information about an
 	// orphan mitigation that is required after failed provisioning.
 	ServiceInstanceConditionOrphanMitigation ServiceInstanceConditionType = "OrphanMitigation"
+
+	// ServiceInstanceConditionUnusable represents that the broker reported
+	// the instance as no longer usable after a failed update or deprovision,
+	// so that it may need manual intervention at the broker.
+	ServiceInstanceConditionUnusable ServiceInstanceConditionType = "Unusable"
+
+	// ServiceInstanceConditionRemovedFromCatalog represents that the class or
+	// plan of the instance has been removed from the broker's catalog.
+	ServiceInstanceConditionRemovedFromCatalog ServiceInstanceConditionType = "RemovedFromCatalog"
+
+	// ServiceInstanceConditionReferencedCatalogEntryRemoved represents that
+	// the class or plan referenced by the instance has been removed from the
+	// broker's catalog. Unlike RemovedFromCatalog, it is set regardless of
+	// the CatalogRemovalPolicy of the broker.
+	ServiceInstanceConditionReferencedCatalogEntryRemoved ServiceInstanceConditionType = "ReferencedCatalogEntryRemoved"
+
+	// ServiceInstanceConditionStuckDeleting represents that the instance has
+	// been terminating for longer than expected. The reason and message of
+	// the condition describe what is blocking the deletion.
+	ServiceInstanceConditionStuckDeleting ServiceInstanceConditionType = "StuckDeleting"
+
+	// ServiceInstanceConditionQueued represents that the provision of the
+	// instance is waiting for other instances of its class or plan to finish
+	// provisioning, because the class or plan limits how many instances may be
+	// provisioned at the same time.
+	ServiceInstanceConditionQueued ServiceInstanceConditionType = "Queued"
+
+	// ServiceInstanceConditionThrottled represents that the provision of the
+	// instance is waiting because its namespace has reached the limit on how
+	// many instances may start to be provisioned within a time window.
+	ServiceInstanceConditionThrottled ServiceInstanceConditionType = "Throttled"
+
+	// ServiceInstanceConditionPendingChanges represents that the spec of the
+	// instance has changed while an operation of the instance is in progress.
+	// The changes are applied by an operation that starts once the current
+	// one completes, so that the instance never has overlapping operations
+	// at the broker.
+	ServiceInstanceConditionPendingChanges ServiceInstanceConditionType = "PendingChanges"
 )
 
 // ServiceInstanceOperation represents a type of operation the controller can
@@ -1057,6 +1747,10 @@ type ServiceInstancePropertiesState struct {
 
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
+
+	// Context is the OSB context object that was sent to the broker, such as
+	// the platform, the cluster ID and the namespace of the ServiceInstance.
+	Context *runtime.RawExtension `json:"context,omitempty"`
 }
 
 // ServiceInstanceDeprovisionStatus is the status of deprovisioning a
@@ -1139,6 +1833,17 @@ type ServiceBindingSpec struct {
 	// Immutable.
 	ServiceInstanceRef LocalObjectReference `json:"instanceRef"`
 
+	// InstanceSelector selects the ServiceInstance this ServiceBinding is to
+	// by its labels instead of by name. When it is set and ServiceInstanceRef
+	// is not, the ServiceBindingInstanceSelector admission plugin sets
+	// ServiceInstanceRef to the only instance in the namespace of the
+	// ServiceBinding that it selects when the ServiceBinding is created. The
+	// binding then stays with that instance.
+	//
+	// Immutable.
+	// +optional
+	InstanceSelector *metav1.LabelSelector `json:"instanceSelector,omitempty"`
+
 	// Parameters is a set of the parameters to be passed to the underlying
 	// broker. The inline YAML/JSON payload to be translated into equivalent
 	// JSON object. If a top-level parameter name exists in multiples sources
@@ -1168,6 +1873,32 @@ type ServiceBindingSpec struct {
 	// associated with the ServiceBinding before they are inserted into the Secret.
 	SecretTransforms []SecretTransform `json:"secretTransforms,omitempty"`
 
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// ImmutableSecret makes the controller create the secret holding the
+	// credentials as an immutable Secret. When the credentials change, a new
+	// secret is created and the previous one is deleted, instead of the
+	// secret being updated. The name of the current secret is recorded in
+	// the SecretName of the status.
+	//
+	// Immutable.
+	// +optional
+	ImmutableSecret bool `json:"immutableSecret,omitempty"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// AppRef is the workload, in the namespace of the ServiceBinding, that
+	// consumes the credentials of the binding. The UID of the workload is
+	// sent to the broker as the app identifier of the bind request, so that
+	// brokers can scope credentials to that workload. If it is not set, the
+	// UID of the namespace is sent.
+	//
+	// Immutable.
+	// +optional
+	AppRef *AppReference `json:"appRef,omitempty"`
+
 	// ExternalID is the identity of this object for use with the OSB API.
 	//
 	// Immutable.
@@ -1182,6 +1913,14 @@ type ServiceBindingSpec struct {
 	// settable by the end-user. User-provided values for this field are not saved.
 	// +optional
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
+
+	// DeletionPolicy specifies whether the broker is asked to unbind the
+	// binding when it is deleted. Defaults to Delete. Set it to Abandon to
+	// remove the binding from Kubernetes without calling the broker. Unlike
+	// the rest of the spec, it may be changed after the binding is created,
+	// including while the binding is being deleted.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // ServiceBindingStatus represents the current status of a ServiceBinding.
@@ -1231,6 +1970,47 @@ type ServiceBindingStatus struct {
 
 	// UnbindStatus describes what has been done to unbind the ServiceBinding.
 	UnbindStatus ServiceBindingUnbindStatus `json:"unbindStatus"`
+
+	// FailedUnbindAttempts is the number of unbind requests for the
+	// ServiceBinding that have failed.
+	// +optional
+	FailedUnbindAttempts int64 `json:"failedUnbindAttempts,omitempty"`
+
+	// BindStartedAt is the time at which the controller first sent a bind
+	// request for the ServiceBinding to the broker.
+	BindStartedAt *metav1.Time `json:"bindStartedAt,omitempty"`
+
+	// ReadyAt is the time at which the ServiceBinding was first successfully
+	// bound and had its credentials injected.
+	ReadyAt *metav1.Time `json:"readyAt,omitempty"`
+
+	// LastPollTime is the time at which the controller last recorded a poll
+	// of the broker for the status of an asynchronous operation. It is only
+	// refreshed periodically while the result of the poll stays the same.
+	LastPollTime *metav1.Time `json:"lastPollTime,omitempty"`
+
+	// LastPollResult is a short description of the result of the last poll
+	// of the broker for the status of an asynchronous operation.
+	LastPollResult string `json:"lastPollResult,omitempty"`
+
+	// SecretName is the name of the secret that holds the credentials of
+	// a ServiceBinding with ImmutableSecret. It is empty for other bindings,
+	// whose secret is always the one named in the spec.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// AcceptedParameters are the parameters of the ServiceBinding that the
+	// broker accepted, as echoed by the broker when the binding is fetched.
+	// A parameter that was sourced from a secret has the value "<redacted>".
+	// +optional
+	AcceptedParameters *runtime.RawExtension `json:"acceptedParameters,omitempty"`
+
+	// CredentialsChecksum is the checksum of the credentials in the secret
+	// of the ServiceBinding, which is also set as the
+	// CredentialsChecksumAnnotation of the secret. It changes whenever the
+	// credentials do.
+	// +optional
+	CredentialsChecksum string `json:"credentialsChecksum,omitempty"`
 }
 
 // ServiceBindingCondition condition information for a ServiceBinding.
@@ -1264,6 +2044,11 @@ const (
 	// ServiceBindingConditionFailed represents a ServiceBindingCondition that has failed
 	// completely and should not be retried.
 	ServiceBindingConditionFailed ServiceBindingConditionType = "Failed"
+
+	// ServiceBindingConditionStuckDeleting represents that the binding has
+	// been terminating for longer than expected. The reason and message of
+	// the condition describe what is blocking the deletion.
+	ServiceBindingConditionStuckDeleting ServiceBindingConditionType = "StuckDeleting"
 )
 
 // ServiceBindingOperation represents a type of operation
@@ -1318,14 +2103,48 @@ type ServiceBindingPropertiesState struct {
 
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
+
+	// AppGUID is the app identifier that was sent to the broker.
+	AppGUID string `json:"appGUID,omitempty"`
+}
+
+// AppReference identifies the workload that consumes the credentials of a
+// ServiceBinding.
+type AppReference struct {
+	// Kind of the workload, either Deployment or StatefulSet.
+	Kind AppReferenceKind `json:"kind"`
+
+	// Name of the workload.
+	Name string `json:"name"`
 }
 
+// AppReferenceKind is the kind of the workload an AppReference identifies.
+type AppReferenceKind string
+
+const (
+	// AppReferenceKindDeployment identifies an apps/v1 Deployment.
+	AppReferenceKindDeployment AppReferenceKind = "Deployment"
+
+	// AppReferenceKindStatefulSet identifies an apps/v1 StatefulSet.
+	AppReferenceKindStatefulSet AppReferenceKind = "StatefulSet"
+)
+
 // ParametersFromSource represents the source of a set of Parameters
 type ParametersFromSource struct {
 	// The Secret key to select from.
 	// The value must be a JSON object.
 	// +optional
 	SecretKeyRef *SecretKeyReference `json:"secretKeyRef,omitempty"`
+
+	// The ConfigMap key to select from.
+	// The value must be a JSON object.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeyReference `json:"configMapKeyRef,omitempty"`
+
+	// The HTTPS endpoint to fetch from.
+	// The response body must be a JSON object.
+	// +optional
+	HTTPS *HTTPSParametersSource `json:"https,omitempty"`
 }
 
 // SecretKeyReference references a key of a Secret.
@@ -1336,6 +2155,35 @@ type SecretKeyReference struct {
 	Key string `json:"key"`
 }
 
+// ConfigMapKeyReference references a key of a ConfigMap.
+type ConfigMapKeyReference struct {
+	// The name of the config map in the pod's namespace to select from.
+	Name string `json:"name"`
+	// The key of the config map to select from.  Must be a valid config map
+	// key.
+	Key string `json:"key"`
+}
+
+// HTTPSParametersSource references parameters served by an HTTPS endpoint.
+// The controller fetches them with a GET request each time it sends the
+// parameters to the broker, so they are never stored by the API server.
+type HTTPSParametersSource struct {
+	// URL of the endpoint. The scheme must be https.
+	URL string `json:"url"`
+
+	// CABundle is a PEM encoded CA bundle which will be used to validate the
+	// certificate of the endpoint. If unspecified, the system trust roots
+	// are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// BearerTokenSecretKeyRef references the key of a Secret, in the
+	// namespace of the resource, that holds the bearer token sent to the
+	// endpoint in the Authorization header.
+	// +optional
+	BearerTokenSecretKeyRef *SecretKeyReference `json:"bearerTokenSecretKeyRef,omitempty"`
+}
+
 // ObjectReference contains enough information to let you locate the
 // referenced object.
 type ObjectReference struct {
@@ -1461,3 +2309,109 @@ type RemoveKeyTransform struct {
 	// The key to remove from the Secret
 	Key string `json:"key"`
 }
+
+// AdminUnbindRequest is posted to the adminunbind subresource of a
+// ServiceBinding that is being deleted, to remove the finalizers that keep it
+// waiting to be unbound at the broker, for instance when the broker is
+// permanently gone. The abandonment is recorded as an event of the binding;
+// its credentials are left untouched at the broker.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AdminUnbindRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Confirm must be set to the name of the binding being abandoned.
+	Confirm string `json:"confirm"`
+
+	// Reason is an explanation of why the binding is being abandoned. It is
+	// recorded in the event of the abandonment and in the audit log of the
+	// request.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// ForceDeleteRequest is posted to the forcedelete subresource of a
+// ServiceInstance or ServiceBinding to remove its finalizers and delete it
+// without waiting for the broker. This is an escape hatch for cluster
+// administrators; the broker-side resource is left untouched.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ForceDeleteRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Confirm must be set to the name of the resource being deleted.
+	Confirm string `json:"confirm"`
+
+	// Reason is an explanation of why the resource is being force deleted.
+	// It is recorded in the audit log of the request.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// ServiceInstanceOperations is returned by the operations subresource of a
+// ServiceInstance. It lists the recorded operations of the instance at the
+// broker, oldest first, followed by its current operation, if any.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ServiceInstanceOperations struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// The name and namespace are those of the ServiceInstance.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Items are the operations of the ServiceInstance.
+	Items []ServiceInstanceOperationRecord `json:"items"`
+}
+
+// RollbackRequest is posted to the rollback subresource of a ServiceInstance
+// to revert its plan and parameters to those last accepted by the broker, as
+// recorded in its ExternalProperties. The controller then sends an update
+// request for them to the broker.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RollbackRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Reason is an explanation of why the instance is being rolled back.
+	// It is recorded in the audit log of the request.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// BrokerCatalog is the catalog last fetched from a broker, as served by the
+// catalog subresource of ClusterServiceBrokers and ServiceBrokers. It is
+// stored apart from the broker, so that a large catalog does not grow every
+// read and write of the broker.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BrokerCatalog struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// The name and namespace are those of the broker.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// RetrievalTime is when the catalog was fetched from the broker.
+	RetrievalTime metav1.Time `json:"retrievalTime"`
+
+	// Catalog is the catalog response of the broker, before it was filtered
+	// by the catalog restrictions of the broker, mutated by the catalog
+	// webhook or converted into classes and plans. It holds the fields that
+	// the controller decodes.
+	Catalog *runtime.RawExtension `json:"catalog"`
+}
+
+// RelistRequest is posted to the relist subresource of a ClusterServiceBroker
+// to increment its RelistRequests, so that the controller fetches its catalog
+// again, without reading and writing its spec.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RelistRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Reason is an explanation of why the broker is being relisted. It is
+	// recorded in the audit log of the request.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
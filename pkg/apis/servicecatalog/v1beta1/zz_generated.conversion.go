@@ -41,10 +41,34 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_servicecatalog_AddKeyTransform_To_v1beta1_AddKeyTransform,
 		Convert_v1beta1_AddKeysFromTransform_To_servicecatalog_AddKeysFromTransform,
 		Convert_servicecatalog_AddKeysFromTransform_To_v1beta1_AddKeysFromTransform,
+		Convert_v1beta1_AdminUnbindRequest_To_servicecatalog_AdminUnbindRequest,
+		Convert_servicecatalog_AdminUnbindRequest_To_v1beta1_AdminUnbindRequest,
+		Convert_v1beta1_AppReference_To_servicecatalog_AppReference,
+		Convert_servicecatalog_AppReference_To_v1beta1_AppReference,
+		Convert_v1beta1_AutoBind_To_servicecatalog_AutoBind,
+		Convert_servicecatalog_AutoBind_To_v1beta1_AutoBind,
 		Convert_v1beta1_BasicAuthConfig_To_servicecatalog_BasicAuthConfig,
 		Convert_servicecatalog_BasicAuthConfig_To_v1beta1_BasicAuthConfig,
 		Convert_v1beta1_BearerTokenAuthConfig_To_servicecatalog_BearerTokenAuthConfig,
 		Convert_servicecatalog_BearerTokenAuthConfig_To_v1beta1_BearerTokenAuthConfig,
+		Convert_v1beta1_BrokerCatalog_To_servicecatalog_BrokerCatalog,
+		Convert_servicecatalog_BrokerCatalog_To_v1beta1_BrokerCatalog,
+		Convert_v1beta1_BrokerHeaderValue_To_servicecatalog_BrokerHeaderValue,
+		Convert_servicecatalog_BrokerHeaderValue_To_v1beta1_BrokerHeaderValue,
+		Convert_v1beta1_BrokerSecretKeyReference_To_servicecatalog_BrokerSecretKeyReference,
+		Convert_servicecatalog_BrokerSecretKeyReference_To_v1beta1_BrokerSecretKeyReference,
+		Convert_v1beta1_BrokerTemplate_To_servicecatalog_BrokerTemplate,
+		Convert_servicecatalog_BrokerTemplate_To_v1beta1_BrokerTemplate,
+		Convert_v1beta1_BrokerTemplateList_To_servicecatalog_BrokerTemplateList,
+		Convert_servicecatalog_BrokerTemplateList_To_v1beta1_BrokerTemplateList,
+		Convert_v1beta1_BrokerTemplateSpec_To_servicecatalog_BrokerTemplateSpec,
+		Convert_servicecatalog_BrokerTemplateSpec_To_v1beta1_BrokerTemplateSpec,
+		Convert_v1beta1_CatalogProjection_To_servicecatalog_CatalogProjection,
+		Convert_servicecatalog_CatalogProjection_To_v1beta1_CatalogProjection,
+		Convert_v1beta1_CatalogProjectionList_To_servicecatalog_CatalogProjectionList,
+		Convert_servicecatalog_CatalogProjectionList_To_v1beta1_CatalogProjectionList,
+		Convert_v1beta1_CatalogProjectionSpec_To_servicecatalog_CatalogProjectionSpec,
+		Convert_servicecatalog_CatalogProjectionSpec_To_v1beta1_CatalogProjectionSpec,
 		Convert_v1beta1_CatalogRestrictions_To_servicecatalog_CatalogRestrictions,
 		Convert_servicecatalog_CatalogRestrictions_To_v1beta1_CatalogRestrictions,
 		Convert_v1beta1_ClusterBasicAuthConfig_To_servicecatalog_ClusterBasicAuthConfig,
@@ -91,18 +115,30 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_servicecatalog_CommonServicePlanSpec_To_v1beta1_CommonServicePlanSpec,
 		Convert_v1beta1_CommonServicePlanStatus_To_servicecatalog_CommonServicePlanStatus,
 		Convert_servicecatalog_CommonServicePlanStatus_To_v1beta1_CommonServicePlanStatus,
+		Convert_v1beta1_ConfigMapKeyReference_To_servicecatalog_ConfigMapKeyReference,
+		Convert_servicecatalog_ConfigMapKeyReference_To_v1beta1_ConfigMapKeyReference,
+		Convert_v1beta1_ForceDeleteRequest_To_servicecatalog_ForceDeleteRequest,
+		Convert_servicecatalog_ForceDeleteRequest_To_v1beta1_ForceDeleteRequest,
+		Convert_v1beta1_HTTPSParametersSource_To_servicecatalog_HTTPSParametersSource,
+		Convert_servicecatalog_HTTPSParametersSource_To_v1beta1_HTTPSParametersSource,
 		Convert_v1beta1_LocalObjectReference_To_servicecatalog_LocalObjectReference,
 		Convert_servicecatalog_LocalObjectReference_To_v1beta1_LocalObjectReference,
 		Convert_v1beta1_ObjectReference_To_servicecatalog_ObjectReference,
 		Convert_servicecatalog_ObjectReference_To_v1beta1_ObjectReference,
+		Convert_v1beta1_ParametersDiff_To_servicecatalog_ParametersDiff,
+		Convert_servicecatalog_ParametersDiff_To_v1beta1_ParametersDiff,
 		Convert_v1beta1_ParametersFromSource_To_servicecatalog_ParametersFromSource,
 		Convert_servicecatalog_ParametersFromSource_To_v1beta1_ParametersFromSource,
 		Convert_v1beta1_PlanReference_To_servicecatalog_PlanReference,
 		Convert_servicecatalog_PlanReference_To_v1beta1_PlanReference,
+		Convert_v1beta1_RelistRequest_To_servicecatalog_RelistRequest,
+		Convert_servicecatalog_RelistRequest_To_v1beta1_RelistRequest,
 		Convert_v1beta1_RemoveKeyTransform_To_servicecatalog_RemoveKeyTransform,
 		Convert_servicecatalog_RemoveKeyTransform_To_v1beta1_RemoveKeyTransform,
 		Convert_v1beta1_RenameKeyTransform_To_servicecatalog_RenameKeyTransform,
 		Convert_servicecatalog_RenameKeyTransform_To_v1beta1_RenameKeyTransform,
+		Convert_v1beta1_RollbackRequest_To_servicecatalog_RollbackRequest,
+		Convert_servicecatalog_RollbackRequest_To_v1beta1_RollbackRequest,
 		Convert_v1beta1_SecretKeyReference_To_servicecatalog_SecretKeyReference,
 		Convert_servicecatalog_SecretKeyReference_To_v1beta1_SecretKeyReference,
 		Convert_v1beta1_SecretTransform_To_servicecatalog_SecretTransform,
@@ -131,6 +167,12 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_servicecatalog_ServiceBrokerSpec_To_v1beta1_ServiceBrokerSpec,
 		Convert_v1beta1_ServiceBrokerStatus_To_servicecatalog_ServiceBrokerStatus,
 		Convert_servicecatalog_ServiceBrokerStatus_To_v1beta1_ServiceBrokerStatus,
+		Convert_v1beta1_ServiceBrokerSummary_To_servicecatalog_ServiceBrokerSummary,
+		Convert_servicecatalog_ServiceBrokerSummary_To_v1beta1_ServiceBrokerSummary,
+		Convert_v1beta1_ServiceBrokerSummaryEntry_To_servicecatalog_ServiceBrokerSummaryEntry,
+		Convert_servicecatalog_ServiceBrokerSummaryEntry_To_v1beta1_ServiceBrokerSummaryEntry,
+		Convert_v1beta1_ServiceBrokerSummaryList_To_servicecatalog_ServiceBrokerSummaryList,
+		Convert_servicecatalog_ServiceBrokerSummaryList_To_v1beta1_ServiceBrokerSummaryList,
 		Convert_v1beta1_ServiceClass_To_servicecatalog_ServiceClass,
 		Convert_servicecatalog_ServiceClass_To_v1beta1_ServiceClass,
 		Convert_v1beta1_ServiceClassList_To_servicecatalog_ServiceClassList,
@@ -145,6 +187,12 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_servicecatalog_ServiceInstanceCondition_To_v1beta1_ServiceInstanceCondition,
 		Convert_v1beta1_ServiceInstanceList_To_servicecatalog_ServiceInstanceList,
 		Convert_servicecatalog_ServiceInstanceList_To_v1beta1_ServiceInstanceList,
+		Convert_v1beta1_ServiceInstanceOperationRecord_To_servicecatalog_ServiceInstanceOperationRecord,
+		Convert_servicecatalog_ServiceInstanceOperationRecord_To_v1beta1_ServiceInstanceOperationRecord,
+		Convert_v1beta1_ServiceInstanceOperations_To_servicecatalog_ServiceInstanceOperations,
+		Convert_servicecatalog_ServiceInstanceOperations_To_v1beta1_ServiceInstanceOperations,
+		Convert_v1beta1_ServiceInstancePropertiesSnapshot_To_servicecatalog_ServiceInstancePropertiesSnapshot,
+		Convert_servicecatalog_ServiceInstancePropertiesSnapshot_To_v1beta1_ServiceInstancePropertiesSnapshot,
 		Convert_v1beta1_ServiceInstancePropertiesState_To_servicecatalog_ServiceInstancePropertiesState,
 		Convert_servicecatalog_ServiceInstancePropertiesState_To_v1beta1_ServiceInstancePropertiesState,
 		Convert_v1beta1_ServiceInstanceSpec_To_servicecatalog_ServiceInstanceSpec,
@@ -210,8 +258,77 @@ func Convert_servicecatalog_AddKeysFromTransform_To_v1beta1_AddKeysFromTransform
 	return autoConvert_servicecatalog_AddKeysFromTransform_To_v1beta1_AddKeysFromTransform(in, out, s)
 }
 
+func autoConvert_v1beta1_AdminUnbindRequest_To_servicecatalog_AdminUnbindRequest(in *AdminUnbindRequest, out *servicecatalog.AdminUnbindRequest, s conversion.Scope) error {
+	out.Confirm = in.Confirm
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1beta1_AdminUnbindRequest_To_servicecatalog_AdminUnbindRequest is an autogenerated conversion function.
+func Convert_v1beta1_AdminUnbindRequest_To_servicecatalog_AdminUnbindRequest(in *AdminUnbindRequest, out *servicecatalog.AdminUnbindRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_AdminUnbindRequest_To_servicecatalog_AdminUnbindRequest(in, out, s)
+}
+
+func autoConvert_servicecatalog_AdminUnbindRequest_To_v1beta1_AdminUnbindRequest(in *servicecatalog.AdminUnbindRequest, out *AdminUnbindRequest, s conversion.Scope) error {
+	out.Confirm = in.Confirm
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_servicecatalog_AdminUnbindRequest_To_v1beta1_AdminUnbindRequest is an autogenerated conversion function.
+func Convert_servicecatalog_AdminUnbindRequest_To_v1beta1_AdminUnbindRequest(in *servicecatalog.AdminUnbindRequest, out *AdminUnbindRequest, s conversion.Scope) error {
+	return autoConvert_servicecatalog_AdminUnbindRequest_To_v1beta1_AdminUnbindRequest(in, out, s)
+}
+
+func autoConvert_v1beta1_AppReference_To_servicecatalog_AppReference(in *AppReference, out *servicecatalog.AppReference, s conversion.Scope) error {
+	out.Kind = servicecatalog.AppReferenceKind(in.Kind)
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_AppReference_To_servicecatalog_AppReference is an autogenerated conversion function.
+func Convert_v1beta1_AppReference_To_servicecatalog_AppReference(in *AppReference, out *servicecatalog.AppReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_AppReference_To_servicecatalog_AppReference(in, out, s)
+}
+
+func autoConvert_servicecatalog_AppReference_To_v1beta1_AppReference(in *servicecatalog.AppReference, out *AppReference, s conversion.Scope) error {
+	out.Kind = AppReferenceKind(in.Kind)
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_servicecatalog_AppReference_To_v1beta1_AppReference is an autogenerated conversion function.
+func Convert_servicecatalog_AppReference_To_v1beta1_AppReference(in *servicecatalog.AppReference, out *AppReference, s conversion.Scope) error {
+	return autoConvert_servicecatalog_AppReference_To_v1beta1_AppReference(in, out, s)
+}
+
+func autoConvert_v1beta1_AutoBind_To_servicecatalog_AutoBind(in *AutoBind, out *servicecatalog.AutoBind, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
+	out.ParametersFrom = *(*[]servicecatalog.ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
+	return nil
+}
+
+// Convert_v1beta1_AutoBind_To_servicecatalog_AutoBind is an autogenerated conversion function.
+func Convert_v1beta1_AutoBind_To_servicecatalog_AutoBind(in *AutoBind, out *servicecatalog.AutoBind, s conversion.Scope) error {
+	return autoConvert_v1beta1_AutoBind_To_servicecatalog_AutoBind(in, out, s)
+}
+
+func autoConvert_servicecatalog_AutoBind_To_v1beta1_AutoBind(in *servicecatalog.AutoBind, out *AutoBind, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
+	out.ParametersFrom = *(*[]ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
+	return nil
+}
+
+// Convert_servicecatalog_AutoBind_To_v1beta1_AutoBind is an autogenerated conversion function.
+func Convert_servicecatalog_AutoBind_To_v1beta1_AutoBind(in *servicecatalog.AutoBind, out *AutoBind, s conversion.Scope) error {
+	return autoConvert_servicecatalog_AutoBind_To_v1beta1_AutoBind(in, out, s)
+}
+
 func autoConvert_v1beta1_BasicAuthConfig_To_servicecatalog_BasicAuthConfig(in *BasicAuthConfig, out *servicecatalog.BasicAuthConfig, s conversion.Scope) error {
 	out.SecretRef = (*servicecatalog.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretNamespace = in.SecretNamespace
 	return nil
 }
 
@@ -222,6 +339,7 @@ func Convert_v1beta1_BasicAuthConfig_To_servicecatalog_BasicAuthConfig(in *Basic
 
 func autoConvert_servicecatalog_BasicAuthConfig_To_v1beta1_BasicAuthConfig(in *servicecatalog.BasicAuthConfig, out *BasicAuthConfig, s conversion.Scope) error {
 	out.SecretRef = (*LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretNamespace = in.SecretNamespace
 	return nil
 }
 
@@ -232,6 +350,7 @@ func Convert_servicecatalog_BasicAuthConfig_To_v1beta1_BasicAuthConfig(in *servi
 
 func autoConvert_v1beta1_BearerTokenAuthConfig_To_servicecatalog_BearerTokenAuthConfig(in *BearerTokenAuthConfig, out *servicecatalog.BearerTokenAuthConfig, s conversion.Scope) error {
 	out.SecretRef = (*servicecatalog.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretNamespace = in.SecretNamespace
 	return nil
 }
 
@@ -242,6 +361,7 @@ func Convert_v1beta1_BearerTokenAuthConfig_To_servicecatalog_BearerTokenAuthConf
 
 func autoConvert_servicecatalog_BearerTokenAuthConfig_To_v1beta1_BearerTokenAuthConfig(in *servicecatalog.BearerTokenAuthConfig, out *BearerTokenAuthConfig, s conversion.Scope) error {
 	out.SecretRef = (*LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretNamespace = in.SecretNamespace
 	return nil
 }
 
@@ -250,9 +370,227 @@ func Convert_servicecatalog_BearerTokenAuthConfig_To_v1beta1_BearerTokenAuthConf
 	return autoConvert_servicecatalog_BearerTokenAuthConfig_To_v1beta1_BearerTokenAuthConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_BrokerCatalog_To_servicecatalog_BrokerCatalog(in *BrokerCatalog, out *servicecatalog.BrokerCatalog, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.RetrievalTime = in.RetrievalTime
+	out.Catalog = (*runtime.RawExtension)(unsafe.Pointer(in.Catalog))
+	return nil
+}
+
+// Convert_v1beta1_BrokerCatalog_To_servicecatalog_BrokerCatalog is an autogenerated conversion function.
+func Convert_v1beta1_BrokerCatalog_To_servicecatalog_BrokerCatalog(in *BrokerCatalog, out *servicecatalog.BrokerCatalog, s conversion.Scope) error {
+	return autoConvert_v1beta1_BrokerCatalog_To_servicecatalog_BrokerCatalog(in, out, s)
+}
+
+func autoConvert_servicecatalog_BrokerCatalog_To_v1beta1_BrokerCatalog(in *servicecatalog.BrokerCatalog, out *BrokerCatalog, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.RetrievalTime = in.RetrievalTime
+	out.Catalog = (*runtime.RawExtension)(unsafe.Pointer(in.Catalog))
+	return nil
+}
+
+// Convert_servicecatalog_BrokerCatalog_To_v1beta1_BrokerCatalog is an autogenerated conversion function.
+func Convert_servicecatalog_BrokerCatalog_To_v1beta1_BrokerCatalog(in *servicecatalog.BrokerCatalog, out *BrokerCatalog, s conversion.Scope) error {
+	return autoConvert_servicecatalog_BrokerCatalog_To_v1beta1_BrokerCatalog(in, out, s)
+}
+
+func autoConvert_v1beta1_BrokerHeaderValue_To_servicecatalog_BrokerHeaderValue(in *BrokerHeaderValue, out *servicecatalog.BrokerHeaderValue, s conversion.Scope) error {
+	out.Value = in.Value
+	out.SecretKeyRef = (*servicecatalog.BrokerSecretKeyReference)(unsafe.Pointer(in.SecretKeyRef))
+	return nil
+}
+
+// Convert_v1beta1_BrokerHeaderValue_To_servicecatalog_BrokerHeaderValue is an autogenerated conversion function.
+func Convert_v1beta1_BrokerHeaderValue_To_servicecatalog_BrokerHeaderValue(in *BrokerHeaderValue, out *servicecatalog.BrokerHeaderValue, s conversion.Scope) error {
+	return autoConvert_v1beta1_BrokerHeaderValue_To_servicecatalog_BrokerHeaderValue(in, out, s)
+}
+
+func autoConvert_servicecatalog_BrokerHeaderValue_To_v1beta1_BrokerHeaderValue(in *servicecatalog.BrokerHeaderValue, out *BrokerHeaderValue, s conversion.Scope) error {
+	out.Value = in.Value
+	out.SecretKeyRef = (*BrokerSecretKeyReference)(unsafe.Pointer(in.SecretKeyRef))
+	return nil
+}
+
+// Convert_servicecatalog_BrokerHeaderValue_To_v1beta1_BrokerHeaderValue is an autogenerated conversion function.
+func Convert_servicecatalog_BrokerHeaderValue_To_v1beta1_BrokerHeaderValue(in *servicecatalog.BrokerHeaderValue, out *BrokerHeaderValue, s conversion.Scope) error {
+	return autoConvert_servicecatalog_BrokerHeaderValue_To_v1beta1_BrokerHeaderValue(in, out, s)
+}
+
+func autoConvert_v1beta1_BrokerSecretKeyReference_To_servicecatalog_BrokerSecretKeyReference(in *BrokerSecretKeyReference, out *servicecatalog.BrokerSecretKeyReference, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1beta1_BrokerSecretKeyReference_To_servicecatalog_BrokerSecretKeyReference is an autogenerated conversion function.
+func Convert_v1beta1_BrokerSecretKeyReference_To_servicecatalog_BrokerSecretKeyReference(in *BrokerSecretKeyReference, out *servicecatalog.BrokerSecretKeyReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_BrokerSecretKeyReference_To_servicecatalog_BrokerSecretKeyReference(in, out, s)
+}
+
+func autoConvert_servicecatalog_BrokerSecretKeyReference_To_v1beta1_BrokerSecretKeyReference(in *servicecatalog.BrokerSecretKeyReference, out *BrokerSecretKeyReference, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_servicecatalog_BrokerSecretKeyReference_To_v1beta1_BrokerSecretKeyReference is an autogenerated conversion function.
+func Convert_servicecatalog_BrokerSecretKeyReference_To_v1beta1_BrokerSecretKeyReference(in *servicecatalog.BrokerSecretKeyReference, out *BrokerSecretKeyReference, s conversion.Scope) error {
+	return autoConvert_servicecatalog_BrokerSecretKeyReference_To_v1beta1_BrokerSecretKeyReference(in, out, s)
+}
+
+func autoConvert_v1beta1_BrokerTemplate_To_servicecatalog_BrokerTemplate(in *BrokerTemplate, out *servicecatalog.BrokerTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_BrokerTemplateSpec_To_servicecatalog_BrokerTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_BrokerTemplate_To_servicecatalog_BrokerTemplate is an autogenerated conversion function.
+func Convert_v1beta1_BrokerTemplate_To_servicecatalog_BrokerTemplate(in *BrokerTemplate, out *servicecatalog.BrokerTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_BrokerTemplate_To_servicecatalog_BrokerTemplate(in, out, s)
+}
+
+func autoConvert_servicecatalog_BrokerTemplate_To_v1beta1_BrokerTemplate(in *servicecatalog.BrokerTemplate, out *BrokerTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_servicecatalog_BrokerTemplateSpec_To_v1beta1_BrokerTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_servicecatalog_BrokerTemplate_To_v1beta1_BrokerTemplate is an autogenerated conversion function.
+func Convert_servicecatalog_BrokerTemplate_To_v1beta1_BrokerTemplate(in *servicecatalog.BrokerTemplate, out *BrokerTemplate, s conversion.Scope) error {
+	return autoConvert_servicecatalog_BrokerTemplate_To_v1beta1_BrokerTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_BrokerTemplateList_To_servicecatalog_BrokerTemplateList(in *BrokerTemplateList, out *servicecatalog.BrokerTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]servicecatalog.BrokerTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_BrokerTemplateList_To_servicecatalog_BrokerTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_BrokerTemplateList_To_servicecatalog_BrokerTemplateList(in *BrokerTemplateList, out *servicecatalog.BrokerTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_BrokerTemplateList_To_servicecatalog_BrokerTemplateList(in, out, s)
+}
+
+func autoConvert_servicecatalog_BrokerTemplateList_To_v1beta1_BrokerTemplateList(in *servicecatalog.BrokerTemplateList, out *BrokerTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]BrokerTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_servicecatalog_BrokerTemplateList_To_v1beta1_BrokerTemplateList is an autogenerated conversion function.
+func Convert_servicecatalog_BrokerTemplateList_To_v1beta1_BrokerTemplateList(in *servicecatalog.BrokerTemplateList, out *BrokerTemplateList, s conversion.Scope) error {
+	return autoConvert_servicecatalog_BrokerTemplateList_To_v1beta1_BrokerTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta1_BrokerTemplateSpec_To_servicecatalog_BrokerTemplateSpec(in *BrokerTemplateSpec, out *servicecatalog.BrokerTemplateSpec, s conversion.Scope) error {
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.BrokerName = in.BrokerName
+	if err := Convert_v1beta1_ServiceBrokerSpec_To_servicecatalog_ServiceBrokerSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_BrokerTemplateSpec_To_servicecatalog_BrokerTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_BrokerTemplateSpec_To_servicecatalog_BrokerTemplateSpec(in *BrokerTemplateSpec, out *servicecatalog.BrokerTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_BrokerTemplateSpec_To_servicecatalog_BrokerTemplateSpec(in, out, s)
+}
+
+func autoConvert_servicecatalog_BrokerTemplateSpec_To_v1beta1_BrokerTemplateSpec(in *servicecatalog.BrokerTemplateSpec, out *BrokerTemplateSpec, s conversion.Scope) error {
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.BrokerName = in.BrokerName
+	if err := Convert_servicecatalog_ServiceBrokerSpec_To_v1beta1_ServiceBrokerSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_servicecatalog_BrokerTemplateSpec_To_v1beta1_BrokerTemplateSpec is an autogenerated conversion function.
+func Convert_servicecatalog_BrokerTemplateSpec_To_v1beta1_BrokerTemplateSpec(in *servicecatalog.BrokerTemplateSpec, out *BrokerTemplateSpec, s conversion.Scope) error {
+	return autoConvert_servicecatalog_BrokerTemplateSpec_To_v1beta1_BrokerTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_CatalogProjection_To_servicecatalog_CatalogProjection(in *CatalogProjection, out *servicecatalog.CatalogProjection, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_CatalogProjectionSpec_To_servicecatalog_CatalogProjectionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_CatalogProjection_To_servicecatalog_CatalogProjection is an autogenerated conversion function.
+func Convert_v1beta1_CatalogProjection_To_servicecatalog_CatalogProjection(in *CatalogProjection, out *servicecatalog.CatalogProjection, s conversion.Scope) error {
+	return autoConvert_v1beta1_CatalogProjection_To_servicecatalog_CatalogProjection(in, out, s)
+}
+
+func autoConvert_servicecatalog_CatalogProjection_To_v1beta1_CatalogProjection(in *servicecatalog.CatalogProjection, out *CatalogProjection, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_servicecatalog_CatalogProjectionSpec_To_v1beta1_CatalogProjectionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_servicecatalog_CatalogProjection_To_v1beta1_CatalogProjection is an autogenerated conversion function.
+func Convert_servicecatalog_CatalogProjection_To_v1beta1_CatalogProjection(in *servicecatalog.CatalogProjection, out *CatalogProjection, s conversion.Scope) error {
+	return autoConvert_servicecatalog_CatalogProjection_To_v1beta1_CatalogProjection(in, out, s)
+}
+
+func autoConvert_v1beta1_CatalogProjectionList_To_servicecatalog_CatalogProjectionList(in *CatalogProjectionList, out *servicecatalog.CatalogProjectionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]servicecatalog.CatalogProjection)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_CatalogProjectionList_To_servicecatalog_CatalogProjectionList is an autogenerated conversion function.
+func Convert_v1beta1_CatalogProjectionList_To_servicecatalog_CatalogProjectionList(in *CatalogProjectionList, out *servicecatalog.CatalogProjectionList, s conversion.Scope) error {
+	return autoConvert_v1beta1_CatalogProjectionList_To_servicecatalog_CatalogProjectionList(in, out, s)
+}
+
+func autoConvert_servicecatalog_CatalogProjectionList_To_v1beta1_CatalogProjectionList(in *servicecatalog.CatalogProjectionList, out *CatalogProjectionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]CatalogProjection)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_servicecatalog_CatalogProjectionList_To_v1beta1_CatalogProjectionList is an autogenerated conversion function.
+func Convert_servicecatalog_CatalogProjectionList_To_v1beta1_CatalogProjectionList(in *servicecatalog.CatalogProjectionList, out *CatalogProjectionList, s conversion.Scope) error {
+	return autoConvert_servicecatalog_CatalogProjectionList_To_v1beta1_CatalogProjectionList(in, out, s)
+}
+
+func autoConvert_v1beta1_CatalogProjectionSpec_To_servicecatalog_CatalogProjectionSpec(in *CatalogProjectionSpec, out *servicecatalog.CatalogProjectionSpec, s conversion.Scope) error {
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.CatalogRestrictions = (*servicecatalog.CatalogRestrictions)(unsafe.Pointer(in.CatalogRestrictions))
+	return nil
+}
+
+// Convert_v1beta1_CatalogProjectionSpec_To_servicecatalog_CatalogProjectionSpec is an autogenerated conversion function.
+func Convert_v1beta1_CatalogProjectionSpec_To_servicecatalog_CatalogProjectionSpec(in *CatalogProjectionSpec, out *servicecatalog.CatalogProjectionSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_CatalogProjectionSpec_To_servicecatalog_CatalogProjectionSpec(in, out, s)
+}
+
+func autoConvert_servicecatalog_CatalogProjectionSpec_To_v1beta1_CatalogProjectionSpec(in *servicecatalog.CatalogProjectionSpec, out *CatalogProjectionSpec, s conversion.Scope) error {
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.CatalogRestrictions = (*CatalogRestrictions)(unsafe.Pointer(in.CatalogRestrictions))
+	return nil
+}
+
+// Convert_servicecatalog_CatalogProjectionSpec_To_v1beta1_CatalogProjectionSpec is an autogenerated conversion function.
+func Convert_servicecatalog_CatalogProjectionSpec_To_v1beta1_CatalogProjectionSpec(in *servicecatalog.CatalogProjectionSpec, out *CatalogProjectionSpec, s conversion.Scope) error {
+	return autoConvert_servicecatalog_CatalogProjectionSpec_To_v1beta1_CatalogProjectionSpec(in, out, s)
+}
+
 func autoConvert_v1beta1_CatalogRestrictions_To_servicecatalog_CatalogRestrictions(in *CatalogRestrictions, out *servicecatalog.CatalogRestrictions, s conversion.Scope) error {
 	out.ServiceClass = *(*[]string)(unsafe.Pointer(&in.ServiceClass))
 	out.ServicePlan = *(*[]string)(unsafe.Pointer(&in.ServicePlan))
+	out.ServiceClassExternalIDs = *(*[]string)(unsafe.Pointer(&in.ServiceClassExternalIDs))
+	out.ServicePlanExternalIDs = *(*[]string)(unsafe.Pointer(&in.ServicePlanExternalIDs))
 	return nil
 }
 
@@ -264,6 +602,8 @@ func Convert_v1beta1_CatalogRestrictions_To_servicecatalog_CatalogRestrictions(i
 func autoConvert_servicecatalog_CatalogRestrictions_To_v1beta1_CatalogRestrictions(in *servicecatalog.CatalogRestrictions, out *CatalogRestrictions, s conversion.Scope) error {
 	out.ServicePlan = *(*[]string)(unsafe.Pointer(&in.ServicePlan))
 	out.ServiceClass = *(*[]string)(unsafe.Pointer(&in.ServiceClass))
+	out.ServiceClassExternalIDs = *(*[]string)(unsafe.Pointer(&in.ServiceClassExternalIDs))
+	out.ServicePlanExternalIDs = *(*[]string)(unsafe.Pointer(&in.ServicePlanExternalIDs))
 	return nil
 }
 
@@ -680,6 +1020,13 @@ func autoConvert_v1beta1_CommonServiceBrokerSpec_To_servicecatalog_CommonService
 	out.RelistDuration = (*v1.Duration)(unsafe.Pointer(in.RelistDuration))
 	out.RelistRequests = in.RelistRequests
 	out.CatalogRestrictions = (*servicecatalog.CatalogRestrictions)(unsafe.Pointer(in.CatalogRestrictions))
+	out.CatalogRemovalPolicy = servicecatalog.CatalogRemovalPolicy(in.CatalogRemovalPolicy)
+	out.CatalogRemovalDeprovisionDelay = (*v1.Duration)(unsafe.Pointer(in.CatalogRemovalDeprovisionDelay))
+	out.CatalogRemovalGracePeriod = (*v1.Duration)(unsafe.Pointer(in.CatalogRemovalGracePeriod))
+	out.MirrorURL = in.MirrorURL
+	out.FailoverURLs = *(*[]string)(unsafe.Pointer(&in.FailoverURLs))
+	out.ReadOnly = in.ReadOnly
+	out.CustomHeaders = *(*map[string]servicecatalog.BrokerHeaderValue)(unsafe.Pointer(&in.CustomHeaders))
 	return nil
 }
 
@@ -696,6 +1043,13 @@ func autoConvert_servicecatalog_CommonServiceBrokerSpec_To_v1beta1_CommonService
 	out.RelistDuration = (*v1.Duration)(unsafe.Pointer(in.RelistDuration))
 	out.RelistRequests = in.RelistRequests
 	out.CatalogRestrictions = (*CatalogRestrictions)(unsafe.Pointer(in.CatalogRestrictions))
+	out.CatalogRemovalPolicy = CatalogRemovalPolicy(in.CatalogRemovalPolicy)
+	out.CatalogRemovalDeprovisionDelay = (*v1.Duration)(unsafe.Pointer(in.CatalogRemovalDeprovisionDelay))
+	out.CatalogRemovalGracePeriod = (*v1.Duration)(unsafe.Pointer(in.CatalogRemovalGracePeriod))
+	out.MirrorURL = in.MirrorURL
+	out.FailoverURLs = *(*[]string)(unsafe.Pointer(&in.FailoverURLs))
+	out.ReadOnly = in.ReadOnly
+	out.CustomHeaders = *(*map[string]BrokerHeaderValue)(unsafe.Pointer(&in.CustomHeaders))
 	return nil
 }
 
@@ -709,6 +1063,14 @@ func autoConvert_v1beta1_CommonServiceBrokerStatus_To_servicecatalog_CommonServi
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
 	out.LastCatalogRetrievalTime = (*v1.Time)(unsafe.Pointer(in.LastCatalogRetrievalTime))
+	out.ClassCount = in.ClassCount
+	out.PlanCount = in.PlanCount
+	out.LastCatalogChecksum = in.LastCatalogChecksum
+	out.OSBAPIVersion = in.OSBAPIVersion
+	out.LastRelistDuration = (*v1.Duration)(unsafe.Pointer(in.LastRelistDuration))
+	out.ObservedRelistRequests = in.ObservedRelistRequests
+	out.ObservedRelistRequestedAt = in.ObservedRelistRequestedAt
+	out.AsyncBindingOperationsRequired = in.AsyncBindingOperationsRequired
 	return nil
 }
 
@@ -722,6 +1084,14 @@ func autoConvert_servicecatalog_CommonServiceBrokerStatus_To_v1beta1_CommonServi
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
 	out.LastCatalogRetrievalTime = (*v1.Time)(unsafe.Pointer(in.LastCatalogRetrievalTime))
+	out.ClassCount = in.ClassCount
+	out.PlanCount = in.PlanCount
+	out.LastCatalogChecksum = in.LastCatalogChecksum
+	out.OSBAPIVersion = in.OSBAPIVersion
+	out.LastRelistDuration = (*v1.Duration)(unsafe.Pointer(in.LastRelistDuration))
+	out.ObservedRelistRequests = in.ObservedRelistRequests
+	out.ObservedRelistRequestedAt = in.ObservedRelistRequestedAt
+	out.AsyncBindingOperationsRequired = in.AsyncBindingOperationsRequired
 	return nil
 }
 
@@ -736,8 +1106,11 @@ func autoConvert_v1beta1_CommonServiceClassSpec_To_servicecatalog_CommonServiceC
 	out.Description = in.Description
 	out.Bindable = in.Bindable
 	out.BindingRetrievable = in.BindingRetrievable
+	out.InstancesRetrievable = in.InstancesRetrievable
 	out.PlanUpdatable = in.PlanUpdatable
 	out.ExternalMetadata = (*runtime.RawExtension)(unsafe.Pointer(in.ExternalMetadata))
+	out.DocumentationURL = in.DocumentationURL
+	out.SupportURL = in.SupportURL
 	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
 	out.Requires = *(*[]string)(unsafe.Pointer(&in.Requires))
 	return nil
@@ -754,8 +1127,11 @@ func autoConvert_servicecatalog_CommonServiceClassSpec_To_v1beta1_CommonServiceC
 	out.Description = in.Description
 	out.Bindable = in.Bindable
 	out.BindingRetrievable = in.BindingRetrievable
+	out.InstancesRetrievable = in.InstancesRetrievable
 	out.PlanUpdatable = in.PlanUpdatable
 	out.ExternalMetadata = (*runtime.RawExtension)(unsafe.Pointer(in.ExternalMetadata))
+	out.DocumentationURL = in.DocumentationURL
+	out.SupportURL = in.SupportURL
 	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
 	out.Requires = *(*[]string)(unsafe.Pointer(&in.Requires))
 	return nil
@@ -768,6 +1144,8 @@ func Convert_servicecatalog_CommonServiceClassSpec_To_v1beta1_CommonServiceClass
 
 func autoConvert_v1beta1_CommonServiceClassStatus_To_servicecatalog_CommonServiceClassStatus(in *CommonServiceClassStatus, out *servicecatalog.CommonServiceClassStatus, s conversion.Scope) error {
 	out.RemovedFromBrokerCatalog = in.RemovedFromBrokerCatalog
+	out.PendingRemoval = in.PendingRemoval
+	out.RemovalTime = (*v1.Time)(unsafe.Pointer(in.RemovalTime))
 	return nil
 }
 
@@ -778,6 +1156,8 @@ func Convert_v1beta1_CommonServiceClassStatus_To_servicecatalog_CommonServiceCla
 
 func autoConvert_servicecatalog_CommonServiceClassStatus_To_v1beta1_CommonServiceClassStatus(in *servicecatalog.CommonServiceClassStatus, out *CommonServiceClassStatus, s conversion.Scope) error {
 	out.RemovedFromBrokerCatalog = in.RemovedFromBrokerCatalog
+	out.PendingRemoval = in.PendingRemoval
+	out.RemovalTime = (*v1.Time)(unsafe.Pointer(in.RemovalTime))
 	return nil
 }
 
@@ -793,6 +1173,8 @@ func autoConvert_v1beta1_CommonServicePlanSpec_To_servicecatalog_CommonServicePl
 	out.Bindable = (*bool)(unsafe.Pointer(in.Bindable))
 	out.Free = in.Free
 	out.ExternalMetadata = (*runtime.RawExtension)(unsafe.Pointer(in.ExternalMetadata))
+	out.DocumentationURL = in.DocumentationURL
+	out.SupportURL = in.SupportURL
 	out.ServiceInstanceCreateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceInstanceCreateParameterSchema))
 	out.ServiceInstanceUpdateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceInstanceUpdateParameterSchema))
 	out.ServiceBindingCreateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceBindingCreateParameterSchema))
@@ -812,6 +1194,8 @@ func autoConvert_servicecatalog_CommonServicePlanSpec_To_v1beta1_CommonServicePl
 	out.Bindable = (*bool)(unsafe.Pointer(in.Bindable))
 	out.Free = in.Free
 	out.ExternalMetadata = (*runtime.RawExtension)(unsafe.Pointer(in.ExternalMetadata))
+	out.DocumentationURL = in.DocumentationURL
+	out.SupportURL = in.SupportURL
 	out.ServiceInstanceCreateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceInstanceCreateParameterSchema))
 	out.ServiceInstanceUpdateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceInstanceUpdateParameterSchema))
 	out.ServiceBindingCreateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceBindingCreateParameterSchema))
@@ -826,6 +1210,8 @@ func Convert_servicecatalog_CommonServicePlanSpec_To_v1beta1_CommonServicePlanSp
 
 func autoConvert_v1beta1_CommonServicePlanStatus_To_servicecatalog_CommonServicePlanStatus(in *CommonServicePlanStatus, out *servicecatalog.CommonServicePlanStatus, s conversion.Scope) error {
 	out.RemovedFromBrokerCatalog = in.RemovedFromBrokerCatalog
+	out.PendingRemoval = in.PendingRemoval
+	out.RemovalTime = (*v1.Time)(unsafe.Pointer(in.RemovalTime))
 	return nil
 }
 
@@ -836,6 +1222,8 @@ func Convert_v1beta1_CommonServicePlanStatus_To_servicecatalog_CommonServicePlan
 
 func autoConvert_servicecatalog_CommonServicePlanStatus_To_v1beta1_CommonServicePlanStatus(in *servicecatalog.CommonServicePlanStatus, out *CommonServicePlanStatus, s conversion.Scope) error {
 	out.RemovedFromBrokerCatalog = in.RemovedFromBrokerCatalog
+	out.PendingRemoval = in.PendingRemoval
+	out.RemovalTime = (*v1.Time)(unsafe.Pointer(in.RemovalTime))
 	return nil
 }
 
@@ -844,6 +1232,74 @@ func Convert_servicecatalog_CommonServicePlanStatus_To_v1beta1_CommonServicePlan
 	return autoConvert_servicecatalog_CommonServicePlanStatus_To_v1beta1_CommonServicePlanStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ConfigMapKeyReference_To_servicecatalog_ConfigMapKeyReference(in *ConfigMapKeyReference, out *servicecatalog.ConfigMapKeyReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1beta1_ConfigMapKeyReference_To_servicecatalog_ConfigMapKeyReference is an autogenerated conversion function.
+func Convert_v1beta1_ConfigMapKeyReference_To_servicecatalog_ConfigMapKeyReference(in *ConfigMapKeyReference, out *servicecatalog.ConfigMapKeyReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ConfigMapKeyReference_To_servicecatalog_ConfigMapKeyReference(in, out, s)
+}
+
+func autoConvert_servicecatalog_ConfigMapKeyReference_To_v1beta1_ConfigMapKeyReference(in *servicecatalog.ConfigMapKeyReference, out *ConfigMapKeyReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_servicecatalog_ConfigMapKeyReference_To_v1beta1_ConfigMapKeyReference is an autogenerated conversion function.
+func Convert_servicecatalog_ConfigMapKeyReference_To_v1beta1_ConfigMapKeyReference(in *servicecatalog.ConfigMapKeyReference, out *ConfigMapKeyReference, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ConfigMapKeyReference_To_v1beta1_ConfigMapKeyReference(in, out, s)
+}
+
+func autoConvert_v1beta1_ForceDeleteRequest_To_servicecatalog_ForceDeleteRequest(in *ForceDeleteRequest, out *servicecatalog.ForceDeleteRequest, s conversion.Scope) error {
+	out.Confirm = in.Confirm
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1beta1_ForceDeleteRequest_To_servicecatalog_ForceDeleteRequest is an autogenerated conversion function.
+func Convert_v1beta1_ForceDeleteRequest_To_servicecatalog_ForceDeleteRequest(in *ForceDeleteRequest, out *servicecatalog.ForceDeleteRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_ForceDeleteRequest_To_servicecatalog_ForceDeleteRequest(in, out, s)
+}
+
+func autoConvert_servicecatalog_ForceDeleteRequest_To_v1beta1_ForceDeleteRequest(in *servicecatalog.ForceDeleteRequest, out *ForceDeleteRequest, s conversion.Scope) error {
+	out.Confirm = in.Confirm
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_servicecatalog_ForceDeleteRequest_To_v1beta1_ForceDeleteRequest is an autogenerated conversion function.
+func Convert_servicecatalog_ForceDeleteRequest_To_v1beta1_ForceDeleteRequest(in *servicecatalog.ForceDeleteRequest, out *ForceDeleteRequest, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ForceDeleteRequest_To_v1beta1_ForceDeleteRequest(in, out, s)
+}
+
+func autoConvert_v1beta1_HTTPSParametersSource_To_servicecatalog_HTTPSParametersSource(in *HTTPSParametersSource, out *servicecatalog.HTTPSParametersSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.BearerTokenSecretKeyRef = (*servicecatalog.SecretKeyReference)(unsafe.Pointer(in.BearerTokenSecretKeyRef))
+	return nil
+}
+
+// Convert_v1beta1_HTTPSParametersSource_To_servicecatalog_HTTPSParametersSource is an autogenerated conversion function.
+func Convert_v1beta1_HTTPSParametersSource_To_servicecatalog_HTTPSParametersSource(in *HTTPSParametersSource, out *servicecatalog.HTTPSParametersSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_HTTPSParametersSource_To_servicecatalog_HTTPSParametersSource(in, out, s)
+}
+
+func autoConvert_servicecatalog_HTTPSParametersSource_To_v1beta1_HTTPSParametersSource(in *servicecatalog.HTTPSParametersSource, out *HTTPSParametersSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.BearerTokenSecretKeyRef = (*SecretKeyReference)(unsafe.Pointer(in.BearerTokenSecretKeyRef))
+	return nil
+}
+
+// Convert_servicecatalog_HTTPSParametersSource_To_v1beta1_HTTPSParametersSource is an autogenerated conversion function.
+func Convert_servicecatalog_HTTPSParametersSource_To_v1beta1_HTTPSParametersSource(in *servicecatalog.HTTPSParametersSource, out *HTTPSParametersSource, s conversion.Scope) error {
+	return autoConvert_servicecatalog_HTTPSParametersSource_To_v1beta1_HTTPSParametersSource(in, out, s)
+}
+
 func autoConvert_v1beta1_LocalObjectReference_To_servicecatalog_LocalObjectReference(in *LocalObjectReference, out *servicecatalog.LocalObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -886,8 +1342,34 @@ func Convert_servicecatalog_ObjectReference_To_v1beta1_ObjectReference(in *servi
 	return autoConvert_servicecatalog_ObjectReference_To_v1beta1_ObjectReference(in, out, s)
 }
 
+func autoConvert_v1beta1_ParametersDiff_To_servicecatalog_ParametersDiff(in *ParametersDiff, out *servicecatalog.ParametersDiff, s conversion.Scope) error {
+	out.Added = *(*[]string)(unsafe.Pointer(&in.Added))
+	out.Changed = *(*[]string)(unsafe.Pointer(&in.Changed))
+	out.Removed = *(*[]string)(unsafe.Pointer(&in.Removed))
+	return nil
+}
+
+// Convert_v1beta1_ParametersDiff_To_servicecatalog_ParametersDiff is an autogenerated conversion function.
+func Convert_v1beta1_ParametersDiff_To_servicecatalog_ParametersDiff(in *ParametersDiff, out *servicecatalog.ParametersDiff, s conversion.Scope) error {
+	return autoConvert_v1beta1_ParametersDiff_To_servicecatalog_ParametersDiff(in, out, s)
+}
+
+func autoConvert_servicecatalog_ParametersDiff_To_v1beta1_ParametersDiff(in *servicecatalog.ParametersDiff, out *ParametersDiff, s conversion.Scope) error {
+	out.Added = *(*[]string)(unsafe.Pointer(&in.Added))
+	out.Changed = *(*[]string)(unsafe.Pointer(&in.Changed))
+	out.Removed = *(*[]string)(unsafe.Pointer(&in.Removed))
+	return nil
+}
+
+// Convert_servicecatalog_ParametersDiff_To_v1beta1_ParametersDiff is an autogenerated conversion function.
+func Convert_servicecatalog_ParametersDiff_To_v1beta1_ParametersDiff(in *servicecatalog.ParametersDiff, out *ParametersDiff, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ParametersDiff_To_v1beta1_ParametersDiff(in, out, s)
+}
+
 func autoConvert_v1beta1_ParametersFromSource_To_servicecatalog_ParametersFromSource(in *ParametersFromSource, out *servicecatalog.ParametersFromSource, s conversion.Scope) error {
 	out.SecretKeyRef = (*servicecatalog.SecretKeyReference)(unsafe.Pointer(in.SecretKeyRef))
+	out.ConfigMapKeyRef = (*servicecatalog.ConfigMapKeyReference)(unsafe.Pointer(in.ConfigMapKeyRef))
+	out.HTTPS = (*servicecatalog.HTTPSParametersSource)(unsafe.Pointer(in.HTTPS))
 	return nil
 }
 
@@ -898,6 +1380,8 @@ func Convert_v1beta1_ParametersFromSource_To_servicecatalog_ParametersFromSource
 
 func autoConvert_servicecatalog_ParametersFromSource_To_v1beta1_ParametersFromSource(in *servicecatalog.ParametersFromSource, out *ParametersFromSource, s conversion.Scope) error {
 	out.SecretKeyRef = (*SecretKeyReference)(unsafe.Pointer(in.SecretKeyRef))
+	out.ConfigMapKeyRef = (*ConfigMapKeyReference)(unsafe.Pointer(in.ConfigMapKeyRef))
+	out.HTTPS = (*HTTPSParametersSource)(unsafe.Pointer(in.HTTPS))
 	return nil
 }
 
@@ -948,6 +1432,26 @@ func Convert_servicecatalog_PlanReference_To_v1beta1_PlanReference(in *serviceca
 	return autoConvert_servicecatalog_PlanReference_To_v1beta1_PlanReference(in, out, s)
 }
 
+func autoConvert_v1beta1_RelistRequest_To_servicecatalog_RelistRequest(in *RelistRequest, out *servicecatalog.RelistRequest, s conversion.Scope) error {
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1beta1_RelistRequest_To_servicecatalog_RelistRequest is an autogenerated conversion function.
+func Convert_v1beta1_RelistRequest_To_servicecatalog_RelistRequest(in *RelistRequest, out *servicecatalog.RelistRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_RelistRequest_To_servicecatalog_RelistRequest(in, out, s)
+}
+
+func autoConvert_servicecatalog_RelistRequest_To_v1beta1_RelistRequest(in *servicecatalog.RelistRequest, out *RelistRequest, s conversion.Scope) error {
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_servicecatalog_RelistRequest_To_v1beta1_RelistRequest is an autogenerated conversion function.
+func Convert_servicecatalog_RelistRequest_To_v1beta1_RelistRequest(in *servicecatalog.RelistRequest, out *RelistRequest, s conversion.Scope) error {
+	return autoConvert_servicecatalog_RelistRequest_To_v1beta1_RelistRequest(in, out, s)
+}
+
 func autoConvert_v1beta1_RemoveKeyTransform_To_servicecatalog_RemoveKeyTransform(in *RemoveKeyTransform, out *servicecatalog.RemoveKeyTransform, s conversion.Scope) error {
 	out.Key = in.Key
 	return nil
@@ -990,6 +1494,26 @@ func Convert_servicecatalog_RenameKeyTransform_To_v1beta1_RenameKeyTransform(in
 	return autoConvert_servicecatalog_RenameKeyTransform_To_v1beta1_RenameKeyTransform(in, out, s)
 }
 
+func autoConvert_v1beta1_RollbackRequest_To_servicecatalog_RollbackRequest(in *RollbackRequest, out *servicecatalog.RollbackRequest, s conversion.Scope) error {
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1beta1_RollbackRequest_To_servicecatalog_RollbackRequest is an autogenerated conversion function.
+func Convert_v1beta1_RollbackRequest_To_servicecatalog_RollbackRequest(in *RollbackRequest, out *servicecatalog.RollbackRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_RollbackRequest_To_servicecatalog_RollbackRequest(in, out, s)
+}
+
+func autoConvert_servicecatalog_RollbackRequest_To_v1beta1_RollbackRequest(in *servicecatalog.RollbackRequest, out *RollbackRequest, s conversion.Scope) error {
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_servicecatalog_RollbackRequest_To_v1beta1_RollbackRequest is an autogenerated conversion function.
+func Convert_servicecatalog_RollbackRequest_To_v1beta1_RollbackRequest(in *servicecatalog.RollbackRequest, out *RollbackRequest, s conversion.Scope) error {
+	return autoConvert_servicecatalog_RollbackRequest_To_v1beta1_RollbackRequest(in, out, s)
+}
+
 func autoConvert_v1beta1_SecretKeyReference_To_servicecatalog_SecretKeyReference(in *SecretKeyReference, out *servicecatalog.SecretKeyReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Key = in.Key
@@ -1124,6 +1648,7 @@ func autoConvert_v1beta1_ServiceBindingPropertiesState_To_servicecatalog_Service
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersChecksum = in.ParametersChecksum
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.AppGUID = in.AppGUID
 	return nil
 }
 
@@ -1136,6 +1661,7 @@ func autoConvert_servicecatalog_ServiceBindingPropertiesState_To_v1beta1_Service
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersChecksum = in.ParametersChecksum
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.AppGUID = in.AppGUID
 	return nil
 }
 
@@ -1148,12 +1674,16 @@ func autoConvert_v1beta1_ServiceBindingSpec_To_servicecatalog_ServiceBindingSpec
 	if err := Convert_v1beta1_LocalObjectReference_To_servicecatalog_LocalObjectReference(&in.ServiceInstanceRef, &out.ServiceInstanceRef, s); err != nil {
 		return err
 	}
+	out.InstanceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.InstanceSelector))
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersFrom = *(*[]servicecatalog.ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
 	out.SecretName = in.SecretName
 	out.SecretTransforms = *(*[]servicecatalog.SecretTransform)(unsafe.Pointer(&in.SecretTransforms))
+	out.ImmutableSecret = in.ImmutableSecret
+	out.AppRef = (*servicecatalog.AppReference)(unsafe.Pointer(in.AppRef))
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.DeletionPolicy = servicecatalog.DeletionPolicy(in.DeletionPolicy)
 	return nil
 }
 
@@ -1166,12 +1696,16 @@ func autoConvert_servicecatalog_ServiceBindingSpec_To_v1beta1_ServiceBindingSpec
 	if err := Convert_servicecatalog_LocalObjectReference_To_v1beta1_LocalObjectReference(&in.ServiceInstanceRef, &out.ServiceInstanceRef, s); err != nil {
 		return err
 	}
+	out.InstanceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.InstanceSelector))
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersFrom = *(*[]ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
 	out.SecretName = in.SecretName
 	out.SecretTransforms = *(*[]SecretTransform)(unsafe.Pointer(&in.SecretTransforms))
+	out.ImmutableSecret = in.ImmutableSecret
+	out.AppRef = (*AppReference)(unsafe.Pointer(in.AppRef))
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
 	return nil
 }
 
@@ -1191,6 +1725,14 @@ func autoConvert_v1beta1_ServiceBindingStatus_To_servicecatalog_ServiceBindingSt
 	out.ExternalProperties = (*servicecatalog.ServiceBindingPropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.OrphanMitigationInProgress = in.OrphanMitigationInProgress
 	out.UnbindStatus = servicecatalog.ServiceBindingUnbindStatus(in.UnbindStatus)
+	out.FailedUnbindAttempts = in.FailedUnbindAttempts
+	out.BindStartedAt = (*v1.Time)(unsafe.Pointer(in.BindStartedAt))
+	out.ReadyAt = (*v1.Time)(unsafe.Pointer(in.ReadyAt))
+	out.LastPollTime = (*v1.Time)(unsafe.Pointer(in.LastPollTime))
+	out.LastPollResult = in.LastPollResult
+	out.SecretName = in.SecretName
+	out.AcceptedParameters = (*runtime.RawExtension)(unsafe.Pointer(in.AcceptedParameters))
+	out.CredentialsChecksum = in.CredentialsChecksum
 	return nil
 }
 
@@ -1210,6 +1752,14 @@ func autoConvert_servicecatalog_ServiceBindingStatus_To_v1beta1_ServiceBindingSt
 	out.ExternalProperties = (*ServiceBindingPropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.OrphanMitigationInProgress = in.OrphanMitigationInProgress
 	out.UnbindStatus = ServiceBindingUnbindStatus(in.UnbindStatus)
+	out.FailedUnbindAttempts = in.FailedUnbindAttempts
+	out.BindStartedAt = (*v1.Time)(unsafe.Pointer(in.BindStartedAt))
+	out.ReadyAt = (*v1.Time)(unsafe.Pointer(in.ReadyAt))
+	out.LastPollTime = (*v1.Time)(unsafe.Pointer(in.LastPollTime))
+	out.LastPollResult = in.LastPollResult
+	out.SecretName = in.SecretName
+	out.AcceptedParameters = (*runtime.RawExtension)(unsafe.Pointer(in.AcceptedParameters))
+	out.CredentialsChecksum = in.CredentialsChecksum
 	return nil
 }
 
@@ -1327,6 +1877,7 @@ func autoConvert_v1beta1_ServiceBrokerSpec_To_servicecatalog_ServiceBrokerSpec(i
 		return err
 	}
 	out.AuthInfo = (*servicecatalog.ServiceBrokerAuthInfo)(unsafe.Pointer(in.AuthInfo))
+	out.TargetNamespaces = *(*[]string)(unsafe.Pointer(&in.TargetNamespaces))
 	return nil
 }
 
@@ -1340,6 +1891,7 @@ func autoConvert_servicecatalog_ServiceBrokerSpec_To_v1beta1_ServiceBrokerSpec(i
 		return err
 	}
 	out.AuthInfo = (*ServiceBrokerAuthInfo)(unsafe.Pointer(in.AuthInfo))
+	out.TargetNamespaces = *(*[]string)(unsafe.Pointer(&in.TargetNamespaces))
 	return nil
 }
 
@@ -1372,6 +1924,84 @@ func Convert_servicecatalog_ServiceBrokerStatus_To_v1beta1_ServiceBrokerStatus(i
 	return autoConvert_servicecatalog_ServiceBrokerStatus_To_v1beta1_ServiceBrokerStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ServiceBrokerSummary_To_servicecatalog_ServiceBrokerSummary(in *ServiceBrokerSummary, out *servicecatalog.ServiceBrokerSummary, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.TotalBrokers = in.TotalBrokers
+	out.ReadyBrokers = in.ReadyBrokers
+	out.Brokers = *(*[]servicecatalog.ServiceBrokerSummaryEntry)(unsafe.Pointer(&in.Brokers))
+	return nil
+}
+
+// Convert_v1beta1_ServiceBrokerSummary_To_servicecatalog_ServiceBrokerSummary is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBrokerSummary_To_servicecatalog_ServiceBrokerSummary(in *ServiceBrokerSummary, out *servicecatalog.ServiceBrokerSummary, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBrokerSummary_To_servicecatalog_ServiceBrokerSummary(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServiceBrokerSummary_To_v1beta1_ServiceBrokerSummary(in *servicecatalog.ServiceBrokerSummary, out *ServiceBrokerSummary, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.TotalBrokers = in.TotalBrokers
+	out.ReadyBrokers = in.ReadyBrokers
+	out.Brokers = *(*[]ServiceBrokerSummaryEntry)(unsafe.Pointer(&in.Brokers))
+	return nil
+}
+
+// Convert_servicecatalog_ServiceBrokerSummary_To_v1beta1_ServiceBrokerSummary is an autogenerated conversion function.
+func Convert_servicecatalog_ServiceBrokerSummary_To_v1beta1_ServiceBrokerSummary(in *servicecatalog.ServiceBrokerSummary, out *ServiceBrokerSummary, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServiceBrokerSummary_To_v1beta1_ServiceBrokerSummary(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBrokerSummaryEntry_To_servicecatalog_ServiceBrokerSummaryEntry(in *ServiceBrokerSummaryEntry, out *servicecatalog.ServiceBrokerSummaryEntry, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ready = servicecatalog.ConditionStatus(in.Ready)
+	out.Reason = in.Reason
+	out.ServiceClasses = in.ServiceClasses
+	out.ServicePlans = in.ServicePlans
+	out.LastCatalogRetrievalTime = (*v1.Time)(unsafe.Pointer(in.LastCatalogRetrievalTime))
+	return nil
+}
+
+// Convert_v1beta1_ServiceBrokerSummaryEntry_To_servicecatalog_ServiceBrokerSummaryEntry is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBrokerSummaryEntry_To_servicecatalog_ServiceBrokerSummaryEntry(in *ServiceBrokerSummaryEntry, out *servicecatalog.ServiceBrokerSummaryEntry, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBrokerSummaryEntry_To_servicecatalog_ServiceBrokerSummaryEntry(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServiceBrokerSummaryEntry_To_v1beta1_ServiceBrokerSummaryEntry(in *servicecatalog.ServiceBrokerSummaryEntry, out *ServiceBrokerSummaryEntry, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ready = ConditionStatus(in.Ready)
+	out.Reason = in.Reason
+	out.ServiceClasses = in.ServiceClasses
+	out.ServicePlans = in.ServicePlans
+	out.LastCatalogRetrievalTime = (*v1.Time)(unsafe.Pointer(in.LastCatalogRetrievalTime))
+	return nil
+}
+
+// Convert_servicecatalog_ServiceBrokerSummaryEntry_To_v1beta1_ServiceBrokerSummaryEntry is an autogenerated conversion function.
+func Convert_servicecatalog_ServiceBrokerSummaryEntry_To_v1beta1_ServiceBrokerSummaryEntry(in *servicecatalog.ServiceBrokerSummaryEntry, out *ServiceBrokerSummaryEntry, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServiceBrokerSummaryEntry_To_v1beta1_ServiceBrokerSummaryEntry(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBrokerSummaryList_To_servicecatalog_ServiceBrokerSummaryList(in *ServiceBrokerSummaryList, out *servicecatalog.ServiceBrokerSummaryList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]servicecatalog.ServiceBrokerSummary)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ServiceBrokerSummaryList_To_servicecatalog_ServiceBrokerSummaryList is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBrokerSummaryList_To_servicecatalog_ServiceBrokerSummaryList(in *ServiceBrokerSummaryList, out *servicecatalog.ServiceBrokerSummaryList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBrokerSummaryList_To_servicecatalog_ServiceBrokerSummaryList(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServiceBrokerSummaryList_To_v1beta1_ServiceBrokerSummaryList(in *servicecatalog.ServiceBrokerSummaryList, out *ServiceBrokerSummaryList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ServiceBrokerSummary)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_servicecatalog_ServiceBrokerSummaryList_To_v1beta1_ServiceBrokerSummaryList is an autogenerated conversion function.
+func Convert_servicecatalog_ServiceBrokerSummaryList_To_v1beta1_ServiceBrokerSummaryList(in *servicecatalog.ServiceBrokerSummaryList, out *ServiceBrokerSummaryList, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServiceBrokerSummaryList_To_v1beta1_ServiceBrokerSummaryList(in, out, s)
+}
+
 func autoConvert_v1beta1_ServiceClass_To_servicecatalog_ServiceClass(in *ServiceClass, out *servicecatalog.ServiceClass, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ServiceClassSpec_To_servicecatalog_ServiceClassSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1431,6 +2061,8 @@ func autoConvert_v1beta1_ServiceClassSpec_To_servicecatalog_ServiceClassSpec(in
 		return err
 	}
 	out.ServiceBrokerName = in.ServiceBrokerName
+	out.ServiceBrokerNamespace = in.ServiceBrokerNamespace
+	out.ClusterServiceBroker = in.ClusterServiceBroker
 	return nil
 }
 
@@ -1444,6 +2076,8 @@ func autoConvert_servicecatalog_ServiceClassSpec_To_v1beta1_ServiceClassSpec(in
 		return err
 	}
 	out.ServiceBrokerName = in.ServiceBrokerName
+	out.ServiceBrokerNamespace = in.ServiceBrokerNamespace
+	out.ClusterServiceBroker = in.ClusterServiceBroker
 	return nil
 }
 
@@ -1558,6 +2192,84 @@ func Convert_servicecatalog_ServiceInstanceList_To_v1beta1_ServiceInstanceList(i
 	return autoConvert_servicecatalog_ServiceInstanceList_To_v1beta1_ServiceInstanceList(in, out, s)
 }
 
+func autoConvert_v1beta1_ServiceInstanceOperationRecord_To_servicecatalog_ServiceInstanceOperationRecord(in *ServiceInstanceOperationRecord, out *servicecatalog.ServiceInstanceOperationRecord, s conversion.Scope) error {
+	out.Operation = servicecatalog.ServiceInstanceOperation(in.Operation)
+	out.StartTime = in.StartTime
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Result = servicecatalog.ServiceInstanceOperationResult(in.Result)
+	out.OperationKey = (*string)(unsafe.Pointer(in.OperationKey))
+	return nil
+}
+
+// Convert_v1beta1_ServiceInstanceOperationRecord_To_servicecatalog_ServiceInstanceOperationRecord is an autogenerated conversion function.
+func Convert_v1beta1_ServiceInstanceOperationRecord_To_servicecatalog_ServiceInstanceOperationRecord(in *ServiceInstanceOperationRecord, out *servicecatalog.ServiceInstanceOperationRecord, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceInstanceOperationRecord_To_servicecatalog_ServiceInstanceOperationRecord(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServiceInstanceOperationRecord_To_v1beta1_ServiceInstanceOperationRecord(in *servicecatalog.ServiceInstanceOperationRecord, out *ServiceInstanceOperationRecord, s conversion.Scope) error {
+	out.Operation = ServiceInstanceOperation(in.Operation)
+	out.StartTime = in.StartTime
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Result = ServiceInstanceOperationResult(in.Result)
+	out.OperationKey = (*string)(unsafe.Pointer(in.OperationKey))
+	return nil
+}
+
+// Convert_servicecatalog_ServiceInstanceOperationRecord_To_v1beta1_ServiceInstanceOperationRecord is an autogenerated conversion function.
+func Convert_servicecatalog_ServiceInstanceOperationRecord_To_v1beta1_ServiceInstanceOperationRecord(in *servicecatalog.ServiceInstanceOperationRecord, out *ServiceInstanceOperationRecord, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServiceInstanceOperationRecord_To_v1beta1_ServiceInstanceOperationRecord(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceInstanceOperations_To_servicecatalog_ServiceInstanceOperations(in *ServiceInstanceOperations, out *servicecatalog.ServiceInstanceOperations, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]servicecatalog.ServiceInstanceOperationRecord)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ServiceInstanceOperations_To_servicecatalog_ServiceInstanceOperations is an autogenerated conversion function.
+func Convert_v1beta1_ServiceInstanceOperations_To_servicecatalog_ServiceInstanceOperations(in *ServiceInstanceOperations, out *servicecatalog.ServiceInstanceOperations, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceInstanceOperations_To_servicecatalog_ServiceInstanceOperations(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServiceInstanceOperations_To_v1beta1_ServiceInstanceOperations(in *servicecatalog.ServiceInstanceOperations, out *ServiceInstanceOperations, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Items = *(*[]ServiceInstanceOperationRecord)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_servicecatalog_ServiceInstanceOperations_To_v1beta1_ServiceInstanceOperations is an autogenerated conversion function.
+func Convert_servicecatalog_ServiceInstanceOperations_To_v1beta1_ServiceInstanceOperations(in *servicecatalog.ServiceInstanceOperations, out *ServiceInstanceOperations, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServiceInstanceOperations_To_v1beta1_ServiceInstanceOperations(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceInstancePropertiesSnapshot_To_servicecatalog_ServiceInstancePropertiesSnapshot(in *ServiceInstancePropertiesSnapshot, out *servicecatalog.ServiceInstancePropertiesSnapshot, s conversion.Scope) error {
+	out.Operation = servicecatalog.ServiceInstanceOperation(in.Operation)
+	out.Time = in.Time
+	if err := Convert_v1beta1_ServiceInstancePropertiesState_To_servicecatalog_ServiceInstancePropertiesState(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ServiceInstancePropertiesSnapshot_To_servicecatalog_ServiceInstancePropertiesSnapshot is an autogenerated conversion function.
+func Convert_v1beta1_ServiceInstancePropertiesSnapshot_To_servicecatalog_ServiceInstancePropertiesSnapshot(in *ServiceInstancePropertiesSnapshot, out *servicecatalog.ServiceInstancePropertiesSnapshot, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceInstancePropertiesSnapshot_To_servicecatalog_ServiceInstancePropertiesSnapshot(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServiceInstancePropertiesSnapshot_To_v1beta1_ServiceInstancePropertiesSnapshot(in *servicecatalog.ServiceInstancePropertiesSnapshot, out *ServiceInstancePropertiesSnapshot, s conversion.Scope) error {
+	out.Operation = ServiceInstanceOperation(in.Operation)
+	out.Time = in.Time
+	if err := Convert_servicecatalog_ServiceInstancePropertiesState_To_v1beta1_ServiceInstancePropertiesState(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_servicecatalog_ServiceInstancePropertiesSnapshot_To_v1beta1_ServiceInstancePropertiesSnapshot is an autogenerated conversion function.
+func Convert_servicecatalog_ServiceInstancePropertiesSnapshot_To_v1beta1_ServiceInstancePropertiesSnapshot(in *servicecatalog.ServiceInstancePropertiesSnapshot, out *ServiceInstancePropertiesSnapshot, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServiceInstancePropertiesSnapshot_To_v1beta1_ServiceInstancePropertiesSnapshot(in, out, s)
+}
+
 func autoConvert_v1beta1_ServiceInstancePropertiesState_To_servicecatalog_ServiceInstancePropertiesState(in *ServiceInstancePropertiesState, out *servicecatalog.ServiceInstancePropertiesState, s conversion.Scope) error {
 	out.ClusterServicePlanExternalName = in.ClusterServicePlanExternalName
 	out.ClusterServicePlanExternalID = in.ClusterServicePlanExternalID
@@ -1566,6 +2278,7 @@ func autoConvert_v1beta1_ServiceInstancePropertiesState_To_servicecatalog_Servic
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersChecksum = in.ParametersChecksum
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.Context = (*runtime.RawExtension)(unsafe.Pointer(in.Context))
 	return nil
 }
 
@@ -1582,6 +2295,7 @@ func autoConvert_servicecatalog_ServiceInstancePropertiesState_To_v1beta1_Servic
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersChecksum = in.ParametersChecksum
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.Context = (*runtime.RawExtension)(unsafe.Pointer(in.Context))
 	return nil
 }
 
@@ -1601,8 +2315,12 @@ func autoConvert_v1beta1_ServiceInstanceSpec_To_servicecatalog_ServiceInstanceSp
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersFrom = *(*[]servicecatalog.ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
 	out.ExternalID = in.ExternalID
+	out.Adopt = in.Adopt
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
 	out.UpdateRequests = in.UpdateRequests
+	out.DeletionPolicy = servicecatalog.DeletionPolicy(in.DeletionPolicy)
+	out.BindingDeletionPolicy = servicecatalog.BindingDeletionPolicy(in.BindingDeletionPolicy)
+	out.AutoBind = (*servicecatalog.AutoBind)(unsafe.Pointer(in.AutoBind))
 	return nil
 }
 
@@ -1622,8 +2340,12 @@ func autoConvert_servicecatalog_ServiceInstanceSpec_To_v1beta1_ServiceInstanceSp
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersFrom = *(*[]ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
 	out.ExternalID = in.ExternalID
+	out.Adopt = in.Adopt
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
 	out.UpdateRequests = in.UpdateRequests
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.BindingDeletionPolicy = BindingDeletionPolicy(in.BindingDeletionPolicy)
+	out.AutoBind = (*AutoBind)(unsafe.Pointer(in.AutoBind))
 	return nil
 }
 
@@ -1646,6 +2368,15 @@ func autoConvert_v1beta1_ServiceInstanceStatus_To_servicecatalog_ServiceInstance
 	out.ExternalProperties = (*servicecatalog.ServiceInstancePropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.ProvisionStatus = servicecatalog.ServiceInstanceProvisionStatus(in.ProvisionStatus)
 	out.DeprovisionStatus = servicecatalog.ServiceInstanceDeprovisionStatus(in.DeprovisionStatus)
+	out.FailedDeprovisionAttempts = in.FailedDeprovisionAttempts
+	out.ProvisionStartedAt = (*v1.Time)(unsafe.Pointer(in.ProvisionStartedAt))
+	out.ReadyAt = (*v1.Time)(unsafe.Pointer(in.ReadyAt))
+	out.LastPollTime = (*v1.Time)(unsafe.Pointer(in.LastPollTime))
+	out.LastPollResult = in.LastPollResult
+	out.ParametersDiff = (*servicecatalog.ParametersDiff)(unsafe.Pointer(in.ParametersDiff))
+	out.PropertiesHistory = *(*[]servicecatalog.ServiceInstancePropertiesSnapshot)(unsafe.Pointer(&in.PropertiesHistory))
+	out.AutoBindingCreated = in.AutoBindingCreated
+	out.Operations = *(*[]servicecatalog.ServiceInstanceOperationRecord)(unsafe.Pointer(&in.Operations))
 	return nil
 }
 
@@ -1668,6 +2399,15 @@ func autoConvert_servicecatalog_ServiceInstanceStatus_To_v1beta1_ServiceInstance
 	out.ExternalProperties = (*ServiceInstancePropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.ProvisionStatus = ServiceInstanceProvisionStatus(in.ProvisionStatus)
 	out.DeprovisionStatus = ServiceInstanceDeprovisionStatus(in.DeprovisionStatus)
+	out.FailedDeprovisionAttempts = in.FailedDeprovisionAttempts
+	out.ProvisionStartedAt = (*v1.Time)(unsafe.Pointer(in.ProvisionStartedAt))
+	out.ReadyAt = (*v1.Time)(unsafe.Pointer(in.ReadyAt))
+	out.LastPollTime = (*v1.Time)(unsafe.Pointer(in.LastPollTime))
+	out.LastPollResult = in.LastPollResult
+	out.ParametersDiff = (*ParametersDiff)(unsafe.Pointer(in.ParametersDiff))
+	out.PropertiesHistory = *(*[]ServiceInstancePropertiesSnapshot)(unsafe.Pointer(&in.PropertiesHistory))
+	out.AutoBindingCreated = in.AutoBindingCreated
+	out.Operations = *(*[]ServiceInstanceOperationRecord)(unsafe.Pointer(&in.Operations))
 	return nil
 }
 
@@ -1738,6 +2478,8 @@ func autoConvert_v1beta1_ServicePlanSpec_To_servicecatalog_ServicePlanSpec(in *S
 	if err := Convert_v1beta1_LocalObjectReference_To_servicecatalog_LocalObjectReference(&in.ServiceClassRef, &out.ServiceClassRef, s); err != nil {
 		return err
 	}
+	out.ServiceBrokerNamespace = in.ServiceBrokerNamespace
+	out.ClusterServiceBroker = in.ClusterServiceBroker
 	return nil
 }
 
@@ -1754,6 +2496,8 @@ func autoConvert_servicecatalog_ServicePlanSpec_To_v1beta1_ServicePlanSpec(in *s
 	if err := Convert_servicecatalog_LocalObjectReference_To_v1beta1_LocalObjectReference(&in.ServiceClassRef, &out.ServiceClassRef, s); err != nil {
 		return err
 	}
+	out.ServiceBrokerNamespace = in.ServiceBrokerNamespace
+	out.ClusterServiceBroker = in.ClusterServiceBroker
 	return nil
 }
 
@@ -28,6 +28,8 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&BrokerTemplate{}, func(obj interface{}) { SetObjectDefaults_BrokerTemplate(obj.(*BrokerTemplate)) })
+	scheme.AddTypeDefaultingFunc(&BrokerTemplateList{}, func(obj interface{}) { SetObjectDefaults_BrokerTemplateList(obj.(*BrokerTemplateList)) })
 	scheme.AddTypeDefaultingFunc(&ClusterServiceBroker{}, func(obj interface{}) { SetObjectDefaults_ClusterServiceBroker(obj.(*ClusterServiceBroker)) })
 	scheme.AddTypeDefaultingFunc(&ClusterServiceBrokerList{}, func(obj interface{}) { SetObjectDefaults_ClusterServiceBrokerList(obj.(*ClusterServiceBrokerList)) })
 	scheme.AddTypeDefaultingFunc(&ServiceBinding{}, func(obj interface{}) { SetObjectDefaults_ServiceBinding(obj.(*ServiceBinding)) })
@@ -37,6 +39,17 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	return nil
 }
 
+func SetObjectDefaults_BrokerTemplate(in *BrokerTemplate) {
+	SetDefaults_ServiceBrokerSpec(&in.Spec.Template)
+}
+
+func SetObjectDefaults_BrokerTemplateList(in *BrokerTemplateList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_BrokerTemplate(a)
+	}
+}
+
 func SetObjectDefaults_ClusterServiceBroker(in *ClusterServiceBroker) {
 	SetDefaults_ClusterServiceBrokerSpec(&in.Spec)
 }
@@ -21,6 +21,7 @@ import (
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 )
@@ -87,6 +88,10 @@ func validateServiceBindingSpec(spec *sc.ServiceBindingSpec, fldPath *field.Path
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("instanceRef", "name"), spec.ServiceInstanceRef.Name, msg))
 	}
 
+	if spec.InstanceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.InstanceSelector, fldPath.Child("instanceSelector"))...)
+	}
+
 	for _, msg := range apivalidation.NameIsDNSSubdomain(spec.SecretName, false /* prefix */) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("secretName"), spec.SecretName, msg))
 	}
@@ -95,6 +100,28 @@ func validateServiceBindingSpec(spec *sc.ServiceBindingSpec, fldPath *field.Path
 		allErrs = append(allErrs, validateParametersFromSource(spec.ParametersFrom, fldPath)...)
 	}
 
+	allErrs = append(allErrs, validateDeletionPolicy(spec.DeletionPolicy, fldPath.Child("deletionPolicy"))...)
+
+	if spec.AppRef != nil {
+		allErrs = append(allErrs, validateAppReference(spec.AppRef, fldPath.Child("appRef"))...)
+	}
+
+	return allErrs
+}
+
+func validateAppReference(ref *sc.AppReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch ref.Kind {
+	case sc.AppReferenceKindDeployment, sc.AppReferenceKindStatefulSet:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kind"), ref.Kind, []string{string(sc.AppReferenceKindDeployment), string(sc.AppReferenceKindStatefulSet)}))
+	}
+
+	for _, msg := range apivalidation.NameIsDNSSubdomain(ref.Name, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), ref.Name, msg))
+	}
+
 	return allErrs
 }
 
@@ -236,6 +263,8 @@ func ValidateServiceBindingUpdate(new *sc.ServiceBinding, old *sc.ServiceBinding
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, internalValidateServiceBindingUpdateAllowed(new, old)...)
 	allErrs = append(allErrs, internalValidateServiceBinding(new, false)...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ImmutableSecret, old.Spec.ImmutableSecret, field.NewPath("spec").Child("immutableSecret"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.AppRef, old.Spec.AppRef, field.NewPath("spec").Child("appRef"))...)
 	return allErrs
 }
 
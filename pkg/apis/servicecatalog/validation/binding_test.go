@@ -101,6 +101,42 @@ func TestValidateServiceBinding(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid instanceSelector",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.InstanceSelector = &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "postgres"},
+				}
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid instanceSelector",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.InstanceSelector = &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "app", Operator: "Near"},
+					},
+				}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "unresolved instanceSelector",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.ServiceInstanceRef.Name = ""
+				b.Spec.InstanceSelector = &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "postgres"},
+				}
+				return b
+			}(),
+			valid: false,
+		},
 		{
 			name: "missing secretName",
 			binding: func() *servicecatalog.ServiceBinding {
@@ -162,6 +198,51 @@ func TestValidateServiceBinding(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid deletionPolicy Abandon",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.DeletionPolicy = servicecatalog.DeletionPolicyAbandon
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid deletionPolicy",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.DeletionPolicy = "Orphan"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid appRef",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AppRef = &servicecatalog.AppReference{Kind: servicecatalog.AppReferenceKindDeployment, Name: "test-app"}
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid appRef kind",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AppRef = &servicecatalog.AppReference{Kind: "DaemonSet", Name: "test-app"}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid appRef name",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AppRef = &servicecatalog.AppReference{Kind: servicecatalog.AppReferenceKindStatefulSet, Name: "test-app-)*!"}
+				return b
+			}(),
+			valid: false,
+		},
 
 		{
 			name:    "valid with in-progress bind",
@@ -637,3 +718,31 @@ func TestInternalValidateServiceBindingUpdateAllowed(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateServiceBindingUpdateImmutableSecret(t *testing.T) {
+	oldBinding := validServiceBinding()
+	oldBinding.Status.ReconciledGeneration = oldBinding.Generation
+
+	newBinding := validServiceBinding()
+	newBinding.Status.ReconciledGeneration = newBinding.Generation
+	if errs := ValidateServiceBindingUpdate(newBinding, oldBinding); len(errs) != 0 {
+		t.Fatalf("unexpected error: %v", errs)
+	}
+
+	newBinding.Spec.ImmutableSecret = true
+	if errs := ValidateServiceBindingUpdate(newBinding, oldBinding); len(errs) == 0 {
+		t.Fatal("expected changing immutableSecret to be rejected")
+	}
+}
+
+func TestValidateServiceBindingUpdateAppRef(t *testing.T) {
+	oldBinding := validServiceBinding()
+	oldBinding.Status.ReconciledGeneration = oldBinding.Generation
+
+	newBinding := validServiceBinding()
+	newBinding.Status.ReconciledGeneration = newBinding.Generation
+	newBinding.Spec.AppRef = &servicecatalog.AppReference{Kind: servicecatalog.AppReferenceKindDeployment, Name: "test-app"}
+	if errs := ValidateServiceBindingUpdate(newBinding, oldBinding); len(errs) == 0 {
+		t.Fatal("expected changing appRef to be rejected")
+	}
+}
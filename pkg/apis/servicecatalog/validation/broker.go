@@ -17,11 +17,20 @@ limitations under the License.
 package validation
 
 import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/kubernetes-incubator/service-catalog/pkg/filter"
 )
 
@@ -29,6 +38,18 @@ import (
 // broker names.
 var validateCommonServiceBrokerName = apivalidation.NameIsDNSSubdomain
 
+// reservedBrokerHeaders are the canonical names of the headers set by the
+// controller on requests to brokers, which custom headers must not override.
+var reservedBrokerHeaders = sets.NewString(
+	"Authorization",
+	"Content-Length",
+	"Content-Type",
+	"Host",
+	"X-Broker-Api-Originating-Identity",
+	"X-Broker-Api-Request-Identity",
+	"X-Broker-Api-Version",
+)
+
 // ValidateClusterServiceBroker implements the validation rules for a
 // ClusterServiceBroker.
 func ValidateClusterServiceBroker(broker *sc.ClusterServiceBroker) field.ErrorList {
@@ -88,6 +109,13 @@ func validateClusterServiceBrokerSpec(spec *sc.ClusterServiceBrokerSpec, fldPath
 		}
 	}
 
+	for name, value := range spec.CustomHeaders {
+		if value.SecretKeyRef != nil && value.SecretKeyRef.Namespace == "" {
+			allErrs = append(allErrs,
+				field.Required(fldPath.Child("customHeaders").Key(name).Child("secretKeyRef", "namespace"), "the namespace of the secret is required for a cluster broker"))
+		}
+	}
+
 	commonErrs := validateCommonServiceBrokerSpec(&spec.CommonServiceBrokerSpec, fldPath)
 
 	if len(commonErrs) != 0 {
@@ -100,6 +128,12 @@ func validateClusterServiceBrokerSpec(spec *sc.ClusterServiceBrokerSpec, fldPath
 // ValidateServiceBroker implements the validation rules for a
 // ServiceBroker.
 func ValidateServiceBroker(broker *sc.ServiceBroker) field.ErrorList {
+	return validateServiceBroker(broker, nil)
+}
+
+// validateServiceBroker validates a ServiceBroker, which replaces the given
+// old broker on update.
+func validateServiceBroker(broker *sc.ServiceBroker, old *sc.ServiceBroker) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs,
@@ -109,6 +143,43 @@ func ValidateServiceBroker(broker *sc.ServiceBroker) field.ErrorList {
 			field.NewPath("metadata"))...)
 
 	allErrs = append(allErrs, validateServiceBrokerSpec(&broker.Spec, field.NewPath("spec"))...)
+
+	if len(broker.Spec.TargetNamespaces) > 0 {
+		fldPath := field.NewPath("spec", "targetNamespaces")
+		// A broker that already shares its catalog can still be updated
+		// after the feature is disabled, as long as its targets stay the
+		// same.
+		if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.CrossNamespaceCatalogSharing) &&
+			(old == nil || !reflect.DeepEqual(old.Spec.TargetNamespaces, broker.Spec.TargetNamespaces)) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "sharing the catalog with other namespaces requires the CrossNamespaceCatalogSharing feature gate"))
+		}
+		allErrs = append(allErrs, validateTargetNamespaces(broker.Namespace, broker.Spec.TargetNamespaces, fldPath)...)
+	}
+	return allErrs
+}
+
+// validateTargetNamespaces validates the namespaces into which a ServiceBroker
+// in the given namespace publishes its catalog. Whether the target namespaces
+// accept the catalog is checked by the controller on every catalog sync, as
+// the annotation that says so can change at any time.
+func validateTargetNamespaces(namespace string, targetNamespaces []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.NewString()
+	for i, targetNamespace := range targetNamespaces {
+		idxPath := fldPath.Index(i)
+		for _, msg := range apivalidation.ValidateNamespaceName(targetNamespace, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(idxPath, targetNamespace, msg))
+		}
+		if targetNamespace == namespace {
+			allErrs = append(allErrs, field.Invalid(idxPath, targetNamespace, "the namespace of the broker is always a target"))
+		}
+		if seen.Has(targetNamespace) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, targetNamespace))
+		}
+		seen.Insert(targetNamespace)
+	}
+
 	return allErrs
 }
 
@@ -123,6 +194,11 @@ func validateServiceBrokerSpec(spec *sc.ServiceBrokerSpec, fldPath *field.Path)
 				for _, msg := range apivalidation.NameIsDNSSubdomain(secretRef.Name, false /* prefix */) {
 					allErrs = append(allErrs, field.Invalid(fldPath.Child("authInfo", "basic", "secretRef", "name"), secretRef.Name, msg))
 				}
+				if secretNamespace := spec.AuthInfo.Basic.SecretNamespace; secretNamespace != "" {
+					for _, msg := range apivalidation.ValidateNamespaceName(secretNamespace, false /* prefix */) {
+						allErrs = append(allErrs, field.Invalid(fldPath.Child("authInfo", "basic", "secretNamespace"), secretNamespace, msg))
+					}
+				}
 			} else {
 				allErrs = append(
 					allErrs,
@@ -135,6 +211,11 @@ func validateServiceBrokerSpec(spec *sc.ServiceBrokerSpec, fldPath *field.Path)
 				for _, msg := range apivalidation.NameIsDNSSubdomain(secretRef.Name, false /* prefix */) {
 					allErrs = append(allErrs, field.Invalid(fldPath.Child("authInfo", "bearer", "secretRef", "name"), secretRef.Name, msg))
 				}
+				if secretNamespace := spec.AuthInfo.Bearer.SecretNamespace; secretNamespace != "" {
+					for _, msg := range apivalidation.ValidateNamespaceName(secretNamespace, false /* prefix */) {
+						allErrs = append(allErrs, field.Invalid(fldPath.Child("authInfo", "bearer", "secretNamespace"), secretNamespace, msg))
+					}
+				}
 			} else {
 				allErrs = append(
 					allErrs,
@@ -205,28 +286,160 @@ func validateCommonServiceBrokerSpec(spec *sc.CommonServiceBrokerSpec, fldPath *
 		}
 	}
 
+	switch spec.CatalogRemovalPolicy {
+	case "",
+		sc.CatalogRemovalPolicyKeep,
+		sc.CatalogRemovalPolicyWarn,
+		sc.CatalogRemovalPolicyBlockNewBindings,
+		sc.CatalogRemovalPolicyScheduledDeprovision:
+	default:
+		commonErrs = append(commonErrs,
+			field.NotSupported(fldPath.Child("catalogRemovalPolicy"), spec.CatalogRemovalPolicy, []string{
+				string(sc.CatalogRemovalPolicyKeep),
+				string(sc.CatalogRemovalPolicyWarn),
+				string(sc.CatalogRemovalPolicyBlockNewBindings),
+				string(sc.CatalogRemovalPolicyScheduledDeprovision),
+			}))
+	}
+
+	if spec.CatalogRemovalDeprovisionDelay != nil && spec.CatalogRemovalDeprovisionDelay.Duration < 0 {
+		commonErrs = append(
+			commonErrs,
+			field.Invalid(fldPath.Child("catalogRemovalDeprovisionDelay"), spec.CatalogRemovalDeprovisionDelay.Duration, "catalogRemovalDeprovisionDelay must not be negative"),
+		)
+	}
+
+	if spec.CatalogRemovalGracePeriod != nil && spec.CatalogRemovalGracePeriod.Duration < 0 {
+		commonErrs = append(
+			commonErrs,
+			field.Invalid(fldPath.Child("catalogRemovalGracePeriod"), spec.CatalogRemovalGracePeriod.Duration, "catalogRemovalGracePeriod must not be negative"),
+		)
+	}
+
+	if spec.MirrorURL != "" {
+		if u, err := url.Parse(spec.MirrorURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			commonErrs = append(commonErrs,
+				field.Invalid(fldPath.Child("mirrorURL"), spec.MirrorURL, "mirrorURL must be an absolute http or https URL"))
+		} else if spec.MirrorURL == spec.URL {
+			commonErrs = append(commonErrs,
+				field.Invalid(fldPath.Child("mirrorURL"), spec.MirrorURL, "mirrorURL must be different from url"))
+		}
+	}
+
+	seenURLs := sets.NewString(spec.URL)
+	for i, failoverURL := range spec.FailoverURLs {
+		fieldPath := fldPath.Child("failoverURLs").Index(i)
+		if u, err := url.Parse(failoverURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			commonErrs = append(commonErrs,
+				field.Invalid(fieldPath, failoverURL, "failover URLs must be absolute http or https URLs"))
+		} else if seenURLs.Has(failoverURL) {
+			commonErrs = append(commonErrs, field.Duplicate(fieldPath, failoverURL))
+		}
+		seenURLs.Insert(failoverURL)
+	}
+
+	if spec.CatalogRestrictions != nil {
+		commonErrs = append(commonErrs, validateCatalogRestrictions(spec.CatalogRestrictions, fldPath.Child("catalogRestrictions"))...)
+	}
+
+	commonErrs = append(commonErrs, validateBrokerCustomHeaders(spec.CustomHeaders, fldPath.Child("customHeaders"))...)
+
+	return commonErrs
+}
+
+// validateBrokerCustomHeaders validates the custom headers of a broker. The
+// headers are validated in the order of their names, so that the errors are
+// stable.
+func validateBrokerCustomHeaders(headers map[string]sc.BrokerHeaderValue, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := headers[name]
+		headerPath := fldPath.Key(name)
+		for _, msg := range utilvalidation.IsHTTPHeaderName(name) {
+			allErrs = append(allErrs, field.Invalid(headerPath, name, msg))
+		}
+		if reservedBrokerHeaders.Has(http.CanonicalHeaderKey(name)) {
+			allErrs = append(allErrs, field.Forbidden(headerPath, "the header is set by the controller and cannot be overridden"))
+		}
+
+		if (value.Value == "") == (value.SecretKeyRef == nil) {
+			allErrs = append(allErrs, field.Invalid(headerPath, name, "exactly one of value or secretKeyRef must be set"))
+			continue
+		}
+		if secretRef := value.SecretKeyRef; secretRef != nil {
+			secretPath := headerPath.Child("secretKeyRef")
+			if secretRef.Namespace != "" {
+				for _, msg := range apivalidation.ValidateNamespaceName(secretRef.Namespace, false /* prefix */) {
+					allErrs = append(allErrs, field.Invalid(secretPath.Child("namespace"), secretRef.Namespace, msg))
+				}
+			}
+			for _, msg := range apivalidation.NameIsDNSSubdomain(secretRef.Name, false /* prefix */) {
+				allErrs = append(allErrs, field.Invalid(secretPath.Child("name"), secretRef.Name, msg))
+			}
+			for _, msg := range utilvalidation.IsConfigMapKey(secretRef.Key) {
+				allErrs = append(allErrs, field.Invalid(secretPath.Child("key"), secretRef.Key, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateCatalogRestrictions(restrictions *sc.CatalogRestrictions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
 	// TODO: could validate if the fields being selected are on the approve list, but this will require breaking
 	// apart the label selector.
-	if spec.CatalogRestrictions != nil && len(spec.CatalogRestrictions.ServiceClass) > 0 {
+	if len(restrictions.ServiceClass) > 0 {
 		// confirm that the restrictions can turn into a predicate.
-		_, err := filter.CreatePredicate(spec.CatalogRestrictions.ServiceClass)
+		_, err := filter.CreatePredicate(restrictions.ServiceClass)
 		if err != nil {
-			commonErrs = append(commonErrs,
-				field.Invalid(fldPath.Child("catalogRestrictions", "serviceClass"),
-					spec.CatalogRestrictions.ServiceClass, err.Error()))
+			allErrs = append(allErrs,
+				field.Invalid(fldPath.Child("serviceClass"),
+					restrictions.ServiceClass, err.Error()))
 		}
 	}
-	if spec.CatalogRestrictions != nil && len(spec.CatalogRestrictions.ServicePlan) > 0 {
+	if len(restrictions.ServicePlan) > 0 {
 		// confirm that the restrictions can turn into a predicate.
-		_, err := filter.CreatePredicate(spec.CatalogRestrictions.ServicePlan)
+		_, err := filter.CreatePredicate(restrictions.ServicePlan)
 		if err != nil {
-			commonErrs = append(commonErrs,
-				field.Invalid(fldPath.Child("catalogRestrictions", "servicePlan"),
-					spec.CatalogRestrictions.ServiceClass, err.Error()))
+			allErrs = append(allErrs,
+				field.Invalid(fldPath.Child("servicePlan"),
+					restrictions.ServicePlan, err.Error()))
 		}
 	}
+	allErrs = append(allErrs, validateCatalogRestrictionsExternalIDs(restrictions.ServiceClassExternalIDs, fldPath.Child("serviceClassExternalIDs"))...)
+	allErrs = append(allErrs, validateCatalogRestrictionsExternalIDs(restrictions.ServicePlanExternalIDs, fldPath.Child("servicePlanExternalIDs"))...)
 
-	return commonErrs
+	return allErrs
+}
+
+// validateCatalogRestrictionsExternalIDs validates an allow-list of external
+// IDs of catalog restrictions: the IDs must be non-empty and unique.
+func validateCatalogRestrictionsExternalIDs(externalIDs []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.NewString()
+	for i, externalID := range externalIDs {
+		idxPath := fldPath.Index(i)
+		if externalID == "" {
+			allErrs = append(allErrs, field.Required(idxPath, "external IDs must not be empty"))
+			continue
+		}
+		if seen.Has(externalID) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, externalID))
+		}
+		seen.Insert(externalID)
+	}
+
+	return allErrs
 }
 
 // ValidateClusterServiceBrokerUpdate checks that when changing from an older broker to a newer broker is okay ?
@@ -239,7 +452,7 @@ func ValidateClusterServiceBrokerUpdate(new *sc.ClusterServiceBroker, old *sc.Cl
 // ValidateServiceBrokerUpdate checks that when changing from an older broker to a newer broker is okay ?
 func ValidateServiceBrokerUpdate(new *sc.ServiceBroker, old *sc.ServiceBroker) field.ErrorList {
 	allErrs := validateCommonServiceBrokerUpdate(&new.Spec.CommonServiceBrokerSpec, &old.Spec.CommonServiceBrokerSpec)
-	allErrs = append(allErrs, ValidateServiceBroker(new)...)
+	allErrs = append(allErrs, validateServiceBroker(new, old)...)
 	return allErrs
 }
 
@@ -17,12 +17,16 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 )
 
 func TestValidateClusterServiceBroker(t *testing.T) {
@@ -516,6 +520,312 @@ func TestValidateClusterServiceBroker(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			name: "valid clusterservicebroker - catalogRequirements with external IDs",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorManual,
+						CatalogRestrictions: &servicecatalog.CatalogRestrictions{
+							ServiceClassExternalIDs: []string{"service-1", "service-2"},
+							ServicePlanExternalIDs:  []string{"plan-1"},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - catalogRequirements with empty external ID",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorManual,
+						CatalogRestrictions: &servicecatalog.CatalogRestrictions{
+							ServiceClassExternalIDs: []string{"service-1", ""},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - catalogRequirements with duplicate external ID",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorManual,
+						CatalogRestrictions: &servicecatalog.CatalogRestrictions{
+							ServicePlanExternalIDs: []string{"plan-1", "plan-1"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - scheduledDeprovision catalogRemovalPolicy",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                            "http://example.com",
+						RelistBehavior:                 servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalPolicy:           servicecatalog.CatalogRemovalPolicyScheduledDeprovision,
+						CatalogRemovalDeprovisionDelay: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - catalogRemovalPolicy is invalid",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                  "http://example.com",
+						RelistBehavior:       servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalPolicy: "Invalid",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - negative catalogRemovalDeprovisionDelay value",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                            "http://example.com",
+						RelistBehavior:                 servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalPolicy:           servicecatalog.CatalogRemovalPolicyScheduledDeprovision,
+						CatalogRemovalDeprovisionDelay: &metav1.Duration{Duration: -time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - catalogRemovalGracePeriod",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                       "http://example.com",
+						RelistBehavior:            servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalGracePeriod: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - negative catalogRemovalGracePeriod value",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                       "http://example.com",
+						RelistBehavior:            servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalGracePeriod: &metav1.Duration{Duration: -time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - mirrorURL",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						MirrorURL:      "https://mirror.example.com",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - relative mirrorURL",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						MirrorURL:      "mirror.example.com",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - mirrorURL equal to url",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						MirrorURL:      "http://example.com",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - failoverURLs",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						FailoverURLs:   []string{"https://standby.example.com"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - relative failoverURL",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						FailoverURLs:   []string{"standby.example.com"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - duplicate failoverURL",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						FailoverURLs:   []string{"http://example.com"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - customHeaders",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CustomHeaders: map[string]servicecatalog.BrokerHeaderValue{
+							"X-Tenant-ID":   {Value: "tenant-1"},
+							"X-Routing-Key": {SecretKeyRef: &servicecatalog.BrokerSecretKeyReference{Namespace: "test-ns", Name: "routing", Key: "key"}},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - reserved custom header",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CustomHeaders: map[string]servicecatalog.BrokerHeaderValue{
+							"authorization": {Value: "Bearer token"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - custom header without value",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CustomHeaders: map[string]servicecatalog.BrokerHeaderValue{
+							"X-Tenant-ID": {},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - custom header secret without namespace",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CustomHeaders: map[string]servicecatalog.BrokerHeaderValue{
+							"X-Routing-Key": {SecretKeyRef: &servicecatalog.BrokerSecretKeyReference{Name: "routing", Key: "key"}},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -643,15 +953,63 @@ func TestValidateServiceBroker(t *testing.T) {
 		valid  bool
 	}{
 		{
-			// covers the case where there is no AuthInfo field specified. the validator should
-			// ignore the field and still succeed the validation
-			name: "valid servicebroker - no auth secret",
+			// covers the case where there is no AuthInfo field specified. the validator should
+			// ignore the field and still succeed the validation
+			name: "valid servicebroker - no auth secret",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "valid servicebroker - basic auth - secret",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
+						Basic: &servicecatalog.BasicAuthConfig{
+							SecretRef: &servicecatalog.LocalObjectReference{
+								Name: "test-secret",
+							},
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						URL:            "http://example.com",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "valid servicebroker - bearer auth - secret",
 			broker: &servicecatalog.ServiceBroker{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-clusterservicebroker",
 					Namespace: "test-ns",
 				},
 				Spec: servicecatalog.ServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
+						Bearer: &servicecatalog.BearerTokenAuthConfig{
+							SecretRef: &servicecatalog.LocalObjectReference{
+								Name: "test-secret",
+							},
+						},
+					},
 					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
 						URL:            "http://example.com",
 						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
@@ -662,7 +1020,7 @@ func TestValidateServiceBroker(t *testing.T) {
 			valid: true,
 		},
 		{
-			name: "valid servicebroker - basic auth - secret",
+			name: "valid servicebroker - bearer auth - secret in central namespace",
 			broker: &servicecatalog.ServiceBroker{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-clusterservicebroker",
@@ -670,23 +1028,24 @@ func TestValidateServiceBroker(t *testing.T) {
 				},
 				Spec: servicecatalog.ServiceBrokerSpec{
 					AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
-						Basic: &servicecatalog.BasicAuthConfig{
+						Bearer: &servicecatalog.BearerTokenAuthConfig{
 							SecretRef: &servicecatalog.LocalObjectReference{
 								Name: "test-secret",
 							},
+							SecretNamespace: "broker-secrets",
 						},
 					},
 					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
 						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
 						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
-						URL:            "http://example.com",
 					},
 				},
 			},
 			valid: true,
 		},
 		{
-			name: "valid servicebroker - bearer auth - secret",
+			name: "invalid servicebroker - basic auth - invalid secret namespace",
 			broker: &servicecatalog.ServiceBroker{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-clusterservicebroker",
@@ -694,10 +1053,11 @@ func TestValidateServiceBroker(t *testing.T) {
 				},
 				Spec: servicecatalog.ServiceBrokerSpec{
 					AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
-						Bearer: &servicecatalog.BearerTokenAuthConfig{
+						Basic: &servicecatalog.BasicAuthConfig{
 							SecretRef: &servicecatalog.LocalObjectReference{
 								Name: "test-secret",
 							},
+							SecretNamespace: "Broker_Secrets",
 						},
 					},
 					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
@@ -707,7 +1067,7 @@ func TestValidateServiceBroker(t *testing.T) {
 					},
 				},
 			},
-			valid: true,
+			valid: false,
 		},
 		{
 			name: "invalid servicebroker - servicebroker without namespace",
@@ -942,6 +1302,272 @@ func TestValidateServiceBroker(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid servicebroker - scheduledDeprovision catalogRemovalPolicy",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                            "http://example.com",
+						RelistBehavior:                 servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalPolicy:           servicecatalog.CatalogRemovalPolicyScheduledDeprovision,
+						CatalogRemovalDeprovisionDelay: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid servicebroker - catalogRemovalPolicy is invalid",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                  "http://example.com",
+						RelistBehavior:       servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalPolicy: "Invalid",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebroker - negative catalogRemovalDeprovisionDelay value",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                            "http://example.com",
+						RelistBehavior:                 servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalPolicy:           servicecatalog.CatalogRemovalPolicyScheduledDeprovision,
+						CatalogRemovalDeprovisionDelay: &metav1.Duration{Duration: -time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid servicebroker - catalogRemovalGracePeriod",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                       "http://example.com",
+						RelistBehavior:            servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalGracePeriod: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid servicebroker - negative catalogRemovalGracePeriod value",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                       "http://example.com",
+						RelistBehavior:            servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CatalogRemovalGracePeriod: &metav1.Duration{Duration: -time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid servicebroker - mirrorURL",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						MirrorURL:      "https://mirror.example.com",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid servicebroker - relative mirrorURL",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						MirrorURL:      "mirror.example.com",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebroker - mirrorURL equal to url",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						MirrorURL:      "http://example.com",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid servicebroker - failoverURLs",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						FailoverURLs:   []string{"https://standby.example.com"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid servicebroker - relative failoverURL",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						FailoverURLs:   []string{"standby.example.com"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebroker - duplicate failoverURL",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						FailoverURLs:   []string{"http://example.com"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid servicebroker - customHeaders",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CustomHeaders: map[string]servicecatalog.BrokerHeaderValue{
+							"X-Tenant-ID":   {Value: "tenant-1"},
+							"X-Routing-Key": {SecretKeyRef: &servicecatalog.BrokerSecretKeyReference{Name: "routing", Key: "key"}},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid servicebroker - reserved custom header",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CustomHeaders: map[string]servicecatalog.BrokerHeaderValue{
+							"X-Broker-API-Version": {Value: "2.13"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebroker - invalid custom header name",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CustomHeaders: map[string]servicecatalog.BrokerHeaderValue{
+							"X Tenant": {Value: "tenant-1"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebroker - custom header with value and secret",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						CustomHeaders: map[string]servicecatalog.BrokerHeaderValue{
+							"X-Tenant-ID": {Value: "tenant-1", SecretKeyRef: &servicecatalog.BrokerSecretKeyReference{Name: "tenant", Key: "id"}},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1067,3 +1693,62 @@ func TestValidateServiceBroker(t *testing.T) {
 		}
 	}
 }
+
+// TestValidateServiceBrokerTargetNamespaces tests that a ServiceBroker can
+// only set target namespaces with the CrossNamespaceCatalogSharing feature
+// enabled, and that its target namespaces must be valid, distinct and other
+// than its own namespace.
+func TestValidateServiceBrokerTargetNamespaces(t *testing.T) {
+	cases := []struct {
+		name       string
+		enabled    bool
+		targets    []string
+		oldTargets []string
+		valid      bool
+	}{
+		{name: "feature enabled", enabled: true, targets: []string{"team-a", "team-b"}, valid: true},
+		{name: "feature disabled", enabled: false, targets: []string{"team-a"}, valid: false},
+		{name: "feature disabled, unchanged targets", enabled: false, targets: []string{"team-a"}, oldTargets: []string{"team-a"}, valid: true},
+		{name: "feature disabled, changed targets", enabled: false, targets: []string{"team-a", "team-b"}, oldTargets: []string{"team-a"}, valid: false},
+		{name: "own namespace", enabled: true, targets: []string{"test-ns"}, valid: false},
+		{name: "duplicate namespace", enabled: true, targets: []string{"team-a", "team-a"}, valid: false},
+		{name: "invalid namespace", enabled: true, targets: []string{"Team_A"}, valid: false},
+	}
+
+	for _, tc := range cases {
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=%v", scfeatures.CrossNamespaceCatalogSharing, tc.enabled)); err != nil {
+			t.Fatalf("Failed to set CrossNamespaceCatalogSharing feature: %v", err)
+		}
+
+		broker := &servicecatalog.ServiceBroker{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-servicebroker",
+				Namespace: "test-ns",
+			},
+			Spec: servicecatalog.ServiceBrokerSpec{
+				CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+					URL:            "http://example.com",
+					RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+					RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+				},
+				TargetNamespaces: tc.targets,
+			},
+		}
+
+		var errs field.ErrorList
+		if tc.oldTargets == nil {
+			errs = ValidateServiceBroker(broker)
+		} else {
+			old := broker.DeepCopy()
+			old.Spec.TargetNamespaces = tc.oldTargets
+			errs = ValidateServiceBrokerUpdate(broker, old)
+		}
+		if len(errs) != 0 && tc.valid {
+			t.Errorf("%v: unexpected error: %v", tc.name, errs)
+		} else if len(errs) == 0 && !tc.valid {
+			t.Errorf("%v: unexpected success", tc.name)
+		}
+	}
+
+	utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.CrossNamespaceCatalogSharing))
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+// brokerTemplateValidationNamespace stands in for the namespace variable when
+// validating the template of a BrokerTemplate, so that the fields that may
+// contain it are validated as they will be once it is expanded.
+const brokerTemplateValidationNamespace = "namespace"
+
+// ValidateBrokerTemplate implements the validation rules for a
+// BrokerTemplate.
+func ValidateBrokerTemplate(template *sc.BrokerTemplate) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs,
+		apivalidation.ValidateObjectMeta(&template.ObjectMeta,
+			false, /* namespace required */
+			validateCommonServiceBrokerName,
+			field.NewPath("metadata"))...)
+
+	allErrs = append(allErrs, validateBrokerTemplateSpec(&template.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+func validateBrokerTemplateSpec(spec *sc.BrokerTemplateSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.NamespaceSelector, fldPath.Child("namespaceSelector"))...)
+	}
+
+	if spec.BrokerName != "" {
+		for _, msg := range validateCommonServiceBrokerName(spec.BrokerName, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("brokerName"), spec.BrokerName, msg))
+		}
+	}
+
+	template := spec.Template.DeepCopy()
+	template.URL = expandBrokerTemplateNamespace(template.URL)
+	if authInfo := template.AuthInfo; authInfo != nil {
+		if authInfo.Basic != nil {
+			expandBrokerTemplateSecretRef(authInfo.Basic.SecretRef, &authInfo.Basic.SecretNamespace)
+		}
+		if authInfo.Bearer != nil {
+			expandBrokerTemplateSecretRef(authInfo.Bearer.SecretRef, &authInfo.Bearer.SecretNamespace)
+		}
+	}
+	allErrs = append(allErrs, validateServiceBrokerSpec(template, fldPath.Child("template"))...)
+
+	return allErrs
+}
+
+func expandBrokerTemplateNamespace(s string) string {
+	return strings.Replace(s, sc.BrokerTemplateNamespaceVariable, brokerTemplateValidationNamespace, -1)
+}
+
+func expandBrokerTemplateSecretRef(secretRef *sc.LocalObjectReference, secretNamespace *string) {
+	if secretRef != nil {
+		secretRef.Name = expandBrokerTemplateNamespace(secretRef.Name)
+	}
+	*secretNamespace = expandBrokerTemplateNamespace(*secretNamespace)
+}
+
+// ValidateBrokerTemplateUpdate checks that an update to a BrokerTemplate is
+// valid.
+func ValidateBrokerTemplateUpdate(new *sc.BrokerTemplate, old *sc.BrokerTemplate) field.ErrorList {
+	return ValidateBrokerTemplate(new)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+func validBrokerTemplate() *servicecatalog.BrokerTemplate {
+	return &servicecatalog.BrokerTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-brokertemplate",
+		},
+		Spec: servicecatalog.BrokerTemplateSpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "a"},
+			},
+			Template: servicecatalog.ServiceBrokerSpec{
+				CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+					URL:            "http://broker.$(namespace).svc.cluster.local",
+					RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+					RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateBrokerTemplate(t *testing.T) {
+	cases := []struct {
+		name     string
+		template func() *servicecatalog.BrokerTemplate
+		valid    bool
+	}{
+		{
+			name:     "valid brokertemplate",
+			template: validBrokerTemplate,
+			valid:    true,
+		},
+		{
+			name: "valid brokertemplate - namespace in auth secret",
+			template: func() *servicecatalog.BrokerTemplate {
+				template := validBrokerTemplate()
+				template.Spec.Template.AuthInfo = &servicecatalog.ServiceBrokerAuthInfo{
+					Basic: &servicecatalog.BasicAuthConfig{
+						SecretRef: &servicecatalog.LocalObjectReference{
+							Name: "$(namespace)-broker-auth",
+						},
+						SecretNamespace: "$(namespace)",
+					},
+				}
+				return template
+			},
+			valid: true,
+		},
+		{
+			name: "valid brokertemplate - broker name",
+			template: func() *servicecatalog.BrokerTemplate {
+				template := validBrokerTemplate()
+				template.Spec.BrokerName = "team-broker"
+				return template
+			},
+			valid: true,
+		},
+		{
+			name: "invalid brokertemplate - namespace set",
+			template: func() *servicecatalog.BrokerTemplate {
+				template := validBrokerTemplate()
+				template.Namespace = "test-ns"
+				return template
+			},
+			valid: false,
+		},
+		{
+			name: "invalid brokertemplate - invalid broker name",
+			template: func() *servicecatalog.BrokerTemplate {
+				template := validBrokerTemplate()
+				template.Spec.BrokerName = "Team_Broker"
+				return template
+			},
+			valid: false,
+		},
+		{
+			name: "invalid brokertemplate - invalid namespace selector",
+			template: func() *servicecatalog.BrokerTemplate {
+				template := validBrokerTemplate()
+				template.Spec.NamespaceSelector = &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "team", Operator: "Unknown"},
+					},
+				}
+				return template
+			},
+			valid: false,
+		},
+		{
+			name: "invalid brokertemplate - missing URL",
+			template: func() *servicecatalog.BrokerTemplate {
+				template := validBrokerTemplate()
+				template.Spec.Template.URL = ""
+				return template
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		errs := ValidateBrokerTemplate(tc.template())
+		if len(errs) != 0 && tc.valid {
+			t.Errorf("%v: unexpected error: %v", tc.name, errs)
+		} else if len(errs) == 0 && !tc.valid {
+			t.Errorf("%v: unexpected success", tc.name)
+		}
+	}
+}
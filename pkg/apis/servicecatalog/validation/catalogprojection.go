@@ -0,0 +1,59 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+// ValidateCatalogProjection implements the validation rules for a
+// CatalogProjection.
+func ValidateCatalogProjection(projection *sc.CatalogProjection) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs,
+		apivalidation.ValidateObjectMeta(&projection.ObjectMeta,
+			false, /* namespace required */
+			apivalidation.NameIsDNSSubdomain,
+			field.NewPath("metadata"))...)
+
+	allErrs = append(allErrs, validateCatalogProjectionSpec(&projection.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+func validateCatalogProjectionSpec(spec *sc.CatalogProjectionSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.NamespaceSelector, fldPath.Child("namespaceSelector"))...)
+	}
+	if spec.CatalogRestrictions != nil {
+		allErrs = append(allErrs, validateCatalogRestrictions(spec.CatalogRestrictions, fldPath.Child("catalogRestrictions"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateCatalogProjectionUpdate checks that an update to a
+// CatalogProjection is valid.
+func ValidateCatalogProjectionUpdate(new *sc.CatalogProjection, old *sc.CatalogProjection) field.ErrorList {
+	return ValidateCatalogProjection(new)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+func validCatalogProjection() *servicecatalog.CatalogProjection {
+	return &servicecatalog.CatalogProjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-catalogprojection",
+		},
+		Spec: servicecatalog.CatalogProjectionSpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tenant": "true"},
+			},
+			CatalogRestrictions: &servicecatalog.CatalogRestrictions{
+				ServiceClass: []string{"spec.externalName in (mysql, postgres)"},
+				ServicePlan:  []string{"spec.free==true"},
+			},
+		},
+	}
+}
+
+func TestValidateCatalogProjection(t *testing.T) {
+	cases := []struct {
+		name       string
+		projection func() *servicecatalog.CatalogProjection
+		valid      bool
+	}{
+		{
+			name:       "valid catalogprojection",
+			projection: validCatalogProjection,
+			valid:      true,
+		},
+		{
+			name: "valid catalogprojection - no restrictions",
+			projection: func() *servicecatalog.CatalogProjection {
+				projection := validCatalogProjection()
+				projection.Spec.NamespaceSelector = nil
+				projection.Spec.CatalogRestrictions = nil
+				return projection
+			},
+			valid: true,
+		},
+		{
+			name: "invalid catalogprojection - namespace set",
+			projection: func() *servicecatalog.CatalogProjection {
+				projection := validCatalogProjection()
+				projection.Namespace = "test-ns"
+				return projection
+			},
+			valid: false,
+		},
+		{
+			name: "invalid catalogprojection - invalid namespace selector",
+			projection: func() *servicecatalog.CatalogProjection {
+				projection := validCatalogProjection()
+				projection.Spec.NamespaceSelector = &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "tenant", Operator: "Unknown"},
+					},
+				}
+				return projection
+			},
+			valid: false,
+		},
+		{
+			name: "invalid catalogprojection - invalid class restriction",
+			projection: func() *servicecatalog.CatalogProjection {
+				projection := validCatalogProjection()
+				projection.Spec.CatalogRestrictions.ServiceClass = []string{"spec.externalName=~mysql"}
+				return projection
+			},
+			valid: false,
+		},
+		{
+			name: "invalid catalogprojection - invalid plan restriction",
+			projection: func() *servicecatalog.CatalogProjection {
+				projection := validCatalogProjection()
+				projection.Spec.CatalogRestrictions.ServicePlan = []string{"spec.free=~true"}
+				return projection
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		errs := ValidateCatalogProjection(tc.projection())
+		if len(errs) != 0 && tc.valid {
+			t.Errorf("%v: unexpected error: %v", tc.name, errs)
+		} else if len(errs) == 0 && !tc.valid {
+			t.Errorf("%v: unexpected success", tc.name)
+		}
+	}
+}
@@ -103,6 +103,76 @@ func validateServiceInstanceSpec(spec *sc.ServiceInstanceSpec, fldPath *field.Pa
 	}
 
 	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.UpdateRequests, fldPath.Child("updateRequests"))...)
+	allErrs = append(allErrs, validateDeletionPolicy(spec.DeletionPolicy, fldPath.Child("deletionPolicy"))...)
+	allErrs = append(allErrs, validateBindingDeletionPolicy(spec.BindingDeletionPolicy, fldPath.Child("bindingDeletionPolicy"))...)
+
+	if spec.AutoBind != nil {
+		allErrs = append(allErrs, validateAutoBind(spec.AutoBind, fldPath.Child("autoBind"))...)
+	}
+
+	if spec.Adopt {
+		if create && !utilfeature.DefaultFeatureGate.Enabled(scfeatures.InstanceAdoption) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("adopt"), "adopting instances requires the InstanceAdoption feature gate"))
+		}
+		if spec.ExternalID == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("externalID"), "the externalID of the instance to adopt is required"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateDeletionPolicy validates the deletion policy of a ServiceInstance
+// or ServiceBinding.
+func validateDeletionPolicy(policy sc.DeletionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch policy {
+	case "", sc.DeletionPolicyDelete, sc.DeletionPolicyAbandon:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, policy, []string{
+			string(sc.DeletionPolicyDelete),
+			string(sc.DeletionPolicyAbandon),
+		}))
+	}
+
+	return allErrs
+}
+
+// validateBindingDeletionPolicy validates the binding deletion policy of a
+// ServiceInstance.
+func validateBindingDeletionPolicy(policy sc.BindingDeletionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch policy {
+	case "", sc.BindingDeletionPolicyBlock, sc.BindingDeletionPolicyCascade:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, policy, []string{
+			string(sc.BindingDeletionPolicyBlock),
+			string(sc.BindingDeletionPolicyCascade),
+		}))
+	}
+
+	return allErrs
+}
+
+// validateAutoBind validates the automatic binding of a ServiceInstance.
+func validateAutoBind(autoBind *sc.AutoBind, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoBind.SecretName != "" {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(autoBind.SecretName, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secretName"), autoBind.SecretName, msg))
+		}
+	}
+	if autoBind.Parameters != nil {
+		if _, err := controller.UnmarshalRawParameters(autoBind.Parameters.Raw); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("parameters"), "", "invalid inline parameters"))
+		}
+	}
+	if autoBind.ParametersFrom != nil {
+		allErrs = append(allErrs, validateParametersFromSource(autoBind.ParametersFrom, fldPath)...)
+	}
 
 	return allErrs
 }
@@ -335,6 +405,7 @@ func ValidateServiceInstanceUpdate(new *sc.ServiceInstance, old *sc.ServiceInsta
 	allErrs = append(allErrs, internalValidateServiceInstance(new, false)...)
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ExternalID, old.Spec.ExternalID, specFieldPath.Child("externalID"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.Adopt, old.Spec.Adopt, specFieldPath.Child("adopt"))...)
 
 	if new.Spec.UpdateRequests < old.Spec.UpdateRequests {
 		allErrs = append(allErrs, field.Invalid(specFieldPath.Child("updateRequests"), new.Spec.UpdateRequests, "new updateRequests value must not be less than the old one"))
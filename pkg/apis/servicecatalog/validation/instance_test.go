@@ -17,6 +17,7 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -24,8 +25,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 )
 
 const (
@@ -297,6 +300,121 @@ func TestValidateServiceInstance(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid configMapKeyRef in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{ConfigMapKeyRef: &servicecatalog.ConfigMapKeyReference{Name: "test-config-map", Key: "test-key"}}}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "key is missing in configMapKeyRef in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{ConfigMapKeyRef: &servicecatalog.ConfigMapKeyReference{Name: "test-config-map", Key: ""}}}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid https in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{HTTPS: &servicecatalog.HTTPSParametersSource{
+							URL:                     "https://vault.example.com/v1/params",
+							BearerTokenSecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-key-name", Key: "token"},
+						}}}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "non-https URL in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{HTTPS: &servicecatalog.HTTPSParametersSource{URL: "http://vault.example.com/v1/params"}}}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "multiple sources in one parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{
+							SecretKeyRef:    &servicecatalog.SecretKeyReference{Name: "test-key-name", Key: "test-key"},
+							ConfigMapKeyRef: &servicecatalog.ConfigMapKeyReference{Name: "test-config-map", Key: "test-key"},
+						}}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid deletionPolicy Abandon",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.DeletionPolicy = servicecatalog.DeletionPolicyAbandon
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid deletionPolicy",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.DeletionPolicy = "Orphan"
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid bindingDeletionPolicy Cascade",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.BindingDeletionPolicy = servicecatalog.BindingDeletionPolicyCascade
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid bindingDeletionPolicy",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.BindingDeletionPolicy = "Orphan"
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid autoBind",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.AutoBind = &servicecatalog.AutoBind{SecretName: "db-secret"}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid autoBind secretName",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.AutoBind = &servicecatalog.AutoBind{SecretName: "T_T"}
+				return i
+			}(),
+			valid: false,
+		},
 		{
 			name:     "valid with in-progress provision",
 			instance: validServiceInstanceWithInProgressProvision(),
@@ -900,6 +1018,46 @@ func TestValidateServiceInstance(t *testing.T) {
 	}
 }
 
+// TestValidateServiceInstanceAdopt tests that an instance can only adopt an
+// existing one with the InstanceAdoption feature enabled, and that it must
+// give the external ID of the instance it adopts.
+func TestValidateServiceInstanceAdopt(t *testing.T) {
+	cases := []struct {
+		name       string
+		enabled    bool
+		externalID string
+		create     bool
+		valid      bool
+	}{
+		{name: "feature enabled", enabled: true, externalID: "existing", create: true, valid: true},
+		{name: "feature disabled", enabled: false, externalID: "existing", create: true, valid: false},
+		{name: "feature disabled after create", enabled: false, externalID: "existing", create: false, valid: true},
+		{name: "missing externalID", enabled: true, externalID: "", create: true, valid: false},
+	}
+
+	for _, tc := range cases {
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=%v", scfeatures.InstanceAdoption, tc.enabled)); err != nil {
+			t.Fatalf("Failed to set InstanceAdoption feature: %v", err)
+		}
+
+		instance := validServiceInstanceForCreateClusterPlanRef()
+		if !tc.create {
+			instance = validClusterRefServiceInstance()
+		}
+		instance.Spec.Adopt = true
+		instance.Spec.ExternalID = tc.externalID
+
+		errs := internalValidateServiceInstance(instance, tc.create)
+		if len(errs) != 0 && tc.valid {
+			t.Errorf("%v: unexpected error: %v", tc.name, errs)
+		} else if len(errs) == 0 && !tc.valid {
+			t.Errorf("%v: unexpected success", tc.name)
+		}
+	}
+
+	utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.InstanceAdoption))
+}
+
 func TestInternalValidateServiceInstanceUpdateAllowed(t *testing.T) {
 	cases := []struct {
 		name             string
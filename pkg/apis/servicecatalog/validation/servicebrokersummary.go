@@ -0,0 +1,73 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+var validConditionStatuses = sets.NewString(
+	string(sc.ConditionTrue),
+	string(sc.ConditionFalse),
+	string(sc.ConditionUnknown),
+)
+
+// ValidateServiceBrokerSummary implements the validation rules for a
+// ServiceBrokerSummary.
+func ValidateServiceBrokerSummary(summary *sc.ServiceBrokerSummary) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs,
+		apivalidation.ValidateObjectMeta(&summary.ObjectMeta,
+			true, /* namespace required */
+			apivalidation.NameIsDNSSubdomain,
+			field.NewPath("metadata"))...)
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(summary.TotalBrokers), field.NewPath("totalBrokers"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(summary.ReadyBrokers), field.NewPath("readyBrokers"))...)
+	if summary.ReadyBrokers > summary.TotalBrokers {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("readyBrokers"), summary.ReadyBrokers, "must not be greater than totalBrokers"))
+	}
+
+	names := sets.NewString()
+	for i, entry := range summary.Brokers {
+		fldPath := field.NewPath("brokers").Index(i)
+		if entry.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name is required"))
+		} else if names.Has(entry.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), entry.Name))
+		}
+		names.Insert(entry.Name)
+		if !validConditionStatuses.Has(string(entry.Ready)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("ready"), entry.Ready, validConditionStatuses.List()))
+		}
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(entry.ServiceClasses), fldPath.Child("serviceClasses"))...)
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(entry.ServicePlans), fldPath.Child("servicePlans"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateServiceBrokerSummaryUpdate checks that an update to a
+// ServiceBrokerSummary is valid.
+func ValidateServiceBrokerSummaryUpdate(new *sc.ServiceBrokerSummary, old *sc.ServiceBrokerSummary) field.ErrorList {
+	return ValidateServiceBrokerSummary(new)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+func validServiceBrokerSummary() *servicecatalog.ServiceBrokerSummary {
+	return &servicecatalog.ServiceBrokerSummary{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      servicecatalog.ServiceBrokerSummaryName,
+			Namespace: "test-ns",
+		},
+		TotalBrokers: 2,
+		ReadyBrokers: 1,
+		Brokers: []servicecatalog.ServiceBrokerSummaryEntry{
+			{
+				Name:           "test-broker",
+				Ready:          servicecatalog.ConditionTrue,
+				ServiceClasses: 2,
+				ServicePlans:   5,
+			},
+			{
+				Name:   "other-broker",
+				Ready:  servicecatalog.ConditionFalse,
+				Reason: "ErrorFetchingCatalog",
+			},
+		},
+	}
+}
+
+func TestValidateServiceBrokerSummary(t *testing.T) {
+	cases := []struct {
+		name    string
+		summary func() *servicecatalog.ServiceBrokerSummary
+		valid   bool
+	}{
+		{
+			name:    "valid servicebrokersummary",
+			summary: validServiceBrokerSummary,
+			valid:   true,
+		},
+		{
+			name: "invalid servicebrokersummary - missing namespace",
+			summary: func() *servicecatalog.ServiceBrokerSummary {
+				summary := validServiceBrokerSummary()
+				summary.Namespace = ""
+				return summary
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebrokersummary - more ready than total brokers",
+			summary: func() *servicecatalog.ServiceBrokerSummary {
+				summary := validServiceBrokerSummary()
+				summary.ReadyBrokers = 3
+				return summary
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebrokersummary - duplicate broker",
+			summary: func() *servicecatalog.ServiceBrokerSummary {
+				summary := validServiceBrokerSummary()
+				summary.Brokers[1].Name = summary.Brokers[0].Name
+				return summary
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebrokersummary - invalid ready status",
+			summary: func() *servicecatalog.ServiceBrokerSummary {
+				summary := validServiceBrokerSummary()
+				summary.Brokers[0].Ready = "Maybe"
+				return summary
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebrokersummary - negative plan count",
+			summary: func() *servicecatalog.ServiceBrokerSummary {
+				summary := validServiceBrokerSummary()
+				summary.Brokers[0].ServicePlans = -1
+				return summary
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		errs := ValidateServiceBrokerSummary(tc.summary())
+		if len(errs) != 0 && tc.valid {
+			t.Errorf("%v: unexpected error: %v", tc.name, errs)
+		} else if len(errs) == 0 && !tc.valid {
+			t.Errorf("%v: unexpected success", tc.name)
+		}
+	}
+}
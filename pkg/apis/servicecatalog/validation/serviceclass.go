@@ -124,6 +124,8 @@ func ValidateServiceClass(serviceclass *sc.ServiceClass) field.ErrorList {
 func ValidateServiceClassUpdate(new *sc.ServiceClass, old *sc.ServiceClass) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, internalValidateServiceClass(new)...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ServiceBrokerNamespace, old.Spec.ServiceBrokerNamespace, field.NewPath("spec", "serviceBrokerNamespace"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ClusterServiceBroker, old.Spec.ClusterServiceBroker, field.NewPath("spec", "clusterServiceBroker"))...)
 
 	return allErrs
 }
@@ -139,6 +141,29 @@ func internalValidateServiceClass(clusterserviceclass *sc.ServiceClass) field.Er
 			field.NewPath("metadata"))...)
 
 	allErrs = append(allErrs, validateServiceClassSpec(&clusterserviceclass.Spec, field.NewPath("spec"), true)...)
+	allErrs = append(allErrs, validateServiceBrokerNamespace(clusterserviceclass.Namespace, clusterserviceclass.Spec.ServiceBrokerNamespace, clusterserviceclass.Spec.ClusterServiceBroker, field.NewPath("spec", "serviceBrokerNamespace"))...)
+	return allErrs
+}
+
+// validateServiceBrokerNamespace validates the namespace of the ServiceBroker
+// of a ServiceClass or ServicePlan in the given namespace, which is only set
+// when the broker is in another namespace. A ClusterServiceBroker has none.
+func validateServiceBrokerNamespace(namespace, brokerNamespace string, clusterServiceBroker bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if brokerNamespace == "" {
+		return allErrs
+	}
+	if clusterServiceBroker {
+		allErrs = append(allErrs, field.Invalid(fldPath, brokerNamespace, "must not be set when the broker is a ClusterServiceBroker"))
+		return allErrs
+	}
+
+	for _, msg := range apivalidation.ValidateNamespaceName(brokerNamespace, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath, brokerNamespace, msg))
+	}
+	if brokerNamespace == namespace {
+		allErrs = append(allErrs, field.Invalid(fldPath, brokerNamespace, "must only be set when the broker is in another namespace"))
+	}
 	return allErrs
 }
 
@@ -202,6 +202,52 @@ func TestValidateServiceClass(t *testing.T) {
 			serviceClass: validServiceClass(),
 			valid:        true,
 		},
+		{
+			name: "valid serviceClass - shared from another namespace",
+			serviceClass: func() *servicecatalog.ServiceClass {
+				s := validServiceClass()
+				s.Spec.ServiceBrokerNamespace = "broker-ns"
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid serviceClass - shared from its own namespace",
+			serviceClass: func() *servicecatalog.ServiceClass {
+				s := validServiceClass()
+				s.Spec.ServiceBrokerNamespace = s.Namespace
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid serviceClass - invalid broker namespace",
+			serviceClass: func() *servicecatalog.ServiceClass {
+				s := validServiceClass()
+				s.Spec.ServiceBrokerNamespace = "Broker_NS"
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid serviceClass - projected from a cluster broker",
+			serviceClass: func() *servicecatalog.ServiceClass {
+				s := validServiceClass()
+				s.Spec.ClusterServiceBroker = true
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid serviceClass - cluster broker with a namespace",
+			serviceClass: func() *servicecatalog.ServiceClass {
+				s := validServiceClass()
+				s.Spec.ClusterServiceBroker = true
+				s.Spec.ServiceBrokerNamespace = "broker-ns"
+				return s
+			}(),
+			valid: false,
+		},
 		{
 			name: "valid serviceClass - uppercase in GUID",
 			serviceClass: func() *servicecatalog.ServiceClass {
@@ -81,6 +81,7 @@ func validateServicePlan(servicePlan *sc.ServicePlan) field.ErrorList {
 			field.NewPath("metadata"))...)
 
 	allErrs = append(allErrs, validateServicePlanSpec(&servicePlan.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateServiceBrokerNamespace(servicePlan.Namespace, servicePlan.Spec.ServiceBrokerNamespace, servicePlan.Spec.ClusterServiceBroker, field.NewPath("spec", "serviceBrokerNamespace"))...)
 	return allErrs
 }
 
@@ -167,5 +168,7 @@ func ValidateServicePlanUpdate(new *sc.ServicePlan, old *sc.ServicePlan) field.E
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateServicePlan(new)...)
 	allErrs = append(allErrs, validateCommonServicePlanUpdate(new.Spec.CommonServicePlanSpec, old.Spec.CommonServicePlanSpec, "ServicePlan")...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ServiceBrokerNamespace, old.Spec.ServiceBrokerNamespace, field.NewPath("spec", "serviceBrokerNamespace"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ClusterServiceBroker, old.Spec.ClusterServiceBroker, field.NewPath("spec", "clusterServiceBroker"))...)
 	return allErrs
 }
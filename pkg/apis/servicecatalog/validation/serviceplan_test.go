@@ -221,6 +221,24 @@ func TestValidateServicePlan(t *testing.T) {
 			servicePlan: validServicePlan(),
 			valid:       true,
 		},
+		{
+			name: "valid ServicePlan - shared from another namespace",
+			servicePlan: func() *servicecatalog.ServicePlan {
+				s := validServicePlan()
+				s.Spec.ServiceBrokerNamespace = "broker-ns"
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid ServicePlan - shared from its own namespace",
+			servicePlan: func() *servicecatalog.ServicePlan {
+				s := validServicePlan()
+				s.Spec.ServiceBrokerNamespace = s.Namespace
+				return s
+			}(),
+			valid: false,
+		},
 		{
 			name: "valid ServicePlan - period in externalName",
 			servicePlan: func() *servicecatalog.ServicePlan {
@@ -440,6 +458,16 @@ func TestValidateServicePlanUpdate(t *testing.T) {
 			}(),
 			valid: true,
 		},
+		{
+			name: "servicePlan changing broker namespace",
+			old:  validServicePlan(),
+			new: func() *servicecatalog.ServicePlan {
+				s := validServicePlan()
+				s.Spec.ServiceBrokerNamespace = "broker-ns"
+				return s
+			}(),
+			valid: false,
+		},
 		{
 			name: "servicePlan changing external ID",
 			old:  validServicePlan(),
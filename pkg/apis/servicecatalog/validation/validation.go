@@ -17,9 +17,11 @@ limitations under the License.
 package validation
 
 import (
+	"net/url"
+	"regexp"
+
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	"regexp"
 )
 
 var hexademicalStringRegexp = regexp.MustCompile("^[[:xdigit:]]*$")
@@ -32,17 +34,51 @@ func validateParametersFromSource(parametersFrom []sc.ParametersFromSource, fldP
 	allErrs := field.ErrorList{}
 
 	for _, paramsFrom := range parametersFrom {
+		sources := 0
 		if paramsFrom.SecretKeyRef != nil {
-			if paramsFrom.SecretKeyRef.Name == "" {
-				allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom.secretKeyRef.name"), "name is required"))
-			}
-			if paramsFrom.SecretKeyRef.Key == "" {
-				allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom.secretKeyRef.key"), "key is required"))
-			}
-		} else {
+			sources++
+			allErrs = append(allErrs, validateKeyReference(paramsFrom.SecretKeyRef.Name, paramsFrom.SecretKeyRef.Key, fldPath.Child("parametersFrom.secretKeyRef"))...)
+		}
+		if paramsFrom.ConfigMapKeyRef != nil {
+			sources++
+			allErrs = append(allErrs, validateKeyReference(paramsFrom.ConfigMapKeyRef.Name, paramsFrom.ConfigMapKeyRef.Key, fldPath.Child("parametersFrom.configMapKeyRef"))...)
+		}
+		if paramsFrom.HTTPS != nil {
+			sources++
+			allErrs = append(allErrs, validateHTTPSParametersSource(paramsFrom.HTTPS, fldPath.Child("parametersFrom.https"))...)
+		}
+
+		switch {
+		case sources == 0:
 			allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom"), "source must not be empty if present"))
+		case sources > 1:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("parametersFrom"), paramsFrom, "only one of secretKeyRef, configMapKeyRef and https may be set"))
 		}
 	}
 
 	return allErrs
 }
+
+func validateKeyReference(name, key string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name is required"))
+	}
+	if key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "key is required"))
+	}
+	return allErrs
+}
+
+func validateHTTPSParametersSource(source *sc.HTTPSParametersSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if source.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "url is required"))
+	} else if u, err := url.Parse(source.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), source.URL, "url must be an absolute https URL"))
+	}
+	if ref := source.BearerTokenSecretKeyRef; ref != nil {
+		allErrs = append(allErrs, validateKeyReference(ref.Name, ref.Key, fldPath.Child("bearerTokenSecretKeyRef"))...)
+	}
+	return allErrs
+}
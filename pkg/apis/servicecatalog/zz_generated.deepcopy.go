@@ -89,6 +89,79 @@ func (in *AddKeysFromTransform) DeepCopy() *AddKeysFromTransform {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminUnbindRequest) DeepCopyInto(out *AdminUnbindRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminUnbindRequest.
+func (in *AdminUnbindRequest) DeepCopy() *AdminUnbindRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminUnbindRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdminUnbindRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppReference) DeepCopyInto(out *AppReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppReference.
+func (in *AppReference) DeepCopy() *AppReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AppReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoBind) DeepCopyInto(out *AutoBind) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(runtime.RawExtension)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.ParametersFrom != nil {
+		in, out := &in.ParametersFrom, &out.ParametersFrom
+		*out = make([]ParametersFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoBind.
+func (in *AutoBind) DeepCopy() *AutoBind {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoBind)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicAuthConfig) DeepCopyInto(out *BasicAuthConfig) {
 	*out = *in
@@ -139,6 +212,263 @@ func (in *BearerTokenAuthConfig) DeepCopy() *BearerTokenAuthConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerCatalog) DeepCopyInto(out *BrokerCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.RetrievalTime.DeepCopyInto(&out.RetrievalTime)
+	if in.Catalog != nil {
+		in, out := &in.Catalog, &out.Catalog
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(runtime.RawExtension)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerCatalog.
+func (in *BrokerCatalog) DeepCopy() *BrokerCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BrokerCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerHeaderValue) DeepCopyInto(out *BrokerHeaderValue) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(BrokerSecretKeyReference)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerHeaderValue.
+func (in *BrokerHeaderValue) DeepCopy() *BrokerHeaderValue {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerHeaderValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerSecretKeyReference) DeepCopyInto(out *BrokerSecretKeyReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerSecretKeyReference.
+func (in *BrokerSecretKeyReference) DeepCopy() *BrokerSecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerSecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerTemplate) DeepCopyInto(out *BrokerTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerTemplate.
+func (in *BrokerTemplate) DeepCopy() *BrokerTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BrokerTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerTemplateList) DeepCopyInto(out *BrokerTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BrokerTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerTemplateList.
+func (in *BrokerTemplateList) DeepCopy() *BrokerTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BrokerTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerTemplateSpec) DeepCopyInto(out *BrokerTemplateSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.LabelSelector)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerTemplateSpec.
+func (in *BrokerTemplateSpec) DeepCopy() *BrokerTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogProjection) DeepCopyInto(out *CatalogProjection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogProjection.
+func (in *CatalogProjection) DeepCopy() *CatalogProjection {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogProjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CatalogProjection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogProjectionList) DeepCopyInto(out *CatalogProjectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CatalogProjection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogProjectionList.
+func (in *CatalogProjectionList) DeepCopy() *CatalogProjectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogProjectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CatalogProjectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogProjectionSpec) DeepCopyInto(out *CatalogProjectionSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.LabelSelector)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.CatalogRestrictions != nil {
+		in, out := &in.CatalogRestrictions, &out.CatalogRestrictions
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(CatalogRestrictions)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogProjectionSpec.
+func (in *CatalogProjectionSpec) DeepCopy() *CatalogProjectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogProjectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CatalogRestrictions) DeepCopyInto(out *CatalogRestrictions) {
 	*out = *in
@@ -152,6 +482,16 @@ func (in *CatalogRestrictions) DeepCopyInto(out *CatalogRestrictions) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceClassExternalIDs != nil {
+		in, out := &in.ServiceClassExternalIDs, &out.ServiceClassExternalIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServicePlanExternalIDs != nil {
+		in, out := &in.ServicePlanExternalIDs, &out.ServicePlanExternalIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -375,7 +715,7 @@ func (in *ClusterServiceClass) DeepCopyInto(out *ClusterServiceClass) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -450,7 +790,7 @@ func (in *ClusterServiceClassSpec) DeepCopy() *ClusterServiceClassSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterServiceClassStatus) DeepCopyInto(out *ClusterServiceClassStatus) {
 	*out = *in
-	out.CommonServiceClassStatus = in.CommonServiceClassStatus
+	in.CommonServiceClassStatus.DeepCopyInto(&out.CommonServiceClassStatus)
 	return
 }
 
@@ -470,7 +810,7 @@ func (in *ClusterServicePlan) DeepCopyInto(out *ClusterServicePlan) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -546,7 +886,7 @@ func (in *ClusterServicePlanSpec) DeepCopy() *ClusterServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterServicePlanStatus) DeepCopyInto(out *ClusterServicePlanStatus) {
 	*out = *in
-	out.CommonServicePlanStatus = in.CommonServicePlanStatus
+	in.CommonServicePlanStatus.DeepCopyInto(&out.CommonServicePlanStatus)
 	return
 }
 
@@ -586,6 +926,38 @@ func (in *CommonServiceBrokerSpec) DeepCopyInto(out *CommonServiceBrokerSpec) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.CatalogRemovalDeprovisionDelay != nil {
+		in, out := &in.CatalogRemovalDeprovisionDelay, &out.CatalogRemovalDeprovisionDelay
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.CatalogRemovalGracePeriod != nil {
+		in, out := &in.CatalogRemovalGracePeriod, &out.CatalogRemovalGracePeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.FailoverURLs != nil {
+		in, out := &in.FailoverURLs, &out.FailoverURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomHeaders != nil {
+		in, out := &in.CustomHeaders, &out.CustomHeaders
+		*out = make(map[string]BrokerHeaderValue, len(*in))
+		for key, val := range *in {
+			newVal := new(BrokerHeaderValue)
+			val.DeepCopyInto(newVal)
+			(*out)[key] = *newVal
+		}
+	}
 	return
 }
 
@@ -625,6 +997,15 @@ func (in *CommonServiceBrokerStatus) DeepCopyInto(out *CommonServiceBrokerStatus
 			*out = (*in).DeepCopy()
 		}
 	}
+	if in.LastRelistDuration != nil {
+		in, out := &in.LastRelistDuration, &out.LastRelistDuration
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -676,6 +1057,14 @@ func (in *CommonServiceClassSpec) DeepCopy() *CommonServiceClassSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonServiceClassStatus) DeepCopyInto(out *CommonServiceClassStatus) {
 	*out = *in
+	if in.RemovalTime != nil {
+		in, out := &in.RemovalTime, &out.RemovalTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
 	return
 }
 
@@ -762,6 +1151,14 @@ func (in *CommonServicePlanSpec) DeepCopy() *CommonServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonServicePlanStatus) DeepCopyInto(out *CommonServicePlanStatus) {
 	*out = *in
+	if in.RemovalTime != nil {
+		in, out := &in.RemovalTime, &out.RemovalTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
 	return
 }
 
@@ -775,6 +1172,22 @@ func (in *CommonServicePlanStatus) DeepCopy() *CommonServicePlanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ExtraValue) DeepCopyInto(out *ExtraValue) {
 	{
@@ -795,6 +1208,61 @@ func (in ExtraValue) DeepCopy() ExtraValue {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForceDeleteRequest) DeepCopyInto(out *ForceDeleteRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForceDeleteRequest.
+func (in *ForceDeleteRequest) DeepCopy() *ForceDeleteRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ForceDeleteRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ForceDeleteRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSParametersSource) DeepCopyInto(out *HTTPSParametersSource) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.BearerTokenSecretKeyRef != nil {
+		in, out := &in.BearerTokenSecretKeyRef, &out.BearerTokenSecretKeyRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyReference)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSParametersSource.
+func (in *HTTPSParametersSource) DeepCopy() *HTTPSParametersSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSParametersSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
 	*out = *in
@@ -827,6 +1295,37 @@ func (in *ObjectReference) DeepCopy() *ObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersDiff) DeepCopyInto(out *ParametersDiff) {
+	*out = *in
+	if in.Added != nil {
+		in, out := &in.Added, &out.Added
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Changed != nil {
+		in, out := &in.Changed, &out.Changed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersDiff.
+func (in *ParametersDiff) DeepCopy() *ParametersDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParametersFromSource) DeepCopyInto(out *ParametersFromSource) {
 	*out = *in
@@ -839,6 +1338,24 @@ func (in *ParametersFromSource) DeepCopyInto(out *ParametersFromSource) {
 			**out = **in
 		}
 	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ConfigMapKeyReference)
+			**out = **in
+		}
+	}
+	if in.HTTPS != nil {
+		in, out := &in.HTTPS, &out.HTTPS
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(HTTPSParametersSource)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -853,21 +1370,46 @@ func (in *ParametersFromSource) DeepCopy() *ParametersFromSource {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlanReference) DeepCopyInto(out *PlanReference) {
+func (in *PlanReference) DeepCopyInto(out *PlanReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanReference.
+func (in *PlanReference) DeepCopy() *PlanReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelistRequest) DeepCopyInto(out *RelistRequest) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanReference.
-func (in *PlanReference) DeepCopy() *PlanReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelistRequest.
+func (in *RelistRequest) DeepCopy() *RelistRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(PlanReference)
+	out := new(RelistRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RelistRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoveKeyTransform) DeepCopyInto(out *RemoveKeyTransform) {
 	*out = *in
@@ -900,6 +1442,31 @@ func (in *RenameKeyTransform) DeepCopy() *RenameKeyTransform {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackRequest) DeepCopyInto(out *RollbackRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackRequest.
+func (in *RollbackRequest) DeepCopy() *RollbackRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RollbackRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
 	*out = *in
@@ -1084,6 +1651,15 @@ func (in *ServiceBindingPropertiesState) DeepCopy() *ServiceBindingPropertiesSta
 func (in *ServiceBindingSpec) DeepCopyInto(out *ServiceBindingSpec) {
 	*out = *in
 	out.ServiceInstanceRef = in.ServiceInstanceRef
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.LabelSelector)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	if in.Parameters != nil {
 		in, out := &in.Parameters, &out.Parameters
 		if *in == nil {
@@ -1107,6 +1683,15 @@ func (in *ServiceBindingSpec) DeepCopyInto(out *ServiceBindingSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AppRef != nil {
+		in, out := &in.AppRef, &out.AppRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AppReference)
+			**out = **in
+		}
+	}
 	if in.UserInfo != nil {
 		in, out := &in.UserInfo, &out.UserInfo
 		if *in == nil {
@@ -1174,6 +1759,39 @@ func (in *ServiceBindingStatus) DeepCopyInto(out *ServiceBindingStatus) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.BindStartedAt != nil {
+		in, out := &in.BindStartedAt, &out.BindStartedAt
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.ReadyAt != nil {
+		in, out := &in.ReadyAt, &out.ReadyAt
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.LastPollTime != nil {
+		in, out := &in.LastPollTime, &out.LastPollTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.AcceptedParameters != nil {
+		in, out := &in.AcceptedParameters, &out.AcceptedParameters
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(runtime.RawExtension)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -1312,6 +1930,11 @@ func (in *ServiceBrokerSpec) DeepCopyInto(out *ServiceBrokerSpec) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.TargetNamespaces != nil {
+		in, out := &in.TargetNamespaces, &out.TargetNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1342,13 +1965,103 @@ func (in *ServiceBrokerStatus) DeepCopy() *ServiceBrokerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBrokerSummary) DeepCopyInto(out *ServiceBrokerSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]ServiceBrokerSummaryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerSummary.
+func (in *ServiceBrokerSummary) DeepCopy() *ServiceBrokerSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBrokerSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceBrokerSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBrokerSummaryEntry) DeepCopyInto(out *ServiceBrokerSummaryEntry) {
+	*out = *in
+	if in.LastCatalogRetrievalTime != nil {
+		in, out := &in.LastCatalogRetrievalTime, &out.LastCatalogRetrievalTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerSummaryEntry.
+func (in *ServiceBrokerSummaryEntry) DeepCopy() *ServiceBrokerSummaryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBrokerSummaryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBrokerSummaryList) DeepCopyInto(out *ServiceBrokerSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceBrokerSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerSummaryList.
+func (in *ServiceBrokerSummaryList) DeepCopy() *ServiceBrokerSummaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBrokerSummaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceBrokerSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClass) DeepCopyInto(out *ServiceClass) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1423,7 +2136,7 @@ func (in *ServiceClassSpec) DeepCopy() *ServiceClassSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClassStatus) DeepCopyInto(out *ServiceClassStatus) {
 	*out = *in
-	out.CommonServiceClassStatus = in.CommonServiceClassStatus
+	in.CommonServiceClassStatus.DeepCopyInto(&out.CommonServiceClassStatus)
 	return
 }
 
@@ -1515,6 +2228,91 @@ func (in *ServiceInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstanceOperationRecord) DeepCopyInto(out *ServiceInstanceOperationRecord) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.OperationKey != nil {
+		in, out := &in.OperationKey, &out.OperationKey
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceOperationRecord.
+func (in *ServiceInstanceOperationRecord) DeepCopy() *ServiceInstanceOperationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInstanceOperationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstanceOperations) DeepCopyInto(out *ServiceInstanceOperations) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceInstanceOperationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceOperations.
+func (in *ServiceInstanceOperations) DeepCopy() *ServiceInstanceOperations {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInstanceOperations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceInstanceOperations) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstancePropertiesSnapshot) DeepCopyInto(out *ServiceInstancePropertiesSnapshot) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	in.Properties.DeepCopyInto(&out.Properties)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstancePropertiesSnapshot.
+func (in *ServiceInstancePropertiesSnapshot) DeepCopy() *ServiceInstancePropertiesSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInstancePropertiesSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceInstancePropertiesState) DeepCopyInto(out *ServiceInstancePropertiesState) {
 	*out = *in
@@ -1536,6 +2334,15 @@ func (in *ServiceInstancePropertiesState) DeepCopyInto(out *ServiceInstancePrope
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.Context != nil {
+		in, out := &in.Context, &out.Context
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(runtime.RawExtension)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -1614,6 +2421,15 @@ func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.AutoBind != nil {
+		in, out := &in.AutoBind, &out.AutoBind
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AutoBind)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -1681,6 +2497,53 @@ func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.ProvisionStartedAt != nil {
+		in, out := &in.ProvisionStartedAt, &out.ProvisionStartedAt
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.ReadyAt != nil {
+		in, out := &in.ReadyAt, &out.ReadyAt
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.LastPollTime != nil {
+		in, out := &in.LastPollTime, &out.LastPollTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.ParametersDiff != nil {
+		in, out := &in.ParametersDiff, &out.ParametersDiff
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ParametersDiff)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.PropertiesHistory != nil {
+		in, out := &in.PropertiesHistory, &out.PropertiesHistory
+		*out = make([]ServiceInstancePropertiesSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]ServiceInstanceOperationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1700,7 +2563,7 @@ func (in *ServicePlan) DeepCopyInto(out *ServicePlan) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1776,7 +2639,7 @@ func (in *ServicePlanSpec) DeepCopy() *ServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServicePlanStatus) DeepCopyInto(out *ServicePlanStatus) {
 	*out = *in
-	out.CommonServicePlanStatus = in.CommonServicePlanStatus
+	in.CommonServicePlanStatus.DeepCopyInto(&out.CommonServicePlanStatus)
 	return
 }
 
@@ -0,0 +1,239 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryptionconfig builds the transformers that encrypt the objects
+// the API server stores in etcd, from the encryption provider configuration
+// file of the Kubernetes API server. The transformers are those of
+// k8s.io/apiserver; only the parsing of the configuration is done here,
+// because k8s.io/apiserver/pkg/server/options/encryptionconfig also builds
+// the KMS and secretbox providers, whose gRPC and NaCl dependencies are not
+// vendored.
+package encryptionconfig
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/storage/value"
+	aestransformer "k8s.io/apiserver/pkg/storage/value/encrypt/aes"
+	"k8s.io/apiserver/pkg/storage/value/encrypt/identity"
+)
+
+const (
+	aesCBCTransformerPrefixV1    = "k8s:enc:aescbc:v1:"
+	aesGCMTransformerPrefixV1    = "k8s:enc:aesgcm:v1:"
+	encryptionConfigKind         = "EncryptionConfig"
+	encryptionConfigAPIVersionV1 = "v1"
+)
+
+// EncryptionConfig lists the providers that encrypt each resource. The first
+// provider of a resource encrypts the objects that are written, and all of
+// them are tried to decrypt the objects that are read, so that a key can be
+// rotated by adding a new key first and removing the old key once every object
+// has been written again.
+type EncryptionConfig struct {
+	Kind       string           `json:"kind"`
+	APIVersion string           `json:"apiVersion"`
+	Resources  []ResourceConfig `json:"resources"`
+}
+
+// ResourceConfig lists the providers that encrypt some resources.
+type ResourceConfig struct {
+	// Resources are the resources to encrypt, such as
+	// serviceinstances.servicecatalog.k8s.io.
+	Resources []string `json:"resources"`
+	// Providers are the providers that encrypt and decrypt the resources.
+	Providers []ProviderConfig `json:"providers"`
+}
+
+// ProviderConfig is a provider of encryption. Exactly one of its fields must
+// be set.
+type ProviderConfig struct {
+	AESGCM    *AESConfig       `json:"aesgcm,omitempty"`
+	AESCBC    *AESConfig       `json:"aescbc,omitempty"`
+	Secretbox *SecretboxConfig `json:"secretbox,omitempty"`
+	Identity  *IdentityConfig  `json:"identity,omitempty"`
+}
+
+// AESConfig holds the keys of an AES provider.
+type AESConfig struct {
+	Keys []Key `json:"keys"`
+}
+
+// SecretboxConfig holds the keys of a secretbox provider. The secretbox
+// provider is not supported by the service catalog API server yet.
+type SecretboxConfig struct {
+	Keys []Key `json:"keys"`
+}
+
+// IdentityConfig is the provider that stores objects as they are.
+type IdentityConfig struct{}
+
+// Key is a named key of a provider.
+type Key struct {
+	// Name is the name of the key, which is stored with the objects it
+	// encrypts.
+	Name string `json:"name"`
+	// Secret is the base64 encoded key.
+	Secret string `json:"secret"`
+}
+
+// GetTransformerOverrides returns the transformers of the resources listed in
+// the encryption provider configuration file at the given path.
+func GetTransformerOverrides(filepath string) (map[schema.GroupResource]value.Transformer, error) {
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening encryption provider configuration file %q: %v", filepath, err)
+	}
+	defer f.Close()
+
+	result, err := ParseEncryptionConfiguration(f)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing encryption provider configuration file %q: %v", filepath, err)
+	}
+	return result, nil
+}
+
+// ParseEncryptionConfiguration returns the transformers of the resources
+// listed in the given encryption provider configuration.
+func ParseEncryptionConfiguration(f io.Reader) (map[schema.GroupResource]value.Transformer, error) {
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not read contents: %v", err)
+	}
+
+	var config EncryptionConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("error while parsing file: %v", err)
+	}
+	if config.Kind != encryptionConfigKind {
+		return nil, fmt.Errorf("invalid configuration kind %q provided, expected %q", config.Kind, encryptionConfigKind)
+	}
+	if config.APIVersion != encryptionConfigAPIVersionV1 {
+		return nil, fmt.Errorf("unsupported configuration apiVersion %q, expected %q", config.APIVersion, encryptionConfigAPIVersionV1)
+	}
+	if len(config.Resources) == 0 {
+		return nil, fmt.Errorf("invalid configuration, no resources provided")
+	}
+
+	result := map[schema.GroupResource]value.Transformer{}
+	for _, resourceConfig := range config.Resources {
+		if len(resourceConfig.Resources) == 0 {
+			return nil, fmt.Errorf("invalid configuration, a resource config has no resources")
+		}
+		transformers, err := getPrefixTransformers(&resourceConfig)
+		if err != nil {
+			return nil, err
+		}
+		transformer := value.NewMutableTransformer(value.NewPrefixTransformers(fmt.Errorf("no matching prefix found"), transformers...))
+		for _, resource := range resourceConfig.Resources {
+			gr := schema.ParseGroupResource(resource)
+			if _, ok := result[gr]; ok {
+				return nil, fmt.Errorf("invalid configuration, the resource %q is listed more than once", resource)
+			}
+			result[gr] = transformer
+		}
+	}
+	return result, nil
+}
+
+// getPrefixTransformers returns the transformers of the providers of the
+// given resources, in order.
+func getPrefixTransformers(config *ResourceConfig) ([]value.PrefixTransformer, error) {
+	if len(config.Providers) == 0 {
+		return nil, fmt.Errorf("invalid configuration, no providers for the resources %v", config.Resources)
+	}
+
+	var result []value.PrefixTransformer
+	for _, provider := range config.Providers {
+		found := 0
+		if provider.AESGCM != nil {
+			found++
+			transformers, err := getAESPrefixTransformers(provider.AESGCM, aestransformer.NewGCMTransformer, aesGCMTransformerPrefixV1)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, transformers...)
+		}
+		if provider.AESCBC != nil {
+			found++
+			transformers, err := getAESPrefixTransformers(provider.AESCBC, aestransformer.NewCBCTransformer, aesCBCTransformerPrefixV1)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, transformers...)
+		}
+		if provider.Secretbox != nil {
+			return nil, fmt.Errorf("invalid configuration, the secretbox provider is not supported, use aescbc or aesgcm instead")
+		}
+		if provider.Identity != nil {
+			found++
+			result = append(result, value.PrefixTransformer{
+				Transformer: identity.NewEncryptCheckTransformer(),
+				Prefix:      []byte{},
+			})
+		}
+		if found != 1 {
+			return nil, fmt.Errorf("invalid provider configuration: exactly one provider must be specified in each provider entry, found %d", found)
+		}
+	}
+	return result, nil
+}
+
+// getAESPrefixTransformers returns a transformer for each key of an AES
+// provider. The first key encrypts the objects that are written.
+func getAESPrefixTransformers(config *AESConfig, newTransformer func(cipher.Block) value.Transformer, prefix string) ([]value.PrefixTransformer, error) {
+	if len(config.Keys) == 0 {
+		return nil, fmt.Errorf("aes provider has no valid keys")
+	}
+
+	keyNames := map[string]bool{}
+	var result []value.PrefixTransformer
+	for _, key := range config.Keys {
+		if key.Name == "" {
+			return nil, fmt.Errorf("key with invalid name provided")
+		}
+		if strings.Contains(key.Name, ":") {
+			return nil, fmt.Errorf("key name %q must not contain ':'", key.Name)
+		}
+		if keyNames[key.Name] {
+			return nil, fmt.Errorf("duplicate key name %q", key.Name)
+		}
+		keyNames[key.Name] = true
+
+		secret, err := base64.StdEncoding.DecodeString(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("could not obtain secret for named key %q: %v", key.Name, err)
+		}
+		block, err := aes.NewCipher(secret)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating cipher for named key %q: %v", key.Name, err)
+		}
+		result = append(result, value.PrefixTransformer{
+			Transformer: newTransformer(block),
+			Prefix:      []byte(prefix + key.Name + ":"),
+		})
+	}
+	return result, nil
+}
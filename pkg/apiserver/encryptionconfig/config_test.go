@@ -0,0 +1,237 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryptionconfig
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/storage/value"
+)
+
+const (
+	// 32 byte keys, base64 encoded
+	testKey1 = "c2VjcmV0IGlzIHNlY3VyZSwgSSB0aGluayBzbyB0b28="
+	testKey2 = "dGhpcyBpcyBhbm90aGVyIDMyIGJ5dGUga2V5IGZvciA="
+)
+
+var instances = schema.GroupResource{Group: "servicecatalog.k8s.io", Resource: "serviceinstances"}
+
+func parseTransformer(t *testing.T, config string) value.Transformer {
+	transformers, err := ParseEncryptionConfiguration(strings.NewReader(config))
+	if err != nil {
+		t.Fatalf("unexpected error parsing the configuration: %v", err)
+	}
+	transformer, ok := transformers[instances]
+	if !ok {
+		t.Fatalf("expected a transformer for %v, got %v", instances, transformers)
+	}
+	return transformer
+}
+
+func TestEncryptionConfig(t *testing.T) {
+	ctx := value.DefaultContext([]byte("/registry/serviceinstances/test-ns/test-instance"))
+	data := []byte(`{"kind":"ServiceInstance"}`)
+
+	for _, provider := range []string{"aesgcm", "aescbc"} {
+		transformer := parseTransformer(t, `
+kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - serviceinstances.servicecatalog.k8s.io
+  providers:
+  - `+provider+`:
+      keys:
+      - name: key1
+        secret: `+testKey1+`
+  - identity: {}
+`)
+		stored, err := transformer.TransformToStorage(data, ctx)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", provider, err)
+		}
+		if prefix := "k8s:enc:" + provider + ":v1:key1:"; !bytes.HasPrefix(stored, []byte(prefix)) {
+			t.Fatalf("%v: expected the stored data to start with %q, got %q", provider, prefix, stored)
+		}
+		if bytes.Contains(stored, data) {
+			t.Fatalf("%v: expected the stored data to be encrypted, got %q", provider, stored)
+		}
+		read, stale, err := transformer.TransformFromStorage(stored, ctx)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", provider, err)
+		}
+		if stale || !bytes.Equal(read, data) {
+			t.Fatalf("%v: expected to read %q, got %q (stale: %v)", provider, data, read, stale)
+		}
+
+		// Unencrypted data is read with the identity provider, and is stale.
+		read, stale, err = transformer.TransformFromStorage(data, ctx)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", provider, err)
+		}
+		if !stale || !bytes.Equal(read, data) {
+			t.Fatalf("%v: expected to read stale %q, got %q (stale: %v)", provider, data, read, stale)
+		}
+	}
+}
+
+func TestEncryptionConfigKeyRotation(t *testing.T) {
+	ctx := value.DefaultContext([]byte("/registry/serviceinstances/test-ns/test-instance"))
+	data := []byte(`{"kind":"ServiceInstance"}`)
+
+	old := parseTransformer(t, `
+kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - serviceinstances.servicecatalog.k8s.io
+  providers:
+  - aescbc:
+      keys:
+      - name: key1
+        secret: `+testKey1+`
+`)
+	stored, err := old.TransformToStorage(data, ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rotated := parseTransformer(t, `
+kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - serviceinstances.servicecatalog.k8s.io
+  providers:
+  - aescbc:
+      keys:
+      - name: key2
+        secret: `+testKey2+`
+      - name: key1
+        secret: `+testKey1+`
+`)
+	read, stale, err := rotated.TransformFromStorage(stored, ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !stale || !bytes.Equal(read, data) {
+		t.Fatalf("expected to read stale %q with the old key, got %q (stale: %v)", data, read, stale)
+	}
+
+	// The identity provider does not read encrypted data.
+	identity := parseTransformer(t, `
+kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - serviceinstances.servicecatalog.k8s.io
+  providers:
+  - identity: {}
+`)
+	if _, _, err := identity.TransformFromStorage(stored, ctx); err == nil {
+		t.Fatal("expected an error reading encrypted data without its key")
+	}
+}
+
+func TestEncryptionConfigErrors(t *testing.T) {
+	cases := []struct {
+		name        string
+		config      string
+		expectedErr string
+	}{
+		{
+			name:        "wrong kind",
+			config:      "kind: Secret\napiVersion: v1\n",
+			expectedErr: "invalid configuration kind",
+		},
+		{
+			name:        "no resources",
+			config:      "kind: EncryptionConfig\napiVersion: v1\n",
+			expectedErr: "no resources provided",
+		},
+		{
+			name: "no providers",
+			config: `
+kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - serviceinstances.servicecatalog.k8s.io
+`,
+			expectedErr: "no providers",
+		},
+		{
+			name: "two providers in one entry",
+			config: `
+kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - serviceinstances.servicecatalog.k8s.io
+  providers:
+  - identity: {}
+    aesgcm:
+      keys:
+      - name: key1
+        secret: ` + testKey1 + `
+`,
+			expectedErr: "exactly one provider",
+		},
+		{
+			name: "invalid key length",
+			config: `
+kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - serviceinstances.servicecatalog.k8s.io
+  providers:
+  - aesgcm:
+      keys:
+      - name: key1
+        secret: c2VjcmV0
+`,
+			expectedErr: "invalid key size",
+		},
+		{
+			name: "secretbox",
+			config: `
+kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - serviceinstances.servicecatalog.k8s.io
+  providers:
+  - secretbox:
+      keys:
+      - name: key1
+        secret: ` + testKey1 + `
+`,
+			expectedErr: "secretbox provider is not supported",
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := ParseEncryptionConfiguration(strings.NewReader(tc.config))
+		if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+			t.Errorf("%v: expected an error containing %q, got %v", tc.name, tc.expectedErr, err)
+		}
+	}
+}
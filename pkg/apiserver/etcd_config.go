@@ -24,6 +24,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/apiserver/pkg/server/storage"
+	"k8s.io/client-go/tools/record"
 )
 
 // EtcdConfig contains a generic API server Config along with config specific to
@@ -38,19 +39,25 @@ type extraConfig struct {
 	// BABYNETES: cargo culted from master.go
 	deleteCollectionWorkers int
 	storageFactory          storage.StorageFactory
+	// recorder records the events of the objects served, if not nil
+	recorder record.EventRecorder
 }
 
-// NewEtcdConfig returns a new server config to describe an etcd-backed API server
+// NewEtcdConfig returns a new server config to describe an etcd-backed API
+// server. The given recorder records the events of the objects served; it may
+// be nil.
 func NewEtcdConfig(
 	genCfg *genericapiserver.RecommendedConfig,
 	deleteCollWorkers int,
 	factory storage.StorageFactory,
+	recorder record.EventRecorder,
 ) Config {
 	return &etcdConfig{
 		genericConfig: genCfg,
 		extraConfig: &extraConfig{
 			deleteCollectionWorkers: deleteCollWorkers,
 			storageFactory:          factory,
+			recorder:                recorder,
 		},
 	}
 }
@@ -99,7 +106,7 @@ func (c completedEtcdConfig) NewServer(stopCh <-chan struct{}) (*ServiceCatalogA
 
 	glog.V(4).Infoln("Installing API groups")
 	// default namespace doesn't matter for etcd
-	providers := restStorageProviders("" /* default namespace */, server.StorageTypeEtcd, nil)
+	providers := restStorageProviders("" /* default namespace */, server.StorageTypeEtcd, nil, c.extraConfig.recorder)
 	for _, provider := range providers {
 		groupInfo, err := provider.NewRESTStorage(c.apiResourceConfigSource, roFactory)
 		if IsErrAPIGroupDisabled(err) {
@@ -118,11 +125,19 @@ func (c completedEtcdConfig) NewServer(stopCh <-chan struct{}) (*ServiceCatalogA
 			for _, mappings := range groupInfo.VersionedResourcesStorageMap { // gv to resource mappings
 				for _, storage := range mappings { // resource name (brokers, brokers/status) to backing storage
 					go func(store rest.Storage) {
-						s, ok := store.(*registry.Store)
-						if ok {
-							<-stopCh
-							s.DestroyFunc()
+						var s *registry.Store
+						switch store := store.(type) {
+						case *registry.Store:
+							s = store
+						case *server.DiscoveryStore:
+							s = store.Store
+						case *server.FieldIndexedStore:
+							s = store.Store
+						default:
+							return
 						}
+						<-stopCh
+						s.DestroyFunc()
 					}(storage)
 				}
 			}
@@ -0,0 +1,61 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package filters contains the HTTP filters that the service catalog API
+// server adds to the handler chain of the generic API server.
+package filters
+
+import (
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+)
+
+// dryRunParam is the query parameter with which clients ask for a request
+// to be validated and admitted without being persisted.
+const dryRunParam = "dryRun"
+
+// WithDryRunRejected rejects the mutating requests that ask for a dry run.
+//
+// The registries of the service catalog API server cannot leave out the
+// persistence of a request, so a dry run of a request, such as the one sent
+// by kubectl apply --server-dry-run, would actually create, change or delete
+// the resources. Rejecting it tells the client that dry runs are not
+// supported instead. This is a stopgap until the vendored k8s.io/apiserver
+// passes dry runs to the registries.
+func WithDryRunRejected(handler http.Handler, s runtime.NegotiatedSerializer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if isMutating(req.Method) && len(req.URL.Query()[dryRunParam]) > 0 {
+			err := apierrors.NewBadRequest("dry run is not supported by the service catalog API server")
+			responsewriters.ErrorNegotiated(err, s, schema.GroupVersion{}, w, req)
+			return
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// isMutating returns whether requests with the given HTTP method change
+// resources.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+)
+
+func TestWithDryRunRejected(t *testing.T) {
+	cases := []struct {
+		name           string
+		method         string
+		url            string
+		expectedCalled bool
+	}{
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			url:            "/apis/servicecatalog.k8s.io/v1beta1/namespaces/test-ns/serviceinstances",
+			expectedCalled: true,
+		},
+		{
+			name:   "dry run of a create",
+			method: http.MethodPost,
+			url:    "/apis/servicecatalog.k8s.io/v1beta1/namespaces/test-ns/serviceinstances?dryRun=All",
+		},
+		{
+			name:   "dry run of an update",
+			method: http.MethodPut,
+			url:    "/apis/servicecatalog.k8s.io/v1beta1/namespaces/test-ns/serviceinstances/test-instance?dryRun=All",
+		},
+		{
+			name:   "dry run of a patch",
+			method: http.MethodPatch,
+			url:    "/apis/servicecatalog.k8s.io/v1beta1/namespaces/test-ns/serviceinstances/test-instance?dryRun=All",
+		},
+		{
+			name:   "dry run of a delete",
+			method: http.MethodDelete,
+			url:    "/apis/servicecatalog.k8s.io/v1beta1/namespaces/test-ns/serviceinstances/test-instance?dryRun=All",
+		},
+		{
+			name:           "get with a dry run parameter",
+			method:         http.MethodGet,
+			url:            "/apis/servicecatalog.k8s.io/v1beta1/namespaces/test-ns/serviceinstances/test-instance?dryRun=All",
+			expectedCalled: true,
+		},
+	}
+
+	for _, tc := range cases {
+		called := false
+		handler := WithDryRunRejected(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			called = true
+		}), api.Codecs)
+
+		req := httptest.NewRequest(tc.method, tc.url, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		if e, a := tc.expectedCalled, called; e != a {
+			t.Errorf("%v: expected the handler to be called: %v, got %v", tc.name, e, a)
+		}
+		if !tc.expectedCalled && w.Code != http.StatusBadRequest {
+			t.Errorf("%v: expected status %v, got %v", tc.name, http.StatusBadRequest, w.Code)
+		}
+	}
+}
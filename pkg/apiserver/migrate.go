@@ -0,0 +1,103 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/server/storage"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/migration"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+)
+
+// MigrateStorage rewrites the stored objects of every resource of the enabled
+// API groups that are not stored in the storage version of their group, as
+// configured in the given storage factory. It is meant to be run after the
+// storage version of a group changes, before the API server stops being able
+// to read the previous version.
+func MigrateStorage(storageFactory storage.StorageFactory, chunkSize int64) error {
+	roFactory := etcdRESTOptionsFactory{
+		storageFactory:   storageFactory,
+		storageDecorator: generic.UndecoratedStorage,
+	}
+
+	providers := restStorageProviders("" /* default namespace */, server.StorageTypeEtcd, nil, nil)
+	for _, provider := range providers {
+		groupInfo, err := provider.NewRESTStorage(DefaultAPIResourceConfigSource(), roFactory)
+		if IsErrAPIGroupDisabled(err) {
+			glog.Warningf("Skipping API group %v because it is not enabled", provider.GroupName())
+			continue
+		} else if err != nil {
+			return fmt.Errorf("error initializing storage for provider %v: %v", provider.GroupName(), err)
+		}
+
+		for _, store := range resourceStores(groupInfo.VersionedResourcesStorageMap) {
+			result, err := migration.MigrateStore(context.Background(), store, chunkSize)
+			store.DestroyFunc()
+			if err != nil {
+				return err
+			}
+			glog.Infof("Migrated %v: %d objects, %d rewritten", store.DefaultQualifiedResource, result.Objects, result.Rewritten)
+		}
+	}
+	return nil
+}
+
+// resourceStores returns the registry stores of the resources of the given
+// versioned storage map, in the order of their names. A resource served in
+// several versions is stored once, so its store is only returned once.
+// Subresources are stored with their resource, so they are left out.
+func resourceStores(storageMap map[string]map[string]rest.Storage) []*registry.Store {
+	stores := map[string]*registry.Store{}
+	for _, resources := range storageMap {
+		for resource, s := range resources {
+			if strings.Contains(resource, "/") {
+				continue
+			}
+			switch s := s.(type) {
+			case *registry.Store:
+				stores[resource] = s
+			case *server.DiscoveryStore:
+				stores[resource] = s.Store
+			case *server.FieldIndexedStore:
+				stores[resource] = s.Store
+			default:
+				glog.Warningf("Skipping resource %v, which is not stored by a registry store", resource)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(stores))
+	for name := range stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sorted := make([]*registry.Store, 0, len(names))
+	for _, name := range names {
+		sorted = append(sorted, stores[name])
+	}
+	return sorted
+}
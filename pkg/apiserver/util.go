@@ -24,6 +24,7 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/client-go/pkg/version"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 )
 
 const (
@@ -34,12 +35,14 @@ func restStorageProviders(
 	defaultNamespace string,
 	storageType server.StorageType,
 	restClient restclient.Interface,
+	recorder record.EventRecorder,
 ) []RESTStorageProvider {
 	return []RESTStorageProvider{
 		servicecatalogrest.StorageProvider{
 			DefaultNamespace: defaultNamespace,
 			StorageType:      storageType,
 			RESTClient:       restClient,
+			EventRecorder:    recorder,
 		},
 		settingsrest.StorageProvider{
 			StorageType: storageType,
@@ -0,0 +1,70 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	testing "k8s.io/client-go/testing"
+)
+
+// GetCatalog is a non-generated fake to get the catalog subresource of a
+// cluster broker
+func (c *FakeClusterServiceBrokers) GetCatalog(name string) (*v1beta1.BrokerCatalog, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetSubresourceAction(clusterservicebrokersResource, "catalog", name), &v1beta1.BrokerCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BrokerCatalog), err
+}
+
+// UpdateCatalog is a non-generated fake to put to the catalog subresource of
+// a cluster broker
+func (c *FakeClusterServiceBrokers) UpdateCatalog(catalog *v1beta1.BrokerCatalog) (*v1beta1.BrokerCatalog, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(clusterservicebrokersResource, "catalog", catalog), &v1beta1.BrokerCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BrokerCatalog), err
+}
+
+// GetCatalog is a non-generated fake to get the catalog subresource of a
+// namespaced broker
+func (c *FakeServiceBrokers) GetCatalog(name string) (*v1beta1.BrokerCatalog, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(servicebrokersResource, c.ns, "catalog", name), &v1beta1.BrokerCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BrokerCatalog), err
+}
+
+// UpdateCatalog is a non-generated fake to put to the catalog subresource of
+// a namespaced broker
+func (c *FakeServiceBrokers) UpdateCatalog(catalog *v1beta1.BrokerCatalog) (*v1beta1.BrokerCatalog, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(servicebrokersResource, "catalog", c.ns, catalog), &v1beta1.BrokerCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.BrokerCatalog), err
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	testing "k8s.io/client-go/testing"
+)
+
+// ForceDelete is a non-generated fake to post to the forcedelete subresource
+// of an instance
+func (c *FakeServiceInstances) ForceDelete(name string, request *v1beta1.ForceDeleteRequest) error {
+	_, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(serviceinstancesResource, name, "forcedelete", c.ns, request), &v1beta1.ForceDeleteRequest{})
+	return err
+}
+
+// ForceDelete is a non-generated fake to post to the forcedelete subresource
+// of a binding
+func (c *FakeServiceBindings) ForceDelete(name string, request *v1beta1.ForceDeleteRequest) error {
+	_, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(servicebindingsResource, name, "forcedelete", c.ns, request), &v1beta1.ForceDeleteRequest{})
+	return err
+}
+
+// AdminUnbind is a non-generated fake to post to the adminunbind subresource
+// of a binding
+func (c *FakeServiceBindings) AdminUnbind(name string, request *v1beta1.AdminUnbindRequest) error {
+	_, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(servicebindingsResource, name, "adminunbind", c.ns, request), &v1beta1.AdminUnbindRequest{})
+	return err
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	testing "k8s.io/client-go/testing"
+)
+
+// Relist is a non-generated fake to post to the relist subresource of a
+// broker
+func (c *FakeClusterServiceBrokers) Relist(name string, request *v1beta1.RelistRequest) (*v1beta1.ClusterServiceBroker, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateSubresourceAction(clusterservicebrokersResource, name, "relist", request), &v1beta1.ClusterServiceBroker{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ClusterServiceBroker), err
+}
@@ -28,6 +28,14 @@ type FakeServicecatalogV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeServicecatalogV1beta1) BrokerTemplates() v1beta1.BrokerTemplateInterface {
+	return &FakeBrokerTemplates{c}
+}
+
+func (c *FakeServicecatalogV1beta1) CatalogProjections() v1beta1.CatalogProjectionInterface {
+	return &FakeCatalogProjections{c}
+}
+
 func (c *FakeServicecatalogV1beta1) ClusterServiceBrokers() v1beta1.ClusterServiceBrokerInterface {
 	return &FakeClusterServiceBrokers{c}
 }
@@ -48,6 +56,10 @@ func (c *FakeServicecatalogV1beta1) ServiceBrokers(namespace string) v1beta1.Ser
 	return &FakeServiceBrokers{c, namespace}
 }
 
+func (c *FakeServicecatalogV1beta1) ServiceBrokerSummaries(namespace string) v1beta1.ServiceBrokerSummaryInterface {
+	return &FakeServiceBrokerSummaries{c, namespace}
+}
+
 func (c *FakeServicecatalogV1beta1) ServiceClasses(namespace string) v1beta1.ServiceClassInterface {
 	return &FakeServiceClasses{c, namespace}
 }
@@ -18,15 +18,15 @@ limitations under the License.
 
 package v1beta1
 
-type ClusterServiceBrokerExpansion interface{}
+type BrokerTemplateExpansion interface{}
+
+type CatalogProjectionExpansion interface{}
 
 type ClusterServiceClassExpansion interface{}
 
 type ClusterServicePlanExpansion interface{}
 
-type ServiceBindingExpansion interface{}
-
-type ServiceBrokerExpansion interface{}
+type ServiceBrokerSummaryExpansion interface{}
 
 type ServiceClassExpansion interface{}
 
@@ -0,0 +1,55 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// The ServiceBindingExpansion interface allows force deleting and abandoning
+// a binding.
+type ServiceBindingExpansion interface {
+	ForceDelete(name string, request *v1beta1.ForceDeleteRequest) error
+	AdminUnbind(name string, request *v1beta1.AdminUnbindRequest) error
+}
+
+// ForceDelete removes the finalizers of the named binding and deletes it
+// without unbinding it at the broker.
+func (c *serviceBindings) ForceDelete(name string, request *v1beta1.ForceDeleteRequest) error {
+	return c.client.Post().
+		Namespace(c.ns).
+		Resource("servicebindings").
+		Name(name).
+		SubResource("forcedelete").
+		Body(request).
+		Do().
+		Error()
+}
+
+// AdminUnbind removes the finalizers of the named binding, which must be being
+// deleted, without unbinding it at the broker, and records the abandonment as
+// an event of the binding.
+func (c *serviceBindings) AdminUnbind(name string, request *v1beta1.AdminUnbindRequest) error {
+	return c.client.Post().
+		Namespace(c.ns).
+		Resource("servicebindings").
+		Name(name).
+		SubResource("adminunbind").
+		Body(request).
+		Do().
+		Error()
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// The ServiceBrokerExpansion interface allows accessing the catalog last
+// fetched from a broker.
+type ServiceBrokerExpansion interface {
+	GetCatalog(name string) (*v1beta1.BrokerCatalog, error)
+	UpdateCatalog(catalog *v1beta1.BrokerCatalog) (*v1beta1.BrokerCatalog, error)
+}
+
+// GetCatalog returns the catalog last fetched from the named broker.
+func (c *serviceBrokers) GetCatalog(name string) (result *v1beta1.BrokerCatalog, err error) {
+	result = &v1beta1.BrokerCatalog{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("servicebrokers").
+		Name(name).
+		SubResource("catalog").
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateCatalog stores the catalog fetched from the broker with the name of
+// the given catalog.
+func (c *serviceBrokers) UpdateCatalog(catalog *v1beta1.BrokerCatalog) (result *v1beta1.BrokerCatalog, err error) {
+	result = &v1beta1.BrokerCatalog{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("servicebrokers").
+		Name(catalog.Name).
+		SubResource("catalog").
+		Body(catalog).
+		Do().
+		Into(result)
+	return
+}
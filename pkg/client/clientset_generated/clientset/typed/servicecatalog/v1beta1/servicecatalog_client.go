@@ -27,11 +27,14 @@ import (
 
 type ServicecatalogV1beta1Interface interface {
 	RESTClient() rest.Interface
+	BrokerTemplatesGetter
+	CatalogProjectionsGetter
 	ClusterServiceBrokersGetter
 	ClusterServiceClassesGetter
 	ClusterServicePlansGetter
 	ServiceBindingsGetter
 	ServiceBrokersGetter
+	ServiceBrokerSummariesGetter
 	ServiceClassesGetter
 	ServiceInstancesGetter
 	ServicePlansGetter
@@ -42,6 +45,14 @@ type ServicecatalogV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *ServicecatalogV1beta1Client) BrokerTemplates() BrokerTemplateInterface {
+	return newBrokerTemplates(c)
+}
+
+func (c *ServicecatalogV1beta1Client) CatalogProjections() CatalogProjectionInterface {
+	return newCatalogProjections(c)
+}
+
 func (c *ServicecatalogV1beta1Client) ClusterServiceBrokers() ClusterServiceBrokerInterface {
 	return newClusterServiceBrokers(c)
 }
@@ -62,6 +73,10 @@ func (c *ServicecatalogV1beta1Client) ServiceBrokers(namespace string) ServiceBr
 	return newServiceBrokers(c, namespace)
 }
 
+func (c *ServicecatalogV1beta1Client) ServiceBrokerSummaries(namespace string) ServiceBrokerSummaryInterface {
+	return newServiceBrokerSummaries(c, namespace)
+}
+
 func (c *ServicecatalogV1beta1Client) ServiceClasses(namespace string) ServiceClassInterface {
 	return newServiceClasses(c, namespace)
 }
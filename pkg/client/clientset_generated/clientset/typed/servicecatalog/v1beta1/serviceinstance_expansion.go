@@ -21,9 +21,13 @@ import (
 )
 
 // The ServiceInstanceExpansion interface allows setting the References
-// to ServiceClasses and ServicePlans.
+// to ServiceClasses and ServicePlans, force deleting an instance, rolling
+// back an instance, and getting the operations of an instance.
 type ServiceInstanceExpansion interface {
 	UpdateReferences(serviceInstance *v1beta1.ServiceInstance) (*v1beta1.ServiceInstance, error)
+	ForceDelete(name string, request *v1beta1.ForceDeleteRequest) error
+	Rollback(name string, request *v1beta1.RollbackRequest) (*v1beta1.ServiceInstance, error)
+	Operations(name string) (*v1beta1.ServiceInstanceOperations, error)
 }
 
 func (c *serviceInstances) UpdateReferences(serviceInstance *v1beta1.ServiceInstance) (result *v1beta1.ServiceInstance, err error) {
@@ -38,3 +42,44 @@ func (c *serviceInstances) UpdateReferences(serviceInstance *v1beta1.ServiceInst
 		Into(result)
 	return
 }
+
+// ForceDelete removes the finalizers of the named instance and deletes it
+// without deprovisioning it at the broker.
+func (c *serviceInstances) ForceDelete(name string, request *v1beta1.ForceDeleteRequest) error {
+	return c.client.Post().
+		Namespace(c.ns).
+		Resource("serviceinstances").
+		Name(name).
+		SubResource("forcedelete").
+		Body(request).
+		Do().
+		Error()
+}
+
+// Rollback reverts the plan and parameters of the named instance to those
+// last accepted by the broker.
+func (c *serviceInstances) Rollback(name string, request *v1beta1.RollbackRequest) (result *v1beta1.ServiceInstance, err error) {
+	result = &v1beta1.ServiceInstance{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("serviceinstances").
+		Name(name).
+		SubResource("rollback").
+		Body(request).
+		Do().
+		Into(result)
+	return
+}
+
+// Operations returns the operations of the named instance at the broker.
+func (c *serviceInstances) Operations(name string) (result *v1beta1.ServiceInstanceOperations, err error) {
+	result = &v1beta1.ServiceInstanceOperations{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("serviceinstances").
+		Name(name).
+		SubResource("operations").
+		Do().
+		Into(result)
+	return
+}
@@ -28,6 +28,14 @@ type FakeServicecatalog struct {
 	*testing.Fake
 }
 
+func (c *FakeServicecatalog) BrokerTemplates() internalversion.BrokerTemplateInterface {
+	return &FakeBrokerTemplates{c}
+}
+
+func (c *FakeServicecatalog) CatalogProjections() internalversion.CatalogProjectionInterface {
+	return &FakeCatalogProjections{c}
+}
+
 func (c *FakeServicecatalog) ClusterServiceBrokers() internalversion.ClusterServiceBrokerInterface {
 	return &FakeClusterServiceBrokers{c}
 }
@@ -48,6 +56,10 @@ func (c *FakeServicecatalog) ServiceBrokers(namespace string) internalversion.Se
 	return &FakeServiceBrokers{c, namespace}
 }
 
+func (c *FakeServicecatalog) ServiceBrokerSummaries(namespace string) internalversion.ServiceBrokerSummaryInterface {
+	return &FakeServiceBrokerSummaries{c, namespace}
+}
+
 func (c *FakeServicecatalog) ServiceClasses(namespace string) internalversion.ServiceClassInterface {
 	return &FakeServiceClasses{c, namespace}
 }
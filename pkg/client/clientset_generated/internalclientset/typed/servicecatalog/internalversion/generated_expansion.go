@@ -18,6 +18,10 @@ limitations under the License.
 
 package internalversion
 
+type BrokerTemplateExpansion interface{}
+
+type CatalogProjectionExpansion interface{}
+
 type ClusterServiceBrokerExpansion interface{}
 
 type ClusterServiceClassExpansion interface{}
@@ -28,6 +32,8 @@ type ServiceBindingExpansion interface{}
 
 type ServiceBrokerExpansion interface{}
 
+type ServiceBrokerSummaryExpansion interface{}
+
 type ServiceClassExpansion interface{}
 
 type ServiceInstanceExpansion interface{}
@@ -25,11 +25,14 @@ import (
 
 type ServicecatalogInterface interface {
 	RESTClient() rest.Interface
+	BrokerTemplatesGetter
+	CatalogProjectionsGetter
 	ClusterServiceBrokersGetter
 	ClusterServiceClassesGetter
 	ClusterServicePlansGetter
 	ServiceBindingsGetter
 	ServiceBrokersGetter
+	ServiceBrokerSummariesGetter
 	ServiceClassesGetter
 	ServiceInstancesGetter
 	ServicePlansGetter
@@ -40,6 +43,14 @@ type ServicecatalogClient struct {
 	restClient rest.Interface
 }
 
+func (c *ServicecatalogClient) BrokerTemplates() BrokerTemplateInterface {
+	return newBrokerTemplates(c)
+}
+
+func (c *ServicecatalogClient) CatalogProjections() CatalogProjectionInterface {
+	return newCatalogProjections(c)
+}
+
 func (c *ServicecatalogClient) ClusterServiceBrokers() ClusterServiceBrokerInterface {
 	return newClusterServiceBrokers(c)
 }
@@ -60,6 +71,10 @@ func (c *ServicecatalogClient) ServiceBrokers(namespace string) ServiceBrokerInt
 	return newServiceBrokers(c, namespace)
 }
 
+func (c *ServicecatalogClient) ServiceBrokerSummaries(namespace string) ServiceBrokerSummaryInterface {
+	return newServiceBrokerSummaries(c, namespace)
+}
+
 func (c *ServicecatalogClient) ServiceClasses(namespace string) ServiceClassInterface {
 	return newServiceClasses(c, namespace)
 }
@@ -24,6 +24,10 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// BrokerTemplates returns a BrokerTemplateInformer.
+	BrokerTemplates() BrokerTemplateInformer
+	// CatalogProjections returns a CatalogProjectionInformer.
+	CatalogProjections() CatalogProjectionInformer
 	// ClusterServiceBrokers returns a ClusterServiceBrokerInformer.
 	ClusterServiceBrokers() ClusterServiceBrokerInformer
 	// ClusterServiceClasses returns a ClusterServiceClassInformer.
@@ -34,6 +38,8 @@ type Interface interface {
 	ServiceBindings() ServiceBindingInformer
 	// ServiceBrokers returns a ServiceBrokerInformer.
 	ServiceBrokers() ServiceBrokerInformer
+	// ServiceBrokerSummaries returns a ServiceBrokerSummaryInformer.
+	ServiceBrokerSummaries() ServiceBrokerSummaryInformer
 	// ServiceClasses returns a ServiceClassInformer.
 	ServiceClasses() ServiceClassInformer
 	// ServiceInstances returns a ServiceInstanceInformer.
@@ -53,6 +59,16 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// BrokerTemplates returns a BrokerTemplateInformer.
+func (v *version) BrokerTemplates() BrokerTemplateInformer {
+	return &brokerTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// CatalogProjections returns a CatalogProjectionInformer.
+func (v *version) CatalogProjections() CatalogProjectionInformer {
+	return &catalogProjectionInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterServiceBrokers returns a ClusterServiceBrokerInformer.
 func (v *version) ClusterServiceBrokers() ClusterServiceBrokerInformer {
 	return &clusterServiceBrokerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -78,6 +94,11 @@ func (v *version) ServiceBrokers() ServiceBrokerInformer {
 	return &serviceBrokerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ServiceBrokerSummaries returns a ServiceBrokerSummaryInformer.
+func (v *version) ServiceBrokerSummaries() ServiceBrokerSummaryInformer {
+	return &serviceBrokerSummaryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ServiceClasses returns a ServiceClassInformer.
 func (v *version) ServiceClasses() ServiceClassInformer {
 	return &serviceClassInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
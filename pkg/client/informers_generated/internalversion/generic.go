@@ -54,6 +54,10 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=servicecatalog.k8s.io, Version=internalVersion
+	case servicecatalog.SchemeGroupVersion.WithResource("brokertemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicecatalog().InternalVersion().BrokerTemplates().Informer()}, nil
+	case servicecatalog.SchemeGroupVersion.WithResource("catalogprojections"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicecatalog().InternalVersion().CatalogProjections().Informer()}, nil
 	case servicecatalog.SchemeGroupVersion.WithResource("clusterservicebrokers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicecatalog().InternalVersion().ClusterServiceBrokers().Informer()}, nil
 	case servicecatalog.SchemeGroupVersion.WithResource("clusterserviceclasses"):
@@ -64,6 +68,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicecatalog().InternalVersion().ServiceBindings().Informer()}, nil
 	case servicecatalog.SchemeGroupVersion.WithResource("servicebrokers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicecatalog().InternalVersion().ServiceBrokers().Informer()}, nil
+	case servicecatalog.SchemeGroupVersion.WithResource("servicebrokersummaries"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicecatalog().InternalVersion().ServiceBrokerSummaries().Informer()}, nil
 	case servicecatalog.SchemeGroupVersion.WithResource("serviceclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicecatalog().InternalVersion().ServiceClasses().Informer()}, nil
 	case servicecatalog.SchemeGroupVersion.WithResource("serviceinstances"):
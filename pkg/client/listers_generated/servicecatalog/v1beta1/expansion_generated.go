@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1beta1
 
+// BrokerTemplateListerExpansion allows custom methods to be added to
+// BrokerTemplateLister.
+type BrokerTemplateListerExpansion interface{}
+
+// CatalogProjectionListerExpansion allows custom methods to be added to
+// CatalogProjectionLister.
+type CatalogProjectionListerExpansion interface{}
+
 // ClusterServiceBrokerListerExpansion allows custom methods to be added to
 // ClusterServiceBrokerLister.
 type ClusterServiceBrokerListerExpansion interface{}
@@ -46,6 +54,14 @@ type ServiceBrokerListerExpansion interface{}
 // ServiceBrokerNamespaceLister.
 type ServiceBrokerNamespaceListerExpansion interface{}
 
+// ServiceBrokerSummaryListerExpansion allows custom methods to be added to
+// ServiceBrokerSummaryLister.
+type ServiceBrokerSummaryListerExpansion interface{}
+
+// ServiceBrokerSummaryNamespaceListerExpansion allows custom methods to be added to
+// ServiceBrokerSummaryNamespaceLister.
+type ServiceBrokerSummaryNamespaceListerExpansion interface{}
+
 // ServiceClassListerExpansion allows custom methods to be added to
 // ServiceClassLister.
 type ServiceClassListerExpansion interface{}
@@ -0,0 +1,144 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	autoBindReason         string = "AutoBindingCreated"
+	autoBindMessage        string = "Created the ServiceBinding %q for the instance"
+	errorAutoBindReason    string = "ErrorCreatingAutoBinding"
+	errorAutoBindMessage   string = "Error creating the ServiceBinding %q for the instance: %v"
+	autoBindConflictReason string = "AutoBindingNameInUse"
+	autoBindConflictMsg    string = "Not creating a ServiceBinding for the instance: the ServiceBinding %q already exists and is not owned by the instance"
+)
+
+// newServiceInstanceAutoBinding returns the ServiceBinding that is created for
+// the given instance, which has an AutoBind.
+func newServiceInstanceAutoBinding(instance *v1beta1.ServiceInstance) *v1beta1.ServiceBinding {
+	autoBind := instance.Spec.AutoBind
+	secretName := autoBind.SecretName
+	if secretName == "" {
+		secretName = instance.Name
+	}
+	isController := true
+	blockOwnerDeletion := false
+	return &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         v1beta1.SchemeGroupVersion.String(),
+					Kind:               "ServiceInstance",
+					Name:               instance.Name,
+					UID:                instance.UID,
+					Controller:         &isController,
+					BlockOwnerDeletion: &blockOwnerDeletion,
+				},
+			},
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			ServiceInstanceRef: v1beta1.LocalObjectReference{Name: instance.Name},
+			SecretName:         secretName,
+			Parameters:         autoBind.Parameters.DeepCopy(),
+			ParametersFrom:     append([]v1beta1.ParametersFromSource(nil), autoBind.ParametersFrom...),
+		},
+	}
+}
+
+// ensureServiceInstanceAutoBinding creates the ServiceBinding of the given
+// provisioned instance if it has an AutoBind and the binding does not exist.
+// The binding is only created once: it is not recreated if the user deletes
+// it, nor changed if the AutoBind changes.
+func (c *controller) ensureServiceInstanceAutoBinding(instance *v1beta1.ServiceInstance) error {
+	if instance.Spec.AutoBind == nil || instance.DeletionTimestamp != nil {
+		return nil
+	}
+	if instance.Status.AutoBindingCreated {
+		return nil
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	binding, err := c.bindingLister.ServiceBindings(instance.Namespace).Get(instance.Name)
+	if err == nil {
+		if !metav1.IsControlledBy(binding, instance) {
+			s := fmt.Sprintf(autoBindConflictMsg, instance.Name)
+			glog.Warning(pcb.Message(s))
+			c.recorder.Event(instance, corev1.EventTypeWarning, autoBindConflictReason, s)
+		}
+		return c.setServiceInstanceAutoBindingCreated(instance)
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	binding = newServiceInstanceAutoBinding(instance)
+	if _, err := c.serviceCatalogClient.ServiceBindings(instance.Namespace).Create(binding); err != nil && !errors.IsAlreadyExists(err) {
+		s := fmt.Sprintf(errorAutoBindMessage, binding.Name, err)
+		glog.Warning(pcb.Message(s))
+		c.recorder.Event(instance, corev1.EventTypeWarning, errorAutoBindReason, s)
+		return err
+	}
+	s := fmt.Sprintf(autoBindMessage, binding.Name)
+	glog.V(4).Info(pcb.Message(s))
+	c.recorder.Event(instance, corev1.EventTypeNormal, autoBindReason, s)
+	return c.setServiceInstanceAutoBindingCreated(instance)
+}
+
+// setServiceInstanceAutoBindingCreated records in the status of the given
+// instance that its ServiceBinding has been created.
+func (c *controller) setServiceInstanceAutoBindingCreated(instance *v1beta1.ServiceInstance) error {
+	instance = instance.DeepCopy()
+	instance.Status.AutoBindingCreated = true
+	_, err := c.updateServiceInstanceStatus(instance)
+	return err
+}
+
+// deleteServiceInstanceAutoBinding deletes the ServiceBinding that was created
+// for the given instance, which is being deleted, so that it does not keep
+// the instance from being deprovisioned.
+func (c *controller) deleteServiceInstanceAutoBinding(instance *v1beta1.ServiceInstance) error {
+	binding, err := c.bindingLister.ServiceBindings(instance.Namespace).Get(instance.Name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(binding, instance) || binding.DeletionTimestamp != nil {
+		return nil
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	glog.V(4).Info(pcb.Messagef("Deleting ServiceBinding %q", binding.Name))
+	err = c.serviceCatalogClient.ServiceBindings(binding.Namespace).Delete(binding.Name, &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
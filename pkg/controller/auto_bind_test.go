@@ -0,0 +1,148 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// getTestProvisionedServiceInstanceWithAutoBind returns an instance that has
+// been provisioned and asks for a binding to be created.
+func getTestProvisionedServiceInstanceWithAutoBind() *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.UID = types.UID("test-instance-uid")
+	instance.Status.ReconciledGeneration = instance.Generation
+	instance.Status.ObservedGeneration = instance.Generation
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successProvisionReason, successProvisionMessage)
+	instance.Spec.AutoBind = &v1beta1.AutoBind{}
+	return instance
+}
+
+// TestReconcileServiceInstanceAutoBind tests that a binding is created for a
+// provisioned instance with an AutoBind.
+func TestReconcileServiceInstanceAutoBind(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+
+	instance := getTestProvisionedServiceInstanceWithAutoBind()
+	instance.Spec.AutoBind.SecretName = "db-secret"
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+
+	binding := assertCreate(t, actions[0], newServiceInstanceAutoBinding(instance)).(*v1beta1.ServiceBinding)
+	if e, a := instance.Name, binding.Name; e != a {
+		t.Fatalf("unexpected binding name: %v", expectedGot(e, a))
+	}
+	if e, a := instance.Name, binding.Spec.ServiceInstanceRef.Name; e != a {
+		t.Fatalf("unexpected instance reference: %v", expectedGot(e, a))
+	}
+	if e, a := "db-secret", binding.Spec.SecretName; e != a {
+		t.Fatalf("unexpected secret name: %v", expectedGot(e, a))
+	}
+	if !metav1.IsControlledBy(binding, instance) {
+		t.Fatalf("expected the binding to be controlled by the instance, got owner references %v", binding.OwnerReferences)
+	}
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance).(*v1beta1.ServiceInstance)
+	if !updatedServiceInstance.Status.AutoBindingCreated {
+		t.Fatal("expected the instance to record that its binding was created")
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(autoBindReason).msg(fmt.Sprintf(autoBindMessage, instance.Name))
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The binding is not created again once it has been.
+	fakeCatalogClient.ClearActions()
+	if err := reconcileServiceInstance(t, testController, updatedServiceInstance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
+
+// TestReconcileServiceInstanceAutoBindNameInUse tests that a binding that is
+// not owned by the instance is left alone.
+func TestReconcileServiceInstanceAutoBindNameInUse(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	instance := getTestProvisionedServiceInstanceWithAutoBind()
+	existing := getTestServiceBinding()
+	existing.Name = instance.Name
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(existing)
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if !updatedServiceInstance.Status.AutoBindingCreated {
+		t.Fatal("expected the instance to stop trying to create its binding")
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := warningEventBuilder(autoBindConflictReason).msg(fmt.Sprintf(autoBindConflictMsg, instance.Name))
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceDeleteAutoBinding tests that deleting an
+// instance deletes the binding created for it, even though its binding
+// deletion policy is Block.
+func TestReconcileServiceInstanceDeleteAutoBinding(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestProvisionedServiceInstanceWithAutoBind()
+	instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Generation = 2
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+	binding := newServiceInstanceAutoBinding(instance)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+
+	if err := reconcileServiceInstance(t, testController, instance); err == nil {
+		t.Fatal("expected reconcileServiceInstance to return an error")
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+	assertDelete(t, actions[0], binding)
+	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, errorDeprovisionBlockedByCredentialsReason)
+}
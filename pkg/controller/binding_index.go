@@ -0,0 +1,61 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// bindingInstanceRefIndex indexes the bindings of the informer cache by the
+// namespace and name of their ServiceInstance. It is named after the field
+// selector the API server serves for the same lookup.
+const bindingInstanceRefIndex = "spec.instanceRef.name"
+
+// bindingIndexers are the indexers added to the binding informer, so that the
+// bindings of an instance are found without going through all the bindings
+// of its namespace.
+var bindingIndexers = cache.Indexers{
+	bindingInstanceRefIndex: bindingInstanceRefIndexFunc,
+}
+
+// bindingInstanceRefIndexFunc returns the namespaced name of the
+// ServiceInstance of the given binding.
+func bindingInstanceRefIndexFunc(obj interface{}) ([]string, error) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected a ServiceBinding, got %T", obj)
+	}
+	return []string{binding.Namespace + "/" + binding.Spec.ServiceInstanceRef.Name}, nil
+}
+
+// listServiceBindingsForInstance returns the bindings of the informer cache
+// which reference the given instance.
+func (c *controller) listServiceBindingsForInstance(instance *v1beta1.ServiceInstance) ([]*v1beta1.ServiceBinding, error) {
+	objs, err := c.bindingIndexer.ByIndex(bindingInstanceRefIndex, instance.Namespace+"/"+instance.Name)
+	if err != nil {
+		return nil, err
+	}
+	bindings := make([]*v1beta1.ServiceBinding, 0, len(objs))
+	for _, obj := range objs {
+		bindings = append(bindings, obj.(*v1beta1.ServiceBinding))
+	}
+	return bindings, nil
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+)
+
+func TestListServiceBindingsForInstance(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	binding := getTestServiceBinding()
+	otherInstance := getTestServiceBinding()
+	otherInstance.Name = "other-instance-binding"
+	otherInstance.Spec.ServiceInstanceRef.Name = "other-instance"
+	otherNamespace := getTestServiceBinding()
+	otherNamespace.Namespace = "other-namespace"
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(otherInstance)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(otherNamespace)
+
+	bindings, err := testController.listServiceBindingsForInstance(getTestServiceInstance())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bindings) != 1 {
+		t.Fatalf("expected 1 binding, got %d", len(bindings))
+	}
+	if bindings[0].Name != binding.Name || bindings[0].Namespace != binding.Namespace {
+		t.Fatalf("expected binding %s/%s, got %s/%s", binding.Namespace, binding.Name, bindings[0].Namespace, bindings[0].Name)
+	}
+}
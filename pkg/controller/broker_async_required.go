@@ -0,0 +1,133 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+// isAsyncRequiredError returns whether the given error of a broker request is
+// the AsyncRequired error, with which a broker rejects a request that it only
+// serves asynchronously. Unlike osb.IsAsyncRequiredError, the description of
+// the error is not checked, since brokers are free to describe it as they
+// like.
+func isAsyncRequiredError(err error) bool {
+	httpErr, ok := osb.IsHTTPError(err)
+	if !ok {
+		return false
+	}
+	return httpErr.StatusCode == http.StatusUnprocessableEntity &&
+		httpErr.ErrorMessage != nil && *httpErr.ErrorMessage == osb.AsyncErrorMessage
+}
+
+// brokerRequiresAsyncBindingOperations returns whether the status of the
+// broker of the given instance records that the broker requires
+// accepts_incomplete=true with binding requests.
+func (c *controller) brokerRequiresAsyncBindingOperations(instance *v1beta1.ServiceInstance) bool {
+	if instance.Spec.ClusterServiceClassRef == nil && instance.Spec.ServiceClassRef == nil {
+		return false
+	}
+	if instance.Spec.ClusterServiceClassSpecified() {
+		class, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return false
+		}
+		return c.clusterServiceBrokerRequiresAsyncBindingOperations(class.Spec.ClusterServiceBrokerName)
+	}
+
+	class, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+	if err != nil {
+		return false
+	}
+	if class.Spec.ClusterServiceBroker {
+		return c.clusterServiceBrokerRequiresAsyncBindingOperations(class.Spec.ServiceBrokerName)
+	}
+	broker, err := c.getServiceBrokerForServiceClass(class)
+	if err != nil {
+		return false
+	}
+	return broker.Status.AsyncBindingOperationsRequired
+}
+
+func (c *controller) clusterServiceBrokerRequiresAsyncBindingOperations(brokerName string) bool {
+	broker, err := c.clusterServiceBrokerLister.Get(brokerName)
+	if err != nil {
+		return false
+	}
+	return broker.Status.AsyncBindingOperationsRequired
+}
+
+// recordBrokerRequiresAsyncBindingOperations records in the status of the
+// broker of the given instance, whose references must be resolved, that the
+// broker requires accepts_incomplete=true with binding requests. A failure to
+// record it is only logged, since the requirement is recorded again the next
+// time the broker rejects a binding request for it.
+func (c *controller) recordBrokerRequiresAsyncBindingOperations(instance *v1beta1.ServiceInstance) {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	if err := c.updateBrokerAsyncBindingOperationsRequired(instance); err != nil {
+		glog.Warning(pcb.Messagef("Error recording that the broker requires asynchronous binding operations: %v", err))
+	}
+}
+
+func (c *controller) updateBrokerAsyncBindingOperationsRequired(instance *v1beta1.ServiceInstance) error {
+	if instance.Spec.ClusterServiceClassSpecified() {
+		class, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return err
+		}
+		return c.updateClusterServiceBrokerAsyncBindingOperationsRequired(class.Spec.ClusterServiceBrokerName)
+	}
+
+	class, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+	if err != nil {
+		return err
+	}
+	if class.Spec.ClusterServiceBroker {
+		return c.updateClusterServiceBrokerAsyncBindingOperationsRequired(class.Spec.ServiceBrokerName)
+	}
+	broker, err := c.getServiceBrokerForServiceClass(class)
+	if err != nil {
+		return err
+	}
+	if broker.Status.AsyncBindingOperationsRequired {
+		return nil
+	}
+	toUpdate := broker.DeepCopy()
+	toUpdate.Status.AsyncBindingOperationsRequired = true
+	_, err = c.serviceCatalogClient.ServiceBrokers(broker.Namespace).UpdateStatus(toUpdate)
+	return err
+}
+
+func (c *controller) updateClusterServiceBrokerAsyncBindingOperationsRequired(brokerName string) error {
+	broker, err := c.clusterServiceBrokerLister.Get(brokerName)
+	if err != nil {
+		return err
+	}
+	if broker.Status.AsyncBindingOperationsRequired {
+		return nil
+	}
+	toUpdate := broker.DeepCopy()
+	toUpdate.Status.AsyncBindingOperationsRequired = true
+	_, err = c.serviceCatalogClient.ClusterServiceBrokers().UpdateStatus(toUpdate)
+	return err
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+// newBrokerCatalog returns the catalog subresource of the given broker that
+// holds the catalog fetched from it at the given time, before it is mutated
+// or converted into classes and plans.
+func newBrokerCatalog(meta metav1.ObjectMeta, catalog *osb.CatalogResponse, retrievalTime metav1.Time) (*v1beta1.BrokerCatalog, error) {
+	raw, err := json.Marshal(catalog)
+	if err != nil {
+		return nil, err
+	}
+	return &v1beta1.BrokerCatalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      meta.Name,
+			Namespace: meta.Namespace,
+		},
+		RetrievalTime: retrievalTime,
+		Catalog:       &runtime.RawExtension{Raw: raw},
+	}, nil
+}
+
+// storeClusterServiceBrokerCatalog stores the catalog fetched from the given
+// broker in its catalog subresource when the BrokerCatalogSubresource feature
+// is enabled. Failing to store it does not fail the reconciliation of the
+// broker, since the subresource is only informational.
+func (c *controller) storeClusterServiceBrokerCatalog(broker *v1beta1.ClusterServiceBroker, catalog *osb.CatalogResponse, retrievalTime metav1.Time) {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.BrokerCatalogSubresource) {
+		return
+	}
+	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
+	brokerCatalog, err := newBrokerCatalog(broker.ObjectMeta, catalog, retrievalTime)
+	if err == nil {
+		_, err = c.serviceCatalogClient.ClusterServiceBrokers().UpdateCatalog(brokerCatalog)
+	}
+	if err != nil {
+		glog.Warning(pcb.Messagef("Error storing the catalog fetched from the broker: %v", err))
+	}
+}
+
+// storeServiceBrokerCatalog stores the catalog fetched from the given
+// namespaced broker in its catalog subresource when the
+// BrokerCatalogSubresource feature is enabled.
+func (c *controller) storeServiceBrokerCatalog(broker *v1beta1.ServiceBroker, catalog *osb.CatalogResponse, retrievalTime metav1.Time) {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.BrokerCatalogSubresource) {
+		return
+	}
+	pcb := pretty.NewServiceBrokerContextBuilder(broker)
+	brokerCatalog, err := newBrokerCatalog(broker.ObjectMeta, catalog, retrievalTime)
+	if err == nil {
+		_, err = c.serviceCatalogClient.ServiceBrokers(broker.Namespace).UpdateCatalog(brokerCatalog)
+	}
+	if err != nil {
+		glog.Warning(pcb.Messagef("Error storing the catalog fetched from the broker: %v", err))
+	}
+}
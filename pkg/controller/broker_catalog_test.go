@@ -0,0 +1,73 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+)
+
+// TestStoreClusterServiceBrokerCatalog tests that the catalog fetched from a
+// broker is stored in its catalog subresource only when the
+// BrokerCatalogSubresource feature is enabled.
+func TestStoreClusterServiceBrokerCatalog(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+	broker := getTestClusterServiceBroker()
+	now := metav1.Now()
+
+	testController.storeClusterServiceBrokerCatalog(broker, getTestCatalog(), now)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.BrokerCatalogSubresource))
+	if err != nil {
+		t.Fatalf("Failed to enable broker catalog subresource feature: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BrokerCatalogSubresource))
+
+	testController.storeClusterServiceBrokerCatalog(broker, getTestCatalog(), now)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	action, ok := actions[0].(clientgotesting.UpdateAction)
+	if !ok || !action.Matches("update", "clusterservicebrokers") || action.GetSubresource() != "catalog" {
+		t.Fatalf("expected an update of the catalog subresource, got %+v", actions[0])
+	}
+	stored := action.GetObject().(*v1beta1.BrokerCatalog)
+	if e, a := broker.Name, stored.Name; e != a {
+		t.Fatalf("unexpected catalog name: %v", expectedGot(e, a))
+	}
+	if !stored.RetrievalTime.Equal(&now) {
+		t.Fatalf("unexpected retrieval time: %v", expectedGot(now, stored.RetrievalTime))
+	}
+	catalog := &osb.CatalogResponse{}
+	if err := json.Unmarshal(stored.Catalog.Raw, catalog); err != nil {
+		t.Fatalf("unexpected error decoding the stored catalog: %v", err)
+	}
+	if e, a := getTestCatalog().Services[0].ID, catalog.Services[0].ID; e != a {
+		t.Fatalf("unexpected service in the stored catalog: %v", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	// BrokerDashboardPath is the path at which the controller manager serves
+	// the broker dashboard.
+	BrokerDashboardPath = "/brokers"
+
+	// maxBrokerDashboardRecentFailures is how many failed instances are
+	// listed for each broker.
+	maxBrokerDashboardRecentFailures = 5
+)
+
+// BrokerDashboard summarizes the brokers known to the controller, so that a
+// dashboard can show their health without listing catalog resources itself.
+type BrokerDashboard struct {
+	// GeneratedAt is when the summary was made.
+	GeneratedAt metav1.Time `json:"generatedAt"`
+	// Brokers are the ClusterServiceBrokers, then the ServiceBrokers, each
+	// sorted by namespace and name.
+	Brokers []BrokerDashboardEntry `json:"brokers"`
+}
+
+// BrokerDashboardEntry summarizes a single broker.
+type BrokerDashboardEntry struct {
+	// Kind is ClusterServiceBroker or ServiceBroker.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of a ServiceBroker.
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+
+	// Ready, Reason and Message are those of the Ready condition of the
+	// broker.
+	Ready   bool   `json:"ready"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	LastCatalogRetrievalTime *metav1.Time `json:"lastCatalogRetrievalTime,omitempty"`
+	ClassCount               int32        `json:"classCount"`
+	PlanCount                int32        `json:"planCount"`
+
+	// Instances counts the instances of the classes of the broker.
+	Instances BrokerDashboardInstanceCounts `json:"instances"`
+	// RecentFailures are the most recently failed instances of the broker,
+	// newest first.
+	RecentFailures []BrokerDashboardFailure `json:"recentFailures,omitempty"`
+}
+
+// BrokerDashboardInstanceCounts counts the instances of a broker by state.
+type BrokerDashboardInstanceCounts struct {
+	Total      int `json:"total"`
+	Ready      int `json:"ready"`
+	InProgress int `json:"inProgress"`
+	Failed     int `json:"failed"`
+}
+
+// BrokerDashboardFailure is a failed instance of a broker.
+type BrokerDashboardFailure struct {
+	Namespace string      `json:"namespace"`
+	Name      string      `json:"name"`
+	Reason    string      `json:"reason"`
+	Message   string      `json:"message"`
+	Time      metav1.Time `json:"time"`
+}
+
+// BrokerDashboardHandler serves the broker dashboard of a controller as JSON.
+// It answers with 503 Service Unavailable until its controller is set, as the
+// HTTP server starts before the controller does.
+type BrokerDashboardHandler struct {
+	lock       sync.RWMutex
+	controller *controller
+}
+
+// NewBrokerDashboardHandler returns a handler with no controller.
+func NewBrokerDashboardHandler() *BrokerDashboardHandler {
+	return &BrokerDashboardHandler{}
+}
+
+// SetController sets the controller whose brokers are served, which must be
+// a controller returned by NewController.
+func (h *BrokerDashboardHandler) SetController(c Controller) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.controller, _ = c.(*controller)
+}
+
+// ServeHTTP serves the broker dashboard.
+func (h *BrokerDashboardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	h.lock.RLock()
+	c := h.controller
+	h.lock.RUnlock()
+	if c == nil {
+		http.Error(w, "the controller is not running", http.StatusServiceUnavailable)
+		return
+	}
+
+	dashboard, err := c.getBrokerDashboard()
+	if err != nil {
+		glog.Errorf("Error making the broker dashboard: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(dashboard); err != nil {
+		glog.Errorf("Error writing the broker dashboard: %v", err)
+	}
+}
+
+// getBrokerDashboard summarizes the brokers and instances in the informer
+// caches of the controller.
+func (c *controller) getBrokerDashboard() (*BrokerDashboard, error) {
+	dashboard := &BrokerDashboard{
+		GeneratedAt: metav1.Now(),
+		Brokers:     []BrokerDashboardEntry{},
+	}
+	// entries maps the key of a broker, which is its namespace and name, to
+	// its index in dashboard.Brokers.
+	entries := make(map[string]int)
+
+	clusterBrokers, err := c.clusterServiceBrokerLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(clusterBrokers, func(i, j int) bool { return clusterBrokers[i].Name < clusterBrokers[j].Name })
+	for _, broker := range clusterBrokers {
+		entries["/"+broker.Name] = len(dashboard.Brokers)
+		dashboard.Brokers = append(dashboard.Brokers, newBrokerDashboardEntry("ClusterServiceBroker", broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, &broker.Status.CommonServiceBrokerStatus))
+	}
+	if c.serviceBrokerLister != nil {
+		brokers, err := c.serviceBrokerLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(brokers, func(i, j int) bool {
+			if brokers[i].Namespace != brokers[j].Namespace {
+				return brokers[i].Namespace < brokers[j].Namespace
+			}
+			return brokers[i].Name < brokers[j].Name
+		})
+		for _, broker := range brokers {
+			entries[broker.Namespace+"/"+broker.Name] = len(dashboard.Brokers)
+			dashboard.Brokers = append(dashboard.Brokers, newBrokerDashboardEntry("ServiceBroker", broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, &broker.Status.CommonServiceBrokerStatus))
+		}
+	}
+
+	instances, err := c.instanceLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		i, ok := entries[c.getServiceInstanceBrokerKey(instance)]
+		if !ok {
+			continue
+		}
+		addInstanceToBrokerDashboardEntry(&dashboard.Brokers[i], instance)
+	}
+	for i := range dashboard.Brokers {
+		failures := dashboard.Brokers[i].RecentFailures
+		sort.Slice(failures, func(i, j int) bool { return failures[j].Time.Before(&failures[i].Time) })
+		if len(failures) > maxBrokerDashboardRecentFailures {
+			dashboard.Brokers[i].RecentFailures = failures[:maxBrokerDashboardRecentFailures]
+		}
+	}
+	return dashboard, nil
+}
+
+func newBrokerDashboardEntry(kind string, meta metav1.ObjectMeta, spec *v1beta1.CommonServiceBrokerSpec, status *v1beta1.CommonServiceBrokerStatus) BrokerDashboardEntry {
+	entry := BrokerDashboardEntry{
+		Kind:                     kind,
+		Namespace:                meta.Namespace,
+		Name:                     meta.Name,
+		URL:                      spec.URL,
+		LastCatalogRetrievalTime: status.LastCatalogRetrievalTime,
+		ClassCount:               status.ClassCount,
+		PlanCount:                status.PlanCount,
+	}
+	for _, cond := range status.Conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionReady {
+			entry.Ready = cond.Status == v1beta1.ConditionTrue
+			entry.Reason = cond.Reason
+			entry.Message = cond.Message
+		}
+	}
+	return entry
+}
+
+// getServiceInstanceBrokerKey returns the namespace and name of the broker of
+// the class of the given instance, with an empty namespace for a
+// ClusterServiceBroker, or "" if the class is not resolved.
+func (c *controller) getServiceInstanceBrokerKey(instance *v1beta1.ServiceInstance) string {
+	if instance.Spec.ClusterServiceClassRef != nil {
+		class, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return ""
+		}
+		return "/" + class.Spec.ClusterServiceBrokerName
+	}
+	if instance.Spec.ServiceClassRef != nil && c.serviceClassLister != nil {
+		class, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return ""
+		}
+		if class.Spec.ClusterServiceBroker {
+			return "/" + class.Spec.ServiceBrokerName
+		}
+		return getServiceBrokerNamespace(class) + "/" + class.Spec.ServiceBrokerName
+	}
+	return ""
+}
+
+func addInstanceToBrokerDashboardEntry(entry *BrokerDashboardEntry, instance *v1beta1.ServiceInstance) {
+	entry.Instances.Total++
+	switch {
+	case instance.Status.CurrentOperation != "":
+		entry.Instances.InProgress++
+	case isServiceInstanceReady(instance):
+		entry.Instances.Ready++
+	case isServiceInstanceFailed(instance):
+		entry.Instances.Failed++
+		for _, cond := range instance.Status.Conditions {
+			if cond.Type == v1beta1.ServiceInstanceConditionFailed {
+				entry.RecentFailures = append(entry.RecentFailures, BrokerDashboardFailure{
+					Namespace: instance.Namespace,
+					Name:      instance.Name,
+					Reason:    cond.Reason,
+					Message:   cond.Message,
+					Time:      cond.LastTransitionTime,
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestBrokerDashboardHandler(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	broker := getTestClusterServiceBroker()
+	broker.Status.Conditions = []v1beta1.ServiceBrokerCondition{
+		{Type: v1beta1.ServiceBrokerConditionReady, Status: v1beta1.ConditionTrue, Reason: successFetchedCatalogReason},
+	}
+	broker.Status.ClassCount = 1
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+
+	ready := getTestServiceInstanceWithClusterRefs()
+	ready.Name = "ready"
+	setServiceInstanceCondition(ready, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successProvisionReason, successProvisionMessage)
+	provisioning := getTestServiceInstanceWithClusterRefs()
+	provisioning.Name = "provisioning"
+	provisioning.Status.CurrentOperation = v1beta1.ServiceInstanceOperationProvision
+	for i, name := range []string{"failed-earlier", "failed-later"} {
+		failed := getTestServiceInstanceWithClusterRefs()
+		failed.Name = name
+		failed.Status.Conditions = []v1beta1.ServiceInstanceCondition{{
+			Type:               v1beta1.ServiceInstanceConditionFailed,
+			Status:             v1beta1.ConditionTrue,
+			Reason:             errorProvisionCallFailedReason,
+			Message:            "provision failed",
+			LastTransitionTime: metav1.NewTime(time.Now().Add(time.Duration(i) * time.Minute)),
+		}}
+		sharedInformers.ServiceInstances().Informer().GetStore().Add(failed)
+	}
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(ready)
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(provisioning)
+
+	handler := NewBrokerDashboardHandler()
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, BrokerDashboardPath, nil))
+	if e, a := http.StatusServiceUnavailable, recorder.Code; e != a {
+		t.Fatalf("expected status %v before the controller is set, got %v", e, a)
+	}
+
+	handler.SetController(testController)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, BrokerDashboardPath, nil))
+	if e, a := http.StatusOK, recorder.Code; e != a {
+		t.Fatalf("expected status %v, got %v: %v", e, a, recorder.Body.String())
+	}
+
+	dashboard := &BrokerDashboard{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), dashboard); err != nil {
+		t.Fatalf("unexpected error decoding the dashboard: %v", err)
+	}
+	if len(dashboard.Brokers) != 1 {
+		t.Fatalf("expected a single broker, got %+v", dashboard.Brokers)
+	}
+	entry := dashboard.Brokers[0]
+	if entry.Name != testClusterServiceBrokerName || entry.Kind != "ClusterServiceBroker" || !entry.Ready || entry.ClassCount != 1 {
+		t.Fatalf("unexpected broker entry: %+v", entry)
+	}
+	if e, a := (BrokerDashboardInstanceCounts{Total: 4, Ready: 1, InProgress: 1, Failed: 2}), entry.Instances; e != a {
+		t.Fatalf("expected instance counts %+v, got %+v", e, a)
+	}
+	if len(entry.RecentFailures) != 2 || entry.RecentFailures[0].Name != "failed-later" {
+		t.Fatalf("expected the most recent failure first, got %+v", entry.RecentFailures)
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+)
+
+// brokerEndpointUnhealthyPeriod is how long a broker endpoint that failed is
+// skipped in favor of the other endpoints of its broker.
+const brokerEndpointUnhealthyPeriod = time.Minute
+
+// brokerEndpointHealth holds the broker endpoints that recently failed, so
+// that the clients created for each reconciliation skip them.
+type brokerEndpointHealth struct {
+	lock sync.Mutex
+	// unhealthyUntil maps the URL of a failed endpoint to when it is tried
+	// first again.
+	unhealthyUntil map[string]time.Time
+}
+
+// isHealthy returns whether the endpoint with the given URL has not recently
+// failed.
+func (h *brokerEndpointHealth) isHealthy(url string) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	until, ok := h.unhealthyUntil[url]
+	if !ok {
+		return true
+	}
+	if time.Now().After(until) {
+		delete(h.unhealthyUntil, url)
+		return true
+	}
+	return false
+}
+
+// setHealthy records whether the endpoint with the given URL is healthy.
+func (h *brokerEndpointHealth) setHealthy(url string, healthy bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if healthy {
+		delete(h.unhealthyUntil, url)
+		return
+	}
+	if h.unhealthyUntil == nil {
+		h.unhealthyUntil = make(map[string]time.Time)
+	}
+	h.unhealthyUntil[url] = time.Now().Add(brokerEndpointUnhealthyPeriod)
+}
+
+// isBrokerEndpointFailure returns whether the given error of a request to a
+// broker endpoint means that the endpoint is unavailable, rather than that
+// the broker rejected the request, so that the request should be sent to
+// another endpoint.
+func isBrokerEndpointFailure(err error) bool {
+	httpErr, ok := osb.IsHTTPError(err)
+	if !ok {
+		return true
+	}
+	switch httpErr.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// failoverClient is an osb.Client that sends requests to the first healthy
+// of several equivalent endpoints of a broker, and to the next endpoint when
+// one is unavailable.
+type failoverClient struct {
+	urls    []string
+	clients []osb.Client
+	health  *brokerEndpointHealth
+}
+
+var _ osb.Client = &failoverClient{}
+
+// newBrokerClient creates a client for a broker with the given client
+// configuration, whose URL is the primary endpoint of the broker, and the
+// given failover URLs.
+func (c *controller) newBrokerClient(clientConfig *brokerClientConfiguration, failoverURLs []string) (osb.Client, error) {
+	responses := &brokerResponses{}
+	if len(failoverURLs) == 0 {
+		client, err := c.createBrokerClient(clientConfig, responses)
+		if err != nil {
+			return nil, err
+		}
+		return &brokerClient{Client: client, responses: responses}, nil
+	}
+
+	client := &failoverClient{health: &c.brokerEndpointHealth}
+	for _, url := range append([]string{clientConfig.URL}, failoverURLs...) {
+		endpointConfig := *clientConfig.ClientConfiguration
+		endpointConfig.URL = url
+		endpointClient, err := c.createBrokerClient(&brokerClientConfiguration{
+			ClientConfiguration: &endpointConfig,
+			CustomHeaders:       clientConfig.CustomHeaders,
+		}, responses)
+		if err != nil {
+			return nil, err
+		}
+		client.urls = append(client.urls, url)
+		client.clients = append(client.clients, endpointClient)
+	}
+	return &brokerClient{Client: client, responses: responses}, nil
+}
+
+// do calls the given request on the healthy endpoints in order until one of
+// them is available. If none is healthy, all of them are tried.
+func (c *failoverClient) do(request func(osb.Client) error) error {
+	var healthy, unhealthy []int
+	for i, url := range c.urls {
+		if c.health.isHealthy(url) {
+			healthy = append(healthy, i)
+		} else {
+			unhealthy = append(unhealthy, i)
+		}
+	}
+	order := healthy
+	if len(order) == 0 {
+		order = unhealthy
+	}
+
+	var err error
+	for _, i := range order {
+		err = request(c.clients[i])
+		if err == nil || !isBrokerEndpointFailure(err) {
+			c.health.setHealthy(c.urls[i], true)
+			return err
+		}
+		glog.Warningf("Broker endpoint %s is unavailable, failing over: %v", c.urls[i], err)
+		c.health.setHealthy(c.urls[i], false)
+	}
+	return err
+}
+
+func (c *failoverClient) GetCatalog() (*osb.CatalogResponse, error) {
+	var response *osb.CatalogResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.GetCatalog()
+		return err
+	})
+	return response, err
+}
+
+func (c *failoverClient) ProvisionInstance(r *osb.ProvisionRequest) (*osb.ProvisionResponse, error) {
+	var response *osb.ProvisionResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.ProvisionInstance(r)
+		return err
+	})
+	return response, err
+}
+
+func (c *failoverClient) UpdateInstance(r *osb.UpdateInstanceRequest) (*osb.UpdateInstanceResponse, error) {
+	var response *osb.UpdateInstanceResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.UpdateInstance(r)
+		return err
+	})
+	return response, err
+}
+
+func (c *failoverClient) DeprovisionInstance(r *osb.DeprovisionRequest) (*osb.DeprovisionResponse, error) {
+	var response *osb.DeprovisionResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.DeprovisionInstance(r)
+		return err
+	})
+	return response, err
+}
+
+func (c *failoverClient) PollLastOperation(r *osb.LastOperationRequest) (*osb.LastOperationResponse, error) {
+	var response *osb.LastOperationResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.PollLastOperation(r)
+		return err
+	})
+	return response, err
+}
+
+func (c *failoverClient) PollBindingLastOperation(r *osb.BindingLastOperationRequest) (*osb.LastOperationResponse, error) {
+	var response *osb.LastOperationResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.PollBindingLastOperation(r)
+		return err
+	})
+	return response, err
+}
+
+func (c *failoverClient) Bind(r *osb.BindRequest) (*osb.BindResponse, error) {
+	var response *osb.BindResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.Bind(r)
+		return err
+	})
+	return response, err
+}
+
+func (c *failoverClient) Unbind(r *osb.UnbindRequest) (*osb.UnbindResponse, error) {
+	var response *osb.UnbindResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.Unbind(r)
+		return err
+	})
+	return response, err
+}
+
+func (c *failoverClient) GetBinding(r *osb.GetBindingRequest) (*osb.GetBindingResponse, error) {
+	var response *osb.GetBindingResponse
+	err := c.do(func(client osb.Client) (err error) {
+		response, err = client.GetBinding(r)
+		return err
+	})
+	return response, err
+}
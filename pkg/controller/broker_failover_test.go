@@ -0,0 +1,134 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+)
+
+func TestIsBrokerEndpointFailure(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"connection error", errors.New("connection refused"), true},
+		{"service unavailable", osb.HTTPStatusCodeError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"gateway timeout", osb.HTTPStatusCodeError{StatusCode: http.StatusGatewayTimeout}, true},
+		{"internal server error", osb.HTTPStatusCodeError{StatusCode: http.StatusInternalServerError}, false},
+		{"bad request", osb.HTTPStatusCodeError{StatusCode: http.StatusBadRequest}, false},
+	}
+	for _, tc := range cases {
+		if e, a := tc.expected, isBrokerEndpointFailure(tc.err); e != a {
+			t.Errorf("%v: expected %v, got %v", tc.name, e, a)
+		}
+	}
+}
+
+// TestFailoverClient tests that requests are sent to the next endpoint when
+// an endpoint is unavailable, and that the unavailable endpoint is then
+// skipped.
+func TestFailoverClient(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	primary := fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Error: errors.New("connection refused"),
+		},
+	})
+	standby := fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Response: &osb.CatalogResponse{},
+		},
+	})
+	clients := map[string]osb.Client{
+		"https://primary.example.com": primary,
+		"https://standby.example.com": standby,
+	}
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		return clients[config.URL], nil
+	}
+
+	clientConfig := osb.DefaultClientConfiguration()
+	clientConfig.URL = "https://primary.example.com"
+	client, err := testController.newBrokerClient(&brokerClientConfiguration{ClientConfiguration: clientConfig}, []string{"https://standby.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetCatalog(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if e, a := 1, len(primary.Actions()); e != a {
+		t.Fatalf("expected the unavailable endpoint to be called %v time, got %v", e, a)
+	}
+	if e, a := 2, len(standby.Actions()); e != a {
+		t.Fatalf("expected the standby endpoint to be called %v times, got %v", e, a)
+	}
+	if testController.brokerEndpointHealth.isHealthy("https://primary.example.com") {
+		t.Fatal("expected the unavailable endpoint to be unhealthy")
+	}
+
+	// An endpoint that is available again is used once it is healthy.
+	testController.brokerEndpointHealth.setHealthy("https://primary.example.com", true)
+	primary.CatalogReaction = &fakeosb.CatalogReaction{Response: &osb.CatalogResponse{}}
+	if _, err := client.GetCatalog(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 2, len(primary.Actions()); e != a {
+		t.Fatalf("expected the primary endpoint to be called again, got %v calls", a)
+	}
+}
+
+// TestFailoverClientBrokerError tests that an error of the broker itself is
+// returned without trying the other endpoints.
+func TestFailoverClientBrokerError(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	primary := fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Error: osb.HTTPStatusCodeError{StatusCode: http.StatusBadRequest},
+		},
+	})
+	standby := fakeosb.NewFakeClient(noFakeActions())
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		if config.URL == "https://standby.example.com" {
+			return standby, nil
+		}
+		return primary, nil
+	}
+
+	clientConfig := osb.DefaultClientConfiguration()
+	clientConfig.URL = "https://primary.example.com"
+	client, err := testController.newBrokerClient(&brokerClientConfiguration{ClientConfiguration: clientConfig}, []string{"https://standby.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetCatalog(); err == nil {
+		t.Fatal("expected the error of the broker to be returned")
+	}
+	if e, a := 0, len(standby.Actions()); e != a {
+		t.Fatalf("expected the standby endpoint not to be called, got %v calls", a)
+	}
+}
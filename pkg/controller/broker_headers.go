@@ -0,0 +1,105 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// brokerClientConfiguration is the configuration of a client for a broker:
+// the configuration of the OSB client library, and the custom headers of the
+// broker, which the transport of the client adds to every request since the
+// library does not send them.
+type brokerClientConfiguration struct {
+	*osb.ClientConfiguration
+	CustomHeaders map[string]string
+}
+
+// getClientConfigurationForClusterServiceBroker returns the configuration of
+// a client for the given broker, with its auth credentials and the values of
+// its custom headers read from their secrets.
+func getClientConfigurationForClusterServiceBroker(client kubernetes.Interface, broker *v1beta1.ClusterServiceBroker) (*brokerClientConfiguration, error) {
+	authConfig, err := getAuthCredentialsFromClusterServiceBroker(client, broker)
+	if err != nil {
+		return nil, err
+	}
+	customHeaders, err := getCustomHeaders(client, broker.Spec.CustomHeaders, "")
+	if err != nil {
+		return nil, err
+	}
+	return &brokerClientConfiguration{
+		ClientConfiguration: NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig),
+		CustomHeaders:       customHeaders,
+	}, nil
+}
+
+// getClientConfigurationForServiceBroker returns the configuration of a
+// client for the given broker, with its auth credentials and the values of
+// its custom headers read from their secrets.
+func getClientConfigurationForServiceBroker(client kubernetes.Interface, broker *v1beta1.ServiceBroker) (*brokerClientConfiguration, error) {
+	authConfig, err := getAuthCredentialsFromServiceBroker(client, broker)
+	if err != nil {
+		return nil, err
+	}
+	customHeaders, err := getCustomHeaders(client, broker.Spec.CustomHeaders, broker.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &brokerClientConfiguration{
+		ClientConfiguration: NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig),
+		CustomHeaders:       customHeaders,
+	}, nil
+}
+
+// getCustomHeaders returns the values of the given custom headers of a
+// broker, reading the values that are kept in secrets. The secrets of
+// references without a namespace are read from the given namespace.
+func getCustomHeaders(client kubernetes.Interface, headers map[string]v1beta1.BrokerHeaderValue, namespace string) (map[string]string, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	values := make(map[string]string, len(headers))
+	for name, header := range headers {
+		secretRef := header.SecretKeyRef
+		if secretRef == nil {
+			values[name] = header.Value
+			continue
+		}
+
+		secretNamespace := secretRef.Namespace
+		if secretNamespace == "" {
+			secretNamespace = namespace
+		}
+		secret, err := client.CoreV1().Secrets(secretNamespace).Get(secretRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting the secret of custom header %q: %v", name, err)
+		}
+		value, ok := secret.Data[secretRef.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s/%s of custom header %q has no key %q", secretNamespace, secretRef.Name, name, secretRef.Key)
+		}
+		values[name] = string(value)
+	}
+	return values, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestGetCustomHeaders(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "routing", Namespace: "broker-ns"},
+		Data:       map[string][]byte{"key": []byte("secret-route")},
+	}
+
+	cases := []struct {
+		name        string
+		headers     map[string]v1beta1.BrokerHeaderValue
+		expected    map[string]string
+		expectedErr string
+	}{
+		{
+			name: "no headers",
+		},
+		{
+			name: "value and secret in the default namespace",
+			headers: map[string]v1beta1.BrokerHeaderValue{
+				"X-Tenant-ID":   {Value: "tenant-1"},
+				"X-Routing-Key": {SecretKeyRef: &v1beta1.BrokerSecretKeyReference{Name: "routing", Key: "key"}},
+			},
+			expected: map[string]string{"X-Tenant-ID": "tenant-1", "X-Routing-Key": "secret-route"},
+		},
+		{
+			name: "secret in another namespace",
+			headers: map[string]v1beta1.BrokerHeaderValue{
+				"X-Routing-Key": {SecretKeyRef: &v1beta1.BrokerSecretKeyReference{Namespace: "other-ns", Name: "routing", Key: "key"}},
+			},
+			expectedErr: `error getting the secret of custom header "X-Routing-Key"`,
+		},
+		{
+			name: "missing key",
+			headers: map[string]v1beta1.BrokerHeaderValue{
+				"X-Routing-Key": {SecretKeyRef: &v1beta1.BrokerSecretKeyReference{Name: "routing", Key: "other-key"}},
+			},
+			expectedErr: `secret broker-ns/routing of custom header "X-Routing-Key" has no key "other-key"`,
+		},
+	}
+
+	for _, tc := range cases {
+		client := clientgofake.NewSimpleClientset(secret)
+		headers, err := getCustomHeaders(client, tc.headers, "broker-ns")
+		if tc.expectedErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("%v: expected an error containing %q, got %v", tc.name, tc.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(headers, tc.expected) {
+			t.Errorf("%v: expected headers %v, got %v", tc.name, tc.expected, headers)
+		}
+	}
+}
+
+func TestGetClientConfigurationForClusterServiceBrokerSetsCustomHeaders(t *testing.T) {
+	broker := getTestClusterServiceBroker()
+	broker.Spec.CustomHeaders = map[string]v1beta1.BrokerHeaderValue{
+		"X-Tenant-ID": {Value: "tenant-1"},
+	}
+
+	clientConfig, err := getClientConfigurationForClusterServiceBroker(clientgofake.NewSimpleClientset(), broker)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := map[string]string{"X-Tenant-ID": "tenant-1"}, clientConfig.CustomHeaders; !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected custom headers %v, got %v", e, a)
+	}
+	if e, a := broker.Spec.URL, clientConfig.URL; e != a {
+		t.Fatalf("expected URL %q, got %q", e, a)
+	}
+}
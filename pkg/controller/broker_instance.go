@@ -0,0 +1,55 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics/osbclientproxy"
+)
+
+// instanceGetter is implemented by the broker clients that fetch instances,
+// which the OSB client library does not support, like the proxy recording
+// metrics.
+type instanceGetter interface {
+	GetInstance(r *osbclientproxy.GetInstanceRequest) (*osbclientproxy.GetInstanceResponse, error)
+}
+
+func (c *failoverClient) GetInstance(r *osbclientproxy.GetInstanceRequest) (*osbclientproxy.GetInstanceResponse, error) {
+	var instance *osbclientproxy.GetInstanceResponse
+	err := c.do(func(client osb.Client) (err error) {
+		instance, err = getBrokerInstance(client, r.InstanceID)
+		return err
+	})
+	return instance, err
+}
+
+func (c *brokerClient) GetInstance(r *osbclientproxy.GetInstanceRequest) (*osbclientproxy.GetInstanceResponse, error) {
+	return getBrokerInstance(c.Client, r.InstanceID)
+}
+
+// getBrokerInstance fetches the instance with the given ID with the given
+// client, or fails if the client cannot fetch instances.
+func getBrokerInstance(client osb.Client, instanceID string) (*osbclientproxy.GetInstanceResponse, error) {
+	getter, ok := client.(instanceGetter)
+	if !ok {
+		return nil, fmt.Errorf("the client of the broker cannot fetch instances")
+	}
+	return getter.GetInstance(&osbclientproxy.GetInstanceRequest{InstanceID: instanceID})
+}
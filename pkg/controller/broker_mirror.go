@@ -0,0 +1,176 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+// getServiceInstanceMirrorClient returns a client for the mirror of the
+// broker of the given instance, whose references must be resolved, or nil if
+// the broker has no mirror. The client uses the credentials, custom headers
+// and TLS settings of the broker.
+func (c *controller) getServiceInstanceMirrorClient(instance *v1beta1.ServiceInstance) (osb.Client, error) {
+	if instance.Spec.ClusterServiceClassSpecified() {
+		class, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return c.getClusterServiceBrokerMirrorClient(class.Spec.ClusterServiceBrokerName)
+	}
+
+	class, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	if class.Spec.ClusterServiceBroker {
+		return c.getClusterServiceBrokerMirrorClient(class.Spec.ServiceBrokerName)
+	}
+	broker, err := c.getServiceBrokerForServiceClass(class)
+	if err != nil {
+		return nil, err
+	}
+	if broker.Spec.MirrorURL == "" {
+		return nil, nil
+	}
+	clientConfig, err := getClientConfigurationForServiceBroker(c.kubeClient, broker)
+	if err != nil {
+		return nil, err
+	}
+	clientConfig.URL = broker.Spec.MirrorURL
+	return c.createBrokerClient(clientConfig, &brokerResponses{})
+}
+
+func (c *controller) getClusterServiceBrokerMirrorClient(brokerName string) (osb.Client, error) {
+	broker, err := c.clusterServiceBrokerLister.Get(brokerName)
+	if err != nil {
+		return nil, err
+	}
+	if broker.Spec.MirrorURL == "" {
+		return nil, nil
+	}
+	clientConfig, err := getClientConfigurationForClusterServiceBroker(c.kubeClient, broker)
+	if err != nil {
+		return nil, err
+	}
+	clientConfig.URL = broker.Spec.MirrorURL
+	return c.createBrokerClient(clientConfig, &brokerResponses{})
+}
+
+// mirroredRequest is the last request of an instance sent to the mirror of
+// its broker.
+type mirroredRequest struct {
+	// deprovision is whether the request was a deprovision request rather
+	// than a provision request.
+	deprovision bool
+	// operationStartTime is the start time of the operation of the instance
+	// the request was sent for.
+	operationStartTime time.Time
+}
+
+// mirroredRequests holds the last request of each instance sent to the mirror
+// of its broker, so that only the first attempt of an operation is mirrored,
+// not the retries.
+type mirroredRequests struct {
+	lock     sync.Mutex
+	requests map[types.UID]mirroredRequest
+}
+
+// firstAttempt records the given request of the given instance and returns
+// whether it is the first attempt of the operation of the instance.
+func (m *mirroredRequests) firstAttempt(instance *v1beta1.ServiceInstance, deprovision bool) bool {
+	request := mirroredRequest{deprovision: deprovision}
+	if instance.Status.OperationStartTime != nil {
+		request.operationStartTime = instance.Status.OperationStartTime.Time
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if last, ok := m.requests[instance.UID]; ok && last.deprovision == request.deprovision && last.operationStartTime.Equal(request.operationStartTime) {
+		return false
+	}
+	if m.requests == nil {
+		m.requests = make(map[types.UID]mirroredRequest)
+	}
+	m.requests[instance.UID] = request
+	return true
+}
+
+// forget drops the requests recorded for the given instance.
+func (m *mirroredRequests) forget(instance *v1beta1.ServiceInstance) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.requests, instance.UID)
+}
+
+// mirrorProvisionRequest sends a copy of the given provision request of an
+// instance to the mirror of its broker, if the broker has one and this is the
+// first attempt of the provision. The copy is sent in the background and its
+// outcome is only logged, so that the mirror can neither hold up nor change
+// the provision of the instance.
+func (c *controller) mirrorProvisionRequest(instance *v1beta1.ServiceInstance, request *osb.ProvisionRequest) {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	mirrorClient, err := c.getServiceInstanceMirrorClient(instance)
+	if err != nil {
+		glog.Warning(pcb.Messagef("Error creating the client for the mirror of the broker: %v", err))
+		return
+	}
+	if mirrorClient == nil || !c.mirroredRequests.firstAttempt(instance, false) {
+		return
+	}
+
+	mirrored := *request
+	go func() {
+		if _, err := mirrorClient.ProvisionInstance(&mirrored); err != nil {
+			glog.Warning(pcb.Messagef("Error sending the mirrored provision request: %v", err))
+			return
+		}
+		glog.V(4).Info(pcb.Message("Sent the mirrored provision request"))
+	}()
+}
+
+// mirrorDeprovisionRequest sends a copy of the given deprovision request of
+// an instance to the mirror of its broker, like mirrorProvisionRequest, so
+// that the mirror can clean up the instances it was sent.
+func (c *controller) mirrorDeprovisionRequest(instance *v1beta1.ServiceInstance, request *osb.DeprovisionRequest) {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	mirrorClient, err := c.getServiceInstanceMirrorClient(instance)
+	if err != nil {
+		glog.Warning(pcb.Messagef("Error creating the client for the mirror of the broker: %v", err))
+		return
+	}
+	if mirrorClient == nil || !c.mirroredRequests.firstAttempt(instance, true) {
+		return
+	}
+
+	mirrored := *request
+	go func() {
+		if _, err := mirrorClient.DeprovisionInstance(&mirrored); err != nil {
+			glog.Warning(pcb.Messagef("Error sending the mirrored deprovision request: %v", err))
+			return
+		}
+		glog.V(4).Info(pcb.Message("Sent the mirrored deprovision request"))
+	}()
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const testMirrorURL = "https://mirror.example.com"
+
+// TestReconcileServiceInstanceMirrorProvision tests that a copy of the
+// provision request is sent to the mirror of the broker, and that the failure
+// of the mirror does not affect the provision.
+func TestReconcileServiceInstanceMirrorProvision(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{},
+		},
+	})
+	fakeMirrorClient := fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Error: errors.New("mirror unavailable"),
+		},
+	})
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		if config.URL == testMirrorURL {
+			return fakeMirrorClient, nil
+		}
+		return fakeClusterServiceBrokerClient, nil
+	}
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	broker := getTestClusterServiceBroker()
+	broker.Spec.MirrorURL = testMirrorURL
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	instance = assertServiceInstanceProvisionInProgressIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedRequest := &osb.ProvisionRequest{
+		AcceptsIncomplete: true,
+		InstanceID:        testServiceInstanceGUID,
+		ServiceID:         testClusterServiceClassGUID,
+		PlanID:            testClusterServicePlanGUID,
+		OrganizationGUID:  testClusterID,
+		SpaceGUID:         testNamespaceGUID,
+		Context:           testContext,
+	}
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertProvision(t, brokerActions[0], expectedRequest)
+
+	err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return len(fakeMirrorClient.Actions()) > 0, nil
+	})
+	if err != nil {
+		t.Fatal("expected the provision request to be sent to the mirror")
+	}
+	mirrorActions := fakeMirrorClient.Actions()
+	assertNumberOfBrokerActions(t, mirrorActions, 1)
+	assertProvision(t, mirrorActions[0], expectedRequest)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceReadyTrue(t, updatedServiceInstance)
+}
+
+// TestGetServiceInstanceMirrorClientNoMirror tests that no client is created
+// for a broker without a mirror.
+func TestGetServiceInstanceMirrorClientNoMirror(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+
+	client, err := testController.getServiceInstanceMirrorClient(getTestServiceInstanceWithClusterRefs())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client != nil {
+		t.Fatal("expected no client for a broker without a mirror")
+	}
+}
+
+// TestMirrorRequestsFirstAttemptOnly tests that only the first attempt of the
+// provision and of the deprovision of an instance is sent to the mirror of
+// its broker.
+func TestMirrorRequestsFirstAttemptOnly(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	fakeMirrorClient := fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+		ProvisionReaction:   &fakeosb.ProvisionReaction{Response: &osb.ProvisionResponse{}},
+		DeprovisionReaction: &fakeosb.DeprovisionReaction{Response: &osb.DeprovisionResponse{}},
+	})
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		return fakeMirrorClient, nil
+	}
+
+	broker := getTestClusterServiceBroker()
+	broker.Spec.MirrorURL = testMirrorURL
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	startTime := metav1.Now()
+	instance.Status.OperationStartTime = &startTime
+
+	waitForMirrorActions := func(count int) {
+		err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+			return len(fakeMirrorClient.Actions()) >= count, nil
+		})
+		if err != nil {
+			t.Fatalf("expected %v requests to be sent to the mirror, got %v", count, len(fakeMirrorClient.Actions()))
+		}
+	}
+
+	provisionRequest := &osb.ProvisionRequest{InstanceID: testServiceInstanceGUID}
+	testController.mirrorProvisionRequest(instance, provisionRequest)
+	waitForMirrorActions(1)
+	// A retry of the provision is not mirrored.
+	testController.mirrorProvisionRequest(instance, provisionRequest)
+
+	deprovisionRequest := &osb.DeprovisionRequest{InstanceID: testServiceInstanceGUID}
+	testController.mirrorDeprovisionRequest(instance, deprovisionRequest)
+	waitForMirrorActions(2)
+	// A retry of the deprovision is not mirrored.
+	testController.mirrorDeprovisionRequest(instance, deprovisionRequest)
+
+	actions := fakeMirrorClient.Actions()
+	assertNumberOfBrokerActions(t, actions, 2)
+	if e, a := fakeosb.ProvisionInstance, actions[0].Type; e != a {
+		t.Fatalf("unexpected first mirrored request: %v", expectedGot(e, a))
+	}
+	if e, a := fakeosb.DeprovisionInstance, actions[1].Type; e != a {
+		t.Fatalf("unexpected second mirrored request: %v", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/time/rate"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// BrokerRelistCallbackPath is the path under which the controller manager
+// serves the relist callback of brokers. A broker whose catalog changed POSTs
+// to BrokerRelistCallbackPath + "clusterservicebrokers/<name>" or to
+// BrokerRelistCallbackPath + "namespaces/<namespace>/servicebrokers/<name>".
+const BrokerRelistCallbackPath = "/relist/"
+
+// BrokerRelistCallbackTokenKey is the key of the auth secret of a broker that
+// holds the bearer token with which the broker calls its relist callback. A
+// broker whose auth secret has no such key cannot call the callback.
+const BrokerRelistCallbackTokenKey = "relistToken"
+
+const (
+	// brokerRelistCallbackFailureRate is the rate at which the relist
+	// callback accepts requests that fail to authenticate, per second.
+	brokerRelistCallbackFailureRate = 1
+	// brokerRelistCallbackFailureBurst is how many requests failing to
+	// authenticate the relist callback accepts in a row.
+	brokerRelistCallbackFailureBurst = 10
+)
+
+// BrokerRelistCallbackHandler requests an immediate relist of the broker named
+// by the path of a POST, instead of waiting for its relist interval. The
+// caller must present the token of that broker, read from its auth secret, as
+// a bearer token, so that a broker can only relist itself. The relist is
+// requested by setting the RelistRequestedAtAnnotation of the broker, so it is
+// honored like a relist requested by a user. It answers with 503 Service
+// Unavailable until its controller is set, as the HTTP server starts before
+// the controller does.
+//
+// Brokers and their secrets are read from caches, so that unauthenticated
+// callers cannot make the handler call the API server, and requests failing
+// to authenticate are rate limited, so that tokens cannot be guessed.
+type BrokerRelistCallbackHandler struct {
+	lock         sync.RWMutex
+	controller   *controller
+	secretLister corelisters.SecretLister
+	// failures limits the rate of the requests that fail to authenticate.
+	// Every request is refused until throttledUntil once they exceed it.
+	failures       *rate.Limiter
+	throttledUntil time.Time
+}
+
+// NewBrokerRelistCallbackHandler returns a handler with no controller.
+func NewBrokerRelistCallbackHandler() *BrokerRelistCallbackHandler {
+	return &BrokerRelistCallbackHandler{
+		failures: rate.NewLimiter(brokerRelistCallbackFailureRate, brokerRelistCallbackFailureBurst),
+	}
+}
+
+// SetController sets the controller whose brokers are relisted, which must be
+// a controller returned by NewController, and the lister of the secrets
+// holding the tokens of the brokers.
+func (h *BrokerRelistCallbackHandler) SetController(c Controller, secretLister corelisters.SecretLister) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.controller, _ = c.(*controller)
+	h.secretLister = secretLister
+}
+
+// throttled returns whether requests are refused because too many requests
+// recently failed to authenticate.
+func (h *BrokerRelistCallbackHandler) throttled() bool {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	return time.Now().Before(h.throttledUntil)
+}
+
+// recordFailure records a request that failed to authenticate, and refuses
+// the next requests until another failure is allowed if it exceeds the rate
+// of failures.
+func (h *BrokerRelistCallbackHandler) recordFailure() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if !h.failures.Allow() {
+		h.throttledUntil = time.Now().Add(time.Second / brokerRelistCallbackFailureRate)
+	}
+}
+
+// ServeHTTP requests a relist of the broker named by the path of the request.
+func (h *BrokerRelistCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	namespace, name, ok := parseBrokerRelistCallbackPath(r.URL.Path)
+	if !ok {
+		http.Error(w, fmt.Sprintf("expected a path of the form %sclusterservicebrokers/<name> or %snamespaces/<namespace>/servicebrokers/<name>", BrokerRelistCallbackPath, BrokerRelistCallbackPath), http.StatusNotFound)
+		return
+	}
+	h.lock.RLock()
+	c, secretLister := h.controller, h.secretLister
+	h.lock.RUnlock()
+	if c == nil {
+		http.Error(w, "the controller is not running", http.StatusServiceUnavailable)
+		return
+	}
+
+	if h.throttled() {
+		http.Error(w, "too many requests failed to authenticate", http.StatusTooManyRequests)
+		return
+	}
+
+	// Unknown brokers are refused like wrong tokens, so that callers cannot
+	// tell which brokers exist.
+	token, err := c.brokerRelistCallbackToken(secretLister, namespace, name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		glog.Errorf("Error getting the relist callback token of broker %q in namespace %q: %v", name, namespace, err)
+		http.Error(w, "unable to authenticate the request", http.StatusInternalServerError)
+		return
+	}
+	if err != nil || !authenticated(r, token) {
+		h.recordFailure()
+		http.Error(w, "a valid bearer token is required", http.StatusUnauthorized)
+		return
+	}
+
+	if err := c.requestBrokerRelist(namespace, name); err != nil {
+		glog.Errorf("Error requesting a relist of broker %q in namespace %q: %v", name, namespace, err)
+		code := http.StatusInternalServerError
+		if apierrors.IsNotFound(err) {
+			code = http.StatusNotFound
+		} else if apierrors.IsConflict(err) {
+			code = http.StatusConflict
+		}
+		http.Error(w, err.Error(), code)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// authenticated returns whether the request carries the given bearer token,
+// which must not be empty.
+func authenticated(r *http.Request, token []byte) bool {
+	const prefix = "Bearer "
+	if len(token) == 0 {
+		return false
+	}
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, prefix) {
+		return false
+	}
+	presented := []byte(strings.TrimSpace(strings.TrimPrefix(header, prefix)))
+	return subtle.ConstantTimeCompare(presented, token) == 1
+}
+
+// parseBrokerRelistCallbackPath returns the namespace and name of the broker
+// named by the given path. The namespace is empty for a ClusterServiceBroker.
+func parseBrokerRelistCallbackPath(path string) (namespace, name string, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(path, BrokerRelistCallbackPath), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "clusterservicebrokers" && parts[1] != "":
+		return "", parts[1], true
+	case len(parts) == 4 && parts[0] == "namespaces" && parts[1] != "" && parts[2] == "servicebrokers" && parts[3] != "":
+		return parts[1], parts[3], true
+	default:
+		return "", "", false
+	}
+}
+
+// brokerRelistCallbackToken returns the token of the relist callback of the
+// given broker, kept under BrokerRelistCallbackTokenKey in its auth secret,
+// which is read with the given lister, or an empty token if the broker has no
+// auth secret or no token. The namespace is empty for a ClusterServiceBroker.
+func (c *controller) brokerRelistCallbackToken(secretLister corelisters.SecretLister, namespace, name string) ([]byte, error) {
+	var secretNamespace, secretName string
+	if namespace == "" {
+		broker, err := c.clusterServiceBrokerLister.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		if authInfo := broker.Spec.AuthInfo; authInfo != nil {
+			if authInfo.Basic != nil && authInfo.Basic.SecretRef != nil {
+				secretNamespace, secretName = authInfo.Basic.SecretRef.Namespace, authInfo.Basic.SecretRef.Name
+			} else if authInfo.Bearer != nil && authInfo.Bearer.SecretRef != nil {
+				secretNamespace, secretName = authInfo.Bearer.SecretRef.Namespace, authInfo.Bearer.SecretRef.Name
+			}
+		}
+	} else {
+		if c.serviceBrokerLister == nil {
+			return nil, apierrors.NewNotFound(v1beta1.Resource("servicebrokers"), name)
+		}
+		broker, err := c.serviceBrokerLister.ServiceBrokers(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		if authInfo := broker.Spec.AuthInfo; authInfo != nil {
+			if authInfo.Basic != nil && authInfo.Basic.SecretRef != nil {
+				secretNamespace, secretName = getServiceBrokerSecretNamespace(broker, authInfo.Basic.SecretNamespace), authInfo.Basic.SecretRef.Name
+			} else if authInfo.Bearer != nil && authInfo.Bearer.SecretRef != nil {
+				secretNamespace, secretName = getServiceBrokerSecretNamespace(broker, authInfo.Bearer.SecretNamespace), authInfo.Bearer.SecretRef.Name
+			}
+		}
+	}
+	if secretName == "" {
+		return nil, nil
+	}
+
+	secret, err := secretLister.Secrets(secretNamespace).Get(secretName)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(secret.Data[BrokerRelistCallbackTokenKey]), nil
+}
+
+// requestBrokerRelist sets the RelistRequestedAtAnnotation of the given broker
+// to the current time, which makes the controller relist it. The namespace is
+// empty for a ClusterServiceBroker.
+func (c *controller) requestBrokerRelist(namespace, name string) error {
+	requestedAt := time.Now().UTC().Format(time.RFC3339Nano)
+
+	if namespace == "" {
+		broker, err := c.clusterServiceBrokerLister.Get(name)
+		if err != nil {
+			return err
+		}
+		toUpdate := broker.DeepCopy()
+		setRelistRequestedAt(&toUpdate.ObjectMeta, requestedAt)
+		glog.V(4).Infof("Requesting a relist of ClusterServiceBroker %q from its callback", name)
+		_, err = c.serviceCatalogClient.ClusterServiceBrokers().Update(toUpdate)
+		return err
+	}
+	if c.serviceBrokerLister == nil {
+		return apierrors.NewNotFound(v1beta1.Resource("servicebrokers"), name)
+	}
+	broker, err := c.serviceBrokerLister.ServiceBrokers(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+	toUpdate := broker.DeepCopy()
+	setRelistRequestedAt(&toUpdate.ObjectMeta, requestedAt)
+	glog.V(4).Infof("Requesting a relist of ServiceBroker %q in namespace %q from its callback", name, namespace)
+	_, err = c.serviceCatalogClient.ServiceBrokers(namespace).Update(toUpdate)
+	return err
+}
+
+// setRelistRequestedAt sets the RelistRequestedAtAnnotation of the given
+// broker metadata to the given time.
+func setRelistRequestedAt(meta *metav1.ObjectMeta, requestedAt string) {
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[v1beta1.RelistRequestedAtAnnotation] = requestedAt
+}
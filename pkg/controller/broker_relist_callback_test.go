@@ -0,0 +1,182 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestBrokerRelistCallbackHandler(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	clusterBroker := getTestClusterServiceBrokerWithAuth(&v1beta1.ClusterServiceBrokerAuthInfo{
+		Bearer: &v1beta1.ClusterBearerTokenAuthConfig{
+			SecretRef: &v1beta1.ObjectReference{Namespace: "catalog", Name: "cluster-broker-auth"},
+		},
+	})
+	broker := getTestServiceBroker()
+	broker.Spec.AuthInfo = &v1beta1.ServiceBrokerAuthInfo{
+		Basic: &v1beta1.BasicAuthConfig{
+			SecretRef: &v1beta1.LocalObjectReference{Name: "broker-auth"},
+		},
+	}
+	// A broker whose auth secret has no relist token cannot be relisted.
+	otherBroker := getTestClusterServiceBroker()
+	otherBroker.Name = "other-broker"
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(clusterBroker)
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(otherBroker)
+	sharedInformers.ServiceBrokers().Informer().GetStore().Add(broker)
+
+	secrets := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	secrets.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "catalog", Name: "cluster-broker-auth"},
+		Data:       map[string][]byte{"token": []byte("broker-token"), BrokerRelistCallbackTokenKey: []byte("cluster-token\n")},
+	})
+	secrets.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "broker-auth"},
+		Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass"), BrokerRelistCallbackTokenKey: []byte("namespaced-token")},
+	})
+
+	handler := NewBrokerRelistCallbackHandler()
+	serve := func(method, path, token string) int {
+		request := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+	clusterBrokerPath := BrokerRelistCallbackPath + "clusterservicebrokers/" + testClusterServiceBrokerName
+	brokerPath := BrokerRelistCallbackPath + "namespaces/" + testNamespace + "/servicebrokers/" + testServiceBrokerName
+
+	if e, a := http.StatusServiceUnavailable, serve(http.MethodPost, clusterBrokerPath, "cluster-token"); e != a {
+		t.Fatalf("expected status %v before the controller is set, got %v", e, a)
+	}
+	handler.SetController(testController, corelisters.NewSecretLister(secrets))
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		code   int
+	}{
+		{"no token", http.MethodPost, clusterBrokerPath, "", http.StatusUnauthorized},
+		{"wrong token", http.MethodPost, clusterBrokerPath, "other-token", http.StatusUnauthorized},
+		{"token of another broker", http.MethodPost, clusterBrokerPath, "namespaced-token", http.StatusUnauthorized},
+		{"broker without a token", http.MethodPost, BrokerRelistCallbackPath + "clusterservicebrokers/other-broker", "", http.StatusUnauthorized},
+		{"wrong method", http.MethodGet, clusterBrokerPath, "cluster-token", http.StatusMethodNotAllowed},
+		{"unknown path", http.MethodPost, BrokerRelistCallbackPath + "brokers/" + testClusterServiceBrokerName, "cluster-token", http.StatusNotFound},
+		{"unknown broker", http.MethodPost, BrokerRelistCallbackPath + "clusterservicebrokers/unknown", "cluster-token", http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		if e, a := tc.code, serve(tc.method, tc.path, tc.token); e != a {
+			t.Errorf("%v: expected status %v, got %v", tc.name, e, a)
+		}
+	}
+	if actions := fakeCatalogClient.Actions(); len(actions) != 0 {
+		t.Fatalf("expected no actions for rejected requests, got %+v", actions)
+	}
+	// The secrets are read from the cache, not from the API server.
+	if actions := fakeKubeClient.Actions(); len(actions) != 0 {
+		t.Fatalf("expected no kube actions, got %+v", actions)
+	}
+
+	for path, token := range map[string]string{clusterBrokerPath: "cluster-token", brokerPath: "namespaced-token"} {
+		if e, a := http.StatusAccepted, serve(http.MethodPost, path, token); e != a {
+			t.Fatalf("%v: expected status %v, got %v", path, e, a)
+		}
+	}
+	actions := fakeCatalogClient.Actions()
+	if len(actions) != 2 {
+		t.Fatalf("expected 2 actions, got %+v", actions)
+	}
+	for _, action := range actions {
+		update, ok := action.(clientgotesting.UpdateAction)
+		if !ok || !(action.Matches("update", "clusterservicebrokers") || action.Matches("update", "servicebrokers")) {
+			t.Fatalf("expected an update of a broker, got %+v", action)
+		}
+		updated, err := meta.Accessor(update.GetObject())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if updated.GetAnnotations()[v1beta1.RelistRequestedAtAnnotation] == "" {
+			t.Fatalf("expected the update to set the %v annotation, got %+v", v1beta1.RelistRequestedAtAnnotation, updated.GetAnnotations())
+		}
+	}
+}
+
+// TestBrokerRelistCallbackHandlerLimitsFailures tests that requests are
+// refused once too many requests failed to authenticate, even with a valid
+// token.
+func TestBrokerRelistCallbackHandlerLimitsFailures(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBrokerWithAuth(&v1beta1.ClusterServiceBrokerAuthInfo{
+		Bearer: &v1beta1.ClusterBearerTokenAuthConfig{
+			SecretRef: &v1beta1.ObjectReference{Namespace: "catalog", Name: "cluster-broker-auth"},
+		},
+	}))
+	secrets := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	secrets.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "catalog", Name: "cluster-broker-auth"},
+		Data:       map[string][]byte{BrokerRelistCallbackTokenKey: []byte("cluster-token")},
+	})
+
+	handler := NewBrokerRelistCallbackHandler()
+	handler.SetController(testController, corelisters.NewSecretLister(secrets))
+	serve := func(token string) int {
+		request := httptest.NewRequest(http.MethodPost, BrokerRelistCallbackPath+"clusterservicebrokers/"+testClusterServiceBrokerName, nil)
+		request.Header.Set("Authorization", "Bearer "+token)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	// Authenticated requests are not charged.
+	for i := 0; i < brokerRelistCallbackFailureBurst+1; i++ {
+		if e, a := http.StatusAccepted, serve("cluster-token"); e != a {
+			t.Fatalf("expected status %v, got %v", e, a)
+		}
+	}
+	// The failure beyond the burst is still answered, and throttles the next
+	// requests.
+	for i := 0; i < brokerRelistCallbackFailureBurst+1; i++ {
+		if e, a := http.StatusUnauthorized, serve("wrong-token"); e != a {
+			t.Fatalf("expected status %v, got %v", e, a)
+		}
+	}
+	fakeCatalogClient.ClearActions()
+	if e, a := http.StatusTooManyRequests, serve("cluster-token"); e != a {
+		t.Fatalf("expected status %v once too many requests failed, got %v", e, a)
+	}
+	if actions := fakeCatalogClient.Actions(); len(actions) != 0 {
+		t.Fatalf("expected no actions for a refused request, got %+v", actions)
+	}
+}
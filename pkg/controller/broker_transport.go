@@ -0,0 +1,200 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+)
+
+// brokerResponses records the fields of broker responses that the OSB client
+// library does not decode.
+type brokerResponses struct {
+	lock sync.Mutex
+	// instanceUsable is the instance_usable field of the last response to a
+	// poll of the last operation of an instance, nil if it had none.
+	instanceUsable *bool
+	// instancesRetrievable holds the IDs of the services of the last catalog
+	// whose instances_retrievable field is true.
+	instancesRetrievable map[string]bool
+}
+
+// recordInstanceLastOperation records the fields of the given body of a
+// response to a poll of the last operation of an instance.
+func (r *brokerResponses) recordInstanceLastOperation(body []byte) {
+	var response struct {
+		InstanceUsable *bool `json:"instance_usable"`
+	}
+	// The library reports the bodies it cannot decode itself.
+	json.Unmarshal(body, &response)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.instanceUsable = response.InstanceUsable
+}
+
+// lastInstanceUsable returns the instance_usable field of the last response
+// to a poll of the last operation of an instance.
+func (r *brokerResponses) lastInstanceUsable() *bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.instanceUsable
+}
+
+// recordCatalog records the fields of the given body of a response to a
+// catalog request.
+func (r *brokerResponses) recordCatalog(body []byte) {
+	var response struct {
+		Services []struct {
+			ID                   string `json:"id"`
+			InstancesRetrievable bool   `json:"instances_retrievable"`
+		} `json:"services"`
+	}
+	json.Unmarshal(body, &response)
+
+	instancesRetrievable := make(map[string]bool)
+	for _, service := range response.Services {
+		if service.InstancesRetrievable {
+			instancesRetrievable[service.ID] = true
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.instancesRetrievable = instancesRetrievable
+}
+
+// lastInstancesRetrievable returns the IDs of the services of the last catalog
+// whose instances_retrievable field is true.
+func (r *brokerResponses) lastInstancesRetrievable() map[string]bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.instancesRetrievable
+}
+
+// brokerTransport is the transport of the clients of the OSB client library
+// created for brokers. It adds the custom headers of the broker to the
+// requests, and records the responses of the broker in fields the library
+// does not decode.
+type brokerTransport struct {
+	base      http.RoundTripper
+	headers   map[string]string
+	responses *brokerResponses
+}
+
+var _ http.RoundTripper = &brokerTransport{}
+
+func (t *brokerTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if len(t.headers) > 0 {
+		request = withCustomHeaders(request, t.headers)
+	}
+	response, err := t.base.RoundTrip(request)
+	if err != nil || response.StatusCode != http.StatusOK {
+		return response, err
+	}
+	isCatalog := strings.HasSuffix(request.URL.Path, "/v2/catalog")
+	if !isCatalog && !isInstanceLastOperationPath(request.URL.Path) {
+		return response, nil
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if isCatalog {
+		t.responses.recordCatalog(body)
+	} else {
+		t.responses.recordInstanceLastOperation(body)
+	}
+	return response, nil
+}
+
+// withCustomHeaders returns a copy of the given request with the given
+// headers, except those the request already has, so that they never replace
+// the headers set by the client itself. A transport must not modify the
+// request it is given.
+func withCustomHeaders(request *http.Request, headers map[string]string) *http.Request {
+	copied := *request
+	copied.Header = make(http.Header, len(request.Header)+len(headers))
+	for name, values := range request.Header {
+		copied.Header[name] = append([]string(nil), values...)
+	}
+	for name, value := range headers {
+		if copied.Header.Get(name) == "" {
+			copied.Header.Set(name, value)
+		}
+	}
+	return &copied
+}
+
+// isInstanceLastOperationPath returns whether the given URL path is the one
+// of the last operation of an instance, not of a binding.
+func isInstanceLastOperationPath(path string) bool {
+	return strings.HasSuffix(path, "/last_operation") && !strings.Contains(path, "/service_bindings/")
+}
+
+// brokerClient is a client for a broker created by the controller. It gives
+// access to the fields of the broker responses that the OSB client library
+// does not decode.
+type brokerClient struct {
+	osb.Client
+	responses *brokerResponses
+}
+
+// lastOperationInstanceUsable returns the instance_usable field of the last
+// response to a poll of the last operation of an instance sent with the given
+// client, or nil if the broker did not send it or the client cannot tell.
+func lastOperationInstanceUsable(client osb.Client) *bool {
+	if client, ok := client.(*brokerClient); ok {
+		return client.responses.lastInstanceUsable()
+	}
+	return nil
+}
+
+// catalogInstancesRetrievable returns the IDs of the services of the last
+// catalog fetched with the given client whose instances_retrievable field is
+// true, or nil if the client cannot tell.
+func catalogInstancesRetrievable(client osb.Client) map[string]bool {
+	if client, ok := client.(*brokerClient); ok {
+		return client.responses.lastInstancesRetrievable()
+	}
+	return nil
+}
+
+// createBrokerClient creates a client with the given configuration that sends
+// the custom headers of the broker and whose responses are recorded in the
+// given responses, through the transport of the HTTP client it is configured
+// with.
+func (c *controller) createBrokerClient(clientConfig *brokerClientConfiguration, responses *brokerResponses) (osb.Client, error) {
+	httpClient, err := osb.NewHTTPClient(clientConfig.ClientConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = &brokerTransport{base: httpClient.Transport, headers: clientConfig.CustomHeaders, responses: responses}
+
+	config := *clientConfig.ClientConfiguration
+	config.HTTPClient = httpClient
+	return c.brokerClientCreateFunc(&config)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+)
+
+// TestCreateBrokerClientConfiguresHTTPClient tests that broker clients are
+// created with an HTTP client sending their requests through the transport of
+// the controller.
+func TestCreateBrokerClientConfiguresHTTPClient(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	var createdConfig *osb.ClientConfiguration
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		createdConfig = config
+		return fakeosb.NewFakeClient(noFakeActions()), nil
+	}
+
+	config := osb.DefaultClientConfiguration()
+	config.URL = "https://example.com"
+	if _, err := testController.newBrokerClient(&brokerClientConfiguration{ClientConfiguration: config}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if createdConfig.HTTPClient == nil {
+		t.Fatal("expected the client to be created with an HTTP client")
+	}
+	if _, ok := createdConfig.HTTPClient.Transport.(*brokerTransport); !ok {
+		t.Fatalf("expected the HTTP client to send its requests through the broker transport, got %T", createdConfig.HTTPClient.Transport)
+	}
+	if config.HTTPClient != nil {
+		t.Fatal("expected the given configuration not to be modified")
+	}
+}
+
+// TestBrokerClientRecordsInstanceUsable tests that the instance_usable field
+// of the last operation of an instance is recorded, and that the one of a
+// binding is not.
+func TestBrokerClientRecordsInstanceUsable(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"state":"failed","instance_usable":false}`))
+	}))
+	defer server.Close()
+	testController.brokerClientCreateFunc = osb.NewClient
+
+	config := osb.DefaultClientConfiguration()
+	config.URL = server.URL
+	config.APIVersion = osb.LatestAPIVersion()
+	config.EnableAlphaFeatures = true
+	client, err := testController.newBrokerClient(&brokerClientConfiguration{ClientConfiguration: config}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.PollBindingLastOperation(&osb.BindingLastOperationRequest{InstanceID: "instance", BindingID: "binding"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usable := lastOperationInstanceUsable(client); usable != nil {
+		t.Fatalf("expected the last operation of a binding not to be recorded, got %v", *usable)
+	}
+
+	if _, err := client.PollLastOperation(&osb.LastOperationRequest{InstanceID: "instance"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usable := lastOperationInstanceUsable(client); usable == nil || *usable {
+		t.Fatalf("expected the instance to be recorded as unusable, got %v", usable)
+	}
+}
+
+// TestBrokerClientRecordsInstancesRetrievable tests that the services of a
+// catalog whose instances_retrievable field is true are recorded.
+func TestBrokerClientRecordsInstancesRetrievable(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"services":[{"id":"retrievable","name":"a","instances_retrievable":true},{"id":"other","name":"b"}]}`))
+	}))
+	defer server.Close()
+	testController.brokerClientCreateFunc = osb.NewClient
+
+	config := osb.DefaultClientConfiguration()
+	config.URL = server.URL
+	client, err := testController.newBrokerClient(&brokerClientConfiguration{ClientConfiguration: config}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, instancesRetrievable, err := fetchBrokerCatalog(client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := map[string]bool{"retrievable": true}, instancesRetrievable; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected services with retrievable instances: %v", expectedGot(e, a))
+	}
+}
+
+// TestBrokerClientSendsCustomHeaders tests that the custom headers of a broker
+// are sent on its requests, without replacing the headers set by the client.
+func TestBrokerClientSendsCustomHeaders(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	defer server.Close()
+	testController.brokerClientCreateFunc = osb.NewClient
+
+	config := osb.DefaultClientConfiguration()
+	config.URL = server.URL
+	client, err := testController.newBrokerClient(&brokerClientConfiguration{
+		ClientConfiguration: config,
+		CustomHeaders: map[string]string{
+			"X-Tenant-ID":        "tenant-1",
+			osb.APIVersionHeader: "1.0",
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.GetCatalog(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "tenant-1", headers.Get("X-Tenant-ID"); e != a {
+		t.Fatalf("unexpected custom header: %v", expectedGot(e, a))
+	}
+	if e, a := config.APIVersion.HeaderValue(), headers.Get(osb.APIVersionHeader); e != a {
+		t.Fatalf("unexpected API version header: %v", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	removedFromCatalogReason                      string = "RemovedFromBrokerCatalog"
+	removedFromCatalogMessage                     string = "The class or plan of the instance has been removed from the broker's catalog"
+	removedFromCatalogBindingsBlockedReason       string = "RemovedFromBrokerCatalogBindingsBlocked"
+	removedFromCatalogBindingsBlockedMessage      string = "The class or plan of the instance has been removed from the broker's catalog; new bindings are blocked"
+	removedFromCatalogDeprovisionScheduledReason  string = "RemovedFromBrokerCatalogDeprovisionScheduled"
+	removedFromCatalogDeprovisionScheduledMessage string = "The class or plan of the instance has been removed from the broker's catalog; the instance will be deprovisioned after %v"
+	deprovisioningRemovedFromCatalogReason        string = "DeprovisioningRemovedFromBrokerCatalog"
+	deprovisioningRemovedFromCatalogMessage       string = "Deleting the instance because its class or plan was removed from the broker's catalog more than %v ago"
+	catalogPendingRemovalReason                   string = "CatalogPendingRemoval"
+	catalogPendingRemovalMessage                  string = "The broker is being deleted; its classes and plans will be deleted at %v"
+	referencedCatalogEntryRemovedReason           string = "ReferencedCatalogEntryRemoved"
+	referencedCatalogEntryRemovedMessage          string = "The class or plan of the instance has been removed from the broker's catalog; plan a migration to another class or plan"
+
+	// defaultCatalogRemovalDeprovisionDelay is the delay used by the
+	// ScheduledDeprovision catalog removal policy when the broker does not
+	// specify one.
+	defaultCatalogRemovalDeprovisionDelay = 24 * time.Hour
+)
+
+// getServiceInstanceRemovedFromCatalogCondition returns the RemovedFromCatalog
+// condition of the given instance, or nil if the instance does not have one.
+func getServiceInstanceRemovedFromCatalogCondition(instance *v1beta1.ServiceInstance) *v1beta1.ServiceInstanceCondition {
+	return getServiceInstanceConditionOfType(instance, v1beta1.ServiceInstanceConditionRemovedFromCatalog)
+}
+
+// getServiceInstanceConditionOfType returns the condition of the given type
+// of the given instance, or nil if the instance does not have one.
+func getServiceInstanceConditionOfType(instance *v1beta1.ServiceInstance, conditionType v1beta1.ServiceInstanceConditionType) *v1beta1.ServiceInstanceCondition {
+	for i, cond := range instance.Status.Conditions {
+		if cond.Type == conditionType {
+			return &instance.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// isServiceInstanceBindingBlockedByCatalogRemoval returns whether new
+// bindings to the given instance are blocked because its class or plan has
+// been removed from the broker's catalog.
+func isServiceInstanceBindingBlockedByCatalogRemoval(instance *v1beta1.ServiceInstance) bool {
+	cond := getServiceInstanceRemovedFromCatalogCondition(instance)
+	if cond == nil || cond.Status != v1beta1.ConditionTrue {
+		return false
+	}
+	return cond.Reason == removedFromCatalogBindingsBlockedReason ||
+		cond.Reason == removedFromCatalogDeprovisionScheduledReason
+}
+
+// applyCatalogRemovalPolicy applies the CatalogRemovalPolicy of the named
+// broker to the instances of that broker. affected are the instances whose
+// class or plan has been removed from the broker's catalog; they get the
+// ReferencedCatalogEntryRemoved condition whatever the policy, and are
+// counted in the BrokerStaleServiceInstanceCount metric. unaffected are the
+// other instances of the broker, which have any RemovedFromCatalog or
+// ReferencedCatalogEntryRemoved condition left over from an earlier relist
+// cleared. Failures to update an instance are logged and retried on the next
+// relist.
+func (c *controller) applyCatalogRemovalPolicy(brokerName string, spec *v1beta1.CommonServiceBrokerSpec, affected, unaffected []*v1beta1.ServiceInstance) {
+	delay := defaultCatalogRemovalDeprovisionDelay
+	if spec.CatalogRemovalDeprovisionDelay != nil {
+		delay = spec.CatalogRemovalDeprovisionDelay.Duration
+	}
+
+	var reason, message string
+	switch spec.CatalogRemovalPolicy {
+	case v1beta1.CatalogRemovalPolicyWarn:
+		reason = removedFromCatalogReason
+		message = removedFromCatalogMessage
+	case v1beta1.CatalogRemovalPolicyBlockNewBindings:
+		reason = removedFromCatalogBindingsBlockedReason
+		message = removedFromCatalogBindingsBlockedMessage
+	case v1beta1.CatalogRemovalPolicyScheduledDeprovision:
+		reason = removedFromCatalogDeprovisionScheduledReason
+		message = fmt.Sprintf(removedFromCatalogDeprovisionScheduledMessage, delay)
+	default:
+		// Keep leaves the RemovedFromCatalog condition unset, and clears any
+		// set by an earlier policy.
+	}
+
+	for _, instance := range unaffected {
+		if getServiceInstanceRemovedFromCatalogCondition(instance) == nil &&
+			getServiceInstanceConditionOfType(instance, v1beta1.ServiceInstanceConditionReferencedCatalogEntryRemoved) == nil {
+			continue
+		}
+		toUpdate := instance.DeepCopy()
+		removeServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionRemovedFromCatalog)
+		removeServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionReferencedCatalogEntryRemoved)
+		c.updateServiceInstanceStatusForCatalogRemoval(toUpdate)
+	}
+
+	staleCount := 0
+	for _, instance := range affected {
+		if instance.DeletionTimestamp != nil {
+			continue
+		}
+		staleCount++
+		pcb := pretty.NewInstanceContextBuilder(instance)
+
+		// Mark the instance as referencing a removed class or plan in the
+		// same update as the condition of the policy, if any.
+		toUpdate := instance.DeepCopy()
+		markedStale := false
+		if !isServiceInstanceConditionTrue(instance, v1beta1.ServiceInstanceConditionReferencedCatalogEntryRemoved) {
+			glog.V(4).Info(pcb.Message(referencedCatalogEntryRemovedMessage))
+			setServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionReferencedCatalogEntryRemoved, v1beta1.ConditionTrue,
+				referencedCatalogEntryRemovedReason, referencedCatalogEntryRemovedMessage)
+			markedStale = true
+		}
+
+		cond := getServiceInstanceRemovedFromCatalogCondition(instance)
+		if reason == "" {
+			if cond != nil {
+				removeServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionRemovedFromCatalog)
+			} else if !markedStale {
+				continue
+			}
+			c.updateStaleServiceInstanceStatus(instance, toUpdate, markedStale)
+			continue
+		}
+
+		if cond == nil || cond.Status != v1beta1.ConditionTrue || cond.Reason != reason || cond.Message != message {
+			glog.V(4).Info(pcb.Message(message))
+			setServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionRemovedFromCatalog, v1beta1.ConditionTrue, reason, message)
+			if c.updateStaleServiceInstanceStatus(instance, toUpdate, markedStale) {
+				c.recorder.Event(instance, corev1.EventTypeWarning, reason, message)
+			}
+			continue
+		}
+
+		if markedStale {
+			c.updateStaleServiceInstanceStatus(instance, toUpdate, markedStale)
+			continue
+		}
+
+		if spec.CatalogRemovalPolicy != v1beta1.CatalogRemovalPolicyScheduledDeprovision ||
+			time.Since(cond.LastTransitionTime.Time) < delay {
+			continue
+		}
+
+		s := fmt.Sprintf(deprovisioningRemovedFromCatalogMessage, delay)
+		glog.V(4).Info(pcb.Message(s))
+		err := c.serviceCatalogClient.ServiceInstances(instance.Namespace).Delete(instance.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			glog.Warning(pcb.Messagef("Error deleting instance: %v", err))
+			continue
+		}
+		c.recorder.Event(instance, corev1.EventTypeWarning, deprovisioningRemovedFromCatalogReason, s)
+	}
+
+	metrics.BrokerStaleServiceInstanceCount.WithLabelValues(brokerName).Set(float64(staleCount))
+}
+
+// updateStaleServiceInstanceStatus updates the status of an instance whose
+// class or plan has been removed from the broker's catalog to toUpdate, and
+// records an event if the ReferencedCatalogEntryRemoved condition has just
+// been set. It returns whether the update succeeded.
+func (c *controller) updateStaleServiceInstanceStatus(instance, toUpdate *v1beta1.ServiceInstance, markedStale bool) bool {
+	if !c.updateServiceInstanceStatusForCatalogRemoval(toUpdate) {
+		return false
+	}
+	if markedStale {
+		c.recorder.Event(instance, corev1.EventTypeWarning, referencedCatalogEntryRemovedReason, referencedCatalogEntryRemovedMessage)
+	}
+	return true
+}
+
+// updateServiceInstanceStatusForCatalogRemoval updates the status of the
+// given instance, logging any error. It returns whether the update succeeded.
+func (c *controller) updateServiceInstanceStatusForCatalogRemoval(toUpdate *v1beta1.ServiceInstance) bool {
+	if _, err := c.serviceCatalogClient.ServiceInstances(toUpdate.Namespace).UpdateStatus(toUpdate); err != nil {
+		pcb := pretty.NewInstanceContextBuilder(toUpdate)
+		glog.Warning(pcb.Messagef("Error updating status for catalog removal: %v", err))
+		return false
+	}
+	return true
+}
+
+// getCatalogRemovalGracePeriod returns how long the classes and plans of the
+// broker with the given spec are kept after being removed from its catalog or
+// after the broker is deleted.
+func getCatalogRemovalGracePeriod(spec *v1beta1.CommonServiceBrokerSpec) time.Duration {
+	if spec.CatalogRemovalGracePeriod == nil {
+		return 0
+	}
+	return spec.CatalogRemovalGracePeriod.Duration
+}
+
+// setPendingRemoval marks a class or plan as pending removal at the given
+// time through pointers to its PendingRemoval and RemovalTime status fields.
+// A removal time that has already been set is kept. It returns whether the
+// fields were changed.
+func setPendingRemoval(pendingRemoval *bool, removalTime **metav1.Time, at time.Time) bool {
+	if *pendingRemoval && *removalTime != nil {
+		return false
+	}
+	t := metav1.NewTime(at)
+	*pendingRemoval = true
+	*removalTime = &t
+	return true
+}
+
+// clearPendingRemoval clears the PendingRemoval and RemovalTime status fields
+// of a class or plan that is back in the broker's catalog. It returns whether
+// the fields were changed.
+func clearPendingRemoval(pendingRemoval *bool, removalTime **metav1.Time) bool {
+	if !*pendingRemoval && *removalTime == nil {
+		return false
+	}
+	*pendingRemoval = false
+	*removalTime = nil
+	return true
+}
+
+// getPendingRemovalDelay returns how long the deletion of a class or plan
+// that is pending removal must still be delayed, or zero if it can be
+// deleted now.
+func getPendingRemovalDelay(pendingRemoval bool, removalTime *metav1.Time, now time.Time) time.Duration {
+	if !pendingRemoval || removalTime == nil {
+		return 0
+	}
+	if delay := removalTime.Time.Sub(now); delay > 0 {
+		return delay
+	}
+	return 0
+}
+
+// isServiceBrokerReadyConditionReason returns whether the given broker
+// conditions contain a Ready condition with the given reason.
+func isServiceBrokerReadyConditionReason(conditions []v1beta1.ServiceBrokerCondition, reason string) bool {
+	for _, cond := range conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionReady {
+			return cond.Reason == reason
+		}
+	}
+	return false
+}
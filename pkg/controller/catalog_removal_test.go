@@ -0,0 +1,228 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func getTestServiceInstanceRemovedFromCatalog(reason string, removedAt time.Time) *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	setServiceInstanceConditionInternal(instance, v1beta1.ServiceInstanceConditionRemovedFromCatalog, v1beta1.ConditionTrue, reason, "", metav1.NewTime(removedAt))
+	setServiceInstanceConditionInternal(instance, v1beta1.ServiceInstanceConditionReferencedCatalogEntryRemoved, v1beta1.ConditionTrue,
+		referencedCatalogEntryRemovedReason, referencedCatalogEntryRemovedMessage, metav1.NewTime(removedAt))
+	return instance
+}
+
+func getTestServiceInstanceReferencingRemovedCatalogEntry() *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReferencedCatalogEntryRemoved, v1beta1.ConditionTrue,
+		referencedCatalogEntryRemovedReason, referencedCatalogEntryRemovedMessage)
+	return instance
+}
+
+func TestApplyCatalogRemovalPolicy(t *testing.T) {
+	cases := []struct {
+		name            string
+		policy          v1beta1.CatalogRemovalPolicy
+		delay           *metav1.Duration
+		affected        *v1beta1.ServiceInstance
+		unaffected      *v1beta1.ServiceInstance
+		expectedVerb    string
+		expectedReason  string
+		expectCondition bool
+	}{
+		{
+			name:         "keep - affected instance marked",
+			policy:       v1beta1.CatalogRemovalPolicyKeep,
+			affected:     getTestServiceInstanceWithClusterRefs(),
+			expectedVerb: "update",
+		},
+		{
+			name:     "keep - marked instance untouched",
+			policy:   v1beta1.CatalogRemovalPolicyKeep,
+			affected: getTestServiceInstanceReferencingRemovedCatalogEntry(),
+		},
+		{
+			name:            "warn - condition set on affected instance",
+			policy:          v1beta1.CatalogRemovalPolicyWarn,
+			affected:        getTestServiceInstanceWithClusterRefs(),
+			expectedVerb:    "update",
+			expectedReason:  removedFromCatalogReason,
+			expectCondition: true,
+		},
+		{
+			name:            "block new bindings - condition set on affected instance",
+			policy:          v1beta1.CatalogRemovalPolicyBlockNewBindings,
+			affected:        getTestServiceInstanceRemovedFromCatalog(removedFromCatalogReason, time.Now()),
+			expectedVerb:    "update",
+			expectedReason:  removedFromCatalogBindingsBlockedReason,
+			expectCondition: true,
+		},
+		{
+			name:         "keep - leftover condition cleared",
+			policy:       v1beta1.CatalogRemovalPolicyKeep,
+			affected:     getTestServiceInstanceRemovedFromCatalog(removedFromCatalogReason, time.Now()),
+			expectedVerb: "update",
+		},
+		{
+			name:         "warn - condition cleared on unaffected instance",
+			policy:       v1beta1.CatalogRemovalPolicyWarn,
+			unaffected:   getTestServiceInstanceRemovedFromCatalog(removedFromCatalogReason, time.Now()),
+			expectedVerb: "update",
+		},
+		{
+			name:   "scheduled deprovision - delay not passed",
+			policy: v1beta1.CatalogRemovalPolicyScheduledDeprovision,
+			delay:  &metav1.Duration{Duration: time.Hour},
+			affected: func() *v1beta1.ServiceInstance {
+				instance := getTestServiceInstanceRemovedFromCatalog(removedFromCatalogDeprovisionScheduledReason, time.Now())
+				instance.Status.Conditions[0].Message = fmt.Sprintf(removedFromCatalogDeprovisionScheduledMessage, time.Hour)
+				return instance
+			}(),
+		},
+		{
+			name:   "scheduled deprovision - delay passed",
+			policy: v1beta1.CatalogRemovalPolicyScheduledDeprovision,
+			delay:  &metav1.Duration{Duration: time.Hour},
+			affected: func() *v1beta1.ServiceInstance {
+				instance := getTestServiceInstanceRemovedFromCatalog(removedFromCatalogDeprovisionScheduledReason, time.Now().Add(-2*time.Hour))
+				instance.Status.Conditions[0].Message = fmt.Sprintf(removedFromCatalogDeprovisionScheduledMessage, time.Hour)
+				return instance
+			}(),
+			expectedVerb: "delete",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{})
+
+			spec := &v1beta1.CommonServiceBrokerSpec{
+				CatalogRemovalPolicy:           tc.policy,
+				CatalogRemovalDeprovisionDelay: tc.delay,
+			}
+			var affected, unaffected []*v1beta1.ServiceInstance
+			instance := tc.affected
+			if instance != nil {
+				affected = append(affected, instance)
+			} else {
+				instance = tc.unaffected
+				unaffected = append(unaffected, instance)
+			}
+
+			testController.applyCatalogRemovalPolicy("test-broker", spec, affected, unaffected)
+
+			actions := fakeCatalogClient.Actions()
+			switch tc.expectedVerb {
+			case "":
+				assertNumberOfActions(t, actions, 0)
+			case "delete":
+				assertNumberOfActions(t, actions, 1)
+				assertDelete(t, actions[0], instance)
+			default:
+				assertNumberOfActions(t, actions, 1)
+				updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+				if tc.expectCondition {
+					assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionRemovedFromCatalog, v1beta1.ConditionTrue, tc.expectedReason)
+				} else {
+					assertServiceInstanceConditionMissing(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionRemovedFromCatalog)
+				}
+				if tc.affected != nil {
+					assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionReferencedCatalogEntryRemoved, v1beta1.ConditionTrue, referencedCatalogEntryRemovedReason)
+				} else {
+					assertServiceInstanceConditionMissing(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionReferencedCatalogEntryRemoved)
+				}
+			}
+		})
+	}
+}
+
+func TestIsServiceInstanceBindingBlockedByCatalogRemoval(t *testing.T) {
+	cases := []struct {
+		name     string
+		instance *v1beta1.ServiceInstance
+		blocked  bool
+	}{
+		{
+			name:     "no condition",
+			instance: getTestServiceInstanceWithClusterRefs(),
+			blocked:  false,
+		},
+		{
+			name:     "warn",
+			instance: getTestServiceInstanceRemovedFromCatalog(removedFromCatalogReason, time.Now()),
+			blocked:  false,
+		},
+		{
+			name:     "block new bindings",
+			instance: getTestServiceInstanceRemovedFromCatalog(removedFromCatalogBindingsBlockedReason, time.Now()),
+			blocked:  true,
+		},
+		{
+			name:     "scheduled deprovision",
+			instance: getTestServiceInstanceRemovedFromCatalog(removedFromCatalogDeprovisionScheduledReason, time.Now()),
+			blocked:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		if e, a := tc.blocked, isServiceInstanceBindingBlockedByCatalogRemoval(tc.instance); e != a {
+			t.Errorf("%v: expected result %v, got %v", tc.name, e, a)
+		}
+	}
+}
+
+func TestPendingRemoval(t *testing.T) {
+	now := time.Now()
+
+	var pendingRemoval bool
+	var removalTime *metav1.Time
+	if e, a := time.Duration(0), getPendingRemovalDelay(pendingRemoval, removalTime, now); e != a {
+		t.Fatalf("unexpected delay when not pending removal: %v", expectedGot(e, a))
+	}
+
+	if !setPendingRemoval(&pendingRemoval, &removalTime, now.Add(time.Hour)) {
+		t.Fatal("expected setPendingRemoval to change the fields")
+	}
+	if setPendingRemoval(&pendingRemoval, &removalTime, now.Add(2*time.Hour)) {
+		t.Fatal("expected setPendingRemoval to keep the existing removal time")
+	}
+	if e, a := time.Hour, getPendingRemovalDelay(pendingRemoval, removalTime, now); e != a {
+		t.Fatalf("unexpected delay before the removal time: %v", expectedGot(e, a))
+	}
+	if e, a := time.Duration(0), getPendingRemovalDelay(pendingRemoval, removalTime, now.Add(2*time.Hour)); e != a {
+		t.Fatalf("unexpected delay after the removal time: %v", expectedGot(e, a))
+	}
+
+	if !clearPendingRemoval(&pendingRemoval, &removalTime) {
+		t.Fatal("expected clearPendingRemoval to change the fields")
+	}
+	if pendingRemoval || removalTime != nil {
+		t.Fatalf("expected pending removal to be cleared, got %v, %v", pendingRemoval, removalTime)
+	}
+	if clearPendingRemoval(&pendingRemoval, &removalTime) {
+		t.Fatal("expected clearPendingRemoval not to change cleared fields")
+	}
+}
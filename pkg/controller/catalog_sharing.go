@@ -0,0 +1,388 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	sharedCatalogNameTakenReason   string = "SharedCatalogNameTaken"
+	sharedCatalogNotAcceptedReason string = "SharedCatalogNotAccepted"
+)
+
+// getServiceBrokerNamespace returns the namespace of the ServiceBroker
+// offering the given ServiceClass. It is the namespace of the class, unless
+// the class is a copy shared from the namespace of its broker.
+func getServiceBrokerNamespace(serviceClass *v1beta1.ServiceClass) string {
+	if serviceClass.Spec.ServiceBrokerNamespace != "" {
+		return serviceClass.Spec.ServiceBrokerNamespace
+	}
+	return serviceClass.Namespace
+}
+
+// getServiceBrokerForServiceClass returns the ServiceBroker offering the
+// given ServiceClass. The broker of a class shared from another namespace
+// must still list the namespace of the class as a target, so that a copy
+// that outlived its sharing, or that was not made by the controller, does not
+// reach the broker. A class projected from a ClusterServiceClass has no
+// ServiceBroker.
+func (c *controller) getServiceBrokerForServiceClass(serviceClass *v1beta1.ServiceClass) (*v1beta1.ServiceBroker, error) {
+	if serviceClass.Spec.ClusterServiceBroker {
+		return nil, errors.NewNotFound(v1beta1.Resource("servicebrokers"), serviceClass.Spec.ServiceBrokerName)
+	}
+	broker, err := c.serviceBrokerLister.ServiceBrokers(getServiceBrokerNamespace(serviceClass)).Get(serviceClass.Spec.ServiceBrokerName)
+	if err != nil {
+		return nil, err
+	}
+	if serviceClass.Spec.ServiceBrokerNamespace == "" {
+		return broker, nil
+	}
+	for _, namespace := range broker.Spec.TargetNamespaces {
+		if namespace == serviceClass.Namespace {
+			return broker, nil
+		}
+	}
+	return nil, errors.NewNotFound(v1beta1.Resource("servicebrokers"), serviceClass.Spec.ServiceBrokerName)
+}
+
+// reconcileSharedCatalog reconciles the copies of the classes and plans of a
+// ServiceBroker in its TargetNamespaces with the given classes and plans from
+// its catalog, in the target namespaces that accept it. Copies that are no
+// longer wanted, because their class or plan left the catalog or their
+// namespace is no longer a target or no longer accepts it, are marked as
+// removed from the broker's catalog, so that they are deleted once no
+// instance uses them. A broker that is being deleted is passed no classes and
+// plans.
+func (c *controller) reconcileSharedCatalog(broker *v1beta1.ServiceBroker, serviceClasses []*v1beta1.ServiceClass, servicePlans []*v1beta1.ServicePlan, now time.Time) error {
+	pcb := pretty.NewServiceBrokerContextBuilder(broker)
+
+	var targetNamespaces []string
+	if broker.DeletionTimestamp == nil {
+		var err error
+		if targetNamespaces, err = c.acceptingTargetNamespaces(broker); err != nil {
+			return err
+		}
+	}
+
+	sharedServiceClasses, sharedServicePlans, err := c.listSharedCatalog(broker)
+	if err != nil {
+		return err
+	}
+	existingServiceClasses := make(map[string]*v1beta1.ServiceClass, len(sharedServiceClasses))
+	for _, serviceClass := range sharedServiceClasses {
+		existingServiceClasses[serviceClass.Namespace+"/"+serviceClass.Name] = serviceClass
+	}
+	existingServicePlans := make(map[string]*v1beta1.ServicePlan, len(sharedServicePlans))
+	for _, servicePlan := range sharedServicePlans {
+		existingServicePlans[servicePlan.Namespace+"/"+servicePlan.Name] = servicePlan
+	}
+
+	for _, namespace := range targetNamespaces {
+		for _, serviceClass := range serviceClasses {
+			key := namespace + "/" + serviceClass.Name
+			existing := existingServiceClasses[key]
+			delete(existingServiceClasses, key)
+			if err := c.reconcileSharedServiceClass(broker, newSharedServiceClass(broker, serviceClass, namespace), existing); err != nil {
+				return err
+			}
+		}
+		for _, servicePlan := range servicePlans {
+			key := namespace + "/" + servicePlan.Name
+			existing := existingServicePlans[key]
+			delete(existingServicePlans, key)
+			if err := c.reconcileSharedServicePlan(broker, newSharedServicePlan(broker, servicePlan, namespace), existing); err != nil {
+				return err
+			}
+		}
+	}
+
+	gracePeriod := getCatalogRemovalGracePeriod(&broker.Spec.CommonServiceBrokerSpec)
+	for _, serviceClass := range existingServiceClasses {
+		if serviceClass.Status.RemovedFromBrokerCatalog {
+			continue
+		}
+		glog.V(4).Info(pcb.Messagef("%s is no longer shared; marking as removed from broker's catalog", pretty.ServiceClassName(serviceClass)))
+		serviceClass.Status.RemovedFromBrokerCatalog = true
+		if gracePeriod > 0 {
+			setPendingRemoval(&serviceClass.Status.PendingRemoval, &serviceClass.Status.RemovalTime, now.Add(gracePeriod))
+		}
+		if _, err := c.serviceCatalogClient.ServiceClasses(serviceClass.Namespace).UpdateStatus(serviceClass); err != nil {
+			glog.Warning(pcb.Messagef("Error updating status of %s: %v", pretty.ServiceClassName(serviceClass), err))
+			return err
+		}
+	}
+	for _, servicePlan := range existingServicePlans {
+		if servicePlan.Status.RemovedFromBrokerCatalog {
+			continue
+		}
+		glog.V(4).Info(pcb.Messagef("%s is no longer shared; marking as removed from broker's catalog", pretty.ServicePlanName(servicePlan)))
+		servicePlan.Status.RemovedFromBrokerCatalog = true
+		if gracePeriod > 0 {
+			setPendingRemoval(&servicePlan.Status.PendingRemoval, &servicePlan.Status.RemovalTime, now.Add(gracePeriod))
+		}
+		if _, err := c.serviceCatalogClient.ServicePlans(servicePlan.Namespace).UpdateStatus(servicePlan); err != nil {
+			glog.Warning(pcb.Messagef("Error updating status of %s: %v", pretty.ServicePlanName(servicePlan), err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// acceptingTargetNamespaces returns the target namespaces of the given
+// ServiceBroker that accept its catalog, because they list the namespace of
+// the broker in their AcceptCatalogsFromAnnotation. The other target
+// namespaces are reported in an event of the broker, and the copies made in
+// them before are marked as removed like those of a namespace that is no
+// longer a target.
+func (c *controller) acceptingTargetNamespaces(broker *v1beta1.ServiceBroker) ([]string, error) {
+	pcb := pretty.NewServiceBrokerContextBuilder(broker)
+
+	var accepting []string
+	for _, namespace := range broker.Spec.TargetNamespaces {
+		ns, err := c.kubeClient.CoreV1().Namespaces().Get(namespace, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			glog.V(4).Info(pcb.Messagef("Not sharing the catalog with namespace %q, which does not exist", namespace))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !acceptsCatalogsFrom(ns, broker.Namespace) {
+			s := "Not sharing the catalog with namespace " + namespace + ", which does not accept catalogs from namespace " + broker.Namespace + " in its " + v1beta1.AcceptCatalogsFromAnnotation + " annotation"
+			glog.Info(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, sharedCatalogNotAcceptedReason, s)
+			continue
+		}
+		accepting = append(accepting, namespace)
+	}
+	return accepting, nil
+}
+
+// acceptsCatalogsFrom returns whether the given namespace accepts the
+// catalogs shared by the ServiceBrokers of the given namespace.
+func acceptsCatalogsFrom(ns *corev1.Namespace, brokerNamespace string) bool {
+	for _, accepted := range strings.Split(ns.Annotations[v1beta1.AcceptCatalogsFromAnnotation], ",") {
+		if strings.TrimSpace(accepted) == brokerNamespace {
+			return true
+		}
+	}
+	return false
+}
+
+// listSharedCatalog returns the copies of the classes and plans of the given
+// ServiceBroker in all namespaces.
+func (c *controller) listSharedCatalog(broker *v1beta1.ServiceBroker) ([]*v1beta1.ServiceClass, []*v1beta1.ServicePlan, error) {
+	allServiceClasses, err := c.serviceClassLister.ServiceClasses(metav1.NamespaceAll).List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	var serviceClasses []*v1beta1.ServiceClass
+	for _, serviceClass := range allServiceClasses {
+		if isSharedByServiceBroker(broker, serviceClass.Spec.ServiceBrokerName, serviceClass.Spec.ServiceBrokerNamespace) {
+			serviceClasses = append(serviceClasses, serviceClass.DeepCopy())
+		}
+	}
+
+	allServicePlans, err := c.servicePlanLister.ServicePlans(metav1.NamespaceAll).List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	var servicePlans []*v1beta1.ServicePlan
+	for _, servicePlan := range allServicePlans {
+		if isSharedByServiceBroker(broker, servicePlan.Spec.ServiceBrokerName, servicePlan.Spec.ServiceBrokerNamespace) {
+			servicePlans = append(servicePlans, servicePlan.DeepCopy())
+		}
+	}
+
+	return serviceClasses, servicePlans, nil
+}
+
+// isSharedByServiceBroker returns whether a class or plan with the given
+// broker name and namespace is a copy shared by the given ServiceBroker.
+func isSharedByServiceBroker(broker *v1beta1.ServiceBroker, brokerName, brokerNamespace string) bool {
+	return brokerName == broker.Name && brokerNamespace == broker.Namespace
+}
+
+// newSharedServiceClass returns the copy of the given ServiceClass of a
+// ServiceBroker in the given target namespace. The copy has no owner
+// references, since owners cannot be in another namespace.
+func newSharedServiceClass(broker *v1beta1.ServiceBroker, serviceClass *v1beta1.ServiceClass, namespace string) *v1beta1.ServiceClass {
+	shared := &v1beta1.ServiceClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        serviceClass.Name,
+			Namespace:   namespace,
+			Labels:      serviceClass.Labels,
+			Annotations: serviceClass.Annotations,
+		},
+		Spec: serviceClass.Spec,
+	}
+	shared = shared.DeepCopy()
+	shared.Spec.ServiceBrokerName = broker.Name
+	shared.Spec.ServiceBrokerNamespace = broker.Namespace
+	return shared
+}
+
+// newSharedServicePlan returns the copy of the given ServicePlan of a
+// ServiceBroker in the given target namespace.
+func newSharedServicePlan(broker *v1beta1.ServiceBroker, servicePlan *v1beta1.ServicePlan, namespace string) *v1beta1.ServicePlan {
+	shared := &v1beta1.ServicePlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        servicePlan.Name,
+			Namespace:   namespace,
+			Labels:      servicePlan.Labels,
+			Annotations: servicePlan.Annotations,
+		},
+		Spec: servicePlan.Spec,
+	}
+	shared = shared.DeepCopy()
+	shared.Spec.ServiceBrokerName = broker.Name
+	shared.Spec.ServiceBrokerNamespace = broker.Namespace
+	return shared
+}
+
+// reconcileSharedServiceClass creates the given copy of a ServiceClass, or
+// updates the existing copy to match it. A class of another broker with the
+// same name in the target namespace is left alone.
+func (c *controller) reconcileSharedServiceClass(broker *v1beta1.ServiceBroker, serviceClass, existing *v1beta1.ServiceClass) error {
+	pcb := pretty.NewServiceBrokerContextBuilder(broker)
+
+	if existing == nil {
+		glog.V(5).Info(pcb.Messagef("Sharing %s", pretty.ServiceClassName(serviceClass)))
+		_, err := c.serviceCatalogClient.ServiceClasses(serviceClass.Namespace).Create(serviceClass)
+		if err == nil {
+			return nil
+		}
+		if errors.IsNotFound(err) {
+			glog.V(4).Info(pcb.Messagef("Not sharing %s: %v", pretty.ServiceClassName(serviceClass), err))
+			return nil
+		}
+		if !errors.IsAlreadyExists(err) {
+			return err
+		}
+		// the copy may have been made by a previous sync that the cache
+		// has not caught up with yet
+		existing, err = c.serviceCatalogClient.ServiceClasses(serviceClass.Namespace).Get(serviceClass.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !isSharedByServiceBroker(broker, existing.Spec.ServiceBrokerName, existing.Spec.ServiceBrokerNamespace) {
+			s := "Not sharing " + pretty.ServiceClassName(serviceClass) + ", which already exists for another broker"
+			glog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, sharedCatalogNameTakenReason, s)
+			return nil
+		}
+	}
+
+	if !reflect.DeepEqual(existing.Spec, serviceClass.Spec) ||
+		!reflect.DeepEqual(existing.Labels, serviceClass.Labels) ||
+		!reflect.DeepEqual(existing.Annotations, serviceClass.Annotations) {
+		glog.V(5).Info(pcb.Messagef("Updating shared %s", pretty.ServiceClassName(serviceClass)))
+		toUpdate := existing.DeepCopy()
+		toUpdate.Labels = serviceClass.Labels
+		toUpdate.Annotations = serviceClass.Annotations
+		toUpdate.Spec = serviceClass.Spec
+		updated, err := c.serviceCatalogClient.ServiceClasses(toUpdate.Namespace).Update(toUpdate)
+		if err != nil {
+			return err
+		}
+		existing = updated
+	}
+
+	if existing.Status.RemovedFromBrokerCatalog {
+		glog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on shared %s", pretty.ServiceClassName(existing)))
+		toUpdate := existing.DeepCopy()
+		toUpdate.Status.RemovedFromBrokerCatalog = false
+		clearPendingRemoval(&toUpdate.Status.PendingRemoval, &toUpdate.Status.RemovalTime)
+		if _, err := c.serviceCatalogClient.ServiceClasses(toUpdate.Namespace).UpdateStatus(toUpdate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileSharedServicePlan creates the given copy of a ServicePlan, or
+// updates the existing copy to match it. A plan of another broker with the
+// same name in the target namespace is left alone.
+func (c *controller) reconcileSharedServicePlan(broker *v1beta1.ServiceBroker, servicePlan, existing *v1beta1.ServicePlan) error {
+	pcb := pretty.NewServiceBrokerContextBuilder(broker)
+
+	if existing == nil {
+		glog.V(5).Info(pcb.Messagef("Sharing %s", pretty.ServicePlanName(servicePlan)))
+		_, err := c.serviceCatalogClient.ServicePlans(servicePlan.Namespace).Create(servicePlan)
+		if err == nil {
+			return nil
+		}
+		if errors.IsNotFound(err) {
+			glog.V(4).Info(pcb.Messagef("Not sharing %s: %v", pretty.ServicePlanName(servicePlan), err))
+			return nil
+		}
+		if !errors.IsAlreadyExists(err) {
+			return err
+		}
+		// the copy may have been made by a previous sync that the cache
+		// has not caught up with yet
+		existing, err = c.serviceCatalogClient.ServicePlans(servicePlan.Namespace).Get(servicePlan.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !isSharedByServiceBroker(broker, existing.Spec.ServiceBrokerName, existing.Spec.ServiceBrokerNamespace) {
+			s := "Not sharing " + pretty.ServicePlanName(servicePlan) + ", which already exists for another broker"
+			glog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, sharedCatalogNameTakenReason, s)
+			return nil
+		}
+	}
+
+	if !reflect.DeepEqual(existing.Spec, servicePlan.Spec) ||
+		!reflect.DeepEqual(existing.Labels, servicePlan.Labels) ||
+		!reflect.DeepEqual(existing.Annotations, servicePlan.Annotations) {
+		glog.V(5).Info(pcb.Messagef("Updating shared %s", pretty.ServicePlanName(servicePlan)))
+		toUpdate := existing.DeepCopy()
+		toUpdate.Labels = servicePlan.Labels
+		toUpdate.Annotations = servicePlan.Annotations
+		toUpdate.Spec = servicePlan.Spec
+		updated, err := c.serviceCatalogClient.ServicePlans(toUpdate.Namespace).Update(toUpdate)
+		if err != nil {
+			return err
+		}
+		existing = updated
+	}
+
+	if existing.Status.RemovedFromBrokerCatalog {
+		glog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on shared %s", pretty.ServicePlanName(existing)))
+		toUpdate := existing.DeepCopy()
+		toUpdate.Status.RemovedFromBrokerCatalog = false
+		clearPendingRemoval(&toUpdate.Status.PendingRemoval, &toUpdate.Status.RemovalTime)
+		if _, err := c.serviceCatalogClient.ServicePlans(toUpdate.Namespace).UpdateStatus(toUpdate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
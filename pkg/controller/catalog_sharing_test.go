@@ -0,0 +1,150 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const testTargetNamespace = "test-target-ns"
+
+func getTestSharedServiceClass(namespace string) *v1beta1.ServiceClass {
+	serviceClass := getTestServiceClass()
+	serviceClass.Namespace = namespace
+	serviceClass.Spec.ServiceBrokerNamespace = testNamespace
+	return serviceClass
+}
+
+func getTestSharedServicePlan(namespace string) *v1beta1.ServicePlan {
+	servicePlan := getTestServicePlan()
+	servicePlan.Namespace = namespace
+	servicePlan.Spec.ServiceBrokerNamespace = testNamespace
+	return servicePlan
+}
+
+// addGetTargetNamespaceReaction makes the namespaces accept the catalogs of
+// the brokers of the given namespace, or of none if it is empty. It takes
+// precedence over the reactions of the test controller.
+func addGetTargetNamespaceReaction(fakeKubeClient *clientgofake.Clientset, acceptCatalogsFrom string) {
+	fakeKubeClient.PrependReactor("get", "namespaces", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: action.(clientgotesting.GetAction).GetName()}}
+		if acceptCatalogsFrom != "" {
+			ns.Annotations = map[string]string{v1beta1.AcceptCatalogsFromAnnotation: "other-ns, " + acceptCatalogsFrom}
+		}
+		return true, ns, nil
+	})
+}
+
+func TestGetServiceBrokerForServiceClass(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	broker := getTestServiceBroker()
+	broker.Spec.TargetNamespaces = []string{testTargetNamespace}
+	sharedInformers.ServiceBrokers().Informer().GetStore().Add(broker)
+
+	for _, serviceClass := range []*v1beta1.ServiceClass{getTestServiceClass(), getTestSharedServiceClass(testTargetNamespace)} {
+		got, err := testController.getServiceBrokerForServiceClass(serviceClass)
+		if err != nil {
+			t.Fatalf("%v/%v: unexpected error: %v", serviceClass.Namespace, serviceClass.Name, err)
+		}
+		if e, a := broker.Name, got.Name; e != a {
+			t.Fatalf("%v/%v: unexpected broker: %v", serviceClass.Namespace, serviceClass.Name, expectedGot(e, a))
+		}
+	}
+
+	if _, err := testController.getServiceBrokerForServiceClass(getTestSharedServiceClass("other-ns")); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected a not found error for a class in a namespace that is not a target, got %v", err)
+	}
+}
+
+// TestReconcileSharedCatalog tests that the classes and plans of a broker are
+// copied into its target namespaces, and that copies in namespaces that are
+// no longer targets are marked as removed from the broker's catalog.
+func TestReconcileSharedCatalog(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	addGetTargetNamespaceReaction(fakeKubeClient, testNamespace)
+	broker := getTestServiceBroker()
+	broker.Spec.TargetNamespaces = []string{testTargetNamespace}
+	sharedInformers.ServiceClasses().Informer().GetStore().Add(getTestSharedServiceClass("stale-ns"))
+	sharedInformers.ServicePlans().Informer().GetStore().Add(getTestSharedServicePlan("stale-ns"))
+
+	err := testController.reconcileSharedCatalog(broker, []*v1beta1.ServiceClass{getTestServiceClass()}, []*v1beta1.ServicePlan{getTestServicePlan()}, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 4)
+
+	createdClass := assertCreate(t, actions[0], getTestSharedServiceClass(testTargetNamespace)).(*v1beta1.ServiceClass)
+	if e, a := testNamespace, createdClass.Spec.ServiceBrokerNamespace; e != a {
+		t.Fatalf("unexpected broker namespace of the shared class: %v", expectedGot(e, a))
+	}
+	if len(createdClass.OwnerReferences) != 0 {
+		t.Fatalf("expected the shared class to have no owner references, got %+v", createdClass.OwnerReferences)
+	}
+	assertCreate(t, actions[1], getTestSharedServicePlan(testTargetNamespace))
+
+	removedClass := assertUpdateStatus(t, actions[2], getTestSharedServiceClass("stale-ns")).(*v1beta1.ServiceClass)
+	if !removedClass.Status.RemovedFromBrokerCatalog {
+		t.Fatal("expected the stale shared class to be marked as removed from the broker's catalog")
+	}
+	removedPlan := assertUpdateStatus(t, actions[3], getTestSharedServicePlan("stale-ns")).(*v1beta1.ServicePlan)
+	if !removedPlan.Status.RemovedFromBrokerCatalog {
+		t.Fatal("expected the stale shared plan to be marked as removed from the broker's catalog")
+	}
+}
+
+// TestReconcileSharedCatalogRequiresAcceptance tests that the catalog of a
+// broker is not copied into a target namespace that does not accept the
+// catalogs of the namespace of the broker, and that the copies made in it
+// before are marked as removed from the broker's catalog.
+func TestReconcileSharedCatalogRequiresAcceptance(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	addGetTargetNamespaceReaction(fakeKubeClient, "")
+	broker := getTestServiceBroker()
+	broker.Spec.TargetNamespaces = []string{testTargetNamespace}
+	sharedInformers.ServiceClasses().Informer().GetStore().Add(getTestSharedServiceClass(testTargetNamespace))
+
+	err := testController.reconcileSharedCatalog(broker, []*v1beta1.ServiceClass{getTestServiceClass()}, nil, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	removedClass := assertUpdateStatus(t, actions[0], getTestSharedServiceClass(testTargetNamespace)).(*v1beta1.ServiceClass)
+	if !removedClass.Status.RemovedFromBrokerCatalog {
+		t.Fatal("expected the shared class to be marked as removed from the broker's catalog")
+	}
+
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 1)
+	if e, a := corev1.EventTypeWarning+" "+sharedCatalogNotAcceptedReason, events[0]; !strings.HasPrefix(a, e) {
+		t.Fatalf("unexpected event: %v", expectedGot(e, a))
+	}
+}
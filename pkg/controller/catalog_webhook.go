@@ -0,0 +1,206 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// CatalogWebhookFailurePolicy specifies how the controller handles a catalog
+// webhook that cannot be called or that returns an error.
+type CatalogWebhookFailurePolicy string
+
+const (
+	// CatalogWebhookFail fails the catalog sync of the broker, which is
+	// retried like any other error fetching the catalog.
+	CatalogWebhookFail CatalogWebhookFailurePolicy = "Fail"
+
+	// CatalogWebhookIgnore syncs the catalog as the broker returned it.
+	CatalogWebhookIgnore CatalogWebhookFailurePolicy = "Ignore"
+)
+
+// maxCatalogWebhookResponseSize is the largest response body accepted from a
+// catalog webhook.
+const maxCatalogWebhookResponseSize = 10 << 20
+
+// CatalogWebhookRequest is the body of the POST request sent to the catalog
+// webhook.
+type CatalogWebhookRequest struct {
+	// Kind is ClusterServiceBroker or ServiceBroker.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of a ServiceBroker.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the broker.
+	Name string `json:"name"`
+	// Catalog is the catalog returned by the broker.
+	Catalog *osb.CatalogResponse `json:"catalog"`
+}
+
+// CatalogWebhookResponse is the body of the response of the catalog webhook.
+type CatalogWebhookResponse struct {
+	// Catalog replaces the catalog of the broker. It must have the same
+	// services and plans, by ID, as the catalog of the request.
+	Catalog *osb.CatalogResponse `json:"catalog"`
+}
+
+// CatalogWebhook is an external HTTPS endpoint that is called with the
+// catalog of a broker each time it is fetched, and that returns the catalog
+// to sync instead. It may change the services and plans, for example to set
+// display names or costs in their metadata or to add tags, but not add or
+// remove any.
+type CatalogWebhook struct {
+	url           string
+	client        *http.Client
+	failurePolicy CatalogWebhookFailurePolicy
+}
+
+// NewCatalogWebhook returns a CatalogWebhook that POSTs to the given https
+// URL, verifying its certificate with the given PEM encoded CA bundle, or with
+// the system trust roots if it is empty.
+func NewCatalogWebhook(webhookURL string, caBundle []byte, timeout time.Duration, failurePolicy CatalogWebhookFailurePolicy) (*CatalogWebhook, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid catalog webhook URL %q: %v", webhookURL, err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid catalog webhook URL %q: the scheme must be https", webhookURL)
+	}
+	switch failurePolicy {
+	case CatalogWebhookFail, CatalogWebhookIgnore:
+	default:
+		return nil, fmt.Errorf("invalid catalog webhook failure policy %q: must be %q or %q", failurePolicy, CatalogWebhookFail, CatalogWebhookIgnore)
+	}
+
+	client, err := newWebhookClient(caBundle, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the CA bundle of the catalog webhook")
+	}
+
+	return &CatalogWebhook{
+		url:           webhookURL,
+		client:        client,
+		failurePolicy: failurePolicy,
+	}, nil
+}
+
+// call sends the request to the webhook and returns the catalog in its
+// response.
+func (w *CatalogWebhook) call(request *CatalogWebhookRequest) (*osb.CatalogResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxCatalogWebhookResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s: %s", resp.Status, bytes.TrimSpace(data))
+	}
+	if len(data) > maxCatalogWebhookResponseSize {
+		return nil, fmt.Errorf("the response is larger than %d bytes", maxCatalogWebhookResponseSize)
+	}
+	response := &CatalogWebhookResponse{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the response: %v", err)
+	}
+	if response.Catalog == nil {
+		return nil, fmt.Errorf("the response has no catalog")
+	}
+	if err := validateMutatedCatalog(request.Catalog, response.Catalog); err != nil {
+		return nil, err
+	}
+	return response.Catalog, nil
+}
+
+// validateMutatedCatalog returns an error if the mutated catalog does not
+// have the same services and plans as the original catalog.
+func validateMutatedCatalog(original, mutated *osb.CatalogResponse) error {
+	originalPlans := make(map[string]sets.String)
+	for _, service := range original.Services {
+		plans := sets.NewString()
+		for _, plan := range service.Plans {
+			plans.Insert(plan.ID)
+		}
+		originalPlans[service.ID] = plans
+	}
+	mutatedServices := sets.NewString()
+	for _, service := range mutated.Services {
+		plans, ok := originalPlans[service.ID]
+		if !ok {
+			return fmt.Errorf("the returned catalog has service %q, which is not in the catalog of the broker", service.ID)
+		}
+		if mutatedServices.Has(service.ID) {
+			return fmt.Errorf("the returned catalog has service %q more than once", service.ID)
+		}
+		mutatedServices.Insert(service.ID)
+		mutatedPlans := sets.NewString()
+		for _, plan := range service.Plans {
+			mutatedPlans.Insert(plan.ID)
+		}
+		if len(service.Plans) != mutatedPlans.Len() || !plans.Equal(mutatedPlans) {
+			return fmt.Errorf("the plans of service %q in the returned catalog are not those in the catalog of the broker", service.ID)
+		}
+	}
+	if mutatedServices.Len() != len(originalPlans) {
+		return fmt.Errorf("the returned catalog has %d services instead of %d", mutatedServices.Len(), len(originalPlans))
+	}
+	return nil
+}
+
+// mutateCatalog returns the catalog to sync for the broker of the given kind
+// with the given metadata. Without a catalog webhook, it is the given
+// catalog, which is not modified in any case as it may be shared with other
+// brokers.
+func (c *controller) mutateCatalog(kind string, meta metav1.ObjectMeta, catalog *osb.CatalogResponse) (*osb.CatalogResponse, error) {
+	if c.catalogWebhook == nil {
+		return catalog, nil
+	}
+
+	mutated, err := c.catalogWebhook.call(&CatalogWebhookRequest{
+		Kind:      kind,
+		Namespace: meta.Namespace,
+		Name:      meta.Name,
+		Catalog:   catalog,
+	})
+	if err != nil {
+		if c.catalogWebhook.failurePolicy == CatalogWebhookIgnore {
+			glog.Warningf("%s %q: Ignoring failed call to the catalog webhook: %v", kind, meta.Name, err)
+			return catalog, nil
+		}
+		return nil, fmt.Errorf("failed to call the catalog webhook: %v", err)
+	}
+	return mutated, nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+)
+
+func TestNewCatalogWebhook(t *testing.T) {
+	if _, err := NewCatalogWebhook("https://webhook.example.com/catalog", nil, time.Second, CatalogWebhookFail); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := NewCatalogWebhook("http://webhook.example.com/catalog", nil, time.Second, CatalogWebhookFail); err == nil {
+		t.Error("expected an error for an http URL")
+	}
+	if _, err := NewCatalogWebhook("https://webhook.example.com/catalog", nil, time.Second, "Retry"); err == nil {
+		t.Error("expected an error for an unknown failure policy")
+	}
+}
+
+func TestMutateCatalog(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &CatalogWebhookRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch request.Name {
+		case "fail":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		case "add-plan":
+			request.Catalog.Services[0].Plans = append(request.Catalog.Services[0].Plans, osb.Plan{ID: "new-plan", Name: "new-plan"})
+		default:
+			service := &request.Catalog.Services[0]
+			service.Tags = append(service.Tags, "internal")
+			service.Metadata = map[string]interface{}{"displayName": request.Kind + " " + request.Name}
+		}
+		json.NewEncoder(w).Encode(&CatalogWebhookResponse{Catalog: request.Catalog})
+	}))
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	cases := []struct {
+		name          string
+		brokerName    string
+		failurePolicy CatalogWebhookFailurePolicy
+		mutated       bool
+		shouldSucceed bool
+	}{
+		{
+			name:          "mutated",
+			brokerName:    "broker",
+			failurePolicy: CatalogWebhookFail,
+			mutated:       true,
+			shouldSucceed: true,
+		},
+		{
+			name:          "plan added",
+			brokerName:    "add-plan",
+			failurePolicy: CatalogWebhookFail,
+		},
+		{
+			name:          "failure policy Fail",
+			brokerName:    "fail",
+			failurePolicy: CatalogWebhookFail,
+		},
+		{
+			name:          "failure policy Ignore",
+			brokerName:    "fail",
+			failurePolicy: CatalogWebhookIgnore,
+			shouldSucceed: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			webhook, err := NewCatalogWebhook(server.URL, caBundle, time.Second, tc.failurePolicy)
+			if err != nil {
+				t.Fatalf("Failed to create the catalog webhook: %v", err)
+			}
+			c := &controller{catalogWebhook: webhook}
+
+			broker := getTestClusterServiceBroker()
+			broker.Name = tc.brokerName
+			catalog := getTestCatalog()
+			actual, err := c.mutateCatalog("ClusterServiceBroker", broker.ObjectMeta, catalog)
+			if !tc.shouldSucceed {
+				if err == nil {
+					t.Fatal("Expected error, but got success")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to mutate catalog: %v", err)
+			}
+			if len(catalog.Services[0].Tags) != len(getTestCatalog().Services[0].Tags) {
+				t.Fatal("expected the original catalog not to be modified")
+			}
+			if !tc.mutated {
+				if actual != catalog {
+					t.Fatal("expected the original catalog to be returned")
+				}
+				return
+			}
+			if e, a := "ClusterServiceBroker broker", actual.Services[0].Metadata["displayName"]; e != a {
+				t.Fatalf("expected display name %q, got %v", e, a)
+			}
+		})
+	}
+}
+
+// TestValidateMutatedCatalog tests that a mutated catalog must have each
+// service of the original catalog exactly once.
+func TestValidateMutatedCatalog(t *testing.T) {
+	catalog := func(ids ...string) *osb.CatalogResponse {
+		response := &osb.CatalogResponse{}
+		for _, id := range ids {
+			response.Services = append(response.Services, osb.Service{ID: id, Name: id})
+		}
+		return response
+	}
+
+	cases := []struct {
+		name          string
+		mutated       *osb.CatalogResponse
+		shouldSucceed bool
+	}{
+		{name: "same services", mutated: catalog("b", "a"), shouldSucceed: true},
+		{name: "duplicate service", mutated: catalog("a", "a")},
+		{name: "missing service", mutated: catalog("a")},
+		{name: "unknown service", mutated: catalog("a", "c")},
+	}
+
+	for _, tc := range cases {
+		err := validateMutatedCatalog(catalog("a", "b"), tc.mutated)
+		if tc.shouldSucceed && err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+		}
+		if !tc.shouldSucceed && err == nil {
+			t.Errorf("%v: expected error, but got success", tc.name)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	clusterIDChangedReason string = "ClusterIDChanged"
+	invalidClusterIDReason string = "InvalidClusterID"
+
+	// maxClusterIDLength is the maximum length of a cluster ID.
+	maxClusterIDLength = 253
+)
+
+// clusterIDRegexp matches the valid cluster IDs: letters, digits, '-', '_'
+// and '.', starting and ending with a letter or a digit. Generated cluster
+// IDs are UUIDs, which match.
+var clusterIDRegexp = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+
+// validateClusterID returns an error if the given cluster ID cannot be sent
+// to brokers.
+func validateClusterID(id string) error {
+	if len(id) > maxClusterIDLength {
+		return fmt.Errorf("cluster ID %q must be no more than %d characters", id, maxClusterIDLength)
+	}
+	if !clusterIDRegexp.MatchString(id) {
+		return fmt.Errorf("cluster ID %q must consist of letters, digits, '-', '_' or '.', and start and end with a letter or a digit", id)
+	}
+	return nil
+}
+
+// recordClusterIDChange logs and records an event on the cluster ID configmap
+// when the cluster ID sent to brokers changes, since brokers may key billing
+// and ownership off it.
+func (c *controller) recordClusterIDChange(cm *corev1.ConfigMap, previous, id string) {
+	glog.Warningf("cluster ID changed from %q to %q", previous, id)
+	c.recorder.Eventf(cm, corev1.EventTypeNormal, clusterIDChangedReason, "Cluster ID changed from %q to %q", previous, id)
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	// maxConcurrentOperationsMetadataKey is the key of the external metadata
+	// of a class or plan that limits how many of its instances may be
+	// provisioned at the same time.
+	maxConcurrentOperationsMetadataKey = "maxConcurrentOperations"
+
+	// maxConcurrentOperationsAnnotation is an annotation with which an
+	// operator overrides the maxConcurrentOperations declared by the broker
+	// for a class or plan. "0" removes the limit.
+	maxConcurrentOperationsAnnotation = "servicecatalog.k8s.io/max-concurrent-operations"
+
+	provisionQueuedReason  string = "ConcurrentOperationsLimitReached"
+	provisionQueuedMessage string = "Waiting to provision: %d of at most %d instances of %s are being provisioned"
+
+	// provisionQueuedRetryInterval is how long a queued instance waits before
+	// it is tried again.
+	provisionQueuedRetryInterval = 10 * time.Second
+
+	// provisionReservationTTL bounds how long an admitted instance counts
+	// against the limits before its provision shows up in the informer
+	// cache.
+	provisionReservationTTL = time.Minute
+)
+
+// concurrentOperationsLimit is the limit a class or plan sets on how many of
+// its instances may be provisioned at the same time.
+type concurrentOperationsLimit struct {
+	// name is the pretty name of the class or plan.
+	name string
+	max  int
+	// matches returns whether an instance is of the class or plan.
+	matches func(instance *v1beta1.ServiceInstance) bool
+}
+
+// provisionReservations holds the instances that were admitted to be
+// provisioned but whose provision may not be in the informer cache yet, so
+// that instances reconciled at the same time do not exceed the limits.
+type provisionReservations struct {
+	lock     sync.Mutex
+	reserved map[string]time.Time
+}
+
+// getMaxConcurrentOperations returns the limit set by a class or plan with the
+// given annotations and external metadata, or zero if there is none. The
+// annotation takes precedence over the metadata.
+func getMaxConcurrentOperations(annotations map[string]string, metadata *runtime.RawExtension) int {
+	if value, ok := annotations[maxConcurrentOperationsAnnotation]; ok {
+		if max, err := strconv.Atoi(value); err == nil && max >= 0 {
+			return max
+		}
+		glog.Warningf("Ignoring invalid %s annotation %q", maxConcurrentOperationsAnnotation, value)
+	}
+	if metadata == nil {
+		return 0
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(metadata.Raw, &m); err != nil {
+		return 0
+	}
+	if max, ok := m[maxConcurrentOperationsMetadataKey].(float64); ok && max > 0 {
+		return int(max)
+	}
+	return 0
+}
+
+// getServiceInstanceConcurrentOperationsLimits returns the limits set by the
+// class and plan of the given instance, whose references must be resolved.
+func (c *controller) getServiceInstanceConcurrentOperationsLimits(instance *v1beta1.ServiceInstance) ([]concurrentOperationsLimit, error) {
+	var limits []concurrentOperationsLimit
+	if instance.Spec.ClusterServiceClassSpecified() {
+		class, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		if max := getMaxConcurrentOperations(class.Annotations, class.Spec.ExternalMetadata); max > 0 {
+			limits = append(limits, concurrentOperationsLimit{
+				name: pretty.ClusterServiceClassName(class),
+				max:  max,
+				matches: func(other *v1beta1.ServiceInstance) bool {
+					return other.Spec.ClusterServiceClassRef != nil && other.Spec.ClusterServiceClassRef.Name == class.Name
+				},
+			})
+		}
+		plan, err := c.clusterServicePlanLister.Get(instance.Spec.ClusterServicePlanRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		if max := getMaxConcurrentOperations(plan.Annotations, plan.Spec.ExternalMetadata); max > 0 {
+			limits = append(limits, concurrentOperationsLimit{
+				name: pretty.ClusterServicePlanName(plan),
+				max:  max,
+				matches: func(other *v1beta1.ServiceInstance) bool {
+					return other.Spec.ClusterServicePlanRef != nil && other.Spec.ClusterServicePlanRef.Name == plan.Name
+				},
+			})
+		}
+		return limits, nil
+	}
+
+	class, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	if max := getMaxConcurrentOperations(class.Annotations, class.Spec.ExternalMetadata); max > 0 {
+		limits = append(limits, concurrentOperationsLimit{
+			name: pretty.ServiceClassName(class),
+			max:  max,
+			matches: func(other *v1beta1.ServiceInstance) bool {
+				return other.Namespace == class.Namespace && other.Spec.ServiceClassRef != nil && other.Spec.ServiceClassRef.Name == class.Name
+			},
+		})
+	}
+	plan, err := c.servicePlanLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	if max := getMaxConcurrentOperations(plan.Annotations, plan.Spec.ExternalMetadata); max > 0 {
+		limits = append(limits, concurrentOperationsLimit{
+			name: pretty.ServicePlanName(plan),
+			max:  max,
+			matches: func(other *v1beta1.ServiceInstance) bool {
+				return other.Namespace == plan.Namespace && other.Spec.ServicePlanRef != nil && other.Spec.ServicePlanRef.Name == plan.Name
+			},
+		})
+	}
+	return limits, nil
+}
+
+// reserveServiceInstanceProvision reserves a place for the given instance to
+// be provisioned under the given limits. If there is none, it returns the
+// limit that was reached and how many instances count against it.
+func (c *controller) reserveServiceInstanceProvision(instance *v1beta1.ServiceInstance, limits []concurrentOperationsLimit) (*concurrentOperationsLimit, int, error) {
+	key, err := cache.MetaNamespaceKeyFunc(instance)
+	if err != nil {
+		return nil, 0, err
+	}
+	instances, err := c.instanceLister.List(labels.Everything())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r := &c.provisionReservations
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.reserved == nil {
+		r.reserved = make(map[string]time.Time)
+	}
+
+	now := time.Now()
+	seen := sets.NewString()
+	var provisioning []*v1beta1.ServiceInstance
+	for _, other := range instances {
+		otherKey, err := cache.MetaNamespaceKeyFunc(other)
+		if err != nil || otherKey == key {
+			continue
+		}
+		seen.Insert(otherKey)
+		if other.Status.CurrentOperation == v1beta1.ServiceInstanceOperationProvision {
+			delete(r.reserved, otherKey)
+			provisioning = append(provisioning, other)
+		} else if reservedAt, ok := r.reserved[otherKey]; ok {
+			if now.Sub(reservedAt) > provisionReservationTTL {
+				delete(r.reserved, otherKey)
+			} else {
+				provisioning = append(provisioning, other)
+			}
+		}
+	}
+	for otherKey := range r.reserved {
+		if otherKey != key && !seen.Has(otherKey) {
+			delete(r.reserved, otherKey)
+		}
+	}
+
+	for i := range limits {
+		count := 0
+		for _, other := range provisioning {
+			if limits[i].matches(other) {
+				count++
+			}
+		}
+		if count >= limits[i].max {
+			return &limits[i], count, nil
+		}
+	}
+	r.reserved[key] = now
+	return nil, 0, nil
+}
+
+// admitServiceInstanceProvision returns whether the given instance may start
+// to be provisioned under the limits set by its class and plan. If it may
+// not, the instance is marked as queued and requeued.
+func (c *controller) admitServiceInstanceProvision(instance *v1beta1.ServiceInstance) (bool, error) {
+	limits, err := c.getServiceInstanceConcurrentOperationsLimits(instance)
+	if err != nil || len(limits) == 0 {
+		return err == nil, err
+	}
+	limit, count, err := c.reserveServiceInstanceProvision(instance, limits)
+	if err != nil {
+		return false, err
+	}
+	if limit == nil {
+		return true, nil
+	}
+	return false, c.processServiceInstanceProvisionQueued(instance, limit, count)
+}
+
+// processServiceInstanceProvisionQueued sets the Queued condition on the given
+// instance, whose provision is waiting for other instances of its class or
+// plan, and requeues it.
+func (c *controller) processServiceInstanceProvisionQueued(instance *v1beta1.ServiceInstance, limit *concurrentOperationsLimit, count int) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	s := fmt.Sprintf(provisionQueuedMessage, count, limit.max, limit.name)
+	glog.V(4).Info(pcb.Message(s))
+
+	c.instanceAddAfter(instance, provisionQueuedRetryInterval)
+
+	queued := false
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionQueued && cond.Status == v1beta1.ConditionTrue {
+			if cond.Message == s {
+				return nil
+			}
+			queued = true
+		}
+	}
+
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionQueued, v1beta1.ConditionTrue, provisionQueuedReason, s)
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return err
+	}
+	if !queued {
+		c.recorder.Event(instance, corev1.EventTypeNormal, provisionQueuedReason, s)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+func TestGetMaxConcurrentOperations(t *testing.T) {
+	cases := []struct {
+		name        string
+		annotations map[string]string
+		metadata    string
+		expected    int
+	}{
+		{
+			name:     "no limit",
+			expected: 0,
+		},
+		{
+			name:     "metadata",
+			metadata: `{"maxConcurrentOperations": 3}`,
+			expected: 3,
+		},
+		{
+			name:        "annotation overrides metadata",
+			annotations: map[string]string{maxConcurrentOperationsAnnotation: "5"},
+			metadata:    `{"maxConcurrentOperations": 3}`,
+			expected:    5,
+		},
+		{
+			name:        "annotation removes limit",
+			annotations: map[string]string{maxConcurrentOperationsAnnotation: "0"},
+			metadata:    `{"maxConcurrentOperations": 3}`,
+			expected:    0,
+		},
+		{
+			name:        "invalid annotation",
+			annotations: map[string]string{maxConcurrentOperationsAnnotation: "many"},
+			metadata:    `{"maxConcurrentOperations": 3}`,
+			expected:    3,
+		},
+	}
+
+	for _, tc := range cases {
+		var metadata *runtime.RawExtension
+		if tc.metadata != "" {
+			metadata = &runtime.RawExtension{Raw: []byte(tc.metadata)}
+		}
+		if e, a := tc.expected, getMaxConcurrentOperations(tc.annotations, metadata); e != a {
+			t.Errorf("%v: expected %v, got %v", tc.name, e, a)
+		}
+	}
+}
+
+// TestReconcileServiceInstanceProvisionQueued tests that an instance is not
+// provisioned while its plan is at its concurrent operations limit.
+func TestReconcileServiceInstanceProvisionQueued(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	plan := getTestClusterServicePlan()
+	plan.Spec.ExternalMetadata = &runtime.RawExtension{Raw: []byte(`{"maxConcurrentOperations": 1}`)}
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(plan)
+
+	other := getTestServiceInstanceWithClusterRefs()
+	other.Name = "other-instance"
+	other.Status.CurrentOperation = v1beta1.ServiceInstanceOperationProvision
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(other)
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	expectedMessage := fmt.Sprintf(provisionQueuedMessage, 1, 1, pretty.ClusterServicePlanName(plan))
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionQueued, v1beta1.ConditionTrue, provisionQueuedReason)
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(provisionQueuedReason).msg(expectedMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Once the other instance is provisioned, the instance is admitted and
+	// is no longer queued.
+	other = other.DeepCopy()
+	other.Status.CurrentOperation = ""
+	sharedInformers.ServiceInstances().Informer().GetStore().Update(other)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, updatedServiceInstance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	assertServiceInstanceCurrentOperation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision)
+	for _, cond := range updatedServiceInstance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionQueued {
+			t.Fatalf("expected the Queued condition to be removed, got %v", cond)
+		}
+	}
+}
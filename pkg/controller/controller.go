@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,8 +29,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -46,6 +49,7 @@ import (
 	listers "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/kubernetes-incubator/service-catalog/pkg/filter"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 )
 
@@ -58,6 +62,9 @@ const (
 	maxRetries = 15
 	// pollingStartInterval is the initial interval to use when polling async OSB operations.
 	pollingStartInterval = 1 * time.Second
+	// pollResultHeartbeatPeriod is how often the last poll time is written to
+	// a resource's status while the result of polling stays the same.
+	pollResultHeartbeatPeriod = 1 * time.Minute
 
 	// ContextProfilePlatformKubernetes is the platform name sent in the OSB
 	// ContextProfile for requests coming from Kubernetes.
@@ -68,6 +75,68 @@ const (
 	DefaultClusterIDConfigMapNamespace string = "default"
 )
 
+// Options holds the settings of the controller that are optional. The zero
+// value disables or leaves out each of them.
+type Options struct {
+	// StuckDeletionThreshold is how long an instance or binding may be
+	// terminating before it is flagged as stuck.
+	StuckDeletionThreshold time.Duration
+	// NamespaceDeletionMaxFailedAttempts is the number of failed deprovision
+	// or unbind attempts after which instances and bindings in a namespace
+	// that is being deleted are abandoned at the broker.
+	NamespaceDeletionMaxFailedAttempts int64
+	// NamespaceDeletionBrokerUnreachableTimeout is how long the broker may
+	// be unreachable before instances and bindings in a namespace that is
+	// being deleted are abandoned at the broker.
+	NamespaceDeletionBrokerUnreachableTimeout time.Duration
+	// InstanceTombstoneTTL is how long the tombstone of a deprovisioned
+	// instance is kept.
+	InstanceTombstoneTTL time.Duration
+	// DeprovisionGracePeriod is how long the deprovision of a deleted
+	// instance is held back, so that the deletion can be cancelled.
+	DeprovisionGracePeriod time.Duration
+	// SharedCatalogTTL is how long the catalog fetched for a namespaced
+	// broker is reused by the namespaced brokers with the same URL and
+	// credentials.
+	SharedCatalogTTL time.Duration
+	// StorageMigration is whether the stored catalog objects are rewritten
+	// in the current storage version after an upgrade.
+	StorageMigration bool
+	// ParametersWebhook is called with the parameters of each provision and
+	// update request before it is sent to the broker.
+	ParametersWebhook *ParametersWebhook
+	// CatalogWebhook is called with the catalog of each broker before it is
+	// synced, and returns the catalog to sync instead.
+	CatalogWebhook *CatalogWebhook
+	// MaxConcurrentDeprovisionsPerBroker is the number of instances that may
+	// be deprovisioned at the same time at each broker.
+	MaxConcurrentDeprovisionsPerBroker int
+	// DeprovisionBatchInterval is how long an instance waits before trying
+	// again to be deprovisioned when its broker is at the deprovision limit.
+	DeprovisionBatchInterval time.Duration
+	// FailureWebhooks is whether the failure webhooks of namespaces are
+	// called.
+	FailureWebhooks bool
+	// SecretPropagatedLabels are the keys of the labels of instances and
+	// bindings that are copied onto the secrets of bindings.
+	SecretPropagatedLabels []string
+	// ClusterID, if set, replaces the cluster ID of the clusterid configmap.
+	ClusterID string
+	// ReadOnly stops the controller from provisioning, updating and
+	// deprovisioning instances and from binding and unbinding bindings.
+	ReadOnly bool
+	// NamespaceProvisionRateLimit is the number of instances that may start
+	// to be provisioned in each namespace within NamespaceProvisionRateWindow.
+	NamespaceProvisionRateLimit int
+	// NamespaceProvisionRateWindow is the time window of
+	// NamespaceProvisionRateLimit.
+	NamespaceProvisionRateWindow time.Duration
+	// SecretRotationPolicy specifies how new credentials of a binding are
+	// handled when running pods consume the secret they would replace.
+	// SecretRotationProceed is used if it is empty.
+	SecretRotationPolicy SecretRotationPolicy
+}
+
 // NewController returns a new Open Service Broker catalog controller.
 func NewController(
 	kubeClient kubernetes.Interface,
@@ -80,6 +149,9 @@ func NewController(
 	bindingInformer informers.ServiceBindingInformer,
 	clusterServicePlanInformer informers.ClusterServicePlanInformer,
 	servicePlanInformer informers.ServicePlanInformer,
+	brokerTemplateInformer informers.BrokerTemplateInformer,
+	catalogProjectionInformer informers.CatalogProjectionInformer,
+	serviceBrokerSummaryInformer informers.ServiceBrokerSummaryInformer,
 	brokerClientCreateFunc osb.CreateFunc,
 	brokerRelistInterval time.Duration,
 	osbAPIPreferredVersion string,
@@ -88,7 +160,19 @@ func NewController(
 	operationPollingMaximumBackoffDuration time.Duration,
 	clusterIDConfigMapName string,
 	clusterIDConfigMapNamespace string,
+	options Options,
 ) (Controller, error) {
+	if options.ClusterID != "" {
+		if err := validateClusterID(options.ClusterID); err != nil {
+			return nil, err
+		}
+	}
+	if options.SecretRotationPolicy == "" {
+		options.SecretRotationPolicy = SecretRotationProceed
+	}
+	if err := validateSecretRotationPolicy(options.SecretRotationPolicy); err != nil {
+		return nil, err
+	}
 	controller := &controller{
 		kubeClient:                  kubeClient,
 		serviceCatalogClient:        serviceCatalogClient,
@@ -103,12 +187,36 @@ func NewController(
 		serviceClassQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-class"),
 		clusterServicePlanQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-service-plan"),
 		servicePlanQueue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-plan"),
+		brokerTemplateQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "broker-template"),
+		catalogProjectionQueue:      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "catalog-projection"),
+		serviceBrokerSummaryQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-broker-summary"),
 		instanceQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-instance"),
 		bindingQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-binding"),
 		instancePollingQueue:        workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "instance-poller"),
 		bindingPollingQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "binding-poller"),
 		clusterIDConfigMapName:      clusterIDConfigMapName,
 		clusterIDConfigMapNamespace: clusterIDConfigMapNamespace,
+		clusterID:                   options.ClusterID,
+		configuredClusterID:         options.ClusterID,
+		stuckDeletionThreshold:      options.StuckDeletionThreshold,
+		instanceTombstoneTTL:        options.InstanceTombstoneTTL,
+		deprovisionGracePeriod:      options.DeprovisionGracePeriod,
+		sharedCatalogTTL:            options.SharedCatalogTTL,
+		storageMigration:            options.StorageMigration,
+		parametersWebhook:           options.ParametersWebhook,
+		catalogWebhook:              options.CatalogWebhook,
+		deprovisionBatcher:          newDeprovisionBatcher(options.MaxConcurrentDeprovisionsPerBroker, options.DeprovisionBatchInterval),
+		secretPropagatedLabels:      options.SecretPropagatedLabels,
+		readOnly:                    options.ReadOnly,
+		provisionRateLimiter:        newNamespaceProvisionRateLimiter(options.NamespaceProvisionRateLimit, options.NamespaceProvisionRateWindow),
+		secretRotationPolicy:        options.SecretRotationPolicy,
+		namespaceDeletionPolicy: namespaceDeletionPolicy{
+			maxFailedAttempts:        options.NamespaceDeletionMaxFailedAttempts,
+			brokerUnreachableTimeout: options.NamespaceDeletionBrokerUnreachableTimeout,
+		},
+	}
+	if options.FailureWebhooks {
+		controller.failureWebhookClient = newFailureWebhookClient()
 	}
 
 	controller.clusterServiceBrokerLister = clusterServiceBrokerInformer.Lister()
@@ -133,6 +241,10 @@ func NewController(
 	})
 
 	controller.instanceLister = instanceInformer.Lister()
+	if err := instanceInformer.Informer().AddIndexers(instanceIndexers); err != nil {
+		return nil, err
+	}
+	controller.instanceIndexer = instanceInformer.Informer().GetIndexer()
 	instanceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    controller.instanceAdd,
 		UpdateFunc: controller.instanceUpdate,
@@ -140,6 +252,10 @@ func NewController(
 	})
 
 	controller.bindingLister = bindingInformer.Lister()
+	if err := bindingInformer.Informer().AddIndexers(bindingIndexers); err != nil {
+		return nil, err
+	}
+	controller.bindingIndexer = bindingInformer.Informer().GetIndexer()
 	bindingInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    controller.bindingAdd,
 		UpdateFunc: controller.bindingUpdate,
@@ -165,6 +281,30 @@ func NewController(
 			UpdateFunc: controller.servicePlanUpdate,
 			DeleteFunc: controller.servicePlanDelete,
 		})
+		// The informers of resources that an older API server does not
+		// serve are nil, and the controller runs without them.
+		if brokerTemplateInformer != nil {
+			controller.brokerTemplateLister = brokerTemplateInformer.Lister()
+			brokerTemplateInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc:    controller.brokerTemplateAdd,
+				UpdateFunc: controller.brokerTemplateUpdate,
+				DeleteFunc: controller.brokerTemplateDelete,
+			})
+		}
+		if catalogProjectionInformer != nil {
+			controller.catalogProjectionLister = catalogProjectionInformer.Lister()
+			catalogProjectionInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc:    controller.catalogProjectionAdd,
+				UpdateFunc: controller.catalogProjectionUpdate,
+				DeleteFunc: controller.catalogProjectionDelete,
+			})
+		}
+		if serviceBrokerSummaryInformer != nil {
+			controller.serviceBrokerSummaryLister = serviceBrokerSummaryInformer.Lister()
+			serviceBrokerSummaryInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+				DeleteFunc: controller.serviceBrokerSummaryDelete,
+			})
+		}
 	}
 	controller.instanceOperationRetryQueue.instances = make(map[string]backoffEntry)
 	controller.instanceOperationRetryQueue.rateLimiter = workqueue.NewItemExponentialFailureRateLimiter(minBrokerOperationRetryDelay, maxBrokerOperationRetryDelay)
@@ -190,9 +330,14 @@ type controller struct {
 	clusterServiceClassLister   listers.ClusterServiceClassLister
 	serviceClassLister          listers.ServiceClassLister
 	instanceLister              listers.ServiceInstanceLister
+	instanceIndexer             cache.Indexer
 	bindingLister               listers.ServiceBindingLister
+	bindingIndexer              cache.Indexer
 	clusterServicePlanLister    listers.ClusterServicePlanLister
 	servicePlanLister           listers.ServicePlanLister
+	brokerTemplateLister        listers.BrokerTemplateLister
+	catalogProjectionLister     listers.CatalogProjectionLister
+	serviceBrokerSummaryLister  listers.ServiceBrokerSummaryLister
 	brokerRelistInterval        time.Duration
 	OSBAPIPreferredVersion      string
 	recorder                    record.EventRecorder
@@ -203,6 +348,9 @@ type controller struct {
 	serviceClassQueue           workqueue.RateLimitingInterface
 	clusterServicePlanQueue     workqueue.RateLimitingInterface
 	servicePlanQueue            workqueue.RateLimitingInterface
+	brokerTemplateQueue         workqueue.RateLimitingInterface
+	catalogProjectionQueue      workqueue.RateLimitingInterface
+	serviceBrokerSummaryQueue   workqueue.RateLimitingInterface
 	instanceQueue               workqueue.RateLimitingInterface
 	bindingQueue                workqueue.RateLimitingInterface
 	instancePollingQueue        workqueue.RateLimitingInterface
@@ -221,8 +369,66 @@ type controller struct {
 	// clusterIDLock protects access to clusterID between the
 	// monitor writing the value from the configmap, and any
 	// readers passing the clusterID to a broker.
-	clusterIDLock               sync.RWMutex
+	clusterIDLock sync.RWMutex
+	// configuredClusterID is the cluster ID the controller was started
+	// with. If set, it is written to the configmap instead of being read
+	// from it, which rotates the cluster ID.
+	configuredClusterID         string
 	instanceOperationRetryQueue instanceOperationBackoff
+	// stuckDeletionThreshold is how long an instance or binding may be
+	// terminating before it is flagged as stuck. Zero disables the check.
+	stuckDeletionThreshold time.Duration
+	// namespaceDeletionPolicy configures when instances and bindings in a
+	// namespace that is being deleted are abandoned at the broker.
+	namespaceDeletionPolicy namespaceDeletionPolicy
+	// instanceTombstoneTTL is how long the tombstone of a deprovisioned
+	// instance is kept. Zero disables tombstones.
+	instanceTombstoneTTL time.Duration
+	// deprovisionGracePeriod is how long the deprovision of a deleted
+	// instance is held back, so that the deletion can be cancelled.
+	deprovisionGracePeriod time.Duration
+	// sharedCatalogTTL is how long the catalog fetched for a namespaced
+	// broker is reused by the namespaced brokers with the same URL and
+	// credentials. Zero disables sharing.
+	sharedCatalogTTL time.Duration
+	sharedCatalogs   sharedCatalogCache
+	// storageMigration is whether the stored catalog objects are rewritten
+	// in the current storage version after an upgrade.
+	storageMigration bool
+	// parametersWebhook, if set, is called with the parameters of each
+	// provision and update request before it is sent to the broker.
+	parametersWebhook *ParametersWebhook
+	// catalogWebhook, if set, is called with the catalog of each broker
+	// before it is synced, and returns the catalog to sync instead.
+	catalogWebhook *CatalogWebhook
+	// deprovisionBatcher limits how many instances are deprovisioned at the
+	// same time at each broker.
+	deprovisionBatcher *deprovisionBatcher
+	// provisionReservations holds the instances admitted under the limits
+	// that classes and plans set on concurrent provisions.
+	provisionReservations provisionReservations
+	// provisionRateLimiter limits how many instances may start to be
+	// provisioned in each namespace within a time window.
+	provisionRateLimiter *namespaceProvisionRateLimiter
+	// brokerEndpointHealth holds the broker endpoints that recently failed
+	// and are skipped by the clients of brokers with failover URLs.
+	brokerEndpointHealth brokerEndpointHealth
+	// mirroredRequests holds the last request of each instance sent to the
+	// mirror of its broker.
+	mirroredRequests mirroredRequests
+	// failureWebhookClient, if set, calls the failure webhooks of
+	// namespaces. Failure webhooks are disabled if it is nil.
+	failureWebhookClient *http.Client
+	// secretPropagatedLabels are the keys of the labels of instances and
+	// bindings that are copied onto the secrets of bindings.
+	secretPropagatedLabels []string
+	// secretRotationPolicy specifies how new credentials of a binding are
+	// handled when running pods consume the secret they would replace.
+	secretRotationPolicy SecretRotationPolicy
+	// readOnly, if true, stops the controller from provisioning, updating
+	// and deprovisioning instances and from binding and unbinding bindings.
+	// Operations in progress are still polled.
+	readOnly bool
 }
 
 // Run runs the controller until the given stop channel can be read from.
@@ -245,6 +451,15 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 			createWorker(c.serviceBrokerQueue, "ServiceBroker", maxRetries, true, c.reconcileServiceBrokerKey, stopCh, &waitGroup)
 			createWorker(c.serviceClassQueue, "ServiceClass", maxRetries, true, c.reconcileServiceClassKey, stopCh, &waitGroup)
 			createWorker(c.servicePlanQueue, "ServicePlan", maxRetries, true, c.reconcileServicePlanKey, stopCh, &waitGroup)
+			if c.brokerTemplateLister != nil {
+				createWorker(c.brokerTemplateQueue, "BrokerTemplate", maxRetries, true, c.reconcileBrokerTemplateKey, stopCh, &waitGroup)
+			}
+			if c.catalogProjectionLister != nil {
+				createWorker(c.catalogProjectionQueue, "CatalogProjection", maxRetries, true, c.reconcileCatalogProjectionKey, stopCh, &waitGroup)
+			}
+			if c.serviceBrokerSummaryLister != nil {
+				createWorker(c.serviceBrokerSummaryQueue, "ServiceBrokerSummary", maxRetries, true, c.reconcileServiceBrokerSummaryKey, stopCh, &waitGroup)
+			}
 		}
 
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
@@ -262,6 +477,14 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 	// instance operation retry entries
 	c.createPurgeExpiredRetryEntriesWorker(stopCh, &waitGroup)
 
+	// create a task that runs periodically to delete expired
+	// tombstones of deprovisioned instances
+	c.createPurgeExpiredTombstonesWorker(stopCh, &waitGroup)
+
+	// create a task that rewrites the stored objects once after
+	// an upgrade
+	c.createStorageMigrationWorker(stopCh, &waitGroup)
+
 	<-stopCh
 	glog.Info("Shutting down service-catalog controller")
 
@@ -277,6 +500,9 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 		c.serviceBrokerQueue.ShutDown()
 		c.serviceClassQueue.ShutDown()
 		c.servicePlanQueue.ShutDown()
+		c.brokerTemplateQueue.ShutDown()
+		c.catalogProjectionQueue.ShutDown()
+		c.serviceBrokerSummaryQueue.ShutDown()
 	}
 
 	waitGroup.Wait()
@@ -340,9 +566,23 @@ func (c *controller) monitorConfigMap() {
 	} else if err == nil {
 		// cluster id exists and is set
 		// get id out of cm
-		if id := cm.Data["id"]; "" != id {
-			c.setClusterID(id)
-		} else {
+		id := cm.Data["id"]
+		switch {
+		case c.configuredClusterID != "" && id != c.configuredClusterID:
+			// the controller was started with a cluster id, which
+			// replaces the one of the configmap
+			if cm.Data == nil {
+				cm.Data = make(map[string]string)
+			}
+			cm.Data["id"] = c.configuredClusterID
+			if _, err := c.kubeClient.CoreV1().ConfigMaps(c.clusterIDConfigMapNamespace).Update(cm); err != nil {
+				glog.Warningf("due to error %q, could not set clusterid configmap to the configured cluster ID %q", err, c.configuredClusterID)
+				break
+			}
+			if id != "" {
+				c.recordClusterIDChange(cm, id, c.configuredClusterID)
+			}
+		case id == "":
 			m := cm.Data
 			if m == nil {
 				m = make(map[string]string)
@@ -350,6 +590,20 @@ func (c *controller) monitorConfigMap() {
 			}
 			m["id"] = c.getClusterID()
 			c.kubeClient.CoreV1().ConfigMaps(c.clusterIDConfigMapNamespace).Update(cm)
+		case validateClusterID(id) != nil:
+			// keep sending the current id rather than one brokers may
+			// not accept
+			err := validateClusterID(id)
+			glog.Warningf("ignoring the clusterid configmap: %v", err)
+			c.recorder.Eventf(cm, corev1.EventTypeWarning, invalidClusterIDReason, "Ignoring the cluster ID: %v", err)
+		default:
+			c.clusterIDLock.Lock()
+			previous := c.clusterID
+			c.clusterID = id
+			c.clusterIDLock.Unlock()
+			if previous != "" && previous != id {
+				c.recordClusterIDChange(cm, previous, id)
+			}
 		}
 	} else { // some err we can't handle
 		glog.V(4).Infof("error getting the cluster info configmap: %q", err)
@@ -374,6 +628,12 @@ func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetri
 				defer queue.Done(key)
 
 				err := reconciler(key.(string))
+				if isAdmissionWebhookUnavailable(err) {
+					glog.Warningf("Error syncing %s %v: an admission webhook of the API server is unavailable: %v", resourceType, key, err)
+					metrics.AdmissionWebhookUnavailable.WithLabelValues(resourceType).Set(1)
+				} else {
+					metrics.AdmissionWebhookUnavailable.WithLabelValues(resourceType).Set(0)
+				}
 				if err == nil {
 					if forgetAfterSuccess {
 						queue.Forget(key)
@@ -396,6 +656,15 @@ func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetri
 	}
 }
 
+// isAdmissionWebhookUnavailable returns whether the given error comes from a
+// request to the API server that failed because one of its admission webhooks
+// could not be called, as opposed to a webhook denying the request. The API
+// server returns such failures as internal errors, so they are recognized by
+// their message, which is kept by the errors wrapping them.
+func isAdmissionWebhookUnavailable(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "failed calling admission webhook")
+}
+
 // operationError is a user-facing error that can be easily embedded in a
 // resource's Condition.
 type operationError struct {
@@ -461,7 +730,6 @@ func (c *controller) getServiceClassPlanAndServiceBroker(instance *v1beta1.Servi
 // places so this method fetches the Service Class and creates
 // a brokerClient to use for that method given an ServiceInstance.
 func (c *controller) getClusterServiceClassAndClusterServiceBroker(instance *v1beta1.ServiceInstance) (*v1beta1.ClusterServiceClass, string, osb.Client, error) {
-	pcb := pretty.NewInstanceContextBuilder(instance)
 	serviceClass, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
 	if err != nil {
 		return nil, "", nil, &operationError{
@@ -473,21 +741,34 @@ func (c *controller) getClusterServiceClassAndClusterServiceBroker(instance *v1b
 		}
 	}
 
-	broker, err := c.clusterServiceBrokerLister.Get(serviceClass.Spec.ClusterServiceBrokerName)
+	brokerClient, err := c.getClusterServiceBrokerClientForServiceInstance(instance, serviceClass.Spec.ClusterServiceBrokerName)
 	if err != nil {
-		return nil, "", nil, &operationError{
+		return nil, "", nil, err
+	}
+
+	return serviceClass, serviceClass.Spec.ClusterServiceBrokerName, brokerClient, nil
+}
+
+// getClusterServiceBrokerClientForServiceInstance creates a brokerClient for
+// the ClusterServiceBroker with the given name, which offers the class of the
+// given ServiceInstance.
+func (c *controller) getClusterServiceBrokerClientForServiceInstance(instance *v1beta1.ServiceInstance, brokerName string) (osb.Client, error) {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	broker, err := c.clusterServiceBrokerLister.Get(brokerName)
+	if err != nil {
+		return nil, &operationError{
 			reason: errorNonexistentClusterServiceBrokerReason,
 			message: fmt.Sprintf(
 				"The instance references a non-existent broker %q",
-				serviceClass.Spec.ClusterServiceBrokerName,
+				brokerName,
 			),
 		}
 
 	}
 
-	authConfig, err := getAuthCredentialsFromClusterServiceBroker(c.kubeClient, broker)
+	clientConfig, err := getClientConfigurationForClusterServiceBroker(c.kubeClient, broker)
 	if err != nil {
-		return nil, "", nil, &operationError{
+		return nil, &operationError{
 			reason: errorAuthCredentialsReason,
 			message: fmt.Sprintf(
 				"Error getting broker auth credentials for broker %q: %s",
@@ -496,14 +777,79 @@ func (c *controller) getClusterServiceClassAndClusterServiceBroker(instance *v1b
 		}
 	}
 
-	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
 	glog.V(4).Info(pcb.Messagef("Creating client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL))
-	brokerClient, err := c.brokerClientCreateFunc(clientConfig)
-	if err != nil {
-		return nil, "", nil, err
+	return c.newBrokerClient(clientConfig, broker.Spec.FailoverURLs)
+}
+
+// getBrokerNameForServiceInstance returns the name of the broker offering the
+// class referenced by the given ServiceInstance, or an empty string if the
+// class cannot be resolved.
+func (c *controller) getBrokerNameForServiceInstance(instance *v1beta1.ServiceInstance) string {
+	if instance.Spec.ClusterServiceClassRef != nil {
+		serviceClass, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return ""
+		}
+		return serviceClass.Spec.ClusterServiceBrokerName
+	}
+	if instance.Spec.ServiceClassRef != nil && c.serviceClassLister != nil {
+		serviceClass, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return ""
+		}
+		return serviceClass.Spec.ServiceBrokerName
 	}
+	return ""
+}
 
-	return serviceClass, broker.Name, brokerClient, nil
+// getClassExternalNameForServiceInstance returns the external name of the
+// class of the given ServiceInstance, or an empty string if the class cannot
+// be found.
+func (c *controller) getClassExternalNameForServiceInstance(instance *v1beta1.ServiceInstance) string {
+	if instance.Spec.ClusterServiceClassRef != nil {
+		serviceClass, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return ""
+		}
+		return serviceClass.Spec.ExternalName
+	}
+	if instance.Spec.ServiceClassRef != nil && c.serviceClassLister != nil {
+		serviceClass, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return ""
+		}
+		return serviceClass.Spec.ExternalName
+	}
+	return ""
+}
+
+// getServiceInstancePlanExternalName returns the external name of the plan
+// that the broker knows the given ServiceInstance to be on.
+func getServiceInstancePlanExternalName(instance *v1beta1.ServiceInstance) string {
+	props := instance.Status.ExternalProperties
+	if props == nil {
+		return ""
+	}
+	if props.ClusterServicePlanExternalName != "" {
+		return props.ClusterServicePlanExternalName
+	}
+	return props.ServicePlanExternalName
+}
+
+// recordPollResult records the result of polling the broker into the given
+// last poll time and result fields. It returns whether the result differs
+// from the previously recorded one, and whether the status needs to be
+// written back, which is the case when the result changed or when the
+// heartbeat period has elapsed since the last recorded poll.
+func recordPollResult(lastPollTime **metav1.Time, lastPollResult *string, result string) (changed bool, updateNeeded bool) {
+	now := metav1.Now()
+	changed = *lastPollResult != result
+	updateNeeded = changed || *lastPollTime == nil || now.Sub((*lastPollTime).Time) >= pollResultHeartbeatPeriod
+	if updateNeeded {
+		*lastPollTime = &now
+	}
+	*lastPollResult = result
+	return changed, updateNeeded
 }
 
 // getServiceClassAndServiceBroker is a sequence of operations that's done in couple of
@@ -522,7 +868,17 @@ func (c *controller) getServiceClassAndServiceBroker(instance *v1beta1.ServiceIn
 		}
 	}
 
-	broker, err := c.serviceBrokerLister.ServiceBrokers(instance.Namespace).Get(serviceClass.Spec.ServiceBrokerName)
+	// A class projected from a ClusterServiceClass is offered by the
+	// ClusterServiceBroker of the latter.
+	if serviceClass.Spec.ClusterServiceBroker {
+		brokerClient, err := c.getClusterServiceBrokerClientForServiceInstance(instance, serviceClass.Spec.ServiceBrokerName)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		return serviceClass, serviceClass.Spec.ServiceBrokerName, brokerClient, nil
+	}
+
+	broker, err := c.getServiceBrokerForServiceClass(serviceClass)
 	if err != nil {
 		return nil, "", nil, &operationError{
 			reason: errorNonexistentServiceBrokerReason,
@@ -534,7 +890,7 @@ func (c *controller) getServiceClassAndServiceBroker(instance *v1beta1.ServiceIn
 
 	}
 
-	authConfig, err := getAuthCredentialsFromServiceBroker(c.kubeClient, broker)
+	clientConfig, err := getClientConfigurationForServiceBroker(c.kubeClient, broker)
 	if err != nil {
 		return nil, "", nil, &operationError{
 			reason: errorAuthCredentialsReason,
@@ -545,9 +901,8 @@ func (c *controller) getServiceClassAndServiceBroker(instance *v1beta1.ServiceIn
 		}
 	}
 
-	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
 	glog.V(4).Info(pcb.Messagef("Creating client for ServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL))
-	brokerClient, err := c.brokerClientCreateFunc(clientConfig)
+	brokerClient, err := c.newBrokerClient(clientConfig, broker.Spec.FailoverURLs)
 	if err != nil {
 		return nil, "", nil, err
 	}
@@ -578,7 +933,7 @@ func (c *controller) getClusterServiceClassAndClusterServiceBrokerForServiceBind
 		return nil, "", nil, err
 	}
 
-	serviceBroker, err := c.getClusterServiceBrokerForServiceBinding(instance, binding, serviceClass)
+	serviceBroker, err := c.getClusterServiceBrokerForServiceBinding(instance, binding, serviceClass.Spec.ClusterServiceBrokerName)
 	if err != nil {
 		return nil, "", nil, err
 	}
@@ -635,12 +990,12 @@ func (c *controller) getClusterServicePlanForServiceBinding(instance *v1beta1.Se
 	return servicePlan, nil
 }
 
-func (c *controller) getClusterServiceBrokerForServiceBinding(instance *v1beta1.ServiceInstance, binding *v1beta1.ServiceBinding, serviceClass *v1beta1.ClusterServiceClass) (*v1beta1.ClusterServiceBroker, error) {
+func (c *controller) getClusterServiceBrokerForServiceBinding(instance *v1beta1.ServiceInstance, binding *v1beta1.ServiceBinding, brokerName string) (*v1beta1.ClusterServiceBroker, error) {
 	pcb := pretty.NewInstanceContextBuilder(instance)
 
-	broker, err := c.clusterServiceBrokerLister.Get(serviceClass.Spec.ClusterServiceBrokerName)
+	broker, err := c.clusterServiceBrokerLister.Get(brokerName)
 	if err != nil {
-		s := fmt.Sprintf("References a non-existent ClusterServiceBroker %q", serviceClass.Spec.ClusterServiceBrokerName)
+		s := fmt.Sprintf("References a non-existent ClusterServiceBroker %q", brokerName)
 		glog.Warning(pcb.Message(s))
 		c.updateServiceBindingCondition(
 			binding,
@@ -666,34 +1021,7 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 			return nil, err
 		}
 
-		broker, err := c.getClusterServiceBrokerForServiceBinding(instance, binding, serviceClass)
-		if err != nil {
-			return nil, err
-		}
-
-		pcb := pretty.NewInstanceContextBuilder(instance)
-		authConfig, err := getAuthCredentialsFromClusterServiceBroker(c.kubeClient, broker)
-		if err != nil {
-			s := fmt.Sprintf("Error getting broker auth credentials for broker %q: %s", broker.Name, err)
-			glog.Warning(pcb.Message(s))
-			c.updateServiceBindingCondition(
-				binding,
-				v1beta1.ServiceBindingConditionReady,
-				v1beta1.ConditionFalse,
-				errorAuthCredentialsReason,
-				"Error getting auth credentials. "+s,
-			)
-			c.recorder.Event(binding, corev1.EventTypeWarning, errorAuthCredentialsReason, s)
-			return nil, err
-		}
-
-		clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-
-		glog.V(4).Infof("Creating client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL)
-		brokerClient, err = c.brokerClientCreateFunc(clientConfig)
-		if err != nil {
-			return nil, err
-		}
+		return c.getClusterServiceBrokerClientForServiceBinding(instance, binding, serviceClass.Spec.ClusterServiceBrokerName)
 
 	} else if instance.Spec.ServiceClassSpecified() {
 
@@ -702,13 +1030,19 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 			return nil, err
 		}
 
+		// A class projected from a ClusterServiceClass is offered by the
+		// ClusterServiceBroker of the latter.
+		if serviceClass.Spec.ClusterServiceBroker {
+			return c.getClusterServiceBrokerClientForServiceBinding(instance, binding, serviceClass.Spec.ServiceBrokerName)
+		}
+
 		broker, err := c.getServiceBrokerForServiceBinding(instance, binding, serviceClass)
 		if err != nil {
 			return nil, err
 		}
 
 		pcb := pretty.NewInstanceContextBuilder(instance)
-		authConfig, err := getAuthCredentialsFromServiceBroker(c.kubeClient, broker)
+		clientConfig, err := getClientConfigurationForServiceBroker(c.kubeClient, broker)
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker auth credentials for broker %q: %s", broker.Name, err)
 			glog.Warning(pcb.Message(s))
@@ -723,10 +1057,8 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 			return nil, err
 		}
 
-		clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-
 		glog.V(4).Infof("Creating client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL)
-		brokerClient, err = c.brokerClientCreateFunc(clientConfig)
+		brokerClient, err = c.newBrokerClient(clientConfig, broker.Spec.FailoverURLs)
 		if err != nil {
 			return nil, err
 		}
@@ -735,6 +1067,35 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 	return brokerClient, nil
 }
 
+// getClusterServiceBrokerClientForServiceBinding creates a brokerClient for
+// the ClusterServiceBroker with the given name, which offers the class of the
+// instance of the given ServiceBinding.
+func (c *controller) getClusterServiceBrokerClientForServiceBinding(instance *v1beta1.ServiceInstance, binding *v1beta1.ServiceBinding, brokerName string) (osb.Client, error) {
+	broker, err := c.getClusterServiceBrokerForServiceBinding(instance, binding, brokerName)
+	if err != nil {
+		return nil, err
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	clientConfig, err := getClientConfigurationForClusterServiceBroker(c.kubeClient, broker)
+	if err != nil {
+		s := fmt.Sprintf("Error getting broker auth credentials for broker %q: %s", broker.Name, err)
+		glog.Warning(pcb.Message(s))
+		c.updateServiceBindingCondition(
+			binding,
+			v1beta1.ServiceBindingConditionReady,
+			v1beta1.ConditionFalse,
+			errorAuthCredentialsReason,
+			"Error getting auth credentials. "+s,
+		)
+		c.recorder.Event(binding, corev1.EventTypeWarning, errorAuthCredentialsReason, s)
+		return nil, err
+	}
+
+	glog.V(4).Infof("Creating client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL)
+	return c.newBrokerClient(clientConfig, broker.Spec.FailoverURLs)
+}
+
 // Broker utility methods - move?
 // getAuthCredentialsFromClusterServiceBroker returns the auth credentials, if any, or
 // returns an error. If the AuthInfo field is nil, empty values are
@@ -785,7 +1146,8 @@ func getAuthCredentialsFromServiceBroker(client kubernetes.Interface, broker *v1
 	authInfo := broker.Spec.AuthInfo
 	if authInfo.Basic != nil {
 		secretRef := authInfo.Basic.SecretRef
-		secret, err := client.CoreV1().Secrets(broker.Namespace).Get(secretRef.Name, metav1.GetOptions{})
+		namespace := getServiceBrokerSecretNamespace(broker, authInfo.Basic.SecretNamespace)
+		secret, err := client.CoreV1().Secrets(namespace).Get(secretRef.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -798,7 +1160,8 @@ func getAuthCredentialsFromServiceBroker(client kubernetes.Interface, broker *v1
 		}, nil
 	} else if authInfo.Bearer != nil {
 		secretRef := authInfo.Bearer.SecretRef
-		secret, err := client.CoreV1().Secrets(broker.Namespace).Get(secretRef.Name, metav1.GetOptions{})
+		namespace := getServiceBrokerSecretNamespace(broker, authInfo.Bearer.SecretNamespace)
+		secret, err := client.CoreV1().Secrets(namespace).Get(secretRef.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -813,6 +1176,15 @@ func getAuthCredentialsFromServiceBroker(client kubernetes.Interface, broker *v1
 	return nil, fmt.Errorf("empty auth info or unsupported auth mode: %s", authInfo)
 }
 
+// getServiceBrokerSecretNamespace returns the namespace of an auth secret of
+// the given broker, which defaults to the namespace of the broker.
+func getServiceBrokerSecretNamespace(broker *v1beta1.ServiceBroker, secretNamespace string) string {
+	if secretNamespace != "" {
+		return secretNamespace
+	}
+	return broker.Namespace
+}
+
 func getBasicAuthConfig(secret *corev1.Secret) (*osb.BasicAuthConfig, error) {
 	usernameBytes, ok := secret.Data["username"]
 	if !ok {
@@ -887,11 +1259,14 @@ func convertAndFilterCatalogToNamespacedTypes(namespace string, in *osb.CatalogR
 			}
 			serviceClass.Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
 		}
+		serviceClass.Spec.DocumentationURL, serviceClass.Spec.SupportURL = getExternalDocumentationURLs(svc.Metadata)
+		setExternalDocumentationAnnotations(serviceClass, serviceClass.Spec.DocumentationURL, serviceClass.Spec.SupportURL)
 		serviceClass.SetName(svc.ID)
 		serviceClass.SetNamespace(namespace)
 
-		// If this service class passes the predicate, process the plans for the class.
-		if fields := v1beta1.ConvertServiceClassToProperties(serviceClass); predicate.Accepts(fields) {
+		// If this service class passes the predicate and the allow-list of
+		// external IDs, process the plans for the class.
+		if fields := v1beta1.ConvertServiceClassToProperties(serviceClass); predicate.Accepts(fields) && acceptsServiceClassExternalID(restrictions, svc.ID) {
 			// set up the plans using the ServiceClass Name
 			plans, err := convertServicePlans(namespace, svc.Plans, serviceClass.Name)
 			if err != nil {
@@ -959,10 +1334,13 @@ func convertAndFilterCatalog(in *osb.CatalogResponse, restrictions *v1beta1.Cata
 			}
 			serviceClass.Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
 		}
+		serviceClass.Spec.DocumentationURL, serviceClass.Spec.SupportURL = getExternalDocumentationURLs(svc.Metadata)
+		setExternalDocumentationAnnotations(serviceClass, serviceClass.Spec.DocumentationURL, serviceClass.Spec.SupportURL)
 		serviceClass.SetName(svc.ID)
 
-		// If this service class passes the predicate, process the plans for the class.
-		if fields := v1beta1.ConvertClusterServiceClassToProperties(serviceClass); predicate.Accepts(fields) {
+		// If this service class passes the predicate and the allow-list of
+		// external IDs, process the plans for the class.
+		if fields := v1beta1.ConvertClusterServiceClassToProperties(serviceClass); predicate.Accepts(fields) && acceptsServiceClassExternalID(restrictions, svc.ID) {
 			// set up the plans using the ClusterServiceClass Name
 			plans, err := convertClusterServicePlans(svc.Plans, serviceClass.Name)
 			if err != nil {
@@ -984,6 +1362,36 @@ func convertAndFilterCatalog(in *osb.CatalogResponse, restrictions *v1beta1.Cata
 	return serviceClasses, servicePlans, nil
 }
 
+// acceptsServiceClassExternalID returns whether the service with the given
+// external ID is in the allow-list of service external IDs of the given
+// restrictions. An empty allow-list accepts every service.
+func acceptsServiceClassExternalID(restrictions *v1beta1.CatalogRestrictions, externalID string) bool {
+	if restrictions == nil || len(restrictions.ServiceClassExternalIDs) == 0 {
+		return true
+	}
+	for _, id := range restrictions.ServiceClassExternalIDs {
+		if id == externalID {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsServicePlanExternalID returns whether the plan with the given
+// external ID is in the allow-list of plan external IDs of the given
+// restrictions. An empty allow-list accepts every plan.
+func acceptsServicePlanExternalID(restrictions *v1beta1.CatalogRestrictions, externalID string) bool {
+	if restrictions == nil || len(restrictions.ServicePlanExternalIDs) == 0 {
+		return true
+	}
+	for _, id := range restrictions.ServicePlanExternalIDs {
+		if id == externalID {
+			return true
+		}
+	}
+	return false
+}
+
 func filterNamespacedServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans []*v1beta1.ServicePlan) ([]*v1beta1.ServicePlan, []*v1beta1.ServicePlan, error) {
 	var predicate filter.Predicate
 	var err error
@@ -996,8 +1404,9 @@ func filterNamespacedServicePlans(restrictions *v1beta1.CatalogRestrictions, ser
 		predicate = filter.NewPredicate()
 	}
 
-	// If the predicate is empty, all plans will pass. No need to run through the list.
-	if predicate.Empty() {
+	// If the predicate and the allow-list of external IDs are empty, all plans
+	// will pass. No need to run through the list.
+	if predicate.Empty() && (restrictions == nil || len(restrictions.ServicePlanExternalIDs) == 0) {
 		return servicePlans, []*v1beta1.ServicePlan(nil), nil
 	}
 
@@ -1005,7 +1414,7 @@ func filterNamespacedServicePlans(restrictions *v1beta1.CatalogRestrictions, ser
 	rejected := []*v1beta1.ServicePlan(nil)
 	for _, sp := range servicePlans {
 		fields := v1beta1.ConvertServicePlanToProperties(sp)
-		if predicate.Accepts(fields) {
+		if predicate.Accepts(fields) && acceptsServicePlanExternalID(restrictions, sp.Spec.ExternalID) {
 			accepted = append(accepted, sp)
 		} else {
 			rejected = append(rejected, sp)
@@ -1027,8 +1436,9 @@ func filterServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans
 		predicate = filter.NewPredicate()
 	}
 
-	// If the predicate is empty, all plans will pass. No need to run through the list.
-	if predicate.Empty() {
+	// If the predicate and the allow-list of external IDs are empty, all plans
+	// will pass. No need to run through the list.
+	if predicate.Empty() && (restrictions == nil || len(restrictions.ServicePlanExternalIDs) == 0) {
 		return servicePlans, []*v1beta1.ClusterServicePlan(nil), nil
 	}
 
@@ -1036,7 +1446,7 @@ func filterServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans
 	rejected := []*v1beta1.ClusterServicePlan(nil)
 	for _, sp := range servicePlans {
 		fields := v1beta1.ConvertClusterServicePlanToProperties(sp)
-		if predicate.Accepts(fields) {
+		if predicate.Accepts(fields) && acceptsServicePlanExternalID(restrictions, sp.Spec.ExternalID) {
 			accepted = append(accepted, sp)
 		} else {
 			rejected = append(rejected, sp)
@@ -1071,6 +1481,7 @@ func convertServicePlans(namespace string, plans []osb.Plan, serviceClassID stri
 		if err != nil {
 			return nil, err
 		}
+		setExternalDocumentationAnnotations(servicePlan, servicePlan.Spec.DocumentationURL, servicePlan.Spec.SupportURL)
 	}
 	return servicePlans, nil
 }
@@ -1090,6 +1501,7 @@ func convertCommonServicePlan(plan osb.Plan, commonServicePlanSpec *v1beta1.Comm
 		}
 		commonServicePlanSpec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
 	}
+	commonServicePlanSpec.DocumentationURL, commonServicePlanSpec.SupportURL = getExternalDocumentationURLs(plan.Metadata)
 
 	if schemas := plan.Schemas; schemas != nil {
 		if instanceSchemas := schemas.ServiceInstance; instanceSchemas != nil {
@@ -1171,6 +1583,8 @@ func convertClusterServicePlans(plans []osb.Plan, serviceClassID string) ([]*v1b
 			}
 			servicePlans[i].Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
 		}
+		servicePlans[i].Spec.DocumentationURL, servicePlans[i].Spec.SupportURL = getExternalDocumentationURLs(plan.Metadata)
+		setExternalDocumentationAnnotations(servicePlans[i], servicePlans[i].Spec.DocumentationURL, servicePlans[i].Spec.SupportURL)
 
 		if schemas := plan.Schemas; schemas != nil {
 			if instanceSchemas := schemas.ServiceInstance; instanceSchemas != nil {
@@ -1287,6 +1701,26 @@ func isRetriableHTTPStatus(statusCode int) bool {
 	return statusCode != http.StatusBadRequest
 }
 
+// isUnsupportedAPIVersionError returns whether the given error is the
+// response of a broker that does not support the version of the Open Service
+// Broker API sent in the request.
+func isUnsupportedAPIVersionError(err error) bool {
+	httpErr, ok := osb.IsHTTPError(err)
+	return ok && httpErr.StatusCode == http.StatusPreconditionFailed
+}
+
+// unsupportedAPIVersionMessage returns the message of the condition set on a
+// broker that does not support the version of the Open Service Broker API
+// used in its client configuration.
+func unsupportedAPIVersionMessage(clientConfig *osb.ClientConfiguration) string {
+	return fmt.Sprintf("%s The broker rejected version %s; versions %s to %s are supported.",
+		errorUnsupportedOSBAPIVersionMessage,
+		clientConfig.APIVersion.HeaderValue(),
+		osb.Version2_11().HeaderValue(),
+		osb.LatestAPIVersion().HeaderValue(),
+	)
+}
+
 // ReconciliationAction represents a type of action the reconciler should take
 // for a resource.
 type ReconciliationAction string
@@ -1350,17 +1784,15 @@ func (c *controller) getServiceClassAndServiceBrokerForServiceBinding(instance *
 		return nil, "", nil, err
 	}
 
-	serviceBroker, err := c.getServiceBrokerForServiceBinding(instance, binding, serviceClass)
-	if err != nil {
-		return nil, "", nil, err
-	}
-
+	// getBrokerClientForServiceBinding checks that the broker exists,
+	// whether it is a ServiceBroker or, for a class projected from a
+	// ClusterServiceClass, a ClusterServiceBroker.
 	osbClient, err := c.getBrokerClientForServiceBinding(instance, binding)
 	if err != nil {
 		return nil, "", nil, err
 	}
 
-	return serviceClass, serviceBroker.Name, osbClient, nil
+	return serviceClass, serviceClass.Spec.ServiceBrokerName, osbClient, nil
 }
 
 func (c *controller) getServiceClassForServiceBinding(instance *v1beta1.ServiceInstance, binding *v1beta1.ServiceBinding) (*v1beta1.ServiceClass, error) {
@@ -1410,7 +1842,7 @@ func (c *controller) getServicePlanForServiceBinding(instance *v1beta1.ServiceIn
 func (c *controller) getServiceBrokerForServiceBinding(instance *v1beta1.ServiceInstance, binding *v1beta1.ServiceBinding, serviceClass *v1beta1.ServiceClass) (*v1beta1.ServiceBroker, error) {
 	pcb := pretty.NewInstanceContextBuilder(instance)
 
-	broker, err := c.serviceBrokerLister.ServiceBrokers(instance.Namespace).Get(serviceClass.Spec.ServiceBrokerName)
+	broker, err := c.getServiceBrokerForServiceClass(serviceClass)
 	if err != nil {
 		s := fmt.Sprintf("References a non-existent ServiceBroker %q", serviceClass.Spec.ServiceBrokerName)
 		glog.Warning(pcb.Message(s))
@@ -1426,3 +1858,32 @@ func (c *controller) getServiceBrokerForServiceBinding(instance *v1beta1.Service
 	}
 	return broker, nil
 }
+
+// listSelectedNamespaces returns the names of the namespaces that match the
+// given selector and are not being deleted. A nil selector matches every
+// namespace.
+func (c *controller) listSelectedNamespaces(namespaceSelector *metav1.LabelSelector) (sets.String, error) {
+	selector := labels.Everything()
+	if namespaceSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(namespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	namespaces, err := c.kubeClient.CoreV1().Namespaces().List(metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selected := sets.NewString()
+	for _, namespace := range namespaces.Items {
+		if namespace.Status.Phase != corev1.NamespaceTerminating {
+			selected.Insert(namespace.Name)
+		}
+	}
+	return selected, nil
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"reflect"
 
 	"github.com/golang/glog"
 	osb "github.com/pmorie/go-open-service-broker-client/v2"
@@ -27,12 +28,16 @@ import (
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/storage/names"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/jsonpath"
 )
@@ -46,13 +51,17 @@ const (
 	errorNonbindableClusterServiceClassReason string = "ErrorNonbindableServiceClass"
 	errorServiceInstanceRefsUnresolved        string = "ErrorInstanceRefsUnresolved"
 	errorServiceInstanceNotReadyReason        string = "ErrorInstanceNotReady"
+	errorInstanceRemovedFromCatalogReason     string = "ErrorInstanceRemovedFromCatalog"
 	errorServiceBindingOrphanMitigation       string = "ServiceBindingNeedsOrphanMitigation"
 	errorFetchingBindingFailedReason          string = "FetchingBindingFailed"
 	errorAsyncOpTimeoutReason                 string = "AsyncOperationTimeout"
+	errorFindingAppReason                     string = "ErrorFindingApp"
 
 	successInjectedBindResultReason  string = "InjectedBindResult"
 	successInjectedBindResultMessage string = "Injected bind result"
 	successUnboundReason             string = "UnboundSuccessfully"
+	abandonedUnbindReason            string = "UnbindAbandoned"
+	abandonedUnbindMessage           string = "The binding was removed without unbinding it at the broker because its deletion policy is Abandon"
 	asyncBindingReason               string = "Binding"
 	asyncBindingMessage              string = "The binding is being created asynchronously"
 	asyncUnbindingReason             string = "Unbinding"
@@ -66,6 +75,9 @@ const (
 // bindingControllerKind contains the schema.GroupVersionKind for this controller type.
 var bindingControllerKind = v1beta1.SchemeGroupVersion.WithKind("ServiceBinding")
 
+// instanceKind contains the schema.GroupVersionKind for ServiceInstances.
+var instanceKind = v1beta1.SchemeGroupVersion.WithKind("ServiceInstance")
+
 // ServiceBinding handlers and control-loop
 
 func (c *controller) bindingAdd(obj interface{}) {
@@ -160,6 +172,10 @@ func (c *controller) reconcileServiceBinding(binding *v1beta1.ServiceBinding) er
 	glog.V(6).Info(pcb.Messagef(`beginning to process resourceVersion: %v`, binding.ResourceVersion))
 
 	reconciliationAction := getReconciliationActionForServiceBinding(binding)
+	if c.readOnly && reconciliationAction != reconcilePoll {
+		glog.V(4).Info(pcb.Messagef("Not doing %v because the controller is read-only", reconciliationAction))
+		return nil
+	}
 	switch reconciliationAction {
 	case reconcileAdd:
 		return c.reconcileServiceBindingAdd(binding)
@@ -198,6 +214,22 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
+	// Record the instance as an owner of the binding when the binding is
+	// first reconciled, so that deleting the instance with foreground
+	// propagation waits for the binding to be unbound and removed. It is not
+	// added again later, so that the reference the garbage collector removes
+	// when the instance is deleted with orphan propagation stays removed. The
+	// reference is persisted with the next status update.
+	if binding.Status.ReconciledGeneration == 0 {
+		setServiceBindingOwnerReference(binding, instance)
+	}
+
+	if isServiceInstanceBindingBlockedByCatalogRemoval(instance) {
+		msg := fmt.Sprintf(`Binding cannot begin because the class or plan of referenced %s has been removed from the broker's catalog`, pretty.ServiceInstanceName(instance))
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorInstanceRemovedFromCatalogReason, msg)
+		return c.processServiceBindingOperationError(binding, readyCond)
+	}
+
 	var prettyName string
 	var brokerClient osb.Client
 	var request *osb.BindRequest
@@ -290,6 +322,17 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 	}
 
 	response, err := brokerClient.Bind(request)
+	if err != nil && !request.AcceptsIncomplete && isAsyncRequiredError(err) {
+		// The broker only binds asynchronously. Rather than failing the
+		// binding, the request is sent again right away, and the requirement
+		// is recorded so that later binding requests to the broker accept an
+		// asynchronous response from the start.
+		glog.V(4).Info(pcb.Message("ServiceBroker requires asynchronous binding operations; retrying bind with accepts_incomplete=true"))
+		c.recordBrokerRequiresAsyncBindingOperations(instance)
+		asyncRequest := *request
+		asyncRequest.AcceptsIncomplete = true
+		response, err = brokerClient.Bind(&asyncRequest)
+	}
 	if err != nil {
 		if httpErr, ok := osb.IsHTTPError(err); ok {
 			msg := fmt.Sprintf("ServiceBroker returned failure; bind operation will not be retried: %v", err.Error())
@@ -327,6 +370,12 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 	binding.Status.ExternalProperties = binding.Status.InProgressProperties
 
 	err = c.injectServiceBinding(binding, response.Credentials)
+	if isSecretRotationBlocked(err) {
+		// The rotation is retried until the policy allows it, however long
+		// that takes.
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, secretRotationBlockedReason, err.Error())
+		return c.processServiceBindingOperationError(binding, readyCond)
+	}
 	if err != nil {
 		msg := fmt.Sprintf(`Error injecting bind result: %s`, err)
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorInjectingBindResultReason, msg)
@@ -358,6 +407,26 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 		return nil
 	}
 
+	// An abandoned binding is removed without involving the broker, even if
+	// an earlier unbind attempt has failed.
+	if binding.DeletionTimestamp != nil && binding.Spec.DeletionPolicy == v1beta1.DeletionPolicyAbandon {
+		return c.processServiceBindingAbandon(binding, corev1.EventTypeNormal, abandonedUnbindReason, abandonedUnbindMessage)
+	}
+
+	if binding.DeletionTimestamp != nil {
+		message, err := c.getServiceBindingNamespaceDeletionAbandonMessage(binding)
+		if err != nil {
+			return err
+		}
+		if message != "" {
+			return c.processServiceBindingAbandon(binding, corev1.EventTypeWarning, namespaceDeletionAbandonedReason, message)
+		}
+	}
+
+	if updated, err := c.checkServiceBindingStuckDeleting(binding); updated {
+		return err
+	}
+
 	// If unbind has failed, do not do anything more
 	if binding.Status.UnbindStatus == v1beta1.ServiceBindingUnbindStatusFailed {
 		glog.V(4).Info(pcb.Message("Not processing delete event because unbinding has failed"))
@@ -462,11 +531,19 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 	}
 
 	response, err := brokerClient.Unbind(request)
+	if err != nil && !request.AcceptsIncomplete && isAsyncRequiredError(err) {
+		glog.V(4).Info(pcb.Message("ServiceBroker requires asynchronous binding operations; retrying unbind with accepts_incomplete=true"))
+		c.recordBrokerRequiresAsyncBindingOperations(instance)
+		asyncRequest := *request
+		asyncRequest.AcceptsIncomplete = true
+		response, err = brokerClient.Unbind(&asyncRequest)
+	}
 	if err != nil {
 		msg := fmt.Sprintf(
 			`Error unbinding from %s: %s`, prettyBrokerName, err,
 		)
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorUnbindCallReason, msg)
+		binding.Status.FailedUnbindAttempts++
 
 		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
@@ -499,6 +576,35 @@ func isClusterServicePlanBindable(serviceClass *v1beta1.ClusterServiceClass, pla
 	return serviceClass.Spec.Bindable
 }
 
+// setServiceBindingOwnerReference adds an owner reference to the given
+// instance on the binding, unless the instance is being deleted or the
+// binding already refers to an instance, possibly an earlier one of the same
+// name with another UID. The reference blocks owner deletion, so that the
+// garbage collector does not remove the foregroundDeletion finalizer from the
+// instance until the binding is gone. It is not a controller reference; the
+// binding controller still manages the lifecycle of the binding. Returns true
+// if the binding was modified.
+func setServiceBindingOwnerReference(binding *v1beta1.ServiceBinding, instance *v1beta1.ServiceInstance) bool {
+	if instance.UID == "" || instance.DeletionTimestamp != nil {
+		return false
+	}
+	for _, ref := range binding.OwnerReferences {
+		if ref.APIVersion == instanceKind.GroupVersion().String() && ref.Kind == instanceKind.Kind {
+			return false
+		}
+	}
+
+	blockOwnerDeletion := true
+	binding.OwnerReferences = append(binding.OwnerReferences, metav1.OwnerReference{
+		APIVersion:         instanceKind.GroupVersion().String(),
+		Kind:               instanceKind.Kind,
+		Name:               instance.Name,
+		UID:                instance.UID,
+		BlockOwnerDeletion: &blockOwnerDeletion,
+	})
+	return true
+}
+
 // isServicePlanBindable returns whether the given ServiceClass and ServicePlan
 // combination is bindable.  Plans may override the service-level bindable
 // attribute, so if the plan provides a value, return that value.  Otherwise,
@@ -534,6 +640,13 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 		}
 	}
 
+	secretLabels := c.getSecretPropagatedLabels(binding)
+	checksum := credentialsChecksum(secretData)
+
+	if binding.Spec.ImmutableSecret {
+		return c.injectServiceBindingImmutableSecret(binding, secretData, secretLabels, checksum)
+	}
+
 	// Creating/updating the Secret
 	secretClient := c.kubeClient.CoreV1().Secrets(binding.Namespace)
 	existingSecret, err := secretClient.Get(binding.Spec.SecretName, metav1.GetOptions{})
@@ -543,7 +656,19 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 			controllerRef := metav1.GetControllerOf(existingSecret)
 			return fmt.Errorf(`Secret "%s/%s" is not owned by ServiceBinding, controllerRef: %v`, binding.Namespace, existingSecret.Name, controllerRef)
 		}
+		if !reflect.DeepEqual(existingSecret.Data, secretData) {
+			if err := c.checkSecretRotation(binding, existingSecret.Name); err != nil {
+				return err
+			}
+		}
 		existingSecret.Data = secretData
+		setCredentialsChecksum(existingSecret, checksum)
+		if len(secretLabels) > 0 && existingSecret.Labels == nil {
+			existingSecret.Labels = make(map[string]string)
+		}
+		for k, v := range secretLabels {
+			existingSecret.Labels[k] = v
+		}
 		_, err = secretClient.Update(existingSecret)
 		if err != nil {
 			if apierrors.IsConflict(err) {
@@ -564,12 +689,14 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      binding.Spec.SecretName,
 				Namespace: binding.Namespace,
+				Labels:    secretLabels,
 				OwnerReferences: []metav1.OwnerReference{
 					*metav1.NewControllerRef(binding, bindingControllerKind),
 				},
 			},
 			Data: secretData,
 		}
+		setCredentialsChecksum(secret, checksum)
 		_, err = secretClient.Create(secret)
 		if err != nil {
 			if apierrors.IsAlreadyExists(err) {
@@ -581,10 +708,128 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 			return fmt.Errorf(`Unexpected error creating Secret "%s/%s": %v`, binding.Namespace, secret.Name, err)
 		}
 	}
+	binding.Status.CredentialsChecksum = checksum
 
 	return err
 }
 
+// injectServiceBindingImmutableSecret stores the given credentials of a
+// ServiceBinding with ImmutableSecret in an immutable Secret. Secrets are never
+// updated: when the current secret of the binding holds other credentials, a
+// new secret is created, recorded in the status of the binding, and the
+// current one is deleted. The given checksum of the credentials is set on the
+// secret and in the status of the binding.
+func (c *controller) injectServiceBindingImmutableSecret(binding *v1beta1.ServiceBinding, secretData map[string][]byte, secretLabels map[string]string, checksum string) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+	secretClient := c.kubeClient.CoreV1().Secrets(binding.Namespace)
+
+	currentName := binding.Status.SecretName
+	if currentName == "" {
+		currentName = binding.Spec.SecretName
+	}
+	existingSecret, err := secretClient.Get(currentName, metav1.GetOptions{})
+	if err == nil {
+		if !metav1.IsControlledBy(existingSecret, binding) {
+			controllerRef := metav1.GetControllerOf(existingSecret)
+			return fmt.Errorf(`Secret "%s/%s" is not owned by ServiceBinding, controllerRef: %v`, binding.Namespace, existingSecret.Name, controllerRef)
+		}
+		if reflect.DeepEqual(existingSecret.Data, secretData) {
+			binding.Status.SecretName = currentName
+			binding.Status.CredentialsChecksum = checksum
+			return nil
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf(`Unexpected error getting Secret "%s/%s": %v`, binding.Namespace, currentName, err)
+	} else {
+		existingSecret = nil
+	}
+
+	if existingSecret != nil {
+		if err := c.checkSecretRotation(binding, existingSecret.Name); err != nil {
+			return err
+		}
+	}
+
+	// The first secret of the binding has the name in its spec. Later ones
+	// get a generated name, since the name of a deleted secret may not be
+	// free yet.
+	name := binding.Spec.SecretName
+	if binding.Status.SecretName != "" || existingSecret != nil {
+		name = names.SimpleNameGenerator.GenerateName(binding.Spec.SecretName + "-")
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: binding.Namespace,
+			Labels:    secretLabels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(binding, bindingControllerKind),
+			},
+		},
+		Data: secretData,
+	}
+	setCredentialsChecksum(secret, checksum)
+	if _, err := secretClient.Create(secret); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf(`Conflicting Secret "%s/%s" creation detected`, binding.Namespace, name)
+		}
+		return fmt.Errorf(`Unexpected error creating Secret "%s/%s": %v`, binding.Namespace, name, err)
+	}
+	// The vendored Secret type predates the immutable field, so it is set
+	// with a patch.
+	if _, err := secretClient.Patch(name, types.MergePatchType, []byte(`{"immutable":true}`)); err != nil {
+		return fmt.Errorf(`Unexpected error making Secret "%s/%s" immutable: %v`, binding.Namespace, name, err)
+	}
+	binding.Status.SecretName = name
+	binding.Status.CredentialsChecksum = checksum
+
+	if existingSecret != nil {
+		glog.V(4).Info(pcb.Messagef(`Rotated credentials from Secret "%s/%s" to Secret "%s/%s"`, binding.Namespace, existingSecret.Name, binding.Namespace, name))
+		// The binding now points at the new secret, so failing to delete
+		// the previous one only leaves it for the garbage collector.
+		err := secretClient.Delete(existingSecret.Name, &metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			glog.Warning(pcb.Messagef(`Error deleting previous Secret "%s/%s": %v`, binding.Namespace, existingSecret.Name, err))
+		}
+	}
+	return nil
+}
+
+// getSecretPropagatedLabels returns the labels of the given binding and its
+// instance whose keys are configured to be copied onto the secret of the
+// binding. A label of the binding takes precedence over the same label of
+// the instance.
+func (c *controller) getSecretPropagatedLabels(binding *v1beta1.ServiceBinding) map[string]string {
+	if len(c.secretPropagatedLabels) == 0 {
+		return nil
+	}
+
+	var instanceLabels map[string]string
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.ServiceInstanceRef.Name)
+	if err == nil {
+		instanceLabels = instance.Labels
+	} else if !apierrors.IsNotFound(err) {
+		pcb := pretty.NewBindingContextBuilder(binding)
+		glog.Warning(pcb.Messagef("Error getting the instance to copy its labels onto the Secret: %v", err))
+	}
+
+	var labels map[string]string
+	for _, key := range c.secretPropagatedLabels {
+		value, ok := binding.Labels[key]
+		if !ok {
+			value, ok = instanceLabels[key]
+		}
+		if !ok {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[key] = value
+	}
+	return labels
+}
+
 func (c *controller) transformCredentials(transforms []v1beta1.SecretTransform, credentials map[string]interface{}) error {
 	for _, t := range transforms {
 		switch {
@@ -640,10 +885,14 @@ func evaluateJSONPath(jsonPath string, credentials map[string]interface{}) (stri
 func (c *controller) ejectServiceBinding(binding *v1beta1.ServiceBinding) error {
 	var err error
 	pcb := pretty.NewBindingContextBuilder(binding)
+	secretName := binding.Spec.SecretName
+	if binding.Status.SecretName != "" {
+		secretName = binding.Status.SecretName
+	}
 	glog.V(5).Info(pcb.Messagef(`Deleting Secret "%s/%s"`,
-		binding.Namespace, binding.Spec.SecretName,
+		binding.Namespace, secretName,
 	))
-	err = c.kubeClient.CoreV1().Secrets(binding.Namespace).Delete(binding.Spec.SecretName, &metav1.DeleteOptions{})
+	err = c.kubeClient.CoreV1().Secrets(binding.Namespace).Delete(secretName, &metav1.DeleteOptions{})
 	if err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
@@ -796,6 +1045,9 @@ func (c *controller) recordStartOfServiceBindingOperation(
 		reason = bindingInFlightReason
 		message = bindingInFlightMessage
 		toUpdate.Status.UnbindStatus = v1beta1.ServiceBindingUnbindStatusRequired
+		if toUpdate.Status.BindStartedAt == nil {
+			toUpdate.Status.BindStartedAt = &now
+		}
 	case v1beta1.ServiceBindingOperationUnbind:
 		reason = unbindingInFlightReason
 		message = unbindingInFlightMessage
@@ -810,6 +1062,13 @@ func (c *controller) recordStartOfServiceBindingOperation(
 	return c.updateServiceBindingStatus(toUpdate)
 }
 
+// recordServiceBindingPollResult records the time and result of the last poll
+// of the broker in the status of the given binding. The status is *not*
+// recorded in the registry. See recordPollResult for the return values.
+func recordServiceBindingPollResult(binding *v1beta1.ServiceBinding, result string) (changed bool, updateNeeded bool) {
+	return recordPollResult(&binding.Status.LastPollTime, &binding.Status.LastPollResult, result)
+}
+
 // clearServiceBindingCurrentOperation sets the fields of the binding's
 // Status to indicate that there is no current operation being performed. The
 // Status is *not* recorded in the registry.
@@ -880,6 +1139,25 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 
 	binding = binding.DeepCopy()
 
+	// An abandoned binding is removed without waiting for the operation in
+	// progress at the broker, so the broker is not polled any more.
+	if binding.DeletionTimestamp != nil && binding.Spec.DeletionPolicy == v1beta1.DeletionPolicyAbandon {
+		binding.Status.AsyncOpInProgress = false
+		binding.Status.LastOperation = nil
+		c.finishPollingServiceBinding(binding)
+		return c.processServiceBindingAbandon(binding, corev1.EventTypeNormal, abandonedUnbindReason, abandonedUnbindMessage)
+	}
+
+	// A deletion waiting on an asynchronous operation can get stuck without
+	// the binding ever going back through reconcileServiceBindingDelete.
+	// The broker is polled again on the next attempt.
+	if updated, err := c.checkServiceBindingStuckDeleting(binding); updated {
+		if err != nil {
+			return err
+		}
+		return c.continuePollingServiceBinding(binding)
+	}
+
 	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.ServiceInstanceRef.Name)
 	if err != nil {
 		msg := fmt.Sprintf(`References a non-existent %s "%s/%s"`, pretty.ServiceInstance, binding.Namespace, binding.Spec.ServiceInstanceRef.Name)
@@ -920,15 +1198,25 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		// the spec.
 		//
 		// The binding's Ready condition should already be False, so we
-		// just need to record an event.
+		// just need to record the poll result, and an event if the
+		// result differs from the last poll.
 		s := fmt.Sprintf("Error polling last operation: %v", err)
 		glog.V(4).Info(pcb.Message(s))
-		c.recorder.Event(binding, corev1.EventTypeWarning, errorPollingLastOperationReason, s)
+		changed, updateNeeded := recordServiceBindingPollResult(binding, s)
+		if changed {
+			c.recorder.Event(binding, corev1.EventTypeWarning, errorPollingLastOperationReason, s)
+		}
 
 		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
 		}
 
+		if updateNeeded {
+			if _, err := c.updateServiceBindingStatus(binding); err != nil {
+				return c.handleServiceBindingPollingError(binding, err)
+			}
+		}
+
 		return c.continuePollingServiceBinding(binding)
 	}
 
@@ -944,18 +1232,27 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
 		}
 
-		// if the description is non-nil, then update the instance condition with it
+		reason := asyncBindingReason
+		message := asyncBindingMessage
+		if deleting {
+			reason = asyncUnbindingReason
+			message = asyncUnbindingMessage
+		}
 		if response.Description != nil {
-			reason := asyncBindingReason
-			message := asyncBindingMessage
-			if deleting {
-				reason = asyncUnbindingReason
-				message = asyncUnbindingMessage
-			}
-
 			message = fmt.Sprintf("%s (%s)", message, *response.Description)
-			setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, reason, message)
-			c.recorder.Event(binding, corev1.EventTypeNormal, reason, message)
+		}
+
+		// only need to update the resource if the result of the poll changed
+		// or a heartbeat is due; if the description is non-nil, then update
+		// the binding condition with it
+		changed, updateNeeded := recordServiceBindingPollResult(binding, message)
+		if updateNeeded {
+			if response.Description != nil {
+				setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, reason, message)
+				if changed {
+					c.recorder.Event(binding, corev1.EventTypeNormal, reason, message)
+				}
+			}
 
 			if _, err := c.updateServiceBindingStatus(binding); err != nil {
 				return err
@@ -965,6 +1262,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		glog.V(4).Info(pcb.Message("Last operation not completed (still in progress)"))
 		return c.continuePollingServiceBinding(binding)
 	case osb.StateSucceeded:
+		recordServiceBindingPollResult(binding, string(response.State))
 		if deleting {
 			if err := c.processUnbindSuccess(binding); err != nil {
 				return err
@@ -996,7 +1294,18 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 			return c.finishPollingServiceBinding(binding)
 		}
 
-		if err := c.injectServiceBinding(binding, getBindingResponse.Credentials); err != nil {
+		err = c.injectServiceBinding(binding, getBindingResponse.Credentials)
+		if isSecretRotationBlocked(err) {
+			// Keep polling, so that the credentials are fetched again and
+			// rotated once the policy allows it.
+			c.recorder.Event(binding, corev1.EventTypeWarning, secretRotationBlockedReason, err.Error())
+			setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, secretRotationBlockedReason, err.Error())
+			if _, err := c.updateServiceBindingStatus(binding); err != nil {
+				return err
+			}
+			return c.continuePollingServiceBinding(binding)
+		}
+		if err != nil {
 			reason := errorInjectingBindResultReason
 			msg := fmt.Sprintf("Error injecting bind results: %v", err)
 
@@ -1010,12 +1319,15 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 			return c.finishPollingServiceBinding(binding)
 		}
 
+		recordServiceBindingAcceptedParameters(binding, getBindingResponse.Parameters)
+
 		if err := c.processBindSuccess(binding); err != nil {
 			return err
 		}
 
 		return c.finishPollingServiceBinding(binding)
 	case osb.StateFailed:
+		recordServiceBindingPollResult(binding, string(response.State))
 		if !deleting {
 			reason := errorBindCallReason
 			message := "Bind call failed: " + description
@@ -1029,6 +1341,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 
 		msg := "Unbind call failed: " + description
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorUnbindCallReason, msg)
+		binding.Status.FailedUnbindAttempts++
 
 		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, readyCond)
@@ -1197,10 +1510,20 @@ func (c *controller) prepareBindRequest(
 		}
 	}
 
+	specParameters := binding.Spec.Parameters
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ParameterExpansion) {
+		specParameters, err = expandRawParameters(specParameters, bindingParameterVariables(binding, instance))
+		if err != nil {
+			return nil, nil, &operationError{
+				reason:  errorWithParameters,
+				message: err.Error(),
+			}
+		}
+	}
 	parameters, parametersChecksum, rawParametersWithRedaction, err := prepareInProgressPropertyParameters(
 		c.kubeClient,
 		binding.Namespace,
-		binding.Spec.Parameters,
+		specParameters,
 		binding.Spec.ParametersFrom,
 	)
 	if err != nil {
@@ -1210,13 +1533,26 @@ func (c *controller) prepareBindRequest(
 		}
 	}
 
+	// The app GUID identifies the namespace of the binding, unless the
+	// binding names the workload that consumes it.
+	appGUID := string(ns.UID)
+	if binding.Spec.AppRef != nil {
+		appGUID, err = c.getAppGUID(binding.Namespace, binding.Spec.AppRef)
+		if err != nil {
+			return nil, nil, &operationError{
+				reason:  errorFindingAppReason,
+				message: fmt.Sprintf(`Failed to get %s %q during binding: %s`, binding.Spec.AppRef.Kind, binding.Spec.AppRef.Name, err),
+			}
+		}
+	}
+
 	inProgressProperties := &v1beta1.ServiceBindingPropertiesState{
 		Parameters:         rawParametersWithRedaction,
 		ParametersChecksum: parametersChecksum,
 		UserInfo:           binding.Spec.UserInfo,
+		AppGUID:            appGUID,
 	}
 
-	appGUID := string(ns.UID)
 	request := &osb.BindRequest{
 		BindingID:    binding.Spec.ExternalID,
 		InstanceID:   instance.Spec.ExternalID,
@@ -1238,6 +1574,12 @@ func (c *controller) prepareBindRequest(
 		request.AcceptsIncomplete = true
 	}
 
+	// A broker that has rejected a binding request because it did not accept
+	// an asynchronous response is always sent accepts_incomplete=true.
+	if c.brokerRequiresAsyncBindingOperations(instance) {
+		request.AcceptsIncomplete = true
+	}
+
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
 		originatingIdentity, err := buildOriginatingIdentity(binding.Spec.UserInfo)
 		if err != nil {
@@ -1252,6 +1594,27 @@ func (c *controller) prepareBindRequest(
 	return request, inProgressProperties, nil
 }
 
+// getAppGUID returns the UID of the workload the given reference names in the
+// given namespace.
+func (c *controller) getAppGUID(namespace string, ref *v1beta1.AppReference) (string, error) {
+	switch ref.Kind {
+	case v1beta1.AppReferenceKindDeployment:
+		deployment, err := c.kubeClient.AppsV1().Deployments(namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return string(deployment.UID), nil
+	case v1beta1.AppReferenceKindStatefulSet:
+		statefulSet, err := c.kubeClient.AppsV1().StatefulSets(namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return string(statefulSet.UID), nil
+	default:
+		return "", fmt.Errorf("unsupported kind %q", ref.Kind)
+	}
+}
+
 // prepareUnbindRequest creates an unbind request object to be passed to the
 // broker client to delete the given binding.
 func (c *controller) prepareUnbindRequest(
@@ -1303,6 +1666,12 @@ func (c *controller) prepareUnbindRequest(
 		request.AcceptsIncomplete = true
 	}
 
+	// A broker that has rejected a binding request because it did not accept
+	// an asynchronous response is always sent accepts_incomplete=true.
+	if c.brokerRequiresAsyncBindingOperations(instance) {
+		request.AcceptsIncomplete = true
+	}
+
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
 		originatingIdentity, err := buildOriginatingIdentity(binding.Spec.UserInfo)
 		if err != nil {
@@ -1401,15 +1770,60 @@ func (c *controller) processBindSuccess(binding *v1beta1.ServiceBinding) error {
 	currentReconciledGeneration := binding.Status.ReconciledGeneration
 	clearServiceBindingCurrentOperation(binding)
 	rollbackBindingReconciledGenerationOnDeletion(binding, currentReconciledGeneration)
+	firstReady := binding.Status.ReadyAt == nil
+	if firstReady {
+		now := metav1.Now()
+		binding.Status.ReadyAt = &now
+	}
 
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
 
+	if firstReady && binding.Status.BindStartedAt != nil {
+		brokerName := ""
+		if instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.ServiceInstanceRef.Name); err == nil {
+			brokerName = c.getBrokerNameForServiceInstance(instance)
+		}
+		metrics.ServiceBindingBindDuration.WithLabelValues(brokerName).Observe(
+			binding.Status.ReadyAt.Sub(binding.Status.BindStartedAt.Time).Seconds())
+	}
+
 	c.recorder.Event(binding, corev1.EventTypeNormal, successInjectedBindResultReason, successInjectedBindResultMessage)
 	return nil
 }
 
+// recordServiceBindingAcceptedParameters records the given parameters that
+// the broker echoed for the binding in the status of the binding. Parameters
+// that were sourced from a secret, and so are redacted in the external
+// properties of the binding, are redacted as well.
+func recordServiceBindingAcceptedParameters(binding *v1beta1.ServiceBinding, parameters map[string]interface{}) {
+	if len(parameters) == 0 {
+		binding.Status.AcceptedParameters = nil
+		return
+	}
+
+	var sent map[string]interface{}
+	if properties := binding.Status.ExternalProperties; properties != nil && properties.Parameters != nil {
+		sent, _ = UnmarshalRawParameters(properties.Parameters.Raw)
+	}
+	accepted := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		if sent[k] == "<redacted>" {
+			v = "<redacted>"
+		}
+		accepted[k] = v
+	}
+
+	raw, err := MarshalRawParameters(accepted)
+	if err != nil {
+		pcb := pretty.NewBindingContextBuilder(binding)
+		glog.Warning(pcb.Messagef("Unable to marshal the parameters accepted by the broker: %v", err))
+		return
+	}
+	binding.Status.AcceptedParameters = &runtime.RawExtension{Raw: raw}
+}
+
 // processBindFailure handles the logging and updating of a ServiceBinding that
 // hit a terminal failure during bind reconciliation.
 func (c *controller) processBindFailure(binding *v1beta1.ServiceBinding, readyCond, failedCond *v1beta1.ServiceBindingCondition, shouldMitigateOrphan bool) error {
@@ -1439,6 +1853,7 @@ func (c *controller) processBindFailure(binding *v1beta1.ServiceBinding, readyCo
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
+	c.notifyTerminalFailure("ServiceBinding", binding, FailureOperationBind, failedCond.Reason, failedCond.Message)
 
 	return nil
 }
@@ -1488,6 +1903,27 @@ func (c *controller) processServiceBindingGracefulDeletionSuccess(binding *v1bet
 	return nil
 }
 
+// processServiceBindingAbandon handles the deletion of a ServiceBinding that
+// is abandoned at the broker, either because its deletion policy is Abandon or
+// because of the namespace deletion policy. An event with the given type,
+// reason and message is recorded, the credentials secret is removed and the
+// finalizer is cleared without sending an unbind request to the broker.
+func (c *controller) processServiceBindingAbandon(binding *v1beta1.ServiceBinding, eventType, reason, message string) error {
+	binding = binding.DeepCopy()
+
+	if err := c.ejectServiceBinding(binding); err != nil {
+		msg := fmt.Sprintf(`Error ejecting binding. Error deleting secret: %s`, err)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorEjectingBindReason, msg)
+		return c.processServiceBindingOperationError(binding, readyCond)
+	}
+
+	pcb := pretty.NewBindingContextBuilder(binding)
+	glog.V(4).Info(pcb.Message(message))
+	c.recorder.Event(binding, eventType, reason, message)
+
+	return c.processServiceBindingGracefulDeletionSuccess(binding)
+}
+
 // processUnbindSuccess handles the logging and updating of a ServiceBinding
 // that has successfully been deleted at the broker.
 func (c *controller) processUnbindSuccess(binding *v1beta1.ServiceBinding) error {
@@ -1534,10 +1970,12 @@ func (c *controller) processUnbindFailure(binding *v1beta1.ServiceBinding, ready
 		c.recorder.Event(binding, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
 	}
 
+	operation, notifiedCond := FailureOperationUnbind, failedCond
 	if binding.Status.OrphanMitigationInProgress {
 		// replace Ready condition with orphan mitigation-related one.
 		msg := "Orphan mitigation failed: " + failedCond.Message
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorOrphanMitigationFailedReason, msg)
+		operation, notifiedCond = FailureOperationOrphanMitigation, readyCond
 		setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
 		c.recorder.Event(binding, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
 	} else {
@@ -1551,6 +1989,7 @@ func (c *controller) processUnbindFailure(binding *v1beta1.ServiceBinding, ready
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
+	c.notifyTerminalFailure("ServiceBinding", binding, operation, notifiedCond.Reason, notifiedCond.Message)
 
 	return nil
 }
@@ -458,9 +458,11 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				Error: fmt.Errorf("random error"),
 			},
 			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
-			validateConditionsFunc:    nil, // does not update resources
-			shouldFinishPolling:       false,
-			expectedEvents:            []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingPollErrorRecorded(t, updatedBinding)
+			},
+			shouldFinishPolling: false,
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
 		},
 		{
 			// Special test for 410, as it is treated differently in other operations
@@ -470,9 +472,11 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				Error: goneError,
 			},
 			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
-			validateConditionsFunc:    nil, // does not update resources
-			shouldFinishPolling:       false,
-			expectedEvents:            []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: " + goneError.Error()},
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingPollErrorRecorded(t, updatedBinding)
+			},
+			shouldFinishPolling: false,
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: " + goneError.Error()},
 		},
 		{
 			name:    "bind - in progress",
@@ -729,9 +733,11 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				Error: fmt.Errorf("random error"),
 			},
 			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
-			validateConditionsFunc:    nil, // does not update resources
-			shouldFinishPolling:       false,
-			expectedEvents:            []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingPollErrorRecorded(t, updatedBinding)
+			},
+			shouldFinishPolling: false,
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
 		},
 		{
 			name:    "unbind - failed (retries)",
@@ -903,9 +909,11 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				Error: fmt.Errorf("random error"),
 			},
 			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
-			validateConditionsFunc:    nil, // does not update resources
-			shouldFinishPolling:       false,
-			expectedEvents:            []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingPollErrorRecorded(t, updatedBinding)
+			},
+			shouldFinishPolling: false,
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
 		},
 		{
 			name:    "orphan mitigation - failed (retries)",
@@ -31,6 +31,7 @@ import (
 	v1beta1informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
 	osb "github.com/pmorie/go-open-service-broker-client/v2"
 	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -490,6 +491,7 @@ func TestReconcileServiceBindingWithParameters(t *testing.T) {
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
 	assertServiceBindingOperationSuccessWithParameters(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, expectedParameters, expectedParametersChecksum, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
+	assertServiceBindingReadyAtAfterBindStartedAt(t, updatedServiceBinding)
 
 	kubeActions := fakeKubeClient.Actions()
 	assertNumberOfActions(t, kubeActions, 3)
@@ -536,6 +538,27 @@ func TestReconcileServiceBindingWithParameters(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceBindingReadOnly tests that a read-only controller does
+// not bind a binding.
+func TestReconcileServiceBindingReadOnly(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.readOnly = true
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBinding()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
+
 // TestReconcileServiceBindingWithSecretTransform tests reconcileBinding to ensure a
 // binding with secretTransforms performs the specified transformations.
 func TestReconcileServiceBindingWithSecretTransform(t *testing.T) {
@@ -1436,6 +1459,87 @@ func TestReconcileServiceBindingDeleteFailedServiceBinding(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceBindingDeleteAbandon tests reconcileServiceBinding to
+// ensure a binding whose deletion policy is Abandon has its secret deleted and
+// its finalizer cleared without an unbind request being sent to the broker.
+func TestReconcileServiceBindingDeleteAbandon(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithRefsAndExternalProperties())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingWithFailedStatus()
+	binding.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+	binding.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	binding.Spec.DeletionPolicy = v1beta1.DeletionPolicyAbandon
+	binding.Status.ExternalProperties = &v1beta1.ServiceBindingPropertiesState{}
+	binding.Status.UnbindStatus = v1beta1.ServiceBindingUnbindStatusFailed
+
+	fakeCatalogClient.AddReactor("get", "servicebindings", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, binding, nil
+	})
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	assertDeleteSecretAction(t, fakeKubeClient.Actions(), binding.Spec.SecretName)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertEmptyFinalizers(t, updatedServiceBinding)
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(abandonedUnbindReason).msg(abandonedUnbindMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestPollServiceBindingAbandonedDuringAsyncBind verifies that a binding
+// with the Abandon deletion policy that is deleted while its bind is still in
+// progress at the broker is removed without polling the broker again.
+func TestPollServiceBindingAbandonedDuringAsyncBind(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		PollBindingLastOperationReaction: &fakeosb.PollBindingLastOperationReaction{
+			Response: &osb.LastOperationResponse{State: osb.StateInProgress},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithRefsAndExternalProperties())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingAsyncBinding(testOperation)
+	binding.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+	binding.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	binding.Spec.DeletionPolicy = v1beta1.DeletionPolicyAbandon
+
+	fakeCatalogClient.AddReactor("get", "servicebindings", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, binding, nil
+	})
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertDeleteSecretAction(t, fakeKubeClient.Actions(), binding.Spec.SecretName)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertEmptyFinalizers(t, updatedServiceBinding)
+}
+
 // TestReconcileBindingWithBrokerError tests reconcileBinding to ensure a
 // binding request response that contains a broker error fails as expected.
 func TestReconcileServiceBindingWithClusterServiceBrokerError(t *testing.T) {
@@ -1512,7 +1616,7 @@ func TestReconcileServiceBindingWithClusterServiceBrokerHTTPError(t *testing.T)
 					"c": "d",
 				},
 			},
-			Error: fakeosb.AsyncRequiredError(),
+			Error: osb.HTTPStatusCodeError{StatusCode: http.StatusBadRequest},
 		},
 	})
 
@@ -1943,6 +2047,9 @@ func TestReconcileUnbindingWithClusterServiceBrokerError(t *testing.T) {
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
 	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, errorUnbindCallReason, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
+	if e, a := int64(1), updatedServiceBinding.(*v1beta1.ServiceBinding).Status.FailedUnbindAttempts; e != a {
+		t.Fatalf("unexpected failed unbind attempts: %v", expectedGot(e, a))
+	}
 
 	events := getRecordedEvents(testController)
 
@@ -3213,6 +3320,124 @@ func TestReconcileServiceBindingAsynchronousUnbind(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceBindingAsyncRequired tests that a bind request that the
+// broker rejects with the AsyncRequired error is sent again with
+// accepts_incomplete=true, and that the requirement is recorded in the status
+// of the broker.
+func TestReconcileServiceBindingAsyncRequired(t *testing.T) {
+	key := osb.OperationKey(testOperation)
+	fakeKubeClient, fakeCatalogClient, fakeServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: fakeosb.DynamicBindReaction(func(r *osb.BindRequest) (*osb.BindResponse, error) {
+			if !r.AcceptsIncomplete {
+				return nil, fakeosb.AsyncRequiredError()
+			}
+			return &osb.BindResponse{Async: true, OperationKey: &key}, nil
+		}),
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+
+	binding := getTestServiceBinding()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binding = assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("a valid binding should not fail: %v", err)
+	}
+
+	expectedRequest := &osb.BindRequest{
+		BindingID:  testServiceBindingGUID,
+		InstanceID: testServiceInstanceGUID,
+		ServiceID:  testClusterServiceClassGUID,
+		PlanID:     testClusterServicePlanGUID,
+		AppGUID:    strPtr(testNamespaceGUID),
+		BindResource: &osb.BindResource{
+			AppGUID: strPtr(testNamespaceGUID),
+		},
+	}
+	brokerActions := fakeServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 2)
+	assertBind(t, brokerActions[0], expectedRequest)
+	expectedRequest.AcceptsIncomplete = true
+	assertBind(t, brokerActions[1], expectedRequest)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+
+	updatedBroker := assertUpdateStatus(t, actions[0], getTestClusterServiceBroker()).(*v1beta1.ClusterServiceBroker)
+	if !updatedBroker.Status.AsyncBindingOperationsRequired {
+		t.Fatal("expected the broker status to record that asynchronous binding operations are required")
+	}
+	updatedServiceBinding := assertUpdateStatus(t, actions[1], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingAsyncInProgress(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, asyncBindingReason, testOperation, binding)
+}
+
+// TestReconcileServiceBindingBrokerRequiresAsync tests that a bind request to
+// a broker whose status records that it requires asynchronous binding
+// operations accepts an asynchronous response from the start.
+func TestReconcileServiceBindingBrokerRequiresAsync(t *testing.T) {
+	key := osb.OperationKey(testOperation)
+	fakeKubeClient, fakeCatalogClient, fakeServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Async:        true,
+				OperationKey: &key,
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	broker := getTestClusterServiceBroker()
+	broker.Status.AsyncBindingOperationsRequired = true
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+
+	binding := getTestServiceBinding()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binding = assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("a valid binding should not fail: %v", err)
+	}
+
+	brokerActions := fakeServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertBind(t, brokerActions[0], &osb.BindRequest{
+		BindingID:  testServiceBindingGUID,
+		InstanceID: testServiceInstanceGUID,
+		ServiceID:  testClusterServiceClassGUID,
+		PlanID:     testClusterServicePlanGUID,
+		AppGUID:    strPtr(testNamespaceGUID),
+		BindResource: &osb.BindResource{
+			AppGUID: strPtr(testNamespaceGUID),
+		},
+		AcceptsIncomplete: true,
+	})
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingAsyncInProgress(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, asyncBindingReason, testOperation, binding)
+}
+
 func TestPollServiceBinding(t *testing.T) {
 	utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.AsyncBindingOperations))
 	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.AsyncBindingOperations))
@@ -3273,9 +3498,11 @@ func TestPollServiceBinding(t *testing.T) {
 				Error: fmt.Errorf("random error"),
 			},
 			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
-			validateConditionsFunc:    nil, // does not update resources
-			shouldFinishPolling:       false,
-			expectedEvents:            []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingPollErrorRecorded(t, updatedBinding)
+			},
+			shouldFinishPolling: false,
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
 		},
 		{
 			// Special test for 410, as it is treated differently in other operations
@@ -3285,9 +3512,11 @@ func TestPollServiceBinding(t *testing.T) {
 				Error: goneError,
 			},
 			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
-			validateConditionsFunc:    nil, // does not update resources
-			shouldFinishPolling:       false,
-			expectedEvents:            []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: " + goneError.Error()},
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingPollErrorRecorded(t, updatedBinding)
+			},
+			shouldFinishPolling: false,
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: " + goneError.Error()},
 		},
 		{
 			name:    "bind - in progress",
@@ -3544,9 +3773,11 @@ func TestPollServiceBinding(t *testing.T) {
 				Error: fmt.Errorf("random error"),
 			},
 			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
-			validateConditionsFunc:    nil, // does not update resources
-			shouldFinishPolling:       false,
-			expectedEvents:            []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingPollErrorRecorded(t, updatedBinding)
+			},
+			shouldFinishPolling: false,
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
 		},
 		{
 			name:    "unbind - failed (retries)",
@@ -3718,9 +3949,11 @@ func TestPollServiceBinding(t *testing.T) {
 				Error: fmt.Errorf("random error"),
 			},
 			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
-			validateConditionsFunc:    nil, // does not update resources
-			shouldFinishPolling:       false,
-			expectedEvents:            []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingPollErrorRecorded(t, updatedBinding)
+			},
+			shouldFinishPolling: false,
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
 		},
 		{
 			name:    "orphan mitigation - failed (retries)",
@@ -4070,6 +4303,152 @@ func TestTransformSecretData(t *testing.T) {
 	}
 }
 
+// TestSetServiceBindingOwnerReference tests that a binding is given a
+// single, owner-deletion-blocking reference to its instance.
+func TestSetServiceBindingOwnerReference(t *testing.T) {
+	instance := getTestServiceInstance()
+	instance.UID = "instance-uid"
+	binding := getTestServiceBinding()
+
+	if !setServiceBindingOwnerReference(binding, instance) {
+		t.Fatal("expected binding to be modified")
+	}
+	if e, a := 1, len(binding.OwnerReferences); e != a {
+		t.Fatalf("unexpected number of owner references: %v", expectedGot(e, a))
+	}
+	ref := binding.OwnerReferences[0]
+	if e, a := "ServiceInstance", ref.Kind; e != a {
+		t.Fatalf("unexpected owner kind: %v", expectedGot(e, a))
+	}
+	if e, a := instance.Name, ref.Name; e != a {
+		t.Fatalf("unexpected owner name: %v", expectedGot(e, a))
+	}
+	if e, a := instance.UID, ref.UID; e != a {
+		t.Fatalf("unexpected owner UID: %v", expectedGot(e, a))
+	}
+	if ref.BlockOwnerDeletion == nil || !*ref.BlockOwnerDeletion {
+		t.Fatal("expected owner reference to block owner deletion")
+	}
+	if ref.Controller != nil && *ref.Controller {
+		t.Fatal("expected owner reference not to be a controller reference")
+	}
+
+	if setServiceBindingOwnerReference(binding, instance) {
+		t.Fatal("expected binding not to be modified a second time")
+	}
+	if e, a := 1, len(binding.OwnerReferences); e != a {
+		t.Fatalf("unexpected number of owner references: %v", expectedGot(e, a))
+	}
+}
+
+// TestSetServiceBindingOwnerReferenceLeavesOtherInstances tests that a
+// binding is not given an owner reference to an instance that is being
+// deleted, nor a second one when it already refers to an instance, which
+// may be an earlier one of the same name.
+func TestSetServiceBindingOwnerReferenceLeavesOtherInstances(t *testing.T) {
+	deleting := getTestServiceInstance()
+	deleting.UID = "instance-uid"
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	binding := getTestServiceBinding()
+	if setServiceBindingOwnerReference(binding, deleting) {
+		t.Fatal("expected binding of an instance being deleted not to be modified")
+	}
+	if e, a := 0, len(binding.OwnerReferences); e != a {
+		t.Fatalf("unexpected number of owner references: %v", expectedGot(e, a))
+	}
+
+	earlier := getTestServiceInstance()
+	earlier.UID = "earlier-instance-uid"
+	setServiceBindingOwnerReference(binding, earlier)
+	instance := getTestServiceInstance()
+	instance.UID = "instance-uid"
+	if setServiceBindingOwnerReference(binding, instance) {
+		t.Fatal("expected binding referring to an earlier instance not to be modified")
+	}
+	if e, a := 1, len(binding.OwnerReferences); e != a {
+		t.Fatalf("unexpected number of owner references: %v", expectedGot(e, a))
+	}
+	if e, a := earlier.UID, binding.OwnerReferences[0].UID; e != a {
+		t.Fatalf("unexpected owner UID: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileServiceBindingOwnerReferenceOnlyOnCreation tests that a
+// binding is given an owner reference to its instance when it is first
+// reconciled, and that a binding that was reconciled before, whose reference
+// the garbage collector may have removed when its instance was deleted with
+// orphan propagation, does not get it back.
+func TestReconcileServiceBindingOwnerReferenceOnlyOnCreation(t *testing.T) {
+	cases := []struct {
+		name                 string
+		generation           int64
+		reconciledGeneration int64
+		numActions           int
+		ownerReferences      int
+	}{
+		{
+			name:            "new binding",
+			generation:      1,
+			numActions:      1,
+			ownerReferences: 1,
+		},
+		{
+			name:                 "updated binding",
+			generation:           2,
+			reconciledGeneration: 1,
+			numActions:           1,
+			ownerReferences:      0,
+		},
+		{
+			name:                 "reconciled binding",
+			generation:           1,
+			reconciledGeneration: 1,
+			numActions:           0,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+			addGetNamespaceReaction(fakeKubeClient)
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.UID = "instance-uid"
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			binding := getTestServiceBinding()
+			binding.Generation = tc.generation
+			binding.Status.ReconciledGeneration = tc.reconciledGeneration
+
+			// The instance is not ready, so the binding is only updated
+			// with the error.
+			reconcileServiceBinding(t, testController, binding)
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, tc.numActions)
+			if tc.numActions == 0 {
+				return
+			}
+			updatedServiceBinding, ok := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+			if !ok {
+				t.Fatal("couldn't convert to *v1beta1.ServiceBinding")
+			}
+			if e, a := tc.ownerReferences, len(updatedServiceBinding.OwnerReferences); e != a {
+				t.Fatalf("unexpected number of owner references: %v", expectedGot(e, a))
+			}
+			if tc.ownerReferences > 0 {
+				if e, a := instance.UID, updatedServiceBinding.OwnerReferences[0].UID; e != a {
+					t.Fatalf("unexpected owner UID: %v", expectedGot(e, a))
+				}
+			}
+		})
+	}
+}
+
 func assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t *testing.T, fakeCatalogClient *fake.Clientset, binding *v1beta1.ServiceBinding) *v1beta1.ServiceBinding {
 	return assertServiceBindingOperationInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding, v1beta1.ServiceBindingOperationBind)
 }
@@ -4123,3 +4502,217 @@ func reconcileServiceBinding(t *testing.T, testController *controller, binding *
 	}
 	return err
 }
+
+// TestInjectServiceBindingImmutableSecret tests that the credentials of a
+// binding with ImmutableSecret are never updated in place: a new secret is
+// created for new credentials and the previous one is deleted.
+func TestInjectServiceBindingImmutableSecret(t *testing.T) {
+	credentials := map[string]interface{}{"password": "first"}
+	secretData := map[string][]byte{"password": []byte("first")}
+
+	cases := []struct {
+		name          string
+		statusSecret  string
+		existing      *corev1.Secret
+		credentials   map[string]interface{}
+		expectCreate  bool
+		expectDeleted string
+	}{
+		{
+			name:         "first secret",
+			credentials:  credentials,
+			expectCreate: true,
+		},
+		{
+			name:         "same credentials",
+			statusSecret: "test-secret",
+			existing:     &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-secret"}, Data: secretData},
+			credentials:  credentials,
+		},
+		{
+			name:          "new credentials",
+			statusSecret:  "test-secret",
+			existing:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-secret"}, Data: secretData},
+			credentials:   map[string]interface{}{"password": "second"},
+			expectCreate:  true,
+			expectDeleted: "test-secret",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+			binding := getTestServiceBinding()
+			binding.Spec.SecretName = "test-secret"
+			binding.Spec.ImmutableSecret = true
+			binding.Status.SecretName = tc.statusSecret
+			fakeKubeClient.AddReactor("get", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				if tc.existing == nil {
+					return true, nil, apierrors.NewNotFound(corev1.Resource("secrets"), action.(clientgotesting.GetAction).GetName())
+				}
+				existing := tc.existing.DeepCopy()
+				existing.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)}
+				return true, existing, nil
+			})
+
+			if err := testController.injectServiceBinding(binding, tc.credentials); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var created, patched, deleted string
+			for _, action := range fakeKubeClient.Actions() {
+				switch {
+				case action.Matches("update", "secrets"):
+					t.Fatal("expected the secret not to be updated")
+				case action.Matches("create", "secrets"):
+					created = action.(clientgotesting.CreateAction).GetObject().(*corev1.Secret).Name
+				case action.Matches("patch", "secrets"):
+					patch := action.(clientgotesting.PatchAction)
+					if e, a := `{"immutable":true}`, string(patch.GetPatch()); e != a {
+						t.Fatalf("unexpected patch: %v", expectedGot(e, a))
+					}
+					patched = patch.GetName()
+				case action.Matches("delete", "secrets"):
+					deleted = action.(clientgotesting.DeleteAction).GetName()
+				}
+			}
+
+			if !tc.expectCreate {
+				if created != "" {
+					t.Fatalf("expected no secret to be created, got %q", created)
+				}
+				if e, a := tc.statusSecret, binding.Status.SecretName; e != a {
+					t.Fatalf("unexpected status secret name: %v", expectedGot(e, a))
+				}
+				return
+			}
+			if created == "" || created != patched {
+				t.Fatalf("expected the created secret %q to be made immutable, got %q", created, patched)
+			}
+			if e, a := created, binding.Status.SecretName; e != a {
+				t.Fatalf("unexpected status secret name: %v", expectedGot(e, a))
+			}
+			if tc.expectDeleted != "" && created == tc.expectDeleted {
+				t.Fatalf("expected the new secret to have a new name, got %q", created)
+			}
+			if e, a := tc.expectDeleted, deleted; e != a {
+				t.Fatalf("unexpected deleted secret: %v", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestInjectServiceBindingPropagatedLabels tests that the configured labels of
+// the binding and its instance are copied onto the secret of the binding.
+func TestInjectServiceBindingPropagatedLabels(t *testing.T) {
+	fakeKubeClient, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.secretPropagatedLabels = []string{"team", "app", "tier"}
+
+	instance := getTestServiceInstance()
+	instance.Labels = map[string]string{"team": "payments", "app": "checkout", "other": "value"}
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+
+	binding := getTestServiceBinding()
+	binding.Labels = map[string]string{"app": "refunds"}
+	binding.Spec.SecretName = "test-secret"
+	fakeKubeClient.AddReactor("get", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(corev1.Resource("secrets"), action.(clientgotesting.GetAction).GetName())
+	})
+
+	if err := testController.injectServiceBinding(binding, map[string]interface{}{"password": "secret"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var secret *corev1.Secret
+	for _, action := range fakeKubeClient.Actions() {
+		if action.Matches("create", "secrets") {
+			secret = action.(clientgotesting.CreateAction).GetObject().(*corev1.Secret)
+		}
+	}
+	if secret == nil {
+		t.Fatal("expected the secret to be created")
+	}
+	expected := map[string]string{"team": "payments", "app": "refunds"}
+	if e, a := expected, secret.Labels; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected secret labels: %v", expectedGot(e, a))
+	}
+}
+
+// TestRecordServiceBindingAcceptedParameters tests that the parameters echoed
+// by the broker are recorded with the parameters sourced from secrets
+// redacted.
+func TestRecordServiceBindingAcceptedParameters(t *testing.T) {
+	binding := getTestServiceBinding()
+	binding.Status.ExternalProperties = &v1beta1.ServiceBindingPropertiesState{
+		Parameters: &runtime.RawExtension{Raw: []byte(`{"name":"test","password":"<redacted>"}`)},
+	}
+
+	recordServiceBindingAcceptedParameters(binding, map[string]interface{}{
+		"name":     "test",
+		"password": "letmein",
+		"region":   "us-east-1",
+	})
+	if binding.Status.AcceptedParameters == nil {
+		t.Fatal("expected the accepted parameters to be recorded")
+	}
+	accepted, err := UnmarshalRawParameters(binding.Status.AcceptedParameters.Raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{"name": "test", "password": "<redacted>", "region": "us-east-1"}
+	if e, a := expected, accepted; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected accepted parameters: %v", expectedGot(e, a))
+	}
+
+	recordServiceBindingAcceptedParameters(binding, nil)
+	if binding.Status.AcceptedParameters != nil {
+		t.Fatalf("expected no accepted parameters, got %s", binding.Status.AcceptedParameters.Raw)
+	}
+}
+
+// TestPrepareBindRequestAppRef tests that the UID of the workload a binding
+// names is sent to the broker as the app GUID and recorded in the status.
+func TestPrepareBindRequestAppRef(t *testing.T) {
+	const testAppGUID = "test-app-guid"
+
+	fakeKubeClient, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	addGetNamespaceReaction(fakeKubeClient)
+	fakeKubeClient.AddReactor("get", "deployments", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		name := action.(clientgotesting.GetAction).GetName()
+		if name != "test-app" {
+			return true, nil, apierrors.NewNotFound(action.GetResource().GroupResource(), name)
+		}
+		return true, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, UID: testAppGUID},
+		}, nil
+	})
+
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithStatus(v1beta1.ConditionTrue)
+	binding := getTestServiceBinding()
+	binding.Spec.AppRef = &v1beta1.AppReference{Kind: v1beta1.AppReferenceKindDeployment, Name: "test-app"}
+
+	request, inProgressProperties, err := testController.prepareBindRequest(binding, instance)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if request.AppGUID == nil || *request.AppGUID != testAppGUID {
+		t.Fatalf("expected app GUID %q, got %v", testAppGUID, request.AppGUID)
+	}
+	if request.BindResource == nil || request.BindResource.AppGUID == nil || *request.BindResource.AppGUID != testAppGUID {
+		t.Fatalf("expected bind resource app GUID %q, got %+v", testAppGUID, request.BindResource)
+	}
+	if inProgressProperties.AppGUID != testAppGUID {
+		t.Fatalf("expected in-progress app GUID %q, got %q", testAppGUID, inProgressProperties.AppGUID)
+	}
+
+	binding.Spec.AppRef.Name = "other-app"
+	_, _, err = testController.prepareBindRequest(binding, instance)
+	if opErr, ok := err.(*operationError); !ok || opErr.reason != errorFindingAppReason {
+		t.Fatalf("expected an %v operation error, got %v", errorFindingAppReason, err)
+	}
+}
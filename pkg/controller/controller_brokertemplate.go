@@ -0,0 +1,204 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	"github.com/golang/glog"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// brokerTemplateLabel is set on the ServiceBrokers created from a
+// BrokerTemplate. Its value is the name of the template.
+const brokerTemplateLabel = "servicecatalog.k8s.io/broker-template"
+
+func (c *controller) brokerTemplateAdd(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.brokerTemplateQueue.Add(key)
+}
+
+// brokerTemplateUpdate also handles the periodic resync of the informer, which
+// is what picks up namespaces created or relabelled since the last reconcile.
+func (c *controller) brokerTemplateUpdate(oldObj, newObj interface{}) {
+	c.brokerTemplateAdd(newObj)
+}
+
+func (c *controller) brokerTemplateDelete(obj interface{}) {
+	template, ok := obj.(*v1beta1.BrokerTemplate)
+	if template == nil || !ok {
+		return
+	}
+
+	// The ServiceBrokers created from the template are owned by it and are
+	// removed by the garbage collector.
+	glog.V(4).Infof("Received delete event for BrokerTemplate %v; no further processing will occur", template.Name)
+}
+
+func (c *controller) reconcileBrokerTemplateKey(key string) error {
+	template, err := c.brokerTemplateLister.Get(key)
+	if errors.IsNotFound(err) {
+		glog.Infof("BrokerTemplate %q: Not doing work because it has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		glog.Infof("BrokerTemplate %q: Unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	return c.reconcileBrokerTemplate(template)
+}
+
+// reconcileBrokerTemplate is the control-loop that reconciles a
+// BrokerTemplate. It makes sure that every namespace selected by the template
+// has a ServiceBroker created from it, and that the ServiceBrokers it created
+// in namespaces that are no longer selected are deleted.
+func (c *controller) reconcileBrokerTemplate(template *v1beta1.BrokerTemplate) error {
+	glog.V(4).Infof("BrokerTemplate %q: Processing", template.Name)
+
+	selected, err := c.listSelectedNamespaces(template.Spec.NamespaceSelector)
+	if err != nil {
+		glog.Errorf("BrokerTemplate %q: Error listing namespaces: %v", template.Name, err)
+		return err
+	}
+
+	var errs []error
+	for _, namespace := range selected.List() {
+		if err := c.applyBrokerTemplate(template, namespace); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	brokers, err := c.serviceBrokerLister.ServiceBrokers(metav1.NamespaceAll).List(labels.SelectorFromSet(labels.Set{
+		brokerTemplateLabel: template.Name,
+	}))
+	if err != nil {
+		return utilerrors.NewAggregate(append(errs, err))
+	}
+	for _, broker := range brokers {
+		if selected.Has(broker.Namespace) || !metav1.IsControlledBy(broker, template) {
+			continue
+		}
+		glog.V(4).Infof("BrokerTemplate %q: Deleting ServiceBroker %s/%s from a namespace that is no longer selected", template.Name, broker.Namespace, broker.Name)
+		err := c.serviceCatalogClient.ServiceBrokers(broker.Namespace).Delete(broker.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// applyBrokerTemplate creates or updates the ServiceBroker created from the
+// given template in the given namespace. A ServiceBroker of the same name that
+// was not created from the template is left alone.
+func (c *controller) applyBrokerTemplate(template *v1beta1.BrokerTemplate, namespace string) error {
+	desired := newServiceBrokerFromTemplate(template, namespace)
+
+	existing, err := c.serviceBrokerLister.ServiceBrokers(namespace).Get(desired.Name)
+	if errors.IsNotFound(err) {
+		glog.V(4).Infof("BrokerTemplate %q: Creating ServiceBroker %s/%s", template.Name, namespace, desired.Name)
+		_, err := c.serviceCatalogClient.ServiceBrokers(namespace).Create(desired)
+		if err != nil && !errors.IsAlreadyExists(err) {
+			glog.Errorf("BrokerTemplate %q: Error creating ServiceBroker %s/%s: %v", template.Name, namespace, desired.Name, err)
+			return err
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(existing, template) {
+		glog.Warningf("BrokerTemplate %q: Not updating ServiceBroker %s/%s because it was not created from the template", template.Name, namespace, desired.Name)
+		return nil
+	}
+
+	// A relist requested on the ServiceBroker itself must not be undone.
+	if existing.Spec.RelistRequests > desired.Spec.RelistRequests {
+		desired.Spec.RelistRequests = existing.Spec.RelistRequests
+	}
+	if apiequality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+		return nil
+	}
+
+	toUpdate := existing.DeepCopy()
+	toUpdate.Spec = desired.Spec
+	glog.V(4).Infof("BrokerTemplate %q: Updating ServiceBroker %s/%s", template.Name, namespace, desired.Name)
+	if _, err := c.serviceCatalogClient.ServiceBrokers(namespace).Update(toUpdate); err != nil {
+		glog.Errorf("BrokerTemplate %q: Error updating ServiceBroker %s/%s: %v", template.Name, namespace, desired.Name, err)
+		return err
+	}
+	return nil
+}
+
+// newServiceBrokerFromTemplate returns the ServiceBroker that the given
+// template creates in the given namespace.
+func newServiceBrokerFromTemplate(template *v1beta1.BrokerTemplate, namespace string) *v1beta1.ServiceBroker {
+	name := template.Spec.BrokerName
+	if name == "" {
+		name = template.Name
+	}
+
+	spec := template.Spec.Template.DeepCopy()
+	spec.URL = expandBrokerTemplateNamespace(spec.URL, namespace)
+	if authInfo := spec.AuthInfo; authInfo != nil {
+		if authInfo.Basic != nil {
+			expandBrokerTemplateSecretRef(authInfo.Basic.SecretRef, &authInfo.Basic.SecretNamespace, namespace)
+		}
+		if authInfo.Bearer != nil {
+			expandBrokerTemplateSecretRef(authInfo.Bearer.SecretRef, &authInfo.Bearer.SecretNamespace, namespace)
+		}
+	}
+
+	return &v1beta1.ServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				brokerTemplateLabel: template.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(template, v1beta1.SchemeGroupVersion.WithKind("BrokerTemplate")),
+			},
+		},
+		Spec: *spec,
+	}
+}
+
+func expandBrokerTemplateNamespace(s, namespace string) string {
+	return strings.Replace(s, v1beta1.BrokerTemplateNamespaceVariable, namespace, -1)
+}
+
+func expandBrokerTemplateSecretRef(secretRef *v1beta1.LocalObjectReference, secretNamespace *string, namespace string) {
+	if secretRef != nil {
+		secretRef.Name = expandBrokerTemplateNamespace(secretRef.Name, namespace)
+	}
+	*secretNamespace = expandBrokerTemplateNamespace(*secretNamespace, namespace)
+}
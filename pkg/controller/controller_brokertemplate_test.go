@@ -0,0 +1,208 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+)
+
+const (
+	testBrokerTemplateName = "test-brokertemplate"
+	testBrokerTemplateUID  = "brokertemplate-uid"
+)
+
+func getTestBrokerTemplate() *v1beta1.BrokerTemplate {
+	return &v1beta1.BrokerTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testBrokerTemplateName,
+			UID:  testBrokerTemplateUID,
+		},
+		Spec: v1beta1.BrokerTemplateSpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "a"},
+			},
+			Template: v1beta1.ServiceBrokerSpec{
+				CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{
+					URL: "https://broker.$(namespace).svc.cluster.local",
+				},
+				AuthInfo: &v1beta1.ServiceBrokerAuthInfo{
+					Bearer: &v1beta1.BearerTokenAuthConfig{
+						SecretRef: &v1beta1.LocalObjectReference{
+							Name: "$(namespace)-token",
+						},
+						SecretNamespace: "broker-secrets",
+					},
+				},
+			},
+		},
+	}
+}
+
+func addListNamespacesReaction(fakeKubeClient *clientgofake.Clientset, namespaces ...corev1.Namespace) {
+	fakeKubeClient.AddReactor("list", "namespaces", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.NamespaceList{Items: namespaces}, nil
+	})
+}
+
+func enableNamespacedServiceBroker(t *testing.T) {
+	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.NamespacedServiceBroker))
+	if err != nil {
+		t.Fatalf("Could not enable NamespacedServiceBroker feature flag.")
+	}
+}
+
+// TestReconcileBrokerTemplateCreatesServiceBrokers tests that a ServiceBroker
+// is created from the template in every selected namespace that is not being
+// deleted.
+func TestReconcileBrokerTemplateCreatesServiceBrokers(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	fakeKubeClient, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+	addListNamespacesReaction(fakeKubeClient,
+		corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a-old", Labels: map[string]string{"team": "a"}},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+		},
+	)
+
+	template := getTestBrokerTemplate()
+	if err := testController.reconcileBrokerTemplate(template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 1)
+	listAction := kubeActions[0].(clientgotesting.ListAction)
+	if e, a := "team=a", listAction.GetListRestrictions().Labels.String(); e != a {
+		t.Fatalf("unexpected namespace selector: %v", expectedGot(e, a))
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertActionEquals(t, actions[0], "create", "servicebrokers")
+	broker := actions[0].(clientgotesting.CreateAction).GetObject().(*v1beta1.ServiceBroker)
+
+	if e, a := "team-a", broker.Namespace; e != a {
+		t.Fatalf("unexpected namespace: %v", expectedGot(e, a))
+	}
+	if e, a := testBrokerTemplateName, broker.Name; e != a {
+		t.Fatalf("unexpected name: %v", expectedGot(e, a))
+	}
+	if e, a := "https://broker.team-a.svc.cluster.local", broker.Spec.URL; e != a {
+		t.Fatalf("unexpected URL: %v", expectedGot(e, a))
+	}
+	if e, a := "team-a-token", broker.Spec.AuthInfo.Bearer.SecretRef.Name; e != a {
+		t.Fatalf("unexpected auth secret: %v", expectedGot(e, a))
+	}
+	if e, a := "broker-secrets", broker.Spec.AuthInfo.Bearer.SecretNamespace; e != a {
+		t.Fatalf("unexpected auth secret namespace: %v", expectedGot(e, a))
+	}
+	if e, a := testBrokerTemplateName, broker.Labels[brokerTemplateLabel]; e != a {
+		t.Fatalf("unexpected template label: %v", expectedGot(e, a))
+	}
+	if !metav1.IsControlledBy(broker, template) {
+		t.Fatalf("expected ServiceBroker to be controlled by the template")
+	}
+}
+
+// TestReconcileBrokerTemplateUpdatesServiceBrokers tests that a ServiceBroker
+// created from the template is updated when the template changes, and that a
+// ServiceBroker of the same name not created from it is left alone.
+func TestReconcileBrokerTemplateUpdatesServiceBrokers(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	addListNamespacesReaction(fakeKubeClient,
+		corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "a"}}},
+	)
+
+	template := getTestBrokerTemplate()
+
+	owned := newServiceBrokerFromTemplate(template, "team-a")
+	owned.Spec.URL = "https://old.example.com"
+	owned.Spec.RelistRequests = 2
+	sharedInformers.ServiceBrokers().Informer().GetStore().Add(owned)
+
+	unowned := newServiceBrokerFromTemplate(template, "team-b")
+	unowned.OwnerReferences = nil
+	unowned.Labels = nil
+	unowned.Spec.URL = "https://mine.example.com"
+	sharedInformers.ServiceBrokers().Informer().GetStore().Add(unowned)
+
+	if err := testController.reconcileBrokerTemplate(template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertActionEquals(t, actions[0], "update", "servicebrokers")
+	broker := actions[0].(clientgotesting.UpdateAction).GetObject().(*v1beta1.ServiceBroker)
+
+	if e, a := "team-a", broker.Namespace; e != a {
+		t.Fatalf("unexpected namespace: %v", expectedGot(e, a))
+	}
+	if e, a := "https://broker.team-a.svc.cluster.local", broker.Spec.URL; e != a {
+		t.Fatalf("unexpected URL: %v", expectedGot(e, a))
+	}
+	if e, a := int64(2), broker.Spec.RelistRequests; e != a {
+		t.Fatalf("unexpected relist requests: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileBrokerTemplateDeletesServiceBrokers tests that a ServiceBroker
+// created from the template is deleted once its namespace is no longer
+// selected.
+func TestReconcileBrokerTemplateDeletesServiceBrokers(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	addListNamespacesReaction(fakeKubeClient)
+
+	template := getTestBrokerTemplate()
+	sharedInformers.ServiceBrokers().Informer().GetStore().Add(newServiceBrokerFromTemplate(template, "team-a"))
+
+	if err := testController.reconcileBrokerTemplate(template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertActionEquals(t, actions[0], "delete", "servicebrokers")
+	deleteAction := actions[0].(clientgotesting.DeleteAction)
+	if e, a := "team-a", deleteAction.GetNamespace(); e != a {
+		t.Fatalf("unexpected namespace: %v", expectedGot(e, a))
+	}
+	if e, a := testBrokerTemplateName, deleteAction.GetName(); e != a {
+		t.Fatalf("unexpected name: %v", expectedGot(e, a))
+	}
+}
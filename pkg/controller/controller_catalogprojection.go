@@ -0,0 +1,310 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/golang/glog"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/filter"
+)
+
+// catalogProjectionLabel is set on the ServiceClasses and ServicePlans
+// projected by a CatalogProjection. Its value is the name of the projection.
+const catalogProjectionLabel = "servicecatalog.k8s.io/catalog-projection"
+
+func (c *controller) catalogProjectionAdd(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.catalogProjectionQueue.Add(key)
+}
+
+// catalogProjectionUpdate also handles the periodic resync of the informer,
+// which is what picks up changes to the cluster catalog and to namespaces
+// since the last reconcile.
+func (c *controller) catalogProjectionUpdate(oldObj, newObj interface{}) {
+	c.catalogProjectionAdd(newObj)
+}
+
+func (c *controller) catalogProjectionDelete(obj interface{}) {
+	projection, ok := obj.(*v1beta1.CatalogProjection)
+	if projection == nil || !ok {
+		return
+	}
+
+	// The projected ServiceClasses and ServicePlans are owned by the
+	// projection and are removed by the garbage collector.
+	glog.V(4).Infof("Received delete event for CatalogProjection %v; no further processing will occur", projection.Name)
+}
+
+func (c *controller) reconcileCatalogProjectionKey(key string) error {
+	projection, err := c.catalogProjectionLister.Get(key)
+	if errors.IsNotFound(err) {
+		glog.Infof("CatalogProjection %q: Not doing work because it has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		glog.Infof("CatalogProjection %q: Unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	return c.reconcileCatalogProjection(projection)
+}
+
+// reconcileCatalogProjection is the control-loop that reconciles a
+// CatalogProjection. It makes sure that every namespace selected by the
+// projection has a ServiceClass and ServicePlan copy of every selected
+// ClusterServiceClass and ClusterServicePlan, and that the copies that are no
+// longer selected are deleted.
+func (c *controller) reconcileCatalogProjection(projection *v1beta1.CatalogProjection) error {
+	glog.V(4).Infof("CatalogProjection %q: Processing", projection.Name)
+
+	serviceClasses, servicePlans, err := c.getProjectedCatalog(projection)
+	if err != nil {
+		glog.Errorf("CatalogProjection %q: Error selecting the classes and plans to project: %v", projection.Name, err)
+		return err
+	}
+
+	selected, err := c.listSelectedNamespaces(projection.Spec.NamespaceSelector)
+	if err != nil {
+		glog.Errorf("CatalogProjection %q: Error listing namespaces: %v", projection.Name, err)
+		return err
+	}
+
+	var errs []error
+	// projectedClasses and projectedPlans hold the namespace/name keys of the
+	// classes and plans that the projection should have created.
+	projectedClasses := sets.NewString()
+	projectedPlans := sets.NewString()
+	for _, namespace := range selected.List() {
+		for _, clusterServiceClass := range serviceClasses {
+			projectedClasses.Insert(namespace + "/" + clusterServiceClass.Name)
+			if err := c.applyProjectedServiceClass(projection, clusterServiceClass, namespace); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		for _, clusterServicePlan := range servicePlans {
+			projectedPlans.Insert(namespace + "/" + clusterServicePlan.Name)
+			if err := c.applyProjectedServicePlan(projection, clusterServicePlan, namespace); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{catalogProjectionLabel: projection.Name})
+
+	existingPlans, err := c.servicePlanLister.ServicePlans(metav1.NamespaceAll).List(selector)
+	if err != nil {
+		return utilerrors.NewAggregate(append(errs, err))
+	}
+	for _, plan := range existingPlans {
+		if projectedPlans.Has(plan.Namespace+"/"+plan.Name) || !metav1.IsControlledBy(plan, projection) {
+			continue
+		}
+		glog.V(4).Infof("CatalogProjection %q: Deleting ServicePlan %s/%s that is no longer projected", projection.Name, plan.Namespace, plan.Name)
+		err := c.serviceCatalogClient.ServicePlans(plan.Namespace).Delete(plan.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	existingClasses, err := c.serviceClassLister.ServiceClasses(metav1.NamespaceAll).List(selector)
+	if err != nil {
+		return utilerrors.NewAggregate(append(errs, err))
+	}
+	for _, class := range existingClasses {
+		if projectedClasses.Has(class.Namespace+"/"+class.Name) || !metav1.IsControlledBy(class, projection) {
+			continue
+		}
+		glog.V(4).Infof("CatalogProjection %q: Deleting ServiceClass %s/%s that is no longer projected", projection.Name, class.Namespace, class.Name)
+		err := c.serviceCatalogClient.ServiceClasses(class.Namespace).Delete(class.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// getProjectedCatalog returns the ClusterServiceClasses and
+// ClusterServicePlans selected by the catalog restrictions of the given
+// projection. As for the catalog of a broker, a class is only selected along
+// with at least one of its plans. Classes and plans that have been removed
+// from their broker's catalog are never selected.
+func (c *controller) getProjectedCatalog(projection *v1beta1.CatalogProjection) ([]*v1beta1.ClusterServiceClass, []*v1beta1.ClusterServicePlan, error) {
+	restrictions := projection.Spec.CatalogRestrictions
+
+	var predicate filter.Predicate
+	var err error
+	if restrictions != nil && len(restrictions.ServiceClass) > 0 {
+		predicate, err = filter.CreatePredicate(restrictions.ServiceClass)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		predicate = filter.NewPredicate()
+	}
+
+	allClasses, err := c.clusterServiceClassLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	allPlans, err := c.clusterServicePlanLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	plansByClass := make(map[string][]*v1beta1.ClusterServicePlan)
+	for _, plan := range allPlans {
+		if !plan.Status.RemovedFromBrokerCatalog {
+			plansByClass[plan.Spec.ClusterServiceClassRef.Name] = append(plansByClass[plan.Spec.ClusterServiceClassRef.Name], plan)
+		}
+	}
+
+	serviceClasses := []*v1beta1.ClusterServiceClass(nil)
+	servicePlans := []*v1beta1.ClusterServicePlan(nil)
+	for _, class := range allClasses {
+		if class.Status.RemovedFromBrokerCatalog || !predicate.Accepts(v1beta1.ConvertClusterServiceClassToProperties(class)) || !acceptsServiceClassExternalID(restrictions, class.Spec.ExternalID) {
+			continue
+		}
+		acceptedPlans, _, err := filterServicePlans(restrictions, plansByClass[class.Name])
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(acceptedPlans) > 0 {
+			serviceClasses = append(serviceClasses, class)
+			servicePlans = append(servicePlans, acceptedPlans...)
+		}
+	}
+	return serviceClasses, servicePlans, nil
+}
+
+// applyProjectedServiceClass creates or updates the projection of the given
+// ClusterServiceClass in the given namespace. A ServiceClass of the same name
+// that was not created by the projection is left alone.
+func (c *controller) applyProjectedServiceClass(projection *v1beta1.CatalogProjection, clusterServiceClass *v1beta1.ClusterServiceClass, namespace string) error {
+	desired := &v1beta1.ServiceClass{
+		ObjectMeta: newCatalogProjectionObjectMeta(projection, clusterServiceClass.Name, namespace),
+		Spec: v1beta1.ServiceClassSpec{
+			CommonServiceClassSpec: clusterServiceClass.Spec.CommonServiceClassSpec,
+			ServiceBrokerName:      clusterServiceClass.Spec.ClusterServiceBrokerName,
+			ClusterServiceBroker:   true,
+		},
+	}
+
+	existing, err := c.serviceClassLister.ServiceClasses(namespace).Get(desired.Name)
+	if errors.IsNotFound(err) {
+		glog.V(4).Infof("CatalogProjection %q: Creating ServiceClass %s/%s", projection.Name, namespace, desired.Name)
+		_, err := c.serviceCatalogClient.ServiceClasses(namespace).Create(desired)
+		if err != nil && !errors.IsAlreadyExists(err) {
+			glog.Errorf("CatalogProjection %q: Error creating ServiceClass %s/%s: %v", projection.Name, namespace, desired.Name, err)
+			return err
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(existing, projection) {
+		glog.Warningf("CatalogProjection %q: Not updating ServiceClass %s/%s because it was not created by the projection", projection.Name, namespace, desired.Name)
+		return nil
+	}
+	if apiequality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+		return nil
+	}
+
+	toUpdate := existing.DeepCopy()
+	toUpdate.Spec = desired.Spec
+	glog.V(4).Infof("CatalogProjection %q: Updating ServiceClass %s/%s", projection.Name, namespace, desired.Name)
+	if _, err := c.serviceCatalogClient.ServiceClasses(namespace).Update(toUpdate); err != nil {
+		glog.Errorf("CatalogProjection %q: Error updating ServiceClass %s/%s: %v", projection.Name, namespace, desired.Name, err)
+		return err
+	}
+	return nil
+}
+
+// applyProjectedServicePlan creates or updates the projection of the given
+// ClusterServicePlan in the given namespace. A ServicePlan of the same name
+// that was not created by the projection is left alone.
+func (c *controller) applyProjectedServicePlan(projection *v1beta1.CatalogProjection, clusterServicePlan *v1beta1.ClusterServicePlan, namespace string) error {
+	desired := &v1beta1.ServicePlan{
+		ObjectMeta: newCatalogProjectionObjectMeta(projection, clusterServicePlan.Name, namespace),
+		Spec: v1beta1.ServicePlanSpec{
+			CommonServicePlanSpec: clusterServicePlan.Spec.CommonServicePlanSpec,
+			ServiceBrokerName:     clusterServicePlan.Spec.ClusterServiceBrokerName,
+			ServiceClassRef: v1beta1.LocalObjectReference{
+				Name: clusterServicePlan.Spec.ClusterServiceClassRef.Name,
+			},
+			ClusterServiceBroker: true,
+		},
+	}
+
+	existing, err := c.servicePlanLister.ServicePlans(namespace).Get(desired.Name)
+	if errors.IsNotFound(err) {
+		glog.V(4).Infof("CatalogProjection %q: Creating ServicePlan %s/%s", projection.Name, namespace, desired.Name)
+		_, err := c.serviceCatalogClient.ServicePlans(namespace).Create(desired)
+		if err != nil && !errors.IsAlreadyExists(err) {
+			glog.Errorf("CatalogProjection %q: Error creating ServicePlan %s/%s: %v", projection.Name, namespace, desired.Name, err)
+			return err
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(existing, projection) {
+		glog.Warningf("CatalogProjection %q: Not updating ServicePlan %s/%s because it was not created by the projection", projection.Name, namespace, desired.Name)
+		return nil
+	}
+	if apiequality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+		return nil
+	}
+
+	toUpdate := existing.DeepCopy()
+	toUpdate.Spec = desired.Spec
+	glog.V(4).Infof("CatalogProjection %q: Updating ServicePlan %s/%s", projection.Name, namespace, desired.Name)
+	if _, err := c.serviceCatalogClient.ServicePlans(namespace).Update(toUpdate); err != nil {
+		glog.Errorf("CatalogProjection %q: Error updating ServicePlan %s/%s: %v", projection.Name, namespace, desired.Name, err)
+		return err
+	}
+	return nil
+}
+
+func newCatalogProjectionObjectMeta(projection *v1beta1.CatalogProjection, name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels: map[string]string{
+			catalogProjectionLabel: projection.Name,
+		},
+		OwnerReferences: []metav1.OwnerReference{
+			*metav1.NewControllerRef(projection, v1beta1.SchemeGroupVersion.WithKind("CatalogProjection")),
+		},
+	}
+}
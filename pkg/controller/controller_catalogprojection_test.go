@@ -0,0 +1,201 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+)
+
+const (
+	testCatalogProjectionName      = "test-catalogprojection"
+	testCatalogProjectionUID       = "catalogprojection-uid"
+	testCatalogProjectionNamespace = "tenant-a"
+)
+
+func getTestCatalogProjection() *v1beta1.CatalogProjection {
+	return &v1beta1.CatalogProjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testCatalogProjectionName,
+			UID:  testCatalogProjectionUID,
+		},
+		Spec: v1beta1.CatalogProjectionSpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tenant": "true"},
+			},
+			CatalogRestrictions: &v1beta1.CatalogRestrictions{
+				ServiceClass: []string{fmt.Sprintf("spec.externalName==%s", testClusterServiceClassName)},
+			},
+		},
+	}
+}
+
+func getTestCatalogProjectionNamespace() corev1.Namespace {
+	return corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   testCatalogProjectionNamespace,
+			Labels: map[string]string{"tenant": "true"},
+		},
+	}
+}
+
+// TestReconcileCatalogProjectionCreatesClassesAndPlans tests that the selected
+// cluster classes and plans are copied into every selected namespace.
+func TestReconcileCatalogProjectionCreatesClassesAndPlans(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	addListNamespacesReaction(fakeKubeClient, getTestCatalogProjectionNamespace())
+
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestMarkedAsRemovedClusterServiceClass())
+
+	projection := getTestCatalogProjection()
+	projection.Spec.CatalogRestrictions = nil
+	if err := testController.reconcileCatalogProjection(projection); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+
+	assertActionEquals(t, actions[0], "create", "serviceclasses")
+	class := actions[0].(clientgotesting.CreateAction).GetObject().(*v1beta1.ServiceClass)
+	if e, a := testCatalogProjectionNamespace, class.Namespace; e != a {
+		t.Fatalf("unexpected namespace: %v", expectedGot(e, a))
+	}
+	if e, a := testClusterServiceClassGUID, class.Name; e != a {
+		t.Fatalf("unexpected name: %v", expectedGot(e, a))
+	}
+	if e, a := testClusterServiceClassName, class.Spec.ExternalName; e != a {
+		t.Fatalf("unexpected external name: %v", expectedGot(e, a))
+	}
+	if e, a := testClusterServiceBrokerName, class.Spec.ServiceBrokerName; e != a {
+		t.Fatalf("unexpected broker name: %v", expectedGot(e, a))
+	}
+	if !class.Spec.ClusterServiceBroker {
+		t.Fatalf("expected ServiceClass to reference a ClusterServiceBroker")
+	}
+	if e, a := testCatalogProjectionName, class.Labels[catalogProjectionLabel]; e != a {
+		t.Fatalf("unexpected projection label: %v", expectedGot(e, a))
+	}
+	if !metav1.IsControlledBy(class, projection) {
+		t.Fatalf("expected ServiceClass to be controlled by the projection")
+	}
+
+	assertActionEquals(t, actions[1], "create", "serviceplans")
+	plan := actions[1].(clientgotesting.CreateAction).GetObject().(*v1beta1.ServicePlan)
+	if e, a := testCatalogProjectionNamespace, plan.Namespace; e != a {
+		t.Fatalf("unexpected namespace: %v", expectedGot(e, a))
+	}
+	if e, a := testClusterServicePlanGUID, plan.Name; e != a {
+		t.Fatalf("unexpected name: %v", expectedGot(e, a))
+	}
+	if e, a := testClusterServiceClassGUID, plan.Spec.ServiceClassRef.Name; e != a {
+		t.Fatalf("unexpected class reference: %v", expectedGot(e, a))
+	}
+	if !plan.Spec.ClusterServiceBroker {
+		t.Fatalf("expected ServicePlan to reference a ClusterServiceBroker")
+	}
+	if !metav1.IsControlledBy(plan, projection) {
+		t.Fatalf("expected ServicePlan to be controlled by the projection")
+	}
+}
+
+// TestReconcileCatalogProjectionDeletesClassesAndPlans tests that projected
+// classes and plans that are no longer selected are deleted, and that those
+// not created by the projection are left alone.
+func TestReconcileCatalogProjectionDeletesClassesAndPlans(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	addListNamespacesReaction(fakeKubeClient, getTestCatalogProjectionNamespace())
+
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	projection := getTestCatalogProjection()
+	projection.Spec.CatalogRestrictions.ServiceClass = []string{"spec.externalName==other"}
+
+	projectedClass := getTestServiceClass()
+	projectedClass.ObjectMeta = newCatalogProjectionObjectMeta(projection, testServiceClassGUID, testCatalogProjectionNamespace)
+	sharedInformers.ServiceClasses().Informer().GetStore().Add(projectedClass)
+
+	projectedPlan := getTestServicePlan()
+	projectedPlan.ObjectMeta = newCatalogProjectionObjectMeta(projection, testServicePlanGUID, testCatalogProjectionNamespace)
+	sharedInformers.ServicePlans().Informer().GetStore().Add(projectedPlan)
+
+	unownedClass := getTestServiceClass()
+	unownedClass.Namespace = testCatalogProjectionNamespace
+	unownedClass.Name = "unowned"
+	unownedClass.Labels = map[string]string{catalogProjectionLabel: projection.Name}
+	sharedInformers.ServiceClasses().Informer().GetStore().Add(unownedClass)
+
+	if err := testController.reconcileCatalogProjection(projection); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+	assertActionEquals(t, actions[0], "delete", "serviceplans")
+	if e, a := testServicePlanGUID, actions[0].(clientgotesting.DeleteAction).GetName(); e != a {
+		t.Fatalf("unexpected plan deleted: %v", expectedGot(e, a))
+	}
+	assertActionEquals(t, actions[1], "delete", "serviceclasses")
+	if e, a := testServiceClassGUID, actions[1].(clientgotesting.DeleteAction).GetName(); e != a {
+		t.Fatalf("unexpected class deleted: %v", expectedGot(e, a))
+	}
+}
+
+// TestGetServiceClassAndServiceBrokerForProjectedClass tests that an instance
+// of a projected class is sent to the ClusterServiceBroker offering the
+// ClusterServiceClass it was projected from.
+func TestGetServiceClassAndServiceBrokerForProjectedClass(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+
+	projectedClass := getTestServiceClass()
+	projectedClass.Spec.ServiceBrokerName = testClusterServiceBrokerName
+	projectedClass.Spec.ClusterServiceBroker = true
+	sharedInformers.ServiceClasses().Informer().GetStore().Add(projectedClass)
+
+	_, brokerName, brokerClient, err := testController.getServiceClassAndServiceBroker(getTestServiceInstanceWithNamespacedRefs())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := testClusterServiceBrokerName, brokerName; e != a {
+		t.Fatalf("unexpected broker name: %v", expectedGot(e, a))
+	}
+	if brokerClient == nil {
+		t.Fatalf("expected a client for the ClusterServiceBroker")
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"strings"
 	"sync"
 	"testing"
 
@@ -215,3 +216,79 @@ func TestMonitorConfigMapConfigmapWithOtherData(t *testing.T) {
 		t.Fatalf("configmap should have been updated with the existing clusterid")
 	}
 }
+
+// TestMonitorConfigMapConfiguredClusterID checks that a cluster ID the
+// controller was started with replaces the one of the configmap, and that
+// the change is recorded as an event.
+func TestMonitorConfigMapConfiguredClusterID(t *testing.T) {
+	kc, _, _, tc, _ := newTestController(t, noFakeActions())
+	kc.AddReactor("get", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultClusterIDConfigMapName,
+			},
+			Data: map[string]string{"id": "old-cluster-id"},
+		}, nil
+	})
+	tc.configuredClusterID = testClusterID
+	tc.setClusterID(testClusterID)
+	tc.monitorConfigMap()
+	if tc.getClusterID() != testClusterID {
+		t.Fatalf("should have kept the configured cluster id")
+	}
+	if expectedCMupdate := kc.Actions()[1]; expectedCMupdate.GetVerb() == "update" {
+		updatedCM := expectedCMupdate.(clientgotesting.UpdateAction).GetObject().(*corev1.ConfigMap)
+		if id := updatedCM.Data["id"]; id != testClusterID {
+			t.Fatalf("configmap should have been updated with the configured clusterid, was %q, expected %q", id, testClusterID)
+		}
+	} else {
+		t.Fatalf("configmap should have been updated with the configured clusterid")
+	}
+	events := getRecordedEvents(tc)
+	expectedEvent := normalEventBuilder(clusterIDChangedReason).msgf("Cluster ID changed from %q to %q", "old-cluster-id", testClusterID)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestMonitorConfigMapInvalidClusterID checks that an invalid ID in the
+// configmap is not sent to brokers.
+func TestMonitorConfigMapInvalidClusterID(t *testing.T) {
+	kc, _, _, tc, _ := newTestController(t, noFakeActions())
+	kc.AddReactor("get", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultClusterIDConfigMapName,
+			},
+			Data: map[string]string{"id": "not a cluster id"},
+		}, nil
+	})
+	tc.setClusterID(testClusterID)
+	tc.monitorConfigMap()
+	if tc.getClusterID() != testClusterID {
+		t.Fatalf("should have kept the existing cluster id")
+	}
+	events := getRecordedEvents(tc)
+	if len(events) != 1 || !strings.HasPrefix(events[0], corev1.EventTypeWarning+" "+invalidClusterIDReason) {
+		t.Fatalf("expected an %v event, got %v", invalidClusterIDReason, events)
+	}
+}
+
+func TestValidateClusterID(t *testing.T) {
+	cases := []struct {
+		id    string
+		valid bool
+	}{
+		{"a7d8e2a4-9b6c-4f3e-8d2b-1c0e5f6a7b8c", true},
+		{"prod-us-east.cluster_1", true},
+		{"", false},
+		{"-leading-dash", false},
+		{"with space", false},
+		{strings.Repeat("a", maxClusterIDLength+1), false},
+	}
+	for _, tc := range cases {
+		if err := validateClusterID(tc.id); (err == nil) != tc.valid {
+			t.Errorf("%q: expected valid %v, got error %v", tc.id, tc.valid, err)
+		}
+	}
+}
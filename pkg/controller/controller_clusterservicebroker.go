@@ -17,11 +17,15 @@ limitations under the License.
 package controller
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -59,6 +63,8 @@ const (
 	successFetchedCatalogReason           string = "FetchedCatalog"
 	successFetchedCatalogMessage          string = "Successfully fetched catalog entries from broker."
 	errorReconciliationRetryTimeoutReason string = "ErrorReconciliationRetryTimeout"
+	errorUnsupportedOSBAPIVersionReason   string = "UnsupportedOSBAPIVersion"
+	errorUnsupportedOSBAPIVersionMessage  string = "The broker does not support the Open Service Broker API version used by the catalog."
 )
 
 func (c *controller) clusterServiceBrokerAdd(obj interface{}) {
@@ -99,6 +105,12 @@ func shouldReconcileClusterServiceBroker(broker *v1beta1.ClusterServiceBroker, n
 		// If the spec has changed, we should reconcile the broker.
 		return true
 	}
+	if broker.Status.RelistRequested(broker.Annotations) {
+		// A relist was requested through the annotation and has not been
+		// honored yet.
+		glog.V(4).Info(pcb.Message("Processing because a relist was requested"))
+		return true
+	}
 	if broker.DeletionTimestamp != nil || len(broker.Status.Conditions) == 0 {
 		// If the deletion timestamp is set or the broker has no status
 		// conditions, we should reconcile it.
@@ -181,7 +193,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 	}
 
 	if broker.DeletionTimestamp == nil { // Add or update
-		authConfig, err := getAuthCredentialsFromClusterServiceBroker(c.kubeClient, broker)
+		clientConfig, err := getClientConfigurationForClusterServiceBroker(c.kubeClient, broker)
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker auth credentials: %s", err)
 			glog.Info(pcb.Message(s))
@@ -192,10 +204,8 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			return err
 		}
 
-		clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-
 		glog.V(4).Info(pcb.Messagef("Creating client, URL: %v", broker.Spec.URL))
-		brokerClient, err := c.brokerClientCreateFunc(clientConfig)
+		brokerClient, err := c.newBrokerClient(clientConfig, broker.Spec.FailoverURLs)
 		if err != nil {
 			s := fmt.Sprintf("Error creating client for broker %q: %s", broker.Name, err)
 			glog.Info(pcb.Message(s))
@@ -210,7 +220,16 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 
 		// get the broker's catalog
 		now := metav1.Now()
-		brokerCatalog, err := brokerClient.GetCatalog()
+		brokerCatalog, instancesRetrievable, err := fetchBrokerCatalog(brokerClient)
+		if isUnsupportedAPIVersionError(err) {
+			// Retrying does not help until the broker or the catalog is
+			// upgraded, so the broker is only checked again on resync.
+			s := unsupportedAPIVersionMessage(clientConfig.ClientConfiguration)
+			glog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, errorUnsupportedOSBAPIVersionReason, s)
+			metrics.BrokerUnsupportedOSBAPIVersion.WithLabelValues(broker.Name).Set(1)
+			return c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorUnsupportedOSBAPIVersionReason, s)
+		}
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker catalog: %s", err)
 			glog.Warning(pcb.Message(s))
@@ -242,6 +261,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 		}
 
 		glog.V(5).Info(pcb.Messagef("Successfully fetched %v catalog entries", len(brokerCatalog.Services)))
+		c.storeClusterServiceBrokerCatalog(broker, brokerCatalog, now)
 
 		// set the operation start time if not already set
 		if broker.Status.OperationStartTime != nil {
@@ -253,6 +273,17 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			}
 		}
 
+		brokerCatalog, err = c.mutateCatalog("ClusterServiceBroker", broker.ObjectMeta, brokerCatalog)
+		if err != nil {
+			s := fmt.Sprintf("Error mutating catalog payload for broker %q: %s", broker.Name, err)
+			glog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
+			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
+				return err
+			}
+			return err
+		}
+
 		// convert the broker's catalog payload into our API objects
 		glog.V(4).Info(pcb.Message("Converting catalog response into service-catalog API"))
 
@@ -267,6 +298,10 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			return err
 		}
 
+		for _, serviceClass := range payloadServiceClasses {
+			serviceClass.Spec.InstancesRetrievable = instancesRetrievable[serviceClass.Spec.ExternalID]
+		}
+
 		glog.V(5).Info(pcb.Message("Successfully converted catalog payload from to service-catalog API"))
 
 		// get the existing services and plans for this broker so that we can
@@ -280,34 +315,16 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 		existingServiceClassMap := convertClusterServiceClassListToMap(existingServiceClasses)
 		existingServicePlanMap := convertClusterServicePlanListToMap(existingServicePlans)
 
-		// reconcile the serviceClasses that were part of the broker's catalog
-		// payload
+		// handle the serviceClasses that were not in the broker's payload;
+		// mark these as having been removed from the broker's catalog. This
+		// is done first, so that a class that the broker replaced with a class
+		// of the same external name is not in the way of the new class.
+		payloadServiceClassNames := sets.NewString()
 		for _, payloadServiceClass := range payloadServiceClasses {
-			existingServiceClass, _ := existingServiceClassMap[payloadServiceClass.Name]
-			delete(existingServiceClassMap, payloadServiceClass.Name)
-
-			glog.V(4).Info(pcb.Messagef("Reconciling %s", pretty.ClusterServiceClassName(payloadServiceClass)))
-			if err := c.reconcileClusterServiceClassFromClusterServiceBrokerCatalog(broker, payloadServiceClass, existingServiceClass); err != nil {
-				s := fmt.Sprintf(
-					"Error reconciling %s (broker %q): %s",
-					pretty.ClusterServiceClassName(payloadServiceClass), broker.Name, err,
-				)
-				glog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s); err != nil {
-					return err
-				}
-				return err
-			}
-
-			glog.V(5).Info(pcb.Messagef("Reconciled %s", pretty.ClusterServiceClassName(payloadServiceClass)))
+			payloadServiceClassNames.Insert(payloadServiceClass.Name)
 		}
-
-		// handle the serviceClasses that were not in the broker's payload;
-		// mark these as having been removed from the broker's catalog
 		for _, existingServiceClass := range existingServiceClassMap {
-			if existingServiceClass.Status.RemovedFromBrokerCatalog {
+			if payloadServiceClassNames.Has(existingServiceClass.Name) || existingServiceClass.Status.RemovedFromBrokerCatalog {
 				continue
 			}
 
@@ -318,6 +335,9 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 
 			glog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ClusterServiceClassName(existingServiceClass)))
 			existingServiceClass.Status.RemovedFromBrokerCatalog = true
+			if gracePeriod := getCatalogRemovalGracePeriod(&broker.Spec.CommonServiceBrokerSpec); gracePeriod > 0 {
+				setPendingRemoval(&existingServiceClass.Status.PendingRemoval, &existingServiceClass.Status.RemovalTime, now.Add(gracePeriod))
+			}
 			_, err := c.serviceCatalogClient.ClusterServiceClasses().UpdateStatus(existingServiceClass)
 			if err != nil {
 				s := fmt.Sprintf(
@@ -334,6 +354,30 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			}
 		}
 
+		// reconcile the serviceClasses that were part of the broker's catalog
+		// payload
+		for _, payloadServiceClass := range payloadServiceClasses {
+			existingServiceClass, _ := existingServiceClassMap[payloadServiceClass.Name]
+			delete(existingServiceClassMap, payloadServiceClass.Name)
+
+			glog.V(4).Info(pcb.Messagef("Reconciling %s", pretty.ClusterServiceClassName(payloadServiceClass)))
+			if err := c.reconcileClusterServiceClassFromClusterServiceBrokerCatalog(broker, payloadServiceClass, existingServiceClass); err != nil {
+				s := fmt.Sprintf(
+					"Error reconciling %s (broker %q): %s",
+					pretty.ClusterServiceClassName(payloadServiceClass), broker.Name, err,
+				)
+				glog.Warning(pcb.Message(s))
+				c.recorder.Event(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
+				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+					errorSyncingCatalogMessage+s); err != nil {
+					return err
+				}
+				return err
+			}
+
+			glog.V(5).Info(pcb.Messagef("Reconciled %s", pretty.ClusterServiceClassName(payloadServiceClass)))
+		}
+
 		// reconcile the plans that were part of the broker's catalog payload
 		for _, payloadServicePlan := range payloadServicePlans {
 			existingServicePlan, _ := existingServicePlanMap[payloadServicePlan.Name]
@@ -372,6 +416,9 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 
 			glog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ClusterServicePlanName(existingServicePlan)))
 			existingServicePlan.Status.RemovedFromBrokerCatalog = true
+			if gracePeriod := getCatalogRemovalGracePeriod(&broker.Spec.CommonServiceBrokerSpec); gracePeriod > 0 {
+				setPendingRemoval(&existingServicePlan.Status.PendingRemoval, &existingServicePlan.Status.RemovalTime, now.Add(gracePeriod))
+			}
 			_, err := c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(existingServicePlan)
 			if err != nil {
 				s := fmt.Sprintf(
@@ -389,9 +436,17 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			}
 		}
 
-		// everything worked correctly; update the broker's ready condition to
-		// status true
-		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+		// apply the broker's catalog removal policy to the instances of the
+		// classes and plans that have been removed from its catalog
+		if err := c.applyCatalogRemovalPolicyToClusterServiceInstances(broker, payloadServiceClasses, existingServiceClassMap, existingServicePlanMap); err != nil {
+			return err
+		}
+
+		// everything worked correctly; record the catalog statistics and
+		// update the broker's ready condition to status true
+		toUpdate := broker.DeepCopy()
+		setCatalogStatistics(&toUpdate.Status.CommonServiceBrokerStatus, brokerCatalog, clientConfig.ClientConfiguration, len(payloadServiceClasses), len(payloadServicePlans), now.Time)
+		if err := c.updateClusterServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
 			return err
 		}
 
@@ -400,6 +455,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 		// Update metrics with the number of serviceclass and serviceplans from this broker
 		metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(len(payloadServiceClasses)))
 		metrics.BrokerServicePlanCount.WithLabelValues(broker.Name).Set(float64(len(payloadServicePlans)))
+		metrics.BrokerUnsupportedOSBAPIVersion.WithLabelValues(broker.Name).Set(0)
 
 		return nil
 	}
@@ -415,6 +471,12 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			return err
 		}
 
+		// keep the classes and plans until the broker's catalog removal
+		// grace period has passed
+		if delay, err := c.scheduleClusterServiceBrokerCatalogRemoval(broker, existingServiceClasses, existingServicePlans); err != nil || delay > 0 {
+			return err
+		}
+
 		glog.V(4).Info(pcb.Messagef("Found %d ClusterServiceClasses and %d ClusterServicePlans to delete", len(existingServiceClasses), len(existingServicePlans)))
 
 		for _, plan := range existingServicePlans {
@@ -474,6 +536,8 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 		// delete the metrics associated with this broker
 		metrics.BrokerServiceClassCount.DeleteLabelValues(broker.Name)
 		metrics.BrokerServicePlanCount.DeleteLabelValues(broker.Name)
+		metrics.BrokerUnsupportedOSBAPIVersion.DeleteLabelValues(broker.Name)
+		metrics.BrokerStaleServiceInstanceCount.DeleteLabelValues(broker.Name)
 		return nil
 	}
 
@@ -536,6 +600,7 @@ func (c *controller) reconcileClusterServiceClassFromClusterServiceBrokerCatalog
 	// update it.
 	toUpdate := existingServiceClass.DeepCopy()
 	toUpdate.Spec.BindingRetrievable = serviceClass.Spec.BindingRetrievable
+	toUpdate.Spec.InstancesRetrievable = serviceClass.Spec.InstancesRetrievable
 	toUpdate.Spec.Bindable = serviceClass.Spec.Bindable
 	toUpdate.Spec.PlanUpdatable = serviceClass.Spec.PlanUpdatable
 	toUpdate.Spec.Tags = serviceClass.Spec.Tags
@@ -543,6 +608,9 @@ func (c *controller) reconcileClusterServiceClassFromClusterServiceBrokerCatalog
 	toUpdate.Spec.Requires = serviceClass.Spec.Requires
 	toUpdate.Spec.ExternalName = serviceClass.Spec.ExternalName
 	toUpdate.Spec.ExternalMetadata = serviceClass.Spec.ExternalMetadata
+	toUpdate.Spec.DocumentationURL = serviceClass.Spec.DocumentationURL
+	toUpdate.Spec.SupportURL = serviceClass.Spec.SupportURL
+	setExternalDocumentationAnnotations(toUpdate, toUpdate.Spec.DocumentationURL, toUpdate.Spec.SupportURL)
 
 	markAsServiceCatalogManagedResource(toUpdate, broker)
 
@@ -555,6 +623,7 @@ func (c *controller) reconcileClusterServiceClassFromClusterServiceBrokerCatalog
 	if updatedServiceClass.Status.RemovedFromBrokerCatalog {
 		glog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ClusterServiceClassName(serviceClass)))
 		updatedServiceClass.Status.RemovedFromBrokerCatalog = false
+		clearPendingRemoval(&updatedServiceClass.Status.PendingRemoval, &updatedServiceClass.Status.RemovalTime)
 		_, err := c.serviceCatalogClient.ClusterServiceClasses().UpdateStatus(updatedServiceClass)
 		if err != nil {
 			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ClusterServiceClassName(updatedServiceClass), err)
@@ -629,6 +698,9 @@ func (c *controller) reconcileClusterServicePlanFromClusterServiceBrokerCatalog(
 	toUpdate.Spec.Free = servicePlan.Spec.Free
 	toUpdate.Spec.ExternalName = servicePlan.Spec.ExternalName
 	toUpdate.Spec.ExternalMetadata = servicePlan.Spec.ExternalMetadata
+	toUpdate.Spec.DocumentationURL = servicePlan.Spec.DocumentationURL
+	toUpdate.Spec.SupportURL = servicePlan.Spec.SupportURL
+	setExternalDocumentationAnnotations(toUpdate, toUpdate.Spec.DocumentationURL, toUpdate.Spec.SupportURL)
 	toUpdate.Spec.ServiceInstanceCreateParameterSchema = servicePlan.Spec.ServiceInstanceCreateParameterSchema
 	toUpdate.Spec.ServiceInstanceUpdateParameterSchema = servicePlan.Spec.ServiceInstanceUpdateParameterSchema
 	toUpdate.Spec.ServiceBindingCreateParameterSchema = servicePlan.Spec.ServiceBindingCreateParameterSchema
@@ -643,6 +715,7 @@ func (c *controller) reconcileClusterServicePlanFromClusterServiceBrokerCatalog(
 
 	if updatedPlan.Status.RemovedFromBrokerCatalog {
 		updatedPlan.Status.RemovedFromBrokerCatalog = false
+		clearPendingRemoval(&updatedPlan.Status.PendingRemoval, &updatedPlan.Status.RemovalTime)
 		glog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ClusterServicePlanName(updatedPlan)))
 
 		_, err := c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(updatedPlan)
@@ -701,6 +774,8 @@ func (c *controller) updateClusterServiceBrokerCondition(broker *v1beta1.Cluster
 
 	if conditionType == v1beta1.ServiceBrokerConditionReady && status == v1beta1.ConditionTrue {
 		toUpdate.Status.ReconciledGeneration = toUpdate.Generation
+		toUpdate.Status.ObservedRelistRequests = toUpdate.Spec.RelistRequests
+		toUpdate.Status.ObservedRelistRequestedAt = toUpdate.Annotations[v1beta1.RelistRequestedAtAnnotation]
 		now := metav1.NewTime(t)
 		toUpdate.Status.LastCatalogRetrievalTime = &now
 	}
@@ -743,6 +818,61 @@ func (c *controller) updateClusterServiceBrokerFinalizers(
 	return err
 }
 
+// scheduleClusterServiceBrokerCatalogRemoval marks the classes and plans of a
+// ClusterServiceBroker that is being deleted as pending removal until its
+// CatalogRemovalGracePeriod has passed since the deletion. It returns how long
+// the deletion of the classes and plans must still be delayed; the broker is
+// requeued to be finalized once that time has passed.
+func (c *controller) scheduleClusterServiceBrokerCatalogRemoval(broker *v1beta1.ClusterServiceBroker, serviceClasses []v1beta1.ClusterServiceClass, servicePlans []v1beta1.ClusterServicePlan) (time.Duration, error) {
+	gracePeriod := getCatalogRemovalGracePeriod(&broker.Spec.CommonServiceBrokerSpec)
+	if gracePeriod <= 0 {
+		return 0, nil
+	}
+	removalTime := broker.DeletionTimestamp.Add(gracePeriod)
+	delay := removalTime.Sub(time.Now())
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
+
+	for i := range serviceClasses {
+		serviceClass := &serviceClasses[i]
+		if !setPendingRemoval(&serviceClass.Status.PendingRemoval, &serviceClass.Status.RemovalTime, removalTime) {
+			continue
+		}
+		glog.V(4).Info(pcb.Messagef("Marking %s as pending removal", pretty.ClusterServiceClassName(serviceClass)))
+		if _, err := c.serviceCatalogClient.ClusterServiceClasses().UpdateStatus(serviceClass); err != nil {
+			glog.Warning(pcb.Messagef("Error updating status of %s: %v", pretty.ClusterServiceClassName(serviceClass), err))
+			return 0, err
+		}
+	}
+
+	for i := range servicePlans {
+		servicePlan := &servicePlans[i]
+		if !setPendingRemoval(&servicePlan.Status.PendingRemoval, &servicePlan.Status.RemovalTime, removalTime) {
+			continue
+		}
+		glog.V(4).Info(pcb.Messagef("Marking %s as pending removal", pretty.ClusterServicePlanName(servicePlan)))
+		if _, err := c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(servicePlan); err != nil {
+			glog.Warning(pcb.Messagef("Error updating status of %s: %v", pretty.ClusterServicePlanName(servicePlan), err))
+			return 0, err
+		}
+	}
+
+	if !isServiceBrokerReadyConditionReason(broker.Status.Conditions, catalogPendingRemovalReason) {
+		s := fmt.Sprintf(catalogPendingRemovalMessage, removalTime)
+		glog.V(4).Info(pcb.Message(s))
+		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, catalogPendingRemovalReason, s); err != nil {
+			return 0, err
+		}
+		c.recorder.Event(broker, corev1.EventTypeNormal, catalogPendingRemovalReason, s)
+	}
+
+	c.clusterServiceBrokerQueue.AddAfter(broker.Name, delay)
+	return delay, nil
+}
+
 func (c *controller) getCurrentServiceClassesAndPlansForBroker(broker *v1beta1.ClusterServiceBroker) ([]v1beta1.ClusterServiceClass, []v1beta1.ClusterServicePlan, error) {
 	fieldSet := fields.Set{
 		"spec.clusterServiceBrokerName": broker.Name,
@@ -785,6 +915,79 @@ func (c *controller) getCurrentServiceClassesAndPlansForBroker(broker *v1beta1.C
 	return existingServiceClasses.Items, existingServicePlans.Items, nil
 }
 
+// setCatalogStatistics records statistics about a successful relist of a
+// broker's catalog in the given broker status.
+func setCatalogStatistics(status *v1beta1.CommonServiceBrokerStatus, catalog *osb.CatalogResponse, clientConfig *osb.ClientConfiguration, classCount, planCount int, relistStartTime time.Time) {
+	status.ClassCount = int32(classCount)
+	status.PlanCount = int32(planCount)
+	status.OSBAPIVersion = clientConfig.APIVersion.HeaderValue()
+	status.LastRelistDuration = &metav1.Duration{Duration: time.Since(relistStartTime)}
+	checksum, err := generateChecksumOfCatalog(catalog)
+	if err != nil {
+		glog.Warningf("Unable to generate checksum of catalog for broker %q: %v", clientConfig.Name, err)
+		return
+	}
+	status.LastCatalogChecksum = checksum
+}
+
+// generateChecksumOfCatalog generates a checksum for the catalog payload
+// returned by a broker. This checksum is used to determine if the catalog has
+// changed between relists.
+func generateChecksumOfCatalog(catalog *osb.CatalogResponse) (string, error) {
+	catalogAsJSON, err := json.Marshal(catalog)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(catalogAsJSON)
+	return fmt.Sprintf("%x", hash), nil
+}
+
+// applyCatalogRemovalPolicyToClusterServiceInstances applies the broker's
+// CatalogRemovalPolicy to the instances of its classes and plans.
+// removedServiceClasses and removedServicePlans hold the classes and plans
+// that were not part of the broker's catalog payload.
+func (c *controller) applyCatalogRemovalPolicyToClusterServiceInstances(broker *v1beta1.ClusterServiceBroker, payloadServiceClasses []*v1beta1.ClusterServiceClass, removedServiceClasses map[string]*v1beta1.ClusterServiceClass, removedServicePlans map[string]*v1beta1.ClusterServicePlan) error {
+	brokerServiceClassNames := sets.NewString()
+	for _, serviceClass := range payloadServiceClasses {
+		brokerServiceClassNames.Insert(serviceClass.Name)
+	}
+	for name, serviceClass := range removedServiceClasses {
+		if serviceClass.Status.RemovedFromBrokerCatalog {
+			brokerServiceClassNames.Insert(name)
+		}
+	}
+
+	var instances []*v1beta1.ServiceInstance
+	for _, name := range brokerServiceClassNames.List() {
+		classInstances, err := c.listServiceInstancesByIndex(instanceClusterServiceClassRefIndex, name)
+		if err != nil {
+			return err
+		}
+		instances = append(instances, classInstances...)
+	}
+
+	var affected, unaffected []*v1beta1.ServiceInstance
+	for _, instance := range instances {
+		removed := false
+		if serviceClass, ok := removedServiceClasses[instance.Spec.ClusterServiceClassRef.Name]; ok && serviceClass.Status.RemovedFromBrokerCatalog {
+			removed = true
+		}
+		if instance.Spec.ClusterServicePlanRef != nil {
+			if servicePlan, ok := removedServicePlans[instance.Spec.ClusterServicePlanRef.Name]; ok && servicePlan.Status.RemovedFromBrokerCatalog {
+				removed = true
+			}
+		}
+		if removed {
+			affected = append(affected, instance)
+		} else {
+			unaffected = append(unaffected, instance)
+		}
+	}
+
+	c.applyCatalogRemovalPolicy(broker.Name, &broker.Spec.CommonServiceBrokerSpec, affected, unaffected)
+	return nil
+}
+
 func convertClusterServiceClassListToMap(list []v1beta1.ClusterServiceClass) map[string]*v1beta1.ClusterServiceClass {
 	ret := make(map[string]*v1beta1.ClusterServiceClass, len(list))
 
@@ -805,16 +1008,41 @@ func convertClusterServicePlanListToMap(list []v1beta1.ClusterServicePlan) map[s
 	return ret
 }
 
-func markAsServiceCatalogManagedResource(obj metav1.Object, broker *v1beta1.ClusterServiceBroker) {
-	if isServiceCatalogManagedResource(obj) {
-		return
+// markAsServiceCatalogManagedResource sets the controller reference of the
+// given class or plan to the given ClusterServiceBroker or ServiceBroker, so
+// that the garbage collector deletes the class or plan once its broker is
+// gone. The reference does not block the deletion of the broker: the broker
+// is only gone once the controller has finalized it, after its catalog
+// removal grace period, whereas a foreground deletion that waits for the
+// class or plan would have the garbage collector delete them right away. A
+// controller reference of an earlier broker of the same name, or one that
+// blocks the deletion of its owner, is replaced.
+func markAsServiceCatalogManagedResource(obj metav1.Object, broker metav1.Object) {
+	kind := "ClusterServiceBroker"
+	if _, ok := broker.(*v1beta1.ServiceBroker); ok {
+		kind = "ServiceBroker"
 	}
 
 	var blockOwnerDeletion = false
-	controllerRef := *metav1.NewControllerRef(broker, v1beta1.SchemeGroupVersion.WithKind("ClusterServiceBroker"))
+	controllerRef := *metav1.NewControllerRef(broker, v1beta1.SchemeGroupVersion.WithKind(kind))
 	controllerRef.BlockOwnerDeletion = &blockOwnerDeletion
 
-	obj.SetOwnerReferences(append(obj.GetOwnerReferences(), controllerRef))
+	ownerRefs := obj.GetOwnerReferences()
+	for i, ref := range ownerRefs {
+		if ref.Controller == nil || !*ref.Controller || !strings.HasPrefix(ref.APIVersion, v1beta1.GroupName) {
+			continue
+		}
+		if reflect.DeepEqual(ref, controllerRef) {
+			return
+		}
+		updated := make([]metav1.OwnerReference, len(ownerRefs))
+		copy(updated, ownerRefs)
+		updated[i] = controllerRef
+		obj.SetOwnerReferences(updated)
+		return
+	}
+
+	obj.SetOwnerReferences(append(ownerRefs, controllerRef))
 }
 
 func isServiceCatalogManagedResource(resource metav1.Object) bool {
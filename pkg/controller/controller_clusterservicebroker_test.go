@@ -18,6 +18,7 @@ package controller
 
 import (
 	"errors"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -172,6 +173,29 @@ func TestShouldReconcileClusterServiceBroker(t *testing.T) {
 			now:       time.Now(),
 			reconcile: true,
 		},
+		{
+			name: "ready, manual behavior, relist requested",
+			broker: func() *v1beta1.ClusterServiceBroker {
+				broker := getTestClusterServiceBrokerWithStatus(v1beta1.ConditionTrue)
+				broker.Spec.RelistBehavior = v1beta1.ServiceBrokerRelistBehaviorManual
+				broker.Annotations = map[string]string{v1beta1.RelistRequestedAtAnnotation: "2018-06-01T00:00:00Z"}
+				return broker
+			}(),
+			now:       time.Now(),
+			reconcile: true,
+		},
+		{
+			name: "ready, manual behavior, relist request honored",
+			broker: func() *v1beta1.ClusterServiceBroker {
+				broker := getTestClusterServiceBrokerWithStatus(v1beta1.ConditionTrue)
+				broker.Spec.RelistBehavior = v1beta1.ServiceBrokerRelistBehaviorManual
+				broker.Annotations = map[string]string{v1beta1.RelistRequestedAtAnnotation: "2018-06-01T00:00:00Z"}
+				broker.Status.ObservedRelistRequestedAt = "2018-06-01T00:00:00Z"
+				return broker
+			}(),
+			now:       time.Now(),
+			reconcile: false,
+		},
 		{
 			name: "ready, duration behavior, nil duration",
 			broker: func() *v1beta1.ClusterServiceBroker {
@@ -265,12 +289,40 @@ func TestReconcileClusterServiceBrokerExistingServiceClassAndServicePlan(t *test
 	// 4 update action for broker status subresource
 	updatedClusterServiceBroker := assertUpdateStatus(t, actions[5], getTestClusterServiceBroker())
 	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+	assertClusterServiceBrokerCatalogStatistics(t, updatedClusterServiceBroker, 1, 2)
 
 	// verify no kube resources created
 	kubeActions := fakeKubeClient.Actions()
 	assertNumberOfActions(t, kubeActions, 0)
 }
 
+// TestReconcileClusterServiceBrokerRecordsRelistRequest verifies that a
+// successful relist records the relist request values it honored.
+func TestReconcileClusterServiceBrokerRecordsRelistRequest(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, getTestCatalogConfig())
+
+	broker := getTestClusterServiceBroker()
+	broker.Spec.RelistRequests = 3
+	broker.Annotations = map[string]string{v1beta1.RelistRequestedAtAnnotation: "2018-06-01T00:00:00Z"}
+
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], broker).(*v1beta1.ClusterServiceBroker)
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+	if e, a := int64(3), updatedClusterServiceBroker.Status.ObservedRelistRequests; e != a {
+		t.Fatalf("unexpected observed relist requests: %v", expectedGot(e, a))
+	}
+	if e, a := "2018-06-01T00:00:00Z", updatedClusterServiceBroker.Status.ObservedRelistRequestedAt; e != a {
+		t.Fatalf("unexpected observed relist requested at: %v", expectedGot(e, a))
+	}
+	if updatedClusterServiceBroker.Status.RelistRequested(updatedClusterServiceBroker.Annotations) {
+		t.Fatal("expected the relist request to be honored")
+	}
+}
+
 func TestReconcileClusterServiceBrokerRemovedClusterServiceClass(t *testing.T) {
 	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, getTestCatalogConfig())
 
@@ -307,8 +359,8 @@ func TestReconcileClusterServiceBrokerRemovedClusterServiceClass(t *testing.T) {
 	assertNumberOfActions(t, actions, 7)
 	assertList(t, actions[0], &v1beta1.ClusterServiceClass{}, listRestrictions)
 	assertList(t, actions[1], &v1beta1.ClusterServicePlan{}, listRestrictions)
-	assertUpdate(t, actions[2], testClusterServiceClass)
-	assertUpdateStatus(t, actions[3], testRemovedClusterServiceClass)
+	assertUpdateStatus(t, actions[2], testRemovedClusterServiceClass)
+	assertUpdate(t, actions[3], testClusterServiceClass)
 	assertCreate(t, actions[4], testClusterServicePlan)
 	assertCreate(t, actions[5], testClusterServicePlanNonbindable)
 
@@ -625,6 +677,87 @@ func TestReconcileClusterServiceBrokerDelete(t *testing.T) {
 	}
 }
 
+// TestReconcileClusterServiceBrokerDeleteWithCatalogRemovalGracePeriod
+// simulates a broker reconciliation where a broker with a catalog removal
+// grace period was marked for deletion. Results in the service class and plan
+// being marked as pending removal instead of being deleted.
+func TestReconcileClusterServiceBrokerDeleteWithCatalogRemovalGracePeriod(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, getTestCatalogConfig())
+
+	testClusterServiceClass := getTestClusterServiceClass()
+	testClusterServicePlan := getTestClusterServicePlan()
+
+	deletionTimestamp := metav1.Now()
+	broker := getTestClusterServiceBroker()
+	broker.DeletionTimestamp = &deletionTimestamp
+	broker.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	broker.Spec.CatalogRemovalGracePeriod = &metav1.Duration{Duration: time.Hour}
+	fakeCatalogClient.AddReactor("list", "clusterserviceclasses", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServiceClassList{
+			Items: []v1beta1.ClusterServiceClass{
+				*testClusterServiceClass,
+			},
+		}, nil
+	})
+	fakeCatalogClient.AddReactor("list", "clusterserviceplans", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServicePlanList{
+			Items: []v1beta1.ClusterServicePlan{
+				*testClusterServicePlan,
+			},
+		}, nil
+	})
+
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 0)
+
+	actions := fakeCatalogClient.Actions()
+	// The actions should be:
+	// - list serviceclasses
+	// - list serviceplans
+	// - mark the serviceclass as pending removal
+	// - mark the serviceplan as pending removal
+	// - update the ready condition
+	assertNumberOfActions(t, actions, 5)
+
+	removalTime := deletionTimestamp.Add(time.Hour)
+
+	updatedClusterServiceClass := assertUpdateStatus(t, actions[2], testClusterServiceClass).(*v1beta1.ClusterServiceClass)
+	if !updatedClusterServiceClass.Status.PendingRemoval {
+		t.Fatal("expected class to be pending removal")
+	}
+	if e, a := removalTime, updatedClusterServiceClass.Status.RemovalTime.Time; !e.Equal(a) {
+		t.Fatalf("unexpected class removal time: %v", expectedGot(e, a))
+	}
+
+	updatedClusterServicePlan := assertUpdateStatus(t, actions[3], testClusterServicePlan).(*v1beta1.ClusterServicePlan)
+	if !updatedClusterServicePlan.Status.PendingRemoval {
+		t.Fatal("expected plan to be pending removal")
+	}
+	if e, a := removalTime, updatedClusterServicePlan.Status.RemovalTime.Time; !e.Equal(a) {
+		t.Fatalf("unexpected plan removal time: %v", expectedGot(e, a))
+	}
+
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[4], broker)
+	assertClusterServiceBrokerReadyFalse(t, updatedClusterServiceBroker)
+	if !isServiceBrokerReadyConditionReason(updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status.Conditions, catalogPendingRemovalReason) {
+		t.Fatalf("expected ready condition reason %q", catalogPendingRemovalReason)
+	}
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := normalEventBuilder(catalogPendingRemovalReason).msgf(catalogPendingRemovalMessage, removalTime)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestReconcileClusterServiceBrokerErrorFetchingCatalog simulates broker reconciliation where
 // OSB client responds with an error for getting the catalog which in turn causes
 // reconcileClusterServiceBroker() to return an error.
@@ -664,6 +797,47 @@ func TestReconcileClusterServiceBrokerErrorFetchingCatalog(t *testing.T) {
 	}
 }
 
+// TestReconcileClusterServiceBrokerUnsupportedAPIVersion simulates broker
+// reconciliation where the broker rejects the OSB API version of the request,
+// which sets the ready condition of the broker to false without retrying.
+func TestReconcileClusterServiceBrokerUnsupportedAPIVersion(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Error: osb.HTTPStatusCodeError{StatusCode: http.StatusPreconditionFailed},
+		},
+	})
+
+	broker := getTestClusterServiceBroker()
+
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertGetCatalog(t, brokerActions[0])
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[0], broker)
+	assertClusterServiceBrokerReadyFalse(t, updatedClusterServiceBroker)
+	condition := updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status.Conditions[0]
+	if e, a := errorUnsupportedOSBAPIVersionReason, condition.Reason; e != a {
+		t.Fatalf("unexpected condition reason: %v", expectedGot(e, a))
+	}
+	assertClusterServiceBrokerOperationStartTimeSet(t, updatedClusterServiceBroker, false)
+
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := warningEventBuilder(errorUnsupportedOSBAPIVersionReason).msg(errorUnsupportedOSBAPIVersionMessage).msg("The broker rejected version 2.13; versions 2.11 to 2.13 are supported.")
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestReconcileClusterServiceBrokerZeroServices simulates broker reconciliation where
 // OSB client responds with zero services which is valid
 func TestReconcileClusterServiceBrokerZeroServices(t *testing.T) {
@@ -1563,9 +1737,9 @@ func TestMarkAsServiceCatalogManagedResource(t *testing.T) {
 				t.Errorf("Expected a controller reference, but Controller is false")
 			}
 
-			gotBlockOwnerDeletion := gotOwner.BlockOwnerDeletion != nil && *gotOwner.BlockOwnerDeletion == true
+			gotBlockOwnerDeletion := gotOwner.BlockOwnerDeletion == nil || *gotOwner.BlockOwnerDeletion == true
 			if gotBlockOwnerDeletion {
-				t.Errorf("Expected the controller reference to not modify deletion semantics, but BlockOwnerDeletion is true")
+				t.Errorf("Expected the controller reference not to block the deletion of the broker, but BlockOwnerDeletion is not false")
 			}
 
 			wantAPIVersion := v1beta1.SchemeGroupVersion.String()
@@ -1581,3 +1755,54 @@ func TestMarkAsServiceCatalogManagedResource(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkAsServiceCatalogManagedResourceReplacesControllerReference(t *testing.T) {
+	broker := getTestServiceBroker()
+	broker.UID = "new-broker-uid"
+	blocking := true
+	plan := &v1beta1.ServicePlan{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "other-owner"},
+		{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "ServiceBroker", Name: broker.Name, UID: "old-broker-uid", Controller: truePtr(), BlockOwnerDeletion: &blocking},
+	}}}
+
+	markAsServiceCatalogManagedResource(plan, broker)
+
+	ownerRefs := plan.GetOwnerReferences()
+	if len(ownerRefs) != 2 {
+		t.Fatalf("Expected 2 owner references, got %v", len(ownerRefs))
+	}
+	if ownerRefs[0].Name != "other-owner" {
+		t.Errorf("Expected the owner reference that is not a controller reference to be kept, got %+v", ownerRefs[0])
+	}
+	gotOwner := ownerRefs[1]
+	if gotOwner.Kind != "ServiceBroker" || gotOwner.UID != broker.UID {
+		t.Errorf("Expected the controller reference to be replaced by one to ServiceBroker %q, got %+v", broker.UID, gotOwner)
+	}
+	if gotOwner.BlockOwnerDeletion == nil || *gotOwner.BlockOwnerDeletion {
+		t.Errorf("Expected the controller reference not to block the deletion of the broker, but BlockOwnerDeletion is not false")
+	}
+}
+
+// TestMarkAsServiceCatalogManagedResourceUnblocksOwnerDeletion tests that a
+// controller reference to the same broker that blocks the deletion of the
+// broker is replaced by one that does not, so that a foreground deletion of
+// the broker does not wait for the garbage collector to delete its classes
+// and plans.
+func TestMarkAsServiceCatalogManagedResourceUnblocksOwnerDeletion(t *testing.T) {
+	broker := getTestClusterServiceBroker()
+	broker.UID = "broker-uid"
+	blocking := true
+	class := &v1beta1.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{
+		{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "ClusterServiceBroker", Name: broker.Name, UID: broker.UID, Controller: truePtr(), BlockOwnerDeletion: &blocking},
+	}}}
+
+	markAsServiceCatalogManagedResource(class, broker)
+
+	ownerRefs := class.GetOwnerReferences()
+	if len(ownerRefs) != 1 {
+		t.Fatalf("Expected 1 owner reference, got %v", len(ownerRefs))
+	}
+	if gotOwner := ownerRefs[0]; gotOwner.UID != broker.UID || gotOwner.BlockOwnerDeletion == nil || *gotOwner.BlockOwnerDeletion {
+		t.Errorf("Expected a controller reference to ClusterServiceBroker %q that does not block its deletion, got %+v", broker.UID, gotOwner)
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package controller
 
 import (
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 
@@ -86,6 +88,12 @@ func (c *controller) reconcileClusterServiceClass(serviceClass *v1beta1.ClusterS
 		return nil
 	}
 
+	if delay := getPendingRemovalDelay(serviceClass.Status.PendingRemoval, serviceClass.Status.RemovalTime, time.Now()); delay > 0 {
+		glog.Infof("ClusterServiceClass %q (ExternalName: %q): has been removed from broker catalog and is pending removal; deleting in %v", serviceClass.Name, serviceClass.Spec.ExternalName, delay)
+		c.clusterServiceClassQueue.AddAfter(serviceClass.Name, delay)
+		return nil
+	}
+
 	glog.Infof("ClusterServiceClass %q (ExternalName: %q): has been removed from broker catalog and has zero instances remaining; deleting", serviceClass.Name, serviceClass.Spec.ExternalName)
 	return c.serviceCatalogClient.ClusterServiceClasses().Delete(serviceClass.Name, &metav1.DeleteOptions{})
 }
@@ -17,6 +17,8 @@ limitations under the License.
 package controller
 
 import (
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 
@@ -87,6 +89,12 @@ func (c *controller) reconcileClusterServicePlan(clusterServicePlan *v1beta1.Clu
 		return nil
 	}
 
+	if delay := getPendingRemovalDelay(clusterServicePlan.Status.PendingRemoval, clusterServicePlan.Status.RemovalTime, time.Now()); delay > 0 {
+		glog.Infof("ClusterServicePlan %q (ExternalName: %q): has been removed from broker catalog and is pending removal; deleting in %v", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName, delay)
+		c.clusterServicePlanQueue.AddAfter(clusterServicePlan.Name, delay)
+		return nil
+	}
+
 	glog.Infof("ClusterServicePlan %q (ExternalName: %q): has been removed from broker catalog and has zero instances remaining; deleting", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
 	return c.serviceCatalogClient.ClusterServicePlans().Delete(clusterServicePlan.Name, &metav1.DeleteOptions{})
 }
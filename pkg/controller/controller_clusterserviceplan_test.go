@@ -19,9 +19,11 @@ package controller
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/test/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"k8s.io/apimachinery/pkg/fields"
@@ -36,6 +38,12 @@ func TestReconcileClusterServicePlanRemovedFromCatalog(t *testing.T) {
 		p.Status.RemovedFromBrokerCatalog = true
 		return p
 	}
+	getPendingRemovalTestObj := func(removalTime time.Time) *v1beta1.ClusterServicePlan {
+		p := getRemovedPlan()
+		p.Status.PendingRemoval = true
+		p.Status.RemovalTime = &metav1.Time{Time: removalTime}
+		return p
+	}
 
 	cases := []struct {
 		name                    string
@@ -82,6 +90,37 @@ func TestReconcileClusterServicePlanRemovedFromCatalog(t *testing.T) {
 				assertDelete(t, actions[1], getRemovedPlan())
 			},
 		},
+		{
+			name:        "removed from catalog, no instances left, pending removal",
+			plan:        getPendingRemovalTestObj(time.Now().Add(time.Hour)),
+			instances:   nil,
+			shouldError: false,
+			catalogActionsCheckFunc: func(t *testing.T, name string, actions []clientgotesting.Action) {
+				listRestrictions := clientgotesting.ListRestrictions{
+					Labels: labels.Everything(),
+					Fields: fields.OneTermEqualSelector("spec.clusterServicePlanRef.name", "CSPGUID"),
+				}
+
+				expectNumberOfActions(t, name, actions, 1)
+				assertList(t, actions[0], &v1beta1.ServiceInstance{}, listRestrictions)
+			},
+		},
+		{
+			name:        "removed from catalog, no instances left, removal time passed",
+			plan:        getPendingRemovalTestObj(time.Now().Add(-time.Hour)),
+			instances:   nil,
+			shouldError: false,
+			catalogActionsCheckFunc: func(t *testing.T, name string, actions []clientgotesting.Action) {
+				listRestrictions := clientgotesting.ListRestrictions{
+					Labels: labels.Everything(),
+					Fields: fields.OneTermEqualSelector("spec.clusterServicePlanRef.name", "CSPGUID"),
+				}
+
+				expectNumberOfActions(t, name, actions, 2)
+				assertList(t, actions[0], &v1beta1.ServiceInstance{}, listRestrictions)
+				assertDelete(t, actions[1], getRemovedPlan())
+			},
+		},
 		{
 			name:        "removed from catalog, no instances left, delete fails",
 			plan:        getRemovedPlan(),
@@ -30,7 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
-	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -39,6 +39,7 @@ import (
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 )
 
@@ -49,8 +50,14 @@ const (
 	successUpdateInstanceMessage   string = "The instance was updated successfully"
 	successProvisionReason         string = "ProvisionedSuccessfully"
 	successProvisionMessage        string = "The instance was provisioned successfully"
+	successAdoptReason             string = "AdoptedSuccessfully"
+	successAdoptMessage            string = "The existing instance was fetched from the broker and adopted without provisioning it"
 	successOrphanMitigationReason  string = "OrphanMitigationSuccessful"
 	successOrphanMitigationMessage string = "Orphan mitigation was completed successfully"
+	abandonedDeprovisionReason     string = "DeprovisionAbandoned"
+	abandonedDeprovisionMessage    string = "The instance was removed without deprovisioning it at the broker because its deletion policy is Abandon"
+	deletingBindingsReason         string = "DeletingBindings"
+	deletingBindingsMessage        string = "Waiting for %d ServiceBindings to be deleted before deprovisioning"
 
 	errorWithParameters                        string = "ErrorWithParameters"
 	errorProvisionCallFailedReason             string = "ProvisionCallFailed"
@@ -79,11 +86,16 @@ const (
 	errorDeletedServiceClassMessage            string = "ReferencesDeletedServiceClass"
 	errorDeletedServicePlanReason              string = "ReferencesDeletedServicePlan"
 	errorDeletedServicePlanMessage             string = "ReferencesDeletedServicePlan"
+	errorInvalidPlanUpgradeReason              string = "InvalidPlanUpgrade"
 	errorFindingNamespaceServiceInstanceReason string = "ErrorFindingNamespaceForInstance"
 	errorOrphanMitigationFailedReason          string = "OrphanMitigationFailed"
 	errorInvalidDeprovisionStatusReason        string = "InvalidDeprovisionStatus"
 	errorInvalidDeprovisionStatusMessage       string = "The deprovision status is invalid"
 	errorAmbiguousPlanReferenceScope           string = "Couldn't determine if the instance refers to a Cluster or Namespaced ServiceClass/Plan"
+	errorInstanceUnusableReason                string = "InstanceUnusable"
+	errorInstanceUnusableMessage               string = "The broker reported that the instance is no longer usable and may need manual intervention"
+	errorAdoptInstanceFailedReason             string = "AdoptInstanceFailed"
+	errorAdoptNotRetrievableMessage            string = "The instance cannot be adopted because the broker does not support fetching the instances of its class"
 
 	asyncProvisioningReason                 string = "Provisioning"
 	asyncProvisioningMessage                string = "The instance is being provisioned asynchronously"
@@ -95,6 +107,7 @@ const (
 	provisioningInFlightMessage             string = "Provision request for ServiceInstance in-flight to Broker"
 	instanceUpdatingInFlightReason          string = "UpdateInstanceRequestInFlight"
 	instanceUpdatingInFlightMessage         string = "Update request for ServiceInstance in-flight to Broker"
+	instanceParametersChangedReason         string = "ParametersChanged"
 	deprovisioningInFlightReason            string = "DeprovisionRequestInFlight"
 	deprovisioningInFlightMessage           string = "Deprovision request for ServiceInstance in-flight to Broker"
 	startingInstanceOrphanMitigationReason  string = "StartingInstanceOrphanMitigation"
@@ -302,7 +315,21 @@ func (c *controller) reconcileServiceInstance(instance *v1beta1.ServiceInstance)
 		// and processed again
 		return nil
 	}
+	updated, err = c.recordServiceInstancePendingChanges(instance)
+	if err != nil {
+		return err
+	}
+	if updated {
+		// The updated instance will be automatically added back to the queue
+		// and processed again
+		return nil
+	}
 	reconciliationAction := getReconciliationActionForServiceInstance(instance)
+	if c.readOnly && reconciliationAction != reconcilePoll {
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		glog.V(4).Info(pcb.Messagef("Not doing %v because the controller is read-only", reconciliationAction))
+		return nil
+	}
 	switch reconciliationAction {
 
 	// ERIK CP
@@ -418,9 +445,10 @@ func (c *controller) backoffAndRequeueIfRetrying(instance *v1beta1.ServiceInstan
 	defer c.instanceOperationRetryQueue.mutex.Unlock()
 	retryEntry, exists := c.instanceOperationRetryQueue.instances[key]
 	if exists {
-		if retryEntry.generation != instance.Generation {
+		if retryEntry.generation != instance.Generation && !hasServiceInstancePendingChanges(instance) {
 			// the retry entry was on an old generation, we don't care,
-			// cleanup and no delay
+			// cleanup and no delay. A spec change during an operation
+			// waits for the backoff of the operation, like its retry.
 			delete(c.instanceOperationRetryQueue.instances, key)
 			c.instanceOperationRetryQueue.rateLimiter.Forget(key)
 			return false
@@ -530,6 +558,22 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 	}
 
 	if instance.Status.CurrentOperation == "" || !isServiceInstancePropertiesStateEqual(instance.Status.InProgressProperties, inProgressProperties) {
+		// The namespace may limit how many instances start to be
+		// provisioned within a time window, and the class or plan how many
+		// instances are provisioned at the same time.
+		if instance.Status.CurrentOperation == "" {
+			admitted, err := c.admitServiceInstanceProvisionRate(instance)
+			if !admitted {
+				return err
+			}
+			admitted, err = c.admitServiceInstanceProvision(instance)
+			if !admitted {
+				c.releaseServiceInstanceProvisionRate(instance)
+				return err
+			}
+			removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionThrottled)
+			removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionQueued)
+		}
 		instance, err = c.recordStartOfServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationProvision, inProgressProperties)
 		if err != nil {
 			// There has been an update to the instance. Start reconciliation
@@ -543,14 +587,39 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 	var prettyClass string
 	var brokerName string
 	var brokerClient osb.Client
+	var instancesRetrievable bool
 	if instance.Spec.ClusterServiceClassSpecified() {
 		var serviceClass *v1beta1.ClusterServiceClass
 		serviceClass, _, brokerName, brokerClient, _ = c.getClusterServiceClassPlanAndClusterServiceBroker(instance)
 		prettyClass = pretty.ClusterServiceClassName(serviceClass)
+		instancesRetrievable = serviceClass.Spec.InstancesRetrievable
 	} else {
 		var serviceClass *v1beta1.ServiceClass
 		serviceClass, _, brokerName, brokerClient, _ = c.getServiceClassPlanAndServiceBroker(instance)
 		prettyClass = pretty.ServiceClassName(serviceClass)
+		instancesRetrievable = serviceClass.Spec.InstancesRetrievable
+	}
+
+	// An instance created again after its deletion was cancelled takes over
+	// the instance at the broker, which was never deprovisioned.
+	cancelledDeletion, err := c.getCancelledServiceInstanceDeletion(instance)
+	if err != nil {
+		return err
+	}
+	if cancelledDeletion != nil {
+		glog.V(4).Info(pcb.Messagef(
+			"Restoring the ServiceInstance %q of %s at Broker %q, whose deletion was cancelled",
+			instance.Spec.ExternalID, prettyClass, brokerName,
+		))
+		return c.processServiceInstanceRestore(instance, cancelledDeletion)
+	}
+
+	if instance.Spec.Adopt {
+		glog.V(4).Info(pcb.Messagef(
+			"Adopting the existing ServiceInstance %q of %s at Broker %q",
+			instance.Spec.ExternalID, prettyClass, brokerName,
+		))
+		return c.processServiceInstanceAdoption(instance, request, brokerClient, instancesRetrievable)
 	}
 
 	glog.V(4).Info(pcb.Messagef(
@@ -559,6 +628,7 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 	))
 
 	c.setRetryBackoffRequired(instance)
+	c.mirrorProvisionRequest(instance, request)
 	response, err := brokerClient.ProvisionInstance(request)
 	if err != nil {
 		if httpErr, ok := osb.IsHTTPError(err); ok {
@@ -616,7 +686,7 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 
 	if isServiceInstanceProcessedAlready(instance) {
 		glog.V(4).Info(pcb.Message("Not processing event because status showed there is no work to do"))
-		return nil
+		return c.ensureServiceInstanceAutoBinding(instance)
 	}
 
 	// don't DOS the broker.  If we already did an update attempt that ended with a non-terminal
@@ -661,6 +731,12 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 			return c.handleServiceInstanceReconciliationError(instance, err)
 		}
 
+		// Check that the broker allows instances to be moved from the plan
+		// they have to the new plan.
+		if err := c.checkClusterPlanUpgradePath(instance, servicePlan); err != nil {
+			return c.handleServiceInstanceReconciliationError(instance, err)
+		}
+
 		req, inProgressProperties, err := c.prepareUpdateInstanceRequest(instance)
 		if err != nil {
 			return c.handleServiceInstanceReconciliationError(instance, err)
@@ -698,6 +774,12 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 			return c.handleServiceInstanceReconciliationError(instance, err)
 		}
 
+		// Check that the broker allows instances to be moved from the plan
+		// they have to the new plan.
+		if err := c.checkPlanUpgradePath(instance, servicePlan); err != nil {
+			return c.handleServiceInstanceReconciliationError(instance, err)
+		}
+
 		req, inProgressProperties, err := c.prepareUpdateInstanceRequest(instance)
 		if err != nil {
 			return c.handleServiceInstanceReconciliationError(instance, err)
@@ -784,6 +866,34 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 
 	pcb := pretty.NewInstanceContextBuilder(instance)
 
+	// An abandoned instance is removed without involving the broker, even if
+	// an earlier deprovision attempt has failed.
+	if instance.DeletionTimestamp != nil && instance.Spec.DeletionPolicy == v1beta1.DeletionPolicyAbandon {
+		// Abandoning an instance whose deprovision is held back cancels its
+		// deletion: the instance created again in its place takes it over.
+		if c.isServiceInstanceDeletionCancellable(instance) {
+			if err := c.recordCancelledServiceInstanceDeletion(instance); err != nil {
+				return err
+			}
+			return c.processServiceInstanceAbandon(instance, corev1.EventTypeNormal, deletionCancelledReason, deletionCancelledMessage)
+		}
+		return c.processServiceInstanceAbandon(instance, corev1.EventTypeNormal, abandonedDeprovisionReason, abandonedDeprovisionMessage)
+	}
+
+	if instance.DeletionTimestamp != nil {
+		message, err := c.getServiceInstanceNamespaceDeletionAbandonMessage(instance)
+		if err != nil {
+			return err
+		}
+		if message != "" {
+			return c.processServiceInstanceAbandon(instance, corev1.EventTypeWarning, namespaceDeletionAbandonedReason, message)
+		}
+	}
+
+	if updated, err := c.checkServiceInstanceStuckDeleting(instance); updated {
+		return err
+	}
+
 	// If deprovisioning has already failed, do not do anything more
 	if instance.Status.DeprovisionStatus == v1beta1.ServiceInstanceDeprovisionStatusFailed {
 		glog.V(4).Info(pcb.Message("Not processing deleting event because deprovisioning has failed"))
@@ -822,8 +932,14 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 		return c.processDeprovisionFailure(instance, readyCond, failedCond)
 	}
 
+	// Give the user a chance to cancel an accidental deletion before anything
+	// is removed at the broker.
+	if remaining := c.getDeprovisionGracePeriodRemaining(instance); remaining > 0 {
+		return c.processServiceInstanceDeprovisionPending(instance, remaining)
+	}
+
 	// We don't want to delete the instance if there are any bindings associated.
-	if err := c.checkServiceInstanceHasExistingBindings(instance); err != nil {
+	if err := c.checkServiceInstanceBindingsDeleted(instance); err != nil {
 		return c.handleServiceInstanceReconciliationError(instance, err)
 	}
 
@@ -852,6 +968,15 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 		prettyName = pretty.ServiceClassName(serviceClass)
 	}
 
+	// Limit how many instances are deprovisioned at the same time at the
+	// broker, so that mass deletions do not flood it.
+	if instance.DeletionTimestamp != nil {
+		admitted, err := c.admitServiceInstanceDeprovision(instance, brokerName)
+		if !admitted {
+			return err
+		}
+	}
+
 	request, inProgressProperties, err := c.prepareDeprovisionRequest(instance)
 	if err != nil {
 		return c.handleServiceInstanceReconciliationError(instance, err)
@@ -884,6 +1009,7 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 	}
 
 	glog.V(4).Info(pcb.Message("Sending deprovision request to broker"))
+	c.mirrorDeprovisionRequest(instance, request)
 	response, err := brokerClient.DeprovisionInstance(request)
 	if err != nil {
 		msg := fmt.Sprintf(
@@ -895,6 +1021,7 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 		}
 
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorDeprovisionCalledReason, msg)
+		instance.Status.FailedDeprovisionAttempts++
 
 		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries because too much time has elapsed"
@@ -918,6 +1045,24 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 
 	instance = instance.DeepCopy()
 
+	// An abandoned instance is removed without waiting for the operation in
+	// progress at the broker, so the broker is not polled any more.
+	if instance.DeletionTimestamp != nil && instance.Spec.DeletionPolicy == v1beta1.DeletionPolicyAbandon {
+		clearServiceInstanceAsyncOsbOperation(instance)
+		c.finishPollingServiceInstance(instance)
+		return c.processServiceInstanceAbandon(instance, corev1.EventTypeNormal, abandonedDeprovisionReason, abandonedDeprovisionMessage)
+	}
+
+	// A deletion waiting on an asynchronous operation can get stuck without
+	// the instance ever going back through reconcileServiceInstanceDelete.
+	// The broker is polled again on the next attempt.
+	if updated, err := c.checkServiceInstanceStuckDeleting(instance); updated {
+		if err != nil {
+			return err
+		}
+		return c.continuePollingServiceInstance(instance)
+	}
+
 	var brokerClient osb.Client
 	var err error
 	if instance.Spec.ClusterServiceClassSpecified() {
@@ -957,17 +1102,27 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		// We got some kind of error and should continue polling.
 		//
 		// The instance's Ready condition should already be False, so
-		// we just need to record an event.
+		// we just need to record the poll result, and an event if the
+		// result differs from the last poll.
 		reason := errorPollingLastOperationReason
 		message := fmt.Sprintf("Error polling last operation: %v", err)
 		glog.V(4).Info(pcb.Message(message))
-		c.recorder.Event(instance, corev1.EventTypeWarning, reason, message)
+		changed, updateNeeded := recordServiceInstancePollResult(instance, message)
+		if changed {
+			c.recorder.Event(instance, corev1.EventTypeWarning, reason, message)
+		}
 
 		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, message)
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 		}
 
+		if updateNeeded {
+			if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+				return c.handleServiceInstancePollingError(instance, err)
+			}
+		}
+
 		return c.continuePollingServiceInstance(instance)
 	}
 
@@ -1002,11 +1157,17 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 		}
 
-		// only need to update the resource if there was a description for the operation provided
-		if response.Description != nil {
-			c.recorder.Event(instance, corev1.EventTypeNormal, readyCond.Reason, readyCond.Message)
-
-			setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+		// only need to update the resource if the result of the poll changed
+		// or a heartbeat is due, and only need to record an event if there
+		// was a new description for the operation provided
+		changed, updateNeeded := recordServiceInstancePollResult(instance, readyCond.Message)
+		if updateNeeded {
+			if response.Description != nil {
+				if changed {
+					c.recorder.Event(instance, corev1.EventTypeNormal, readyCond.Reason, readyCond.Message)
+				}
+				setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+			}
 			if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 				return c.handleServiceInstancePollingError(instance, err)
 			}
@@ -1015,6 +1176,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		glog.V(4).Info(pcb.Message("Last operation not completed (still in progress)"))
 		return c.continuePollingServiceInstance(instance)
 	case osb.StateSucceeded:
+		recordServiceInstancePollResult(instance, string(response.State))
 		var err error
 		switch {
 		case deleting:
@@ -1029,12 +1191,17 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		}
 		return c.finishPollingServiceInstance(instance)
 	case osb.StateFailed:
+		recordServiceInstancePollResult(instance, string(response.State))
+		if !provisioning {
+			c.setServiceInstanceUsability(instance, lastOperationInstanceUsable(brokerClient), description)
+		}
 		var err error
 		switch {
 		case deleting:
 			// For deprovisioning only, we should reattempt even on failure
 			msg := "Deprovision call failed: " + description
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorDeprovisionCalledReason, msg)
+			instance.Status.FailedDeprovisionAttempts++
 
 			if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
 				return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
@@ -1071,6 +1238,30 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 	}
 }
 
+// setServiceInstanceUsability sets the Unusable condition on the given
+// instance when the broker reported that the instance is no longer usable
+// after a failed operation, and removes it when the broker reported that the
+// instance is usable. The status is *not* recorded in the registry.
+func (c *controller) setServiceInstanceUsability(instance *v1beta1.ServiceInstance, instanceUsable *bool, description string) {
+	if instanceUsable == nil {
+		return
+	}
+	if *instanceUsable {
+		removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionUnusable)
+		return
+	}
+	message := fmt.Sprintf("%s: %s", errorInstanceUnusableMessage, description)
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionUnusable, v1beta1.ConditionTrue, errorInstanceUnusableReason, message)
+	c.recorder.Event(instance, corev1.EventTypeWarning, errorInstanceUnusableReason, message)
+}
+
+// recordServiceInstancePollResult records the time and result of the last
+// poll of the broker in the status of the given instance. The status is *not*
+// recorded in the registry. See recordPollResult for the return values.
+func recordServiceInstancePollResult(instance *v1beta1.ServiceInstance, result string) (changed bool, updateNeeded bool) {
+	return recordPollResult(&instance.Status.LastPollTime, &instance.Status.LastPollResult, result)
+}
+
 // clearServiceInstanceAsyncOsbOperation will reset the given instance's
 // asynchronous OSB operation status fields. Note: This does not clear the
 // Service Catalog operation, only the concept of "operation" as part of the
@@ -1775,6 +1966,9 @@ func (c *controller) prepareObservedGeneration(toUpdate *v1beta1.ServiceInstance
 	removeServiceInstanceCondition(
 		toUpdate,
 		v1beta1.ServiceInstanceConditionFailed)
+	removeServiceInstanceCondition(
+		toUpdate,
+		v1beta1.ServiceInstanceConditionPendingChanges)
 }
 
 // isServiceInstancePropertiesStateEqual checks whether two ServiceInstancePropertiesState objects are equal
@@ -1832,9 +2026,24 @@ func (c *controller) recordStartOfServiceInstanceOperation(toUpdate *v1beta1.Ser
 		reason = provisioningInFlightReason
 		message = provisioningInFlightMessage
 		toUpdate.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+		if toUpdate.Status.ProvisionStartedAt == nil {
+			toUpdate.Status.ProvisionStartedAt = &now
+		}
 	case v1beta1.ServiceInstanceOperationUpdate:
 		reason = instanceUpdatingInFlightReason
 		message = instanceUpdatingInFlightMessage
+		toUpdate.Status.ParametersDiff = nil
+		if toUpdate.Status.ExternalProperties != nil && inProgressProperties != nil &&
+			toUpdate.Status.ExternalProperties.ParametersChecksum != inProgressProperties.ParametersChecksum {
+			diff, err := diffRawParameters(toUpdate.Status.ExternalProperties.Parameters, inProgressProperties.Parameters)
+			if err != nil {
+				pcb := pretty.NewInstanceContextBuilder(toUpdate)
+				glog.Warning(pcb.Messagef("Unable to diff the parameters of the update: %v", err))
+			} else if diff != nil {
+				toUpdate.Status.ParametersDiff = diff
+				c.recorder.Event(toUpdate, corev1.EventTypeNormal, instanceParametersChangedReason, parametersDiffMessage(diff))
+			}
+		}
 	case v1beta1.ServiceInstanceOperationDeprovision:
 		reason = deprovisioningInFlightReason
 		message = deprovisioningInFlightMessage
@@ -1929,6 +2138,52 @@ func (c *controller) checkForRemovedClassAndPlan(instance *v1beta1.ServiceInstan
 	}
 }
 
+// checkClusterPlanUpgradePath returns an operationError if the plan that the
+// broker has for the instance declares upgrade paths that do not include the
+// given plan.
+func (c *controller) checkClusterPlanUpgradePath(instance *v1beta1.ServiceInstance, servicePlan *v1beta1.ClusterServicePlan) error {
+	externalProperties := instance.Status.ExternalProperties
+	if externalProperties == nil || externalProperties.ClusterServicePlanExternalID == servicePlan.Spec.ExternalID {
+		return nil
+	}
+	currentPlan, err := c.clusterServicePlanLister.Get(externalProperties.ClusterServicePlanExternalID)
+	if err != nil {
+		// The plan is no longer in the catalog, so it does not restrict
+		// where the instance goes.
+		return nil
+	}
+	if currentPlan.Spec.AllowsUpgradeTo(&servicePlan.Spec.CommonServicePlanSpec) {
+		return nil
+	}
+	paths, _ := currentPlan.Spec.GetUpgradePaths()
+	return &operationError{
+		reason:  errorInvalidPlanUpgradeReason,
+		message: fmt.Sprintf("%s cannot be upgraded to %s; valid upgrade paths are %v", pretty.ClusterServicePlanName(currentPlan), pretty.ClusterServicePlanName(servicePlan), paths),
+	}
+}
+
+// checkPlanUpgradePath returns an operationError if the plan that the broker
+// has for the instance declares upgrade paths that do not include the given
+// plan.
+func (c *controller) checkPlanUpgradePath(instance *v1beta1.ServiceInstance, servicePlan *v1beta1.ServicePlan) error {
+	externalProperties := instance.Status.ExternalProperties
+	if externalProperties == nil || externalProperties.ServicePlanExternalID == servicePlan.Spec.ExternalID {
+		return nil
+	}
+	currentPlan, err := c.servicePlanLister.ServicePlans(instance.Namespace).Get(externalProperties.ServicePlanExternalID)
+	if err != nil {
+		return nil
+	}
+	if currentPlan.Spec.AllowsUpgradeTo(&servicePlan.Spec.CommonServicePlanSpec) {
+		return nil
+	}
+	paths, _ := currentPlan.Spec.GetUpgradePaths()
+	return &operationError{
+		reason:  errorInvalidPlanUpgradeReason,
+		message: fmt.Sprintf("%s cannot be upgraded to %s; valid upgrade paths are %v", pretty.ServicePlanName(currentPlan), pretty.ServicePlanName(servicePlan), paths),
+	}
+}
+
 // clearServiceInstanceCurrentOperation sets the fields of the instance's Status
 // to indicate that there is no current operation being performed. The Status
 // is *not* recorded in the registry.
@@ -1940,29 +2195,106 @@ func clearServiceInstanceCurrentOperation(toUpdate *v1beta1.ServiceInstance) {
 	toUpdate.Status.InProgressProperties = nil
 }
 
+// recordServiceInstancePropertiesSnapshot appends the external properties of
+// the given instance, after the given operation succeeded, to its properties
+// history, dropping the oldest snapshots beyond the limit.
+func recordServiceInstancePropertiesSnapshot(toUpdate *v1beta1.ServiceInstance, operation v1beta1.ServiceInstanceOperation) {
+	if toUpdate.Status.ExternalProperties == nil {
+		return
+	}
+	history := append(toUpdate.Status.PropertiesHistory, v1beta1.ServiceInstancePropertiesSnapshot{
+		Operation:  operation,
+		Time:       metav1.Now(),
+		Properties: *toUpdate.Status.ExternalProperties.DeepCopy(),
+	})
+	if len(history) > v1beta1.MaxServiceInstancePropertiesHistory {
+		history = history[len(history)-v1beta1.MaxServiceInstancePropertiesHistory:]
+	}
+	toUpdate.Status.PropertiesHistory = history
+}
+
+// recordServiceInstanceOperation appends the current operation of the given
+// instance, which the broker completed with the given result, to its
+// operations, dropping the oldest operations beyond the limit. It must be
+// called before the current operation is cleared.
+func recordServiceInstanceOperation(toUpdate *v1beta1.ServiceInstance, operation v1beta1.ServiceInstanceOperation, result v1beta1.ServiceInstanceOperationResult) {
+	now := metav1.Now()
+	record := v1beta1.ServiceInstanceOperationRecord{
+		Operation:      operation,
+		StartTime:      now,
+		CompletionTime: &now,
+		Result:         result,
+	}
+	if toUpdate.Status.OperationStartTime != nil {
+		record.StartTime = *toUpdate.Status.OperationStartTime
+	}
+	if toUpdate.Status.LastOperation != nil {
+		operationKey := *toUpdate.Status.LastOperation
+		record.OperationKey = &operationKey
+	}
+	operations := append(toUpdate.Status.Operations, record)
+	if len(operations) > v1beta1.MaxServiceInstanceOperations {
+		operations = operations[len(operations)-v1beta1.MaxServiceInstanceOperations:]
+	}
+	toUpdate.Status.Operations = operations
+}
+
 // serviceInstanceHasExistingBindings returns true if there are any existing
 // bindings associated with the given ServiceInstance.
 func (c *controller) checkServiceInstanceHasExistingBindings(instance *v1beta1.ServiceInstance) error {
-	bindingLister := c.bindingLister.ServiceBindings(instance.Namespace)
+	bindingList, err := c.listServiceBindingsForInstance(instance)
+	if err != nil {
+		return err
+	}
+
+	// Note that as we are potentially looking at a stale binding resource
+	// and cannot rely on UnbindStatus == ServiceBindingUnbindStatusNotRequired
+	// to filter out binding requests that have yet to be sent to the broker.
+	if len(bindingList) > 0 {
+		return &operationError{
+			reason:  errorDeprovisionBlockedByCredentialsReason,
+			message: "All associated ServiceBindings must be removed before this ServiceInstance can be deleted",
+		}
+	}
+
+	return nil
+}
+
+// checkServiceInstanceBindingsDeleted returns an operationError while the
+// given instance, which is being deleted, has bindings. If the binding
+// deletion policy of the instance is Cascade, its bindings are deleted first.
+// Otherwise only the binding created for its AutoBind is.
+func (c *controller) checkServiceInstanceBindingsDeleted(instance *v1beta1.ServiceInstance) error {
+	if instance.Spec.BindingDeletionPolicy != v1beta1.BindingDeletionPolicyCascade {
+		if err := c.deleteServiceInstanceAutoBinding(instance); err != nil {
+			return err
+		}
+		return c.checkServiceInstanceHasExistingBindings(instance)
+	}
 
-	selector := labels.NewSelector()
-	bindingList, err := bindingLister.List(selector)
+	bindingList, err := c.listServiceBindingsForInstance(instance)
 	if err != nil {
 		return err
 	}
 
+	pcb := pretty.NewInstanceContextBuilder(instance)
 	for _, binding := range bindingList {
-		// Note that as we are potentially looking at a stale binding resource
-		// and cannot rely on UnbindStatus == ServiceBindingUnbindStatusNotRequired
-		// to filter out binding requests that have yet to be sent to the broker.
-		if instance.Name == binding.Spec.ServiceInstanceRef.Name {
-			return &operationError{
-				reason:  errorDeprovisionBlockedByCredentialsReason,
-				message: "All associated ServiceBindings must be removed before this ServiceInstance can be deleted",
-			}
+		if binding.DeletionTimestamp != nil {
+			continue
+		}
+		glog.V(4).Info(pcb.Messagef("Deleting ServiceBinding %q", binding.Name))
+		err := c.serviceCatalogClient.ServiceBindings(binding.Namespace).Delete(binding.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
 		}
 	}
 
+	if len(bindingList) > 0 {
+		return &operationError{
+			reason:  deletingBindingsReason,
+			message: fmt.Sprintf(deletingBindingsMessage, len(bindingList)),
+		}
+	}
 	return nil
 }
 
@@ -2008,10 +2340,20 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 	rh.ns = ns
 
 	if setInProgressProperties {
+		specParameters := instance.Spec.Parameters
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ParameterExpansion) {
+			specParameters, err = expandRawParameters(specParameters, instanceParameterVariables(instance))
+			if err != nil {
+				return nil, &operationError{
+					reason:  errorWithParameters,
+					message: err.Error(),
+				}
+			}
+		}
 		parameters, parametersChecksum, rawParametersWithRedaction, err := prepareInProgressPropertyParameters(
 			c.kubeClient,
 			instance.Namespace,
-			instance.Spec.Parameters,
+			specParameters,
 			instance.Spec.ParametersFrom,
 		)
 		if err != nil {
@@ -2020,6 +2362,17 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 				message: err.Error(),
 			}
 		}
+		operation := ParametersWebhookOperationProvision
+		if reconciliationAction == reconcileUpdate {
+			operation = ParametersWebhookOperationUpdate
+		}
+		parameters, err = c.mutateParameters(instance, operation, planName, parameters)
+		if err != nil {
+			return nil, &operationError{
+				reason:  errorWithParameters,
+				message: err.Error(),
+			}
+		}
 		rh.parameters = parameters
 
 		rh.inProgressProperties = &v1beta1.ServiceInstancePropertiesState{
@@ -2045,6 +2398,19 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 		"namespace":          instance.Namespace,
 		clusterIdentifierKey: id,
 	}
+
+	// Record the context sent to the broker, so that mismatches with what the
+	// broker expects, such as a wrong cluster ID, can be diagnosed.
+	if rh.inProgressProperties != nil {
+		rawContext, err := MarshalRawParameters(rh.requestContext)
+		if err != nil {
+			return nil, &operationError{
+				reason:  errorWithParameters,
+				message: fmt.Sprintf("Failed to marshal the request context: %v", err),
+			}
+		}
+		rh.inProgressProperties.Context = &runtime.RawExtension{Raw: rawContext}
+	}
 	return rh, nil
 }
 
@@ -2308,6 +2674,27 @@ func (c *controller) processServiceInstanceGracefulDeletionSuccess(instance *v1b
 	return nil
 }
 
+// processServiceInstanceAbandon handles the deletion of a ServiceInstance
+// that is abandoned at the broker, either because its deletion policy is
+// Abandon or because of the namespace deletion policy. An event with the
+// given type, reason and message is recorded and the finalizer is cleared
+// without sending a deprovision request to the broker.
+func (c *controller) processServiceInstanceAbandon(instance *v1beta1.ServiceInstance, eventType, reason, message string) error {
+	instance = instance.DeepCopy()
+
+	// Bindings would be left referencing an instance that no longer exists,
+	// so they must still be removed first.
+	if err := c.checkServiceInstanceBindingsDeleted(instance); err != nil {
+		return c.handleServiceInstanceReconciliationError(instance, err)
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	glog.V(4).Info(pcb.Message(message))
+	c.recorder.Event(instance, eventType, reason, message)
+
+	return c.processServiceInstanceGracefulDeletionSuccess(instance)
+}
+
 func (c *controller) removeFinalizer(instance *v1beta1.ServiceInstance) {
 	finalizers := sets.NewString(instance.Finalizers...)
 	finalizers.Delete(v1beta1.FinalizerServiceCatalog)
@@ -2351,19 +2738,112 @@ func (c *controller) processProvisionSuccess(instance *v1beta1.ServiceInstance,
 	setServiceInstanceDashboardURL(instance, dashboardURL)
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successProvisionReason, successProvisionMessage)
 	instance.Status.ExternalProperties = instance.Status.InProgressProperties
+	recordServiceInstancePropertiesSnapshot(instance, v1beta1.ServiceInstanceOperationProvision)
+	recordServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationProvision, v1beta1.ServiceInstanceOperationResultSucceeded)
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
 	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
+	firstReady := instance.Status.ReadyAt == nil
+	if firstReady {
+		now := metav1.Now()
+		instance.Status.ReadyAt = &now
+	}
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
 
+	if firstReady && instance.Status.ProvisionStartedAt != nil {
+		metrics.ServiceInstanceProvisionDuration.WithLabelValues(
+			c.getBrokerNameForServiceInstance(instance),
+			getServiceInstancePlanExternalName(instance),
+		).Observe(instance.Status.ReadyAt.Sub(instance.Status.ProvisionStartedAt.Time).Seconds())
+	}
+	if firstReady {
+		metrics.ServiceInstanceTimeToReady.WithLabelValues(
+			c.getBrokerNameForServiceInstance(instance),
+			c.getClassExternalNameForServiceInstance(instance),
+			getServiceInstancePlanExternalName(instance),
+		).Observe(instance.Status.ReadyAt.Sub(instance.CreationTimestamp.Time).Seconds())
+	}
+
 	c.removeInstanceFromRetryMap(instance)
 	c.recorder.Eventf(instance, corev1.EventTypeNormal, successProvisionReason, successProvisionMessage)
 	return nil
 }
 
+// processServiceInstanceAdoption adopts the existing instance with the
+// external ID of the given ServiceInstance at the broker. The instance is
+// fetched from the broker, and is only adopted if it is of the class and the
+// plan of the given provision request, so that a ServiceInstance cannot take
+// over an instance it was not meant to use. A failed adoption is terminal and
+// never deprovisions the instance at the broker.
+func (c *controller) processServiceInstanceAdoption(instance *v1beta1.ServiceInstance, request *osb.ProvisionRequest, brokerClient osb.Client, instancesRetrievable bool) error {
+	if !instancesRetrievable {
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorAdoptInstanceFailedReason, errorAdoptNotRetrievableMessage)
+		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorAdoptInstanceFailedReason, errorAdoptNotRetrievableMessage)
+		return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
+	}
+
+	existing, err := getBrokerInstance(brokerClient, instance.Spec.ExternalID)
+	if err != nil {
+		if httpErr, ok := osb.IsHTTPError(err); ok {
+			msg := fmt.Sprintf("The instance cannot be adopted because the broker did not return it: %v", httpErr)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorAdoptInstanceFailedReason, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorAdoptInstanceFailedReason, msg)
+			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
+		}
+
+		msg := fmt.Sprintf("The adoption will be retried: Error fetching the instance from the broker: %v", err)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorAdoptInstanceFailedReason, msg)
+		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+			msg := "Stopping reconciliation retries because too much time has elapsed"
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
+		}
+		return c.processServiceInstanceOperationError(instance, readyCond)
+	}
+
+	if existing.ServiceID != request.ServiceID || existing.PlanID != request.PlanID {
+		msg := fmt.Sprintf(
+			"The instance cannot be adopted because the broker returned it with the service %q and the plan %q, instead of %q and %q",
+			existing.ServiceID, existing.PlanID, request.ServiceID, request.PlanID,
+		)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorAdoptInstanceFailedReason, msg)
+		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorAdoptInstanceFailedReason, msg)
+		return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
+	}
+
+	setServiceInstanceDashboardURL(instance, existing.DashboardURL)
+	return c.processExistingServiceInstanceSuccess(instance, successAdoptReason, successAdoptMessage)
+}
+
+// processExistingServiceInstanceSuccess handles the logging and updating of
+// a ServiceInstance that takes over the instance with its external ID at the
+// broker, which already exists, with the given reason and message. No
+// provision request is sent: the instance is taken to exist with the plan and
+// parameters of the spec.
+func (c *controller) processExistingServiceInstanceSuccess(instance *v1beta1.ServiceInstance, reason, message string) error {
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, reason, message)
+	instance.Status.ExternalProperties = instance.Status.InProgressProperties
+	recordServiceInstancePropertiesSnapshot(instance, v1beta1.ServiceInstanceOperationProvision)
+	clearServiceInstanceCurrentOperation(instance)
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
+	if instance.Status.ReadyAt == nil {
+		now := metav1.Now()
+		instance.Status.ReadyAt = &now
+	}
+
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return err
+	}
+
+	c.removeInstanceFromRetryMap(instance)
+	c.recorder.Event(instance, corev1.EventTypeNormal, reason, message)
+	return nil
+}
+
 // processTerminalProvisionFailure handles the logging and updating of a
 // ServiceInstance that hit a terminal failure during provision reconciliation.
 func (c *controller) processTerminalProvisionFailure(instance *v1beta1.ServiceInstance, readyCond, failedCond *v1beta1.ServiceInstanceCondition, shouldMitigateOrphan bool) error {
@@ -2414,6 +2894,9 @@ func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance,
 		instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusNotRequired
 	}
 
+	if failedCond != nil || shouldMitigateOrphan {
+		recordServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationProvision, v1beta1.ServiceInstanceOperationResultFailed)
+	}
 	if failedCond == nil || shouldMitigateOrphan {
 		// Don't reset the current operation if the error is retriable
 		// or requires an orphan mitigation.
@@ -2427,6 +2910,9 @@ func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance,
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
+	if failedCond != nil {
+		c.notifyTerminalFailure("ServiceInstance", instance, FailureOperationProvision, failedCond.Reason, failedCond.Message)
+	}
 
 	// The instance will be requeued in any case, since we updated the status
 	// a few lines above.
@@ -2459,7 +2945,10 @@ func (c *controller) processProvisionAsyncResponse(instance *v1beta1.ServiceInst
 // ServiceInstance that has successfully been updated at the broker.
 func (c *controller) processUpdateServiceInstanceSuccess(instance *v1beta1.ServiceInstance) error {
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successUpdateInstanceReason, successUpdateInstanceMessage)
+	removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionUnusable)
 	instance.Status.ExternalProperties = instance.Status.InProgressProperties
+	recordServiceInstancePropertiesSnapshot(instance, v1beta1.ServiceInstanceOperationUpdate)
+	recordServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationUpdate, v1beta1.ServiceInstanceOperationResultSucceeded)
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
 
@@ -2496,6 +2985,7 @@ func (c *controller) processUpdateServiceInstanceFailure(instance *v1beta1.Servi
 
 	if failedCond != nil {
 		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionFailed, failedCond.Status, failedCond.Reason, failedCond.Message)
+		recordServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationUpdate, v1beta1.ServiceInstanceOperationResultFailed)
 		// Reset the current operation if there was a terminal error
 		clearServiceInstanceCurrentOperation(instance)
 	} else {
@@ -2508,6 +2998,9 @@ func (c *controller) processUpdateServiceInstanceFailure(instance *v1beta1.Servi
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
+	if failedCond != nil {
+		c.notifyTerminalFailure("ServiceInstance", instance, FailureOperationUpdate, failedCond.Reason, failedCond.Message)
+	}
 
 	// The instance will be requeued in any case, since we updated the status
 	// a few lines above.
@@ -2539,6 +3032,9 @@ func (c *controller) processUpdateServiceInstanceAsyncResponse(instance *v1beta1
 // a ServiceInstance that has successfully been deprovisioned at the broker.
 func (c *controller) processDeprovisionSuccess(instance *v1beta1.ServiceInstance) error {
 	mitigatingOrphan := instance.Status.OrphanMitigationInProgress
+	if !mitigatingOrphan {
+		c.recordServiceInstanceTombstone(instance)
+	}
 
 	reason := successDeprovisionReason
 	msg := successDeprovisionMessage
@@ -2550,10 +3046,12 @@ func (c *controller) processDeprovisionSuccess(instance *v1beta1.ServiceInstance
 	}
 
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, reason, msg)
+	recordServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationDeprovision, v1beta1.ServiceInstanceOperationResultSucceeded)
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.ExternalProperties = nil
 	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusNotProvisioned
 	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusSucceeded
+	c.mirroredRequests.forget(instance)
 
 	if mitigatingOrphan {
 		if _, err := c.updateServiceInstanceStatus(instance); err != nil {
@@ -2579,10 +3077,12 @@ func (c *controller) processDeprovisionFailure(instance *v1beta1.ServiceInstance
 		return fmt.Errorf("failedCond must not be nil")
 	}
 
+	operation, notifiedCond := FailureOperationDeprovision, failedCond
 	if instance.Status.OrphanMitigationInProgress {
 		// replace Ready condition with orphan mitigation-related one.
 		msg := "Orphan mitigation failed: " + failedCond.Message
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorOrphanMitigationFailedReason, msg)
+		operation, notifiedCond = FailureOperationOrphanMitigation, readyCond
 
 		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
 		c.recorder.Event(instance, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
@@ -2596,12 +3096,14 @@ func (c *controller) processDeprovisionFailure(instance *v1beta1.ServiceInstance
 		c.recorder.Event(instance, corev1.EventTypeWarning, failedCond.Reason, failedCond.Message)
 	}
 
+	recordServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationDeprovision, v1beta1.ServiceInstanceOperationResultFailed)
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusFailed
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
+	c.notifyTerminalFailure("ServiceInstance", instance, operation, notifiedCond.Reason, notifiedCond.Message)
 
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"strings"
@@ -41,7 +42,11 @@ import (
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics/osbclientproxy"
 	"github.com/kubernetes-incubator/service-catalog/test/fake"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	corev1 "k8s.io/api/core/v1"
 	clientgotesting "k8s.io/client-go/testing"
 )
@@ -1176,6 +1181,10 @@ func TestReconcileServiceInstance(t *testing.T) {
 	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
 	assertServiceInstanceOperationSuccess(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision, testClusterServicePlanName, testClusterServicePlanGUID, instance)
 	assertServiceInstanceDashboardURL(t, updatedServiceInstance, testDashboardURL)
+	assertServiceInstanceReadyAtAfterProvisionStartedAt(t, updatedServiceInstance)
+	if operations := updatedServiceInstance.(*v1beta1.ServiceInstance).Status.Operations; len(operations) != 1 || operations[0].Result != v1beta1.ServiceInstanceOperationResultSucceeded {
+		t.Fatalf("expected the provision to be recorded as succeeded, got %+v", operations)
+	}
 
 	events := getRecordedEvents(testController)
 
@@ -1185,6 +1194,174 @@ func TestReconcileServiceInstance(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceInstanceReadOnly tests that a read-only controller does
+// not provision an instance.
+func TestReconcileServiceInstanceReadOnly(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.readOnly = true
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
+
+// newAdoptionBrokerServer returns a broker server answering the fetch of the
+// test instance with the given status code and body.
+func newAdoptionBrokerServer(t *testing.T, statusCode int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e, a := "/v2/service_instances/"+testServiceInstanceGUID, r.URL.Path; e != a {
+			t.Errorf("unexpected request path: %v", expectedGot(e, a))
+		}
+		w.WriteHeader(statusCode)
+		w.Write([]byte(body))
+	}))
+}
+
+// TestReconcileServiceInstanceAdopt tests that an existing instance fetched
+// from the broker with the class and plan of the ServiceInstance is adopted
+// without provisioning it.
+func TestReconcileServiceInstanceAdopt(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	server := newAdoptionBrokerServer(t, http.StatusOK, fmt.Sprintf(
+		`{"service_id":%q,"plan_id":%q,"dashboard_url":"https://dashboard.example.com"}`,
+		testClusterServiceClassGUID, testClusterServicePlanGUID,
+	))
+	defer server.Close()
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		config.URL = server.URL
+		return osbclientproxy.NewClient(config)
+	}
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	serviceClass := getTestClusterServiceClass()
+	serviceClass.Spec.InstancesRetrievable = true
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(serviceClass)
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Spec.Adopt = true
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	instance = assertServiceInstanceProvisionInProgressIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceReadyCondition(t, updatedServiceInstance, v1beta1.ConditionTrue, successAdoptReason)
+	assertServiceInstanceCurrentOperationClear(t, updatedServiceInstance)
+	assertServiceInstanceProvisioned(t, updatedServiceInstance, v1beta1.ServiceInstanceProvisionStatusProvisioned)
+	assertServiceInstanceDeprovisionStatus(t, updatedServiceInstance, v1beta1.ServiceInstanceDeprovisionStatusRequired)
+	adopted := updatedServiceInstance.(*v1beta1.ServiceInstance)
+	if adopted.Status.ExternalProperties == nil {
+		t.Fatalf("expected external properties to be set")
+	}
+	if adopted.Status.DashboardURL == nil || *adopted.Status.DashboardURL != "https://dashboard.example.com" {
+		t.Fatalf("expected the dashboard URL of the broker instance, got %v", adopted.Status.DashboardURL)
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(successAdoptReason).msg(successAdoptMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceAdoptFailure tests that an instance is not
+// adopted, and is never deprovisioned, when its class does not support
+// fetching instances, when the broker does not return it, or when the broker
+// returns it with another plan.
+func TestReconcileServiceInstanceAdoptFailure(t *testing.T) {
+	cases := []struct {
+		name                 string
+		instancesRetrievable bool
+		statusCode           int
+		body                 string
+	}{
+		{
+			name:       "not retrievable",
+			statusCode: http.StatusOK,
+			body:       fmt.Sprintf(`{"service_id":%q,"plan_id":%q}`, testClusterServiceClassGUID, testClusterServicePlanGUID),
+		},
+		{
+			name:                 "not found",
+			instancesRetrievable: true,
+			statusCode:           http.StatusNotFound,
+			body:                 `{}`,
+		},
+		{
+			name:                 "other plan",
+			instancesRetrievable: true,
+			statusCode:           http.StatusOK,
+			body:                 fmt.Sprintf(`{"service_id":%q,"plan_id":"other-plan"}`, testClusterServiceClassGUID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+			server := newAdoptionBrokerServer(t, tc.statusCode, tc.body)
+			defer server.Close()
+			testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+				config.URL = server.URL
+				return osbclientproxy.NewClient(config)
+			}
+
+			addGetNamespaceReaction(fakeKubeClient)
+
+			serviceClass := getTestClusterServiceClass()
+			serviceClass.Spec.InstancesRetrievable = tc.instancesRetrievable
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(serviceClass)
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.Spec.Adopt = true
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			instance = assertServiceInstanceProvisionInProgressIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance)
+			fakeCatalogClient.ClearActions()
+
+			// A failed adoption is terminal, so it is not retried.
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			assertServiceInstanceReadyFalse(t, updatedServiceInstance, errorAdoptInstanceFailedReason)
+			assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, errorAdoptInstanceFailedReason)
+			assertServiceInstanceDeprovisionStatus(t, updatedServiceInstance, v1beta1.ServiceInstanceDeprovisionStatusNotRequired)
+		})
+	}
+}
+
 // TestReconcileServiceInstanceFailsWithDeletedPlan tests that a ServiceInstance is not
 // created if the ServicePlan specified is marked as RemovedFromCatalog.
 func TestReconcileServiceInstanceFailsWithDeletedPlan(t *testing.T) {
@@ -2170,6 +2347,229 @@ func TestReconcileServiceInstanceDeleteDoesNotInvokeClusterServiceBroker(t *test
 	assertNumEvents(t, events, 0)
 }
 
+// TestReconcileServiceInstanceDeleteAbandon verifies that an instance whose
+// deletion policy is Abandon has its finalizer cleared without a deprovision
+// request being sent to the broker, even if a previous deprovision failed.
+func TestReconcileServiceInstanceDeleteAbandon(t *testing.T) {
+	cases := []struct {
+		name              string
+		deprovisionStatus v1beta1.ServiceInstanceDeprovisionStatus
+	}{
+		{
+			name:              "deprovision required",
+			deprovisionStatus: v1beta1.ServiceInstanceDeprovisionStatusRequired,
+		},
+		{
+			name:              "deprovision failed",
+			deprovisionStatus: v1beta1.ServiceInstanceDeprovisionStatusFailed,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+			instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+			instance.Spec.DeletionPolicy = v1beta1.DeletionPolicyAbandon
+			instance.Generation = 2
+			instance.Status.ReconciledGeneration = 2
+			instance.Status.ObservedGeneration = 2
+			instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+			instance.Status.DeprovisionStatus = tc.deprovisionStatus
+
+			fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, instance, nil
+			})
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			assertNumberOfBrokerActions(t, brokerActions, 0)
+
+			kubeActions := fakeKubeClient.Actions()
+			assertNumberOfActions(t, kubeActions, 0)
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			assertEmptyFinalizers(t, updatedServiceInstance)
+
+			events := getRecordedEvents(testController)
+			expectedEvent := normalEventBuilder(abandonedDeprovisionReason).msg(abandonedDeprovisionMessage)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// TestPollServiceInstanceAbandonedDuringAsyncProvision verifies that an
+// instance with the Abandon deletion policy that is deleted while its
+// provision is still in progress at the broker is removed without polling
+// the broker again.
+func TestPollServiceInstanceAbandonedDuringAsyncProvision(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		PollLastOperationReaction: &fakeosb.PollLastOperationReaction{
+			Response: &osb.LastOperationResponse{State: osb.StateInProgress},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Spec.DeletionPolicy = v1beta1.DeletionPolicyAbandon
+
+	fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, instance, nil
+	})
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertEmptyFinalizers(t, updatedServiceInstance)
+	if updatedServiceInstance.(*v1beta1.ServiceInstance).Status.AsyncOpInProgress {
+		t.Fatal("expected the async operation to be cleared")
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(abandonedDeprovisionReason).msg(abandonedDeprovisionMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceDeleteAbandonBlockedByCredentials verifies that
+// an abandoned instance is not removed while it still has bindings.
+func TestReconcileServiceInstanceDeleteAbandonBlockedByCredentials(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(getTestServiceBinding())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Spec.DeletionPolicy = v1beta1.DeletionPolicyAbandon
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+
+	fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, instance, nil
+	})
+
+	if err := reconcileServiceInstance(t, testController, instance); err == nil {
+		t.Fatal("expected reconcileServiceInstance to return an error")
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, errorDeprovisionBlockedByCredentialsReason)
+	if e, a := []string{v1beta1.FinalizerServiceCatalog}, updatedServiceInstance.(*v1beta1.ServiceInstance).Finalizers; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected finalizers: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileServiceInstanceDeleteCascadeBindings tests that deleting an
+// instance whose binding deletion policy is Cascade deletes its bindings and
+// waits for them to be removed before deprovisioning.
+func TestReconcileServiceInstanceDeleteCascadeBindings(t *testing.T) {
+	terminatingBinding := getTestServiceBindingUnbinding()
+
+	cases := []struct {
+		name          string
+		binding       *v1beta1.ServiceBinding
+		expectDelete  bool
+		expectedEvent string
+	}{
+		{
+			name:          "binding is deleted",
+			binding:       getTestServiceBinding(),
+			expectDelete:  true,
+			expectedEvent: fmt.Sprintf(deletingBindingsMessage, 1),
+		},
+		{
+			name:          "binding is already being deleted",
+			binding:       terminatingBinding,
+			expectedEvent: fmt.Sprintf(deletingBindingsMessage, 1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+			sharedInformers.ServiceBindings().Informer().GetStore().Add(tc.binding)
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+			instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+			instance.Spec.BindingDeletionPolicy = v1beta1.BindingDeletionPolicyCascade
+			instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+
+			if err := reconcileServiceInstance(t, testController, instance); err == nil {
+				t.Fatal("expected reconcileServiceInstance to return an error")
+			}
+
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			assertNumberOfBrokerActions(t, brokerActions, 0)
+
+			kubeActions := fakeKubeClient.Actions()
+			assertNumberOfActions(t, kubeActions, 0)
+
+			actions := fakeCatalogClient.Actions()
+			if tc.expectDelete {
+				assertNumberOfActions(t, actions, 2)
+				assertDelete(t, actions[0], tc.binding)
+				actions = actions[1:]
+			} else {
+				assertNumberOfActions(t, actions, 1)
+			}
+
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			assertServiceInstanceReadyFalse(t, updatedServiceInstance, deletingBindingsReason)
+
+			events := getRecordedEvents(testController)
+			expectedEvent := warningEventBuilder(deletingBindingsReason).msg(tc.expectedEvent)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 // TestFinalizerClearedWhen409ConflictEncounteredOnStatusUpdate verfies that the finalizer
 // is removed even when the status update gets back a 409 Conflict from the API server
 // because the controller is working with an old version of the ServiceInstance
@@ -2679,6 +3079,9 @@ func TestPollServiceInstanceFailureDeprovisioning(t *testing.T) {
 		testClusterServicePlanGUID,
 		instance,
 	)
+	if e, a := int64(1), updatedServiceInstance.(*v1beta1.ServiceInstance).Status.FailedDeprovisionAttempts; e != a {
+		t.Fatalf("unexpected failed deprovision attempts: %v", expectedGot(e, a))
+	}
 
 	events := getRecordedEvents(testController)
 
@@ -2871,7 +3274,9 @@ func TestPollServiceInstanceClusterServiceBrokerError(t *testing.T) {
 	assertNumberOfActions(t, kubeActions, 0)
 
 	actions := fakeCatalogClient.Actions()
-	assertNumberOfActions(t, actions, 0)
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstancePollErrorRecorded(t, updatedServiceInstance)
 
 	events := getRecordedEvents(testController)
 
@@ -4534,10 +4939,103 @@ func TestReconcileServiceInstanceUpdateParameters(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successUpdateInstanceReason).msg("The instance was updated successfully")
-	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+	expectedEvents := []string{
+		normalEventBuilder(instanceParametersChangedReason).msg("Updating parameters: changed args.second").String(),
+		normalEventBuilder(successUpdateInstanceReason).msg("The instance was updated successfully").String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
 		t.Fatal(err)
 	}
+
+	expectedDiff := &v1beta1.ParametersDiff{Changed: []string{"args.second"}}
+	if e, a := expectedDiff, updateObject.Status.ParametersDiff; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected parameters diff: %v", diff.ObjectReflectDiff(e, a))
+	}
+
+	history := updateObject.Status.PropertiesHistory
+	if len(history) != 1 {
+		t.Fatalf("expected a single properties snapshot, got %v", len(history))
+	}
+	if e, a := v1beta1.ServiceInstanceOperationUpdate, history[0].Operation; e != a {
+		t.Fatalf("unexpected snapshot operation: expected %v, got %v", e, a)
+	}
+	if e, a := expectedParametersChecksum, history[0].Properties.ParametersChecksum; e != a {
+		t.Fatalf("unexpected snapshot parameters checksum: expected %v, got %v", e, a)
+	}
+}
+
+// TestRecordServiceInstancePropertiesSnapshot tests that the properties
+// history of an instance keeps only the latest snapshots.
+func TestRecordServiceInstancePropertiesSnapshot(t *testing.T) {
+	instance := getTestServiceInstanceWithClusterRefs()
+	recordServiceInstancePropertiesSnapshot(instance, v1beta1.ServiceInstanceOperationProvision)
+	if len(instance.Status.PropertiesHistory) != 0 {
+		t.Fatalf("expected no snapshot of an instance without external properties, got %v", instance.Status.PropertiesHistory)
+	}
+
+	for i := 0; i < v1beta1.MaxServiceInstancePropertiesHistory+2; i++ {
+		instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+			ParametersChecksum: fmt.Sprintf("checksum-%d", i),
+		}
+		recordServiceInstancePropertiesSnapshot(instance, v1beta1.ServiceInstanceOperationUpdate)
+	}
+
+	history := instance.Status.PropertiesHistory
+	if e, a := v1beta1.MaxServiceInstancePropertiesHistory, len(history); e != a {
+		t.Fatalf("expected %v snapshots, got %v", e, a)
+	}
+	if e, a := "checksum-2", history[0].Properties.ParametersChecksum; e != a {
+		t.Fatalf("expected the oldest snapshots to be dropped: expected %v, got %v", e, a)
+	}
+	last := v1beta1.MaxServiceInstancePropertiesHistory + 1
+	if e, a := fmt.Sprintf("checksum-%d", last), history[len(history)-1].Properties.ParametersChecksum; e != a {
+		t.Fatalf("expected the latest snapshot last: expected %v, got %v", e, a)
+	}
+
+	// A snapshot does not change when the instance changes afterwards.
+	instance.Status.ExternalProperties.ParametersChecksum = "changed"
+	if history[len(history)-1].Properties.ParametersChecksum == "changed" {
+		t.Fatal("expected the snapshot to be a copy of the external properties")
+	}
+}
+
+func TestRecordServiceInstanceOperation(t *testing.T) {
+	instance := getTestServiceInstanceWithClusterRefs()
+	startTime := metav1.NewTime(time.Now().Add(-time.Minute))
+	instance.Status.OperationStartTime = &startTime
+	operationKey := "op-key"
+	instance.Status.LastOperation = &operationKey
+
+	recordServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationProvision, v1beta1.ServiceInstanceOperationResultSucceeded)
+	record := instance.Status.Operations[0]
+	if e, a := v1beta1.ServiceInstanceOperationProvision, record.Operation; e != a {
+		t.Fatalf("expected operation %v, got %v", e, a)
+	}
+	if e, a := v1beta1.ServiceInstanceOperationResultSucceeded, record.Result; e != a {
+		t.Fatalf("expected result %v, got %v", e, a)
+	}
+	if !record.StartTime.Equal(&startTime) || record.CompletionTime == nil {
+		t.Fatalf("expected the start and completion times to be recorded, got %v and %v", record.StartTime, record.CompletionTime)
+	}
+	if record.OperationKey == nil || *record.OperationKey != operationKey {
+		t.Fatalf("expected operation key %v, got %v", operationKey, record.OperationKey)
+	}
+
+	// The record does not change when the operation key is cleared.
+	clearServiceInstanceCurrentOperation(instance)
+	if *instance.Status.Operations[0].OperationKey != operationKey {
+		t.Fatal("expected the operation key to be copied")
+	}
+
+	for i := 0; i < v1beta1.MaxServiceInstanceOperations+2; i++ {
+		recordServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationUpdate, v1beta1.ServiceInstanceOperationResultFailed)
+	}
+	if e, a := v1beta1.MaxServiceInstanceOperations, len(instance.Status.Operations); e != a {
+		t.Fatalf("expected %v operations, got %v", e, a)
+	}
+	if e, a := v1beta1.ServiceInstanceOperationUpdate, instance.Status.Operations[0].Operation; e != a {
+		t.Fatalf("expected the oldest operations to be dropped: expected %v, got %v", e, a)
+	}
 }
 
 // TestReconcileServiceInstanceDeleteParameters tests updating a
@@ -4630,10 +5128,18 @@ func TestReconcileServiceInstanceDeleteParameters(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successUpdateInstanceReason).msg("The instance was updated successfully")
-	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+	expectedEvents := []string{
+		normalEventBuilder(instanceParametersChangedReason).msg("Updating parameters: removed args, name").String(),
+		normalEventBuilder(successUpdateInstanceReason).msg("The instance was updated successfully").String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
 		t.Fatal(err)
 	}
+
+	expectedDiff := &v1beta1.ParametersDiff{Removed: []string{"args", "name"}}
+	if e, a := expectedDiff, updateObject.Status.ParametersDiff; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected parameters diff: %v", diff.ObjectReflectDiff(e, a))
+	}
 }
 
 // TestResolveReferencesNoClusterServicePlan tests that resolveReferences fails
@@ -4971,6 +5477,60 @@ func TestReconcileServiceInstanceUpdatePlan(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceInstanceUpdatePlanInvalidUpgradePath tests that an
+// instance is not moved to a plan that is not among the upgrade paths
+// declared by its current plan.
+func TestReconcileServiceInstanceUpdatePlanInvalidUpgradePath(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	oldPlan := getTestClusterServicePlan()
+	oldPlan.Name = "old-plan-id"
+	oldPlan.Spec.ExternalID = "old-plan-id"
+	oldPlan.Spec.ExternalName = "old-plan-name"
+	oldPlan.Spec.ExternalMetadata = &runtime.RawExtension{Raw: []byte(`{"upgradePaths": ["other-plan-name"]}`)}
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(oldPlan)
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Generation = 2
+	instance.Status.ReconciledGeneration = 1
+	instance.Status.ObservedGeneration = 1
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: "old-plan-name",
+		ClusterServicePlanExternalID:   "old-plan-id",
+	}
+
+	if err := reconcileServiceInstance(t, testController, instance); err == nil {
+		t.Fatalf("This should fail")
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, errorInvalidPlanUpgradeReason)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := warningEventBuilder(errorInvalidPlanUpgradeReason).msgf(
+		"ClusterServicePlan (K8S: %q ExternalName: %q) cannot be upgraded to ClusterServicePlan (K8S: %q ExternalName: %q); valid upgrade paths are [other-plan-name]",
+		"old-plan-id", "old-plan-name", testClusterServicePlanGUID, testClusterServicePlanName,
+	)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestReconcileServiceInstanceWithUpdateCallFailure tests that when the update
 // call to the broker fails, the ready condition becomes false, and the
 // failure condition is not set.
@@ -5524,6 +6084,50 @@ func TestPollServiceInstanceAsyncFailureUpdating(t *testing.T) {
 	assertServiceInstanceUpdateRequestFailingErrorNoOrphanMitigation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, errorUpdateInstanceCallFailedReason, "", instance)
 }
 
+// TestPollServiceInstanceAsyncFailureUpdatingInstanceUnusable tests that a
+// failed update reported by the broker as leaving the instance unusable sets
+// the Unusable condition on the instance.
+func TestPollServiceInstanceAsyncFailureUpdatingInstanceUnusable(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	// The fake client cannot report instance_usable, which the OSB client
+	// library does not decode, so the poll is sent to a broker server.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"state":"failed","description":%q,"instance_usable":false}`, lastOperationDescription)
+	}))
+	defer server.Close()
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		config.URL = server.URL
+		return osb.NewClient(config)
+	}
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceAsyncUpdating(testOperation)
+
+	if err := testController.pollServiceInstance(instance); err != nil {
+		t.Fatalf("pollServiceInstance failed: %s", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionUnusable, v1beta1.ConditionTrue, errorInstanceUnusableReason)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvents := []string{
+		warningEventBuilder(errorInstanceUnusableReason).msg(errorInstanceUnusableMessage + ": " + lastOperationDescription).String(),
+		warningEventBuilder(errorUpdateInstanceCallFailedReason).msg("Update call failed: " + lastOperationDescription).String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestCheckClassAndPlanForDeletion(t *testing.T) {
 	cases := []struct {
 		name           string
@@ -6005,3 +6609,73 @@ func reconcileServiceInstance(t *testing.T, testController *controller, instance
 	}
 	return err
 }
+
+// TestPrepareProvisionRequestRecordsContext tests that the context sent to the
+// broker is recorded in the in-progress properties of the instance.
+func TestPrepareProvisionRequestRecordsContext(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	addGetNamespaceReaction(fakeKubeClient)
+	testController.setClusterID(testClusterID)
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	request, inProgressProperties, err := testController.innerPrepareProvisionRequest(
+		instance,
+		getTestClusterServiceClass().Spec.CommonServiceClassSpec,
+		getTestClusterServicePlan().Spec.CommonServicePlanSpec,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inProgressProperties.Context == nil {
+		t.Fatalf("expected the context to be recorded")
+	}
+	var recorded map[string]interface{}
+	if err := json.Unmarshal(inProgressProperties.Context.Raw, &recorded); err != nil {
+		t.Fatalf("unexpected error unmarshalling the recorded context: %v", err)
+	}
+	if !reflect.DeepEqual(recorded, request.Context) {
+		t.Fatalf("recorded context does not match the sent one: %v", diff.ObjectReflectDiff(request.Context, recorded))
+	}
+	if e, a := testClusterID, recorded[clusterIdentifierKey]; e != a {
+		t.Fatalf("unexpected recorded cluster id: expected %v, got %v", e, a)
+	}
+}
+
+// TestProcessProvisionSuccessObservesTimeToReady tests that the time from the
+// creation of an instance until it first becomes ready is observed by class
+// and plan, and that it is not observed again when the instance is ready
+// again after an update.
+func TestProcessProvisionSuccessObservesTimeToReady(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+
+	timeToReady := metrics.ServiceInstanceTimeToReady.WithLabelValues(testClusterServiceBrokerName, testClusterServiceClassName, testClusterServicePlanName)
+	sampleCount := func() uint64 {
+		m := &dto.Metric{}
+		if err := timeToReady.(prometheus.Metric).Write(m); err != nil {
+			t.Fatalf("unexpected error reading the metric: %v", err)
+		}
+		return m.GetHistogram().GetSampleCount()
+	}
+	before := sampleCount()
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+	instance.Status.InProgressProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+	}
+	if err := testController.processProvisionSuccess(instance, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := before+1, sampleCount(); e != a {
+		t.Fatalf("unexpected number of observations: %v", expectedGot(e, a))
+	}
+
+	if err := testController.processProvisionSuccess(instance, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := before+1, sampleCount(); e != a {
+		t.Fatalf("expected the time to ready to be observed once: %v", expectedGot(e, a))
+	}
+}
@@ -63,6 +63,7 @@ func (c *controller) serviceBrokerAdd(obj interface{}) {
 		return
 	}
 	c.serviceBrokerQueue.Add(key)
+	c.enqueueServiceBrokerSummary(obj)
 }
 
 func (c *controller) serviceBrokerUpdate(oldObj, newObj interface{}) {
@@ -70,6 +71,8 @@ func (c *controller) serviceBrokerUpdate(oldObj, newObj interface{}) {
 }
 
 func (c *controller) serviceBrokerDelete(obj interface{}) {
+	c.enqueueServiceBrokerSummary(obj)
+
 	broker, ok := obj.(*v1beta1.ServiceBroker)
 	if broker == nil || !ok {
 		return
@@ -90,6 +93,12 @@ func shouldReconcileServiceBroker(broker *v1beta1.ServiceBroker, now time.Time)
 		// If the spec has changed, we should reconcile the broker.
 		return true
 	}
+	if broker.Status.RelistRequested(broker.Annotations) {
+		// A relist was requested through the annotation and has not been
+		// honored yet.
+		glog.V(4).Info(pcb.Message("Processing because a relist was requested"))
+		return true
+	}
 	if broker.DeletionTimestamp != nil || len(broker.Status.Conditions) == 0 {
 		// If the deletion timestamp is set or the broker has no status
 		// conditions, we should reconcile it.
@@ -176,7 +185,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 	}
 
 	if broker.DeletionTimestamp == nil { // Add or update
-		authConfig, err := getAuthCredentialsFromServiceBroker(c.kubeClient, broker)
+		clientConfig, err := getClientConfigurationForServiceBroker(c.kubeClient, broker)
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker auth credentials: %s", err)
 			glog.Info(pcb.Message(s))
@@ -187,11 +196,8 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			return err
 		}
 
-		// clientConfig := NewClientConfigurationForBroker(broker, authConfig)
-		clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-
 		glog.V(4).Info(pcb.Messagef("Creating client, URL: %v", broker.Spec.URL))
-		brokerClient, err := c.brokerClientCreateFunc(clientConfig)
+		brokerClient, err := c.newBrokerClient(clientConfig, broker.Spec.FailoverURLs)
 		if err != nil {
 			s := fmt.Sprintf("Error creating client for broker %q: %s", broker.Name, err)
 			glog.Info(pcb.Message(s))
@@ -206,7 +212,16 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 
 		// get the broker's catalog
 		now := metav1.Now()
-		brokerCatalog, err := brokerClient.GetCatalog()
+		brokerCatalog, instancesRetrievable, err := c.getServiceBrokerCatalog(broker, clientConfig, brokerClient)
+		if isUnsupportedAPIVersionError(err) {
+			// Retrying does not help until the broker or the catalog is
+			// upgraded, so the broker is only checked again on resync.
+			s := unsupportedAPIVersionMessage(clientConfig.ClientConfiguration)
+			glog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, errorUnsupportedOSBAPIVersionReason, s)
+			metrics.BrokerUnsupportedOSBAPIVersion.WithLabelValues(broker.Name).Set(1)
+			return c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorUnsupportedOSBAPIVersionReason, s)
+		}
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker catalog: %s", err)
 			glog.Warning(pcb.Message(s))
@@ -238,6 +253,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		}
 
 		glog.V(5).Info(pcb.Messagef("Successfully fetched %v catalog entries", len(brokerCatalog.Services)))
+		c.storeServiceBrokerCatalog(broker, brokerCatalog, now)
 
 		// set the operation start time if not already set
 		if broker.Status.OperationStartTime != nil {
@@ -249,6 +265,17 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			}
 		}
 
+		brokerCatalog, err = c.mutateCatalog("ServiceBroker", broker.ObjectMeta, brokerCatalog)
+		if err != nil {
+			s := fmt.Sprintf("Error mutating catalog payload for broker %q: %s", broker.Name, err)
+			glog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
+			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
+				return err
+			}
+			return err
+		}
+
 		// convert the broker's catalog payload into our API objects
 		glog.V(4).Info(pcb.Message("Converting catalog response into service-catalog API"))
 
@@ -263,6 +290,10 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			return err
 		}
 
+		for _, serviceClass := range payloadServiceClasses {
+			serviceClass.Spec.InstancesRetrievable = instancesRetrievable[serviceClass.Spec.ExternalID]
+		}
+
 		glog.V(5).Info(pcb.Message("Successfully converted catalog payload from to service-catalog API"))
 
 		// get the existing services and plans for this broker so that we can
@@ -276,17 +307,29 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		existingServiceClassMap := convertServiceClassListToMap(existingServiceClasses)
 		existingServicePlanMap := convertServicePlanListToMap(existingServicePlans)
 
-		// reconcile the serviceClasses that were part of the broker's catalog
-		// payload
+		// handle the serviceClasses that were not in the broker's payload;
+		// mark these as having been removed from the broker's catalog. This
+		// is done first, so that a class that the broker replaced with a class
+		// of the same external name is not in the way of the new class.
+		payloadServiceClassNames := sets.NewString()
 		for _, payloadServiceClass := range payloadServiceClasses {
-			existingServiceClass, _ := existingServiceClassMap[payloadServiceClass.Name]
-			delete(existingServiceClassMap, payloadServiceClass.Name)
+			payloadServiceClassNames.Insert(payloadServiceClass.Name)
+		}
+		for _, existingServiceClass := range existingServiceClassMap {
+			if payloadServiceClassNames.Has(existingServiceClass.Name) || existingServiceClass.Status.RemovedFromBrokerCatalog {
+				continue
+			}
 
-			glog.V(4).Info(pcb.Messagef("Reconciling %s", pretty.ServiceClassName(payloadServiceClass)))
-			if err := c.reconcileServiceClassFromServiceBrokerCatalog(broker, payloadServiceClass, existingServiceClass); err != nil {
+			glog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ServiceClassName(existingServiceClass)))
+			existingServiceClass.Status.RemovedFromBrokerCatalog = true
+			if gracePeriod := getCatalogRemovalGracePeriod(&broker.Spec.CommonServiceBrokerSpec); gracePeriod > 0 {
+				setPendingRemoval(&existingServiceClass.Status.PendingRemoval, &existingServiceClass.Status.RemovalTime, now.Add(gracePeriod))
+			}
+			_, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).UpdateStatus(existingServiceClass)
+			if err != nil {
 				s := fmt.Sprintf(
-					"Error reconciling %s (broker %q): %s",
-					pretty.ServiceClassName(payloadServiceClass), broker.Name, err,
+					"Error updating status of %s: %v",
+					pretty.ServiceClassName(existingServiceClass), err,
 				)
 				glog.Warning(pcb.Message(s))
 				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
@@ -296,33 +339,30 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 				}
 				return err
 			}
-
-			glog.V(5).Info(pcb.Messagef("Reconciled %s", pretty.ServiceClassName(payloadServiceClass)))
 		}
 
-		// handle the serviceClasses that were not in the broker's payload;
-		// mark these as having been removed from the broker's catalog
-		for _, existingServiceClass := range existingServiceClassMap {
-			if existingServiceClass.Status.RemovedFromBrokerCatalog {
-				continue
-			}
+		// reconcile the serviceClasses that were part of the broker's catalog
+		// payload
+		for _, payloadServiceClass := range payloadServiceClasses {
+			existingServiceClass, _ := existingServiceClassMap[payloadServiceClass.Name]
+			delete(existingServiceClassMap, payloadServiceClass.Name)
 
-			glog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ServiceClassName(existingServiceClass)))
-			existingServiceClass.Status.RemovedFromBrokerCatalog = true
-			_, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).UpdateStatus(existingServiceClass)
-			if err != nil {
+			glog.V(4).Info(pcb.Messagef("Reconciling %s", pretty.ServiceClassName(payloadServiceClass)))
+			if err := c.reconcileServiceClassFromServiceBrokerCatalog(broker, payloadServiceClass, existingServiceClass); err != nil {
 				s := fmt.Sprintf(
-					"Error updating status of %s: %v",
-					pretty.ServiceClassName(existingServiceClass), err,
+					"Error reconciling %s (broker %q): %s",
+					pretty.ServiceClassName(payloadServiceClass), broker.Name, err,
 				)
 				glog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
+				c.recorder.Event(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
 				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
 					errorSyncingCatalogMessage+s); err != nil {
 					return err
 				}
 				return err
 			}
+
+			glog.V(5).Info(pcb.Messagef("Reconciled %s", pretty.ServiceClassName(payloadServiceClass)))
 		}
 
 		// reconcile the plans that were part of the broker's catalog payload
@@ -357,6 +397,9 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			}
 			glog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ServicePlanName(existingServicePlan)))
 			existingServicePlan.Status.RemovedFromBrokerCatalog = true
+			if gracePeriod := getCatalogRemovalGracePeriod(&broker.Spec.CommonServiceBrokerSpec); gracePeriod > 0 {
+				setPendingRemoval(&existingServicePlan.Status.PendingRemoval, &existingServicePlan.Status.RemovalTime, now.Add(gracePeriod))
+			}
 			_, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).UpdateStatus(existingServicePlan)
 			if err != nil {
 				s := fmt.Sprintf(
@@ -374,9 +417,29 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			}
 		}
 
-		// everything worked correctly; update the broker's ready condition to
-		// status true
-		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+		// apply the broker's catalog removal policy to the instances of the
+		// classes and plans that have been removed from its catalog
+		if err := c.applyCatalogRemovalPolicyToServiceInstances(broker, payloadServiceClasses, existingServiceClassMap, existingServicePlanMap); err != nil {
+			return err
+		}
+
+		// copy the classes and plans into the broker's target namespaces
+		if err := c.reconcileSharedCatalog(broker, payloadServiceClasses, payloadServicePlans, now.Time); err != nil {
+			s := fmt.Sprintf("Error sharing the catalog of broker %q with its target namespaces: %s", broker.Name, err)
+			glog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
+			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				errorSyncingCatalogMessage+s); err != nil {
+				return err
+			}
+			return err
+		}
+
+		// everything worked correctly; record the catalog statistics and
+		// update the broker's ready condition to status true
+		toUpdate := broker.DeepCopy()
+		setCatalogStatistics(&toUpdate.Status.CommonServiceBrokerStatus, brokerCatalog, clientConfig.ClientConfiguration, len(payloadServiceClasses), len(payloadServicePlans), now.Time)
+		if err := c.updateServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
 			return err
 		}
 
@@ -385,6 +448,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		// Update metrics with the number of serviceclass and serviceplans from this broker
 		metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(len(payloadServiceClasses)))
 		metrics.BrokerServicePlanCount.WithLabelValues(broker.Name).Set(float64(len(payloadServicePlans)))
+		metrics.BrokerUnsupportedOSBAPIVersion.WithLabelValues(broker.Name).Set(0)
 
 		return nil
 	}
@@ -400,6 +464,19 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			return err
 		}
 
+		// the copies in the target namespaces are deleted once no instance
+		// uses them
+		if err := c.reconcileSharedCatalog(broker, nil, nil, time.Now()); err != nil {
+			glog.Warning(pcb.Messagef("Error removing the shared catalog: %v", err))
+			return err
+		}
+
+		// keep the classes and plans until the broker's catalog removal
+		// grace period has passed
+		if delay, err := c.scheduleServiceBrokerCatalogRemoval(broker, existingServiceClasses, existingServicePlans); err != nil || delay > 0 {
+			return err
+		}
+
 		glog.V(4).Info(pcb.Messagef("Found %d ServiceClasses and %d ServicePlans to delete", len(existingServiceClasses), len(existingServicePlans)))
 
 		for _, plan := range existingServicePlans {
@@ -459,6 +536,8 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		// delete the metrics associated with this broker
 		metrics.BrokerServiceClassCount.DeleteLabelValues(broker.Name)
 		metrics.BrokerServicePlanCount.DeleteLabelValues(broker.Name)
+		metrics.BrokerUnsupportedOSBAPIVersion.DeleteLabelValues(broker.Name)
+		metrics.BrokerStaleServiceInstanceCount.DeleteLabelValues(broker.Name)
 		return nil
 	}
 
@@ -486,7 +565,7 @@ func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1bet
 			// we do not expect to find an existing service class if we were
 			// not already passed one; the following if statement will almost
 			// certainly evaluate to true.
-			if otherServiceClass.Spec.ServiceBrokerName != broker.Name {
+			if otherServiceClass.Spec.ServiceBrokerName != broker.Name || otherServiceClass.Spec.ClusterServiceBroker {
 				errMsg := fmt.Sprintf("%s already exists for Broker %q",
 					pretty.ServiceClassName(serviceClass), otherServiceClass.Spec.ServiceBrokerName,
 				)
@@ -495,6 +574,8 @@ func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1bet
 			}
 		}
 
+		markAsServiceCatalogManagedResource(serviceClass, broker)
+
 		glog.V(5).Info(pcb.Messagef("Fresh %s; creating", pretty.ServiceClassName(serviceClass)))
 		if _, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).Create(serviceClass); err != nil {
 			glog.Error(pcb.Messagef("Error creating %s: %v", pretty.ServiceClassName(serviceClass), err))
@@ -519,6 +600,7 @@ func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1bet
 	// update it.
 	toUpdate := existingServiceClass.DeepCopy()
 	toUpdate.Spec.BindingRetrievable = serviceClass.Spec.BindingRetrievable
+	toUpdate.Spec.InstancesRetrievable = serviceClass.Spec.InstancesRetrievable
 	toUpdate.Spec.Bindable = serviceClass.Spec.Bindable
 	toUpdate.Spec.PlanUpdatable = serviceClass.Spec.PlanUpdatable
 	toUpdate.Spec.Tags = serviceClass.Spec.Tags
@@ -526,6 +608,11 @@ func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1bet
 	toUpdate.Spec.Requires = serviceClass.Spec.Requires
 	toUpdate.Spec.ExternalName = serviceClass.Spec.ExternalName
 	toUpdate.Spec.ExternalMetadata = serviceClass.Spec.ExternalMetadata
+	toUpdate.Spec.DocumentationURL = serviceClass.Spec.DocumentationURL
+	toUpdate.Spec.SupportURL = serviceClass.Spec.SupportURL
+	setExternalDocumentationAnnotations(toUpdate, toUpdate.Spec.DocumentationURL, toUpdate.Spec.SupportURL)
+
+	markAsServiceCatalogManagedResource(toUpdate, broker)
 
 	updatedServiceClass, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).Update(toUpdate)
 	if err != nil {
@@ -536,6 +623,7 @@ func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1bet
 	if updatedServiceClass.Status.RemovedFromBrokerCatalog {
 		glog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ServiceClassName(serviceClass)))
 		updatedServiceClass.Status.RemovedFromBrokerCatalog = false
+		clearPendingRemoval(&updatedServiceClass.Status.PendingRemoval, &updatedServiceClass.Status.RemovalTime)
 		_, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).UpdateStatus(updatedServiceClass)
 		if err != nil {
 			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ServiceClassName(updatedServiceClass), err)
@@ -569,7 +657,7 @@ func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta
 			// we do not expect to find an existing service class if we were
 			// not already passed one; the following if statement will almost
 			// certainly evaluate to true.
-			if otherServicePlan.Spec.ServiceBrokerName != broker.Name {
+			if otherServicePlan.Spec.ServiceBrokerName != broker.Name || otherServicePlan.Spec.ClusterServiceBroker {
 				errMsg := fmt.Sprintf(
 					"%s already exists for Broker %q",
 					pretty.ServicePlanName(servicePlan), otherServicePlan.Spec.ServiceBrokerName,
@@ -579,6 +667,8 @@ func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta
 			}
 		}
 
+		markAsServiceCatalogManagedResource(servicePlan, broker)
+
 		// An error returned from a lister Get call means that the object does
 		// not exist.  Create a new ServicePlan.
 		if _, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).Create(servicePlan); err != nil {
@@ -608,10 +698,15 @@ func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta
 	toUpdate.Spec.Free = servicePlan.Spec.Free
 	toUpdate.Spec.ExternalName = servicePlan.Spec.ExternalName
 	toUpdate.Spec.ExternalMetadata = servicePlan.Spec.ExternalMetadata
+	toUpdate.Spec.DocumentationURL = servicePlan.Spec.DocumentationURL
+	toUpdate.Spec.SupportURL = servicePlan.Spec.SupportURL
+	setExternalDocumentationAnnotations(toUpdate, toUpdate.Spec.DocumentationURL, toUpdate.Spec.SupportURL)
 	toUpdate.Spec.ServiceInstanceCreateParameterSchema = servicePlan.Spec.ServiceInstanceCreateParameterSchema
 	toUpdate.Spec.ServiceInstanceUpdateParameterSchema = servicePlan.Spec.ServiceInstanceUpdateParameterSchema
 	toUpdate.Spec.ServiceBindingCreateParameterSchema = servicePlan.Spec.ServiceBindingCreateParameterSchema
 
+	markAsServiceCatalogManagedResource(toUpdate, broker)
+
 	updatedPlan, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).Update(toUpdate)
 	if err != nil {
 		glog.Error(pcb.Messagef("Error updating %s: %v", pretty.ServicePlanName(servicePlan), err))
@@ -620,6 +715,7 @@ func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta
 
 	if updatedPlan.Status.RemovedFromBrokerCatalog {
 		updatedPlan.Status.RemovedFromBrokerCatalog = false
+		clearPendingRemoval(&updatedPlan.Status.PendingRemoval, &updatedPlan.Status.RemovalTime)
 		glog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ServicePlanName(updatedPlan)))
 
 		_, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).UpdateStatus(updatedPlan)
@@ -639,7 +735,7 @@ func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta
 
 // updateCommonStatusCondition updates the common ready condition for the given CommonServiceBrokerStatus
 // with the given status, reason, and message.
-func updateCommonStatusCondition(pcb *pretty.ContextBuilder, meta metav1.ObjectMeta, commonStatus *v1beta1.CommonServiceBrokerStatus, conditionType v1beta1.ServiceBrokerConditionType, status v1beta1.ConditionStatus, reason, message string) {
+func updateCommonStatusCondition(pcb *pretty.ContextBuilder, meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, commonStatus *v1beta1.CommonServiceBrokerStatus, conditionType v1beta1.ServiceBrokerConditionType, status v1beta1.ConditionStatus, reason, message string) {
 	newCondition := v1beta1.ServiceBrokerCondition{
 		Type:    conditionType,
 		Status:  status,
@@ -675,6 +771,8 @@ func updateCommonStatusCondition(pcb *pretty.ContextBuilder, meta metav1.ObjectM
 	// Set status.ReconciledGeneration && status.LastCatalogRetrievalTime if updating ready condition to true
 	if conditionType == v1beta1.ServiceBrokerConditionReady && status == v1beta1.ConditionTrue {
 		commonStatus.ReconciledGeneration = meta.Generation
+		commonStatus.ObservedRelistRequests = commonSpec.RelistRequests
+		commonStatus.ObservedRelistRequestedAt = meta.Annotations[v1beta1.RelistRequestedAtAnnotation]
 		now := metav1.NewTime(t)
 		commonStatus.LastCatalogRetrievalTime = &now
 	}
@@ -686,7 +784,7 @@ func (c *controller) updateServiceBrokerCondition(broker *v1beta1.ServiceBroker,
 	toUpdate := broker.DeepCopy()
 
 	pcb := pretty.NewServiceBrokerContextBuilder(toUpdate)
-	updateCommonStatusCondition(pcb, toUpdate.ObjectMeta, &toUpdate.Status.CommonServiceBrokerStatus, conditionType, status, reason, message)
+	updateCommonStatusCondition(pcb, toUpdate.ObjectMeta, &toUpdate.Spec.CommonServiceBrokerSpec, &toUpdate.Status.CommonServiceBrokerStatus, conditionType, status, reason, message)
 
 	glog.V(4).Info(pcb.Messagef("Updating ready condition to %v", status))
 	_, err := c.serviceCatalogClient.ServiceBrokers(broker.Namespace).UpdateStatus(toUpdate)
@@ -726,6 +824,65 @@ func (c *controller) updateServiceBrokerFinalizers(
 	return err
 }
 
+// scheduleServiceBrokerCatalogRemoval marks the classes and plans of a
+// ServiceBroker that is being deleted as pending removal until its
+// CatalogRemovalGracePeriod has passed since the deletion. It returns how long
+// the deletion of the classes and plans must still be delayed; the broker is
+// requeued to be finalized once that time has passed.
+func (c *controller) scheduleServiceBrokerCatalogRemoval(broker *v1beta1.ServiceBroker, serviceClasses []v1beta1.ServiceClass, servicePlans []v1beta1.ServicePlan) (time.Duration, error) {
+	gracePeriod := getCatalogRemovalGracePeriod(&broker.Spec.CommonServiceBrokerSpec)
+	if gracePeriod <= 0 {
+		return 0, nil
+	}
+	removalTime := broker.DeletionTimestamp.Add(gracePeriod)
+	delay := removalTime.Sub(time.Now())
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	pcb := pretty.NewServiceBrokerContextBuilder(broker)
+
+	for i := range serviceClasses {
+		serviceClass := &serviceClasses[i]
+		if !setPendingRemoval(&serviceClass.Status.PendingRemoval, &serviceClass.Status.RemovalTime, removalTime) {
+			continue
+		}
+		glog.V(4).Info(pcb.Messagef("Marking %s as pending removal", pretty.ServiceClassName(serviceClass)))
+		if _, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).UpdateStatus(serviceClass); err != nil {
+			glog.Warning(pcb.Messagef("Error updating status of %s: %v", pretty.ServiceClassName(serviceClass), err))
+			return 0, err
+		}
+	}
+
+	for i := range servicePlans {
+		servicePlan := &servicePlans[i]
+		if !setPendingRemoval(&servicePlan.Status.PendingRemoval, &servicePlan.Status.RemovalTime, removalTime) {
+			continue
+		}
+		glog.V(4).Info(pcb.Messagef("Marking %s as pending removal", pretty.ServicePlanName(servicePlan)))
+		if _, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).UpdateStatus(servicePlan); err != nil {
+			glog.Warning(pcb.Messagef("Error updating status of %s: %v", pretty.ServicePlanName(servicePlan), err))
+			return 0, err
+		}
+	}
+
+	if !isServiceBrokerReadyConditionReason(broker.Status.Conditions, catalogPendingRemovalReason) {
+		s := fmt.Sprintf(catalogPendingRemovalMessage, removalTime)
+		glog.V(4).Info(pcb.Message(s))
+		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, catalogPendingRemovalReason, s); err != nil {
+			return 0, err
+		}
+		c.recorder.Event(broker, corev1.EventTypeNormal, catalogPendingRemovalReason, s)
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(broker)
+	if err != nil {
+		return 0, err
+	}
+	c.serviceBrokerQueue.AddAfter(key, delay)
+	return delay, nil
+}
+
 func (c *controller) getCurrentServiceClassesAndPlansForNamespacedBroker(broker *v1beta1.ServiceBroker) ([]v1beta1.ServiceClass, []v1beta1.ServicePlan, error) {
 	fieldSet := fields.Set{
 		v1beta1.FilterSpecServiceBrokerName: broker.Name,
@@ -765,7 +922,69 @@ func (c *controller) getCurrentServiceClassesAndPlansForNamespacedBroker(broker
 		return nil, nil, err
 	}
 
-	return existingServiceClasses.Items, existingServicePlans.Items, nil
+	// copies shared by a broker of the same name in another namespace, or
+	// projected from the catalog of a ClusterServiceBroker of the same name,
+	// are not part of this broker's catalog
+	var serviceClasses []v1beta1.ServiceClass
+	for _, serviceClass := range existingServiceClasses.Items {
+		if serviceClass.Spec.ServiceBrokerNamespace == "" && !serviceClass.Spec.ClusterServiceBroker {
+			serviceClasses = append(serviceClasses, serviceClass)
+		}
+	}
+	var servicePlans []v1beta1.ServicePlan
+	for _, servicePlan := range existingServicePlans.Items {
+		if servicePlan.Spec.ServiceBrokerNamespace == "" && !servicePlan.Spec.ClusterServiceBroker {
+			servicePlans = append(servicePlans, servicePlan)
+		}
+	}
+
+	return serviceClasses, servicePlans, nil
+}
+
+// applyCatalogRemovalPolicyToServiceInstances applies the broker's
+// CatalogRemovalPolicy to the instances of its classes and plans.
+// removedServiceClasses and removedServicePlans hold the classes and plans
+// that were not part of the broker's catalog payload.
+func (c *controller) applyCatalogRemovalPolicyToServiceInstances(broker *v1beta1.ServiceBroker, payloadServiceClasses []*v1beta1.ServiceClass, removedServiceClasses map[string]*v1beta1.ServiceClass, removedServicePlans map[string]*v1beta1.ServicePlan) error {
+	brokerServiceClassNames := sets.NewString()
+	for _, serviceClass := range payloadServiceClasses {
+		brokerServiceClassNames.Insert(serviceClass.Name)
+	}
+	for name, serviceClass := range removedServiceClasses {
+		if serviceClass.Status.RemovedFromBrokerCatalog {
+			brokerServiceClassNames.Insert(name)
+		}
+	}
+
+	var instances []*v1beta1.ServiceInstance
+	for _, name := range brokerServiceClassNames.List() {
+		classInstances, err := c.listServiceInstancesByIndex(instanceServiceClassRefIndex, broker.Namespace+"/"+name)
+		if err != nil {
+			return err
+		}
+		instances = append(instances, classInstances...)
+	}
+
+	var affected, unaffected []*v1beta1.ServiceInstance
+	for _, instance := range instances {
+		removed := false
+		if serviceClass, ok := removedServiceClasses[instance.Spec.ServiceClassRef.Name]; ok && serviceClass.Status.RemovedFromBrokerCatalog {
+			removed = true
+		}
+		if instance.Spec.ServicePlanRef != nil {
+			if servicePlan, ok := removedServicePlans[instance.Spec.ServicePlanRef.Name]; ok && servicePlan.Status.RemovedFromBrokerCatalog {
+				removed = true
+			}
+		}
+		if removed {
+			affected = append(affected, instance)
+		} else {
+			unaffected = append(unaffected, instance)
+		}
+	}
+
+	c.applyCatalogRemovalPolicy(broker.Name, &broker.Spec.CommonServiceBrokerSpec, affected, unaffected)
+	return nil
 }
 
 func convertServiceClassListToMap(list []v1beta1.ServiceClass) map[string]*v1beta1.ServiceClass {
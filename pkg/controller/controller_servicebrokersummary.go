@@ -0,0 +1,182 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+
+	"github.com/golang/glog"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// enqueueServiceBrokerSummary queues the summary of the namespace of the given
+// ServiceBroker or ServiceBrokerSummary. The queue is keyed by namespace.
+// Nothing is queued when the API server does not serve summaries.
+func (c *controller) enqueueServiceBrokerSummary(obj interface{}) {
+	if c.serviceBrokerSummaryLister == nil {
+		return
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		glog.Errorf("Couldn't split key %q: %v", key, err)
+		return
+	}
+	c.serviceBrokerSummaryQueue.Add(namespace)
+}
+
+// serviceBrokerSummaryDelete recreates a summary that was deleted while there
+// are still ServiceBrokers in its namespace. Adds and updates are not handled,
+// as they are made by the controller itself.
+func (c *controller) serviceBrokerSummaryDelete(obj interface{}) {
+	c.enqueueServiceBrokerSummary(obj)
+}
+
+func (c *controller) reconcileServiceBrokerSummaryKey(namespace string) error {
+	brokers, err := c.serviceBrokerLister.ServiceBrokers(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	return c.reconcileServiceBrokerSummary(namespace, brokers)
+}
+
+// reconcileServiceBrokerSummary is the control-loop that keeps the
+// ServiceBrokerSummary of a namespace up to date with the ServiceBrokers in
+// it. The summary is deleted once the last ServiceBroker is gone.
+func (c *controller) reconcileServiceBrokerSummary(namespace string, brokers []*v1beta1.ServiceBroker) error {
+	existing, err := c.serviceBrokerSummaryLister.ServiceBrokerSummaries(namespace).Get(v1beta1.ServiceBrokerSummaryName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if errors.IsNotFound(err) {
+		existing = nil
+	}
+
+	if len(brokers) == 0 {
+		if existing == nil {
+			return nil
+		}
+		glog.V(4).Infof("ServiceBrokerSummary %s/%s: Deleting as there are no ServiceBrokers left", namespace, existing.Name)
+		err := c.serviceCatalogClient.ServiceBrokerSummaries(namespace).Delete(existing.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	desired, err := c.newServiceBrokerSummary(namespace, brokers)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		glog.V(4).Infof("ServiceBrokerSummary %s/%s: Creating", namespace, desired.Name)
+		_, err := c.serviceCatalogClient.ServiceBrokerSummaries(namespace).Create(desired)
+		if err != nil && !errors.IsAlreadyExists(err) {
+			glog.Errorf("ServiceBrokerSummary %s/%s: Error creating: %v", namespace, desired.Name, err)
+			return err
+		}
+		return nil
+	}
+
+	if existing.TotalBrokers == desired.TotalBrokers &&
+		existing.ReadyBrokers == desired.ReadyBrokers &&
+		apiequality.Semantic.DeepEqual(existing.Brokers, desired.Brokers) {
+		return nil
+	}
+
+	toUpdate := existing.DeepCopy()
+	toUpdate.TotalBrokers = desired.TotalBrokers
+	toUpdate.ReadyBrokers = desired.ReadyBrokers
+	toUpdate.Brokers = desired.Brokers
+	glog.V(4).Infof("ServiceBrokerSummary %s/%s: Updating", namespace, desired.Name)
+	if _, err := c.serviceCatalogClient.ServiceBrokerSummaries(namespace).Update(toUpdate); err != nil {
+		glog.Errorf("ServiceBrokerSummary %s/%s: Error updating: %v", namespace, desired.Name, err)
+		return err
+	}
+	return nil
+}
+
+// newServiceBrokerSummary returns the summary of the given ServiceBrokers,
+// which are all in the given namespace. Classes and plans that were removed
+// from the catalog of their broker are not counted.
+func (c *controller) newServiceBrokerSummary(namespace string, brokers []*v1beta1.ServiceBroker) (*v1beta1.ServiceBrokerSummary, error) {
+	classes, err := c.serviceClassLister.ServiceClasses(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	plans, err := c.servicePlanLister.ServicePlans(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	classCounts := make(map[string]int32)
+	for _, class := range classes {
+		if !class.Status.RemovedFromBrokerCatalog && !class.Spec.ClusterServiceBroker {
+			classCounts[class.Spec.ServiceBrokerName]++
+		}
+	}
+	planCounts := make(map[string]int32)
+	for _, plan := range plans {
+		if !plan.Status.RemovedFromBrokerCatalog && !plan.Spec.ClusterServiceBroker {
+			planCounts[plan.Spec.ServiceBrokerName]++
+		}
+	}
+
+	summary := &v1beta1.ServiceBrokerSummary{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1beta1.ServiceBrokerSummaryName,
+			Namespace: namespace,
+		},
+		TotalBrokers: int32(len(brokers)),
+	}
+	for _, broker := range brokers {
+		entry := v1beta1.ServiceBrokerSummaryEntry{
+			Name:                     broker.Name,
+			Ready:                    v1beta1.ConditionUnknown,
+			ServiceClasses:           classCounts[broker.Name],
+			ServicePlans:             planCounts[broker.Name],
+			LastCatalogRetrievalTime: broker.Status.LastCatalogRetrievalTime,
+		}
+		for _, condition := range broker.Status.Conditions {
+			if condition.Type == v1beta1.ServiceBrokerConditionReady {
+				entry.Ready = condition.Status
+				entry.Reason = condition.Reason
+			}
+		}
+		if entry.Ready == v1beta1.ConditionTrue {
+			summary.ReadyBrokers++
+		}
+		summary.Brokers = append(summary.Brokers, entry)
+	}
+	sort.Slice(summary.Brokers, func(i, j int) bool {
+		return summary.Brokers[i].Name < summary.Brokers[j].Name
+	})
+
+	return summary, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+)
+
+func getTestServiceBrokerSummaryBrokers() []*v1beta1.ServiceBroker {
+	ready := getTestServiceBroker()
+	ready.Status.Conditions = []v1beta1.ServiceBrokerCondition{{
+		Type:   v1beta1.ServiceBrokerConditionReady,
+		Status: v1beta1.ConditionTrue,
+		Reason: successFetchedCatalogReason,
+	}}
+	ready.Status.LastCatalogRetrievalTime = &metav1.Time{}
+
+	unreachable := getTestServiceBroker()
+	unreachable.Name = "unreachable-broker"
+	unreachable.Status.Conditions = []v1beta1.ServiceBrokerCondition{{
+		Type:   v1beta1.ServiceBrokerConditionReady,
+		Status: v1beta1.ConditionFalse,
+		Reason: errorFetchingCatalogReason,
+	}}
+
+	return []*v1beta1.ServiceBroker{unreachable, ready}
+}
+
+// TestReconcileServiceBrokerSummaryCreate tests that the summary of a
+// namespace is created with the state of each of its brokers.
+func TestReconcileServiceBrokerSummaryCreate(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ServiceClasses().Informer().GetStore().Add(getTestServiceClass())
+	sharedInformers.ServicePlans().Informer().GetStore().Add(getTestServicePlan())
+	removedPlan := getTestServicePlan()
+	removedPlan.Name = "removed-plan"
+	removedPlan.Status.RemovedFromBrokerCatalog = true
+	sharedInformers.ServicePlans().Informer().GetStore().Add(removedPlan)
+
+	if err := testController.reconcileServiceBrokerSummary(testNamespace, getTestServiceBrokerSummaryBrokers()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertActionEquals(t, actions[0], "create", "servicebrokersummaries")
+	summary := actions[0].(clientgotesting.CreateAction).GetObject().(*v1beta1.ServiceBrokerSummary)
+
+	if e, a := v1beta1.ServiceBrokerSummaryName, summary.Name; e != a {
+		t.Fatalf("unexpected name: %v", expectedGot(e, a))
+	}
+	if e, a := int32(2), summary.TotalBrokers; e != a {
+		t.Fatalf("unexpected total brokers: %v", expectedGot(e, a))
+	}
+	if e, a := int32(1), summary.ReadyBrokers; e != a {
+		t.Fatalf("unexpected ready brokers: %v", expectedGot(e, a))
+	}
+	if e, a := 2, len(summary.Brokers); e != a {
+		t.Fatalf("unexpected number of broker entries: %v", expectedGot(e, a))
+	}
+
+	entry := summary.Brokers[0]
+	if e, a := testServiceBrokerName, entry.Name; e != a {
+		t.Fatalf("unexpected broker: %v", expectedGot(e, a))
+	}
+	if e, a := v1beta1.ConditionTrue, entry.Ready; e != a {
+		t.Fatalf("unexpected ready status: %v", expectedGot(e, a))
+	}
+	if e, a := int32(1), entry.ServiceClasses; e != a {
+		t.Fatalf("unexpected class count: %v", expectedGot(e, a))
+	}
+	if e, a := int32(1), entry.ServicePlans; e != a {
+		t.Fatalf("unexpected plan count: %v", expectedGot(e, a))
+	}
+	if entry.LastCatalogRetrievalTime == nil {
+		t.Fatalf("expected last catalog retrieval time to be set")
+	}
+
+	entry = summary.Brokers[1]
+	if e, a := v1beta1.ConditionFalse, entry.Ready; e != a {
+		t.Fatalf("unexpected ready status: %v", expectedGot(e, a))
+	}
+	if e, a := errorFetchingCatalogReason, entry.Reason; e != a {
+		t.Fatalf("unexpected reason: %v", expectedGot(e, a))
+	}
+	if e, a := int32(0), entry.ServiceClasses; e != a {
+		t.Fatalf("unexpected class count: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileServiceBrokerSummaryUpdate tests that the summary is only
+// updated when the state of the brokers has changed.
+func TestReconcileServiceBrokerSummaryUpdate(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	brokers := getTestServiceBrokerSummaryBrokers()
+	summary, err := testController.newServiceBrokerSummary(testNamespace, brokers)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sharedInformers.ServiceBrokerSummaries().Informer().GetStore().Add(summary)
+
+	if err := testController.reconcileServiceBrokerSummary(testNamespace, brokers); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	brokers[0].Status.Conditions[0].Status = v1beta1.ConditionTrue
+	if err := testController.reconcileServiceBrokerSummary(testNamespace, brokers); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertActionEquals(t, actions[0], "update", "servicebrokersummaries")
+	updated := actions[0].(clientgotesting.UpdateAction).GetObject().(*v1beta1.ServiceBrokerSummary)
+	if e, a := int32(2), updated.ReadyBrokers; e != a {
+		t.Fatalf("unexpected ready brokers: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileServiceBrokerSummaryDelete tests that the summary is deleted
+// once there are no brokers left in the namespace.
+func TestReconcileServiceBrokerSummaryDelete(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	summary, err := testController.newServiceBrokerSummary(testNamespace, getTestServiceBrokerSummaryBrokers())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sharedInformers.ServiceBrokerSummaries().Informer().GetStore().Add(summary)
+
+	if err := testController.reconcileServiceBrokerSummary(testNamespace, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertActionEquals(t, actions[0], "delete", "servicebrokersummaries")
+}
+
+// TestEnqueueServiceBrokerSummaryNotServed tests that no summary is queued
+// when the API server does not serve summaries.
+func TestEnqueueServiceBrokerSummaryNotServed(t *testing.T) {
+	enableNamespacedServiceBroker(t)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.serviceBrokerSummaryLister = nil
+
+	testController.serviceBrokerAdd(getTestServiceBroker())
+
+	if e, a := 0, testController.serviceBrokerSummaryQueue.Len(); e != a {
+		t.Fatalf("unexpected number of queued summaries: %v", expectedGot(e, a))
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package controller
 
 import (
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 
@@ -91,6 +93,16 @@ func (c *controller) reconcileServiceClass(serviceClass *v1beta1.ServiceClass) e
 		return nil
 	}
 
+	if delay := getPendingRemovalDelay(serviceClass.Status.PendingRemoval, serviceClass.Status.RemovalTime, time.Now()); delay > 0 {
+		key, err := cache.MetaNamespaceKeyFunc(serviceClass)
+		if err != nil {
+			return err
+		}
+		glog.Info(pcb.Messagef("Removed from broker catalog and pending removal; deleting in %v", delay))
+		c.serviceClassQueue.AddAfter(key, delay)
+		return nil
+	}
+
 	glog.Info(pcb.Message("Removed from broker catalog and has zero instances remaining; deleting"))
 	return c.serviceCatalogClient.ServiceClasses(serviceClass.Namespace).Delete(serviceClass.Name, &metav1.DeleteOptions{})
 }
@@ -17,6 +17,8 @@ limitations under the License.
 package controller
 
 import (
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 
@@ -93,6 +95,16 @@ func (c *controller) reconcileServicePlan(servicePlan *v1beta1.ServicePlan) erro
 		return nil
 	}
 
+	if delay := getPendingRemovalDelay(servicePlan.Status.PendingRemoval, servicePlan.Status.RemovalTime, time.Now()); delay > 0 {
+		key, err := cache.MetaNamespaceKeyFunc(servicePlan)
+		if err != nil {
+			return err
+		}
+		glog.Info(pcb.Messagef("removed from broker catalog and pending removal; deleting in %v", delay))
+		c.servicePlanQueue.AddAfter(key, delay)
+		return nil
+	}
+
 	glog.Infof(pcb.Message("removed from broker catalog and has zero instances remaining; deleting"))
 	return c.serviceCatalogClient.ServicePlans(servicePlan.Namespace).Delete(servicePlan.Name, &metav1.DeleteOptions{})
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"strings"
 	"testing"
 	"time"
 
@@ -1260,6 +1261,42 @@ type bindingParameters struct {
 	Args []string `json:"args"`
 }
 
+func TestIsAdmissionWebhookUnavailable(t *testing.T) {
+	webhookErr := apierrors.NewInternalError(fmt.Errorf(`failed calling admission webhook "validate.example.com": Post https://webhook.example.com/validate: dial tcp: i/o timeout`))
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name:     "webhook could not be called",
+			err:      webhookErr,
+			expected: true,
+		},
+		{
+			name:     "wrapped webhook error",
+			err:      fmt.Errorf("failed to update status: %v", webhookErr),
+			expected: true,
+		},
+		{
+			name: "webhook denied the request",
+			err:  apierrors.NewForbidden(v1beta1.Resource("serviceinstances"), "test-instance", fmt.Errorf(`admission webhook "validate.example.com" denied the request`)),
+		},
+		{
+			name: "conflict",
+			err:  apierrors.NewConflict(v1beta1.Resource("serviceinstances"), "test-instance", fmt.Errorf("the object has been modified")),
+		},
+	}
+	for _, tc := range cases {
+		if e, a := tc.expected, isAdmissionWebhookUnavailable(tc.err); e != a {
+			t.Errorf("%v: expected %v, got %v", tc.name, e, a)
+		}
+	}
+}
+
 func TestEmptyCatalogConversion(t *testing.T) {
 	serviceClasses, servicePlans, err := convertAndFilterCatalog(&osb.CatalogResponse{}, nil)
 	if err != nil {
@@ -1597,6 +1634,44 @@ func TestConvertAndFilterCatalog(t *testing.T) {
 			plans:   []string{"Eastwatch-by-the-Sea", "OldOak", "Queensgate"},
 			catalog: largeTestCatalog,
 		},
+		{
+			name: "allow-list classes by external ID",
+			restrictions: &v1beta1.CatalogRestrictions{
+				ServiceClassExternalIDs: []string{"41726368-6f6e-4569-8172-63686f6e6569", "42616c65-7269-4f6e-8261-6c6572696f6e"},
+			},
+			classes: []string{"Archonei", "Balerion"},
+			plans:   []string{"Goldengrove", "Ironrath", "Queensgate"},
+			catalog: largeTestCatalog,
+		},
+		{
+			name: "allow-list plans by external ID and trim services without plans",
+			restrictions: &v1beta1.CatalogRestrictions{
+				ServicePlanExternalIDs: []string{"45617374-7761-4463-a82d-62792d746865", "51756565-6e73-4761-b465-517565656e73"},
+			},
+			classes: []string{"Arrax", "Balerion"},
+			plans:   []string{"Eastwatch-by-the-Sea", "Queensgate"},
+			catalog: largeTestCatalog,
+		},
+		{
+			name: "allow-list by external ID and predicate",
+			restrictions: &v1beta1.CatalogRestrictions{
+				ServiceClass:            []string{"spec.externalName notin (Balerion)"},
+				ServiceClassExternalIDs: []string{"41727261-7841-4272-a178-417272617841", "42616c65-7269-4f6e-8261-6c6572696f6e"},
+				ServicePlan:             []string{"spec.externalName notin (OldOak)"},
+			},
+			classes: []string{"Arrax"},
+			plans:   []string{"Eastwatch-by-the-Sea"},
+			catalog: largeTestCatalog,
+		},
+		{
+			name: "allow-list of unknown external IDs",
+			restrictions: &v1beta1.CatalogRestrictions{
+				ServiceClassExternalIDs: []string{"unknown"},
+			},
+			classes: []string{},
+			plans:   []string{},
+			catalog: largeTestCatalog,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1893,6 +1968,72 @@ func TestIsClusterServiceBrokerReady(t *testing.T) {
 	}
 }
 
+func TestRecordPollResult(t *testing.T) {
+	recent := metav1.NewTime(time.Now().Add(-time.Second))
+	stale := metav1.NewTime(time.Now().Add(-2 * pollResultHeartbeatPeriod))
+
+	cases := []struct {
+		name           string
+		lastPollTime   *metav1.Time
+		lastPollResult string
+		result         string
+		changed        bool
+		updateNeeded   bool
+	}{
+		{
+			name:         "first poll",
+			result:       "in progress",
+			changed:      true,
+			updateNeeded: true,
+		},
+		{
+			name:           "same result within heartbeat period",
+			lastPollTime:   &recent,
+			lastPollResult: "in progress",
+			result:         "in progress",
+			changed:        false,
+			updateNeeded:   false,
+		},
+		{
+			name:           "same result after heartbeat period",
+			lastPollTime:   &stale,
+			lastPollResult: "in progress",
+			result:         "in progress",
+			changed:        false,
+			updateNeeded:   true,
+		},
+		{
+			name:           "different result within heartbeat period",
+			lastPollTime:   &recent,
+			lastPollResult: "in progress",
+			result:         "Error polling last operation: random error",
+			changed:        true,
+			updateNeeded:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		lastPollTime := tc.lastPollTime
+		lastPollResult := tc.lastPollResult
+		changed, updateNeeded := recordPollResult(&lastPollTime, &lastPollResult, tc.result)
+		if e, a := tc.changed, changed; e != a {
+			t.Errorf("%v: expected changed %v, got %v", tc.name, e, a)
+		}
+		if e, a := tc.updateNeeded, updateNeeded; e != a {
+			t.Errorf("%v: expected updateNeeded %v, got %v", tc.name, e, a)
+		}
+		if e, a := tc.result, lastPollResult; e != a {
+			t.Errorf("%v: expected last poll result %q, got %q", tc.name, e, a)
+		}
+		if updateNeeded && lastPollTime == tc.lastPollTime {
+			t.Errorf("%v: expected last poll time to be refreshed", tc.name)
+		}
+		if !updateNeeded && lastPollTime != tc.lastPollTime {
+			t.Errorf("%v: expected last poll time to be left unchanged", tc.name)
+		}
+	}
+}
+
 func TestIsPlanBindable(t *testing.T) {
 	serviceClass := func(bindable bool) *v1beta1.ClusterServiceClass {
 		serviceClass := getTestClusterServiceClass()
@@ -2005,6 +2146,9 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		serviceCatalogSharedInformers.BrokerTemplates(),
+		serviceCatalogSharedInformers.CatalogProjections(),
+		serviceCatalogSharedInformers.ServiceBrokerSummaries(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -2013,6 +2157,7 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 		7*24*time.Hour,
 		DefaultClusterIDConfigMapName,
 		DefaultClusterIDConfigMapNamespace,
+		Options{},
 	)
 
 	if c, ok := testController.(*controller); ok {
@@ -2342,6 +2487,29 @@ func assertClusterServiceBrokerCondition(t *testing.T, obj runtime.Object, condi
 	}
 }
 
+func assertClusterServiceBrokerCatalogStatistics(t *testing.T, obj runtime.Object, classCount, planCount int32) {
+	broker, ok := obj.(*v1beta1.ClusterServiceBroker)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ClusterServiceBroker", obj)
+	}
+
+	if e, a := classCount, broker.Status.ClassCount; e != a {
+		fatalf(t, "Unexpected class count: expected %v, got %v", e, a)
+	}
+	if e, a := planCount, broker.Status.PlanCount; e != a {
+		fatalf(t, "Unexpected plan count: expected %v, got %v", e, a)
+	}
+	if e, a := osb.LatestAPIVersion().HeaderValue(), broker.Status.OSBAPIVersion; e != a {
+		fatalf(t, "Unexpected OSB API version: expected %v, got %v", e, a)
+	}
+	if broker.Status.LastCatalogChecksum == "" {
+		fatalf(t, "Expected catalog checksum to be set")
+	}
+	if broker.Status.LastRelistDuration == nil {
+		fatalf(t, "Expected last relist duration to be set")
+	}
+}
+
 func assertClusterServiceBrokerOperationStartTimeSet(t *testing.T, obj runtime.Object, isOperationStartTimeSet bool) {
 	broker, ok := obj.(*v1beta1.ClusterServiceBroker)
 	if !ok {
@@ -2571,6 +2739,64 @@ func assertServiceInstanceDashboardURL(t *testing.T, obj runtime.Object, dashboa
 	}
 }
 
+func assertServiceInstanceReadyAtAfterProvisionStartedAt(t *testing.T, obj runtime.Object) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceInstance", obj)
+	}
+	if instance.Status.ProvisionStartedAt == nil {
+		fatalf(t, "ProvisionStartedAt was nil")
+	}
+	if instance.Status.ReadyAt == nil {
+		fatalf(t, "ReadyAt was nil")
+	}
+	if instance.Status.ReadyAt.Before(instance.Status.ProvisionStartedAt) {
+		fatalf(t, "ReadyAt %v is before ProvisionStartedAt %v", instance.Status.ReadyAt, instance.Status.ProvisionStartedAt)
+	}
+}
+
+func assertServiceBindingReadyAtAfterBindStartedAt(t *testing.T, obj runtime.Object) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceBinding", obj)
+	}
+	if binding.Status.BindStartedAt == nil {
+		fatalf(t, "BindStartedAt was nil")
+	}
+	if binding.Status.ReadyAt == nil {
+		fatalf(t, "ReadyAt was nil")
+	}
+	if binding.Status.ReadyAt.Before(binding.Status.BindStartedAt) {
+		fatalf(t, "ReadyAt %v is before BindStartedAt %v", binding.Status.ReadyAt, binding.Status.BindStartedAt)
+	}
+}
+
+func assertServiceInstancePollErrorRecorded(t *testing.T, obj runtime.Object) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceInstance", obj)
+	}
+	if instance.Status.LastPollTime == nil {
+		fatalf(t, "LastPollTime was nil")
+	}
+	if e, a := "Error polling last operation:", instance.Status.LastPollResult; !strings.HasPrefix(a, e) {
+		fatalf(t, "Unexpected LastPollResult: expected prefix %q, got %q", e, a)
+	}
+}
+
+func assertServiceBindingPollErrorRecorded(t *testing.T, obj runtime.Object) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceBinding", obj)
+	}
+	if binding.Status.LastPollTime == nil {
+		fatalf(t, "LastPollTime was nil")
+	}
+	if e, a := "Error polling last operation:", binding.Status.LastPollResult; !strings.HasPrefix(a, e) {
+		fatalf(t, "Unexpected LastPollResult: expected prefix %q, got %q", e, a)
+	}
+}
+
 func assertServiceInstanceDeprovisionStatus(t *testing.T, obj runtime.Object, deprovisionStatus v1beta1.ServiceInstanceDeprovisionStatus) {
 	instance, ok := obj.(*v1beta1.ServiceInstance)
 	if !ok {
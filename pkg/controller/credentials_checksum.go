@@ -0,0 +1,61 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// credentialsChecksum returns the hex encoded SHA-256 checksum of the given
+// secret data. Keys are hashed in order, and every key and value is prefixed
+// with its length, so that different data never hash the same input.
+func credentialsChecksum(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	length := make([]byte, 8)
+	write := func(b []byte) {
+		binary.BigEndian.PutUint64(length, uint64(len(b)))
+		hash.Write(length)
+		hash.Write(b)
+	}
+	for _, k := range keys {
+		write([]byte(k))
+		write(data[k])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// setCredentialsChecksum sets the CredentialsChecksumAnnotation of the given
+// secret to the given checksum.
+func setCredentialsChecksum(secret *corev1.Secret, checksum string) {
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[v1beta1.CredentialsChecksumAnnotation] = checksum
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestCredentialsChecksum(t *testing.T) {
+	data := map[string][]byte{"username": []byte("admin"), "password": []byte("letmein")}
+	checksum := credentialsChecksum(data)
+	if len(checksum) != 64 {
+		t.Fatalf("expected a hex encoded SHA-256 checksum, got %q", checksum)
+	}
+	if e, a := checksum, credentialsChecksum(map[string][]byte{"password": []byte("letmein"), "username": []byte("admin")}); e != a {
+		t.Fatalf("expected the checksum not to depend on the order of the keys: %v", expectedGot(e, a))
+	}
+
+	different := []map[string][]byte{
+		{"username": []byte("admin"), "password": []byte("other")},
+		{"username": []byte("admin")},
+		{"username": []byte("admin"), "password": []byte("letmein"), "host": []byte("db")},
+	}
+	for _, d := range different {
+		if credentialsChecksum(d) == checksum {
+			t.Fatalf("expected different credentials %v to have a different checksum", d)
+		}
+	}
+	if credentialsChecksum(map[string][]byte{"ab": []byte("c")}) == credentialsChecksum(map[string][]byte{"a": []byte("bc")}) {
+		t.Fatal("expected the boundary between keys and values to change the checksum")
+	}
+}
+
+// TestInjectServiceBindingCredentialsChecksum tests that the checksum of the
+// credentials is set on the secret of a binding and in its status, and that
+// it changes with the credentials.
+func TestInjectServiceBindingCredentialsChecksum(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	binding := getTestServiceBinding()
+	binding.Spec.SecretName = "test-secret"
+	var existing *corev1.Secret
+	fakeKubeClient.AddReactor("get", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		if existing == nil {
+			return true, nil, apierrors.NewNotFound(corev1.Resource("secrets"), action.(clientgotesting.GetAction).GetName())
+		}
+		return true, existing.DeepCopy(), nil
+	})
+
+	inject := func(credentials map[string]interface{}) *corev1.Secret {
+		fakeKubeClient.ClearActions()
+		if err := testController.injectServiceBinding(binding, credentials); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, action := range fakeKubeClient.Actions() {
+			switch {
+			case action.Matches("create", "secrets"):
+				return action.(clientgotesting.CreateAction).GetObject().(*corev1.Secret)
+			case action.Matches("update", "secrets"):
+				return action.(clientgotesting.UpdateAction).GetObject().(*corev1.Secret)
+			}
+		}
+		t.Fatal("expected the secret to be created or updated")
+		return nil
+	}
+
+	secret := inject(map[string]interface{}{"password": "first"})
+	first := secret.Annotations[v1beta1.CredentialsChecksumAnnotation]
+	if e, a := credentialsChecksum(map[string][]byte{"password": []byte("first")}), first; e != a {
+		t.Fatalf("unexpected checksum annotation: %v", expectedGot(e, a))
+	}
+	if e, a := first, binding.Status.CredentialsChecksum; e != a {
+		t.Fatalf("unexpected status checksum: %v", expectedGot(e, a))
+	}
+
+	existing = secret
+	existing.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)}
+	secret = inject(map[string]interface{}{"password": "second"})
+	second := secret.Annotations[v1beta1.CredentialsChecksumAnnotation]
+	if second == "" || second == first {
+		t.Fatalf("expected the checksum annotation to change with the credentials, got %q", second)
+	}
+	if e, a := second, binding.Status.CredentialsChecksum; e != a {
+		t.Fatalf("unexpected status checksum: %v", expectedGot(e, a))
+	}
+}
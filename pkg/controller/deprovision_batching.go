@@ -0,0 +1,190 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	deprovisionQueuedReason  string = "DeprovisionQueued"
+	deprovisionQueuedMessage string = "Waiting to deprovision: %d of at most %d deprovision requests to the broker %q are in progress, and %d instances are waiting"
+)
+
+// deprovisionBatcher limits how many instances are deprovisioned at the same
+// time at each broker, so that deleting a namespace with many instances does
+// not flood their broker. Instances that are over the limit are retried after
+// the batch interval.
+//
+// The instances being deprovisioned are tracked in memory. Instances whose
+// deprovision was already started when the controller started are always let
+// through, so the limit may be exceeded briefly after a restart.
+type deprovisionBatcher struct {
+	// maxConcurrent is the number of instances that may be deprovisioned at
+	// the same time at each broker. Zero disables the limit.
+	maxConcurrent int
+	// interval is how long an instance that is over the limit waits before
+	// it is tried again.
+	interval time.Duration
+
+	lock sync.Mutex
+	// inFlight and waiting are the keys of the instances being deprovisioned
+	// and waiting to be deprovisioned, by broker.
+	inFlight map[string]sets.String
+	waiting  map[string]sets.String
+}
+
+func newDeprovisionBatcher(maxConcurrent int, interval time.Duration) *deprovisionBatcher {
+	return &deprovisionBatcher{
+		maxConcurrent: maxConcurrent,
+		interval:      interval,
+		inFlight:      make(map[string]sets.String),
+		waiting:       make(map[string]sets.String),
+	}
+}
+
+func (b *deprovisionBatcher) enabled() bool {
+	return b != nil && b.maxConcurrent > 0
+}
+
+// admit returns whether the instance with the given key may send a deprovision
+// request to the given broker. If it may not, it also returns the number of
+// instances being deprovisioned and waiting at the broker. isDone reports
+// whether another instance no longer needs to be deprovisioned, and is used to
+// release the slots of finished instances. started is whether the deprovision
+// of the instance has already been started.
+func (b *deprovisionBatcher) admit(brokerKey, instanceKey string, started bool, isDone func(instanceKey string) bool) (bool, int, int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	inFlight, ok := b.inFlight[brokerKey]
+	if !ok {
+		inFlight = sets.NewString()
+		b.inFlight[brokerKey] = inFlight
+	}
+	waiting, ok := b.waiting[brokerKey]
+	if !ok {
+		waiting = sets.NewString()
+		b.waiting[brokerKey] = waiting
+	}
+
+	if inFlight.Has(instanceKey) {
+		return true, 0, 0
+	}
+	for _, key := range inFlight.List() {
+		if isDone(key) {
+			inFlight.Delete(key)
+		}
+	}
+	for _, key := range waiting.List() {
+		if key != instanceKey && isDone(key) {
+			waiting.Delete(key)
+		}
+	}
+
+	if started || inFlight.Len() < b.maxConcurrent {
+		waiting.Delete(instanceKey)
+		inFlight.Insert(instanceKey)
+		return true, 0, 0
+	}
+	waiting.Insert(instanceKey)
+	return false, inFlight.Len(), waiting.Len()
+}
+
+// getDeprovisionBatchingBrokerKey returns the key that the deprovisions of the
+// given instance are limited by.
+func getDeprovisionBatchingBrokerKey(instance *v1beta1.ServiceInstance, brokerName string) string {
+	if instance.Spec.ServiceClassSpecified() {
+		return instance.Namespace + "/" + brokerName
+	}
+	return brokerName
+}
+
+// isServiceInstanceDeprovisionDone returns whether the instance with the given
+// key no longer needs to be deprovisioned.
+func (c *controller) isServiceInstanceDeprovisionDone(key string) bool {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return true
+	}
+	instance, err := c.instanceLister.ServiceInstances(namespace).Get(name)
+	if err != nil {
+		return true
+	}
+	return instance.DeletionTimestamp == nil ||
+		instance.Status.DeprovisionStatus != v1beta1.ServiceInstanceDeprovisionStatusRequired
+}
+
+// admitServiceInstanceDeprovision returns whether the given instance may be
+// deprovisioned at the given broker now. If it may not, the instance is
+// requeued for the next batch and its progress is reported in its status.
+func (c *controller) admitServiceInstanceDeprovision(instance *v1beta1.ServiceInstance, brokerName string) (bool, error) {
+	if !c.deprovisionBatcher.enabled() {
+		return true, nil
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(instance)
+	if err != nil {
+		return false, err
+	}
+	started := instance.Status.CurrentOperation == v1beta1.ServiceInstanceOperationDeprovision
+	admitted, inFlight, waiting := c.deprovisionBatcher.admit(getDeprovisionBatchingBrokerKey(instance, brokerName), key, started, c.isServiceInstanceDeprovisionDone)
+	if admitted {
+		return true, nil
+	}
+	return false, c.processServiceInstanceDeprovisionQueued(instance, brokerName, inFlight, waiting)
+}
+
+// processServiceInstanceDeprovisionQueued reports that the deprovision of the
+// given instance is waiting for other deprovisions at its broker to complete
+// and requeues the instance for the next batch.
+func (c *controller) processServiceInstanceDeprovisionQueued(instance *v1beta1.ServiceInstance, brokerName string, inFlight, waiting int) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	s := fmt.Sprintf(deprovisionQueuedMessage, inFlight, c.deprovisionBatcher.maxConcurrent, brokerName, waiting)
+	glog.V(4).Info(pcb.Message(s))
+
+	c.instanceAddAfter(instance, c.deprovisionBatcher.interval)
+
+	queued := false
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionReady && cond.Reason == deprovisionQueuedReason {
+			if cond.Message == s {
+				return nil
+			}
+			queued = true
+		}
+	}
+
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, deprovisionQueuedReason, s)
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return err
+	}
+	if !queued {
+		c.recorder.Event(instance, corev1.EventTypeNormal, deprovisionQueuedReason, s)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestDeprovisionBatcherAdmit(t *testing.T) {
+	done := map[string]bool{}
+	isDone := func(key string) bool { return done[key] }
+
+	b := newDeprovisionBatcher(2, time.Minute)
+	for _, key := range []string{"ns/a", "ns/b"} {
+		if admitted, _, _ := b.admit("broker", key, false, isDone); !admitted {
+			t.Fatalf("expected %s to be admitted", key)
+		}
+	}
+	if admitted, inFlight, waiting := b.admit("broker", "ns/c", false, isDone); admitted || inFlight != 2 || waiting != 1 {
+		t.Fatalf("expected ns/c to wait behind 2 deprovisions, got admitted=%v inFlight=%v waiting=%v", admitted, inFlight, waiting)
+	}
+	if admitted, _, _ := b.admit("other-broker", "ns/d", false, isDone); !admitted {
+		t.Fatal("expected the limit to apply per broker")
+	}
+	if admitted, _, _ := b.admit("broker", "ns/e", true, isDone); !admitted {
+		t.Fatal("expected an instance whose deprovision has started to be admitted")
+	}
+	if admitted, _, _ := b.admit("broker", "ns/a", false, isDone); !admitted {
+		t.Fatal("expected an admitted instance to stay admitted")
+	}
+
+	done["ns/a"] = true
+	done["ns/e"] = true
+	if admitted, _, _ := b.admit("broker", "ns/c", false, isDone); !admitted {
+		t.Fatal("expected ns/c to be admitted once other deprovisions are done")
+	}
+}
+
+// TestReconcileServiceInstanceDeleteDeprovisionQueued tests that an instance
+// is not deprovisioned while its broker is at the deprovision limit, and is
+// deprovisioned once the other deprovision has finished.
+func TestReconcileServiceInstanceDeleteDeprovisionQueued(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.deprovisionBatcher = newDeprovisionBatcher(1, time.Minute)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	other := getTestServiceInstanceDeletedAt(metav1.Now())
+	other.Name = "other-instance"
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(other)
+	otherKey := other.Namespace + "/" + other.Name
+	if admitted, _, _ := testController.deprovisionBatcher.admit(testClusterServiceBrokerName, otherKey, false, testController.isServiceInstanceDeprovisionDone); !admitted {
+		t.Fatal("expected the other instance to be admitted")
+	}
+
+	instance := getTestServiceInstanceDeletedAt(metav1.Now())
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, deprovisionQueuedReason)
+
+	expectedMessage := fmt.Sprintf(deprovisionQueuedMessage, 1, 1, testClusterServiceBrokerName, 1)
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(deprovisionQueuedReason).msg(expectedMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Once the other instance is deprovisioned, the instance is.
+	other = other.DeepCopy()
+	other.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusSucceeded
+	sharedInformers.ServiceInstances().Informer().GetStore().Update(other)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, updatedServiceInstance.(*v1beta1.ServiceInstance)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertServiceInstanceDeprovisionInProgressIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	deprovisionPendingReason        string = "DeprovisionPending"
+	deprovisionPendingMessage       string = "The instance will be deprovisioned at %s. To cancel the deletion, set spec.deletionPolicy to Abandon before then and create the instance again with the same spec.externalID"
+	deletionCancelledReason         string = "DeletionCancelled"
+	deletionCancelledMessage        string = "The deletion was cancelled before the instance was deprovisioned"
+	restoredReason                  string = "RestoredSuccessfully"
+	restoredMessage                 string = "The instance was restored from a cancelled deletion without provisioning it at the broker"
+	errorRestoreSpecMismatchReason  string = "RestoreSpecMismatch"
+	errorRestoreSpecMismatchMessage string = "The deletion of an instance with the same spec.externalID was cancelled, but the plan or parameters differ from those of the deleted instance"
+
+	// cancelledDeletionLabel is set on the ConfigMaps that record the
+	// instances whose deletion was cancelled within the deprovision grace
+	// period, until they are created again.
+	cancelledDeletionLabel = "servicecatalog.k8s.io/cancelled-deletion"
+	// cancelledDeletionNamePrefix is prepended to the UID of a deleted
+	// instance to name the record of its cancelled deletion.
+	cancelledDeletionNamePrefix = "servicecatalog-cancelled-deletion-"
+)
+
+// getDeprovisionGracePeriodRemaining returns how long the deprovision of the
+// given instance is still to be held back for. It is zero if the instance is
+// not being deleted, or if the deprovision has already been started.
+func (c *controller) getDeprovisionGracePeriodRemaining(instance *v1beta1.ServiceInstance) time.Duration {
+	if c.deprovisionGracePeriod <= 0 || instance.DeletionTimestamp == nil {
+		return 0
+	}
+	if instance.Status.CurrentOperation == v1beta1.ServiceInstanceOperationDeprovision {
+		return 0
+	}
+	remaining := c.deprovisionGracePeriod - time.Since(instance.DeletionTimestamp.Time)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// processServiceInstanceDeprovisionPending reports that the deprovision of the
+// given instance is being held back and requeues the instance for when the
+// grace period ends.
+func (c *controller) processServiceInstanceDeprovisionPending(instance *v1beta1.ServiceInstance, remaining time.Duration) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	deprovisionAt := instance.DeletionTimestamp.Add(c.deprovisionGracePeriod)
+	s := fmt.Sprintf(deprovisionPendingMessage, deprovisionAt.UTC().Format(time.RFC3339))
+	glog.V(4).Info(pcb.Message(s))
+
+	c.instanceAddAfter(instance, remaining)
+
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionReady && cond.Reason == deprovisionPendingReason {
+			return nil
+		}
+	}
+
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, deprovisionPendingReason, s)
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return err
+	}
+	c.recorder.Event(instance, corev1.EventTypeNormal, deprovisionPendingReason, s)
+	return nil
+}
+
+// isServiceInstanceDeletionCancellable returns whether abandoning the given
+// instance cancels its deletion: the deprovision is still held back, and the
+// instance exists at the broker.
+func (c *controller) isServiceInstanceDeletionCancellable(instance *v1beta1.ServiceInstance) bool {
+	return c.getDeprovisionGracePeriodRemaining(instance) > 0 &&
+		instance.Status.ProvisionStatus == v1beta1.ServiceInstanceProvisionStatusProvisioned &&
+		instance.Status.ExternalProperties != nil
+}
+
+// newCancelledServiceInstanceDeletion returns a ConfigMap recording the
+// external properties of the given instance, whose deletion is cancelled,
+// until the end of its deprovision grace period.
+func (c *controller) newCancelledServiceInstanceDeletion(instance *v1beta1.ServiceInstance) *corev1.ConfigMap {
+	props := instance.Status.ExternalProperties
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cancelledDeletionNamePrefix + string(instance.UID),
+			Namespace: c.clusterIDConfigMapNamespace,
+			Labels: map[string]string{
+				cancelledDeletionLabel: "ServiceInstance",
+			},
+			Annotations: map[string]string{
+				tombstoneExpiresAnnotation: instance.DeletionTimestamp.Add(c.deprovisionGracePeriod).UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string]string{
+			"instanceNamespace":            instance.Namespace,
+			"externalID":                   instance.Spec.ExternalID,
+			"clusterServicePlanExternalID": props.ClusterServicePlanExternalID,
+			"servicePlanExternalID":        props.ServicePlanExternalID,
+			"parametersChecksum":           props.ParametersChecksum,
+		},
+	}
+}
+
+// recordCancelledServiceInstanceDeletion records that the deletion of the
+// given instance is cancelled, so that the instance created again in its
+// place takes over the instance at the broker instead of provisioning it.
+func (c *controller) recordCancelledServiceInstanceDeletion(instance *v1beta1.ServiceInstance) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	record := c.newCancelledServiceInstanceDeletion(instance)
+	_, err := c.kubeClient.CoreV1().ConfigMaps(record.Namespace).Create(record)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		s := fmt.Sprintf("Error recording the cancelled deletion in %s/%s: %v", record.Namespace, record.Name, err)
+		glog.Warning(pcb.Message(s))
+		return fmt.Errorf("%s", s)
+	}
+	glog.V(4).Info(pcb.Messagef("Recorded the cancelled deletion in %s/%s", record.Namespace, record.Name))
+	return nil
+}
+
+// getCancelledServiceInstanceDeletion returns the unexpired record of the
+// cancelled deletion of an instance in the namespace and with the external ID
+// of the given one, or nil if there is none.
+func (c *controller) getCancelledServiceInstanceDeletion(instance *v1beta1.ServiceInstance) (*corev1.ConfigMap, error) {
+	if c.deprovisionGracePeriod <= 0 {
+		return nil, nil
+	}
+	records, err := c.kubeClient.CoreV1().ConfigMaps(c.clusterIDConfigMapNamespace).List(metav1.ListOptions{
+		LabelSelector: cancelledDeletionLabel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i, record := range records.Items {
+		if record.Data["instanceNamespace"] != instance.Namespace || record.Data["externalID"] != instance.Spec.ExternalID {
+			continue
+		}
+		expires, err := time.Parse(time.RFC3339, record.Annotations[tombstoneExpiresAnnotation])
+		if err != nil || !now.Before(expires) {
+			continue
+		}
+		return &records.Items[i], nil
+	}
+	return nil, nil
+}
+
+// processServiceInstanceRestore takes over, without calling the broker, the
+// instance at the broker whose deletion is recorded as cancelled in the given
+// record. The plan and parameters of the given instance must be those the
+// deleted instance had at the broker.
+func (c *controller) processServiceInstanceRestore(instance *v1beta1.ServiceInstance, record *corev1.ConfigMap) error {
+	props := instance.Status.InProgressProperties
+	if props == nil ||
+		props.ClusterServicePlanExternalID != record.Data["clusterServicePlanExternalID"] ||
+		props.ServicePlanExternalID != record.Data["servicePlanExternalID"] ||
+		props.ParametersChecksum != record.Data["parametersChecksum"] {
+		return c.handleServiceInstanceReconciliationError(instance, &operationError{
+			reason:  errorRestoreSpecMismatchReason,
+			message: errorRestoreSpecMismatchMessage,
+		})
+	}
+
+	if err := c.processExistingServiceInstanceSuccess(instance, restoredReason, restoredMessage); err != nil {
+		return err
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	err := c.kubeClient.CoreV1().ConfigMaps(record.Namespace).Delete(record.Name, &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		glog.Warning(pcb.Messagef("Error deleting the record of the cancelled deletion %s/%s: %v", record.Namespace, record.Name, err))
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	testDeprovisionGracePeriod  = time.Hour
+	testCancelledDeletionRecord = cancelledDeletionNamePrefix + "instance-uid"
+)
+
+func getTestServiceInstanceDeletedAt(deletionTimestamp metav1.Time) *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.ObjectMeta.DeletionTimestamp = &deletionTimestamp
+	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Generation = 2
+	instance.Status.ReconciledGeneration = 1
+	instance.Status.ObservedGeneration = 1
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+	}
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+	return instance
+}
+
+// TestReconcileServiceInstanceDeleteWithinGracePeriod tests that an instance
+// deleted within the deprovision grace period is not deprovisioned.
+func TestReconcileServiceInstanceDeleteWithinGracePeriod(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.deprovisionGracePeriod = testDeprovisionGracePeriod
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	deletionTimestamp := metav1.Now()
+	instance := getTestServiceInstanceDeletedAt(deletionTimestamp)
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, deprovisionPendingReason)
+
+	expectedMessage := fmt.Sprintf(deprovisionPendingMessage, deletionTimestamp.Add(testDeprovisionGracePeriod).UTC().Format(time.RFC3339))
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(deprovisionPendingReason).msg(expectedMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reconciling the pending instance again does not update it.
+	fakeCatalogClient.ClearActions()
+	if err := reconcileServiceInstance(t, testController, updatedServiceInstance.(*v1beta1.ServiceInstance)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+}
+
+// TestReconcileServiceInstanceDeleteAfterGracePeriod tests that an instance
+// is deprovisioned once the deprovision grace period has passed.
+func TestReconcileServiceInstanceDeleteAfterGracePeriod(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.deprovisionGracePeriod = testDeprovisionGracePeriod
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceDeletedAt(metav1.NewTime(time.Now().Add(-2 * testDeprovisionGracePeriod)))
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertServiceInstanceDeprovisionInProgressIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance)
+}
+
+// TestReconcileServiceInstanceDeleteCancelled tests that abandoning an
+// instance within the deprovision grace period records the cancelled
+// deletion instead of deprovisioning the instance.
+func TestReconcileServiceInstanceDeleteCancelled(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.deprovisionGracePeriod = testDeprovisionGracePeriod
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceDeletedAt(metav1.Now())
+	instance.ObjectMeta.UID = "instance-uid"
+	instance.Spec.DeletionPolicy = v1beta1.DeletionPolicyAbandon
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 1)
+	createAction, ok := kubeActions[0].(clientgotesting.CreateAction)
+	if !ok || createAction.GetResource().Resource != "configmaps" {
+		t.Fatalf("unexpected action: %+v", kubeActions[0])
+	}
+	record := createAction.GetObject().(*corev1.ConfigMap)
+	if e, a := testCancelledDeletionRecord, record.Name; e != a {
+		t.Fatalf("unexpected record name: %v", expectedGot(e, a))
+	}
+	if e, a := DefaultClusterIDConfigMapNamespace, record.Namespace; e != a {
+		t.Fatalf("unexpected record namespace: %v", expectedGot(e, a))
+	}
+	if e, a := testServiceInstanceGUID, record.Data["externalID"]; e != a {
+		t.Fatalf("unexpected external ID: %v", expectedGot(e, a))
+	}
+	if e, a := testClusterServicePlanGUID, record.Data["clusterServicePlanExternalID"]; e != a {
+		t.Fatalf("unexpected plan: %v", expectedGot(e, a))
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertEmptyFinalizers(t, updatedServiceInstance)
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(deletionCancelledReason).msg(deletionCancelledMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceRestoredAfterCancelledDeletion tests that an
+// instance created again after its deletion was cancelled takes over the
+// instance at the broker without a provision request.
+func TestReconcileServiceInstanceRestoredAfterCancelledDeletion(t *testing.T) {
+	cases := []struct {
+		name          string
+		planID        string
+		expectRestore bool
+	}{
+		{
+			name:          "same plan",
+			planID:        testClusterServicePlanGUID,
+			expectRestore: true,
+		},
+		{
+			name:   "different plan",
+			planID: "other-plan",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+			testController.deprovisionGracePeriod = testDeprovisionGracePeriod
+
+			addGetNamespaceReaction(fakeKubeClient)
+			fakeKubeClient.AddReactor("list", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.ConfigMapList{
+					Items: []corev1.ConfigMap{{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        testCancelledDeletionRecord,
+							Namespace:   DefaultClusterIDConfigMapNamespace,
+							Labels:      map[string]string{cancelledDeletionLabel: "ServiceInstance"},
+							Annotations: map[string]string{tombstoneExpiresAnnotation: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)},
+						},
+						Data: map[string]string{
+							"instanceNamespace":            testNamespace,
+							"externalID":                   testServiceInstanceGUID,
+							"clusterServicePlanExternalID": tc.planID,
+						},
+					}},
+				}, nil
+			})
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			instance = assertServiceInstanceProvisionInProgressIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance)
+			fakeCatalogClient.ClearActions()
+			fakeKubeClient.ClearActions()
+
+			err := reconcileServiceInstance(t, testController, instance)
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+
+			kubeActions := fakeKubeClient.Actions()
+			if !tc.expectRestore {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				assertServiceInstanceReadyFalse(t, updatedServiceInstance, errorRestoreSpecMismatchReason)
+				assertNumberOfActions(t, kubeActions, 2)
+				assertActionEquals(t, kubeActions[0], "get", "namespaces")
+				assertActionEquals(t, kubeActions[1], "list", "configmaps")
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertServiceInstanceReadyCondition(t, updatedServiceInstance, v1beta1.ConditionTrue, restoredReason)
+			assertServiceInstanceProvisioned(t, updatedServiceInstance, v1beta1.ServiceInstanceProvisionStatusProvisioned)
+
+			assertNumberOfActions(t, kubeActions, 3)
+			assertActionEquals(t, kubeActions[0], "get", "namespaces")
+			assertActionEquals(t, kubeActions[1], "list", "configmaps")
+			assertActionEquals(t, kubeActions[2], "delete", "configmaps")
+			if e, a := testCancelledDeletionRecord, kubeActions[2].(clientgotesting.DeleteAction).GetName(); e != a {
+				t.Fatalf("unexpected record deleted: %v", expectedGot(e, a))
+			}
+
+			events := getRecordedEvents(testController)
+			expectedEvent := normalEventBuilder(restoredReason).msg(restoredMessage)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/url"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// documentationURLAnnotation is the annotation of a class or plan that
+	// holds the link to its documentation.
+	documentationURLAnnotation = "servicecatalog.k8s.io/documentation-url"
+
+	// supportURLAnnotation is the annotation of a class or plan that holds
+	// the link to its support.
+	supportURLAnnotation = "servicecatalog.k8s.io/support-url"
+
+	// documentationURLMetadataKey and supportURLMetadataKey are the
+	// conventional keys of the metadata of a service or plan in the catalog
+	// of a broker that hold the links to its documentation and support.
+	documentationURLMetadataKey = "documentationUrl"
+	supportURLMetadataKey       = "supportUrl"
+)
+
+// getExternalDocumentationURLs returns the links to the documentation and the
+// support given by the metadata of a service or plan in the catalog of a
+// broker. Values that are not absolute http or https URLs are ignored.
+func getExternalDocumentationURLs(metadata map[string]interface{}) (documentationURL, supportURL string) {
+	return getExternalURL(metadata, documentationURLMetadataKey), getExternalURL(metadata, supportURLMetadataKey)
+}
+
+func getExternalURL(metadata map[string]interface{}, key string) string {
+	value, ok := metadata[key].(string)
+	if !ok || value == "" {
+		return ""
+	}
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() || (u.Scheme != "http" && u.Scheme != "https") {
+		glog.V(4).Infof("Ignoring the %s %q of the catalog metadata, which is not an http or https URL", key, value)
+		return ""
+	}
+	return value
+}
+
+// setExternalDocumentationAnnotations sets the annotations of the given class
+// or plan that hold the links to its documentation and support, and removes
+// those whose link is empty.
+func setExternalDocumentationAnnotations(obj metav1.Object, documentationURL, supportURL string) {
+	annotations := obj.GetAnnotations()
+	for key, value := range map[string]string{
+		documentationURLAnnotation: documentationURL,
+		supportURLAnnotation:       supportURL,
+	} {
+		if value == "" {
+			delete(annotations, key)
+			continue
+		}
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[key] = value
+	}
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetExternalDocumentationURLs(t *testing.T) {
+	cases := []struct {
+		name                     string
+		metadata                 map[string]interface{}
+		expectedDocumentationURL string
+		expectedSupportURL       string
+	}{
+		{
+			name: "no metadata",
+		},
+		{
+			name: "both links",
+			metadata: map[string]interface{}{
+				"documentationUrl": "https://docs.example.com",
+				"supportUrl":       "http://support.example.com",
+			},
+			expectedDocumentationURL: "https://docs.example.com",
+			expectedSupportURL:       "http://support.example.com",
+		},
+		{
+			name: "not a string",
+			metadata: map[string]interface{}{
+				"documentationUrl": 42,
+			},
+		},
+		{
+			name: "not an http URL",
+			metadata: map[string]interface{}{
+				"documentationUrl": "ftp://docs.example.com",
+				"supportUrl":       "support.example.com",
+			},
+		},
+	}
+	for _, tc := range cases {
+		documentationURL, supportURL := getExternalDocumentationURLs(tc.metadata)
+		if e, a := tc.expectedDocumentationURL, documentationURL; e != a {
+			t.Errorf("%v: unexpected documentation URL: expected %q, got %q", tc.name, e, a)
+		}
+		if e, a := tc.expectedSupportURL, supportURL; e != a {
+			t.Errorf("%v: unexpected support URL: expected %q, got %q", tc.name, e, a)
+		}
+	}
+}
+
+func TestSetExternalDocumentationAnnotations(t *testing.T) {
+	obj := &metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"other":              "value",
+			supportURLAnnotation: "https://old.example.com",
+		},
+	}
+	setExternalDocumentationAnnotations(obj, "https://docs.example.com", "")
+
+	if e, a := "https://docs.example.com", obj.Annotations[documentationURLAnnotation]; e != a {
+		t.Errorf("unexpected documentation URL annotation: expected %q, got %q", e, a)
+	}
+	if _, ok := obj.Annotations[supportURLAnnotation]; ok {
+		t.Errorf("expected the support URL annotation to be removed")
+	}
+	if e, a := "value", obj.Annotations["other"]; e != a {
+		t.Errorf("expected other annotations to be kept")
+	}
+}
+
+func TestConvertCatalogExternalDocumentation(t *testing.T) {
+	catalog := &osb.CatalogResponse{
+		Services: []osb.Service{{
+			ID:   "service-id",
+			Name: "service",
+			Metadata: map[string]interface{}{
+				"documentationUrl": "https://docs.example.com",
+				"supportUrl":       "https://support.example.com",
+			},
+			Plans: []osb.Plan{{
+				ID:   "plan-id",
+				Name: "plan",
+				Metadata: map[string]interface{}{
+					"documentationUrl": "https://docs.example.com/plan",
+				},
+			}},
+		}},
+	}
+
+	classes, plans, err := convertAndFilterCatalog(catalog, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	class := classes[0]
+	if e, a := "https://docs.example.com", class.Spec.DocumentationURL; e != a {
+		t.Errorf("unexpected class documentation URL: expected %q, got %q", e, a)
+	}
+	if e, a := "https://support.example.com", class.Spec.SupportURL; e != a {
+		t.Errorf("unexpected class support URL: expected %q, got %q", e, a)
+	}
+	if e, a := "https://support.example.com", class.Annotations[supportURLAnnotation]; e != a {
+		t.Errorf("unexpected class support URL annotation: expected %q, got %q", e, a)
+	}
+	plan := plans[0]
+	if e, a := "https://docs.example.com/plan", plan.Spec.DocumentationURL; e != a {
+		t.Errorf("unexpected plan documentation URL: expected %q, got %q", e, a)
+	}
+	if _, ok := plan.Annotations[supportURLAnnotation]; ok {
+		t.Errorf("expected the plan to have no support URL annotation")
+	}
+
+	namespacedClasses, namespacedPlans, err := convertAndFilterCatalogToNamespacedTypes("test-ns", catalog, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "https://docs.example.com", namespacedClasses[0].Annotations[documentationURLAnnotation]; e != a {
+		t.Errorf("unexpected namespaced class documentation URL annotation: expected %q, got %q", e, a)
+	}
+	if e, a := "https://docs.example.com/plan", namespacedPlans[0].Spec.DocumentationURL; e != a {
+		t.Errorf("unexpected namespaced plan documentation URL: expected %q, got %q", e, a)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// failureWebhookAnnotation is the annotation of a namespace that holds
+	// the https URL that is notified of the terminal failures of the
+	// instances and bindings in the namespace.
+	failureWebhookAnnotation = "servicecatalog.k8s.io/failure-webhook-url"
+
+	// failureWebhookTimeout is how long a call to a failure webhook may take.
+	failureWebhookTimeout = 10 * time.Second
+)
+
+// The operations of a FailureNotification.
+const (
+	FailureOperationProvision        = "Provision"
+	FailureOperationUpdate           = "Update"
+	FailureOperationDeprovision      = "Deprovision"
+	FailureOperationBind             = "Bind"
+	FailureOperationUnbind           = "Unbind"
+	FailureOperationOrphanMitigation = "OrphanMitigation"
+)
+
+// FailureNotification is the body of the POST request sent to the failure
+// webhook of a namespace when an operation on an instance or binding in the
+// namespace fails for good. Failures that are retried are not notified.
+type FailureNotification struct {
+	// Kind is ServiceInstance or ServiceBinding.
+	Kind string `json:"kind"`
+	// Namespace and Name identify the instance or binding.
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Operation is the operation that failed.
+	Operation string `json:"operation"`
+	// Reason and Message are those of the condition that records the
+	// failure.
+	Reason  string      `json:"reason"`
+	Message string      `json:"message"`
+	Time    metav1.Time `json:"time"`
+}
+
+// newFailureWebhookClient returns the client that calls failure webhooks.
+func newFailureWebhookClient() *http.Client {
+	return &http.Client{Timeout: failureWebhookTimeout}
+}
+
+// notifyTerminalFailure sends a FailureNotification to the failure webhook of
+// the namespace of the given instance or binding, if failure webhooks are
+// enabled and the namespace has one. The webhook is called in the background,
+// so that it cannot hold up the reconciliation, and errors are only logged.
+func (c *controller) notifyTerminalFailure(kind string, meta metav1.Object, operation, reason, message string) {
+	if c.failureWebhookClient == nil {
+		return
+	}
+	ns, err := c.kubeClient.CoreV1().Namespaces().Get(meta.GetNamespace(), metav1.GetOptions{})
+	if err != nil {
+		glog.Warningf("Couldn't get namespace %q to notify the failure of %s %s/%s: %v", meta.GetNamespace(), kind, meta.GetNamespace(), meta.GetName(), err)
+		return
+	}
+	webhookURL := ns.Annotations[failureWebhookAnnotation]
+	if webhookURL == "" {
+		return
+	}
+	if u, err := url.Parse(webhookURL); err != nil || u.Scheme != "https" {
+		glog.Warningf("Ignoring the %s annotation of namespace %q: %q is not an https URL", failureWebhookAnnotation, ns.Name, webhookURL)
+		return
+	}
+
+	notification := &FailureNotification{
+		Kind:      kind,
+		Namespace: meta.GetNamespace(),
+		Name:      meta.GetName(),
+		Operation: operation,
+		Reason:    reason,
+		Message:   message,
+		Time:      metav1.Now(),
+	}
+	body, err := json.Marshal(notification)
+	if err != nil {
+		glog.Errorf("Couldn't marshal the failure notification of %s %s/%s: %v", kind, meta.GetNamespace(), meta.GetName(), err)
+		return
+	}
+	go func() {
+		if err := c.callFailureWebhook(webhookURL, body); err != nil {
+			glog.Warningf("Failed to notify the failure webhook of namespace %q of the failure of %s %s: %v", notification.Namespace, kind, notification.Name, err)
+		}
+	}()
+}
+
+func (c *controller) callFailureWebhook(webhookURL string, body []byte) error {
+	resp, err := c.failureWebhookClient.Post(webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
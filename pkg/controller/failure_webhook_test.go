@@ -0,0 +1,138 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func addGetNamespaceWithAnnotationsReaction(fakeKubeClient *clientgofake.Clientset, annotations map[string]string) {
+	fakeKubeClient.PrependReactor("get", "namespaces", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        testNamespace,
+				Annotations: annotations,
+			},
+		}, nil
+	})
+}
+
+// TestProcessBindFailureNotifiesFailureWebhook tests that a terminal bind
+// failure is sent to the failure webhook of the namespace of the binding.
+func TestProcessBindFailureNotifiesFailureWebhook(t *testing.T) {
+	notifications := make(chan *FailureNotification, 1)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notification := &FailureNotification{}
+		if err := json.NewDecoder(r.Body).Decode(notification); err != nil {
+			t.Errorf("unexpected error decoding the notification: %v", err)
+		}
+		notifications <- notification
+	}))
+	defer server.Close()
+
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.failureWebhookClient = server.Client()
+	addGetNamespaceWithAnnotationsReaction(fakeKubeClient, map[string]string{failureWebhookAnnotation: server.URL})
+
+	binding := getTestServiceBinding()
+	failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorBindCallReason, "bind failed")
+	if err := testController.processBindFailure(binding, nil, failedCond, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case notification := <-notifications:
+		expected := FailureNotification{
+			Kind:      "ServiceBinding",
+			Namespace: testNamespace,
+			Name:      testServiceBindingName,
+			Operation: FailureOperationBind,
+			Reason:    errorBindCallReason,
+			Message:   "bind failed",
+			Time:      notification.Time,
+		}
+		if *notification != expected {
+			t.Fatalf("expected notification %+v, got %+v", expected, *notification)
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("timed out waiting for the failure webhook to be called")
+	}
+}
+
+// TestNotifyTerminalFailureWithoutWebhook tests that nothing is sent when
+// failure webhooks are disabled, or the namespace has no https webhook.
+func TestNotifyTerminalFailureWithoutWebhook(t *testing.T) {
+	cases := []struct {
+		name        string
+		enabled     bool
+		annotations map[string]string
+		kubeActions int
+	}{
+		{
+			name:        "disabled",
+			annotations: map[string]string{failureWebhookAnnotation: "https://example.com/failures"},
+		},
+		{
+			name:        "no annotation",
+			enabled:     true,
+			kubeActions: 1,
+		},
+		{
+			name:        "not https",
+			enabled:     true,
+			annotations: map[string]string{failureWebhookAnnotation: "http://example.com/failures"},
+			kubeActions: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+		client := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			t.Errorf("%v: unexpected call to %v", tc.name, r.URL)
+			return nil, nil
+		})}
+		if tc.enabled {
+			testController.failureWebhookClient = client
+		}
+		addGetNamespaceWithAnnotationsReaction(fakeKubeClient, tc.annotations)
+
+		instance := getTestServiceInstance()
+		testController.notifyTerminalFailure("ServiceInstance", instance, FailureOperationProvision, errorProvisionCallFailedReason, "provision failed")
+		if e, a := tc.kubeActions, len(fakeKubeClient.Actions()); e != a {
+			t.Errorf("%v: expected %v kube actions, got %v", tc.name, e, a)
+		}
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
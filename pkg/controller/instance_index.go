@@ -0,0 +1,102 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	// instanceClusterServiceClassRefIndex indexes the instances of the
+	// informer cache by the name of their resolved ClusterServiceClass. It is
+	// named after the field selector the API server serves for the same
+	// lookup.
+	instanceClusterServiceClassRefIndex = "spec.clusterServiceClassRef.name"
+	// instanceClusterServicePlanRefIndex indexes the instances of the
+	// informer cache by the name of their resolved ClusterServicePlan.
+	instanceClusterServicePlanRefIndex = "spec.clusterServicePlanRef.name"
+	// instanceServiceClassRefIndex indexes the instances of the informer
+	// cache by the namespace and name of their resolved ServiceClass, joined
+	// by a slash.
+	instanceServiceClassRefIndex = "spec.serviceClassRef.name"
+)
+
+// instanceIndexers are the indexers added to the instance informer, so that
+// the instances of a class or plan are found without going through all the
+// instances of the cluster.
+var instanceIndexers = cache.Indexers{
+	instanceClusterServiceClassRefIndex: instanceClusterServiceClassRefIndexFunc,
+	instanceClusterServicePlanRefIndex:  instanceClusterServicePlanRefIndexFunc,
+	instanceServiceClassRefIndex:        instanceServiceClassRefIndexFunc,
+}
+
+// instanceClusterServiceClassRefIndexFunc returns the name of the resolved
+// ClusterServiceClass of the given instance, if any.
+func instanceClusterServiceClassRefIndexFunc(obj interface{}) ([]string, error) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		return nil, fmt.Errorf("expected a ServiceInstance, got %T", obj)
+	}
+	if instance.Spec.ClusterServiceClassRef == nil {
+		return nil, nil
+	}
+	return []string{instance.Spec.ClusterServiceClassRef.Name}, nil
+}
+
+// instanceClusterServicePlanRefIndexFunc returns the name of the resolved
+// ClusterServicePlan of the given instance, if any.
+func instanceClusterServicePlanRefIndexFunc(obj interface{}) ([]string, error) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		return nil, fmt.Errorf("expected a ServiceInstance, got %T", obj)
+	}
+	if instance.Spec.ClusterServicePlanRef == nil {
+		return nil, nil
+	}
+	return []string{instance.Spec.ClusterServicePlanRef.Name}, nil
+}
+
+// instanceServiceClassRefIndexFunc returns the namespace and name of the
+// resolved ServiceClass of the given instance, if any.
+func instanceServiceClassRefIndexFunc(obj interface{}) ([]string, error) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		return nil, fmt.Errorf("expected a ServiceInstance, got %T", obj)
+	}
+	if instance.Spec.ServiceClassRef == nil {
+		return nil, nil
+	}
+	return []string{instance.Namespace + "/" + instance.Spec.ServiceClassRef.Name}, nil
+}
+
+// listServiceInstancesByIndex returns the instances of the informer cache
+// whose value of the given index is the given value.
+func (c *controller) listServiceInstancesByIndex(index, value string) ([]*v1beta1.ServiceInstance, error) {
+	objs, err := c.instanceIndexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+	instances := make([]*v1beta1.ServiceInstance, 0, len(objs))
+	for _, obj := range objs {
+		instances = append(instances, obj.(*v1beta1.ServiceInstance))
+	}
+	return instances, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+)
+
+func TestListServiceInstancesByIndex(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	resolved := getTestServiceInstanceWithClusterRefs()
+	unresolved := getTestServiceInstance()
+	unresolved.Name = "unresolved-instance"
+	namespaced := getTestServiceInstanceWithNamespacedRefs()
+	namespaced.Name = "namespaced-instance"
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(resolved)
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(unresolved)
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(namespaced)
+
+	cases := []struct {
+		index    string
+		value    string
+		expected string
+	}{
+		{instanceClusterServiceClassRefIndex, testClusterServiceClassGUID, resolved.Name},
+		{instanceClusterServicePlanRefIndex, testClusterServicePlanGUID, resolved.Name},
+		{instanceClusterServiceClassRefIndex, "other-class", ""},
+		{instanceClusterServicePlanRefIndex, "other-plan", ""},
+		{instanceServiceClassRefIndex, testNamespace + "/" + testServiceClassGUID, namespaced.Name},
+		{instanceServiceClassRefIndex, "other-ns/" + testServiceClassGUID, ""},
+	}
+	for _, tc := range cases {
+		instances, err := testController.listServiceInstancesByIndex(tc.index, tc.value)
+		if err != nil {
+			t.Fatalf("%v=%v: unexpected error: %v", tc.index, tc.value, err)
+		}
+		if tc.expected == "" {
+			if len(instances) != 0 {
+				t.Fatalf("%v=%v: expected no instances, got %d", tc.index, tc.value, len(instances))
+			}
+			continue
+		}
+		if len(instances) != 1 || instances[0].Name != tc.expected {
+			t.Fatalf("%v=%v: expected instance %q, got %v", tc.index, tc.value, tc.expected, instances)
+		}
+	}
+}
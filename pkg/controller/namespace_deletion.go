@@ -0,0 +1,171 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	namespaceDeletionAbandonedReason          string = "NamespaceDeletionAbandoned"
+	namespaceDeletionFailedAttemptsMessage    string = "The namespace is being deleted and %d %s requests have failed; removing the resource without contacting the broker"
+	namespaceDeletionBrokerUnreachableMessage string = "The namespace is being deleted and the broker has been unreachable for more than %v; removing the resource without contacting the broker"
+)
+
+// namespaceDeletionPolicy configures when instances and bindings in a
+// namespace that is being deleted are abandoned at the broker, so that the
+// namespace can terminate even if the broker is gone.
+type namespaceDeletionPolicy struct {
+	// maxFailedAttempts is the number of failed deprovision or unbind
+	// requests after which the resource is abandoned. Zero disables the
+	// check.
+	maxFailedAttempts int64
+	// brokerUnreachableTimeout is how long the broker may be unreachable
+	// before the resource is abandoned. Zero disables the check.
+	brokerUnreachableTimeout time.Duration
+}
+
+func (p namespaceDeletionPolicy) enabled() bool {
+	return p.maxFailedAttempts > 0 || p.brokerUnreachableTimeout > 0
+}
+
+// getBrokerUnreachableSince returns since when a broker with the given
+// conditions has been failing to fetch its catalog, or nil if it has not.
+func getBrokerUnreachableSince(conditions []v1beta1.ServiceBrokerCondition) *metav1.Time {
+	for _, cond := range conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionReady {
+			if cond.Status == v1beta1.ConditionFalse && cond.Reason == errorFetchingCatalogReason {
+				return &cond.LastTransitionTime
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// getServiceInstanceBrokerConditions returns the conditions of the broker
+// offering the class of the given instance, and whether the broker exists.
+func (c *controller) getServiceInstanceBrokerConditions(instance *v1beta1.ServiceInstance) ([]v1beta1.ServiceBrokerCondition, bool) {
+	brokerName := c.getBrokerNameForServiceInstance(instance)
+	if brokerName == "" {
+		return nil, false
+	}
+	if instance.Spec.ClusterServiceClassSpecified() {
+		broker, err := c.clusterServiceBrokerLister.Get(brokerName)
+		if err != nil {
+			return nil, false
+		}
+		return broker.Status.Conditions, true
+	}
+	serviceClass, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+	if err != nil {
+		return nil, false
+	}
+	if serviceClass.Spec.ClusterServiceBroker {
+		broker, err := c.clusterServiceBrokerLister.Get(brokerName)
+		if err != nil {
+			return nil, false
+		}
+		return broker.Status.Conditions, true
+	}
+	broker, err := c.getServiceBrokerForServiceClass(serviceClass)
+	if err != nil {
+		return nil, false
+	}
+	return broker.Status.Conditions, true
+}
+
+// isNamespaceTerminating returns whether the given namespace is being, or has
+// been, deleted.
+func (c *controller) isNamespaceTerminating(namespace string) (bool, error) {
+	ns, err := c.kubeClient.CoreV1().Namespaces().Get(namespace, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating, nil
+}
+
+// getNamespaceDeletionAbandonMessage returns why a resource in a namespace
+// that is being deleted should be abandoned at the broker, or an empty string
+// if it should not. failedAttempts is the number of failed requests to delete
+// the resource at the broker, described by operation. brokerUnreachableSince
+// is since when the broker has been unreachable, or nil if it is reachable.
+func (c *controller) getNamespaceDeletionAbandonMessage(namespace string, failedAttempts int64, operation string, brokerUnreachableSince *metav1.Time) (string, error) {
+	policy := c.namespaceDeletionPolicy
+
+	var message string
+	switch {
+	case policy.maxFailedAttempts > 0 && failedAttempts >= policy.maxFailedAttempts:
+		message = fmt.Sprintf(namespaceDeletionFailedAttemptsMessage, failedAttempts, operation)
+	case policy.brokerUnreachableTimeout > 0 && brokerUnreachableSince != nil &&
+		time.Since(brokerUnreachableSince.Time) > policy.brokerUnreachableTimeout:
+		message = fmt.Sprintf(namespaceDeletionBrokerUnreachableMessage, policy.brokerUnreachableTimeout)
+	default:
+		return "", nil
+	}
+
+	terminating, err := c.isNamespaceTerminating(namespace)
+	if err != nil || !terminating {
+		return "", err
+	}
+	return message, nil
+}
+
+// getServiceInstanceNamespaceDeletionAbandonMessage returns why the given
+// instance should be abandoned at the broker under the namespace deletion
+// policy, or an empty string if it should not. An instance whose broker no
+// longer exists is considered unreachable since it was deleted.
+func (c *controller) getServiceInstanceNamespaceDeletionAbandonMessage(instance *v1beta1.ServiceInstance) (string, error) {
+	if !c.namespaceDeletionPolicy.enabled() {
+		return "", nil
+	}
+
+	unreachableSince := instance.DeletionTimestamp
+	if conditions, ok := c.getServiceInstanceBrokerConditions(instance); ok {
+		unreachableSince = getBrokerUnreachableSince(conditions)
+	}
+	return c.getNamespaceDeletionAbandonMessage(instance.Namespace, instance.Status.FailedDeprovisionAttempts, "deprovision", unreachableSince)
+}
+
+// getServiceBindingNamespaceDeletionAbandonMessage returns why the given
+// binding should be abandoned at the broker under the namespace deletion
+// policy, or an empty string if it should not. A binding whose instance or
+// broker no longer exists is considered unreachable since it was deleted.
+func (c *controller) getServiceBindingNamespaceDeletionAbandonMessage(binding *v1beta1.ServiceBinding) (string, error) {
+	if !c.namespaceDeletionPolicy.enabled() {
+		return "", nil
+	}
+
+	unreachableSince := binding.DeletionTimestamp
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.ServiceInstanceRef.Name)
+	if err == nil {
+		if conditions, ok := c.getServiceInstanceBrokerConditions(instance); ok {
+			unreachableSince = getBrokerUnreachableSince(conditions)
+		}
+	}
+	return c.getNamespaceDeletionAbandonMessage(binding.Namespace, binding.Status.FailedUnbindAttempts, "unbind", unreachableSince)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	testNamespaceDeletionMaxFailedAttempts        = 3
+	testNamespaceDeletionBrokerUnreachableTimeout = time.Hour
+)
+
+func getTestTerminatingNamespace() *corev1.Namespace {
+	now := metav1.Now()
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              testNamespace,
+			DeletionTimestamp: &now,
+		},
+		Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+	}
+}
+
+func getTestClusterServiceBrokerUnreachableSince(since time.Time) *v1beta1.ClusterServiceBroker {
+	broker := getTestClusterServiceBroker()
+	broker.Status.Conditions = []v1beta1.ServiceBrokerCondition{{
+		Type:               v1beta1.ServiceBrokerConditionReady,
+		Status:             v1beta1.ConditionFalse,
+		Reason:             errorFetchingCatalogReason,
+		LastTransitionTime: metav1.NewTime(since),
+	}}
+	return broker
+}
+
+func TestReconcileServiceInstanceDeleteNamespaceDeletionPolicy(t *testing.T) {
+	cases := []struct {
+		name                 string
+		failedAttempts       int64
+		broker               *v1beta1.ClusterServiceBroker
+		namespaceTerminating bool
+		expectAbandon        bool
+		expectedMessage      string
+	}{
+		{
+			name:                 "failed attempts exceeded in terminating namespace",
+			failedAttempts:       testNamespaceDeletionMaxFailedAttempts,
+			broker:               getTestClusterServiceBroker(),
+			namespaceTerminating: true,
+			expectAbandon:        true,
+			expectedMessage:      "The namespace is being deleted and 3 deprovision requests have failed; removing the resource without contacting the broker",
+		},
+		{
+			name:           "failed attempts exceeded in active namespace",
+			failedAttempts: testNamespaceDeletionMaxFailedAttempts,
+			broker:         getTestClusterServiceBroker(),
+		},
+		{
+			name:                 "broker unreachable beyond timeout in terminating namespace",
+			broker:               getTestClusterServiceBrokerUnreachableSince(time.Now().Add(-2 * testNamespaceDeletionBrokerUnreachableTimeout)),
+			namespaceTerminating: true,
+			expectAbandon:        true,
+			expectedMessage:      "The namespace is being deleted and the broker has been unreachable for more than 1h0m0s; removing the resource without contacting the broker",
+		},
+		{
+			name:                 "broker unreachable within timeout in terminating namespace",
+			broker:               getTestClusterServiceBrokerUnreachableSince(time.Now()),
+			namespaceTerminating: true,
+		},
+		{
+			name:                 "broker reachable in terminating namespace",
+			failedAttempts:       1,
+			broker:               getTestClusterServiceBroker(),
+			namespaceTerminating: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+			testController.namespaceDeletionPolicy = namespaceDeletionPolicy{
+				maxFailedAttempts:        testNamespaceDeletionMaxFailedAttempts,
+				brokerUnreachableTimeout: testNamespaceDeletionBrokerUnreachableTimeout,
+			}
+			if tc.namespaceTerminating {
+				fakeKubeClient.PrependReactor("get", "namespaces", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+					return true, getTestTerminatingNamespace(), nil
+				})
+			}
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(tc.broker)
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+			instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+			instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusFailed
+			instance.Status.FailedDeprovisionAttempts = tc.failedAttempts
+
+			fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, instance, nil
+			})
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+			actions := fakeCatalogClient.Actions()
+			events := getRecordedEvents(testController)
+			if !tc.expectAbandon {
+				assertNumberOfActions(t, actions, 0)
+				assertNumEvents(t, events, 0)
+				return
+			}
+
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			assertEmptyFinalizers(t, updatedServiceInstance)
+
+			expectedEvent := warningEventBuilder(namespaceDeletionAbandonedReason).msg(tc.expectedMessage)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReconcileServiceBindingDeleteNamespaceDeletionPolicy(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.namespaceDeletionPolicy = namespaceDeletionPolicy{
+		maxFailedAttempts: testNamespaceDeletionMaxFailedAttempts,
+	}
+	fakeKubeClient.PrependReactor("get", "namespaces", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, getTestTerminatingNamespace(), nil
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithRefsAndExternalProperties())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingUnbinding()
+	binding.Status.UnbindStatus = v1beta1.ServiceBindingUnbindStatusFailed
+	binding.Status.FailedUnbindAttempts = testNamespaceDeletionMaxFailedAttempts
+
+	fakeCatalogClient.AddReactor("get", "servicebindings", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, binding, nil
+	})
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 2)
+	assertActionEquals(t, kubeActions[0], "get", "namespaces")
+	assertActionEquals(t, kubeActions[1], "delete", "secrets")
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertEmptyFinalizers(t, updatedServiceBinding)
+
+	events := getRecordedEvents(testController)
+	expectedEvent := warningEventBuilder(namespaceDeletionAbandonedReason).msg("The namespace is being deleted and 3 unbind requests have failed; removing the resource without contacting the broker")
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
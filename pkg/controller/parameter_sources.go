@@ -0,0 +1,255 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ParameterSource fetches parameters from one kind of source referenced by
+// the parametersFrom of an instance or binding. Sources are resolved by the
+// controller each time it sends parameters to a broker; only the redacted
+// parameters are stored in the status of the resource.
+type ParameterSource interface {
+	// Handles returns whether the source fetches the parameters of the
+	// given parametersFrom entry.
+	Handles(parametersFrom *v1beta1.ParametersFromSource) bool
+
+	// Fetch returns the parameters of the given parametersFrom entry of a
+	// resource in the given namespace.
+	Fetch(kubeClient kubernetes.Interface, namespace string, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error)
+}
+
+var (
+	parameterSourcesLock sync.RWMutex
+
+	// parameterSources are consulted in order; the first source that handles
+	// a parametersFrom entry fetches it.
+	parameterSources = []ParameterSource{
+		secretParameterSource{},
+		configMapParameterSource{},
+		defaultHTTPSParameterSource,
+	}
+)
+
+// RegisterParameterSource adds a source of parameters, which is consulted
+// after the built-in secret, config map and HTTPS sources.
+func RegisterParameterSource(source ParameterSource) {
+	parameterSourcesLock.Lock()
+	defer parameterSourcesLock.Unlock()
+	parameterSources = append(parameterSources, source)
+}
+
+// findParameterSource returns the source that handles the given
+// parametersFrom entry, or nil if there is none.
+func findParameterSource(parametersFrom *v1beta1.ParametersFromSource) ParameterSource {
+	parameterSourcesLock.RLock()
+	defer parameterSourcesLock.RUnlock()
+	for _, source := range parameterSources {
+		if source.Handles(parametersFrom) {
+			return source
+		}
+	}
+	return nil
+}
+
+// secretParameterSource fetches parameters from a key of a Secret.
+type secretParameterSource struct{}
+
+func (secretParameterSource) Handles(parametersFrom *v1beta1.ParametersFromSource) bool {
+	return parametersFrom.SecretKeyRef != nil
+}
+
+func (secretParameterSource) Fetch(kubeClient kubernetes.Interface, namespace string, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error) {
+	data, err := fetchSecretKeyValue(kubeClient, namespace, parametersFrom.SecretKeyRef)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalJSON(data)
+}
+
+// configMapParameterSource fetches parameters from a key of a ConfigMap.
+type configMapParameterSource struct{}
+
+func (configMapParameterSource) Handles(parametersFrom *v1beta1.ParametersFromSource) bool {
+	return parametersFrom.ConfigMapKeyRef != nil
+}
+
+func (configMapParameterSource) Fetch(kubeClient kubernetes.Interface, namespace string, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error) {
+	ref := parametersFrom.ConfigMapKeyRef
+	configMap, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalJSON([]byte(configMap.Data[ref.Key]))
+}
+
+const (
+	// httpsParameterSourceTimeout bounds each request to an HTTPS parameter
+	// source.
+	httpsParameterSourceTimeout = 30 * time.Second
+
+	// maxHTTPSParametersSize is the largest response body accepted from an
+	// HTTPS parameter source.
+	maxHTTPSParametersSize = 1 << 20
+)
+
+// httpsParameterSource fetches parameters from an HTTPS endpoint, optionally
+// authenticating with a bearer token held in a Secret. The requests come from
+// the network of the controller, so the source is disabled unless the
+// operator allows the hosts it may fetch from.
+type httpsParameterSource struct {
+	lock sync.Mutex
+	// allowedHosts holds the host names, or host:port pairs, of the URLs
+	// parameters may be fetched from. The source is disabled if empty.
+	allowedHosts map[string]bool
+	// clients holds the client for each distinct CA bundle, keyed by its
+	// hash, so that their connections are reused.
+	clients map[string]*http.Client
+}
+
+// defaultHTTPSParameterSource is the built-in HTTPS source of parameters.
+var defaultHTTPSParameterSource = &httpsParameterSource{}
+
+// SetHTTPSParameterSourceHosts sets the host names, or host:port pairs, of the
+// URLs from which the built-in HTTPS source may fetch parameters. An empty
+// list disables the source.
+func SetHTTPSParameterSourceHosts(hosts []string) {
+	allowedHosts := make(map[string]bool)
+	for _, host := range hosts {
+		allowedHosts[strings.ToLower(host)] = true
+	}
+
+	defaultHTTPSParameterSource.lock.Lock()
+	defer defaultHTTPSParameterSource.lock.Unlock()
+	defaultHTTPSParameterSource.allowedHosts = allowedHosts
+}
+
+func (*httpsParameterSource) Handles(parametersFrom *v1beta1.ParametersFromSource) bool {
+	return parametersFrom.HTTPS != nil
+}
+
+// checkURL returns an error if parameters may not be fetched from the given
+// URL.
+func (s *httpsParameterSource) checkURL(u *url.URL) error {
+	if u.Scheme != "https" {
+		return fmt.Errorf("parameters may only be fetched over https, not from %q", u)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.allowedHosts) == 0 {
+		return fmt.Errorf("fetching parameters over https is disabled on this controller")
+	}
+	if !s.allowedHosts[strings.ToLower(u.Hostname())] && !s.allowedHosts[strings.ToLower(u.Host)] {
+		return fmt.Errorf("parameters may not be fetched from the host of %q", u)
+	}
+	return nil
+}
+
+// client returns the client verifying certificates with the given CA bundle,
+// or with the system trust roots if it is empty.
+func (s *httpsParameterSource) client(caBundle []byte) (*http.Client, error) {
+	sum := sha256.Sum256(caBundle)
+	key := hex.EncodeToString(sum[:])
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if client, ok := s.clients[key]; ok {
+		return client, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if len(caBundle) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("failed to parse the CA bundle")
+		}
+	}
+	client := &http.Client{
+		Timeout:   httpsParameterSourceTimeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		// Redirects are held to the same checks as the URL of the source.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return fmt.Errorf("stopped after 10 redirects")
+			}
+			return s.checkURL(req.URL)
+		},
+	}
+	if s.clients == nil {
+		s.clients = make(map[string]*http.Client)
+	}
+	s.clients[key] = client
+	return client, nil
+}
+
+func (s *httpsParameterSource) Fetch(kubeClient kubernetes.Interface, namespace string, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error) {
+	source := parametersFrom.HTTPS
+
+	req, err := http.NewRequest(http.MethodGet, source.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkURL(req.URL); err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if source.BearerTokenSecretKeyRef != nil {
+		token, err := fetchSecretKeyValue(kubeClient, namespace, source.BearerTokenSecretKeyRef)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+string(token))
+	}
+
+	client, err := s.client(source.CABundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: %v", source.URL, err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: %v", source.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: unexpected status %s", source.URL, resp.Status)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPSParametersSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read parameters from %q: %v", source.URL, err)
+	}
+	if len(body) > maxHTTPSParametersSize {
+		return nil, fmt.Errorf("parameters from %q are larger than %d bytes", source.URL, maxHTTPSParametersSize)
+	}
+	return unmarshalJSON(body)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+func TestConfigMapParameterSource(t *testing.T) {
+	fakeKubeClient := &clientgofake.Clientset{}
+	fakeKubeClient.AddReactor("get", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.ConfigMap{
+			Data: map[string]string{"json-key": `{ "json": true }`},
+		}, nil
+	})
+
+	parametersFrom := []v1beta1.ParametersFromSource{
+		{ConfigMapKeyRef: &v1beta1.ConfigMapKeyReference{Name: "config", Key: "json-key"}},
+	}
+	params, redacted, err := buildParameters(fakeKubeClient, "test-ns", parametersFrom, nil)
+	if err != nil {
+		t.Fatalf("Failed to build parameters: %v", err)
+	}
+	if e, a := map[string]interface{}{"json": true}, params; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected parameters: expected %v, got %v", e, a)
+	}
+	if e, a := map[string]interface{}{"json": "<redacted>"}, redacted; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected redacted parameters: expected %v, got %v", e, a)
+	}
+}
+
+func TestHTTPSParameterSource(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{ "password": "s3cr3t" }`)
+	}))
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetHTTPSParameterSourceHosts([]string{serverURL.Hostname()})
+	defer SetHTTPSParameterSourceHosts(nil)
+
+	fakeKubeClient := &clientgofake.Clientset{}
+	addGetSecretReaction(fakeKubeClient, &corev1.Secret{
+		Data: map[string][]byte{"token": []byte("token")},
+	})
+	tokenRef := &v1beta1.SecretKeyReference{Name: "secret", Key: "token"}
+
+	cases := []struct {
+		name          string
+		source        *v1beta1.HTTPSParametersSource
+		shouldSucceed bool
+	}{
+		{
+			name:          "authenticated",
+			source:        &v1beta1.HTTPSParametersSource{URL: server.URL, CABundle: caBundle, BearerTokenSecretKeyRef: tokenRef},
+			shouldSucceed: true,
+		},
+		{
+			name:   "unauthenticated",
+			source: &v1beta1.HTTPSParametersSource{URL: server.URL, CABundle: caBundle},
+		},
+		{
+			name:   "untrusted certificate",
+			source: &v1beta1.HTTPSParametersSource{URL: server.URL, BearerTokenSecretKeyRef: tokenRef},
+		},
+		{
+			name:   "host not allowed",
+			source: &v1beta1.HTTPSParametersSource{URL: "https://internal.example.com", CABundle: caBundle, BearerTokenSecretKeyRef: tokenRef},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params, err := fetchParametersFromSource(fakeKubeClient, "test-ns", &v1beta1.ParametersFromSource{HTTPS: tc.source})
+			if !tc.shouldSucceed {
+				if err == nil {
+					t.Fatal("Expected error, but got success")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to fetch parameters: %v", err)
+			}
+			if e, a := map[string]interface{}{"password": "s3cr3t"}, params; !reflect.DeepEqual(e, a) {
+				t.Fatalf("unexpected parameters: expected %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+// TestHTTPSParameterSourceDisabled tests that no parameters are fetched over
+// https unless the hosts they may be fetched from are set.
+func TestHTTPSParameterSourceDisabled(t *testing.T) {
+	requests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	source := &v1beta1.HTTPSParametersSource{URL: server.URL, CABundle: caBundle}
+	if _, err := fetchParametersFromSource(&clientgofake.Clientset{}, "test-ns", &v1beta1.ParametersFromSource{HTTPS: source}); err == nil {
+		t.Fatal("Expected error, but got success")
+	}
+	if requests != 0 {
+		t.Fatalf("expected no request, got %v", requests)
+	}
+}
@@ -17,9 +17,13 @@ limitations under the License.
 package controller
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/ghodss/yaml"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
@@ -80,20 +84,11 @@ func buildParameters(kubeClient kubernetes.Interface, namespace string, paramete
 // fetchParametersFromSource fetches data from a specified external source and
 // represents it in the parameters map format
 func fetchParametersFromSource(kubeClient kubernetes.Interface, namespace string, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error) {
-	var params map[string]interface{}
-	if parametersFrom.SecretKeyRef != nil {
-		data, err := fetchSecretKeyValue(kubeClient, namespace, parametersFrom.SecretKeyRef)
-		if err != nil {
-			return nil, err
-		}
-		p, err := unmarshalJSON(data)
-		if err != nil {
-			return nil, err
-		}
-		params = p
-
+	source := findParameterSource(parametersFrom)
+	if source == nil {
+		return nil, fmt.Errorf("no parameter source handles %+v", *parametersFrom)
 	}
-	return params, nil
+	return source.Fetch(kubeClient, namespace, parametersFrom)
 }
 
 // UnmarshalRawParameters produces a map structure from a given raw YAML/JSON input
@@ -185,3 +180,159 @@ func prepareInProgressPropertyParameters(kubeClient kubernetes.Interface, namesp
 
 	return parameters, parametersChecksum, rawParametersWithRedaction, err
 }
+
+// diffRawParameters returns the structural diff between the old and the new
+// recorded parameters, or nil if they do not differ.
+func diffRawParameters(oldParameters, newParameters *runtime.RawExtension) (*v1beta1.ParametersDiff, error) {
+	var oldParams, newParams map[string]interface{}
+	var err error
+	if oldParameters != nil {
+		if oldParams, err = UnmarshalRawParameters(oldParameters.Raw); err != nil {
+			return nil, err
+		}
+	}
+	if newParameters != nil {
+		if newParams, err = UnmarshalRawParameters(newParameters.Raw); err != nil {
+			return nil, err
+		}
+	}
+
+	diff := &v1beta1.ParametersDiff{}
+	diffParameters("", oldParams, newParams, diff)
+	if len(diff.Added) == 0 && len(diff.Changed) == 0 && len(diff.Removed) == 0 {
+		return nil, nil
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Changed)
+	sort.Strings(diff.Removed)
+	return diff, nil
+}
+
+// diffParameters adds the paths of the parameters that differ between the old
+// and the new parameters to diff. Nested objects are compared key by key;
+// any other values, including arrays, are compared as a whole.
+func diffParameters(prefix string, oldParams, newParams map[string]interface{}, diff *v1beta1.ParametersDiff) {
+	for k, newValue := range newParams {
+		path := prefix + k
+		oldValue, ok := oldParams[k]
+		if !ok {
+			diff.Added = append(diff.Added, path)
+			continue
+		}
+		oldMap, oldIsMap := oldValue.(map[string]interface{})
+		newMap, newIsMap := newValue.(map[string]interface{})
+		if oldIsMap && newIsMap {
+			diffParameters(path+".", oldMap, newMap, diff)
+		} else if !reflect.DeepEqual(oldValue, newValue) {
+			diff.Changed = append(diff.Changed, path)
+		}
+	}
+	for k := range oldParams {
+		if _, ok := newParams[k]; !ok {
+			diff.Removed = append(diff.Removed, prefix+k)
+		}
+	}
+}
+
+// parametersDiffMessage describes the diff for an event.
+func parametersDiffMessage(diff *v1beta1.ParametersDiff) string {
+	var parts []string
+	if len(diff.Added) > 0 {
+		parts = append(parts, "added "+strings.Join(diff.Added, ", "))
+	}
+	if len(diff.Changed) > 0 {
+		parts = append(parts, "changed "+strings.Join(diff.Changed, ", "))
+	}
+	if len(diff.Removed) > 0 {
+		parts = append(parts, "removed "+strings.Join(diff.Removed, ", "))
+	}
+	return fmt.Sprintf("Updating parameters: %s", strings.Join(parts, "; "))
+}
+
+// instanceParameterVariables returns the variables that the parameters of the
+// given instance can refer to.
+func instanceParameterVariables(instance *v1beta1.ServiceInstance) map[string]string {
+	return map[string]string{
+		"NAMESPACE":     instance.Namespace,
+		"INSTANCE_NAME": instance.Name,
+		"INSTANCE_ID":   instance.Spec.ExternalID,
+	}
+}
+
+// bindingParameterVariables returns the variables that the parameters of the
+// given binding to the given instance can refer to.
+func bindingParameterVariables(binding *v1beta1.ServiceBinding, instance *v1beta1.ServiceInstance) map[string]string {
+	variables := instanceParameterVariables(instance)
+	variables["BINDING_NAME"] = binding.Name
+	variables["BINDING_ID"] = binding.Spec.ExternalID
+	return variables
+}
+
+// expandRawParameters returns the given parameters with the variable
+// references in their string values expanded, as done by expandVariables.
+func expandRawParameters(parameters *runtime.RawExtension, variables map[string]string) (*runtime.RawExtension, error) {
+	if parameters == nil {
+		return nil, nil
+	}
+	params, err := UnmarshalRawParameters(parameters.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal parameters to expand their variables: %v", err)
+	}
+	expanded, err := json.Marshal(expandParameterValue(params, variables))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal expanded parameters: %v", err)
+	}
+	return &runtime.RawExtension{Raw: expanded}, nil
+}
+
+// expandParameterValue expands the variable references in the strings of the
+// given parameter value, recursing into objects and arrays.
+func expandParameterValue(value interface{}, variables map[string]string) interface{} {
+	switch value := value.(type) {
+	case string:
+		return expandVariables(value, variables)
+	case map[string]interface{}:
+		expanded := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			expanded[k] = expandParameterValue(v, variables)
+		}
+		return expanded
+	case []interface{}:
+		expanded := make([]interface{}, len(value))
+		for i, v := range value {
+			expanded[i] = expandParameterValue(v, variables)
+		}
+		return expanded
+	default:
+		return value
+	}
+}
+
+// expandVariables replaces the references to variables of the form
+// $(VARIABLE) in the given string with the value of the variable, like the
+// references to environment variables in the commands of containers.
+// References to unknown variables are left unchanged, and $$ escapes a $, so
+// that $$(VARIABLE) is replaced with $(VARIABLE).
+func expandVariables(s string, variables map[string]string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if s[i] == '$' && i+1 < len(s) {
+			switch s[i+1] {
+			case '$':
+				buf.WriteByte('$')
+				i++
+				continue
+			case '(':
+				if end := strings.IndexByte(s[i+2:], ')'); end >= 0 {
+					if value, ok := variables[s[i+2:i+2+end]]; ok {
+						buf.WriteString(value)
+						i += 2 + end
+						continue
+					}
+				}
+			}
+		}
+		buf.WriteByte(s[i])
+	}
+	return buf.String()
+}
@@ -227,3 +227,145 @@ func TestGenerateChecksumOfParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffRawParameters(t *testing.T) {
+	cases := []struct {
+		name          string
+		oldParameters string
+		newParameters string
+		expectedDiff  *v1beta1.ParametersDiff
+	}{
+		{
+			name:          "no change",
+			oldParameters: `{"a": "1", "b": {"c": "2"}}`,
+			newParameters: `{"b": {"c": "2"}, "a": "1"}`,
+		},
+		{
+			name:          "nested keys",
+			oldParameters: `{"a": "1", "b": {"c": "2", "d": "3"}, "e": [1, 2]}`,
+			newParameters: `{"a": "1", "b": {"c": "4", "f": "5"}, "e": [1, 3], "g": "6"}`,
+			expectedDiff: &v1beta1.ParametersDiff{
+				Added:   []string{"b.f", "g"},
+				Changed: []string{"b.c", "e"},
+				Removed: []string{"b.d"},
+			},
+		},
+		{
+			name:          "object replaced by value",
+			oldParameters: `{"a": {"b": "1"}}`,
+			newParameters: `{"a": "1"}`,
+			expectedDiff:  &v1beta1.ParametersDiff{Changed: []string{"a"}},
+		},
+		{
+			name:          "redacted value moved to parameters",
+			oldParameters: `{"password": "<redacted>"}`,
+			newParameters: `{"password": "s3cr3t"}`,
+			expectedDiff:  &v1beta1.ParametersDiff{Changed: []string{"password"}},
+		},
+		{
+			name:          "first parameters",
+			newParameters: `{"a": "1"}`,
+			expectedDiff:  &v1beta1.ParametersDiff{Added: []string{"a"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var oldParameters, newParameters *runtime.RawExtension
+			if tc.oldParameters != "" {
+				oldParameters = &runtime.RawExtension{Raw: []byte(tc.oldParameters)}
+			}
+			if tc.newParameters != "" {
+				newParameters = &runtime.RawExtension{Raw: []byte(tc.newParameters)}
+			}
+			actual, err := diffRawParameters(oldParameters, newParameters)
+			if err != nil {
+				t.Fatalf("Failed to diff parameters: %v", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expectedDiff) {
+				t.Fatalf("incorrect result: diff \n%v", diff.ObjectGoPrintSideBySide(tc.expectedDiff, actual))
+			}
+		})
+	}
+}
+
+func TestExpandVariables(t *testing.T) {
+	variables := map[string]string{
+		"NAMESPACE":     "test-ns",
+		"INSTANCE_NAME": "test-instance",
+	}
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "no reference",
+			input:    "db",
+			expected: "db",
+		},
+		{
+			name:     "references",
+			input:    "$(NAMESPACE)-$(INSTANCE_NAME)-db",
+			expected: "test-ns-test-instance-db",
+		},
+		{
+			name:     "unknown variable",
+			input:    "$(NAMESPACE)-$(UNKNOWN)",
+			expected: "test-ns-$(UNKNOWN)",
+		},
+		{
+			name:     "escaped reference",
+			input:    "$$(NAMESPACE)",
+			expected: "$(NAMESPACE)",
+		},
+		{
+			name:     "unterminated reference",
+			input:    "$(NAMESPACE",
+			expected: "$(NAMESPACE",
+		},
+		{
+			name:     "trailing dollar",
+			input:    "price in $",
+			expected: "price in $",
+		},
+	}
+	for _, tc := range cases {
+		if e, a := tc.expected, expandVariables(tc.input, variables); e != a {
+			t.Errorf("%v: expected %q, got %q", tc.name, e, a)
+		}
+	}
+}
+
+func TestExpandRawParameters(t *testing.T) {
+	instance := &v1beta1.ServiceInstance{}
+	instance.Namespace = "test-ns"
+	instance.Name = "test-instance"
+	instance.Spec.ExternalID = "test-instance-id"
+	binding := &v1beta1.ServiceBinding{}
+	binding.Name = "test-binding"
+	binding.Spec.ExternalID = "test-binding-id"
+
+	parameters := &runtime.RawExtension{Raw: []byte(`{"database": "$(NAMESPACE)_$(INSTANCE_NAME)", "size": 3, "users": [{"name": "$(BINDING_NAME)"}], "labels": {"id": "$(INSTANCE_ID)"}}`)}
+	expanded, err := expandRawParameters(parameters, bindingParameterVariables(binding, instance))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual, err := UnmarshalRawParameters(expanded.Raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{
+		"database": "test-ns_test-instance",
+		"size":     float64(3),
+		"users":    []interface{}{map[string]interface{}{"name": "test-binding"}},
+		"labels":   map[string]interface{}{"id": "test-instance-id"},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("unexpected expanded parameters: %v", diff.ObjectReflectDiff(expected, actual))
+	}
+
+	if expanded, err := expandRawParameters(nil, nil); err != nil || expanded != nil {
+		t.Fatalf("expected no parameters, got %v, %v", expanded, err)
+	}
+}
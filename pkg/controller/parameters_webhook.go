@@ -0,0 +1,200 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+// ParametersWebhookFailurePolicy specifies how the controller handles a
+// parameters webhook that cannot be called or that returns an error.
+type ParametersWebhookFailurePolicy string
+
+const (
+	// ParametersWebhookFail fails the provision or update request, which is
+	// retried like any other error with the parameters.
+	ParametersWebhookFail ParametersWebhookFailurePolicy = "Fail"
+
+	// ParametersWebhookIgnore sends the parameters to the broker as they
+	// were before the webhook was called.
+	ParametersWebhookIgnore ParametersWebhookFailurePolicy = "Ignore"
+)
+
+const (
+	// ParametersWebhookOperationProvision is the operation of a
+	// ParametersWebhookRequest sent before a provision request.
+	ParametersWebhookOperationProvision = "provision"
+
+	// ParametersWebhookOperationUpdate is the operation of a
+	// ParametersWebhookRequest sent before an update request.
+	ParametersWebhookOperationUpdate = "update"
+
+	// maxParametersWebhookResponseSize is the largest response body accepted
+	// from a parameters webhook.
+	maxParametersWebhookResponseSize = 1 << 20
+)
+
+// ParametersWebhookRequest is the body of the POST request sent to the
+// parameters webhook.
+type ParametersWebhookRequest struct {
+	// Operation is either "provision" or "update".
+	Operation string `json:"operation"`
+	// Namespace and Name identify the instance.
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// PlanExternalName is the external name of the plan of the instance.
+	PlanExternalName string `json:"planExternalName"`
+	// Parameters are the parameters resolved from the parameters and
+	// parametersFrom of the instance.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ParametersWebhookResponse is the body of the response of the parameters
+// webhook.
+type ParametersWebhookResponse struct {
+	// Parameters replace the parameters sent to the broker.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ParametersWebhook is an external HTTPS endpoint that is called with the
+// resolved parameters of an instance before they are sent to the broker in a
+// provision or update request, and that returns the parameters to send
+// instead. Only the parameters from the spec of the instance are recorded in
+// its status, so the webhook may add values that must not be stored.
+type ParametersWebhook struct {
+	url           string
+	client        *http.Client
+	failurePolicy ParametersWebhookFailurePolicy
+}
+
+// NewParametersWebhook returns a ParametersWebhook that POSTs to the given
+// https URL, verifying its certificate with the given PEM encoded CA bundle,
+// or with the system trust roots if it is empty.
+func NewParametersWebhook(webhookURL string, caBundle []byte, timeout time.Duration, failurePolicy ParametersWebhookFailurePolicy) (*ParametersWebhook, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameters webhook URL %q: %v", webhookURL, err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid parameters webhook URL %q: the scheme must be https", webhookURL)
+	}
+	switch failurePolicy {
+	case ParametersWebhookFail, ParametersWebhookIgnore:
+	default:
+		return nil, fmt.Errorf("invalid parameters webhook failure policy %q: must be %q or %q", failurePolicy, ParametersWebhookFail, ParametersWebhookIgnore)
+	}
+
+	client, err := newWebhookClient(caBundle, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the CA bundle of the parameters webhook")
+	}
+
+	return &ParametersWebhook{
+		url:           webhookURL,
+		client:        client,
+		failurePolicy: failurePolicy,
+	}, nil
+}
+
+// newWebhookClient returns a client that calls a webhook with the given
+// timeout, verifying its certificate with the given PEM encoded CA bundle, or
+// with the system trust roots if it is empty.
+func newWebhookClient(caBundle []byte, timeout time.Duration) (*http.Client, error) {
+	tlsConfig := &tls.Config{}
+	if len(caBundle) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no certificates found in the CA bundle")
+		}
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, nil
+}
+
+// call sends the request to the webhook and returns the parameters in its
+// response.
+func (w *ParametersWebhook) call(request *ParametersWebhookRequest) (map[string]interface{}, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxParametersWebhookResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s: %s", resp.Status, bytes.TrimSpace(data))
+	}
+	if len(data) > maxParametersWebhookResponseSize {
+		return nil, fmt.Errorf("the response is larger than %d bytes", maxParametersWebhookResponseSize)
+	}
+	response := &ParametersWebhookResponse{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the response: %v", err)
+	}
+	return response.Parameters, nil
+}
+
+// mutateParameters returns the parameters to send to the broker for the given
+// operation on the instance. Without a parameters webhook, they are the given
+// parameters.
+func (c *controller) mutateParameters(instance *v1beta1.ServiceInstance, operation, planName string, parameters map[string]interface{}) (map[string]interface{}, error) {
+	if c.parametersWebhook == nil {
+		return parameters, nil
+	}
+
+	mutated, err := c.parametersWebhook.call(&ParametersWebhookRequest{
+		Operation:        operation,
+		Namespace:        instance.Namespace,
+		Name:             instance.Name,
+		PlanExternalName: planName,
+		Parameters:       parameters,
+	})
+	if err != nil {
+		if c.parametersWebhook.failurePolicy == ParametersWebhookIgnore {
+			pcb := pretty.NewInstanceContextBuilder(instance)
+			glog.Warning(pcb.Messagef("Ignoring failed call to the parameters webhook: %v", err))
+			return parameters, nil
+		}
+		return nil, fmt.Errorf("failed to call the parameters webhook: %v", err)
+	}
+	if len(mutated) == 0 {
+		return nil, nil
+	}
+	return mutated, nil
+}
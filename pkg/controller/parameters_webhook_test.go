@@ -0,0 +1,138 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewParametersWebhook(t *testing.T) {
+	cases := []struct {
+		name          string
+		url           string
+		failurePolicy ParametersWebhookFailurePolicy
+		shouldSucceed bool
+	}{
+		{
+			name:          "valid",
+			url:           "https://webhook.example.com/mutate",
+			failurePolicy: ParametersWebhookFail,
+			shouldSucceed: true,
+		},
+		{
+			name:          "http URL",
+			url:           "http://webhook.example.com/mutate",
+			failurePolicy: ParametersWebhookFail,
+		},
+		{
+			name:          "unknown failure policy",
+			url:           "https://webhook.example.com/mutate",
+			failurePolicy: "Retry",
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := NewParametersWebhook(tc.url, nil, time.Second, tc.failurePolicy)
+		if tc.shouldSucceed && err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+		}
+		if !tc.shouldSucceed && err == nil {
+			t.Errorf("%v: expected error", tc.name)
+		}
+	}
+}
+
+func TestMutateParameters(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &ParametersWebhookRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := request.Parameters["fail"]; ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		request.Parameters["operation"] = request.Operation
+		request.Parameters["plan"] = request.PlanExternalName
+		json.NewEncoder(w).Encode(&ParametersWebhookResponse{Parameters: request.Parameters})
+	}))
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	cases := []struct {
+		name               string
+		failurePolicy      ParametersWebhookFailurePolicy
+		parameters         map[string]interface{}
+		expectedParameters map[string]interface{}
+		shouldSucceed      bool
+	}{
+		{
+			name:          "mutated",
+			failurePolicy: ParametersWebhookFail,
+			parameters:    map[string]interface{}{"a": "1"},
+			expectedParameters: map[string]interface{}{
+				"a":         "1",
+				"operation": ParametersWebhookOperationProvision,
+				"plan":      testClusterServicePlanName,
+			},
+			shouldSucceed: true,
+		},
+		{
+			name:          "failure policy Fail",
+			failurePolicy: ParametersWebhookFail,
+			parameters:    map[string]interface{}{"fail": true},
+		},
+		{
+			name:               "failure policy Ignore",
+			failurePolicy:      ParametersWebhookIgnore,
+			parameters:         map[string]interface{}{"fail": true},
+			expectedParameters: map[string]interface{}{"fail": true},
+			shouldSucceed:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			webhook, err := NewParametersWebhook(server.URL, caBundle, time.Second, tc.failurePolicy)
+			if err != nil {
+				t.Fatalf("Failed to create the parameters webhook: %v", err)
+			}
+			c := &controller{parametersWebhook: webhook}
+
+			actual, err := c.mutateParameters(getTestServiceInstance(), ParametersWebhookOperationProvision, testClusterServicePlanName, tc.parameters)
+			if !tc.shouldSucceed {
+				if err == nil {
+					t.Fatal("Expected error, but got success")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to mutate parameters: %v", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expectedParameters) {
+				t.Fatalf("unexpected parameters: expected %v, got %v", tc.expectedParameters, actual)
+			}
+		})
+	}
+}
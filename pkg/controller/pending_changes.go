@@ -0,0 +1,79 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	pendingChangesReason  string = "OperationInProgress"
+	pendingChangesMessage string = "The spec has changed while the %s operation is in progress; the changes are applied once it completes"
+)
+
+// hasServiceInstancePendingChanges returns whether the spec of the given
+// instance has changed since its current provision or update started.
+//
+// The operation holds the instance until it completes: an asynchronous
+// operation is polled to its end, and a failed synchronous attempt is retried
+// after its backoff, rather than sending the broker a new request as soon as
+// the spec changes while the broker may still be working on the previous one.
+func hasServiceInstancePendingChanges(instance *v1beta1.ServiceInstance) bool {
+	// The spec no longer matters to an instance that is being deleted or
+	// whose failed provision is being mitigated.
+	if instance.DeletionTimestamp != nil || instance.Status.OrphanMitigationInProgress {
+		return false
+	}
+	switch instance.Status.CurrentOperation {
+	case v1beta1.ServiceInstanceOperationProvision, v1beta1.ServiceInstanceOperationUpdate:
+		return instance.Generation > instance.Status.ObservedGeneration
+	default:
+		return false
+	}
+}
+
+// recordServiceInstancePendingChanges sets the PendingChanges condition on
+// the given instance if its spec has changed during its current operation and
+// the condition is not set yet. Returns true if the status was updated (i.e.
+// the iteration has finished and no more processing needed).
+func (c *controller) recordServiceInstancePendingChanges(instance *v1beta1.ServiceInstance) (bool, error) {
+	if !hasServiceInstancePendingChanges(instance) {
+		return false, nil
+	}
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionPendingChanges && cond.Status == v1beta1.ConditionTrue {
+			return false, nil
+		}
+	}
+
+	instance = instance.DeepCopy()
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	s := fmt.Sprintf(pendingChangesMessage, instance.Status.CurrentOperation)
+	glog.V(4).Info(pcb.Message(s))
+	c.recorder.Event(instance, corev1.EventTypeNormal, pendingChangesReason, s)
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionPendingChanges, v1beta1.ConditionTrue, pendingChangesReason, s)
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return false, err
+	}
+	return true, nil
+}
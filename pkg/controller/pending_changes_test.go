@@ -0,0 +1,104 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// TestReconcileServiceInstancePendingChanges tests that a spec change during
+// an asynchronous provision is recorded with the PendingChanges condition,
+// and that the provision is polled to its end rather than restarted.
+func TestReconcileServiceInstancePendingChanges(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		PollLastOperationReaction: &fakeosb.PollLastOperationReaction{
+			Response: &osb.LastOperationResponse{
+				State: osb.StateInProgress,
+			},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	instance.Generation = instance.Status.ObservedGeneration + 1
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionPendingChanges, v1beta1.ConditionTrue, pendingChangesReason)
+	if updatedServiceInstance.Status.ObservedGeneration != instance.Status.ObservedGeneration {
+		t.Fatalf("expected the observed generation to stay %v, got %v", instance.Status.ObservedGeneration, updatedServiceInstance.Status.ObservedGeneration)
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(pendingChangesReason).msg(fmt.Sprintf(pendingChangesMessage, v1beta1.ServiceInstanceOperationProvision))
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The provision in progress is polled, not restarted with the new spec.
+	fakeCatalogClient.ClearActions()
+	if err := reconcileServiceInstance(t, testController, updatedServiceInstance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	operationKey := osb.OperationKey(testOperation)
+	assertPollLastOperation(t, brokerActions[0], &osb.LastOperationRequest{
+		InstanceID:   testServiceInstanceGUID,
+		ServiceID:    strPtr(testClusterServiceClassGUID),
+		PlanID:       strPtr(testClusterServicePlanGUID),
+		OperationKey: &operationKey,
+	})
+}
+
+// TestBackoffAndRequeueIfRetryingWithPendingChanges tests that a spec change
+// during an operation waits for the backoff of the operation, while a spec
+// change after an operation does not.
+func TestBackoffAndRequeueIfRetryingWithPendingChanges(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Status.ObservedGeneration = instance.Generation
+	instance.Status.CurrentOperation = v1beta1.ServiceInstanceOperationProvision
+	testController.setRetryBackoffRequired(instance)
+
+	changed := instance.DeepCopy()
+	changed.Generation++
+	if !testController.backoffAndRequeueIfRetrying(changed, "provision") {
+		t.Fatal("expected a spec change during the provision to wait for its backoff")
+	}
+
+	changed.Status.CurrentOperation = ""
+	if testController.backoffAndRequeueIfRetrying(changed, "provision") {
+		t.Fatal("expected a spec change after the provision not to wait")
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	provisionThrottledReason  string = "ProvisionRateLimitReached"
+	provisionThrottledMessage string = "Waiting to provision: %d instances started to be provisioned in the namespace %q in the last %v, and at most %d may be"
+)
+
+// namespaceProvisionRateLimiter limits how many instances may start to be
+// provisioned in each namespace within a time window, so that a runaway loop
+// creating instances in one namespace does not flood shared brokers.
+//
+// The provisions are tracked in memory, so the window starts over when the
+// controller is restarted.
+type namespaceProvisionRateLimiter struct {
+	// limit is the number of instances that may start to be provisioned in
+	// each namespace within the window. Zero disables the limit.
+	limit  int
+	window time.Duration
+
+	lock sync.Mutex
+	// started holds the time each instance was admitted to be provisioned,
+	// by namespace and instance UID.
+	started map[string]map[types.UID]time.Time
+}
+
+func newNamespaceProvisionRateLimiter(limit int, window time.Duration) *namespaceProvisionRateLimiter {
+	return &namespaceProvisionRateLimiter{
+		limit:   limit,
+		window:  window,
+		started: make(map[string]map[types.UID]time.Time),
+	}
+}
+
+func (l *namespaceProvisionRateLimiter) enabled() bool {
+	return l != nil && l.limit > 0 && l.window > 0
+}
+
+// admit returns whether the instance with the given UID may start to be
+// provisioned in the given namespace at the given time. If it may not, it
+// also returns how many instances started to be provisioned in the window and
+// how long it takes until the oldest of them leaves the window. An instance
+// that was already admitted within the window is admitted again.
+func (l *namespaceProvisionRateLimiter) admit(namespace string, uid types.UID, now time.Time) (bool, int, time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	started, ok := l.started[namespace]
+	if !ok {
+		started = make(map[types.UID]time.Time)
+		l.started[namespace] = started
+	}
+
+	var oldest time.Time
+	for otherUID, startedAt := range started {
+		if now.Sub(startedAt) >= l.window {
+			delete(started, otherUID)
+			continue
+		}
+		if oldest.IsZero() || startedAt.Before(oldest) {
+			oldest = startedAt
+		}
+	}
+
+	if _, ok := started[uid]; ok {
+		return true, 0, 0
+	}
+	if len(started) < l.limit {
+		started[uid] = now
+		return true, 0, 0
+	}
+	return false, len(started), oldest.Add(l.window).Sub(now)
+}
+
+// release forgets the admission of the instance with the given UID, whose
+// provision did not start after all.
+func (l *namespaceProvisionRateLimiter) release(namespace string, uid types.UID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if started, ok := l.started[namespace]; ok {
+		delete(started, uid)
+	}
+}
+
+// admitServiceInstanceProvisionRate returns whether the given instance may
+// start to be provisioned under the provision rate limit of its namespace. If
+// it may not, the instance is marked as throttled and requeued for when the
+// namespace is under the limit again.
+func (c *controller) admitServiceInstanceProvisionRate(instance *v1beta1.ServiceInstance) (bool, error) {
+	if !c.provisionRateLimiter.enabled() {
+		return true, nil
+	}
+	admitted, count, retryAfter := c.provisionRateLimiter.admit(instance.Namespace, instance.UID, time.Now())
+	if admitted {
+		return true, nil
+	}
+	return false, c.processServiceInstanceProvisionThrottled(instance, count, retryAfter)
+}
+
+// releaseServiceInstanceProvisionRate gives back the place the given instance
+// took under the provision rate limit of its namespace.
+func (c *controller) releaseServiceInstanceProvisionRate(instance *v1beta1.ServiceInstance) {
+	if c.provisionRateLimiter.enabled() {
+		c.provisionRateLimiter.release(instance.Namespace, instance.UID)
+	}
+}
+
+// processServiceInstanceProvisionThrottled sets the Throttled condition on the
+// given instance, whose namespace has reached its provision rate limit, and
+// requeues it.
+func (c *controller) processServiceInstanceProvisionThrottled(instance *v1beta1.ServiceInstance, count int, retryAfter time.Duration) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	s := fmt.Sprintf(provisionThrottledMessage, count, instance.Namespace, c.provisionRateLimiter.window, c.provisionRateLimiter.limit)
+	glog.V(4).Info(pcb.Message(s))
+
+	c.instanceAddAfter(instance, retryAfter)
+
+	throttled := false
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionThrottled && cond.Status == v1beta1.ConditionTrue {
+			if cond.Message == s {
+				return nil
+			}
+			throttled = true
+		}
+	}
+
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionThrottled, v1beta1.ConditionTrue, provisionThrottledReason, s)
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return err
+	}
+	if !throttled {
+		c.recorder.Event(instance, corev1.EventTypeNormal, provisionThrottledReason, s)
+	}
+	return nil
+}
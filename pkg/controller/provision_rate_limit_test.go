@@ -0,0 +1,108 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestNamespaceProvisionRateLimiterAdmit(t *testing.T) {
+	now := time.Now()
+	l := newNamespaceProvisionRateLimiter(2, time.Hour)
+	if admitted, _, _ := l.admit("ns", "a", now); !admitted {
+		t.Fatal("expected a to be admitted")
+	}
+	if admitted, _, _ := l.admit("ns", "b", now.Add(10*time.Minute)); !admitted {
+		t.Fatal("expected b to be admitted")
+	}
+	if admitted, count, retryAfter := l.admit("ns", "c", now.Add(20*time.Minute)); admitted || count != 2 || retryAfter != 40*time.Minute {
+		t.Fatalf("expected c to wait 40m behind 2 provisions, got admitted=%v count=%v retryAfter=%v", admitted, count, retryAfter)
+	}
+	if admitted, _, _ := l.admit("other-ns", "d", now.Add(20*time.Minute)); !admitted {
+		t.Fatal("expected the limit to apply per namespace")
+	}
+	if admitted, _, _ := l.admit("ns", "a", now.Add(20*time.Minute)); !admitted {
+		t.Fatal("expected an admitted instance to stay admitted")
+	}
+
+	l.release("ns", "b")
+	if admitted, _, _ := l.admit("ns", "c", now.Add(20*time.Minute)); !admitted {
+		t.Fatal("expected c to be admitted once b is released")
+	}
+	if admitted, _, _ := l.admit("ns", "e", now.Add(30*time.Minute)); admitted {
+		t.Fatal("expected e to wait")
+	}
+	if admitted, _, _ := l.admit("ns", "e", now.Add(time.Hour)); !admitted {
+		t.Fatal("expected e to be admitted once a leaves the window")
+	}
+}
+
+// TestReconcileServiceInstanceProvisionThrottled tests that an instance is not
+// provisioned while its namespace is at its provision rate limit.
+func TestReconcileServiceInstanceProvisionThrottled(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.provisionRateLimiter = newNamespaceProvisionRateLimiter(1, time.Hour)
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	testController.provisionRateLimiter.admit(testNamespace, "other-instance", time.Now())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionThrottled, v1beta1.ConditionTrue, provisionThrottledReason)
+
+	events := getRecordedEvents(testController)
+	expectedMessage := fmt.Sprintf(provisionThrottledMessage, 1, testNamespace, time.Hour, 1)
+	expectedEvent := normalEventBuilder(provisionThrottledReason).msg(expectedMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Once the other instance is released, the instance is admitted and is
+	// no longer throttled.
+	testController.provisionRateLimiter.release(testNamespace, "other-instance")
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, updatedServiceInstance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	assertServiceInstanceCurrentOperation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision)
+	for _, cond := range updatedServiceInstance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionThrottled {
+			t.Fatalf("expected the Throttled condition to be removed, got %v", cond)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// SecretRotationPolicy specifies how the controller handles new credentials
+// of a binding that would replace the credentials of a secret that running
+// pods consume.
+type SecretRotationPolicy string
+
+const (
+	// SecretRotationProceed rotates the credentials regardless of the pods
+	// consuming the secret.
+	SecretRotationProceed SecretRotationPolicy = "Proceed"
+
+	// SecretRotationWaitForDrain waits until no running pod consumes the
+	// secret before rotating the credentials.
+	SecretRotationWaitForDrain SecretRotationPolicy = "WaitForDrain"
+
+	// SecretRotationRequireAnnotation waits until no running pod consumes
+	// the secret, or until the binding carries the
+	// SecretRotationApprovedAnnotation, before rotating the credentials.
+	SecretRotationRequireAnnotation SecretRotationPolicy = "RequireAnnotation"
+
+	// SecretRotationApprovedAnnotation is the annotation of a binding that
+	// approves rotating the credentials of its secret while pods consume it,
+	// when set to "true".
+	SecretRotationApprovedAnnotation = "servicecatalog.k8s.io/approve-secret-rotation"
+
+	secretRotationBlockedReason string = "SecretRotationBlocked"
+)
+
+// validateSecretRotationPolicy returns an error if the given policy is not
+// known.
+func validateSecretRotationPolicy(policy SecretRotationPolicy) error {
+	switch policy {
+	case SecretRotationProceed, SecretRotationWaitForDrain, SecretRotationRequireAnnotation:
+		return nil
+	default:
+		return fmt.Errorf("invalid binding secret rotation policy %q: must be %q, %q or %q", policy, SecretRotationProceed, SecretRotationWaitForDrain, SecretRotationRequireAnnotation)
+	}
+}
+
+// secretRotationBlockedError is returned when the credentials of the secret
+// of a binding cannot be rotated yet because of the secret rotation policy.
+type secretRotationBlockedError struct {
+	message string
+}
+
+func (e *secretRotationBlockedError) Error() string {
+	return e.message
+}
+
+// isSecretRotationBlocked returns whether the given error is a
+// secretRotationBlockedError.
+func isSecretRotationBlocked(err error) bool {
+	_, ok := err.(*secretRotationBlockedError)
+	return ok
+}
+
+// checkSecretRotation returns a secretRotationBlockedError if the secret
+// rotation policy of the controller does not allow the credentials of the
+// named secret of the given binding to be replaced yet.
+func (c *controller) checkSecretRotation(binding *v1beta1.ServiceBinding, secretName string) error {
+	switch c.secretRotationPolicy {
+	case "", SecretRotationProceed:
+		return nil
+	case SecretRotationRequireAnnotation:
+		if binding.Annotations[SecretRotationApprovedAnnotation] == "true" {
+			return nil
+		}
+	}
+
+	pods, err := c.kubeClient.CoreV1().Pods(binding.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf(`Unexpected error listing the pods consuming Secret "%s/%s": %v`, binding.Namespace, secretName, err)
+	}
+	var consumers []string
+	for i := range pods.Items {
+		if podConsumesSecret(&pods.Items[i], secretName) {
+			consumers = append(consumers, pods.Items[i].Name)
+		}
+	}
+	if len(consumers) == 0 {
+		return nil
+	}
+	sort.Strings(consumers)
+
+	message := fmt.Sprintf(`The credentials of Secret "%s/%s" are not rotated while pods consume it: %s`, binding.Namespace, secretName, strings.Join(consumers, ", "))
+	if c.secretRotationPolicy == SecretRotationRequireAnnotation {
+		message = fmt.Sprintf(`%s; annotate the binding with %s=true to rotate them`, message, SecretRotationApprovedAnnotation)
+	}
+	return &secretRotationBlockedError{message: message}
+}
+
+// podConsumesSecret returns whether the given pod is running and mounts the
+// named secret or reads it into the environment of one of its containers.
+func podConsumesSecret(pod *corev1.Pod, secretName string) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Secret != nil && volume.Secret.SecretName == secretName {
+			return true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil && source.Secret.Name == secretName {
+					return true
+				}
+			}
+		}
+	}
+	containers := append(append([]corev1.Container(nil), pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil && envFrom.SecretRef.Name == secretName {
+				return true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == secretName {
+				return true
+			}
+		}
+	}
+	return false
+}
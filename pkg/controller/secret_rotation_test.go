@@ -0,0 +1,215 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+func newSecretVolumePod(name, secretName string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: "credentials",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestPodConsumesSecret(t *testing.T) {
+	envPod := corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					Env: []corev1.EnvVar{
+						{
+							Name: "PASSWORD",
+							ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "test-secret"},
+									Key:                  "password",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	envFromPod := corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{
+					Name: "migrate",
+					EnvFrom: []corev1.EnvFromSource{
+						{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "test-secret"}}},
+					},
+				},
+			},
+		},
+	}
+	projectedPod := corev1.Pod{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: "credentials",
+					VolumeSource: corev1.VolumeSource{
+						Projected: &corev1.ProjectedVolumeSource{
+							Sources: []corev1.VolumeProjection{
+								{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "test-secret"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	succeededPod := newSecretVolumePod("done", "test-secret")
+	succeededPod.Status.Phase = corev1.PodSucceeded
+
+	cases := []struct {
+		name     string
+		pod      corev1.Pod
+		consumes bool
+	}{
+		{name: "secret volume", pod: newSecretVolumePod("app", "test-secret"), consumes: true},
+		{name: "other secret volume", pod: newSecretVolumePod("app", "other-secret"), consumes: false},
+		{name: "projected volume", pod: projectedPod, consumes: true},
+		{name: "env", pod: envPod, consumes: true},
+		{name: "envFrom of init container", pod: envFromPod, consumes: true},
+		{name: "succeeded pod", pod: succeededPod, consumes: false},
+	}
+	for _, tc := range cases {
+		if e, a := tc.consumes, podConsumesSecret(&tc.pod, "test-secret"); e != a {
+			t.Errorf("%s: unexpected result: %v", tc.name, expectedGot(e, a))
+		}
+	}
+}
+
+// TestInjectServiceBindingSecretRotationPolicy tests that new credentials of
+// a binding only replace those of a secret consumed by pods when the secret
+// rotation policy allows it.
+func TestInjectServiceBindingSecretRotationPolicy(t *testing.T) {
+	secretData := map[string][]byte{"password": []byte("first")}
+
+	cases := []struct {
+		name         string
+		policy       SecretRotationPolicy
+		approved     bool
+		consumers    []corev1.Pod
+		credentials  map[string]interface{}
+		expectUpdate bool
+	}{
+		{
+			name:         "proceed",
+			policy:       SecretRotationProceed,
+			consumers:    []corev1.Pod{newSecretVolumePod("app", "test-secret")},
+			credentials:  map[string]interface{}{"password": "second"},
+			expectUpdate: true,
+		},
+		{
+			name:        "wait for drain with consumers",
+			policy:      SecretRotationWaitForDrain,
+			consumers:   []corev1.Pod{newSecretVolumePod("app", "test-secret")},
+			credentials: map[string]interface{}{"password": "second"},
+		},
+		{
+			name:         "wait for drain without consumers",
+			policy:       SecretRotationWaitForDrain,
+			consumers:    []corev1.Pod{newSecretVolumePod("app", "other-secret")},
+			credentials:  map[string]interface{}{"password": "second"},
+			expectUpdate: true,
+		},
+		{
+			name:         "wait for drain with the same credentials",
+			policy:       SecretRotationWaitForDrain,
+			consumers:    []corev1.Pod{newSecretVolumePod("app", "test-secret")},
+			credentials:  map[string]interface{}{"password": "first"},
+			expectUpdate: true,
+		},
+		{
+			name:        "require annotation without annotation",
+			policy:      SecretRotationRequireAnnotation,
+			consumers:   []corev1.Pod{newSecretVolumePod("app", "test-secret")},
+			credentials: map[string]interface{}{"password": "second"},
+		},
+		{
+			name:         "require annotation with annotation",
+			policy:       SecretRotationRequireAnnotation,
+			approved:     true,
+			consumers:    []corev1.Pod{newSecretVolumePod("app", "test-secret")},
+			credentials:  map[string]interface{}{"password": "second"},
+			expectUpdate: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+			testController.secretRotationPolicy = tc.policy
+
+			binding := getTestServiceBinding()
+			binding.Spec.SecretName = "test-secret"
+			if tc.approved {
+				binding.Annotations = map[string]string{SecretRotationApprovedAnnotation: "true"}
+			}
+			fakeKubeClient.AddReactor("get", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-secret",
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+					},
+					Data: secretData,
+				}, nil
+			})
+			fakeKubeClient.AddReactor("list", "pods", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.PodList{Items: tc.consumers}, nil
+			})
+
+			err := testController.injectServiceBinding(binding, tc.credentials)
+			if tc.expectUpdate && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.expectUpdate && !isSecretRotationBlocked(err) {
+				t.Fatalf("expected the rotation to be blocked, got %v", err)
+			}
+
+			updated := false
+			for _, action := range fakeKubeClient.Actions() {
+				if action.Matches("update", "secrets") {
+					updated = true
+				}
+			}
+			if e, a := tc.expectUpdate, updated; e != a {
+				t.Fatalf("unexpected secret update: %v", expectedGot(e, a))
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// sharedCatalogCache holds the catalogs recently fetched for namespaced
+// brokers, so that brokers that point at the same URL with the same
+// credentials share a single catalog fetch.
+type sharedCatalogCache struct {
+	lock    sync.Mutex
+	entries map[string]*sharedCatalogEntry
+}
+
+type sharedCatalogEntry struct {
+	// lastUsed is guarded by the lock of the cache.
+	lastUsed time.Time
+
+	// lock guards the catalog and is held while it is fetched, so that
+	// brokers that are reconciled at the same time wait for a single fetch.
+	lock                 sync.Mutex
+	catalog              *osb.CatalogResponse
+	instancesRetrievable map[string]bool
+	fetchedAt            time.Time
+}
+
+// sharedCatalogKey identifies the brokers that receive the same catalog. It
+// covers everything in the client configuration that can change the response
+// except the name of the broker. The credentials are only kept hashed.
+func sharedCatalogKey(clientConfig *brokerClientConfiguration) (string, error) {
+	b, err := json.Marshal(struct {
+		URL                 string
+		APIVersion          string
+		AuthConfig          *osb.AuthConfig
+		Insecure            bool
+		CAData              []byte
+		EnableAlphaFeatures bool
+		CustomHeaders       map[string]string
+	}{
+		URL:                 clientConfig.URL,
+		APIVersion:          clientConfig.APIVersion.HeaderValue(),
+		AuthConfig:          clientConfig.AuthConfig,
+		Insecure:            clientConfig.Insecure,
+		CAData:              clientConfig.CAData,
+		EnableAlphaFeatures: clientConfig.EnableAlphaFeatures,
+		CustomHeaders:       clientConfig.CustomHeaders,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// get returns the catalog stored under the given key, with the IDs of its
+// services whose instances are retrievable, if it was fetched less than ttl
+// ago and forceFetch is false. Otherwise it fetches the catalog and stores it
+// for the other brokers with the same key. Failed fetches are not stored.
+func (c *sharedCatalogCache) get(key string, ttl time.Duration, forceFetch bool, fetch func() (*osb.CatalogResponse, map[string]bool, error)) (*osb.CatalogResponse, map[string]bool, error) {
+	now := time.Now()
+
+	c.lock.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*sharedCatalogEntry)
+	}
+	// Drop the entries of brokers that are no longer relisted.
+	for k, e := range c.entries {
+		if now.Sub(e.lastUsed) > ttl {
+			delete(c.entries, k)
+		}
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &sharedCatalogEntry{}
+		c.entries[key] = entry
+	}
+	entry.lastUsed = now
+	c.lock.Unlock()
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if !forceFetch && entry.catalog != nil && time.Since(entry.fetchedAt) < ttl {
+		return entry.catalog, entry.instancesRetrievable, nil
+	}
+
+	catalog, instancesRetrievable, err := fetch()
+	if err != nil {
+		return nil, nil, err
+	}
+	entry.catalog = catalog
+	entry.instancesRetrievable = instancesRetrievable
+	entry.fetchedAt = time.Now()
+	return catalog, instancesRetrievable, nil
+}
+
+// fetchBrokerCatalog fetches the catalog of a broker with the given client,
+// along with the IDs of its services whose instances are retrievable, which
+// the OSB client library does not decode.
+func fetchBrokerCatalog(brokerClient osb.Client) (*osb.CatalogResponse, map[string]bool, error) {
+	catalog, err := brokerClient.GetCatalog()
+	if err != nil {
+		return nil, nil, err
+	}
+	return catalog, catalogInstancesRetrievable(brokerClient), nil
+}
+
+// getServiceBrokerCatalog fetches the catalog of the given namespaced broker,
+// sharing it with the other namespaced brokers that have the same client
+// configuration when the shared catalog TTL is set. A broker whose spec has
+// changed since it was last reconciled, or that has a pending relist request,
+// always fetches its catalog.
+func (c *controller) getServiceBrokerCatalog(broker *v1beta1.ServiceBroker, clientConfig *brokerClientConfiguration, brokerClient osb.Client) (*osb.CatalogResponse, map[string]bool, error) {
+	if c.sharedCatalogTTL <= 0 {
+		return fetchBrokerCatalog(brokerClient)
+	}
+	key, err := sharedCatalogKey(clientConfig)
+	if err != nil {
+		return fetchBrokerCatalog(brokerClient)
+	}
+	forceFetch := broker.Status.ReconciledGeneration != broker.Generation || broker.Status.RelistRequested(broker.Annotations)
+	return c.sharedCatalogs.get(key, c.sharedCatalogTTL, forceFetch, func() (*osb.CatalogResponse, map[string]bool, error) {
+		return fetchBrokerCatalog(brokerClient)
+	})
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// TestSharedCatalogCacheGet tests that a catalog is only fetched again once
+// it has expired, when the fetch is forced, or when the last fetch failed.
+func TestSharedCatalogCacheGet(t *testing.T) {
+	var cache sharedCatalogCache
+	fetches := 0
+	fetchErr := error(nil)
+	fetch := func() (*osb.CatalogResponse, map[string]bool, error) {
+		fetches++
+		if fetchErr != nil {
+			return nil, nil, fetchErr
+		}
+		return getTestCatalog(), nil, nil
+	}
+
+	cases := []struct {
+		name            string
+		key             string
+		ttl             time.Duration
+		forceFetch      bool
+		fetchErr        error
+		expectedFetches int
+	}{
+		{name: "first fetch", key: "a", ttl: time.Hour, expectedFetches: 1},
+		{name: "shared", key: "a", ttl: time.Hour, expectedFetches: 1},
+		{name: "other key", key: "b", ttl: time.Hour, expectedFetches: 2},
+		{name: "forced", key: "a", ttl: time.Hour, forceFetch: true, expectedFetches: 3},
+		{name: "expired", key: "a", ttl: time.Nanosecond, expectedFetches: 4},
+		{name: "failed", key: "c", ttl: time.Hour, fetchErr: errors.New("unreachable"), expectedFetches: 5},
+		{name: "after failure", key: "c", ttl: time.Hour, expectedFetches: 6},
+	}
+
+	for _, tc := range cases {
+		fetchErr = tc.fetchErr
+		catalog, _, err := cache.get(tc.key, tc.ttl, tc.forceFetch, fetch)
+		if tc.fetchErr != nil {
+			if err == nil {
+				t.Errorf("%v: expected error", tc.name)
+			}
+		} else if err != nil || catalog == nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+		}
+		if e, a := tc.expectedFetches, fetches; e != a {
+			t.Errorf("%v: unexpected number of fetches: %v", tc.name, expectedGot(e, a))
+		}
+	}
+}
+
+// TestGetServiceBrokerCatalogShared tests that namespaced brokers with the same
+// URL and credentials share a catalog fetch when the shared catalog TTL is set.
+func TestGetServiceBrokerCatalogShared(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.sharedCatalogTTL = time.Hour
+
+	brokerClient := fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Response: getTestCatalog(),
+		},
+	})
+
+	brokerA := getTestServiceBroker()
+	brokerA.Namespace = "tenant-a"
+	brokerB := getTestServiceBroker()
+	brokerB.Namespace = "tenant-b"
+	brokerOther := getTestServiceBroker()
+	brokerOther.Spec.URL = "https://other.example.com"
+
+	for _, broker := range []*v1beta1.ServiceBroker{brokerA, brokerB, brokerOther} {
+		clientConfig := &brokerClientConfiguration{
+			ClientConfiguration: NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, nil),
+		}
+		if _, _, err := testController.getServiceBrokerCatalog(broker, clientConfig, brokerClient); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// The brokers in tenant-a and tenant-b share a fetch.
+	assertNumberOfBrokerActions(t, brokerClient.Actions(), 2)
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/pkg/version"
+)
+
+const (
+	// storageMigrationConfigMapName is the name of the ConfigMap, in the
+	// namespace of the clusterid configmap, that records the version of
+	// service-catalog whose storage version the stored objects were last
+	// migrated to.
+	storageMigrationConfigMapName = "service-catalog-storage-migration"
+	// storageMigrationVersionKey is the key of the migrated version in the
+	// ConfigMap.
+	storageMigrationVersionKey = "version"
+	// storageMigrationRetryInterval is how often a failed migration is
+	// retried.
+	storageMigrationRetryInterval = time.Minute
+)
+
+// storageMigration rewrites the stored objects of a resource.
+type storageMigration struct {
+	resource string
+	list     func() (runtime.Object, error)
+	update   func(runtime.Object) error
+}
+
+func (c *controller) createStorageMigrationWorker(stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+	if !c.storageMigration {
+		return
+	}
+	waitGroup.Add(1)
+	go func() {
+		wait.PollImmediateUntil(storageMigrationRetryInterval, c.migrateStorage, stopCh)
+		waitGroup.Done()
+	}()
+}
+
+// migrateStorage rewrites every stored catalog object once after each
+// upgrade, so that objects serialized in an older storage version or encoding
+// are stored in the current one before a later release stops decoding it.
+// Writing an object back unchanged is enough, as the API server only skips
+// the write when the stored bytes would not change. It returns true once the
+// objects have been migrated for the running version.
+func (c *controller) migrateStorage() (bool, error) {
+	current := version.Get().GitVersion
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.clusterIDConfigMapNamespace).Get(storageMigrationConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		cm = nil
+	} else if err != nil {
+		glog.Warningf("Error getting storage migration configmap: %v", err)
+		return false, nil
+	}
+	if cm != nil && cm.Data[storageMigrationVersionKey] == current {
+		glog.V(4).Infof("Stored objects have already been migrated for version %v", current)
+		return true, nil
+	}
+
+	glog.Infof("Migrating stored objects to the storage version of %v", current)
+	var errs []error
+	for _, migration := range c.storageMigrations() {
+		n, err := migrateStoredObjects(migration)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		glog.V(4).Infof("Migrated %d stored %s", n, migration.resource)
+	}
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		glog.Warningf("Error migrating stored objects, retrying in %v: %v", storageMigrationRetryInterval, err)
+		return false, nil
+	}
+
+	if err := c.recordStorageMigration(cm, current); err != nil {
+		glog.Warningf("Error recording storage migration: %v", err)
+		return false, nil
+	}
+	glog.Infof("Migrated stored objects to the storage version of %v", current)
+	return true, nil
+}
+
+// migrateStoredObjects writes back every object of the resource of the given
+// migration, and returns how many it wrote. Objects that were modified or
+// deleted since they were listed have already been rewritten or are gone. A
+// resource that the API server does not serve has nothing to migrate.
+func migrateStoredObjects(migration storageMigration) (int, error) {
+	list, err := migration.list()
+	if errors.IsNotFound(err) {
+		glog.V(4).Infof("Not migrating %s, which the API server does not serve", migration.resource)
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	objs, err := meta.ExtractList(list)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	var errs []error
+	for _, obj := range objs {
+		err := migration.update(obj)
+		if errors.IsConflict(err) || errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		n++
+	}
+	return n, utilerrors.NewAggregate(errs)
+}
+
+// recordStorageMigration records in the given ConfigMap, which is nil if it
+// does not exist yet, that the stored objects were migrated for the given
+// version.
+func (c *controller) recordStorageMigration(cm *corev1.ConfigMap, version string) error {
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(c.clusterIDConfigMapNamespace)
+	if cm == nil {
+		_, err := configMaps.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: storageMigrationConfigMapName,
+			},
+			Data: map[string]string{storageMigrationVersionKey: version},
+		})
+		return err
+	}
+	toUpdate := cm.DeepCopy()
+	if toUpdate.Data == nil {
+		toUpdate.Data = make(map[string]string)
+	}
+	toUpdate.Data[storageMigrationVersionKey] = version
+	_, err := configMaps.Update(toUpdate)
+	return err
+}
+
+// storageMigrations returns the migrations of every catalog resource.
+func (c *controller) storageMigrations() []storageMigration {
+	client := c.serviceCatalogClient
+	migrations := []storageMigration{
+		{
+			resource: "clusterservicebrokers",
+			list:     func() (runtime.Object, error) { return client.ClusterServiceBrokers().List(metav1.ListOptions{}) },
+			update: func(obj runtime.Object) error {
+				_, err := client.ClusterServiceBrokers().Update(obj.(*v1beta1.ClusterServiceBroker))
+				return err
+			},
+		},
+		{
+			resource: "clusterserviceclasses",
+			list:     func() (runtime.Object, error) { return client.ClusterServiceClasses().List(metav1.ListOptions{}) },
+			update: func(obj runtime.Object) error {
+				_, err := client.ClusterServiceClasses().Update(obj.(*v1beta1.ClusterServiceClass))
+				return err
+			},
+		},
+		{
+			resource: "clusterserviceplans",
+			list:     func() (runtime.Object, error) { return client.ClusterServicePlans().List(metav1.ListOptions{}) },
+			update: func(obj runtime.Object) error {
+				_, err := client.ClusterServicePlans().Update(obj.(*v1beta1.ClusterServicePlan))
+				return err
+			},
+		},
+		{
+			resource: "serviceinstances",
+			list: func() (runtime.Object, error) {
+				return client.ServiceInstances(metav1.NamespaceAll).List(metav1.ListOptions{})
+			},
+			update: func(obj runtime.Object) error {
+				instance := obj.(*v1beta1.ServiceInstance)
+				_, err := client.ServiceInstances(instance.Namespace).Update(instance)
+				return err
+			},
+		},
+		{
+			resource: "servicebindings",
+			list: func() (runtime.Object, error) {
+				return client.ServiceBindings(metav1.NamespaceAll).List(metav1.ListOptions{})
+			},
+			update: func(obj runtime.Object) error {
+				binding := obj.(*v1beta1.ServiceBinding)
+				_, err := client.ServiceBindings(binding.Namespace).Update(binding)
+				return err
+			},
+		},
+	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
+		return migrations
+	}
+
+	return append(migrations,
+		storageMigration{
+			resource: "servicebrokers",
+			list: func() (runtime.Object, error) {
+				return client.ServiceBrokers(metav1.NamespaceAll).List(metav1.ListOptions{})
+			},
+			update: func(obj runtime.Object) error {
+				broker := obj.(*v1beta1.ServiceBroker)
+				_, err := client.ServiceBrokers(broker.Namespace).Update(broker)
+				return err
+			},
+		},
+		storageMigration{
+			resource: "serviceclasses",
+			list: func() (runtime.Object, error) {
+				return client.ServiceClasses(metav1.NamespaceAll).List(metav1.ListOptions{})
+			},
+			update: func(obj runtime.Object) error {
+				class := obj.(*v1beta1.ServiceClass)
+				_, err := client.ServiceClasses(class.Namespace).Update(class)
+				return err
+			},
+		},
+		storageMigration{
+			resource: "serviceplans",
+			list: func() (runtime.Object, error) {
+				return client.ServicePlans(metav1.NamespaceAll).List(metav1.ListOptions{})
+			},
+			update: func(obj runtime.Object) error {
+				plan := obj.(*v1beta1.ServicePlan)
+				_, err := client.ServicePlans(plan.Namespace).Update(plan)
+				return err
+			},
+		},
+		storageMigration{
+			resource: "brokertemplates",
+			list:     func() (runtime.Object, error) { return client.BrokerTemplates().List(metav1.ListOptions{}) },
+			update: func(obj runtime.Object) error {
+				_, err := client.BrokerTemplates().Update(obj.(*v1beta1.BrokerTemplate))
+				return err
+			},
+		},
+		storageMigration{
+			resource: "catalogprojections",
+			list:     func() (runtime.Object, error) { return client.CatalogProjections().List(metav1.ListOptions{}) },
+			update: func(obj runtime.Object) error {
+				_, err := client.CatalogProjections().Update(obj.(*v1beta1.CatalogProjection))
+				return err
+			},
+		},
+		storageMigration{
+			resource: "servicebrokersummaries",
+			list: func() (runtime.Object, error) {
+				return client.ServiceBrokerSummaries(metav1.NamespaceAll).List(metav1.ListOptions{})
+			},
+			update: func(obj runtime.Object) error {
+				summary := obj.(*v1beta1.ServiceBrokerSummary)
+				_, err := client.ServiceBrokerSummaries(summary.Namespace).Update(summary)
+				return err
+			},
+		},
+	)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/version"
+)
+
+// TestMigrateStorage tests that every stored object is written back when the
+// objects have not been migrated for the running version, and that the
+// migration is then recorded.
+func TestMigrateStorage(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+
+	fakeKubeClient.AddReactor("get", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewNotFound(corev1.Resource("configmaps"), storageMigrationConfigMapName)
+	})
+	fakeCatalogClient.AddReactor("list", "clusterservicebrokers", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServiceBrokerList{
+			Items: []v1beta1.ClusterServiceBroker{*getTestClusterServiceBroker()},
+		}, nil
+	})
+	fakeCatalogClient.AddReactor("list", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ServiceInstanceList{
+			Items: []v1beta1.ServiceInstance{*getTestServiceInstance()},
+		}, nil
+	})
+	fakeCatalogClient.AddReactor("update", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewConflict(servicecatalog.Resource("serviceinstances"), testServiceInstanceName, nil)
+	})
+
+	done, err := testController.migrateStorage()
+	if err != nil || !done {
+		t.Fatalf("expected migration to be done, got %v, %v", done, err)
+	}
+
+	var updated []string
+	for _, action := range fakeCatalogClient.Actions() {
+		if action.GetVerb() == "update" {
+			updated = append(updated, action.GetResource().Resource)
+		}
+	}
+	if e, a := 2, len(updated); e != a {
+		t.Fatalf("unexpected number of updates: %v", expectedGot(e, a))
+	}
+	if e, a := "clusterservicebrokers", updated[0]; e != a {
+		t.Fatalf("unexpected resource updated: %v", expectedGot(e, a))
+	}
+	if e, a := "serviceinstances", updated[1]; e != a {
+		t.Fatalf("unexpected resource updated: %v", expectedGot(e, a))
+	}
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 2)
+	assertActionEquals(t, kubeActions[1], "create", "configmaps")
+	cm := kubeActions[1].(clientgotesting.CreateAction).GetObject().(*corev1.ConfigMap)
+	if e, a := version.Get().GitVersion, cm.Data[storageMigrationVersionKey]; e != a {
+		t.Fatalf("unexpected migrated version: %v", expectedGot(e, a))
+	}
+}
+
+// TestMigrateStorageAlreadyMigrated tests that nothing is written when the
+// objects have already been migrated for the running version.
+func TestMigrateStorageAlreadyMigrated(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+
+	fakeKubeClient.AddReactor("get", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: storageMigrationConfigMapName},
+			Data:       map[string]string{storageMigrationVersionKey: version.Get().GitVersion},
+		}, nil
+	})
+
+	done, err := testController.migrateStorage()
+	if err != nil || !done {
+		t.Fatalf("expected migration to be done, got %v, %v", done, err)
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 1)
+}
+
+// TestMigrateStoredObjectsNotServed tests that a resource that the API server
+// does not serve has nothing to migrate.
+func TestMigrateStoredObjectsNotServed(t *testing.T) {
+	n, err := migrateStoredObjects(storageMigration{
+		resource: "catalogprojections",
+		list: func() (runtime.Object, error) {
+			return nil, errors.NewNotFound(servicecatalog.Resource("catalogprojections"), "")
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 0, n; e != a {
+		t.Fatalf("unexpected number of migrated objects: %v", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	stuckDeletingReason           string = "StuckDeleting"
+	stuckDeletingMessage          string = "Deletion has not completed %v after it was requested; blocked by %s: %s. If the resource no longer exists at the broker, set spec.deletionPolicy to Abandon to remove it without contacting the broker"
+	deletionNotProgressingReason  string = "DeletionNotProgressing"
+	deletionNotProgressingMessage string = "the controller has not reported any progress"
+)
+
+// isStuckDeleting returns whether an object with the given deletion timestamp
+// has been terminating for longer than the stuck deletion threshold, counted
+// from the end of the given grace period.
+func (c *controller) isStuckDeleting(deletionTimestamp *metav1.Time, gracePeriod time.Duration) bool {
+	if c.stuckDeletionThreshold <= 0 || deletionTimestamp == nil {
+		return false
+	}
+	return time.Since(deletionTimestamp.Time) > gracePeriod+c.stuckDeletionThreshold
+}
+
+// getServiceInstanceDeletionBlocker returns the reason and message describing
+// what is keeping the deletion of the given instance from completing. A failed
+// deprovision is reported through the Failed condition; anything else through
+// the Ready condition.
+func getServiceInstanceDeletionBlocker(instance *v1beta1.ServiceInstance) (string, string) {
+	var ready *v1beta1.ServiceInstanceCondition
+	for i, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionFailed && cond.Status == v1beta1.ConditionTrue {
+			return cond.Reason, cond.Message
+		}
+		if cond.Type == v1beta1.ServiceInstanceConditionReady {
+			ready = &instance.Status.Conditions[i]
+		}
+	}
+	if ready != nil && ready.Reason != "" {
+		return ready.Reason, ready.Message
+	}
+	return deletionNotProgressingReason, deletionNotProgressingMessage
+}
+
+// getServiceBindingDeletionBlocker returns the reason and message describing
+// what is keeping the deletion of the given binding from completing.
+func getServiceBindingDeletionBlocker(binding *v1beta1.ServiceBinding) (string, string) {
+	var ready *v1beta1.ServiceBindingCondition
+	for i, cond := range binding.Status.Conditions {
+		if cond.Type == v1beta1.ServiceBindingConditionFailed && cond.Status == v1beta1.ConditionTrue {
+			return cond.Reason, cond.Message
+		}
+		if cond.Type == v1beta1.ServiceBindingConditionReady {
+			ready = &binding.Status.Conditions[i]
+		}
+	}
+	if ready != nil && ready.Reason != "" {
+		return ready.Reason, ready.Message
+	}
+	return deletionNotProgressingReason, deletionNotProgressingMessage
+}
+
+// checkServiceInstanceStuckDeleting sets the StuckDeleting condition on an
+// instance that has been terminating for longer than the stuck deletion
+// threshold, naming what blocks the deletion. It returns whether the status
+// of the instance was updated, in which case the caller should stop
+// processing it; the update triggers another reconciliation.
+func (c *controller) checkServiceInstanceStuckDeleting(instance *v1beta1.ServiceInstance) (bool, error) {
+	if !c.isStuckDeleting(instance.DeletionTimestamp, c.deprovisionGracePeriod) {
+		return false, nil
+	}
+
+	reason, blocker := getServiceInstanceDeletionBlocker(instance)
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionStuckDeleting &&
+			cond.Status == v1beta1.ConditionTrue && cond.Reason == reason {
+			return false, nil
+		}
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	s := fmt.Sprintf(stuckDeletingMessage, c.deprovisionGracePeriod+c.stuckDeletionThreshold, reason, blocker)
+	glog.Warning(pcb.Message(s))
+
+	toUpdate := instance.DeepCopy()
+	setServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionStuckDeleting, v1beta1.ConditionTrue, reason, s)
+	if _, err := c.updateServiceInstanceStatus(toUpdate); err != nil {
+		return true, err
+	}
+	c.recorder.Event(instance, corev1.EventTypeWarning, stuckDeletingReason, s)
+	return true, nil
+}
+
+// checkServiceBindingStuckDeleting sets the StuckDeleting condition on a
+// binding that has been terminating for longer than the stuck deletion
+// threshold, naming what blocks the deletion. It returns whether the status
+// of the binding was updated, in which case the caller should stop processing
+// it; the update triggers another reconciliation.
+func (c *controller) checkServiceBindingStuckDeleting(binding *v1beta1.ServiceBinding) (bool, error) {
+	if !c.isStuckDeleting(binding.DeletionTimestamp, 0) {
+		return false, nil
+	}
+
+	reason, blocker := getServiceBindingDeletionBlocker(binding)
+	for _, cond := range binding.Status.Conditions {
+		if cond.Type == v1beta1.ServiceBindingConditionStuckDeleting &&
+			cond.Status == v1beta1.ConditionTrue && cond.Reason == reason {
+			return false, nil
+		}
+	}
+
+	pcb := pretty.NewBindingContextBuilder(binding)
+	s := fmt.Sprintf(stuckDeletingMessage, c.stuckDeletionThreshold, reason, blocker)
+	glog.Warning(pcb.Message(s))
+
+	toUpdate := binding.DeepCopy()
+	setServiceBindingCondition(toUpdate, v1beta1.ServiceBindingConditionStuckDeleting, v1beta1.ConditionTrue, reason, s)
+	if _, err := c.updateServiceBindingStatus(toUpdate); err != nil {
+		return true, err
+	}
+	c.recorder.Event(binding, corev1.EventTypeWarning, stuckDeletingReason, s)
+	return true, nil
+}
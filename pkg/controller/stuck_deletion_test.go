@@ -0,0 +1,213 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	testStuckDeletionThreshold = time.Hour
+	testDeletionBlockerReason  = "DeprovisionCallFailed"
+	testDeletionBlockerMessage = "broker unreachable"
+)
+
+func getTestServiceInstanceStuckDeleting(deletedAt time.Time) *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	deletionTimestamp := metav1.NewTime(deletedAt)
+	instance.DeletionTimestamp = &deletionTimestamp
+	instance.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusFailed
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, testDeletionBlockerReason, testDeletionBlockerMessage)
+	return instance
+}
+
+func TestReconcileServiceInstanceStuckDeleting(t *testing.T) {
+	flagged := getTestServiceInstanceStuckDeleting(time.Now().Add(-2 * testStuckDeletionThreshold))
+	setServiceInstanceCondition(flagged, v1beta1.ServiceInstanceConditionStuckDeleting, v1beta1.ConditionTrue, testDeletionBlockerReason, "")
+
+	cases := []struct {
+		name          string
+		instance      *v1beta1.ServiceInstance
+		threshold     time.Duration
+		expectFlagged bool
+	}{
+		{
+			name:          "stuck beyond threshold",
+			instance:      getTestServiceInstanceStuckDeleting(time.Now().Add(-2 * testStuckDeletionThreshold)),
+			threshold:     testStuckDeletionThreshold,
+			expectFlagged: true,
+		},
+		{
+			name:      "within threshold",
+			instance:  getTestServiceInstanceStuckDeleting(time.Now()),
+			threshold: testStuckDeletionThreshold,
+		},
+		{
+			name:     "detection disabled",
+			instance: getTestServiceInstanceStuckDeleting(time.Now().Add(-2 * testStuckDeletionThreshold)),
+		},
+		{
+			name:      "already flagged",
+			instance:  flagged,
+			threshold: testStuckDeletionThreshold,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+			testController.stuckDeletionThreshold = tc.threshold
+
+			if err := reconcileServiceInstance(t, testController, tc.instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+			actions := fakeCatalogClient.Actions()
+			events := getRecordedEvents(testController)
+			if !tc.expectFlagged {
+				assertNumberOfActions(t, actions, 0)
+				if len(events) != 0 {
+					t.Fatalf("expected no events; got %v", events)
+				}
+				return
+			}
+
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], tc.instance).(*v1beta1.ServiceInstance)
+			if !isServiceInstanceConditionTrue(updatedServiceInstance, v1beta1.ServiceInstanceConditionStuckDeleting) {
+				t.Fatalf("expected StuckDeleting condition to be true; got %+v", updatedServiceInstance.Status.Conditions)
+			}
+
+			expectedEvent := warningEventBuilder(stuckDeletingReason).msgf(stuckDeletingMessage, testStuckDeletionThreshold, testDeletionBlockerReason, testDeletionBlockerMessage)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReconcileServiceBindingStuckDeleting(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+	testController.stuckDeletionThreshold = testStuckDeletionThreshold
+
+	binding := getTestServiceBindingUnbinding()
+	deletionTimestamp := metav1.NewTime(time.Now().Add(-2 * testStuckDeletionThreshold))
+	binding.DeletionTimestamp = &deletionTimestamp
+	binding.Status.UnbindStatus = v1beta1.ServiceBindingUnbindStatusFailed
+	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionFailed, v1beta1.ConditionTrue, errorUnbindCallReason, testDeletionBlockerMessage)
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	var stuck *v1beta1.ServiceBindingCondition
+	for i, cond := range updatedServiceBinding.Status.Conditions {
+		if cond.Type == v1beta1.ServiceBindingConditionStuckDeleting {
+			stuck = &updatedServiceBinding.Status.Conditions[i]
+		}
+	}
+	if stuck == nil || stuck.Status != v1beta1.ConditionTrue || stuck.Reason != errorUnbindCallReason {
+		t.Fatalf("expected StuckDeleting condition with reason %q; got %+v", errorUnbindCallReason, updatedServiceBinding.Status.Conditions)
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := warningEventBuilder(stuckDeletingReason).msgf(stuckDeletingMessage, testStuckDeletionThreshold, errorUnbindCallReason, testDeletionBlockerMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestPollServiceInstanceStuckDeleting tests that an instance whose
+// asynchronous deprovision never completes is flagged from the polling loop,
+// without polling the broker on that attempt.
+func TestPollServiceInstanceStuckDeleting(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+	testController.stuckDeletionThreshold = testStuckDeletionThreshold
+
+	instance := getTestServiceInstanceAsyncDeprovisioningWithFinalizer(testOperation)
+	deletionTimestamp := metav1.NewTime(time.Now().Add(-2 * testStuckDeletionThreshold))
+	instance.DeletionTimestamp = &deletionTimestamp
+
+	if err := testController.pollServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if !isServiceInstanceConditionTrue(updatedServiceInstance, v1beta1.ServiceInstanceConditionStuckDeleting) {
+		t.Fatalf("expected StuckDeleting condition to be true; got %+v", updatedServiceInstance.Status.Conditions)
+	}
+
+	instanceKey := testNamespace + "/" + testServiceInstanceName
+	if testController.instancePollingQueue.NumRequeues(instanceKey) != 1 {
+		t.Fatalf("Expected polling queue to have record of seeing test instance once")
+	}
+}
+
+// TestPollServiceBindingStuckDeleting tests that a binding whose asynchronous
+// unbind never completes is flagged from the polling loop, without polling
+// the broker on that attempt.
+func TestPollServiceBindingStuckDeleting(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.stuckDeletionThreshold = testStuckDeletionThreshold
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithClusterRefs())
+
+	binding := getTestServiceBindingAsyncUnbinding(testOperation)
+	deletionTimestamp := metav1.NewTime(time.Now().Add(-2 * testStuckDeletionThreshold))
+	binding.DeletionTimestamp = &deletionTimestamp
+
+	if err := testController.pollServiceBinding(binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	var stuck *v1beta1.ServiceBindingCondition
+	for i, cond := range updatedServiceBinding.Status.Conditions {
+		if cond.Type == v1beta1.ServiceBindingConditionStuckDeleting {
+			stuck = &updatedServiceBinding.Status.Conditions[i]
+		}
+	}
+	if stuck == nil || stuck.Status != v1beta1.ConditionTrue {
+		t.Fatalf("expected StuckDeleting condition to be true; got %+v", updatedServiceBinding.Status.Conditions)
+	}
+
+	bindingKey := testNamespace + "/" + testServiceBindingName
+	if testController.bindingPollingQueue.NumRequeues(bindingKey) != 1 {
+		t.Fatalf("Expected polling queue to have record of seeing test binding once")
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	// tombstoneLabel is set on the ConfigMaps that record deprovisioned
+	// instances. Its value is the kind of the deleted resource.
+	tombstoneLabel = "servicecatalog.k8s.io/tombstone"
+	// tombstoneExpiresAnnotation holds the RFC 3339 time after which a
+	// tombstone, or the record of a cancelled deletion, is deleted.
+	tombstoneExpiresAnnotation = "servicecatalog.k8s.io/tombstone-expires"
+	// tombstoneNamePrefix is prepended to the UID of a deprovisioned
+	// instance to name its tombstone.
+	tombstoneNamePrefix = "servicecatalog-tombstone-"
+	// tombstonePurgeInterval is how often expired tombstones are deleted.
+	tombstonePurgeInterval = 10 * time.Minute
+)
+
+// newServiceInstanceTombstone returns a ConfigMap in the given namespace
+// recording what the given instance was, who asked for its deletion and when
+// it was deprovisioned. It must be called before the external properties of
+// the instance are cleared.
+func newServiceInstanceTombstone(instance *v1beta1.ServiceInstance, namespace string, deprovisionedAt, expires time.Time) *corev1.ConfigMap {
+	data := map[string]string{
+		"instanceNamespace": instance.Namespace,
+		"instanceName":      instance.Name,
+		"instanceUID":       string(instance.UID),
+		"externalID":        instance.Spec.ExternalID,
+		"createdAt":         instance.CreationTimestamp.UTC().Format(time.RFC3339),
+		"deprovisionedAt":   deprovisionedAt.UTC().Format(time.RFC3339),
+	}
+
+	if instance.Spec.ClusterServiceClassSpecified() {
+		data["serviceClassExternalName"] = instance.Spec.ClusterServiceClassExternalName
+		data["servicePlanExternalName"] = instance.Spec.ClusterServicePlanExternalName
+	} else {
+		data["serviceClassExternalName"] = instance.Spec.ServiceClassExternalName
+		data["servicePlanExternalName"] = instance.Spec.ServicePlanExternalName
+	}
+	if instance.Spec.ClusterServiceClassRef != nil {
+		data["clusterServiceClassName"] = instance.Spec.ClusterServiceClassRef.Name
+	}
+	if instance.Spec.ClusterServicePlanRef != nil {
+		data["clusterServicePlanName"] = instance.Spec.ClusterServicePlanRef.Name
+	}
+	if instance.Spec.ServiceClassRef != nil {
+		data["serviceClassName"] = instance.Spec.ServiceClassRef.Name
+	}
+	if instance.Spec.ServicePlanRef != nil {
+		data["servicePlanName"] = instance.Spec.ServicePlanRef.Name
+	}
+	// The plan the instance was last provisioned or updated with takes
+	// precedence over the one in the spec, which may never have been applied.
+	if props := instance.Status.ExternalProperties; props != nil {
+		if props.ClusterServicePlanExternalName != "" {
+			data["servicePlanExternalName"] = props.ClusterServicePlanExternalName
+		}
+		if props.ServicePlanExternalName != "" {
+			data["servicePlanExternalName"] = props.ServicePlanExternalName
+		}
+	}
+
+	if instance.Status.ReadyAt != nil {
+		data["provisionedAt"] = instance.Status.ReadyAt.UTC().Format(time.RFC3339)
+	}
+	if instance.DeletionTimestamp != nil {
+		data["deletionRequestedAt"] = instance.DeletionTimestamp.UTC().Format(time.RFC3339)
+	}
+	// The user info of an instance that is being deleted is that of the user
+	// that deleted it when the OriginatingIdentity feature is enabled.
+	if instance.Spec.UserInfo != nil {
+		data["requester"] = instance.Spec.UserInfo.Username
+	}
+	for k, v := range data {
+		if v == "" {
+			delete(data, k)
+		}
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tombstoneNamePrefix + string(instance.UID),
+			Namespace: namespace,
+			Labels: map[string]string{
+				tombstoneLabel: "ServiceInstance",
+			},
+			Annotations: map[string]string{
+				tombstoneExpiresAnnotation: expires.UTC().Format(time.RFC3339),
+			},
+		},
+		Data: data,
+	}
+}
+
+// recordServiceInstanceTombstone creates the tombstone of the given instance,
+// which has just been deprovisioned, if tombstones are enabled. Tombstones
+// are kept in the namespace of the controller, so that it only needs access
+// to the ConfigMaps of that namespace and users cannot tamper with them.
+// Failing to record a tombstone does not block the deletion of the instance.
+func (c *controller) recordServiceInstanceTombstone(instance *v1beta1.ServiceInstance) {
+	if c.instanceTombstoneTTL <= 0 {
+		return
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	now := time.Now()
+	tombstone := newServiceInstanceTombstone(instance, c.clusterIDConfigMapNamespace, now, now.Add(c.instanceTombstoneTTL))
+	_, err := c.kubeClient.CoreV1().ConfigMaps(tombstone.Namespace).Create(tombstone)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		glog.Warning(pcb.Messagef("Error creating tombstone %s/%s: %v", tombstone.Namespace, tombstone.Name, err))
+		return
+	}
+	glog.V(4).Info(pcb.Messagef("Recorded tombstone %s/%s", tombstone.Namespace, tombstone.Name))
+}
+
+func (c *controller) createPurgeExpiredTombstonesWorker(stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+	if c.instanceTombstoneTTL <= 0 && c.deprovisionGracePeriod <= 0 {
+		return
+	}
+	waitGroup.Add(1)
+	go func() {
+		wait.Until(c.purgeExpiredTombstones, tombstonePurgeInterval, stopCh)
+		waitGroup.Done()
+	}()
+}
+
+// purgeExpiredTombstones deletes the tombstones and the records of cancelled
+// deletions that have outlived their TTL.
+func (c *controller) purgeExpiredTombstones() {
+	if c.instanceTombstoneTTL > 0 {
+		c.purgeExpiredConfigMaps(tombstoneLabel)
+	}
+	if c.deprovisionGracePeriod > 0 {
+		c.purgeExpiredConfigMaps(cancelledDeletionLabel)
+	}
+}
+
+// purgeExpiredConfigMaps deletes the ConfigMaps with the given label in the
+// namespace of the controller whose expiry has passed.
+func (c *controller) purgeExpiredConfigMaps(label string) {
+	configMaps, err := c.kubeClient.CoreV1().ConfigMaps(c.clusterIDConfigMapNamespace).List(metav1.ListOptions{
+		LabelSelector: label,
+	})
+	if err != nil {
+		glog.Warningf("Error listing ConfigMaps labeled %s: %v", label, err)
+		return
+	}
+
+	now := time.Now()
+	for _, configMap := range configMaps.Items {
+		expires, err := time.Parse(time.RFC3339, configMap.Annotations[tombstoneExpiresAnnotation])
+		if err != nil {
+			glog.Warningf("Ignoring ConfigMap %s/%s with invalid expiry: %v", configMap.Namespace, configMap.Name, err)
+			continue
+		}
+		if now.Before(expires) {
+			continue
+		}
+		glog.V(4).Infof("Deleting expired ConfigMap %s/%s", configMap.Namespace, configMap.Name)
+		err = c.kubeClient.CoreV1().ConfigMaps(configMap.Namespace).Delete(configMap.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			glog.Warningf("Error deleting ConfigMap %s/%s: %v", configMap.Namespace, configMap.Name, err)
+		}
+	}
+}
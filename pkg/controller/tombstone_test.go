@@ -0,0 +1,154 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const testTombstoneInstanceUID = "instance-uid"
+
+func getTestTombstone(name string, expires time.Time) corev1.ConfigMap {
+	return corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   DefaultClusterIDConfigMapNamespace,
+			Labels:      map[string]string{tombstoneLabel: "ServiceInstance"},
+			Annotations: map[string]string{tombstoneExpiresAnnotation: expires.UTC().Format(time.RFC3339)},
+		},
+	}
+}
+
+// TestReconcileServiceInstanceDeleteRecordsTombstone tests that a tombstone
+// is created when an instance is deprovisioned and tombstones are enabled.
+func TestReconcileServiceInstanceDeleteRecordsTombstone(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		DeprovisionReaction: &fakeosb.DeprovisionReaction{
+			Response: &osb.DeprovisionResponse{},
+		},
+	})
+	testController.instanceTombstoneTTL = time.Hour
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	deletionTimestamp := metav1.NewTime(time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC))
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.ObjectMeta.UID = testTombstoneInstanceUID
+	instance.ObjectMeta.DeletionTimestamp = &deletionTimestamp
+	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Spec.UserInfo = &v1beta1.UserInfo{Username: "deleter"}
+	instance.Generation = 2
+	instance.Status.ReconciledGeneration = 1
+	instance.Status.ObservedGeneration = 1
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+	}
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+
+	fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, instance, nil
+	})
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	instance = assertServiceInstanceDeprovisionInProgressIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance)
+	fakeCatalogClient.ClearActions()
+	fakeKubeClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 1)
+	createAction, ok := kubeActions[0].(clientgotesting.CreateAction)
+	if !ok || createAction.GetResource().Resource != "configmaps" {
+		t.Fatalf("unexpected action: %+v", kubeActions[0])
+	}
+	tombstone := createAction.GetObject().(*corev1.ConfigMap)
+
+	if e, a := tombstoneNamePrefix+testTombstoneInstanceUID, tombstone.Name; e != a {
+		t.Fatalf("unexpected tombstone name: %v", expectedGot(e, a))
+	}
+	if e, a := DefaultClusterIDConfigMapNamespace, tombstone.Namespace; e != a {
+		t.Fatalf("unexpected tombstone namespace: %v", expectedGot(e, a))
+	}
+	if _, ok := tombstone.Annotations[tombstoneExpiresAnnotation]; !ok {
+		t.Fatalf("expected tombstone to have an expiry")
+	}
+
+	expectedData := map[string]string{
+		"instanceNamespace":       testNamespace,
+		"instanceName":            testServiceInstanceName,
+		"externalID":              testServiceInstanceGUID,
+		"servicePlanExternalName": testClusterServicePlanName,
+		"deletionRequestedAt":     "2018-03-01T12:00:00Z",
+		"requester":               "deleter",
+	}
+	for k, e := range expectedData {
+		if a := tombstone.Data[k]; e != a {
+			t.Errorf("unexpected tombstone %v: %v", k, expectedGot(e, a))
+		}
+	}
+	if _, ok := tombstone.Data["deprovisionedAt"]; !ok {
+		t.Errorf("expected tombstone to record when the instance was deprovisioned")
+	}
+}
+
+// TestPurgeExpiredTombstones tests that only expired tombstones are deleted.
+func TestPurgeExpiredTombstones(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.instanceTombstoneTTL = time.Hour
+
+	now := time.Now()
+	fakeKubeClient.AddReactor("list", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.ConfigMapList{
+			Items: []corev1.ConfigMap{
+				getTestTombstone("expired", now.Add(-time.Minute)),
+				getTestTombstone("live", now.Add(time.Hour)),
+			},
+		}, nil
+	})
+
+	testController.purgeExpiredTombstones()
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 2)
+	assertActionEquals(t, kubeActions[0], "list", "configmaps")
+	if e, a := DefaultClusterIDConfigMapNamespace, kubeActions[0].GetNamespace(); e != a {
+		t.Fatalf("unexpected namespace listed: %v", expectedGot(e, a))
+	}
+	assertActionEquals(t, kubeActions[1], "delete", "configmaps")
+	if e, a := "expired", kubeActions[1].(clientgotesting.DeleteAction).GetName(); e != a {
+		t.Fatalf("unexpected tombstone deleted: %v", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdmigration
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	apiServicesPath = "/apis/apiregistration.k8s.io/v1beta1/apiservices"
+	crdsPath        = "/apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions"
+
+	// category is the category of the resources, as published by the
+	// aggregated API server.
+	category = "servicecatalog"
+)
+
+// apiServiceName is the name of the APIService through which the aggregated
+// API server serves the servicecatalog API group.
+var apiServiceName = v1beta1.SchemeGroupVersion.Version + "." + v1beta1.SchemeGroupVersion.Group
+
+// CutOver moves the servicecatalog API group between the aggregated API server
+// and CustomResourceDefinitions.
+type CutOver interface {
+	// GetAPIService returns the APIService of the aggregated API server.
+	GetAPIService() ([]byte, error)
+	// DeleteAPIService deletes the APIService of the aggregated API server.
+	DeleteAPIService() error
+	// CreateAPIService creates the given APIService, previously returned by
+	// GetAPIService.
+	CreateAPIService(apiService []byte) error
+	// CreateCRDs creates the CustomResourceDefinitions of the resources of
+	// the group, and waits until they are established.
+	CreateCRDs() error
+	// DeleteCRDs deletes the CustomResourceDefinitions of the resources of
+	// the group, along with all their custom resources.
+	DeleteCRDs() error
+}
+
+// restCutOver is the CutOver sending its requests to the Kubernetes API server
+// with a REST client. The clients of the apiregistration and apiextensions
+// groups are not vendored, so the objects are sent as JSON.
+type restCutOver struct {
+	client       rest.Interface
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+// NewRESTCutOver returns a CutOver sending its requests with the given REST
+// client, which must not be bound to an API group.
+func NewRESTCutOver(client rest.Interface, pollInterval, timeout time.Duration) CutOver {
+	return &restCutOver{
+		client:       client,
+		pollInterval: pollInterval,
+		timeout:      timeout,
+	}
+}
+
+func (c *restCutOver) GetAPIService() ([]byte, error) {
+	return c.client.Get().AbsPath(apiServicesPath, apiServiceName).Do().Raw()
+}
+
+func (c *restCutOver) DeleteAPIService() error {
+	err := c.client.Delete().AbsPath(apiServicesPath, apiServiceName).Do().Error()
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *restCutOver) CreateAPIService(apiService []byte) error {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(apiService, &obj); err != nil {
+		return err
+	}
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"uid", "resourceVersion", "creationTimestamp", "selfLink"} {
+			delete(metadata, field)
+		}
+	}
+	delete(obj, "status")
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	err = c.client.Post().AbsPath(apiServicesPath).Body(body).Do().Error()
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *restCutOver) CreateCRDs() error {
+	for _, r := range resources {
+		body, err := json.Marshal(newCRD(r))
+		if err != nil {
+			return err
+		}
+		glog.V(4).Infof("Creating the CustomResourceDefinition of %v", r.plural)
+		err = c.client.Post().AbsPath(crdsPath).Body(body).Do().Error()
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("error creating the CustomResourceDefinition of %v: %v", r.plural, err)
+		}
+	}
+	for _, r := range resources {
+		if err := wait.PollImmediate(c.pollInterval, c.timeout, func() (bool, error) {
+			return c.isCRDEstablished(crdName(r))
+		}); err != nil {
+			return fmt.Errorf("error waiting for the CustomResourceDefinition of %v to be established: %v", r.plural, err)
+		}
+	}
+	return nil
+}
+
+// isCRDEstablished returns whether the CustomResourceDefinition of the given
+// name has its Established condition set to true.
+func (c *restCutOver) isCRDEstablished(name string) (bool, error) {
+	raw, err := c.client.Get().AbsPath(crdsPath, name).Do().Raw()
+	if err != nil {
+		return false, err
+	}
+	var crd struct {
+		Status struct {
+			Conditions []struct {
+				Type   string `json:"type"`
+				Status string `json:"status"`
+			} `json:"conditions"`
+		} `json:"status"`
+	}
+	if err := json.Unmarshal(raw, &crd); err != nil {
+		return false, err
+	}
+	for _, condition := range crd.Status.Conditions {
+		if condition.Type == "Established" {
+			return condition.Status == "True", nil
+		}
+	}
+	return false, nil
+}
+
+func (c *restCutOver) DeleteCRDs() error {
+	for _, r := range resources {
+		err := c.client.Delete().AbsPath(crdsPath, crdName(r)).Do().Error()
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("error deleting the CustomResourceDefinition of %v: %v", r.plural, err)
+		}
+	}
+	return nil
+}
+
+// crdName returns the name of the CustomResourceDefinition of the given
+// resource.
+func crdName(r resource) string {
+	return r.plural + "." + v1beta1.SchemeGroupVersion.Group
+}
+
+// newCRD returns the CustomResourceDefinition of the given resource. It serves
+// the resource under the same names as the aggregated API server, and the
+// status subresource of the resources that have one.
+func newCRD(r resource) map[string]interface{} {
+	scope := "Cluster"
+	if r.namespaced {
+		scope = "Namespaced"
+	}
+	columns := []interface{}{}
+	for _, column := range r.columns {
+		columns = append(columns, map[string]interface{}{
+			"name":     column.name,
+			"type":     "string",
+			"JSONPath": column.jsonPath,
+		})
+	}
+	columns = append(columns, map[string]interface{}{
+		"name":     "Age",
+		"type":     "date",
+		"JSONPath": ".metadata.creationTimestamp",
+	})
+	spec := map[string]interface{}{
+		"group":   v1beta1.SchemeGroupVersion.Group,
+		"version": v1beta1.SchemeGroupVersion.Version,
+		"scope":   scope,
+		"names": map[string]interface{}{
+			"plural":     r.plural,
+			"singular":   r.singular,
+			"kind":       r.kind,
+			"listKind":   r.kind + "List",
+			"shortNames": r.shortNames,
+			"categories": []string{category},
+		},
+		"additionalPrinterColumns": columns,
+	}
+	if r.updateStatus != nil {
+		spec["subresources"] = map[string]interface{}{
+			"status": map[string]interface{}{},
+		}
+	}
+	return map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1beta1",
+		"kind":       "CustomResourceDefinition",
+		"metadata": map[string]interface{}{
+			"name": crdName(r),
+		},
+		"spec": spec,
+	}
+}
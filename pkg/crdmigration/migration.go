@@ -0,0 +1,661 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crdmigration moves the objects of the servicecatalog API group from
+// the aggregated API server to CustomResourceDefinitions, without sending
+// anything to the brokers.
+package crdmigration
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	servicecatalogclientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
+)
+
+// MigratedFromUIDAnnotation is the annotation holding the UID an object had in
+// the aggregated API server. Objects created through the API always get a new
+// UID, so the owner references to migrated objects are rewritten to the new
+// UIDs.
+const MigratedFromUIDAnnotation = "servicecatalog.k8s.io/migrated-from-uid"
+
+// generationFields are the fields of the status of the objects holding a
+// generation of the object.
+var generationFields = []string{"reconciledGeneration", "observedGeneration"}
+
+// stage is how far the migration went in moving the API group.
+type stage int
+
+const (
+	// stageUnlinked is when the secrets are unlinked from the bindings.
+	stageUnlinked stage = iota
+	// stageCRDsCreated is when the CustomResourceDefinitions are created.
+	stageCRDsCreated
+	// stageSwitched is when the APIService of the aggregated API server is
+	// deleted, and the group is served from the CustomResourceDefinitions.
+	stageSwitched
+)
+
+// secretOwner is the owner reference of a secret to a ServiceBinding, which
+// is removed while the binding is migrated.
+type secretOwner struct {
+	Namespace      string                `json:"namespace"`
+	Name           string                `json:"name"`
+	OwnerReference metav1.OwnerReference `json:"ownerReference"`
+}
+
+// Export is everything the migration reads before moving the API group. It is
+// saved to a file, from which an interrupted migration can be finished or
+// reverted by hand.
+type Export struct {
+	// Objects maps the plural name of each resource to its objects.
+	Objects map[string][]map[string]interface{} `json:"objects"`
+	// APIService is the APIService of the aggregated API server.
+	APIService json.RawMessage `json:"apiService"`
+	// SecretOwners are the owner references of secrets to ServiceBindings.
+	SecretOwners []secretOwner `json:"secretOwners,omitempty"`
+	// ControllerManagerReplicas is the number of replicas the deployment of
+	// the controller manager had before it was paused.
+	ControllerManagerReplicas int32 `json:"controllerManagerReplicas"`
+}
+
+// Migrator moves the objects of the servicecatalog API group from the
+// aggregated API server to CustomResourceDefinitions. The migration:
+//
+//  1. pauses the controller manager, by scaling its deployment to zero,
+//  2. exports every object of the group to a file,
+//  3. unlinks the secrets of the bindings from them, so that they are not
+//     garbage collected while the bindings are replaced,
+//  4. creates the CustomResourceDefinitions and deletes the APIService of the
+//     aggregated API server,
+//  5. copies the objects, with their status and finalizers,
+//  6. verifies that the copies match the exported objects,
+//  7. links the secrets to the new bindings, and resumes the controller
+//     manager.
+//
+// If any step after the switch fails, the custom resources are deleted, the
+// APIService is recreated and the secrets are linked to the bindings again,
+// which leaves the installation as it was. The aggregated API server and its
+// etcd are never written to.
+type Migrator struct {
+	kubeClient    kubernetes.Interface
+	catalogClient servicecatalogclientset.Interface
+	cutOver       CutOver
+
+	// exportPath is the path of the file the export is saved to.
+	exportPath string
+	// controllerManagerNamespace and controllerManagerName identify the
+	// deployment of the controller manager.
+	controllerManagerNamespace string
+	controllerManagerName      string
+	pollInterval               time.Duration
+	timeout                    time.Duration
+}
+
+// NewMigrator returns a Migrator sending its requests to the Kubernetes API
+// server with the given clients.
+func NewMigrator(
+	kubeClient kubernetes.Interface,
+	catalogClient servicecatalogclientset.Interface,
+	cutOver CutOver,
+	exportPath string,
+	controllerManagerNamespace string,
+	controllerManagerName string,
+	pollInterval time.Duration,
+	timeout time.Duration,
+) *Migrator {
+	return &Migrator{
+		kubeClient:                 kubeClient,
+		catalogClient:              catalogClient,
+		cutOver:                    cutOver,
+		exportPath:                 exportPath,
+		controllerManagerNamespace: controllerManagerNamespace,
+		controllerManagerName:      controllerManagerName,
+		pollInterval:               pollInterval,
+		timeout:                    timeout,
+	}
+}
+
+// Run migrates the objects of the servicecatalog API group to
+// CustomResourceDefinitions.
+func (m *Migrator) Run() error {
+	replicas, err := m.pauseControllerManager()
+	if err != nil {
+		return err
+	}
+
+	export, err := m.export()
+	if err == nil {
+		export.ControllerManagerReplicas = replicas
+		err = m.saveExport(export)
+	}
+	if err != nil {
+		return m.resumeAfter(err, replicas)
+	}
+
+	if err := m.unlinkSecrets(export); err != nil {
+		return m.resumeAfter(m.rollbackAfter(err, export, stageUnlinked), replicas)
+	}
+
+	glog.Info("Switching the servicecatalog API group to CustomResourceDefinitions")
+	if err := m.cutOver.CreateCRDs(); err != nil {
+		return m.resumeAfter(m.rollbackAfter(err, export, stageCRDsCreated), replicas)
+	}
+	if err := m.cutOver.DeleteAPIService(); err != nil {
+		return m.resumeAfter(m.rollbackAfter(err, export, stageCRDsCreated), replicas)
+	}
+	if err := m.waitForCRDs(); err != nil {
+		return m.resumeAfter(m.rollbackAfter(err, export, stageSwitched), replicas)
+	}
+
+	uids, err := m.copyObjects(export)
+	if err == nil {
+		err = m.verify(export, uids)
+	}
+	if err == nil {
+		err = m.linkSecrets(export.SecretOwners, uids)
+	}
+	if err != nil {
+		return m.resumeAfter(m.rollbackAfter(err, export, stageSwitched), replicas)
+	}
+
+	glog.Info("Migrated the servicecatalog API group to CustomResourceDefinitions")
+	return m.resumeControllerManager(replicas)
+}
+
+// pauseControllerManager scales the deployment of the controller manager to
+// zero, and waits until none of its pods are left, so that nothing writes to
+// the objects of the group or calls the brokers. It returns the number of
+// replicas the deployment had.
+func (m *Migrator) pauseControllerManager() (int32, error) {
+	deployments := m.kubeClient.AppsV1().Deployments(m.controllerManagerNamespace)
+	deployment, err := deployments.Get(m.controllerManagerName, metav1.GetOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("error getting the deployment of the controller manager: %v", err)
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	glog.Infof("Pausing the controller manager %s/%s", m.controllerManagerNamespace, m.controllerManagerName)
+	if err := m.scaleControllerManager(0); err != nil {
+		return 0, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return 0, m.resumeAfter(err, replicas)
+	}
+	err = wait.PollImmediate(m.pollInterval, m.timeout, func() (bool, error) {
+		pods, err := m.kubeClient.CoreV1().Pods(m.controllerManagerNamespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return false, err
+		}
+		return len(pods.Items) == 0, nil
+	})
+	if err != nil {
+		return 0, m.resumeAfter(fmt.Errorf("error waiting for the pods of the controller manager to terminate: %v", err), replicas)
+	}
+	return replicas, nil
+}
+
+// resumeControllerManager scales the deployment of the controller manager
+// back to the given number of replicas.
+func (m *Migrator) resumeControllerManager(replicas int32) error {
+	glog.Infof("Resuming the controller manager %s/%s", m.controllerManagerNamespace, m.controllerManagerName)
+	return m.scaleControllerManager(replicas)
+}
+
+// resumeAfter resumes the controller manager after the given error, and
+// returns the error.
+func (m *Migrator) resumeAfter(err error, replicas int32) error {
+	if resumeErr := m.resumeControllerManager(replicas); resumeErr != nil {
+		return utilerrors.NewAggregate([]error{err, resumeErr})
+	}
+	return err
+}
+
+func (m *Migrator) scaleControllerManager(replicas int32) error {
+	deployments := m.kubeClient.AppsV1().Deployments(m.controllerManagerNamespace)
+	deployment, err := deployments.Get(m.controllerManagerName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting the deployment of the controller manager: %v", err)
+	}
+	deployment = deployment.DeepCopy()
+	deployment.Spec.Replicas = &replicas
+	if _, err := deployments.Update(deployment); err != nil {
+		return fmt.Errorf("error scaling the deployment of the controller manager to %d: %v", replicas, err)
+	}
+	return nil
+}
+
+// export reads every object of the group, the APIService of the aggregated
+// API server, and the owner references of secrets to bindings.
+func (m *Migrator) export() (*Export, error) {
+	apiService, err := m.cutOver.GetAPIService()
+	if err != nil {
+		return nil, fmt.Errorf("error getting the APIService %q: %v", apiServiceName, err)
+	}
+	export := &Export{
+		Objects:    map[string][]map[string]interface{}{},
+		APIService: apiService,
+	}
+
+	client := m.catalogClient.ServicecatalogV1beta1()
+	var errs []error
+	for _, r := range resources {
+		objs, err := r.list(client)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %v: %v", r.plural, err)
+		}
+		for _, obj := range objs {
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				return nil, err
+			}
+			// An object being deleted cannot be created again with its
+			// deletion timestamp, so its deletion has to finish first.
+			if accessor.GetDeletionTimestamp() != nil {
+				errs = append(errs, fmt.Errorf("%v %q is being deleted", r.plural, objectKey(accessor.GetNamespace(), accessor.GetName())))
+				continue
+			}
+			fields, err := toFields(obj)
+			if err != nil {
+				return nil, err
+			}
+			export.Objects[r.plural] = append(export.Objects[r.plural], fields)
+		}
+		glog.Infof("Exported %d %v", len(export.Objects[r.plural]), r.plural)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("objects are being deleted, run the migration again once they are gone: %v", utilerrors.NewAggregate(errs))
+	}
+
+	bindingUIDs := map[types.UID]bool{}
+	namespaces := map[string]bool{}
+	for _, binding := range export.Objects["servicebindings"] {
+		metadata := binding["metadata"].(map[string]interface{})
+		bindingUIDs[types.UID(metadata["uid"].(string))] = true
+		namespaces[metadata["namespace"].(string)] = true
+	}
+	for namespace := range namespaces {
+		secrets, err := m.kubeClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error listing the secrets of namespace %q: %v", namespace, err)
+		}
+		for _, secret := range secrets.Items {
+			for _, ref := range secret.OwnerReferences {
+				if bindingUIDs[ref.UID] {
+					export.SecretOwners = append(export.SecretOwners, secretOwner{
+						Namespace:      secret.Namespace,
+						Name:           secret.Name,
+						OwnerReference: ref,
+					})
+				}
+			}
+		}
+	}
+	return export, nil
+}
+
+func (m *Migrator) saveExport(export *Export) error {
+	data, err := json.Marshal(export)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(m.exportPath, data, 0600); err != nil {
+		return fmt.Errorf("error saving the export to %q: %v", m.exportPath, err)
+	}
+	glog.Infof("Saved the export to %q", m.exportPath)
+	return nil
+}
+
+// unlinkSecrets removes the owner references of secrets to bindings. The new
+// bindings get new UIDs, so the garbage collector would otherwise delete the
+// secrets, and with them the credentials of the workloads, once the old
+// bindings are gone.
+func (m *Migrator) unlinkSecrets(export *Export) error {
+	for _, owner := range export.SecretOwners {
+		if err := m.updateSecretOwnerReference(owner, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkSecrets adds back the given owner references of secrets to bindings,
+// with the UIDs of the given map of old to new UIDs if they are in it.
+func (m *Migrator) linkSecrets(owners []secretOwner, uids map[types.UID]types.UID) error {
+	for _, owner := range owners {
+		ref := owner.OwnerReference
+		if uid, ok := uids[ref.UID]; ok {
+			ref.UID = uid
+		}
+		if err := m.updateSecretOwnerReference(owner, &ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateSecretOwnerReference replaces the owner reference of the given secret
+// to a binding by the given one, or removes it if ref is nil.
+func (m *Migrator) updateSecretOwnerReference(owner secretOwner, ref *metav1.OwnerReference) error {
+	secrets := m.kubeClient.CoreV1().Secrets(owner.Namespace)
+	secret, err := secrets.Get(owner.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting secret %q: %v", objectKey(owner.Namespace, owner.Name), err)
+	}
+	secret = secret.DeepCopy()
+	var refs []metav1.OwnerReference
+	for _, existing := range secret.OwnerReferences {
+		if existing.Kind == owner.OwnerReference.Kind && existing.Name == owner.OwnerReference.Name {
+			continue
+		}
+		refs = append(refs, existing)
+	}
+	if ref != nil {
+		refs = append(refs, *ref)
+	}
+	secret.OwnerReferences = refs
+	if _, err := secrets.Update(secret); err != nil {
+		return fmt.Errorf("error updating the owner references of secret %q: %v", objectKey(owner.Namespace, owner.Name), err)
+	}
+	return nil
+}
+
+// waitForCRDs waits until the objects of the group are served from the
+// CustomResourceDefinitions, which are empty until the objects are copied.
+func (m *Migrator) waitForCRDs() error {
+	client := m.catalogClient.ServicecatalogV1beta1()
+	return wait.PollImmediate(m.pollInterval, m.timeout, func() (bool, error) {
+		for _, r := range resources {
+			objs, err := r.list(client)
+			if err != nil || len(objs) > 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// copyObjects creates the exported objects as custom resources, and writes
+// their status. It returns the map of the old UIDs of the objects to their
+// new UIDs.
+func (m *Migrator) copyObjects(export *Export) (map[types.UID]types.UID, error) {
+	client := m.catalogClient.ServicecatalogV1beta1()
+	uids := map[types.UID]types.UID{}
+	for _, r := range resources {
+		for _, fields := range export.Objects[r.plural] {
+			obj, err := fromFields(r, newObjectFields(fields, uids))
+			if err != nil {
+				return nil, err
+			}
+			created, err := r.create(client, obj)
+			if err != nil {
+				return nil, fmt.Errorf("error creating %v %q: %v", r.plural, fieldsKey(fields), err)
+			}
+			accessor, err := meta.Accessor(created)
+			if err != nil {
+				return nil, err
+			}
+			uids[fieldsUID(fields)] = accessor.GetUID()
+
+			status, ok := fields["status"].(map[string]interface{})
+			if !ok || r.updateStatus == nil {
+				continue
+			}
+			createdFields, err := toFields(created)
+			if err != nil {
+				return nil, err
+			}
+			createdFields["status"] = newStatusFields(status, fieldsGeneration(fields), accessor.GetGeneration())
+			obj, err = fromFields(r, createdFields)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := r.updateStatus(client, obj); err != nil {
+				return nil, fmt.Errorf("error updating the status of %v %q: %v", r.plural, fieldsKey(fields), err)
+			}
+		}
+		glog.Infof("Copied %d %v", len(export.Objects[r.plural]), r.plural)
+	}
+	return uids, nil
+}
+
+// verify checks that the custom resources match the exported objects: their
+// labels, annotations, finalizers, owner references, spec, status and any
+// other field, but their UID, resource version, generation and creation
+// timestamp.
+func (m *Migrator) verify(export *Export, uids map[types.UID]types.UID) error {
+	client := m.catalogClient.ServicecatalogV1beta1()
+	var errs []error
+	for _, r := range resources {
+		objs, err := r.list(client)
+		if err != nil {
+			return fmt.Errorf("error listing %v: %v", r.plural, err)
+		}
+		if e, a := len(export.Objects[r.plural]), len(objs); e != a {
+			errs = append(errs, fmt.Errorf("expected %d %v, found %d", e, r.plural, a))
+		}
+		for _, fields := range export.Objects[r.plural] {
+			metadata := fields["metadata"].(map[string]interface{})
+			namespace, _ := metadata["namespace"].(string)
+			obj, err := r.get(client, namespace, metadata["name"].(string))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error getting %v %q: %v", r.plural, fieldsKey(fields), err))
+				continue
+			}
+			actual, err := toFields(obj)
+			if err != nil {
+				return err
+			}
+			expected := newObjectFields(fields, uids)
+			if status, ok := fields["status"].(map[string]interface{}); ok {
+				expected["status"] = newStatusFields(status, fieldsGeneration(fields), fieldsGeneration(actual))
+			}
+			if !reflect.DeepEqual(comparedFields(expected), comparedFields(actual)) {
+				errs = append(errs, fmt.Errorf("%v %q differs from the exported object", r.plural, fieldsKey(fields)))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("the copied objects do not match the exported ones: %v", utilerrors.NewAggregate(errs))
+	}
+	return nil
+}
+
+// rollbackAfter reverts the migration, which failed with the given error at
+// the given stage, and returns the error. The custom resources are deleted
+// along with their definitions, the APIService of the aggregated API server is
+// created again, and the secrets are linked to the bindings again.
+func (m *Migrator) rollbackAfter(err error, export *Export, s stage) error {
+	glog.Errorf("Reverting the migration: %v", err)
+	errs := []error{err}
+	if s >= stageSwitched {
+		if rollbackErr := m.deleteCustomResources(); rollbackErr != nil {
+			errs = append(errs, rollbackErr)
+		}
+	}
+	if s >= stageCRDsCreated {
+		if rollbackErr := m.cutOver.DeleteCRDs(); rollbackErr != nil {
+			errs = append(errs, rollbackErr)
+		}
+	}
+	if s >= stageSwitched {
+		if rollbackErr := m.cutOver.CreateAPIService(export.APIService); rollbackErr != nil {
+			errs = append(errs, rollbackErr)
+		}
+	}
+	if rollbackErr := m.linkSecrets(export.SecretOwners, nil); rollbackErr != nil {
+		errs = append(errs, rollbackErr)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// deleteCustomResources removes the finalizers of the custom resources, so
+// that deleting their definitions deletes them without the controller. Only
+// the objects created by the migration are modified, in case the group is
+// still served by the aggregated API server.
+func (m *Migrator) deleteCustomResources() error {
+	client := m.catalogClient.ServicecatalogV1beta1()
+	for _, r := range resources {
+		objs, err := r.list(client)
+		if err != nil {
+			// The definition of the resource was not created.
+			continue
+		}
+		for _, obj := range objs {
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				return err
+			}
+			if _, ok := accessor.GetAnnotations()[MigratedFromUIDAnnotation]; !ok || len(accessor.GetFinalizers()) == 0 {
+				continue
+			}
+			accessor.SetFinalizers(nil)
+			if _, err := r.update(client, obj); err != nil {
+				return fmt.Errorf("error removing the finalizers of %v %q: %v", r.plural, objectKey(accessor.GetNamespace(), accessor.GetName()), err)
+			}
+		}
+	}
+	return nil
+}
+
+// newObjectFields returns the fields of the custom resource to create for the
+// given exported object, without its status. The owner references to objects
+// already copied are rewritten to the new UIDs of their owners.
+func newObjectFields(fields map[string]interface{}, uids map[types.UID]types.UID) map[string]interface{} {
+	obj := runtime.DeepCopyJSON(fields)
+	delete(obj, "status")
+
+	metadata := obj["metadata"].(map[string]interface{})
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "selfLink"} {
+		delete(metadata, field)
+	}
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	if annotations == nil {
+		annotations = map[string]interface{}{}
+	}
+	annotations[MigratedFromUIDAnnotation] = string(fieldsUID(fields))
+	metadata["annotations"] = annotations
+
+	if refs, ok := metadata["ownerReferences"].([]interface{}); ok {
+		for _, ref := range refs {
+			ref := ref.(map[string]interface{})
+			if uid, ok := uids[types.UID(ref["uid"].(string))]; ok {
+				ref["uid"] = string(uid)
+			}
+		}
+	}
+	return obj
+}
+
+// newStatusFields returns the given status of an object of the given
+// generation, for its copy of the given generation. The generations the
+// status records are shifted by the difference between the two, so that the
+// controller sees the same changes as pending, or none. A generation that
+// would become negative is set to zero, which is lower than any generation.
+func newStatusFields(status map[string]interface{}, oldGeneration, newGeneration int64) map[string]interface{} {
+	status = runtime.DeepCopyJSON(status)
+	for _, field := range generationFields {
+		value, ok := status[field].(float64)
+		if !ok || value == 0 {
+			continue
+		}
+		generation := int64(value) - oldGeneration + newGeneration
+		if generation < 0 {
+			generation = 0
+		}
+		status[field] = float64(generation)
+	}
+	return status
+}
+
+// comparedFields returns the fields of the given object compared by verify.
+func comparedFields(fields map[string]interface{}) map[string]interface{} {
+	compared := runtime.DeepCopyJSON(fields)
+	delete(compared, "apiVersion")
+	delete(compared, "kind")
+	metadata := compared["metadata"].(map[string]interface{})
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "selfLink"} {
+		delete(metadata, field)
+	}
+	return compared
+}
+
+// toFields returns the JSON fields of the given object.
+func toFields(obj runtime.Object) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// fromFields returns the object of the given resource with the given JSON
+// fields.
+func fromFields(r resource, fields map[string]interface{}) (runtime.Object, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	obj := r.newObject()
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func fieldsUID(fields map[string]interface{}) types.UID {
+	uid, _ := fields["metadata"].(map[string]interface{})["uid"].(string)
+	return types.UID(uid)
+}
+
+func fieldsGeneration(fields map[string]interface{}) int64 {
+	generation, _ := fields["metadata"].(map[string]interface{})["generation"].(float64)
+	return int64(generation)
+}
+
+func fieldsKey(fields map[string]interface{}) string {
+	metadata := fields["metadata"].(map[string]interface{})
+	namespace, _ := metadata["namespace"].(string)
+	name, _ := metadata["name"].(string)
+	return objectKey(namespace, name)
+}
+
+func objectKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdmigration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	fakeservicecatalogclientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	servicecatalogv1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
+)
+
+const (
+	testNamespace             = "test-ns"
+	testControllerManagerName = "catalog-controller-manager"
+)
+
+// fakeCutOver records the calls to it. Once the APIService is deleted, the
+// catalog client sends its requests to the custom resources.
+type fakeCutOver struct {
+	calls    []string
+	switched *bool
+}
+
+func (f *fakeCutOver) GetAPIService() ([]byte, error) {
+	f.calls = append(f.calls, "GetAPIService")
+	return []byte(`{"metadata":{"name":"v1beta1.servicecatalog.k8s.io"}}`), nil
+}
+
+func (f *fakeCutOver) DeleteAPIService() error {
+	f.calls = append(f.calls, "DeleteAPIService")
+	*f.switched = true
+	return nil
+}
+
+func (f *fakeCutOver) CreateAPIService(apiService []byte) error {
+	f.calls = append(f.calls, "CreateAPIService")
+	*f.switched = false
+	return nil
+}
+
+func (f *fakeCutOver) CreateCRDs() error {
+	f.calls = append(f.calls, "CreateCRDs")
+	return nil
+}
+
+func (f *fakeCutOver) DeleteCRDs() error {
+	f.calls = append(f.calls, "DeleteCRDs")
+	return nil
+}
+
+// switchingClientset sends the requests of the servicecatalog group to the
+// aggregated API server until the group is switched, and to the custom
+// resources afterwards.
+type switchingClientset struct {
+	*fakeservicecatalogclientset.Clientset
+	customResources *fakeservicecatalogclientset.Clientset
+	switched        *bool
+}
+
+func (c *switchingClientset) ServicecatalogV1beta1() servicecatalogv1beta1.ServicecatalogV1beta1Interface {
+	if *c.switched {
+		return c.customResources.ServicecatalogV1beta1()
+	}
+	return c.Clientset.ServicecatalogV1beta1()
+}
+
+func getTestDeployment() *appsv1.Deployment {
+	replicas := int32(2)
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testControllerManagerName},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": testControllerManagerName}},
+		},
+	}
+}
+
+func getTestObjects() []runtime.Object {
+	broker := &v1beta1.ClusterServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{Name: "broker", UID: "broker-uid", Generation: 2},
+		Spec: v1beta1.ClusterServiceBrokerSpec{
+			CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{URL: "https://broker.example.com"},
+		},
+		Status: v1beta1.ClusterServiceBrokerStatus{
+			CommonServiceBrokerStatus: v1beta1.CommonServiceBrokerStatus{ReconciledGeneration: 2},
+		},
+	}
+	instance := &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       "instance",
+			UID:        "instance-uid",
+			Generation: 3,
+			Finalizers: []string{v1beta1.FinalizerServiceCatalog},
+			Labels:     map[string]string{"team": "a"},
+		},
+		Spec: v1beta1.ServiceInstanceSpec{ExternalID: "instance-external-id"},
+		Status: v1beta1.ServiceInstanceStatus{
+			AsyncOpInProgress:    true,
+			ObservedGeneration:   3,
+			ReconciledGeneration: 2,
+			ProvisionStatus:      v1beta1.ServiceInstanceProvisionStatusProvisioned,
+		},
+	}
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       "binding",
+			UID:        "binding-uid",
+			Generation: 1,
+			Finalizers: []string{v1beta1.FinalizerServiceCatalog},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: v1beta1.SchemeGroupVersion.String(),
+				Kind:       "ServiceInstance",
+				Name:       "instance",
+				UID:        "instance-uid",
+			}},
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			ServiceInstanceRef: v1beta1.LocalObjectReference{Name: "instance"},
+			ExternalID:         "binding-external-id",
+			SecretName:         "binding-secret",
+		},
+		Status: v1beta1.ServiceBindingStatus{ReconciledGeneration: 1},
+	}
+	return []runtime.Object{broker, instance, binding}
+}
+
+func getTestSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "binding-secret",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: v1beta1.SchemeGroupVersion.String(),
+				Kind:       "ServiceBinding",
+				Name:       "binding",
+				UID:        "binding-uid",
+			}},
+		},
+	}
+}
+
+// newTestMigrator returns a Migrator migrating the given objects, the fake
+// clients of the aggregated API server and of the custom resources, the
+// tracker of the custom resources, the fake Kubernetes client and the fake
+// CutOver.
+func newTestMigrator(t *testing.T, objs ...runtime.Object) (*Migrator, *fakeservicecatalogclientset.Clientset, *fakeservicecatalogclientset.Clientset, clientgotesting.ObjectTracker, *fakekubeclientset.Clientset, *fakeCutOver) {
+	switched := false
+	aggregated := fakeservicecatalogclientset.NewSimpleClientset(objs...)
+	customResources, tracker := newCustomResourcesClientset()
+	customResources.PrependReactor("create", "*", createCustomResource(tracker, nil))
+	kubeClient := fakekubeclientset.NewSimpleClientset(getTestDeployment(), getTestSecret())
+	cutOver := &fakeCutOver{switched: &switched}
+
+	dir, err := ioutil.TempDir("", "crdmigration")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	catalogClient := &switchingClientset{
+		Clientset:       aggregated,
+		customResources: customResources,
+		switched:        &switched,
+	}
+	m := NewMigrator(kubeClient, catalogClient, cutOver, filepath.Join(dir, "export.json"), testNamespace, testControllerManagerName, time.Millisecond, time.Second)
+	return m, aggregated, customResources, tracker, kubeClient, cutOver
+}
+
+// newCustomResourcesClientset returns a fake clientset of the custom
+// resources, and the tracker holding them.
+func newCustomResourcesClientset() (*fakeservicecatalogclientset.Clientset, clientgotesting.ObjectTracker) {
+	scheme := runtime.NewScheme()
+	fakeservicecatalogclientset.AddToScheme(scheme)
+	tracker := clientgotesting.NewObjectTracker(scheme, serializer.NewCodecFactory(scheme).UniversalDecoder())
+	client := &fakeservicecatalogclientset.Clientset{}
+	client.AddReactor("*", "*", clientgotesting.ObjectReaction(tracker))
+	return client, tracker
+}
+
+// createCustomResource returns a reaction creating custom resources in the
+// given tracker. Like the API server, it gives them new UIDs and starts their
+// generation at 1. The given function, if any, modifies them beforehand.
+func createCustomResource(tracker clientgotesting.ObjectTracker, modify func(metav1.Object)) clientgotesting.ReactionFunc {
+	return func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		obj := action.(clientgotesting.CreateAction).GetObject()
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return true, nil, err
+		}
+		accessor.SetUID(types.UID("new-" + accessor.GetName()))
+		accessor.SetGeneration(1)
+		if modify != nil {
+			modify(accessor)
+		}
+		if err := tracker.Create(action.GetResource(), obj, action.GetNamespace()); err != nil {
+			return true, nil, err
+		}
+		return true, obj, nil
+	}
+}
+
+func assertControllerManagerReplicas(t *testing.T, kubeClient *fakekubeclientset.Clientset, expected int32) {
+	deployment, err := kubeClient.AppsV1().Deployments(testNamespace).Get(testControllerManagerName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := expected, *deployment.Spec.Replicas; e != a {
+		t.Fatalf("unexpected number of replicas of the controller manager: expected %v, got %v", e, a)
+	}
+}
+
+func assertSecretOwnerUID(t *testing.T, kubeClient *fakekubeclientset.Clientset, expected types.UID) {
+	secret, err := kubeClient.CoreV1().Secrets(testNamespace).Get("binding-secret", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(secret.OwnerReferences) != 1 {
+		t.Fatalf("expected the secret to have one owner reference, got %v", secret.OwnerReferences)
+	}
+	if e, a := expected, secret.OwnerReferences[0].UID; e != a {
+		t.Fatalf("unexpected UID of the owner of the secret: expected %v, got %v", e, a)
+	}
+}
+
+// TestMigrate tests that the objects are copied with their status and
+// finalizers, that the owner references to them are rewritten to their new
+// UIDs, and that the controller manager is resumed.
+func TestMigrate(t *testing.T) {
+	m, aggregated, customResources, _, kubeClient, cutOver := newTestMigrator(t, getTestObjects()...)
+	defer os.RemoveAll(filepath.Dir(m.exportPath))
+
+	if err := m.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e, a := []string{"GetAPIService", "CreateCRDs", "DeleteAPIService"}, cutOver.calls; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected calls: expected %v, got %v", e, a)
+	}
+	for _, action := range aggregated.Actions() {
+		if action.GetVerb() != "list" {
+			t.Fatalf("unexpected action on the aggregated API server: %v", action)
+		}
+	}
+	assertControllerManagerReplicas(t, kubeClient, 2)
+	assertSecretOwnerUID(t, kubeClient, "new-binding")
+	if _, err := os.Stat(m.exportPath); err != nil {
+		t.Fatalf("expected the export to be saved: %v", err)
+	}
+
+	client := customResources.ServicecatalogV1beta1()
+	instance, err := client.ServiceInstances(testNamespace).Get("instance", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "instance-uid", instance.Annotations[MigratedFromUIDAnnotation]; e != a {
+		t.Fatalf("unexpected migrated-from UID: expected %v, got %v", e, a)
+	}
+	if e, a := []string{v1beta1.FinalizerServiceCatalog}, instance.Finalizers; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected finalizers: expected %v, got %v", e, a)
+	}
+	if e, a := "instance-external-id", instance.Spec.ExternalID; e != a {
+		t.Fatalf("unexpected external ID: expected %v, got %v", e, a)
+	}
+	if !instance.Status.AsyncOpInProgress {
+		t.Fatalf("expected the operation in progress to be copied")
+	}
+	// The observed generation was the current one, and the reconciled one
+	// was behind it.
+	if e, a := int64(1), instance.Status.ObservedGeneration; e != a {
+		t.Fatalf("unexpected observed generation: expected %v, got %v", e, a)
+	}
+	if e, a := int64(0), instance.Status.ReconciledGeneration; e != a {
+		t.Fatalf("unexpected reconciled generation: expected %v, got %v", e, a)
+	}
+
+	binding, err := client.ServiceBindings(testNamespace).Get("binding", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := types.UID("new-instance"), binding.OwnerReferences[0].UID; e != a {
+		t.Fatalf("unexpected UID of the owner of the binding: expected %v, got %v", e, a)
+	}
+	if e, a := binding.Generation, binding.Status.ReconciledGeneration; e != a {
+		t.Fatalf("unexpected reconciled generation: expected %v, got %v", e, a)
+	}
+
+	broker, err := client.ClusterServiceBrokers().Get("broker", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := broker.Generation, broker.Status.ReconciledGeneration; e != a {
+		t.Fatalf("unexpected reconciled generation: expected %v, got %v", e, a)
+	}
+}
+
+// TestMigrateRevertsWhenVerificationFails tests that the group is switched
+// back to the aggregated API server when a copy differs from its object.
+func TestMigrateRevertsWhenVerificationFails(t *testing.T) {
+	m, _, customResources, tracker, kubeClient, cutOver := newTestMigrator(t, getTestObjects()...)
+	defer os.RemoveAll(filepath.Dir(m.exportPath))
+
+	customResources.PrependReactor("create", "serviceinstances", createCustomResource(tracker, func(obj metav1.Object) {
+		obj.SetLabels(nil)
+	}))
+
+	if err := m.Run(); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if e, a := []string{"GetAPIService", "CreateCRDs", "DeleteAPIService", "DeleteCRDs", "CreateAPIService"}, cutOver.calls; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected calls: expected %v, got %v", e, a)
+	}
+	assertControllerManagerReplicas(t, kubeClient, 2)
+	assertSecretOwnerUID(t, kubeClient, "binding-uid")
+
+	instance, err := customResources.ServicecatalogV1beta1().ServiceInstances(testNamespace).Get("instance", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(instance.Finalizers) != 0 {
+		t.Fatalf("expected the finalizers of the custom resource to be removed, got %v", instance.Finalizers)
+	}
+}
+
+// TestMigrateObjectsBeingDeleted tests that nothing is migrated while an
+// object is being deleted.
+func TestMigrateObjectsBeingDeleted(t *testing.T) {
+	objs := getTestObjects()
+	deletionTimestamp := metav1.Now()
+	objs[1].(*v1beta1.ServiceInstance).DeletionTimestamp = &deletionTimestamp
+	m, _, customResources, _, kubeClient, cutOver := newTestMigrator(t, objs...)
+	defer os.RemoveAll(filepath.Dir(m.exportPath))
+
+	if err := m.Run(); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if e, a := []string{"GetAPIService"}, cutOver.calls; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected calls: expected %v, got %v", e, a)
+	}
+	if a := customResources.Actions(); len(a) != 0 {
+		t.Fatalf("expected no custom resource to be created, got %v", a)
+	}
+	assertControllerManagerReplicas(t, kubeClient, 2)
+	assertSecretOwnerUID(t, kubeClient, "binding-uid")
+}
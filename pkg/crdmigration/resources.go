@@ -0,0 +1,415 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdmigration
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalogclientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
+)
+
+// printerColumn is a column printed by kubectl for the custom resources of a
+// resource.
+type printerColumn struct {
+	name     string
+	jsonPath string
+}
+
+// resource describes a resource of the servicecatalog API group, and how its
+// objects are read and written with the typed client.
+type resource struct {
+	// plural, singular and kind are the names of the resource.
+	plural   string
+	singular string
+	kind     string
+	// shortNames are the short names served by the aggregated API server.
+	shortNames []string
+	// namespaced is whether the objects of the resource are namespaced.
+	namespaced bool
+	// columns are the columns printed by kubectl, besides the name and age.
+	columns []printerColumn
+
+	// newObject returns an empty object of the resource.
+	newObject func() runtime.Object
+	list      func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error)
+	get       func(client servicecatalogclientset.ServicecatalogV1beta1Interface, namespace, name string) (runtime.Object, error)
+	create    func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error)
+	update    func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error)
+	// updateStatus writes the status of an object through the status
+	// subresource. It is nil for the resources without status.
+	updateStatus func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error)
+}
+
+// resources are the resources of the servicecatalog API group, in the order
+// their objects are copied: the owners and the referenced objects of an object
+// are copied before it.
+var resources = []resource{
+	{
+		plural:     "clusterservicebrokers",
+		singular:   "clusterservicebroker",
+		kind:       "ClusterServiceBroker",
+		shortNames: []string{"csb"},
+		columns:    []printerColumn{{"URL", ".spec.url"}},
+		newObject:  func() runtime.Object { return &v1beta1.ClusterServiceBroker{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ClusterServiceBrokers().List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, _, name string) (runtime.Object, error) {
+			return client.ClusterServiceBrokers().Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServiceBrokers().Create(obj.(*v1beta1.ClusterServiceBroker))
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServiceBrokers().Update(obj.(*v1beta1.ClusterServiceBroker))
+		},
+		updateStatus: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServiceBrokers().UpdateStatus(obj.(*v1beta1.ClusterServiceBroker))
+		},
+	},
+	{
+		plural:     "clusterserviceclasses",
+		singular:   "clusterserviceclass",
+		kind:       "ClusterServiceClass",
+		shortNames: []string{"csc"},
+		columns:    []printerColumn{{"External-Name", ".spec.externalName"}, {"Broker", ".spec.clusterServiceBrokerName"}},
+		newObject:  func() runtime.Object { return &v1beta1.ClusterServiceClass{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ClusterServiceClasses().List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, _, name string) (runtime.Object, error) {
+			return client.ClusterServiceClasses().Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServiceClasses().Create(obj.(*v1beta1.ClusterServiceClass))
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServiceClasses().Update(obj.(*v1beta1.ClusterServiceClass))
+		},
+		updateStatus: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServiceClasses().UpdateStatus(obj.(*v1beta1.ClusterServiceClass))
+		},
+	},
+	{
+		plural:     "clusterserviceplans",
+		singular:   "clusterserviceplan",
+		kind:       "ClusterServicePlan",
+		shortNames: []string{"csp"},
+		columns:    []printerColumn{{"External-Name", ".spec.externalName"}, {"Broker", ".spec.clusterServiceBrokerName"}, {"Class", ".spec.clusterServiceClassRef.name"}},
+		newObject:  func() runtime.Object { return &v1beta1.ClusterServicePlan{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ClusterServicePlans().List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, _, name string) (runtime.Object, error) {
+			return client.ClusterServicePlans().Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServicePlans().Create(obj.(*v1beta1.ClusterServicePlan))
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServicePlans().Update(obj.(*v1beta1.ClusterServicePlan))
+		},
+		updateStatus: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.ClusterServicePlans().UpdateStatus(obj.(*v1beta1.ClusterServicePlan))
+		},
+	},
+	{
+		plural:     "brokertemplates",
+		singular:   "brokertemplate",
+		kind:       "BrokerTemplate",
+		shortNames: []string{"bt"},
+		newObject:  func() runtime.Object { return &v1beta1.BrokerTemplate{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.BrokerTemplates().List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, _, name string) (runtime.Object, error) {
+			return client.BrokerTemplates().Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.BrokerTemplates().Create(obj.(*v1beta1.BrokerTemplate))
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.BrokerTemplates().Update(obj.(*v1beta1.BrokerTemplate))
+		},
+	},
+	{
+		plural:     "catalogprojections",
+		singular:   "catalogprojection",
+		kind:       "CatalogProjection",
+		shortNames: []string{"cpr"},
+		newObject:  func() runtime.Object { return &v1beta1.CatalogProjection{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.CatalogProjections().List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, _, name string) (runtime.Object, error) {
+			return client.CatalogProjections().Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.CatalogProjections().Create(obj.(*v1beta1.CatalogProjection))
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			return client.CatalogProjections().Update(obj.(*v1beta1.CatalogProjection))
+		},
+	},
+	{
+		plural:     "servicebrokers",
+		singular:   "servicebroker",
+		kind:       "ServiceBroker",
+		shortNames: []string{"sbr"},
+		namespaced: true,
+		columns:    []printerColumn{{"URL", ".spec.url"}},
+		newObject:  func() runtime.Object { return &v1beta1.ServiceBroker{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ServiceBrokers(metav1.NamespaceAll).List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, namespace, name string) (runtime.Object, error) {
+			return client.ServiceBrokers(namespace).Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			broker := obj.(*v1beta1.ServiceBroker)
+			return client.ServiceBrokers(broker.Namespace).Create(broker)
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			broker := obj.(*v1beta1.ServiceBroker)
+			return client.ServiceBrokers(broker.Namespace).Update(broker)
+		},
+		updateStatus: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			broker := obj.(*v1beta1.ServiceBroker)
+			return client.ServiceBrokers(broker.Namespace).UpdateStatus(broker)
+		},
+	},
+	{
+		plural:     "serviceclasses",
+		singular:   "serviceclass",
+		kind:       "ServiceClass",
+		shortNames: []string{"scl"},
+		namespaced: true,
+		columns:    []printerColumn{{"External-Name", ".spec.externalName"}, {"Broker", ".spec.serviceBrokerName"}},
+		newObject:  func() runtime.Object { return &v1beta1.ServiceClass{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ServiceClasses(metav1.NamespaceAll).List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, namespace, name string) (runtime.Object, error) {
+			return client.ServiceClasses(namespace).Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			class := obj.(*v1beta1.ServiceClass)
+			return client.ServiceClasses(class.Namespace).Create(class)
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			class := obj.(*v1beta1.ServiceClass)
+			return client.ServiceClasses(class.Namespace).Update(class)
+		},
+		updateStatus: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			class := obj.(*v1beta1.ServiceClass)
+			return client.ServiceClasses(class.Namespace).UpdateStatus(class)
+		},
+	},
+	{
+		plural:     "serviceplans",
+		singular:   "serviceplan",
+		kind:       "ServicePlan",
+		shortNames: []string{"spl"},
+		namespaced: true,
+		columns:    []printerColumn{{"External-Name", ".spec.externalName"}, {"Broker", ".spec.serviceBrokerName"}, {"Class", ".spec.serviceClassRef.name"}},
+		newObject:  func() runtime.Object { return &v1beta1.ServicePlan{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ServicePlans(metav1.NamespaceAll).List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, namespace, name string) (runtime.Object, error) {
+			return client.ServicePlans(namespace).Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			plan := obj.(*v1beta1.ServicePlan)
+			return client.ServicePlans(plan.Namespace).Create(plan)
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			plan := obj.(*v1beta1.ServicePlan)
+			return client.ServicePlans(plan.Namespace).Update(plan)
+		},
+		updateStatus: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			plan := obj.(*v1beta1.ServicePlan)
+			return client.ServicePlans(plan.Namespace).UpdateStatus(plan)
+		},
+	},
+	{
+		plural:     "servicebrokersummaries",
+		singular:   "servicebrokersummary",
+		kind:       "ServiceBrokerSummary",
+		shortNames: []string{"sbs"},
+		namespaced: true,
+		newObject:  func() runtime.Object { return &v1beta1.ServiceBrokerSummary{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ServiceBrokerSummaries(metav1.NamespaceAll).List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, namespace, name string) (runtime.Object, error) {
+			return client.ServiceBrokerSummaries(namespace).Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			summary := obj.(*v1beta1.ServiceBrokerSummary)
+			return client.ServiceBrokerSummaries(summary.Namespace).Create(summary)
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			summary := obj.(*v1beta1.ServiceBrokerSummary)
+			return client.ServiceBrokerSummaries(summary.Namespace).Update(summary)
+		},
+	},
+	{
+		plural:     "serviceinstances",
+		singular:   "serviceinstance",
+		kind:       "ServiceInstance",
+		shortNames: []string{"si"},
+		namespaced: true,
+		columns:    []printerColumn{{"Class", ".spec.clusterServiceClassExternalName"}, {"Plan", ".spec.clusterServicePlanExternalName"}},
+		newObject:  func() runtime.Object { return &v1beta1.ServiceInstance{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ServiceInstances(metav1.NamespaceAll).List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, namespace, name string) (runtime.Object, error) {
+			return client.ServiceInstances(namespace).Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			instance := obj.(*v1beta1.ServiceInstance)
+			return client.ServiceInstances(instance.Namespace).Create(instance)
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			instance := obj.(*v1beta1.ServiceInstance)
+			return client.ServiceInstances(instance.Namespace).Update(instance)
+		},
+		updateStatus: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			instance := obj.(*v1beta1.ServiceInstance)
+			return client.ServiceInstances(instance.Namespace).UpdateStatus(instance)
+		},
+	},
+	{
+		plural:     "servicebindings",
+		singular:   "servicebinding",
+		kind:       "ServiceBinding",
+		shortNames: []string{"sb"},
+		namespaced: true,
+		columns:    []printerColumn{{"Service-Instance", ".spec.instanceRef.name"}, {"Secret-Name", ".spec.secretName"}},
+		newObject:  func() runtime.Object { return &v1beta1.ServiceBinding{} },
+		list: func(client servicecatalogclientset.ServicecatalogV1beta1Interface) ([]runtime.Object, error) {
+			list, err := client.ServiceBindings(metav1.NamespaceAll).List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var objs []runtime.Object
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			return objs, nil
+		},
+		get: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, namespace, name string) (runtime.Object, error) {
+			return client.ServiceBindings(namespace).Get(name, metav1.GetOptions{})
+		},
+		create: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			binding := obj.(*v1beta1.ServiceBinding)
+			return client.ServiceBindings(binding.Namespace).Create(binding)
+		},
+		update: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			binding := obj.(*v1beta1.ServiceBinding)
+			return client.ServiceBindings(binding.Namespace).Update(binding)
+		},
+		updateStatus: func(client servicecatalogclientset.ServicecatalogV1beta1Interface, obj runtime.Object) (runtime.Object, error) {
+			binding := obj.(*v1beta1.ServiceBinding)
+			return client.ServiceBindings(binding.Namespace).UpdateStatus(binding)
+		},
+	},
+}
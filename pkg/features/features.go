@@ -71,6 +71,29 @@ const (
 	// owner: @nilebox
 	// alpha: v0.1.14
 	OriginatingIdentityLocking utilfeature.Feature = "OriginatingIdentityLocking"
+
+	// InstanceAdoption allows ServiceInstances to adopt instances that
+	// already exist at the broker instead of provisioning them.
+	// alpha: v0.1.14
+	InstanceAdoption utilfeature.Feature = "InstanceAdoption"
+
+	// ParameterExpansion enables the expansion of variable references such
+	// as $(NAMESPACE) in the parameters of ServiceInstances and
+	// ServiceBindings before they are sent to brokers.
+	// alpha: v0.1.14
+	ParameterExpansion utilfeature.Feature = "ParameterExpansion"
+
+	// CrossNamespaceCatalogSharing allows a ServiceBroker to publish its
+	// ServiceClasses and ServicePlans into other namespaces listed in its
+	// spec.targetNamespaces.
+	// alpha: v0.1.14
+	CrossNamespaceCatalogSharing utilfeature.Feature = "CrossNamespaceCatalogSharing"
+
+	// BrokerCatalogSubresource enables the catalog subresource of
+	// ClusterServiceBrokers and ServiceBrokers, which serves the catalog last
+	// fetched from the broker, and makes the controller store it.
+	// alpha: v0.1.14
+	BrokerCatalogSubresource utilfeature.Feature = "BrokerCatalogSubresource"
 )
 
 func init() {
@@ -81,11 +104,15 @@ func init() {
 // To add a new feature, define a key for it above and add it here. The features will be
 // available throughout service catalog binaries.
 var defaultServiceCatalogFeatureGates = map[utilfeature.Feature]utilfeature.FeatureSpec{
-	PodPreset:                  {Default: false, PreRelease: utilfeature.Alpha},
-	OriginatingIdentity:        {Default: false, PreRelease: utilfeature.Alpha},
-	AsyncBindingOperations:     {Default: false, PreRelease: utilfeature.Alpha},
-	NamespacedServiceBroker:    {Default: true, PreRelease: utilfeature.Alpha},
-	ResponseSchema:             {Default: false, PreRelease: utilfeature.Alpha},
-	UpdateDashboardURL:         {Default: false, PreRelease: utilfeature.Alpha},
-	OriginatingIdentityLocking: {Default: true, PreRelease: utilfeature.Alpha},
+	PodPreset:                    {Default: false, PreRelease: utilfeature.Alpha},
+	OriginatingIdentity:          {Default: false, PreRelease: utilfeature.Alpha},
+	AsyncBindingOperations:       {Default: false, PreRelease: utilfeature.Alpha},
+	NamespacedServiceBroker:      {Default: true, PreRelease: utilfeature.Alpha},
+	ResponseSchema:               {Default: false, PreRelease: utilfeature.Alpha},
+	UpdateDashboardURL:           {Default: false, PreRelease: utilfeature.Alpha},
+	OriginatingIdentityLocking:   {Default: true, PreRelease: utilfeature.Alpha},
+	InstanceAdoption:             {Default: false, PreRelease: utilfeature.Alpha},
+	ParameterExpansion:           {Default: false, PreRelease: utilfeature.Alpha},
+	CrossNamespaceCatalogSharing: {Default: false, PreRelease: utilfeature.Alpha},
+	BrokerCatalogSubresource:     {Default: false, PreRelease: utilfeature.Alpha},
 }
@@ -61,6 +61,30 @@ var (
 		[]string{"broker"},
 	)
 
+	// BrokerUnsupportedOSBAPIVersion is 1 for the brokers that rejected the
+	// Open Service Broker API version used by the catalog on their last
+	// relist, and 0 for the others.
+	BrokerUnsupportedOSBAPIVersion = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "broker_unsupported_osb_api_version",
+			Help:      "Whether the Broker rejected the OSB API version used by the catalog on its last relist.",
+		},
+		[]string{"broker"},
+	)
+
+	// BrokerStaleServiceInstanceCount exposes the number of Service Instances
+	// per broker whose class or plan has been removed from the broker's
+	// catalog, as of the last relist of the broker.
+	BrokerStaleServiceInstanceCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "broker_stale_service_instance_count",
+			Help:      "Number of Service Instances whose class or plan has been removed from the catalog of the Broker.",
+		},
+		[]string{"broker"},
+	)
+
 	// OSBRequestCount exposes the number of HTTP requests made to Open Service
 	// Brokers.  The metric is broken out by broker name and response status
 	// group (1xx/2xx/3xx/4xx/5xx or 'client-error')
@@ -72,13 +96,87 @@ var (
 		},
 		[]string{"broker", "method", "status"},
 	)
+
+	// OSBOriginatingIdentityRequestCount exposes the number of requests made
+	// to Open Service Brokers for operations that can carry an originating
+	// identity.  The metric is broken out by broker name, broker method and
+	// whether the request carried an originating identity ('true'/'false').
+	OSBOriginatingIdentityRequestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "osb_originating_identity_request_count",
+			Help:      "Cumulative number of requests from the OSB Client to the specified Service Broker for operations that can carry an originating identity, grouped by broker name, broker method, and whether they carried one.",
+		},
+		[]string{"broker", "method", "originating_identity"},
+	)
+
+	// ServiceInstanceProvisionDuration exposes the time taken from the first
+	// provision request until a ServiceInstance became ready.  The metric is
+	// broken out by broker name and plan external name.
+	ServiceInstanceProvisionDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: catalogNamespace,
+			Name:      "instance_provision_duration_seconds",
+			Help:      "Time in seconds from the first provision request until the Service Instance became ready, grouped by broker name and plan.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+		},
+		[]string{"broker", "plan"},
+	)
+
+	// ServiceInstanceTimeToReady exposes the time taken from the creation of
+	// a ServiceInstance until it first became ready, which includes the time
+	// it waited before being provisioned.  The metric is broken out by broker
+	// name, class external name and plan external name, so that provisioning
+	// SLOs can be published per plan.
+	ServiceInstanceTimeToReady = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: catalogNamespace,
+			Name:      "instance_time_to_ready_seconds",
+			Help:      "Time in seconds from the creation of the Service Instance until it first became ready, grouped by broker name, class and plan.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+		},
+		[]string{"broker", "class", "plan"},
+	)
+
+	// ServiceBindingBindDuration exposes the time taken from the first bind
+	// request until a ServiceBinding became ready.  The metric is broken out
+	// by broker name.
+	ServiceBindingBindDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: catalogNamespace,
+			Name:      "binding_bind_duration_seconds",
+			Help:      "Time in seconds from the first bind request until the Service Binding became ready, grouped by broker name.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+		},
+		[]string{"broker"},
+	)
+
+	// AdmissionWebhookUnavailable is 1 for the resource types whose last
+	// reconciliation failed because an admission webhook of the API server
+	// could not be called, and 0 for the others.  Without it, an unavailable
+	// webhook is only seen as writes of the controller failing at random.
+	AdmissionWebhookUnavailable = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "admission_webhook_unavailable",
+			Help:      "Whether the last reconciliation of the resource type failed because an admission webhook could not be called.",
+		},
+		[]string{"resource"},
+	)
 )
 
 func register(registry *prometheus.Registry) {
 	registerMetrics.Do(func() {
 		registry.MustRegister(BrokerServiceClassCount)
 		registry.MustRegister(BrokerServicePlanCount)
+		registry.MustRegister(BrokerUnsupportedOSBAPIVersion)
+		registry.MustRegister(BrokerStaleServiceInstanceCount)
 		registry.MustRegister(OSBRequestCount)
+		registry.MustRegister(OSBOriginatingIdentityRequestCount)
+		registry.MustRegister(ServiceInstanceProvisionDuration)
+		registry.MustRegister(ServiceInstanceTimeToReady)
+		registry.MustRegister(ServiceBindingBindDuration)
+		registry.MustRegister(AdmissionWebhookUnavailable)
 	})
 }
 
@@ -0,0 +1,96 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osbclientproxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+)
+
+// getInstanceAPIVersion is the version of the OSB API sent when fetching an
+// instance, which the API added in 2.14, a version the OSB client library does
+// not know.
+const getInstanceAPIVersion = "2.14"
+
+// GetInstanceRequest is a request to fetch an instance from a broker.
+type GetInstanceRequest struct {
+	// InstanceID is the ID of the instance to fetch.
+	InstanceID string
+}
+
+// GetInstanceResponse is an instance fetched from a broker.
+type GetInstanceResponse struct {
+	ServiceID    string                 `json:"service_id"`
+	PlanID       string                 `json:"plan_id"`
+	DashboardURL *string                `json:"dashboard_url,omitempty"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// fetchInstance fetches an instance from the broker of the given configuration
+// with its HTTP client, since the OSB client library does not fetch
+// instances. Errors are reported like the library does.
+func fetchInstance(config *osb.ClientConfiguration, r *GetInstanceRequest) (*GetInstanceResponse, error) {
+	if config.HTTPClient == nil {
+		return nil, errors.New("instances can only be fetched by clients configured with an HTTP client")
+	}
+
+	url := fmt.Sprintf("%s/v2/service_instances/%s", strings.TrimRight(config.URL, "/"), r.InstanceID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set(osb.APIVersionHeader, getInstanceAPIVersion)
+	if auth := config.AuthConfig; auth != nil {
+		if auth.BasicAuthConfig != nil {
+			request.SetBasicAuth(auth.BasicAuthConfig.Username, auth.BasicAuthConfig.Password)
+		} else if auth.BearerConfig != nil {
+			request.Header.Set("Authorization", "Bearer "+auth.BearerConfig.Token)
+		}
+	}
+
+	response, err := config.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		httpErr := osb.HTTPStatusCodeError{StatusCode: response.StatusCode}
+		var body struct {
+			Error       *string `json:"error"`
+			Description *string `json:"description"`
+		}
+		if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+			httpErr.ResponseError = err
+		} else {
+			httpErr.ErrorMessage = body.Error
+			httpErr.Description = body.Description
+		}
+		return nil, httpErr
+	}
+
+	instance := &GetInstanceResponse{}
+	if err := json.NewDecoder(response.Body).Decode(instance); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
@@ -20,6 +20,7 @@ package osbclientproxy
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/golang/glog"
 	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
@@ -31,6 +32,7 @@ import (
 type proxyclient struct {
 	brokerName    string
 	realOSBClient osb.Client
+	config        osb.ClientConfiguration
 }
 
 // NewClient is a CreateFunc for creating a new functional Client and
@@ -40,7 +42,7 @@ func NewClient(config *osb.ClientConfiguration) (osb.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	proxy := proxyclient{realOSBClient: osbClient}
+	proxy := proxyclient{realOSBClient: osbClient, config: *config}
 	proxy.brokerName = config.Name
 	return proxy, nil
 }
@@ -57,6 +59,7 @@ const (
 	bind                     = "Bind"
 	unbind                   = "Unbind"
 	getBinding               = "GetBinding"
+	getInstance              = "GetInstance"
 )
 
 // GetCatalog implements go-open-service-broker-client/v2/Client.GetCatalog by
@@ -76,6 +79,7 @@ func (pc proxyclient) ProvisionInstance(r *osb.ProvisionRequest) (*osb.Provision
 	glog.V(9).Info("OSBClientProxy ProvisionInstance()")
 	response, err := pc.realOSBClient.ProvisionInstance(r)
 	pc.updateMetrics(provisionInstance, err)
+	pc.updateOriginatingIdentityMetrics(provisionInstance, r.OriginatingIdentity)
 	return response, err
 
 }
@@ -87,6 +91,7 @@ func (pc proxyclient) UpdateInstance(r *osb.UpdateInstanceRequest) (*osb.UpdateI
 	glog.V(9).Info("OSBClientProxy UpdateInstance()")
 	response, err := pc.realOSBClient.UpdateInstance(r)
 	pc.updateMetrics(updateInstance, err)
+	pc.updateOriginatingIdentityMetrics(updateInstance, r.OriginatingIdentity)
 	return response, err
 }
 
@@ -97,6 +102,7 @@ func (pc proxyclient) DeprovisionInstance(r *osb.DeprovisionRequest) (*osb.Depro
 	glog.V(9).Info("OSBClientProxy DeprovisionInstance()")
 	response, err := pc.realOSBClient.DeprovisionInstance(r)
 	pc.updateMetrics(deprovisionInstance, err)
+	pc.updateOriginatingIdentityMetrics(deprovisionInstance, r.OriginatingIdentity)
 	return response, err
 }
 
@@ -107,6 +113,7 @@ func (pc proxyclient) PollLastOperation(r *osb.LastOperationRequest) (*osb.LastO
 	glog.V(9).Info("OSBClientProxy PollLastOperation()")
 	response, err := pc.realOSBClient.PollLastOperation(r)
 	pc.updateMetrics(pollLastOperation, err)
+	pc.updateOriginatingIdentityMetrics(pollLastOperation, r.OriginatingIdentity)
 	return response, err
 }
 
@@ -117,6 +124,7 @@ func (pc proxyclient) PollBindingLastOperation(r *osb.BindingLastOperationReques
 	glog.V(9).Info("OSBClientProxy PollBindingLastOperation()")
 	response, err := pc.realOSBClient.PollBindingLastOperation(r)
 	pc.updateMetrics(pollBindingLastOperation, err)
+	pc.updateOriginatingIdentityMetrics(pollBindingLastOperation, r.OriginatingIdentity)
 	return response, err
 }
 
@@ -126,6 +134,7 @@ func (pc proxyclient) Bind(r *osb.BindRequest) (*osb.BindResponse, error) {
 	glog.V(9).Info("OSBClientProxy Bind().")
 	response, err := pc.realOSBClient.Bind(r)
 	pc.updateMetrics(bind, err)
+	pc.updateOriginatingIdentityMetrics(bind, r.OriginatingIdentity)
 	return response, err
 }
 
@@ -135,6 +144,7 @@ func (pc proxyclient) Unbind(r *osb.UnbindRequest) (*osb.UnbindResponse, error)
 	glog.V(9).Info("OSBClientProxy Unbind()")
 	response, err := pc.realOSBClient.Unbind(r)
 	pc.updateMetrics(unbind, err)
+	pc.updateOriginatingIdentityMetrics(unbind, r.OriginatingIdentity)
 	return response, err
 }
 
@@ -148,6 +158,16 @@ func (pc proxyclient) GetBinding(r *osb.GetBindingRequest) (*osb.GetBindingRespo
 	return response, err
 }
 
+// GetInstance fetches an instance from the broker, which the OSB client
+// library does not support, with the HTTP client of the configuration of the
+// proxy, and captures request metrics.
+func (pc proxyclient) GetInstance(r *GetInstanceRequest) (*GetInstanceResponse, error) {
+	glog.V(9).Info("OSBClientProxy GetInstance()")
+	response, err := fetchInstance(&pc.config, r)
+	pc.updateMetrics(getInstance, err)
+	return response, err
+}
+
 const clientErr = "client-error"
 
 // updateMetrics bumps the request count metric for the specific broker, method
@@ -169,3 +189,9 @@ func (pc proxyclient) updateMetrics(method string, err error) {
 	}
 	metrics.OSBRequestCount.WithLabelValues(pc.brokerName, method, statusGroup).Inc()
 }
+
+// updateOriginatingIdentityMetrics bumps the count of requests for the
+// specific broker and method with or without an originating identity.
+func (pc proxyclient) updateOriginatingIdentityMetrics(method string, identity *osb.OriginatingIdentity) {
+	metrics.OSBOriginatingIdentityRequestCount.WithLabelValues(pc.brokerName, method, strconv.FormatBool(identity != nil)).Inc()
+}
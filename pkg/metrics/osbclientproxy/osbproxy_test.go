@@ -0,0 +1,139 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osbclientproxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+)
+
+// counterValue returns the value of the given counter.
+func counterValue(t *testing.T, counter prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	if err := counter.Write(m); err != nil {
+		t.Fatalf("unexpected error reading the metric: %v", err)
+	}
+	return m.GetCounter().GetValue()
+}
+
+// newTestProxy returns a proxy for a broker with the given name served by the
+// given server.
+func newTestProxy(t *testing.T, brokerName string, server *httptest.Server) proxyclient {
+	config := osb.DefaultClientConfiguration()
+	config.Name = brokerName
+	config.URL = server.URL
+	config.HTTPClient = server.Client()
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return client.(proxyclient)
+}
+
+// TestGetInstance tests that an instance is fetched from the broker with the
+// HTTP client of the configuration, and that the request is counted.
+func TestGetInstance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e, a := "/v2/service_instances/instance-id", r.URL.Path; e != a {
+			t.Errorf("unexpected request path: expected %v, got %v", e, a)
+		}
+		if e, a := getInstanceAPIVersion, r.Header.Get(osb.APIVersionHeader); e != a {
+			t.Errorf("unexpected API version: expected %v, got %v", e, a)
+		}
+		w.Write([]byte(`{"service_id":"service-id","plan_id":"plan-id"}`))
+	}))
+	defer server.Close()
+	proxy := newTestProxy(t, "get-instance-broker", server)
+
+	succeeded := metrics.OSBRequestCount.WithLabelValues("get-instance-broker", getInstance, "2xx")
+	before := counterValue(t, succeeded)
+
+	instance, err := proxy.GetInstance(&GetInstanceRequest{InstanceID: "instance-id"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if instance.ServiceID != "service-id" || instance.PlanID != "plan-id" {
+		t.Fatalf("unexpected instance: %+v", instance)
+	}
+	if e, a := before+1, counterValue(t, succeeded); e != a {
+		t.Fatalf("unexpected count of requests: expected %v, got %v", e, a)
+	}
+}
+
+// TestGetInstanceError tests that the failure of the broker to return an
+// instance is an HTTP error of the OSB client library, counted by status.
+func TestGetInstanceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"description":"no such instance"}`))
+	}))
+	defer server.Close()
+	proxy := newTestProxy(t, "get-instance-error-broker", server)
+
+	failed := metrics.OSBRequestCount.WithLabelValues("get-instance-error-broker", getInstance, "4xx")
+	before := counterValue(t, failed)
+
+	_, err := proxy.GetInstance(&GetInstanceRequest{InstanceID: "instance-id"})
+	httpErr, ok := osb.IsHTTPError(err)
+	if !ok {
+		t.Fatalf("expected an HTTP error, got %v", err)
+	}
+	if httpErr.StatusCode != http.StatusNotFound || httpErr.Description == nil || *httpErr.Description != "no such instance" {
+		t.Fatalf("unexpected error: %v", httpErr)
+	}
+	if e, a := before+1, counterValue(t, failed); e != a {
+		t.Fatalf("unexpected count of requests: expected %v, got %v", e, a)
+	}
+}
+
+// TestProvisionInstanceCountsOriginatingIdentity tests that requests are
+// counted by whether they carry an originating identity, without any label
+// identifying the user.
+func TestProvisionInstanceCountsOriginatingIdentity(t *testing.T) {
+	proxy := proxyclient{
+		brokerName: "originating-identity-broker",
+		realOSBClient: fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+			ProvisionReaction: &fakeosb.ProvisionReaction{Response: &osb.ProvisionResponse{}},
+		}),
+	}
+
+	with := metrics.OSBOriginatingIdentityRequestCount.WithLabelValues("originating-identity-broker", provisionInstance, "true")
+	without := metrics.OSBOriginatingIdentityRequestCount.WithLabelValues("originating-identity-broker", provisionInstance, "false")
+	beforeWith, beforeWithout := counterValue(t, with), counterValue(t, without)
+
+	identity := &osb.OriginatingIdentity{Platform: "kubernetes", Value: `{"username":"alice"}`}
+	for _, r := range []*osb.ProvisionRequest{{OriginatingIdentity: identity}, {OriginatingIdentity: identity}, {}} {
+		if _, err := proxy.ProvisionInstance(r); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if e, a := beforeWith+2, counterValue(t, with); e != a {
+		t.Fatalf("unexpected count of requests with an originating identity: expected %v, got %v", e, a)
+	}
+	if e, a := beforeWithout+1, counterValue(t, without); e != a {
+		t.Fatalf("unexpected count of requests without an originating identity: expected %v, got %v", e, a)
+	}
+}
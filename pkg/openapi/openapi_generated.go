@@ -31,307 +31,331 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeyTransform":                schema_pkg_apis_servicecatalog_v1beta1_AddKeyTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform":           schema_pkg_apis_servicecatalog_v1beta1_AddKeysFromTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig":                schema_pkg_apis_servicecatalog_v1beta1_BasicAuthConfig(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig":          schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions":            schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBasicAuthConfig":         schema_pkg_apis_servicecatalog_v1beta1_ClusterBasicAuthConfig(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig":   schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenAuthConfig(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference":         schema_pkg_apis_servicecatalog_v1beta1_ClusterObjectReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBroker":           schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBroker(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo":   schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerAuthInfo(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerList":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerSpec":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerStatus":     schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClass":            schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClass(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassList":        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassSpec":        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassStatus":      schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlan":             schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlan(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanList":         schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanSpec":         schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanStatus":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerSpec":        schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerStatus":      schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassSpec":         schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassStatus":       schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanSpec":          schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanStatus":        schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference":           schema_pkg_apis_servicecatalog_v1beta1_LocalObjectReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ObjectReference":                schema_pkg_apis_servicecatalog_v1beta1_ObjectReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource":           schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.PlanReference":                  schema_pkg_apis_servicecatalog_v1beta1_PlanReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform":             schema_pkg_apis_servicecatalog_v1beta1_RemoveKeyTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RenameKeyTransform":             schema_pkg_apis_servicecatalog_v1beta1_RenameKeyTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference":             schema_pkg_apis_servicecatalog_v1beta1_SecretKeyReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform":                schema_pkg_apis_servicecatalog_v1beta1_SecretTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBinding":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceBinding(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition":        schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingCondition(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingList":             schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState":  schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingPropertiesState(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingSpec":             schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingStatus":           schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBroker":                  schema_pkg_apis_servicecatalog_v1beta1_ServiceBroker(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo":          schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerAuthInfo(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition":         schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerCondition(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerList":              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSpec":              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerStatus":            schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClass":                   schema_pkg_apis_servicecatalog_v1beta1_ServiceClass(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassList":               schema_pkg_apis_servicecatalog_v1beta1_ServiceClassList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassSpec":               schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassStatus":             schema_pkg_apis_servicecatalog_v1beta1_ServiceClassStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstance":                schema_pkg_apis_servicecatalog_v1beta1_ServiceInstance(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition":       schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceCondition(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceList":            schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState": schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceSpec":            schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceStatus":          schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlan":                    schema_pkg_apis_servicecatalog_v1beta1_ServicePlan(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanList":                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanSpec":                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanStatus":              schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo":                       schema_pkg_apis_servicecatalog_v1beta1_UserInfo(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPreset":                           schema_pkg_apis_settings_v1alpha1_PodPreset(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPresetList":                       schema_pkg_apis_settings_v1alpha1_PodPresetList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPresetSpec":                       schema_pkg_apis_settings_v1alpha1_PodPresetSpec(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                            schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                                                    schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                                              schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                                                   schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                                       schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                                             schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                                       schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		"k8s.io/api/core/v1.Binding":                                                                                     schema_k8sio_api_core_v1_Binding(ref),
-		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                                                   schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.Capabilities":                                                                                schema_k8sio_api_core_v1_Capabilities(ref),
-		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                                                schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CephFSVolumeSource":                                                                          schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                                                schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderVolumeSource":                                                                          schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		"k8s.io/api/core/v1.ClientIPConfig":                                                                              schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		"k8s.io/api/core/v1.ComponentCondition":                                                                          schema_k8sio_api_core_v1_ComponentCondition(ref),
-		"k8s.io/api/core/v1.ComponentStatus":                                                                             schema_k8sio_api_core_v1_ComponentStatus(ref),
-		"k8s.io/api/core/v1.ComponentStatusList":                                                                         schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		"k8s.io/api/core/v1.ConfigMap":                                                                                   schema_k8sio_api_core_v1_ConfigMap(ref),
-		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                                          schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		"k8s.io/api/core/v1.ConfigMapKeySelector":                                                                        schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		"k8s.io/api/core/v1.ConfigMapList":                                                                               schema_k8sio_api_core_v1_ConfigMapList(ref),
-		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                                                   schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		"k8s.io/api/core/v1.ConfigMapProjection":                                                                         schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                                                       schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		"k8s.io/api/core/v1.Container":                                                                                   schema_k8sio_api_core_v1_Container(ref),
-		"k8s.io/api/core/v1.ContainerImage":                                                                              schema_k8sio_api_core_v1_ContainerImage(ref),
-		"k8s.io/api/core/v1.ContainerPort":                                                                               schema_k8sio_api_core_v1_ContainerPort(ref),
-		"k8s.io/api/core/v1.ContainerState":                                                                              schema_k8sio_api_core_v1_ContainerState(ref),
-		"k8s.io/api/core/v1.ContainerStateRunning":                                                                       schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		"k8s.io/api/core/v1.ContainerStateTerminated":                                                                    schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		"k8s.io/api/core/v1.ContainerStateWaiting":                                                                       schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		"k8s.io/api/core/v1.ContainerStatus":                                                                             schema_k8sio_api_core_v1_ContainerStatus(ref),
-		"k8s.io/api/core/v1.DaemonEndpoint":                                                                              schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		"k8s.io/api/core/v1.DownwardAPIProjection":                                                                       schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                                                       schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                                                     schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                                                        schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		"k8s.io/api/core/v1.EndpointAddress":                                                                             schema_k8sio_api_core_v1_EndpointAddress(ref),
-		"k8s.io/api/core/v1.EndpointPort":                                                                                schema_k8sio_api_core_v1_EndpointPort(ref),
-		"k8s.io/api/core/v1.EndpointSubset":                                                                              schema_k8sio_api_core_v1_EndpointSubset(ref),
-		"k8s.io/api/core/v1.Endpoints":                                                                                   schema_k8sio_api_core_v1_Endpoints(ref),
-		"k8s.io/api/core/v1.EndpointsList":                                                                               schema_k8sio_api_core_v1_EndpointsList(ref),
-		"k8s.io/api/core/v1.EnvFromSource":                                                                               schema_k8sio_api_core_v1_EnvFromSource(ref),
-		"k8s.io/api/core/v1.EnvVar":                                                                                      schema_k8sio_api_core_v1_EnvVar(ref),
-		"k8s.io/api/core/v1.EnvVarSource":                                                                                schema_k8sio_api_core_v1_EnvVarSource(ref),
-		"k8s.io/api/core/v1.Event":                                                                                       schema_k8sio_api_core_v1_Event(ref),
-		"k8s.io/api/core/v1.EventList":                                                                                   schema_k8sio_api_core_v1_EventList(ref),
-		"k8s.io/api/core/v1.EventSeries":                                                                                 schema_k8sio_api_core_v1_EventSeries(ref),
-		"k8s.io/api/core/v1.EventSource":                                                                                 schema_k8sio_api_core_v1_EventSource(ref),
-		"k8s.io/api/core/v1.ExecAction":                                                                                  schema_k8sio_api_core_v1_ExecAction(ref),
-		"k8s.io/api/core/v1.FCVolumeSource":                                                                              schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                                                  schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexVolumeSource":                                                                            schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		"k8s.io/api/core/v1.FlockerVolumeSource":                                                                         schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                                               schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                                         schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                                                       schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		"k8s.io/api/core/v1.HTTPGetAction":                                                                               schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		"k8s.io/api/core/v1.HTTPHeader":                                                                                  schema_k8sio_api_core_v1_HTTPHeader(ref),
-		"k8s.io/api/core/v1.Handler":                                                                                     schema_k8sio_api_core_v1_Handler(ref),
-		"k8s.io/api/core/v1.HostAlias":                                                                                   schema_k8sio_api_core_v1_HostAlias(ref),
-		"k8s.io/api/core/v1.HostPathVolumeSource":                                                                        schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                                                 schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                                           schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		"k8s.io/api/core/v1.KeyToPath":                                                                                   schema_k8sio_api_core_v1_KeyToPath(ref),
-		"k8s.io/api/core/v1.Lifecycle":                                                                                   schema_k8sio_api_core_v1_Lifecycle(ref),
-		"k8s.io/api/core/v1.LimitRange":                                                                                  schema_k8sio_api_core_v1_LimitRange(ref),
-		"k8s.io/api/core/v1.LimitRangeItem":                                                                              schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		"k8s.io/api/core/v1.LimitRangeList":                                                                              schema_k8sio_api_core_v1_LimitRangeList(ref),
-		"k8s.io/api/core/v1.LimitRangeSpec":                                                                              schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		"k8s.io/api/core/v1.List":                                                                                        schema_k8sio_api_core_v1_List(ref),
-		"k8s.io/api/core/v1.LoadBalancerIngress":                                                                         schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		"k8s.io/api/core/v1.LoadBalancerStatus":                                                                          schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		"k8s.io/api/core/v1.LocalObjectReference":                                                                        schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		"k8s.io/api/core/v1.LocalVolumeSource":                                                                           schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		"k8s.io/api/core/v1.NFSVolumeSource":                                                                             schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		"k8s.io/api/core/v1.Namespace":                                                                                   schema_k8sio_api_core_v1_Namespace(ref),
-		"k8s.io/api/core/v1.NamespaceList":                                                                               schema_k8sio_api_core_v1_NamespaceList(ref),
-		"k8s.io/api/core/v1.NamespaceSpec":                                                                               schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		"k8s.io/api/core/v1.NamespaceStatus":                                                                             schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		"k8s.io/api/core/v1.Node":                                                                                        schema_k8sio_api_core_v1_Node(ref),
-		"k8s.io/api/core/v1.NodeAddress":                                                                                 schema_k8sio_api_core_v1_NodeAddress(ref),
-		"k8s.io/api/core/v1.NodeAffinity":                                                                                schema_k8sio_api_core_v1_NodeAffinity(ref),
-		"k8s.io/api/core/v1.NodeCondition":                                                                               schema_k8sio_api_core_v1_NodeCondition(ref),
-		"k8s.io/api/core/v1.NodeConfigSource":                                                                            schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		"k8s.io/api/core/v1.NodeConfigStatus":                                                                            schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                                         schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		"k8s.io/api/core/v1.NodeList":                                                                                    schema_k8sio_api_core_v1_NodeList(ref),
-		"k8s.io/api/core/v1.NodeProxyOptions":                                                                            schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		"k8s.io/api/core/v1.NodeResources":                                                                               schema_k8sio_api_core_v1_NodeResources(ref),
-		"k8s.io/api/core/v1.NodeSelector":                                                                                schema_k8sio_api_core_v1_NodeSelector(ref),
-		"k8s.io/api/core/v1.NodeSelectorRequirement":                                                                     schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		"k8s.io/api/core/v1.NodeSelectorTerm":                                                                            schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		"k8s.io/api/core/v1.NodeSpec":                                                                                    schema_k8sio_api_core_v1_NodeSpec(ref),
-		"k8s.io/api/core/v1.NodeStatus":                                                                                  schema_k8sio_api_core_v1_NodeStatus(ref),
-		"k8s.io/api/core/v1.NodeSystemInfo":                                                                              schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		"k8s.io/api/core/v1.ObjectFieldSelector":                                                                         schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		"k8s.io/api/core/v1.ObjectReference":                                                                             schema_k8sio_api_core_v1_ObjectReference(ref),
-		"k8s.io/api/core/v1.PersistentVolume":                                                                            schema_k8sio_api_core_v1_PersistentVolume(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaim":                                                                       schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                                              schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                                                   schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                                                   schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                                                 schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                                           schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeList":                                                                        schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSource":                                                                      schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                                        schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                                      schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                                            schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                                                         schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                                                 schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                                             schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                                             schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                                            schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                                                schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                                                schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                                                          schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                                              schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodList":                                                                                     schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                                               schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                                                       schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                                             schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                                            schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                                                          schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                                                schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                                                     schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                                                   schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                                             schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                                                 schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                                             schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                                             schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                                                        schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                                        schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                                                     schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                                                       schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                                       schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                                         schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                                                   schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                                             schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                                             schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                                                       schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                                              schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                                                   schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                                                   schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                                                 schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                                                       schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                                               schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                                                           schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                                           schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                                         schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                                                        schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                                              schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                                               schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                                         schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                                               schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                                           schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.Secret":                                                                                      schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                                             schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                                                           schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                                                  schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                                            schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                                             schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                                                          schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                                             schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                                                         schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                                                     schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                                              schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                                                          schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                                               schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                                                 schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                                                 schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                                                         schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                                                 schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                                               schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                                                       schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                                             schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                                       schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                                                      schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                                             schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                                                       schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                                                  schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                                            schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                                                        schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.Volume":                                                                                      schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                                                schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                                                 schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                                          schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                                            schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                                                schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                                              schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                                                     schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                                                  schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                                               schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                                                  schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                                              schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                                               schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                                           schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                                               schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                                             schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                                                  schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                                             schema_pkg_apis_meta_v1_ExportOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                                                schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                                                 schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                                             schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                                              schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                                                  schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                                          schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                                      schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                                               schema_pkg_apis_meta_v1_Initializer(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                                              schema_pkg_apis_meta_v1_Initializers(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                                             schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                                             schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                                                  schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                                      schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                                                  schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                                               schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                                                 schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                                                schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                                            schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                                     schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                                             schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                                                 schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                                                 schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                                    schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                                               schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                                             schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                                      schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                                                 schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                                                  schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                                                schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                                                   schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                                       schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                                        schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                                                           schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeyTransform":                   schema_pkg_apis_servicecatalog_v1beta1_AddKeyTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform":              schema_pkg_apis_servicecatalog_v1beta1_AddKeysFromTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AdminUnbindRequest":                schema_pkg_apis_servicecatalog_v1beta1_AdminUnbindRequest(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AppReference":                      schema_pkg_apis_servicecatalog_v1beta1_AppReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AutoBind":                          schema_pkg_apis_servicecatalog_v1beta1_AutoBind(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig":                   schema_pkg_apis_servicecatalog_v1beta1_BasicAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig":             schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerCatalog":                     schema_pkg_apis_servicecatalog_v1beta1_BrokerCatalog(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerHeaderValue":                 schema_pkg_apis_servicecatalog_v1beta1_BrokerHeaderValue(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerSecretKeyReference":          schema_pkg_apis_servicecatalog_v1beta1_BrokerSecretKeyReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerTemplate":                    schema_pkg_apis_servicecatalog_v1beta1_BrokerTemplate(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerTemplateList":                schema_pkg_apis_servicecatalog_v1beta1_BrokerTemplateList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerTemplateSpec":                schema_pkg_apis_servicecatalog_v1beta1_BrokerTemplateSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogProjection":                 schema_pkg_apis_servicecatalog_v1beta1_CatalogProjection(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogProjectionList":             schema_pkg_apis_servicecatalog_v1beta1_CatalogProjectionList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogProjectionSpec":             schema_pkg_apis_servicecatalog_v1beta1_CatalogProjectionSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions":               schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBasicAuthConfig":            schema_pkg_apis_servicecatalog_v1beta1_ClusterBasicAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig":      schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference":            schema_pkg_apis_servicecatalog_v1beta1_ClusterObjectReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBroker":              schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBroker(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo":      schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerAuthInfo(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerList":          schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerSpec":          schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerStatus":        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClass":               schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClass(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassList":           schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassSpec":           schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassStatus":         schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlan":                schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlan(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanList":            schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanSpec":            schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanStatus":          schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerSpec":           schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerStatus":         schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassSpec":            schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassStatus":          schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanSpec":             schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanStatus":           schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ConfigMapKeyReference":             schema_pkg_apis_servicecatalog_v1beta1_ConfigMapKeyReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ForceDeleteRequest":                schema_pkg_apis_servicecatalog_v1beta1_ForceDeleteRequest(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.HTTPSParametersSource":             schema_pkg_apis_servicecatalog_v1beta1_HTTPSParametersSource(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference":              schema_pkg_apis_servicecatalog_v1beta1_LocalObjectReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ObjectReference":                   schema_pkg_apis_servicecatalog_v1beta1_ObjectReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersDiff":                    schema_pkg_apis_servicecatalog_v1beta1_ParametersDiff(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource":              schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.PlanReference":                     schema_pkg_apis_servicecatalog_v1beta1_PlanReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RelistRequest":                     schema_pkg_apis_servicecatalog_v1beta1_RelistRequest(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform":                schema_pkg_apis_servicecatalog_v1beta1_RemoveKeyTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RenameKeyTransform":                schema_pkg_apis_servicecatalog_v1beta1_RenameKeyTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RollbackRequest":                   schema_pkg_apis_servicecatalog_v1beta1_RollbackRequest(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference":                schema_pkg_apis_servicecatalog_v1beta1_SecretKeyReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform":                   schema_pkg_apis_servicecatalog_v1beta1_SecretTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBinding":                    schema_pkg_apis_servicecatalog_v1beta1_ServiceBinding(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition":           schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingCondition(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingList":                schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState":     schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingPropertiesState(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingSpec":                schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingStatus":              schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBroker":                     schema_pkg_apis_servicecatalog_v1beta1_ServiceBroker(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo":             schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerAuthInfo(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition":            schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerCondition(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerList":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSpec":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerStatus":               schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSummary":              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSummary(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSummaryEntry":         schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSummaryEntry(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSummaryList":          schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSummaryList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClass":                      schema_pkg_apis_servicecatalog_v1beta1_ServiceClass(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassList":                  schema_pkg_apis_servicecatalog_v1beta1_ServiceClassList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassSpec":                  schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassStatus":                schema_pkg_apis_servicecatalog_v1beta1_ServiceClassStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstance":                   schema_pkg_apis_servicecatalog_v1beta1_ServiceInstance(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition":          schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceCondition(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceList":               schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationRecord":    schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceOperationRecord(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperations":         schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceOperations(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesSnapshot": schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesSnapshot(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState":    schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceSpec":               schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceStatus":             schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlan":                       schema_pkg_apis_servicecatalog_v1beta1_ServicePlan(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanList":                   schema_pkg_apis_servicecatalog_v1beta1_ServicePlanList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanSpec":                   schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanStatus":                 schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo":                          schema_pkg_apis_servicecatalog_v1beta1_UserInfo(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPreset":                              schema_pkg_apis_settings_v1alpha1_PodPreset(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPresetList":                          schema_pkg_apis_settings_v1alpha1_PodPresetList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPresetSpec":                          schema_pkg_apis_settings_v1alpha1_PodPresetSpec(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                               schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                                                                       schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                                                                                 schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                                                                      schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                                          schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                                                schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                                          schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"k8s.io/api/core/v1.Binding":                                                                                        schema_k8sio_api_core_v1_Binding(ref),
+		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                                                      schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.Capabilities":                                                                                   schema_k8sio_api_core_v1_Capabilities(ref),
+		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                                                   schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CephFSVolumeSource":                                                                             schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                                                   schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderVolumeSource":                                                                             schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		"k8s.io/api/core/v1.ClientIPConfig":                                                                                 schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		"k8s.io/api/core/v1.ComponentCondition":                                                                             schema_k8sio_api_core_v1_ComponentCondition(ref),
+		"k8s.io/api/core/v1.ComponentStatus":                                                                                schema_k8sio_api_core_v1_ComponentStatus(ref),
+		"k8s.io/api/core/v1.ComponentStatusList":                                                                            schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		"k8s.io/api/core/v1.ConfigMap":                                                                                      schema_k8sio_api_core_v1_ConfigMap(ref),
+		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                                             schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		"k8s.io/api/core/v1.ConfigMapKeySelector":                                                                           schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		"k8s.io/api/core/v1.ConfigMapList":                                                                                  schema_k8sio_api_core_v1_ConfigMapList(ref),
+		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                                                      schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		"k8s.io/api/core/v1.ConfigMapProjection":                                                                            schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                                                          schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		"k8s.io/api/core/v1.Container":                                                                                      schema_k8sio_api_core_v1_Container(ref),
+		"k8s.io/api/core/v1.ContainerImage":                                                                                 schema_k8sio_api_core_v1_ContainerImage(ref),
+		"k8s.io/api/core/v1.ContainerPort":                                                                                  schema_k8sio_api_core_v1_ContainerPort(ref),
+		"k8s.io/api/core/v1.ContainerState":                                                                                 schema_k8sio_api_core_v1_ContainerState(ref),
+		"k8s.io/api/core/v1.ContainerStateRunning":                                                                          schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		"k8s.io/api/core/v1.ContainerStateTerminated":                                                                       schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		"k8s.io/api/core/v1.ContainerStateWaiting":                                                                          schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		"k8s.io/api/core/v1.ContainerStatus":                                                                                schema_k8sio_api_core_v1_ContainerStatus(ref),
+		"k8s.io/api/core/v1.DaemonEndpoint":                                                                                 schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		"k8s.io/api/core/v1.DownwardAPIProjection":                                                                          schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                                                          schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                                                        schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                                                           schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		"k8s.io/api/core/v1.EndpointAddress":                                                                                schema_k8sio_api_core_v1_EndpointAddress(ref),
+		"k8s.io/api/core/v1.EndpointPort":                                                                                   schema_k8sio_api_core_v1_EndpointPort(ref),
+		"k8s.io/api/core/v1.EndpointSubset":                                                                                 schema_k8sio_api_core_v1_EndpointSubset(ref),
+		"k8s.io/api/core/v1.Endpoints":                                                                                      schema_k8sio_api_core_v1_Endpoints(ref),
+		"k8s.io/api/core/v1.EndpointsList":                                                                                  schema_k8sio_api_core_v1_EndpointsList(ref),
+		"k8s.io/api/core/v1.EnvFromSource":                                                                                  schema_k8sio_api_core_v1_EnvFromSource(ref),
+		"k8s.io/api/core/v1.EnvVar":                                                                                         schema_k8sio_api_core_v1_EnvVar(ref),
+		"k8s.io/api/core/v1.EnvVarSource":                                                                                   schema_k8sio_api_core_v1_EnvVarSource(ref),
+		"k8s.io/api/core/v1.Event":                                                                                          schema_k8sio_api_core_v1_Event(ref),
+		"k8s.io/api/core/v1.EventList":                                                                                      schema_k8sio_api_core_v1_EventList(ref),
+		"k8s.io/api/core/v1.EventSeries":                                                                                    schema_k8sio_api_core_v1_EventSeries(ref),
+		"k8s.io/api/core/v1.EventSource":                                                                                    schema_k8sio_api_core_v1_EventSource(ref),
+		"k8s.io/api/core/v1.ExecAction":                                                                                     schema_k8sio_api_core_v1_ExecAction(ref),
+		"k8s.io/api/core/v1.FCVolumeSource":                                                                                 schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                                                     schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexVolumeSource":                                                                               schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		"k8s.io/api/core/v1.FlockerVolumeSource":                                                                            schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                                                  schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                                            schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                                                          schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		"k8s.io/api/core/v1.HTTPGetAction":                                                                                  schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		"k8s.io/api/core/v1.HTTPHeader":                                                                                     schema_k8sio_api_core_v1_HTTPHeader(ref),
+		"k8s.io/api/core/v1.Handler":                                                                                        schema_k8sio_api_core_v1_Handler(ref),
+		"k8s.io/api/core/v1.HostAlias":                                                                                      schema_k8sio_api_core_v1_HostAlias(ref),
+		"k8s.io/api/core/v1.HostPathVolumeSource":                                                                           schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                                                    schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                                              schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		"k8s.io/api/core/v1.KeyToPath":                                                                                      schema_k8sio_api_core_v1_KeyToPath(ref),
+		"k8s.io/api/core/v1.Lifecycle":                                                                                      schema_k8sio_api_core_v1_Lifecycle(ref),
+		"k8s.io/api/core/v1.LimitRange":                                                                                     schema_k8sio_api_core_v1_LimitRange(ref),
+		"k8s.io/api/core/v1.LimitRangeItem":                                                                                 schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		"k8s.io/api/core/v1.LimitRangeList":                                                                                 schema_k8sio_api_core_v1_LimitRangeList(ref),
+		"k8s.io/api/core/v1.LimitRangeSpec":                                                                                 schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		"k8s.io/api/core/v1.List":                                                                                           schema_k8sio_api_core_v1_List(ref),
+		"k8s.io/api/core/v1.LoadBalancerIngress":                                                                            schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		"k8s.io/api/core/v1.LoadBalancerStatus":                                                                             schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		"k8s.io/api/core/v1.LocalObjectReference":                                                                           schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		"k8s.io/api/core/v1.LocalVolumeSource":                                                                              schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		"k8s.io/api/core/v1.NFSVolumeSource":                                                                                schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		"k8s.io/api/core/v1.Namespace":                                                                                      schema_k8sio_api_core_v1_Namespace(ref),
+		"k8s.io/api/core/v1.NamespaceList":                                                                                  schema_k8sio_api_core_v1_NamespaceList(ref),
+		"k8s.io/api/core/v1.NamespaceSpec":                                                                                  schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		"k8s.io/api/core/v1.NamespaceStatus":                                                                                schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		"k8s.io/api/core/v1.Node":                                                                                           schema_k8sio_api_core_v1_Node(ref),
+		"k8s.io/api/core/v1.NodeAddress":                                                                                    schema_k8sio_api_core_v1_NodeAddress(ref),
+		"k8s.io/api/core/v1.NodeAffinity":                                                                                   schema_k8sio_api_core_v1_NodeAffinity(ref),
+		"k8s.io/api/core/v1.NodeCondition":                                                                                  schema_k8sio_api_core_v1_NodeCondition(ref),
+		"k8s.io/api/core/v1.NodeConfigSource":                                                                               schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		"k8s.io/api/core/v1.NodeConfigStatus":                                                                               schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                                            schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		"k8s.io/api/core/v1.NodeList":                                                                                       schema_k8sio_api_core_v1_NodeList(ref),
+		"k8s.io/api/core/v1.NodeProxyOptions":                                                                               schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		"k8s.io/api/core/v1.NodeResources":                                                                                  schema_k8sio_api_core_v1_NodeResources(ref),
+		"k8s.io/api/core/v1.NodeSelector":                                                                                   schema_k8sio_api_core_v1_NodeSelector(ref),
+		"k8s.io/api/core/v1.NodeSelectorRequirement":                                                                        schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		"k8s.io/api/core/v1.NodeSelectorTerm":                                                                               schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		"k8s.io/api/core/v1.NodeSpec":                                                                                       schema_k8sio_api_core_v1_NodeSpec(ref),
+		"k8s.io/api/core/v1.NodeStatus":                                                                                     schema_k8sio_api_core_v1_NodeStatus(ref),
+		"k8s.io/api/core/v1.NodeSystemInfo":                                                                                 schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		"k8s.io/api/core/v1.ObjectFieldSelector":                                                                            schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		"k8s.io/api/core/v1.ObjectReference":                                                                                schema_k8sio_api_core_v1_ObjectReference(ref),
+		"k8s.io/api/core/v1.PersistentVolume":                                                                               schema_k8sio_api_core_v1_PersistentVolume(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaim":                                                                          schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                                                 schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                                                      schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                                                      schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                                                    schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                                              schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeList":                                                                           schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSource":                                                                         schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                                           schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                                         schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                                               schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.Pod":                                                                                            schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                                                                    schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                                                                                schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                                                                                schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                                                                               schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCondition":                                                                                   schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                                                                   schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                                                                             schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                                                                                 schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodList":                                                                                        schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                                                                                  schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                                                                          schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                                                                                schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                                                                               schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                                                                             schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                                                                   schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                                                                        schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                                                                      schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                                                                                schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                                                                    schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                                                                                schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                                                                                schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                                                                           schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                                           schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                                                        schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                                                                          schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                                          schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                                            schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                                                      schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                                                                                schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                                                                                schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                                                                          schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":                                                                 schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                                                                      schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                                                                      schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                                                                    schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                                                                          schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                                                                                  schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                                                                              schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                                              schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                                            schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                                                                           schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                                                                                 schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                                                  schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                                            schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                                                                                  schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                                              schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.Secret":                                                                                         schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                                                                                schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                                                                              schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                                                                     schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                                                                               schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                                                                                schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                                                                             schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                                                                                schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                                                                            schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                                                                        schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                                                                                 schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                                                                             schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                                                  schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                                                                    schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                                                                    schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                                                                            schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                                                                    schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                                                                                  schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                                                                          schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                                                schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                                          schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                                                                         schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                                                                                schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                                                                          schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                                                                     schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                                               schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                                                                           schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.Volume":                                                                                         schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                                                                   schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                                                                    schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                                             schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                                                                               schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                                                                   schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                                                 schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                                                        schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                                                     schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                                                  schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                                                     schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                                                 schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                                                  schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                                              schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                                                  schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                                                schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                                                     schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                                                schema_pkg_apis_meta_v1_ExportOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                                                   schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                                                    schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                                                schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                                                 schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                                                     schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                                             schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                                         schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                                                  schema_pkg_apis_meta_v1_Initializer(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                                                 schema_pkg_apis_meta_v1_Initializers(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                                                schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                                                schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                                                     schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                                         schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                                                     schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                                                  schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                                                    schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                                                   schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                                               schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                                        schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                                                schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                                                    schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                                                    schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                                       schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                                                  schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                                                schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                                         schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                                                    schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                                                     schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                                                   schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                                                      schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                                          schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                                           schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                                              schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -397,6 +421,116 @@ func schema_pkg_apis_servicecatalog_v1beta1_AddKeysFromTransform(ref common.Refe
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_AdminUnbindRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdminUnbindRequest is posted to the adminunbind subresource of a ServiceBinding that is being deleted, to remove the finalizers that keep it waiting to be unbound at the broker, for instance when the broker is permanently gone. The abandonment is recorded as an event of the binding; its credentials are left untouched at the broker.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"confirm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Confirm must be set to the name of the binding being abandoned.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is an explanation of why the binding is being abandoned. It is recorded in the event of the abandonment and in the audit log of the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"confirm"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_AppReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AppReference identifies the workload that consumes the credentials of a ServiceBinding.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the workload, either Deployment or StatefulSet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the workload.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_AutoBind(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutoBind describes the ServiceBinding that the controller creates for a ServiceInstance once it has been provisioned.",
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the secret to create in the namespace of the instance that will hold the credentials of the binding. Defaults to the name of the instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is a set of the parameters to be passed to the broker when binding, like the Parameters of a ServiceBinding.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"parametersFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParametersFrom is a list of sources to populate the parameters of the binding, like the ParametersFrom of a ServiceBinding.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_BasicAuthConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -409,6 +543,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_BasicAuthConfig(ref common.Reference
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference"),
 						},
 					},
+					"secretNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretNamespace is the namespace of the Secret referenced by SecretRef. Defaults to the namespace of the ServiceBroker. A Secret in another namespace may only be referenced by users that are allowed to get it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -429,6 +570,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref common.Ref
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference"),
 						},
 					},
+					"secretNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretNamespace is the namespace of the Secret referenced by SecretRef. Defaults to the namespace of the ServiceBroker. A Secret in another namespace may only be referenced by users that are allowed to get it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -437,6 +585,347 @@ func schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_BrokerCatalog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BrokerCatalog is the catalog last fetched from a broker, as served by the catalog subresource of ClusterServiceBrokers and ServiceBrokers. It is stored apart from the broker, so that a large catalog does not grow every read and write of the broker.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name and namespace are those of the broker.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"retrievalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetrievalTime is when the catalog was fetched from the broker.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"catalog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Catalog is the catalog response of the broker, before it was filtered by the catalog restrictions of the broker, mutated by the catalog webhook or converted into classes and plans. It holds the fields that the controller decodes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"retrievalTime", "catalog"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_BrokerHeaderValue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BrokerHeaderValue is the value of a custom header sent to a broker. Exactly one of Value and SecretKeyRef must be set.",
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKeyRef references the key of a Secret that holds the value of the header, for values that must be kept secret.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerSecretKeyReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerSecretKeyReference"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_BrokerSecretKeyReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BrokerSecretKeyReference references a key of a Secret used to communicate with a broker.",
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the Secret. It is required for a ClusterServiceBroker, and defaults to the namespace of a ServiceBroker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key of the Secret to select from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "key"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_BrokerTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BrokerTemplate creates a ServiceBroker in every namespace that matches its namespace selector, for platforms that give each tenant its own broker endpoint.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Non-namespaced.  The name of this resource in etcd is in ObjectMeta.Name. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the ServiceBrokers created from the template.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerTemplateSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_BrokerTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BrokerTemplateList is a list of BrokerTemplates.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_BrokerTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BrokerTemplateSpec represents a description of a BrokerTemplate.",
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces in which a ServiceBroker is created. An empty selector matches every namespace.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"brokerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BrokerName is the name of the ServiceBrokers created from the template. Defaults to the name of the template.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the spec of the ServiceBrokers created from the template. Every occurrence of $(namespace) in the URL and in the name and namespace of the auth secret is replaced with the name of the namespace the ServiceBroker is created in.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSpec"),
+						},
+					},
+				},
+				Required: []string{"template"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_CatalogProjection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CatalogProjection copies the ClusterServiceClasses and ClusterServicePlans it selects into every namespace that matches its namespace selector, as ServiceClasses and ServicePlans, for tools that only read namespaced resources.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Non-namespaced.  The name of this resource in etcd is in ObjectMeta.Name. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the classes and plans that are projected, and where.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogProjectionSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogProjectionSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_CatalogProjectionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CatalogProjectionList is a list of CatalogProjections.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogProjection"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogProjection", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_CatalogProjectionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CatalogProjectionSpec represents a description of a CatalogProjection.",
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces the classes and plans are projected into. An empty selector matches every namespace.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"catalogRestrictions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRestrictions selects the ClusterServiceClasses and ClusterServicePlans that are projected, in the same way as the catalog restrictions of a ClusterServiceBroker. Every class and plan is projected if it is empty.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -471,6 +960,34 @@ func schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref common.Refer
 							},
 						},
 					},
+					"serviceClassExternalIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceClassExternalIDs is an allow-list of the external IDs of the services of the broker's catalog to create classes for. External IDs are stable across renames of the services by the broker. An empty list allows every service.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"servicePlanExternalIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServicePlanExternalIDs is an allow-list of the external IDs of the plans of the broker's catalog to create plans for. An empty list allows every plan.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -703,7 +1220,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref common.
 					},
 					"relistRequests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RelistRequests is a strictly increasing, non-negative integer counter that can be manually incremented by a user to manually trigger a relist.",
+							Description: "RelistRequests is a strictly increasing, non-negative integer counter that can be manually incremented by a user to manually trigger a relist.\n\nDeprecated: set the servicecatalog.k8s.io/relist-requested-at annotation to the current time instead, which does not conflict with other changes to the spec. The value a relist honored is recorded in status.observedRelistRequestedAt.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
@@ -714,6 +1231,66 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref common.
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions"),
 						},
 					},
+					"catalogRemovalPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalPolicy specifies what the controller does with the ServiceInstances of a class or plan that has been removed from the broker's catalog. Defaults to CatalogRemovalPolicyKeep.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catalogRemovalDeprovisionDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalDeprovisionDelay is how long the controller waits after a class or plan was removed from the broker's catalog before deprovisioning its ServiceInstances when the CatalogRemovalPolicy is set to CatalogRemovalPolicyScheduledDeprovision.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"catalogRemovalGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalGracePeriod is how long the controller keeps the classes and plans that were removed from the broker's catalog, or that belong to a broker being deleted, before deleting them. During the grace period they are marked as pending removal. If unset, they are deleted as soon as they are no longer in use.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"mirrorURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorURL is the address of a second broker to which a copy of each provision request sent to this broker is also sent, for example to test a replacement broker with real traffic before switching over to it. The copies are sent in the background with the same credentials and TLS settings as the requests to this broker, and the responses of the mirror are only logged: they never affect the instances.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failoverURLs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailoverURLs are the addresses of standby endpoints of the same broker, such as its deployments in other regions, which serve the same catalog and instances as URL. When an endpoint cannot be reached or answers that it is unavailable, requests are sent to the next one, in the order URL, then FailoverURLs, and the endpoint is skipped for a while.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"readOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadOnly marks the broker as deprecated, for example while it is being decommissioned. New ServiceInstances of its classes are rejected, while the existing ones keep working and can be unbound and deprovisioned as usual.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"customHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomHeaders are HTTP headers sent on every request to the broker, for example the tenant or routing headers required by some brokers. The keys are the names of the headers, which must not be the name of a header set by the controller itself, such as Authorization or X-Broker-API-Version.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerHeaderValue"),
+									},
+								},
+							},
+						},
+					},
 					"authInfo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "AuthInfo contains the data that the service catalog should use to authenticate with the ClusterServiceBroker.",
@@ -725,7 +1302,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerHeaderValue", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -766,12 +1343,67 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"classCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassCount is the number of service classes that resulted from the last successful relist of the broker's catalog.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"planCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanCount is the number of service plans that resulted from the last successful relist of the broker's catalog.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastCatalogChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogChecksum is the checksum of the catalog payload returned by the broker on the last successful relist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API that was negotiated with the broker on the last successful relist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRelistDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRelistDuration is the time it took to fetch and reconcile the broker's catalog on the last successful relist.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"observedRelistRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedRelistRequests is the value of spec.relistRequests that the last successful relist honored.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"observedRelistRequestedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedRelistRequestedAt is the value of the servicecatalog.k8s.io/relist-requested-at annotation that the last successful relist honored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"asyncBindingOperationsRequired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AsyncBindingOperationsRequired is set once the broker has rejected a binding request without accepts_incomplete=true with the AsyncRequired error. The controller then sends accepts_incomplete=true with every binding request to the broker.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"conditions", "reconciledGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -906,9 +1538,16 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref common.R
 							Format:      "",
 						},
 					},
-					"bindingRetrievable": {
+					"bindingRetrievable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nBindingRetrievable indicates whether fetching a binding via a GET on its endpoint is supported for all plans.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"instancesRetrievable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nBindingRetrievable indicates whether fetching a binding via a GET on its endpoint is supported for all plans.",
+							Description: "InstancesRetrievable indicates whether fetching an instance via a GET on its endpoint is supported, which is required to adopt an existing instance of the class.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -926,6 +1565,20 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref common.R
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"documentationURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DocumentationURL is the link to the documentation of the service, as given by the documentationUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"supportURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SupportURL is the link to the support of the service, as given by the supportUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"tags": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nTags is a list of strings that represent different classification attributes of the ServiceClass.  These are used in Cloud Foundry in a way similar to Kubernetes labels, but they currently have no special meaning in Kubernetes.",
@@ -983,11 +1636,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassStatus(ref common
 							Format:      "",
 						},
 					},
+					"pendingRemoval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingRemoval indicates that the class will be deleted once the RemovalTime has passed, because it was removed from the broker's catalog or its broker is being deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"removalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovalTime is when the class will be deleted, if it is pending removal.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1135,6 +1802,20 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref common.Re
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"documentationURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DocumentationURL is the link to the documentation of the plan, as given by the documentationUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"supportURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SupportURL is the link to the support of the plan, as given by the supportUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"instanceCreateParameterSchema": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceInstanceCreateParameterSchema is the schema for the parameters that may be supplied when provisioning a new ServiceInstance on this plan.",
@@ -1194,11 +1875,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref common.
 							Format:      "",
 						},
 					},
+					"pendingRemoval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingRemoval indicates that the plan will be deleted once the RemovalTime has passed, because it was removed from the broker's catalog or its broker is being deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"removalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovalTime is when the plan will be deleted, if it is pending removal.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1244,7 +1939,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref common.R
 					},
 					"relistRequests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RelistRequests is a strictly increasing, non-negative integer counter that can be manually incremented by a user to manually trigger a relist.",
+							Description: "RelistRequests is a strictly increasing, non-negative integer counter that can be manually incremented by a user to manually trigger a relist.\n\nDeprecated: set the servicecatalog.k8s.io/relist-requested-at annotation to the current time instead, which does not conflict with other changes to the spec. The value a relist honored is recorded in status.observedRelistRequestedAt.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
@@ -1255,12 +1950,72 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref common.R
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions"),
 						},
 					},
+					"catalogRemovalPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalPolicy specifies what the controller does with the ServiceInstances of a class or plan that has been removed from the broker's catalog. Defaults to CatalogRemovalPolicyKeep.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catalogRemovalDeprovisionDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalDeprovisionDelay is how long the controller waits after a class or plan was removed from the broker's catalog before deprovisioning its ServiceInstances when the CatalogRemovalPolicy is set to CatalogRemovalPolicyScheduledDeprovision.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"catalogRemovalGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalGracePeriod is how long the controller keeps the classes and plans that were removed from the broker's catalog, or that belong to a broker being deleted, before deleting them. During the grace period they are marked as pending removal. If unset, they are deleted as soon as they are no longer in use.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"mirrorURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorURL is the address of a second broker to which a copy of each provision request sent to this broker is also sent, for example to test a replacement broker with real traffic before switching over to it. The copies are sent in the background with the same credentials and TLS settings as the requests to this broker, and the responses of the mirror are only logged: they never affect the instances.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failoverURLs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailoverURLs are the addresses of standby endpoints of the same broker, such as its deployments in other regions, which serve the same catalog and instances as URL. When an endpoint cannot be reached or answers that it is unavailable, requests are sent to the next one, in the order URL, then FailoverURLs, and the endpoint is skipped for a while.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"readOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadOnly marks the broker as deprecated, for example while it is being decommissioned. New ServiceInstances of its classes are rejected, while the existing ones keep working and can be unbound and deprovisioned as usual.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"customHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomHeaders are HTTP headers sent on every request to the broker, for example the tenant or routing headers required by some brokers. The keys are the names of the headers, which must not be the name of a header set by the controller itself, such as Authorization or X-Broker-API-Version.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerHeaderValue"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerHeaderValue", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1301,12 +2056,67 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref common
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"classCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassCount is the number of service classes that resulted from the last successful relist of the broker's catalog.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"planCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanCount is the number of service plans that resulted from the last successful relist of the broker's catalog.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastCatalogChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogChecksum is the checksum of the catalog payload returned by the broker on the last successful relist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API that was negotiated with the broker on the last successful relist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRelistDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRelistDuration is the time it took to fetch and reconcile the broker's catalog on the last successful relist.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"observedRelistRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedRelistRequests is the value of spec.relistRequests that the last successful relist honored.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"observedRelistRequestedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedRelistRequestedAt is the value of the servicecatalog.k8s.io/relist-requested-at annotation that the last successful relist honored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"asyncBindingOperationsRequired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AsyncBindingOperationsRequired is set once the broker has rejected a binding request without accepts_incomplete=true with the AsyncRequired error. The controller then sends accepts_incomplete=true with every binding request to the broker.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"conditions", "reconciledGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1351,6 +2161,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref common.Re
 							Format:      "",
 						},
 					},
+					"instancesRetrievable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstancesRetrievable indicates whether fetching an instance via a GET on its endpoint is supported, which is required to adopt an existing instance of the class.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"planUpdatable": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PlanUpdatable indicates whether instances provisioned from this ServiceClass may change ServicePlans after being provisioned.",
@@ -1364,6 +2181,20 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref common.Re
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"documentationURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DocumentationURL is the link to the documentation of the service, as given by the documentationUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"supportURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SupportURL is the link to the support of the service, as given by the supportUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"tags": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nTags is a list of strings that represent different classification attributes of the ServiceClass.  These are used in Cloud Foundry in a way similar to Kubernetes labels, but they currently have no special meaning in Kubernetes.",
@@ -1414,11 +2245,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassStatus(ref common.
 							Format:      "",
 						},
 					},
+					"pendingRemoval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingRemoval indicates that the class will be deleted once the RemovalTime has passed, because it was removed from the broker's catalog or its broker is being deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"removalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovalTime is when the class will be deleted, if it is pending removal.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1451,75 +2296,208 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanSpec(ref common.Ref
 					},
 					"bindable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Bindable indicates whether a user can create bindings to an ServiceInstance using this ServicePlan.  If set, overrides the value of the corresponding ServiceClassSpec Bindable field.",
-							Type:        []string{"boolean"},
+							Description: "Bindable indicates whether a user can create bindings to an ServiceInstance using this ServicePlan.  If set, overrides the value of the corresponding ServiceClassSpec Bindable field.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"free": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Free indicates whether this plan is available at no cost.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"externalMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalMetadata is a blob of information about the plan, meant to be user-facing content and display instructions.  This field may contain platform-specific conventional values.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"documentationURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DocumentationURL is the link to the documentation of the plan, as given by the documentationUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"supportURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SupportURL is the link to the support of the plan, as given by the supportUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"instanceCreateParameterSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceInstanceCreateParameterSchema is the schema for the parameters that may be supplied when provisioning a new ServiceInstance on this plan.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"instanceUpdateParameterSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceInstanceUpdateParameterSchema is the schema for the parameters that may be updated once an ServiceInstance has been provisioned on this plan. This field only has meaning if the corresponding ServiceClassSpec is PlanUpdatable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"serviceBindingCreateParameterSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceBindingCreateParameterSchema is the schema for the parameters that may be supplied binding to a ServiceInstance on this plan.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"serviceBindingCreateResponseSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.when a bind operation stored in the Secret when binding to a ServiceInstance on this plan. The ResponseSchema feature gate needs to be enabled for this field to be populated.\n\nServiceBindingCreateResponseSchema is the schema for the response that will be returned by the broker when binding to a ServiceInstance on this plan. The schema also contains the sub-schema for the credentials part of the broker's response, which allows clients to see what the credentials will look like even before the binding operation is performed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"externalName", "externalID", "description", "free"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CommonServicePlanStatus represents status information about a ClusterServicePlan or a ServicePlan.",
+				Properties: map[string]spec.Schema{
+					"removedFromBrokerCatalog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovedFromBrokerCatalog indicates that the broker removed the plan from its catalog.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"pendingRemoval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingRemoval indicates that the plan will be deleted once the RemovalTime has passed, because it was removed from the broker's catalog or its broker is being deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"removalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovalTime is when the plan will be deleted, if it is pending removal.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"removedFromBrokerCatalog"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ConfigMapKeyReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapKeyReference references a key of a ConfigMap.",
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the config map in the pod's namespace to select from.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"free": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Free indicates whether this plan is available at no cost.",
-							Type:        []string{"boolean"},
+							Description: "The key of the config map to select from.  Must be a valid config map key.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"externalMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExternalMetadata is a blob of information about the plan, meant to be user-facing content and display instructions.  This field may contain platform-specific conventional values.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"instanceCreateParameterSchema": {
+				},
+				Required: []string{"name", "key"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ForceDeleteRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ForceDeleteRequest is posted to the forcedelete subresource of a ServiceInstance or ServiceBinding to remove its finalizers and delete it without waiting for the broker. This is an escape hatch for cluster administrators; the broker-side resource is left untouched.",
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceInstanceCreateParameterSchema is the schema for the parameters that may be supplied when provisioning a new ServiceInstance on this plan.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"instanceUpdateParameterSchema": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceInstanceUpdateParameterSchema is the schema for the parameters that may be updated once an ServiceInstance has been provisioned on this plan. This field only has meaning if the corresponding ServiceClassSpec is PlanUpdatable.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceBindingCreateParameterSchema": {
+					"confirm": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceBindingCreateParameterSchema is the schema for the parameters that may be supplied binding to a ServiceInstance on this plan.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Confirm must be set to the name of the resource being deleted.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceBindingCreateResponseSchema": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.when a bind operation stored in the Secret when binding to a ServiceInstance on this plan. The ResponseSchema feature gate needs to be enabled for this field to be populated.\n\nServiceBindingCreateResponseSchema is the schema for the response that will be returned by the broker when binding to a ServiceInstance on this plan. The schema also contains the sub-schema for the credentials part of the broker's response, which allows clients to see what the credentials will look like even before the binding operation is performed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Reason is an explanation of why the resource is being force deleted. It is recorded in the audit log of the request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"externalName", "externalID", "description", "free"},
+				Required: []string{"confirm"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+		Dependencies: []string{},
 	}
 }
 
-func schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_servicecatalog_v1beta1_HTTPSParametersSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CommonServicePlanStatus represents status information about a ClusterServicePlan or a ServicePlan.",
+				Description: "HTTPSParametersSource references parameters served by an HTTPS endpoint. The controller fetches them with a GET request each time it sends the parameters to the broker, so they are never stored by the API server.",
 				Properties: map[string]spec.Schema{
-					"removedFromBrokerCatalog": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RemovedFromBrokerCatalog indicates that the broker removed the plan from its catalog.",
-							Type:        []string{"boolean"},
+							Description: "URL of the endpoint. The scheme must be https.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is a PEM encoded CA bundle which will be used to validate the certificate of the endpoint. If unspecified, the system trust roots are used.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"bearerTokenSecretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerTokenSecretKeyRef references the key of a Secret, in the namespace of the resource, that holds the bearer token sent to the endpoint in the Authorization header.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"),
+						},
+					},
 				},
-				Required: []string{"removedFromBrokerCatalog"},
+				Required: []string{"url"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"},
 	}
 }
 
@@ -1570,6 +2548,61 @@ func schema_pkg_apis_servicecatalog_v1beta1_ObjectReference(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_ParametersDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ParametersDiff lists the parameters that an update adds, changes and removes. Parameters are identified by their path in the parameters object, with the keys of nested objects separated by dots. Values are never recorded, so a diff does not expose secrets.",
+				Properties: map[string]spec.Schema{
+					"added": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Added are the parameters that the broker did not have.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"changed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changed are the parameters whose value is changed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"removed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Removed are the parameters that are no longer sent to the broker.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1582,11 +2615,23 @@ func schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref common.Refe
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"),
 						},
 					},
+					"configMapKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The ConfigMap key to select from. The value must be a JSON object.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ConfigMapKeyReference"),
+						},
+					},
+					"https": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The HTTPS endpoint to fetch from. The response body must be a JSON object.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.HTTPSParametersSource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ConfigMapKeyReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.HTTPSParametersSource", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"},
 	}
 }
 
@@ -1687,6 +2732,40 @@ func schema_pkg_apis_servicecatalog_v1beta1_PlanReference(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_RelistRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RelistRequest is posted to the relist subresource of a ClusterServiceBroker to increment its RelistRequests, so that the controller fetches its catalog again, without reading and writing its spec.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is an explanation of why the broker is being relisted. It is recorded in the audit log of the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_RemoveKeyTransform(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1736,6 +2815,40 @@ func schema_pkg_apis_servicecatalog_v1beta1_RenameKeyTransform(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_RollbackRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackRequest is posted to the rollback subresource of a ServiceInstance to revert its plan and parameters to those last accepted by the broker, as recorded in its ExternalProperties. The controller then sends an update request for them to the broker.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is an explanation of why the instance is being rolled back. It is recorded in the audit log of the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_SecretKeyReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1973,6 +3086,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingPropertiesState(ref co
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo"),
 						},
 					},
+					"appGUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppGUID is the app identifier that was sent to the broker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1993,6 +3113,12 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref common.Refere
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference"),
 						},
 					},
+					"instanceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceSelector selects the ServiceInstance this ServiceBinding is to by its labels instead of by name. When it is set and ServiceInstanceRef is not, the ServiceBindingInstanceSelector admission plugin sets ServiceInstanceRef to the only instance in the namespace of the ServiceBinding that it selects when the ServiceBinding is created. The binding then stays with that instance.\n\nImmutable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
 					"parameters": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Parameters is a set of the parameters to be passed to the underlying broker. The inline YAML/JSON payload to be translated into equivalent JSON object. If a top-level parameter name exists in multiples sources among `Parameters` and `ParametersFrom` fields, it is considered to be a user error in the specification.\n\nThe Parameters field is NOT secret or secured in any way and should NEVER be used to hold sensitive information. To set parameters that contain secret information, you should ALWAYS store that information in a Secret and use the ParametersFrom field.",
@@ -2032,6 +3158,19 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref common.Refere
 							},
 						},
 					},
+					"immutableSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nImmutableSecret makes the controller create the secret holding the credentials as an immutable Secret. When the credentials change, a new secret is created and the previous one is deleted, instead of the secret being updated. The name of the current secret is recorded in the SecretName of the status.\n\nImmutable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"appRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nAppRef is the workload, in the namespace of the ServiceBinding, that consumes the credentials of the binding. The UID of the workload is sent to the broker as the app identifier of the bind request, so that brokers can scope credentials to that workload. If it is not set, the UID of the namespace is sent.\n\nImmutable.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AppReference"),
+						},
+					},
 					"externalID": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalID is the identity of this object for use with the OSB API.\n\nImmutable.",
@@ -2045,12 +3184,19 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref common.Refere
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo"),
 						},
 					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy specifies whether the broker is asked to unbind the binding when it is deleted. Defaults to Delete. Set it to Abandon to remove the binding from Kubernetes without calling the broker. Unlike the rest of the spec, it may be changed after the binding is created, including while the binding is being deleted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"instanceRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AppReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -2093,41 +3239,93 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref common.Refe
 							Format:      "",
 						},
 					},
-					"reconciledGeneration": {
+					"reconciledGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconciledGeneration is the 'Generation' of the ServiceBindingSpec that was last processed by the controller. The reconciled generation is updated even if the controller failed to process the spec.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"operationStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationStartTime is the time at which the current operation began.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"inProgressProperties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InProgressProperties is the properties state of the ServiceBinding when a Bind is in progress. If the current operation is an Unbind, this will be nil.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState"),
+						},
+					},
+					"externalProperties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalProperties is the properties state of the ServiceBinding which the broker knows about.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState"),
+						},
+					},
+					"orphanMitigationInProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrphanMitigationInProgress is a flag that represents whether orphan mitigation is in progress.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"unbindStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnbindStatus describes what has been done to unbind the ServiceBinding.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failedUnbindAttempts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReconciledGeneration is the 'Generation' of the ServiceBindingSpec that was last processed by the controller. The reconciled generation is updated even if the controller failed to process the spec.",
+							Description: "FailedUnbindAttempts is the number of unbind requests for the ServiceBinding that have failed.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"operationStartTime": {
+					"bindStartedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OperationStartTime is the time at which the current operation began.",
+							Description: "BindStartedAt is the time at which the controller first sent a bind request for the ServiceBinding to the broker.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"inProgressProperties": {
+					"readyAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InProgressProperties is the properties state of the ServiceBinding when a Bind is in progress. If the current operation is an Unbind, this will be nil.",
-							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState"),
+							Description: "ReadyAt is the time at which the ServiceBinding was first successfully bound and had its credentials injected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"externalProperties": {
+					"lastPollTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExternalProperties is the properties state of the ServiceBinding which the broker knows about.",
-							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState"),
+							Description: "LastPollTime is the time at which the controller last recorded a poll of the broker for the status of an asynchronous operation. It is only refreshed periodically while the result of the poll stays the same.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"orphanMitigationInProgress": {
+					"lastPollResult": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OrphanMitigationInProgress is a flag that represents whether orphan mitigation is in progress.",
-							Type:        []string{"boolean"},
+							Description: "LastPollResult is a short description of the result of the last poll of the broker for the status of an asynchronous operation.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"unbindStatus": {
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UnbindStatus describes what has been done to unbind the ServiceBinding.",
+							Description: "SecretName is the name of the secret that holds the credentials of a ServiceBinding with ImmutableSecret. It is empty for other bindings, whose secret is always the one named in the spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"acceptedParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AcceptedParameters are the parameters of the ServiceBinding that the broker accepted, as echoed by the broker when the binding is fetched. A parameter that was sourced from a secret has the value \"<redacted>\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"credentialsChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsChecksum is the checksum of the credentials in the secret of the ServiceBinding, which is also set as the CredentialsChecksumAnnotation of the secret. It changes whenever the credentials do.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2137,7 +3335,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -2355,7 +3553,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref common.Referen
 					},
 					"relistRequests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RelistRequests is a strictly increasing, non-negative integer counter that can be manually incremented by a user to manually trigger a relist.",
+							Description: "RelistRequests is a strictly increasing, non-negative integer counter that can be manually incremented by a user to manually trigger a relist.\n\nDeprecated: set the servicecatalog.k8s.io/relist-requested-at annotation to the current time instead, which does not conflict with other changes to the spec. The value a relist honored is recorded in status.observedRelistRequestedAt.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
@@ -2366,18 +3564,92 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref common.Referen
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions"),
 						},
 					},
+					"catalogRemovalPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalPolicy specifies what the controller does with the ServiceInstances of a class or plan that has been removed from the broker's catalog. Defaults to CatalogRemovalPolicyKeep.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catalogRemovalDeprovisionDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalDeprovisionDelay is how long the controller waits after a class or plan was removed from the broker's catalog before deprovisioning its ServiceInstances when the CatalogRemovalPolicy is set to CatalogRemovalPolicyScheduledDeprovision.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"catalogRemovalGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogRemovalGracePeriod is how long the controller keeps the classes and plans that were removed from the broker's catalog, or that belong to a broker being deleted, before deleting them. During the grace period they are marked as pending removal. If unset, they are deleted as soon as they are no longer in use.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"mirrorURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorURL is the address of a second broker to which a copy of each provision request sent to this broker is also sent, for example to test a replacement broker with real traffic before switching over to it. The copies are sent in the background with the same credentials and TLS settings as the requests to this broker, and the responses of the mirror are only logged: they never affect the instances.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failoverURLs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailoverURLs are the addresses of standby endpoints of the same broker, such as its deployments in other regions, which serve the same catalog and instances as URL. When an endpoint cannot be reached or answers that it is unavailable, requests are sent to the next one, in the order URL, then FailoverURLs, and the endpoint is skipped for a while.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"readOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadOnly marks the broker as deprecated, for example while it is being decommissioned. New ServiceInstances of its classes are rejected, while the existing ones keep working and can be unbound and deprovisioned as usual.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"customHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomHeaders are HTTP headers sent on every request to the broker, for example the tenant or routing headers required by some brokers. The keys are the names of the headers, which must not be the name of a header set by the controller itself, such as Authorization or X-Broker-API-Version.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerHeaderValue"),
+									},
+								},
+							},
+						},
+					},
 					"authInfo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "AuthInfo contains the data that the service catalog should use to authenticate with the ServiceBroker.",
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo"),
 						},
 					},
+					"targetNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNamespaces are other namespaces into which the ServiceClasses and ServicePlans of the ServiceBroker are published, so that one namespaced broker can serve several tenant namespaces. Instances in a target namespace are provisioned through the ServiceBroker in its own namespace, with its credentials. Only the target namespaces that list the namespace of the ServiceBroker in their servicecatalog.k8s.io/accept-catalogs-from annotation receive the catalog. Requires the CrossNamespaceCatalogSharing feature.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerHeaderValue", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2414,16 +3686,234 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref common.Refer
 					},
 					"lastCatalogRetrievalTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastCatalogRetrievalTime is the time the Catalog was last fetched from the Service Broker",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "LastCatalogRetrievalTime is the time the Catalog was last fetched from the Service Broker",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"classCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassCount is the number of service classes that resulted from the last successful relist of the broker's catalog.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"planCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanCount is the number of service plans that resulted from the last successful relist of the broker's catalog.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastCatalogChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogChecksum is the checksum of the catalog payload returned by the broker on the last successful relist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API that was negotiated with the broker on the last successful relist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRelistDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRelistDuration is the time it took to fetch and reconcile the broker's catalog on the last successful relist.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"observedRelistRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedRelistRequests is the value of spec.relistRequests that the last successful relist honored.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"observedRelistRequestedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedRelistRequestedAt is the value of the servicecatalog.k8s.io/relist-requested-at annotation that the last successful relist honored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"asyncBindingOperationsRequired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AsyncBindingOperationsRequired is set once the broker has rejected a binding request without accepts_incomplete=true with the AsyncRequired error. The controller then sends accepts_incomplete=true with every binding request to the broker.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"conditions", "reconciledGeneration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBrokerSummary summarizes the ServiceBrokers in a namespace, for dashboards that should not have to list every ServiceBroker. It is maintained by the controller and named \"brokers\".",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"totalBrokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBrokers is the number of ServiceBrokers in the namespace.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyBrokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyBrokers is the number of ServiceBrokers in the namespace whose catalog was last fetched successfully.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"brokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Brokers summarizes each ServiceBroker in the namespace.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSummaryEntry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"totalBrokers", "readyBrokers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSummaryEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSummaryEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBrokerSummaryEntry summarizes a ServiceBroker.",
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the ServiceBroker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is the status of the Ready condition of the ServiceBroker, which is true when its catalog was last fetched successfully.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason of the Ready condition of the ServiceBroker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceClasses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceClasses is the number of ServiceClasses offered by the ServiceBroker.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"servicePlans": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServicePlans is the number of ServicePlans offered by the ServiceBroker.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastCatalogRetrievalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogRetrievalTime is when the catalog of the ServiceBroker was last fetched successfully.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "ready", "serviceClasses", "servicePlans"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSummaryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBrokerSummaryList is a list of ServiceBrokerSummaries.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSummary"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"conditions", "reconciledGeneration"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSummary", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
@@ -2560,6 +4050,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"instancesRetrievable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstancesRetrievable indicates whether fetching an instance via a GET on its endpoint is supported, which is required to adopt an existing instance of the class.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"planUpdatable": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PlanUpdatable indicates whether instances provisioned from this ServiceClass may change ServicePlans after being provisioned.",
@@ -2573,6 +4070,20 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref common.Referenc
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"documentationURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DocumentationURL is the link to the documentation of the service, as given by the documentationUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"supportURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SupportURL is the link to the support of the service, as given by the supportUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"tags": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nTags is a list of strings that represent different classification attributes of the ServiceClass.  These are used in Cloud Foundry in a way similar to Kubernetes labels, but they currently have no special meaning in Kubernetes.",
@@ -2608,6 +4119,20 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"serviceBrokerNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceBrokerNamespace is the namespace of the ServiceBroker that provides this ServiceClass when it is not the namespace of the ServiceClass, which is the case of the classes that a broker publishes into its TargetNamespaces.\n\nImmutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterServiceBroker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterServiceBroker is set when ServiceBrokerName is the name of the ClusterServiceBroker that provides this ServiceClass, which is the case of the classes that a CatalogProjection copies from ClusterServiceClasses.\n\nImmutable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"externalName", "externalID", "description", "bindable", "bindingRetrievable", "planUpdatable", "serviceBrokerName"},
 			},
@@ -2630,11 +4155,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassStatus(ref common.Refere
 							Format:      "",
 						},
 					},
+					"pendingRemoval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingRemoval indicates that the class will be deleted once the RemovalTime has passed, because it was removed from the broker's catalog or its broker is being deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"removalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovalTime is when the class will be deleted, if it is pending removal.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2784,6 +4323,136 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceList(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceOperationRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceInstanceOperationRecord records an operation of a ServiceInstance at the broker.",
+				Properties: map[string]spec.Schema{
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the type of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time at which the operation began.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time at which the operation completed. It is not set for the current operation.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Result is the result of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operationKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationKey is the operation key returned by the broker for an asynchronous operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"operation", "startTime", "result"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceOperations(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceInstanceOperations is returned by the operations subresource of a ServiceInstance. It lists the recorded operations of the instance at the broker, oldest first, followed by its current operation, if any.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name and namespace are those of the ServiceInstance.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items are the operations of the ServiceInstance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationRecord"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationRecord", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceInstancePropertiesSnapshot is the properties state of a ServiceInstance after an operation succeeded at the broker. Like in ExternalProperties, the values of parameters that came from a secret are redacted.",
+				Properties: map[string]spec.Schema{
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the operation that succeeded.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time at which the operation succeeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Properties is the properties state of the ServiceInstance which the broker knew about after the operation.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState"),
+						},
+					},
+				},
+				Required: []string{"operation", "time", "properties"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2837,6 +4506,12 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref c
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo"),
 						},
 					},
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context is the OSB context object that was sent to the broker, such as the platform, the cluster ID and the namespace of the ServiceInstance.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
 				},
 				Required: []string{"clusterServicePlanExternalName", "clusterServicePlanExternalID"},
 			},
@@ -2986,6 +4661,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref common.Refer
 							Format:      "",
 						},
 					},
+					"adopt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nAdopt makes the controller take over the instance with ExternalID that already exists at the broker, for example one created by another platform, instead of provisioning a new one. ExternalID must be set.\n\nImmutable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"userInfo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nUserInfo contains information about the user that last modified this instance. This field is set by the API server and not settable by the end-user. User-provided values for this field are not saved.",
@@ -2999,11 +4681,31 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref common.Refer
 							Format:      "int64",
 						},
 					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy specifies whether the broker is asked to deprovision the instance when it is deleted. Defaults to Delete. Set it to Abandon to remove the instance from Kubernetes without calling the broker, for example when the broker-side resource has already been removed. It may be changed while the instance is being deleted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bindingDeletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BindingDeletionPolicy specifies what happens to the ServiceBindings of the instance when it is deleted. Defaults to Block, which keeps the instance from being deprovisioned until all of its bindings have been deleted. Set it to Cascade to have the bindings deleted, and unbound, before the instance is deprovisioned. It may be changed while the instance is being deleted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"autoBind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nAutoBind makes the controller create a ServiceBinding for the instance, with the same name as the instance, once it has been provisioned. The binding is owned by the instance and is deleted with it, whatever the BindingDeletionPolicy.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AutoBind"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AutoBind", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3107,12 +4809,83 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"failedDeprovisionAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedDeprovisionAttempts is the number of deprovision requests for the ServiceInstance that have failed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"provisionStartedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProvisionStartedAt is the time at which the controller first sent a provision request for the ServiceInstance to the broker.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"readyAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyAt is the time at which the ServiceInstance was first successfully provisioned.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastPollTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastPollTime is the time at which the controller last recorded a poll of the broker for the status of an asynchronous operation. It is only refreshed periodically while the result of the poll stays the same.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastPollResult": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastPollResult is a short description of the result of the last poll of the broker for the status of an asynchronous operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parametersDiff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParametersDiff describes how the parameters sent to the broker by the current or last update differ from the parameters the broker had. It is nil if the update did not change the parameters.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersDiff"),
+						},
+					},
+					"propertiesHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PropertiesHistory is the properties state of the ServiceInstance after each of its last successful provisions and updates, oldest first. At most MaxServiceInstancePropertiesHistory are kept.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesSnapshot"),
+									},
+								},
+							},
+						},
+					},
+					"autoBindingCreated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoBindingCreated is true once the controller has created the ServiceBinding requested by the AutoBind of the instance. The binding is not created again if it is deleted afterwards.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"operations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations are the last provision, update and deprovision operations of the ServiceInstance that the broker completed, oldest first. At most MaxServiceInstanceOperations are kept. They are served, with the current operation, by the operations subresource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationRecord"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"conditions", "asyncOpInProgress", "orphanMitigationInProgress", "reconciledGeneration", "observedGeneration", "provisionStatus", "deprovisionStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersDiff", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationRecord", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesSnapshot", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -3260,6 +5033,20 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref common.Reference
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"documentationURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DocumentationURL is the link to the documentation of the plan, as given by the documentationUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"supportURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SupportURL is the link to the support of the plan, as given by the supportUrl value of its external metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"instanceCreateParameterSchema": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceInstanceCreateParameterSchema is the schema for the parameters that may be supplied when provisioning a new ServiceInstance on this plan.",
@@ -3297,6 +5084,20 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref common.Reference
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference"),
 						},
 					},
+					"serviceBrokerNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceBrokerNamespace is the namespace of the ServiceBroker that offers this ServicePlan when it is not the namespace of the ServicePlan, which is the case of the plans that a broker publishes into its TargetNamespaces.\n\nImmutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterServiceBroker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterServiceBroker is set when ServiceBrokerName is the name of the ClusterServiceBroker that offers this ServicePlan, which is the case of the plans that a CatalogProjection copies from ClusterServicePlans.\n\nImmutable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"externalName", "externalID", "description", "free", "serviceBrokerName", "serviceClassRef"},
 			},
@@ -3319,11 +5120,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref common.Referen
 							Format:      "",
 						},
 					},
+					"pendingRemoval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingRemoval indicates that the plan will be deleted once the RemovalTime has passed, because it was removed from the broker's catalog or its broker is being deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"removalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovalTime is when the plan will be deleted, if it is pending removal.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
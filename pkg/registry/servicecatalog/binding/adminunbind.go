@@ -0,0 +1,126 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// AdminUnbindAuditAnnotation is the key of the audit annotation holding
+	// the reason given for abandoning a binding.
+	AdminUnbindAuditAnnotation = "servicecatalog.k8s.io/admin-unbind-reason"
+
+	abandonedByAdminReason  = "AbandonedByAdmin"
+	abandonedByAdminMessage = "The binding was abandoned by an administrator without being unbound at the broker"
+)
+
+// AdminUnbindREST defines the REST operations for the adminunbind
+// subresource. It supports the http verb POST.
+type AdminUnbindREST struct {
+	store *registry.Store
+	// recorder records the abandonments as events of the bindings. It is
+	// nil when the API server runs without a Kubernetes API server.
+	recorder record.EventRecorder
+}
+
+var (
+	_ rest.Storage      = &AdminUnbindREST{}
+	_ rest.NamedCreater = &AdminUnbindREST{}
+)
+
+// New returns a new AdminUnbindRequest.
+func (r *AdminUnbindREST) New() runtime.Object {
+	return &servicecatalog.AdminUnbindRequest{}
+}
+
+// Create force deletes the named binding, which must be being deleted, so
+// that its deletion completes without it being unbound at the broker, and
+// records the abandonment as a warning event of the binding. It implements
+// the rest.NamedCreater interface.
+func (r *AdminUnbindREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, includeUninitialized bool) (runtime.Object, error) {
+	request, ok := obj.(*servicecatalog.AdminUnbindRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not an AdminUnbindRequest: %#v", obj))
+	}
+	if request.Confirm != name {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("confirm must be set to %q to abandon it", name))
+	}
+
+	existing, err := r.store.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	binding, ok := existing.(*servicecatalog.ServiceBinding)
+	if !ok {
+		return nil, errNotAServiceBinding
+	}
+	// Only the deletion of a binding is abandoned, so that the finalizers
+	// are only removed once nothing else is holding the binding back.
+	if binding.DeletionTimestamp == nil {
+		return nil, apierrors.NewConflict(servicecatalog.Resource("servicebindings"), name,
+			fmt.Errorf("the binding is not being deleted; delete it before abandoning it"))
+	}
+
+	audit.LogAnnotation(genericapirequest.AuditEventFrom(ctx), AdminUnbindAuditAnnotation, request.Reason)
+	glog.Infof("Abandoning ServiceBinding %q: %q", name, request.Reason)
+	if r.recorder != nil {
+		r.recorder.Event(bindingReference(binding), corev1.EventTypeWarning, abandonedByAdminReason, abandonedByAdminEventMessage(request.Reason))
+	}
+
+	if err := server.DeleteWithoutFinalizers(ctx, r.store, name); err != nil {
+		return nil, err
+	}
+	return &metav1.Status{Status: metav1.StatusSuccess}, nil
+}
+
+// abandonedByAdminEventMessage returns the message of the event recorded
+// when a binding is abandoned for the given reason.
+func abandonedByAdminEventMessage(reason string) string {
+	if reason == "" {
+		return abandonedByAdminMessage
+	}
+	return fmt.Sprintf("%s: %s", abandonedByAdminMessage, reason)
+}
+
+// bindingReference returns a reference to the given binding in the version
+// served to clients, which events are recorded against.
+func bindingReference(binding *servicecatalog.ServiceBinding) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion:      v1beta1.SchemeGroupVersion.String(),
+		Kind:            "ServiceBinding",
+		Namespace:       binding.Namespace,
+		Name:            binding.Name,
+		UID:             binding.UID,
+		ResourceVersion: binding.ResourceVersion,
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAdminUnbindRequiresConfirmation(t *testing.T) {
+	cases := []struct {
+		name string
+		obj  runtime.Object
+	}{
+		{
+			name: "not an admin unbind request",
+			obj:  &servicecatalog.ServiceBinding{},
+		},
+		{
+			name: "missing confirmation",
+			obj:  &servicecatalog.AdminUnbindRequest{},
+		},
+		{
+			name: "wrong confirmation",
+			obj:  &servicecatalog.AdminUnbindRequest{Confirm: "other-binding"},
+		},
+	}
+	for _, tc := range cases {
+		// The store is never reached for an unconfirmed request.
+		r := &AdminUnbindREST{}
+		_, err := r.Create(context.Background(), "test-binding", tc.obj, nil, false)
+		if !apierrors.IsBadRequest(err) {
+			t.Errorf("%v: expected a bad request error, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestAbandonedByAdminEventMessage(t *testing.T) {
+	if e, a := abandonedByAdminMessage, abandonedByAdminEventMessage(""); e != a {
+		t.Errorf("unexpected message without a reason: expected %q, got %q", e, a)
+	}
+	if e, a := abandonedByAdminMessage+": broker gone", abandonedByAdminEventMessage("broker gone"); e != a {
+		t.Errorf("unexpected message with a reason: expected %q, got %q", e, a)
+	}
+}
+
+func TestBindingReference(t *testing.T) {
+	binding := getTestInstanceCredential()
+	binding.UID = "test-uid"
+
+	ref := bindingReference(binding)
+	if e, a := "servicecatalog.k8s.io/v1beta1", ref.APIVersion; e != a {
+		t.Errorf("unexpected API version: expected %q, got %q", e, a)
+	}
+	if e, a := "ServiceBinding", ref.Kind; e != a {
+		t.Errorf("unexpected kind: expected %q, got %q", e, a)
+	}
+	if ref.Namespace != binding.Namespace || ref.Name != binding.Name || ref.UID != binding.UID {
+		t.Errorf("expected a reference to %s/%s (%s), got %+v", binding.Namespace, binding.Name, binding.UID, ref)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/client-go/tools/record"
 )
 
 var (
@@ -82,17 +83,28 @@ func CheckObject(obj runtime.Object) error {
 // selector.
 func Match(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
 	return storage.SelectionPredicate{
-		Label:    label,
-		Field:    field,
-		GetAttrs: GetAttrs,
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"spec.instanceRef.name"},
 	}
 }
 
+// InstanceRefTriggerFunc returns the value of the spec.instanceRef.name index
+// of a ServiceBinding, so that a watch of the bindings of a single instance
+// only receives the events of that instance.
+func InstanceRefTriggerFunc(obj runtime.Object) []storage.MatchValue {
+	binding := obj.(*servicecatalog.ServiceBinding)
+	result := storage.MatchValue{IndexName: "spec.instanceRef.name", Value: binding.Spec.ServiceInstanceRef.Name}
+	return []storage.MatchValue{result}
+}
+
 // toSelectableFields returns a field set that represents the object for matching purposes.
 func toSelectableFields(binding *servicecatalog.ServiceBinding) fields.Set {
 	// If you add a new selectable field, you also need to modify
 	// pkg/apis/servicecatalog/v1beta1/conversion[_test].go
-	specFieldSet := make(fields.Set, 1)
+	specFieldSet := make(fields.Set, 2)
+	specFieldSet["spec.instanceRef.name"] = binding.Spec.ServiceInstanceRef.Name
 	specFieldSet["spec.externalID"] = binding.Spec.ExternalID
 	return generic.AddObjectMetaFieldsSet(specFieldSet, &binding.ObjectMeta, true)
 }
@@ -107,8 +119,9 @@ func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
 }
 
 // NewStorage creates a new rest.Storage responsible for accessing ServiceBinding
-// resources
-func NewStorage(opts server.Options) (rest.Storage, rest.Storage, error) {
+// resources. The given recorder, which may be nil, records the abandonments of
+// bindings through the adminunbind subresource.
+func NewStorage(opts server.Options, recorder record.EventRecorder) (rest.Storage, rest.Storage, rest.Storage, rest.Storage, error) {
 	prefix := "/" + opts.ResourcePrefix()
 
 	storageInterface, dFunc := opts.GetStorage(
@@ -117,7 +130,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, error) {
 		bindingRESTStrategies,
 		NewList,
 		nil,
-		storage.NoTriggerPublisher,
+		InstanceRefTriggerFunc,
 	)
 
 	store := registry.Store{
@@ -138,6 +151,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, error) {
 		CreateStrategy:          bindingRESTStrategies,
 		UpdateStrategy:          bindingRESTStrategies,
 		DeleteStrategy:          bindingRESTStrategies,
+		ExportStrategy:          bindingRESTStrategies,
 		EnableGarbageCollection: true,
 
 		TableConvertor: tableconvertor.NewTableConvertor(
@@ -184,7 +198,11 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, error) {
 	statusStore := store
 	statusStore.UpdateStrategy = bindingStatusUpdateStrategy
 
-	return &store, &StatusREST{&statusStore}, nil
+	// The lookups of bindings by their external ID, which map an OSB
+	// binding_id back to its binding, are served from an index.
+	indexedStore := server.NewFieldIndexedStore(server.NewDiscoveryStore(&store, "sb"), "spec.externalID")
+
+	return indexedStore, &StatusREST{&statusStore}, &ForceDeleteREST{&statusStore}, &AdminUnbindREST{store: &statusStore, recorder: recorder}, nil
 }
 
 // StatusREST defines the REST operations for the status subresource via
@@ -216,3 +234,25 @@ func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOp
 func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc) (runtime.Object, bool, error) {
 	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation)
 }
+
+// ForceDeleteREST defines the REST operations for the forcedelete
+// subresource. It supports the http verb POST.
+type ForceDeleteREST struct {
+	store *registry.Store
+}
+
+var (
+	_ rest.Storage      = &ForceDeleteREST{}
+	_ rest.NamedCreater = &ForceDeleteREST{}
+)
+
+// New returns a new ForceDeleteRequest.
+func (r *ForceDeleteREST) New() runtime.Object {
+	return &servicecatalog.ForceDeleteRequest{}
+}
+
+// Create removes the finalizers of the named binding and deletes it without
+// unbinding it at the broker. It implements the rest.NamedCreater interface.
+func (r *ForceDeleteREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, includeUninitialized bool) (runtime.Object, error) {
+	return server.ForceDelete(ctx, r.store, name, obj)
+}
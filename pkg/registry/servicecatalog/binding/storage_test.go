@@ -30,3 +30,19 @@ func TestNewListNilItems(t *testing.T) {
 		t.Fatalf("nil incorrectly set on Items field")
 	}
 }
+
+func TestInstanceRefTriggerFunc(t *testing.T) {
+	binding := &servicecatalog.ServiceBinding{
+		Spec: servicecatalog.ServiceBindingSpec{
+			ServiceInstanceRef: servicecatalog.LocalObjectReference{Name: "test-instance"},
+		},
+	}
+
+	values := InstanceRefTriggerFunc(binding)
+	if len(values) != 1 || values[0].IndexName != "spec.instanceRef.name" || values[0].Value != "test-instance" {
+		t.Fatalf("unexpected trigger values: %+v", values)
+	}
+	if _, fields, _, err := GetAttrs(binding); err != nil || fields["spec.instanceRef.name"] != "test-instance" {
+		t.Fatalf("expected spec.instanceRef.name to be selectable, got %v (%v)", fields, err)
+	}
+}
@@ -20,6 +20,7 @@ package binding
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -68,6 +69,7 @@ var (
 	_ rest.RESTUpdateStrategy         = bindingRESTStrategies
 	_ rest.RESTDeleteStrategy         = bindingRESTStrategies
 	_ rest.RESTGracefulDeleteStrategy = bindingRESTStrategies
+	_ rest.RESTExportStrategy         = bindingRESTStrategies
 
 	bindingStatusUpdateStrategy = bindingStatusRESTStrategy{
 		bindingRESTStrategies,
@@ -145,14 +147,21 @@ func (bindingRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtim
 	// proper validation of allowed changes needs to be implemented in
 	// ValidateUpdate. Also, the check for whether the generation needs
 	// to be updated needs to be un-commented.
+	//
+	// The deletion policy is the exception: it only matters once the binding
+	// is deleted, so it may be changed at any time.
+	deletionPolicy := newServiceBinding.Spec.DeletionPolicy
 	newServiceBinding.Spec = oldServiceBinding.Spec
+	newServiceBinding.Spec.DeletionPolicy = deletionPolicy
 
 	// Spec updates bump the generation so that we can distinguish between
 	// spec changes and other changes to the object.
 	//
 	// Note that since we do not currently handle any changes to the spec,
 	// the generation will never be incremented
-	if !apiequality.Semantic.DeepEqual(oldServiceBinding.Spec, newServiceBinding.Spec) {
+	oldSpec := oldServiceBinding.Spec
+	oldSpec.DeletionPolicy = newServiceBinding.Spec.DeletionPolicy
+	if !apiequality.Semantic.DeepEqual(oldSpec, newServiceBinding.Spec) {
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
 			setServiceBindingUserInfo(ctx, newServiceBinding)
 		}
@@ -190,6 +199,30 @@ func (bindingRESTStrategy) CheckGracefulDelete(ctx context.Context, obj runtime.
 	return false
 }
 
+// Export strips the fields of a binding that are not settable by the user,
+// so that it can be re-created in another cluster: its status, its user info,
+// its finalizers and, when it selects its instance by labels, the instance
+// reference resolved from the selector. Unless exact is set, the ExternalID
+// is stripped as well, so that the re-created binding is bound anew.
+func (bindingRESTStrategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	binding, ok := obj.(*sc.ServiceBinding)
+	if !ok {
+		return fmt.Errorf("received a non-ServiceBinding object to export: %T", obj)
+	}
+
+	binding.Status = sc.ServiceBindingStatus{}
+	if binding.Spec.InstanceSelector != nil {
+		binding.Spec.ServiceInstanceRef = sc.LocalObjectReference{}
+	}
+	binding.Spec.UserInfo = nil
+	binding.Finalizers = nil
+	binding.Generation = 0
+	if !exact {
+		binding.Spec.ExternalID = ""
+	}
+	return nil
+}
+
 func (bindingStatusRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
 	newServiceBinding, ok := new.(*sc.ServiceBinding)
 	if !ok {
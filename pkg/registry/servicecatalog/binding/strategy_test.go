@@ -70,12 +70,23 @@ func TestInstanceCredentialUpdate(t *testing.T) {
 		older                     *servicecatalog.ServiceBinding
 		newer                     *servicecatalog.ServiceBinding
 		shouldGenerationIncrement bool
+		expectedDeletionPolicy    servicecatalog.DeletionPolicy
 	}{
 		{
 			name:  "no spec change",
 			older: getTestInstanceCredential(),
 			newer: getTestInstanceCredential(),
 		},
+		{
+			name:  "deletion policy change",
+			older: getTestInstanceCredential(),
+			newer: func() *servicecatalog.ServiceBinding {
+				ic := getTestInstanceCredential()
+				ic.Spec.DeletionPolicy = servicecatalog.DeletionPolicyAbandon
+				return ic
+			}(),
+			expectedDeletionPolicy: servicecatalog.DeletionPolicyAbandon,
+		},
 		//		{
 		//			name:  "spec change",
 		//			older: getTestInstanceCredential(),
@@ -99,6 +110,9 @@ func TestInstanceCredentialUpdate(t *testing.T) {
 		if e, a := expectedGeneration, tc.newer.Generation; e != a {
 			t.Errorf("%v: expected %v, got %v for generation", tc.name, e, a)
 		}
+		if e, a := tc.expectedDeletionPolicy, tc.newer.Spec.DeletionPolicy; e != a {
+			t.Errorf("%v: expected %v, got %v for deletion policy", tc.name, e, a)
+		}
 	}
 }
 
@@ -161,3 +175,64 @@ func TestExternalIDUserProvided(t *testing.T) {
 		t.Errorf("Modified user provided ExternalID to %q", createdInstanceCredential.Spec.ExternalID)
 	}
 }
+
+// TestInstanceCredentialExport checks that exporting a binding strips the
+// fields that are not settable by the user, and the ExternalID unless the
+// export is exact.
+func TestInstanceCredentialExport(t *testing.T) {
+	cases := []struct {
+		name                string
+		exact               bool
+		instanceSelector    *metav1.LabelSelector
+		expectedExternalID  string
+		expectedInstanceRef string
+	}{
+		{
+			name:                "not exact",
+			exact:               false,
+			expectedExternalID:  "",
+			expectedInstanceRef: "some-string",
+		},
+		{
+			name:                "exact",
+			exact:               true,
+			expectedExternalID:  "my-id",
+			expectedInstanceRef: "some-string",
+		},
+		{
+			name:  "instance selector",
+			exact: true,
+			instanceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "db"},
+			},
+			expectedExternalID:  "my-id",
+			expectedInstanceRef: "",
+		},
+	}
+	for _, tc := range cases {
+		binding := getTestInstanceCredential()
+		binding.Finalizers = []string{servicecatalog.FinalizerServiceCatalog}
+		binding.Spec.ExternalID = "my-id"
+		binding.Spec.InstanceSelector = tc.instanceSelector
+		binding.Spec.UserInfo = &servicecatalog.UserInfo{Username: "some-user"}
+		if err := bindingRESTStrategies.Export(nil, binding, tc.exact); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+
+		if binding.Spec.UserInfo != nil {
+			t.Errorf("%s: expected the user info to be stripped, got %+v", tc.name, binding.Spec.UserInfo)
+		}
+		if len(binding.Status.Conditions) != 0 {
+			t.Errorf("%s: expected the status to be stripped, got %+v", tc.name, binding.Status)
+		}
+		if len(binding.Finalizers) != 0 || binding.Generation != 0 {
+			t.Errorf("%s: expected the finalizers and generation to be stripped, got %v and %v", tc.name, binding.Finalizers, binding.Generation)
+		}
+		if e, a := tc.expectedExternalID, binding.Spec.ExternalID; e != a {
+			t.Errorf("%s: unexpected ExternalID: expected %q, got %q", tc.name, e, a)
+		}
+		if e, a := tc.expectedInstanceRef, binding.Spec.ServiceInstanceRef.Name; e != a {
+			t.Errorf("%s: unexpected instance reference: expected %q, got %q", tc.name, e, a)
+		}
+	}
+}
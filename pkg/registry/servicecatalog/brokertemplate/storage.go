@@ -0,0 +1,165 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package brokertemplate
+
+import (
+	"errors"
+	"fmt"
+
+	scmeta "github.com/kubernetes-incubator/service-catalog/pkg/api/meta"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/tableconvertor"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+var (
+	errNotABrokerTemplate = errors.New("not a brokertemplate")
+)
+
+// NewSingular returns a new shell of a broker template, according to the
+// given namespace and name
+func NewSingular(ns, name string) runtime.Object {
+	return &servicecatalog.BrokerTemplate{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "BrokerTemplate",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      name,
+		},
+	}
+}
+
+// EmptyObject returns an empty broker template
+func EmptyObject() runtime.Object {
+	return &servicecatalog.BrokerTemplate{}
+}
+
+// NewList returns a new shell of a broker template list
+func NewList() runtime.Object {
+	return &servicecatalog.BrokerTemplateList{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "BrokerTemplateList",
+		},
+		Items: []servicecatalog.BrokerTemplate{},
+	}
+}
+
+// CheckObject returns a non-nil error if obj is not a broker template object
+func CheckObject(obj runtime.Object) error {
+	_, ok := obj.(*servicecatalog.BrokerTemplate)
+	if !ok {
+		return errNotABrokerTemplate
+	}
+	return nil
+}
+
+// Match determines whether a BrokerTemplate matches a field and label
+// selector.
+func Match(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// toSelectableFields returns a field set that represents the object for matching purposes.
+func toSelectableFields(template *servicecatalog.BrokerTemplate) fields.Set {
+	return generic.ObjectMetaFieldsSet(&template.ObjectMeta, false)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
+	template, ok := obj.(*servicecatalog.BrokerTemplate)
+	if !ok {
+		return nil, nil, false, fmt.Errorf("given object is not a BrokerTemplate")
+	}
+	return labels.Set(template.ObjectMeta.Labels), toSelectableFields(template), template.Initializers != nil, nil
+}
+
+// NewStorage creates a new rest.Storage responsible for accessing
+// BrokerTemplate resources
+func NewStorage(opts server.Options) rest.Storage {
+	prefix := "/" + opts.ResourcePrefix()
+
+	storageInterface, dFunc := opts.GetStorage(
+		&servicecatalog.BrokerTemplate{},
+		prefix,
+		brokerTemplateRESTStrategies,
+		NewList,
+		nil,
+		storage.NoTriggerPublisher,
+	)
+
+	store := registry.Store{
+		NewFunc:     EmptyObject,
+		NewListFunc: NewList,
+		KeyRootFunc: opts.KeyRootFunc(),
+		KeyFunc:     opts.KeyFunc(false),
+		// Retrieve the name field of the resource.
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return scmeta.GetAccessor().Name(obj)
+		},
+		// Used to match objects based on labels/fields for list.
+		PredicateFunc: Match,
+		// DefaultQualifiedResource should always be plural
+		DefaultQualifiedResource: servicecatalog.Resource("brokertemplates"),
+
+		CreateStrategy:          brokerTemplateRESTStrategies,
+		UpdateStrategy:          brokerTemplateRESTStrategies,
+		DeleteStrategy:          brokerTemplateRESTStrategies,
+		EnableGarbageCollection: true,
+
+		TableConvertor: tableconvertor.NewTableConvertor(
+			[]metav1beta1.TableColumnDefinition{
+				{Name: "Name", Type: "string", Format: "name"},
+				{Name: "URL", Type: "string"},
+				{Name: "Age", Type: "string"},
+			},
+			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
+				template := obj.(*servicecatalog.BrokerTemplate)
+				cells := []interface{}{
+					name,
+					template.Spec.Template.URL,
+					age,
+				}
+				return cells, nil
+			},
+		),
+
+		Storage:     storageInterface,
+		DestroyFunc: dFunc,
+	}
+
+	options := &generic.StoreOptions{RESTOptions: opts.EtcdOptions.RESTOptions, AttrFunc: GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err) // TODO: Propagate error up
+	}
+
+	return server.NewDiscoveryStore(&store, "bt")
+}
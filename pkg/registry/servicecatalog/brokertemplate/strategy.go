@@ -0,0 +1,120 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package brokertemplate
+
+import (
+	"context"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/golang/glog"
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+)
+
+// NewScopeStrategy returns a new NamespaceScopedStrategy for broker templates
+func NewScopeStrategy() rest.NamespaceScopedStrategy {
+	return brokerTemplateRESTStrategies
+}
+
+// implements interfaces RESTCreateStrategy, RESTUpdateStrategy, RESTDeleteStrategy,
+// NamespaceScopedStrategy
+type brokerTemplateRESTStrategy struct {
+	runtime.ObjectTyper // inherit ObjectKinds method
+	names.NameGenerator // GenerateName method for CreateStrategy
+}
+
+var (
+	brokerTemplateRESTStrategies = brokerTemplateRESTStrategy{
+		ObjectTyper:   api.Scheme,
+		NameGenerator: names.SimpleNameGenerator,
+	}
+	_ rest.RESTCreateStrategy = brokerTemplateRESTStrategies
+	_ rest.RESTUpdateStrategy = brokerTemplateRESTStrategies
+	_ rest.RESTDeleteStrategy = brokerTemplateRESTStrategies
+)
+
+// Canonicalize does not transform a broker template.
+func (brokerTemplateRESTStrategy) Canonicalize(obj runtime.Object) {
+	_, ok := obj.(*sc.BrokerTemplate)
+	if !ok {
+		glog.Fatal("received a non-brokertemplate object to create")
+	}
+}
+
+// NamespaceScoped returns false as brokertemplates are not scoped to a
+// namespace.
+func (brokerTemplateRESTStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate receives the incoming BrokerTemplate and sets its
+// generation.
+func (brokerTemplateRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	template, ok := obj.(*sc.BrokerTemplate)
+	if !ok {
+		glog.Fatal("received a non-brokertemplate object to create")
+	}
+	template.Generation = 1
+}
+
+func (brokerTemplateRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return scv.ValidateBrokerTemplate(obj.(*sc.BrokerTemplate))
+}
+
+func (brokerTemplateRESTStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (brokerTemplateRESTStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (brokerTemplateRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
+	newBrokerTemplate, ok := new.(*sc.BrokerTemplate)
+	if !ok {
+		glog.Fatal("received a non-brokertemplate object to update to")
+	}
+	oldBrokerTemplate, ok := old.(*sc.BrokerTemplate)
+	if !ok {
+		glog.Fatal("received a non-brokertemplate object to update from")
+	}
+
+	// Spec updates bump the generation so that we can distinguish between
+	// spec changes and other changes to the object.
+	if !apiequality.Semantic.DeepEqual(oldBrokerTemplate.Spec, newBrokerTemplate.Spec) {
+		newBrokerTemplate.Generation = oldBrokerTemplate.Generation + 1
+	}
+}
+
+func (brokerTemplateRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+	newBrokerTemplate, ok := new.(*sc.BrokerTemplate)
+	if !ok {
+		glog.Fatal("received a non-brokertemplate object to validate to")
+	}
+	oldBrokerTemplate, ok := old.(*sc.BrokerTemplate)
+	if !ok {
+		glog.Fatal("received a non-brokertemplate object to validate from")
+	}
+
+	return scv.ValidateBrokerTemplateUpdate(newBrokerTemplate, oldBrokerTemplate)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogprojection
+
+import (
+	"errors"
+	"fmt"
+
+	scmeta "github.com/kubernetes-incubator/service-catalog/pkg/api/meta"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/tableconvertor"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+var (
+	errNotACatalogProjection = errors.New("not a catalogprojection")
+)
+
+// NewSingular returns a new shell of a catalog projection, according to the
+// given namespace and name
+func NewSingular(ns, name string) runtime.Object {
+	return &servicecatalog.CatalogProjection{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "CatalogProjection",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      name,
+		},
+	}
+}
+
+// EmptyObject returns an empty catalog projection
+func EmptyObject() runtime.Object {
+	return &servicecatalog.CatalogProjection{}
+}
+
+// NewList returns a new shell of a catalog projection list
+func NewList() runtime.Object {
+	return &servicecatalog.CatalogProjectionList{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "CatalogProjectionList",
+		},
+		Items: []servicecatalog.CatalogProjection{},
+	}
+}
+
+// CheckObject returns a non-nil error if obj is not a catalog projection object
+func CheckObject(obj runtime.Object) error {
+	_, ok := obj.(*servicecatalog.CatalogProjection)
+	if !ok {
+		return errNotACatalogProjection
+	}
+	return nil
+}
+
+// Match determines whether a CatalogProjection matches a field and label
+// selector.
+func Match(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// toSelectableFields returns a field set that represents the object for matching purposes.
+func toSelectableFields(projection *servicecatalog.CatalogProjection) fields.Set {
+	return generic.ObjectMetaFieldsSet(&projection.ObjectMeta, false)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
+	projection, ok := obj.(*servicecatalog.CatalogProjection)
+	if !ok {
+		return nil, nil, false, fmt.Errorf("given object is not a CatalogProjection")
+	}
+	return labels.Set(projection.ObjectMeta.Labels), toSelectableFields(projection), projection.Initializers != nil, nil
+}
+
+// NewStorage creates a new rest.Storage responsible for accessing
+// CatalogProjection resources
+func NewStorage(opts server.Options) rest.Storage {
+	prefix := "/" + opts.ResourcePrefix()
+
+	storageInterface, dFunc := opts.GetStorage(
+		&servicecatalog.CatalogProjection{},
+		prefix,
+		catalogProjectionRESTStrategies,
+		NewList,
+		nil,
+		storage.NoTriggerPublisher,
+	)
+
+	store := registry.Store{
+		NewFunc:     EmptyObject,
+		NewListFunc: NewList,
+		KeyRootFunc: opts.KeyRootFunc(),
+		KeyFunc:     opts.KeyFunc(false),
+		// Retrieve the name field of the resource.
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return scmeta.GetAccessor().Name(obj)
+		},
+		// Used to match objects based on labels/fields for list.
+		PredicateFunc: Match,
+		// DefaultQualifiedResource should always be plural
+		DefaultQualifiedResource: servicecatalog.Resource("catalogprojections"),
+
+		CreateStrategy:          catalogProjectionRESTStrategies,
+		UpdateStrategy:          catalogProjectionRESTStrategies,
+		DeleteStrategy:          catalogProjectionRESTStrategies,
+		EnableGarbageCollection: true,
+
+		TableConvertor: tableconvertor.NewTableConvertor(
+			[]metav1beta1.TableColumnDefinition{
+				{Name: "Name", Type: "string", Format: "name"},
+				{Name: "Age", Type: "string"},
+			},
+			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
+				cells := []interface{}{
+					name,
+					age,
+				}
+				return cells, nil
+			},
+		),
+
+		Storage:     storageInterface,
+		DestroyFunc: dFunc,
+	}
+
+	options := &generic.StoreOptions{RESTOptions: opts.EtcdOptions.RESTOptions, AttrFunc: GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err) // TODO: Propagate error up
+	}
+
+	return server.NewDiscoveryStore(&store, "cpr")
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogprojection
+
+import (
+	"context"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/golang/glog"
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+)
+
+// NewScopeStrategy returns a new NamespaceScopedStrategy for catalog projections
+func NewScopeStrategy() rest.NamespaceScopedStrategy {
+	return catalogProjectionRESTStrategies
+}
+
+// implements interfaces RESTCreateStrategy, RESTUpdateStrategy, RESTDeleteStrategy,
+// NamespaceScopedStrategy
+type catalogProjectionRESTStrategy struct {
+	runtime.ObjectTyper // inherit ObjectKinds method
+	names.NameGenerator // GenerateName method for CreateStrategy
+}
+
+var (
+	catalogProjectionRESTStrategies = catalogProjectionRESTStrategy{
+		ObjectTyper:   api.Scheme,
+		NameGenerator: names.SimpleNameGenerator,
+	}
+	_ rest.RESTCreateStrategy = catalogProjectionRESTStrategies
+	_ rest.RESTUpdateStrategy = catalogProjectionRESTStrategies
+	_ rest.RESTDeleteStrategy = catalogProjectionRESTStrategies
+)
+
+// Canonicalize does not transform a catalog projection.
+func (catalogProjectionRESTStrategy) Canonicalize(obj runtime.Object) {
+	_, ok := obj.(*sc.CatalogProjection)
+	if !ok {
+		glog.Fatal("received a non-catalogprojection object to create")
+	}
+}
+
+// NamespaceScoped returns false as catalogprojections are not scoped to a
+// namespace.
+func (catalogProjectionRESTStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate receives the incoming CatalogProjection and sets its
+// generation.
+func (catalogProjectionRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	projection, ok := obj.(*sc.CatalogProjection)
+	if !ok {
+		glog.Fatal("received a non-catalogprojection object to create")
+	}
+	projection.Generation = 1
+}
+
+func (catalogProjectionRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return scv.ValidateCatalogProjection(obj.(*sc.CatalogProjection))
+}
+
+func (catalogProjectionRESTStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (catalogProjectionRESTStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (catalogProjectionRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
+	newCatalogProjection, ok := new.(*sc.CatalogProjection)
+	if !ok {
+		glog.Fatal("received a non-catalogprojection object to update to")
+	}
+	oldCatalogProjection, ok := old.(*sc.CatalogProjection)
+	if !ok {
+		glog.Fatal("received a non-catalogprojection object to update from")
+	}
+
+	// Spec updates bump the generation so that we can distinguish between
+	// spec changes and other changes to the object.
+	if !apiequality.Semantic.DeepEqual(oldCatalogProjection.Spec, newCatalogProjection.Spec) {
+		newCatalogProjection.Generation = oldCatalogProjection.Generation + 1
+	}
+}
+
+func (catalogProjectionRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+	newCatalogProjection, ok := new.(*sc.CatalogProjection)
+	if !ok {
+		glog.Fatal("received a non-catalogprojection object to validate to")
+	}
+	oldCatalogProjection, ok := old.(*sc.CatalogProjection)
+	if !ok {
+		glog.Fatal("received a non-catalogprojection object to validate from")
+	}
+
+	return scv.ValidateCatalogProjectionUpdate(newCatalogProjection, oldCatalogProjection)
+}
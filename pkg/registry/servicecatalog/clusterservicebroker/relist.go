@@ -0,0 +1,82 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterservicebroker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// RelistAuditAnnotation is the key of the audit annotation holding the reason
+// given for a relist.
+const RelistAuditAnnotation = "servicecatalog.k8s.io/relist-reason"
+
+// RelistREST defines the REST operations for the relist subresource. It
+// supports the http verb POST.
+type RelistREST struct {
+	store *registry.Store
+}
+
+var (
+	_ rest.Storage      = &RelistREST{}
+	_ rest.NamedCreater = &RelistREST{}
+)
+
+// New returns a new RelistRequest.
+func (r *RelistREST) New() runtime.Object {
+	return &servicecatalog.RelistRequest{}
+}
+
+// Create increments the RelistRequests of the named broker and returns the
+// updated broker. The increment is applied to the stored broker and retried on
+// conflicts, so that it does not race with other writers of the spec. It
+// implements the rest.NamedCreater interface.
+func (r *RelistREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, includeUninitialized bool) (runtime.Object, error) {
+	request, ok := obj.(*servicecatalog.RelistRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a RelistRequest: %#v", obj))
+	}
+
+	audit.LogAnnotation(genericapirequest.AuditEventFrom(ctx), RelistAuditAnnotation, request.Reason)
+	glog.V(4).Infof("Relisting %v %q: %q", r.store.DefaultQualifiedResource, name, request.Reason)
+
+	broker, _, err := r.store.Update(ctx, name, rest.DefaultUpdatedObjectInfo(nil, incrementRelistRequests), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc)
+	if err != nil {
+		return nil, err
+	}
+	return broker, nil
+}
+
+// incrementRelistRequests is a rest.TransformFunc that returns a copy of the
+// old broker with its RelistRequests incremented.
+func incrementRelistRequests(ctx context.Context, newObj, oldObj runtime.Object) (runtime.Object, error) {
+	broker, ok := oldObj.DeepCopyObject().(*servicecatalog.ClusterServiceBroker)
+	if !ok {
+		return nil, fmt.Errorf("given object is not a ClusterServiceBroker")
+	}
+	broker.Spec.RelistRequests++
+	return broker, nil
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterservicebroker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIncrementRelistRequests(t *testing.T) {
+	old := &servicecatalog.ClusterServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-broker"},
+		Spec: servicecatalog.ClusterServiceBrokerSpec{
+			CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+				URL:            "https://broker.example.com",
+				RelistRequests: 2,
+			},
+		},
+	}
+
+	obj, err := incrementRelistRequests(context.Background(), nil, old)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	broker := obj.(*servicecatalog.ClusterServiceBroker)
+	if e, a := int64(3), broker.Spec.RelistRequests; e != a {
+		t.Fatalf("unexpected relist requests: expected %v, got %v", e, a)
+	}
+	if e, a := int64(2), old.Spec.RelistRequests; e != a {
+		t.Fatalf("expected the old broker to be unchanged, got %v relist requests", a)
+	}
+	if e, a := old.Spec.URL, broker.Spec.URL; e != a {
+		t.Fatalf("expected the rest of the spec to be unchanged: expected %q, got %q", e, a)
+	}
+}
@@ -23,9 +23,11 @@ import (
 
 	scmeta "github.com/kubernetes-incubator/service-catalog/pkg/api/meta"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/tableconvertor"
 
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 )
 
 var (
@@ -91,7 +94,31 @@ func Match(label labels.Selector, field fields.Selector) storage.SelectionPredic
 
 // toSelectableFields returns a field set that represents the object for matching purposes.
 func toSelectableFields(broker *servicecatalog.ClusterServiceBroker) fields.Set {
-	return generic.ObjectMetaFieldsSet(&broker.ObjectMeta, false)
+	// If you add a new selectable field, you also need to modify
+	// pkg/apis/servicecatalog/v1beta1/conversion[_test].go
+	fieldSet := make(fields.Set, 3)
+	fieldSet["spec.url"] = broker.Spec.URL
+	fieldSet["spec.relistBehavior"] = string(broker.Spec.RelistBehavior)
+	fieldSet["status.condition"] = string(getTrueConditionType(broker.Status.Conditions))
+	return generic.AddObjectMetaFieldsSet(fieldSet, &broker.ObjectMeta, false)
+}
+
+// getTrueConditionType returns the type of the condition of a broker that is
+// true, preferring Failed over Ready, or an empty type if neither is true.
+func getTrueConditionType(conditions []servicecatalog.ServiceBrokerCondition) servicecatalog.ServiceBrokerConditionType {
+	var conditionType servicecatalog.ServiceBrokerConditionType
+	for _, condition := range conditions {
+		if condition.Status != servicecatalog.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case servicecatalog.ServiceBrokerConditionFailed:
+			return condition.Type
+		case servicecatalog.ServiceBrokerConditionReady:
+			conditionType = condition.Type
+		}
+	}
+	return conditionType
 }
 
 // GetAttrs returns labels and fields of a given object for filtering purposes.
@@ -104,8 +131,9 @@ func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
 }
 
 // NewStorage creates a new rest.Storage responsible for accessing
-// ClusterServiceBroker resources
-func NewStorage(opts server.Options) (clusterServiceBrokers, clusterServiceBrokerStatus rest.Storage) {
+// ClusterServiceBroker resources. The catalog storage is nil unless the
+// BrokerCatalogSubresource feature is enabled.
+func NewStorage(opts server.Options) (clusterServiceBrokers, clusterServiceBrokerStatus, clusterServiceBrokerRelist, clusterServiceBrokerCatalog rest.Storage) {
 	prefix := "/" + opts.ResourcePrefix()
 
 	storageInterface, dFunc := opts.GetStorage(
@@ -141,6 +169,10 @@ func NewStorage(opts server.Options) (clusterServiceBrokers, clusterServiceBroke
 				{Name: "Name", Type: "string", Format: "name"},
 				{Name: "URL", Type: "string"},
 				{Name: "Status", Type: "string"},
+				{Name: "Classes", Type: "integer"},
+				{Name: "Plans", Type: "integer"},
+				{Name: "Last Retrieval", Type: "string"},
+				{Name: "Relist", Type: "string", Priority: 1},
 				{Name: "Age", Type: "string"},
 			},
 			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
@@ -154,11 +186,27 @@ func NewStorage(opts server.Options) (clusterServiceBrokers, clusterServiceBroke
 					}
 					return ""
 				}
+				getLastCatalogRetrieval := func(status servicecatalog.CommonServiceBrokerStatus) string {
+					if status.LastCatalogRetrievalTime == nil {
+						return "<none>"
+					}
+					return metatable.ConvertToHumanReadableDateType(*status.LastCatalogRetrievalTime)
+				}
+				getRelist := func(spec servicecatalog.CommonServiceBrokerSpec) string {
+					if spec.RelistBehavior == servicecatalog.ServiceBrokerRelistBehaviorDuration && spec.RelistDuration != nil {
+						return fmt.Sprintf("%s (%v)", spec.RelistBehavior, spec.RelistDuration.Duration)
+					}
+					return string(spec.RelistBehavior)
+				}
 				broker := obj.(*servicecatalog.ClusterServiceBroker)
 				cells := []interface{}{
 					name,
 					broker.Spec.URL,
 					getStatus(broker.Status.CommonServiceBrokerStatus),
+					broker.Status.ClassCount,
+					broker.Status.PlanCount,
+					getLastCatalogRetrieval(broker.Status.CommonServiceBrokerStatus),
+					getRelist(broker.Spec.CommonServiceBrokerSpec),
 					age,
 				}
 				return cells, nil
@@ -174,10 +222,14 @@ func NewStorage(opts server.Options) (clusterServiceBrokers, clusterServiceBroke
 		panic(err) // TODO: Propagate error up
 	}
 
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.BrokerCatalogSubresource) {
+		clusterServiceBrokerCatalog = server.NewCatalogREST(opts, &store, false)
+	}
+
 	statusStore := store
 	statusStore.UpdateStrategy = clusterServiceBrokerStatusUpdateStrategy
 
-	return &store, &StatusREST{&statusStore}
+	return server.NewDiscoveryStore(&store, "csb"), &StatusREST{&statusStore}, &RelistREST{&store}, clusterServiceBrokerCatalog
 }
 
 // StatusREST defines the REST operations for the status subresource via
@@ -17,9 +17,12 @@ limitations under the License.
 package clusterservicebroker
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 )
 
 func TestNewListNilItems(t *testing.T) {
@@ -30,3 +33,40 @@ func TestNewListNilItems(t *testing.T) {
 		t.Fatalf("nil incorrectly set on Items field")
 	}
 }
+
+func TestToSelectableFields(t *testing.T) {
+	broker := &servicecatalog.ClusterServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-broker"},
+		Spec: servicecatalog.ClusterServiceBrokerSpec{
+			CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+				URL:            "https://broker.example.com",
+				RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorManual,
+			},
+		},
+		Status: servicecatalog.ClusterServiceBrokerStatus{
+			CommonServiceBrokerStatus: servicecatalog.CommonServiceBrokerStatus{
+				Conditions: []servicecatalog.ServiceBrokerCondition{
+					{Type: servicecatalog.ServiceBrokerConditionReady, Status: servicecatalog.ConditionTrue},
+				},
+			},
+		},
+	}
+
+	expected := fields.Set{
+		"metadata.name":       "test-broker",
+		"spec.url":            "https://broker.example.com",
+		"spec.relistBehavior": "Manual",
+		"status.condition":    "Ready",
+	}
+	if e, a := expected, toSelectableFields(broker); !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected fields: expected %v, got %v", e, a)
+	}
+
+	broker.Status.Conditions = append(broker.Status.Conditions, servicecatalog.ServiceBrokerCondition{
+		Type:   servicecatalog.ServiceBrokerConditionFailed,
+		Status: servicecatalog.ConditionTrue,
+	})
+	if e, a := "Failed", toSelectableFields(broker)["status.condition"]; e != a {
+		t.Fatalf("unexpected status.condition: expected %q, got %q", e, a)
+	}
+}
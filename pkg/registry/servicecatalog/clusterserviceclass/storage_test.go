@@ -30,3 +30,16 @@ func TestNewList(t *testing.T) {
 		t.Fatalf("nil incorrectly set on Items field")
 	}
 }
+
+func TestClusterServiceBrokerNameTriggerFunc(t *testing.T) {
+	class := &servicecatalog.ClusterServiceClass{
+		Spec: servicecatalog.ClusterServiceClassSpec{
+			ClusterServiceBrokerName: "test-broker",
+		},
+	}
+
+	values := ClusterServiceBrokerNameTriggerFunc(class)
+	if len(values) != 1 || values[0].IndexName != "spec.clusterServiceBrokerName" || values[0].Value != "test-broker" {
+		t.Fatalf("unexpected trigger values: %+v", values)
+	}
+}
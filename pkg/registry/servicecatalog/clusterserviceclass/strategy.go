@@ -20,8 +20,11 @@ package clusterserviceclass
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -43,6 +46,11 @@ func NewScopeStrategy() rest.NamespaceScopedStrategy {
 type clusterServiceClassRESTStrategy struct {
 	runtime.ObjectTyper // inherit ObjectKinds method
 	names.NameGenerator // GenerateName method for CreateStrategy
+
+	// classes lists the existing classes, to validate that the external name
+	// of a class is unique among the classes of its broker. No class is
+	// listed if it is nil.
+	classes rest.Lister
 }
 
 // clusterServiceClassStatusRESTStrategy implements interface
@@ -95,8 +103,10 @@ func (clusterServiceClassRESTStrategy) PrepareForCreate(ctx context.Context, obj
 	clusterServiceClass.Status = sc.ClusterServiceClassStatus{}
 }
 
-func (clusterServiceClassRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return scv.ValidateClusterServiceClass(obj.(*sc.ClusterServiceClass))
+func (s clusterServiceClassRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	class := obj.(*sc.ClusterServiceClass)
+	allErrs := scv.ValidateClusterServiceClass(class)
+	return append(allErrs, s.validateUniqueExternalName(ctx, class)...)
 }
 
 func (clusterServiceClassRESTStrategy) AllowCreateOnUpdate() bool {
@@ -123,7 +133,7 @@ func (clusterServiceClassRESTStrategy) PrepareForUpdate(ctx context.Context, new
 	newServiceClass.Spec.ClusterServiceBrokerName = oldServiceClass.Spec.ClusterServiceBrokerName
 }
 
-func (clusterServiceClassRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+func (s clusterServiceClassRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
 	newServiceclass, ok := new.(*sc.ClusterServiceClass)
 	if !ok {
 		glog.Fatal("received a non-clusterserviceclass object to validate to")
@@ -133,7 +143,41 @@ func (clusterServiceClassRESTStrategy) ValidateUpdate(ctx context.Context, new,
 		glog.Fatal("received a non-clusterserviceclass object to validate from")
 	}
 
-	return scv.ValidateClusterServiceClassUpdate(newServiceclass, oldServiceclass)
+	allErrs := scv.ValidateClusterServiceClassUpdate(newServiceclass, oldServiceclass)
+	if newServiceclass.Spec.ExternalName != oldServiceclass.Spec.ExternalName {
+		allErrs = append(allErrs, s.validateUniqueExternalName(ctx, newServiceclass)...)
+	}
+	return allErrs
+}
+
+// validateUniqueExternalName validates that no other class of the broker of
+// the given class has the same external name, so that a class is found
+// unambiguously by its external name. The classes that have been removed from
+// the catalog of the broker are ignored, as the broker may have replaced them
+// with the given class.
+func (s clusterServiceClassRESTStrategy) validateUniqueExternalName(ctx context.Context, class *sc.ClusterServiceClass) field.ErrorList {
+	if s.classes == nil || class.Spec.ExternalName == "" {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "externalName")
+	list, err := s.classes.List(ctx, &metainternalversion.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{
+			"spec.externalName":             class.Spec.ExternalName,
+			"spec.clusterServiceBrokerName": class.Spec.ClusterServiceBrokerName,
+		}),
+	})
+	if err != nil {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+	for _, other := range list.(*sc.ClusterServiceClassList).Items {
+		if other.Name == class.Name || other.Status.RemovedFromBrokerCatalog {
+			continue
+		}
+		return field.ErrorList{field.Invalid(fldPath, class.Spec.ExternalName,
+			fmt.Sprintf("the ClusterServiceClass %q of the broker %q has the same external name", other.Name, class.Spec.ClusterServiceBrokerName))}
+	}
+	return nil
 }
 
 func (clusterServiceClassStatusRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
@@ -0,0 +1,122 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterserviceclass
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+// fakeClassLister is a rest.Lister of the ClusterServiceClasses matching the
+// field selector of a list.
+type fakeClassLister struct {
+	classes []sc.ClusterServiceClass
+}
+
+func (f *fakeClassLister) NewList() runtime.Object {
+	return &sc.ClusterServiceClassList{}
+}
+
+func (f *fakeClassLister) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	list := &sc.ClusterServiceClassList{}
+	for _, class := range f.classes {
+		if options.FieldSelector.Matches(toSelectableFields(&class)) {
+			list.Items = append(list.Items, class)
+		}
+	}
+	return list, nil
+}
+
+func newTestClass(name, brokerName, externalName string) *sc.ClusterServiceClass {
+	class := &sc.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	class.Spec.ClusterServiceBrokerName = brokerName
+	class.Spec.ExternalName = externalName
+	class.Spec.ExternalID = name
+	class.Spec.Description = "a test class"
+	return class
+}
+
+func TestValidateUniqueExternalName(t *testing.T) {
+	removedClass := newTestClass("removed-class", "test-broker", "removed")
+	removedClass.Status.RemovedFromBrokerCatalog = true
+
+	strategy := clusterServiceClassRESTStrategies
+	strategy.classes = &fakeClassLister{
+		classes: []sc.ClusterServiceClass{
+			*newTestClass("test-class", "test-broker", "test"),
+			*newTestClass("other-class", "other-broker", "other"),
+			*removedClass,
+		},
+	}
+
+	cases := []struct {
+		name        string
+		class       *sc.ClusterServiceClass
+		expectedErr string
+	}{
+		{
+			name:  "unique external name",
+			class: newTestClass("new-class", "test-broker", "new"),
+		},
+		{
+			name:        "external name of a class of the same broker",
+			class:       newTestClass("new-class", "test-broker", "test"),
+			expectedErr: `spec.externalName: Invalid value: "test": the ClusterServiceClass "test-class" of the broker "test-broker" has the same external name`,
+		},
+		{
+			name:  "external name of a class of another broker",
+			class: newTestClass("new-class", "test-broker", "other"),
+		},
+		{
+			name:  "external name of a class removed from the catalog",
+			class: newTestClass("new-class", "test-broker", "removed"),
+		},
+	}
+
+	for _, tc := range cases {
+		errs := strategy.Validate(context.Background(), tc.class)
+		if tc.expectedErr == "" {
+			if len(errs) != 0 {
+				t.Errorf("%v: unexpected errors: %v", tc.name, errs)
+			}
+			continue
+		}
+		if !strings.Contains(errs.ToAggregate().Error(), tc.expectedErr) {
+			t.Errorf("%v: expected an error containing %q, got %v", tc.name, tc.expectedErr, errs)
+		}
+	}
+
+	// An update that keeps the external name is not checked again, and a class
+	// does not conflict with itself.
+	old := newTestClass("test-class", "test-broker", "test")
+	updated := old.DeepCopy()
+	updated.Spec.Description = "an updated test class"
+	if errs := strategy.ValidateUpdate(context.Background(), updated, old); len(errs) != 0 {
+		t.Errorf("unexpected errors updating a class: %v", errs)
+	}
+	updated.Spec.ExternalName = "other"
+	if errs := strategy.ValidateUpdate(context.Background(), updated, old); len(errs) != 0 {
+		t.Errorf("unexpected errors renaming a class to the external name of a class of another broker: %v", errs)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// OperationsREST defines the REST operations for the operations subresource.
+// It supports the http verb GET.
+type OperationsREST struct {
+	store *registry.Store
+}
+
+var (
+	_ rest.Storage = &OperationsREST{}
+	_ rest.Getter  = &OperationsREST{}
+)
+
+// New returns a new ServiceInstanceOperations.
+func (r *OperationsREST) New() runtime.Object {
+	return &servicecatalog.ServiceInstanceOperations{}
+}
+
+// Get returns the operations of the named instance at the broker. It
+// implements the rest.Getter interface.
+func (r *OperationsREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := r.store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := obj.(*servicecatalog.ServiceInstance)
+	if !ok {
+		return nil, errNotAnServiceInstance
+	}
+	return getServiceInstanceOperations(instance), nil
+}
+
+// getServiceInstanceOperations returns the recorded operations of the given
+// instance followed by its current operation, if any.
+func getServiceInstanceOperations(instance *servicecatalog.ServiceInstance) *servicecatalog.ServiceInstanceOperations {
+	operations := &servicecatalog.ServiceInstanceOperations{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            instance.Name,
+			Namespace:       instance.Namespace,
+			UID:             instance.UID,
+			ResourceVersion: instance.ResourceVersion,
+		},
+	}
+	for _, record := range instance.Status.Operations {
+		operations.Items = append(operations.Items, *record.DeepCopy())
+	}
+
+	status := &instance.Status
+	if status.CurrentOperation != "" && status.OperationStartTime != nil {
+		current := servicecatalog.ServiceInstanceOperationRecord{
+			Operation: status.CurrentOperation,
+			StartTime: *status.OperationStartTime,
+			Result:    servicecatalog.ServiceInstanceOperationResultInProgress,
+		}
+		if status.LastOperation != nil {
+			operationKey := *status.LastOperation
+			current.OperationKey = &operationKey
+		}
+		operations.Items = append(operations.Items, current)
+	}
+	return operations
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestGetServiceInstanceOperations tests that the current operation of an
+// instance is listed after its recorded operations.
+func TestGetServiceInstanceOperations(t *testing.T) {
+	instance := getTestInstance()
+	instance.Name = "test-instance"
+	completed := metav1.Now()
+	instance.Status.Operations = []servicecatalog.ServiceInstanceOperationRecord{
+		{
+			Operation:      servicecatalog.ServiceInstanceOperationProvision,
+			StartTime:      completed,
+			CompletionTime: &completed,
+			Result:         servicecatalog.ServiceInstanceOperationResultSucceeded,
+		},
+	}
+
+	operations := getServiceInstanceOperations(instance)
+	if e, a := "test-instance", operations.Name; e != a {
+		t.Fatalf("expected name %v, got %v", e, a)
+	}
+	if e, a := 1, len(operations.Items); e != a {
+		t.Fatalf("expected %v operations without a current operation, got %v", e, a)
+	}
+
+	operationKey := "op-key"
+	instance.Status.CurrentOperation = servicecatalog.ServiceInstanceOperationUpdate
+	instance.Status.OperationStartTime = &completed
+	instance.Status.LastOperation = &operationKey
+
+	operations = getServiceInstanceOperations(instance)
+	if e, a := 2, len(operations.Items); e != a {
+		t.Fatalf("expected %v operations, got %v", e, a)
+	}
+	current := operations.Items[1]
+	if current.Operation != servicecatalog.ServiceInstanceOperationUpdate ||
+		current.Result != servicecatalog.ServiceInstanceOperationResultInProgress ||
+		current.CompletionTime != nil ||
+		current.OperationKey == nil || *current.OperationKey != operationKey {
+		t.Fatalf("unexpected current operation %+v", current)
+	}
+
+	// The operations are copies that do not change with the instance.
+	*instance.Status.Operations[0].CompletionTime = metav1.Time{}
+	if operations.Items[0].CompletionTime.IsZero() {
+		t.Fatal("expected the recorded operations to be copied")
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const (
+	// RollbackAuditAnnotation is the key of the audit annotation holding the
+	// reason given for a rollback.
+	RollbackAuditAnnotation = "servicecatalog.k8s.io/rollback-reason"
+
+	// redactedParameterValue replaces the values of parameters that came from
+	// parametersFrom in the ExternalProperties of an instance.
+	redactedParameterValue = "<redacted>"
+)
+
+// RollbackREST defines the REST operations for the rollback subresource. It
+// supports the http verb POST.
+type RollbackREST struct {
+	store *registry.Store
+}
+
+var (
+	_ rest.Storage      = &RollbackREST{}
+	_ rest.NamedCreater = &RollbackREST{}
+)
+
+// New returns a new RollbackRequest.
+func (r *RollbackREST) New() runtime.Object {
+	return &servicecatalog.RollbackRequest{}
+}
+
+// Create reverts the plan and parameters in the spec of the named instance to
+// those last accepted by the broker, and returns the updated instance. It
+// implements the rest.NamedCreater interface.
+func (r *RollbackREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, includeUninitialized bool) (runtime.Object, error) {
+	request, ok := obj.(*servicecatalog.RollbackRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a RollbackRequest: %#v", obj))
+	}
+
+	audit.LogAnnotation(genericapirequest.AuditEventFrom(ctx), RollbackAuditAnnotation, request.Reason)
+	glog.Infof("Rolling back %v %q: %q", r.store.DefaultQualifiedResource, name, request.Reason)
+
+	instance, _, err := r.store.Update(ctx, name, rest.DefaultUpdatedObjectInfo(nil, rollbackSpec), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc)
+	if err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// rollbackSpec is a rest.TransformFunc that returns a copy of the old instance
+// with the plan and parameters of its ExternalProperties in its spec. The
+// plan is referred to in the same way as the class of the instance. Parameters
+// that came from parametersFrom are only recorded redacted, so they are
+// dropped; the parametersFrom of the spec are kept as they are.
+func rollbackSpec(ctx context.Context, newObj, oldObj runtime.Object) (runtime.Object, error) {
+	instance, ok := oldObj.DeepCopyObject().(*servicecatalog.ServiceInstance)
+	if !ok {
+		return nil, errNotAnServiceInstance
+	}
+	props := instance.Status.ExternalProperties
+	if props == nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("instance %q has not been provisioned, so there is nothing to roll back to", instance.Name))
+	}
+
+	spec := &instance.Spec
+	if spec.ClusterServiceClassSpecified() {
+		planName, planExternalName, planExternalID := "", "", ""
+		switch {
+		case spec.ClusterServiceClassExternalName != "":
+			planExternalName = props.ClusterServicePlanExternalName
+		case spec.ClusterServiceClassExternalID != "":
+			planExternalID = props.ClusterServicePlanExternalID
+		default:
+			// Plans are named after their external ID.
+			planName = props.ClusterServicePlanExternalID
+		}
+		spec.ClusterServicePlanName = planName
+		spec.ClusterServicePlanExternalName = planExternalName
+		spec.ClusterServicePlanExternalID = planExternalID
+	} else {
+		planName, planExternalName, planExternalID := "", "", ""
+		switch {
+		case spec.ServiceClassExternalName != "":
+			planExternalName = props.ServicePlanExternalName
+		case spec.ServiceClassExternalID != "":
+			planExternalID = props.ServicePlanExternalID
+		default:
+			planName = props.ServicePlanExternalID
+		}
+		if spec.ServicePlanName != planName || spec.ServicePlanExternalName != planExternalName || spec.ServicePlanExternalID != planExternalID {
+			spec.ServicePlanRef = nil
+		}
+		spec.ServicePlanName = planName
+		spec.ServicePlanExternalName = planExternalName
+		spec.ServicePlanExternalID = planExternalID
+	}
+
+	parameters, err := rollbackParameters(props.Parameters)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	spec.Parameters = parameters
+
+	return instance, nil
+}
+
+// rollbackParameters returns the given recorded parameters without the ones
+// whose values were redacted.
+func rollbackParameters(recorded *runtime.RawExtension) (*runtime.RawExtension, error) {
+	if recorded == nil || len(recorded.Raw) == 0 {
+		return nil, nil
+	}
+	params := make(map[string]interface{})
+	if err := json.Unmarshal(recorded.Raw, &params); err != nil {
+		return nil, err
+	}
+	for k, v := range params {
+		if v == redactedParameterValue {
+			delete(params, k)
+		}
+	}
+	if len(params) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
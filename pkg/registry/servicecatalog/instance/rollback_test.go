@@ -0,0 +1,139 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// TestRollbackSpec tests that the plan and parameters of an instance are
+// reverted to those of its ExternalProperties.
+func TestRollbackSpec(t *testing.T) {
+	cases := []struct {
+		name                     string
+		instance                 func() *servicecatalog.ServiceInstance
+		expectedErr              bool
+		expectedPlanExternalName string
+		expectedPlanExternalID   string
+		expectedPlanName         string
+		expectedParameters       string
+	}{
+		{
+			name: "not provisioned",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := getTestInstance()
+				i.Status.ExternalProperties = nil
+				return i
+			},
+			expectedErr: true,
+		},
+		{
+			name:                     "external name",
+			instance:                 getTestRollbackInstance,
+			expectedPlanExternalName: "good-plan",
+			expectedParameters:       `{"a":"1"}`,
+		},
+		{
+			name: "external ID",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := getTestRollbackInstance()
+				i.Spec.ClusterServiceClassExternalName = ""
+				i.Spec.ClusterServiceClassExternalID = "class-id"
+				i.Spec.ClusterServicePlanExternalName = ""
+				i.Spec.ClusterServicePlanExternalID = "bad-plan-id"
+				return i
+			},
+			expectedPlanExternalID: "good-plan-id",
+			expectedParameters:     `{"a":"1"}`,
+		},
+		{
+			name: "kubernetes name",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := getTestRollbackInstance()
+				i.Spec.ClusterServiceClassExternalName = ""
+				i.Spec.ClusterServiceClassName = "class-id"
+				i.Spec.ClusterServicePlanExternalName = ""
+				i.Spec.ClusterServicePlanName = "bad-plan-id"
+				return i
+			},
+			expectedPlanName:   "good-plan-id",
+			expectedParameters: `{"a":"1"}`,
+		},
+		{
+			name: "only redacted parameters",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := getTestRollbackInstance()
+				i.Status.ExternalProperties.Parameters = &runtime.RawExtension{Raw: []byte(`{"secret":"<redacted>"}`)}
+				return i
+			},
+			expectedPlanExternalName: "good-plan",
+		},
+	}
+
+	for _, tc := range cases {
+		obj, err := rollbackSpec(genericapirequest.NewContext(), nil, tc.instance())
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%v: expected error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+			continue
+		}
+		spec := obj.(*servicecatalog.ServiceInstance).Spec
+		if e, a := tc.expectedPlanExternalName, spec.ClusterServicePlanExternalName; e != a {
+			t.Errorf("%v: unexpected plan external name: expected %q, got %q", tc.name, e, a)
+		}
+		if e, a := tc.expectedPlanExternalID, spec.ClusterServicePlanExternalID; e != a {
+			t.Errorf("%v: unexpected plan external ID: expected %q, got %q", tc.name, e, a)
+		}
+		if e, a := tc.expectedPlanName, spec.ClusterServicePlanName; e != a {
+			t.Errorf("%v: unexpected plan name: expected %q, got %q", tc.name, e, a)
+		}
+		var parameters string
+		if spec.Parameters != nil {
+			parameters = string(spec.Parameters.Raw)
+		}
+		if e, a := tc.expectedParameters, parameters; e != a {
+			t.Errorf("%v: unexpected parameters: expected %q, got %q", tc.name, e, a)
+		}
+		if len(spec.ParametersFrom) != 1 {
+			t.Errorf("%v: expected parametersFrom to be kept", tc.name)
+		}
+	}
+}
+
+func getTestRollbackInstance() *servicecatalog.ServiceInstance {
+	i := getTestInstance()
+	i.Spec.ClusterServicePlanExternalName = "bad-plan"
+	i.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"a":"2"}`)}
+	i.Spec.ParametersFrom = []servicecatalog.ParametersFromSource{
+		{SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "secret", Key: "key"}},
+	}
+	i.Status.ExternalProperties = &servicecatalog.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: "good-plan",
+		ClusterServicePlanExternalID:   "good-plan-id",
+		Parameters:                     &runtime.RawExtension{Raw: []byte(`{"a":"1","secret":"<redacted>"}`)},
+	}
+	return i
+}
@@ -82,12 +82,26 @@ func CheckObject(obj runtime.Object) error {
 // selector.
 func Match(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
 	return storage.SelectionPredicate{
-		Label:    label,
-		Field:    field,
-		GetAttrs: GetAttrs,
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"spec.clusterServiceClassRef.name"},
 	}
 }
 
+// ClusterServiceClassRefTriggerFunc returns the value of the
+// spec.clusterServiceClassRef.name index of a ServiceInstance, so that a watch
+// of the instances of a single class only receives the events of that class.
+// The value is empty for instances whose ClusterServiceClass is not resolved.
+func ClusterServiceClassRefTriggerFunc(obj runtime.Object) []storage.MatchValue {
+	instance := obj.(*servicecatalog.ServiceInstance)
+	result := storage.MatchValue{IndexName: "spec.clusterServiceClassRef.name"}
+	if instance.Spec.ClusterServiceClassRef != nil {
+		result.Value = instance.Spec.ClusterServiceClassRef.Name
+	}
+	return []storage.MatchValue{result}
+}
+
 // toSelectableFields returns a field set that represents the object for matching purposes.
 func toSelectableFields(instance *servicecatalog.ServiceInstance) fields.Set {
 	// If you add a new selectable field, you also need to modify
@@ -112,9 +126,32 @@ func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
 	return labels.Set(instance.ObjectMeta.Labels), toSelectableFields(instance), instance.Initializers != nil, nil
 }
 
+// getLastOperation describes the last operation of an instance and its result
+// for the Last-Operation column of tables, with the operation key returned by
+// the broker while an asynchronous operation is in progress.
+func getLastOperation(status servicecatalog.ServiceInstanceStatus) string {
+	var operation servicecatalog.ServiceInstanceOperation
+	var result servicecatalog.ServiceInstanceOperationResult
+	if n := len(status.Operations); n > 0 {
+		operation, result = status.Operations[n-1].Operation, status.Operations[n-1].Result
+	}
+	if status.CurrentOperation != "" {
+		operation, result = status.CurrentOperation, servicecatalog.ServiceInstanceOperationResultInProgress
+	}
+	if operation == "" {
+		return ""
+	}
+	description := fmt.Sprintf("%s %s", operation, result)
+	if result == servicecatalog.ServiceInstanceOperationResultInProgress && status.AsyncOpInProgress &&
+		status.LastOperation != nil && *status.LastOperation != "" {
+		description += fmt.Sprintf(" (%s)", *status.LastOperation)
+	}
+	return description
+}
+
 // NewStorage creates a new rest.Storage responsible for accessing ServiceInstance
 // resources
-func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage) {
+func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage, rest.Storage, rest.Storage, rest.Storage) {
 	prefix := "/" + opts.ResourcePrefix()
 
 	storageInterface, dFunc := opts.GetStorage(
@@ -123,7 +160,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage)
 		instanceRESTStrategies,
 		NewList,
 		nil,
-		storage.NoTriggerPublisher,
+		ClusterServiceClassRefTriggerFunc,
 	)
 
 	store := registry.Store{
@@ -143,6 +180,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage)
 		CreateStrategy:          instanceRESTStrategies,
 		UpdateStrategy:          instanceRESTStrategies,
 		DeleteStrategy:          instanceRESTStrategies,
+		ExportStrategy:          instanceRESTStrategies,
 		EnableGarbageCollection: true,
 
 		TableConvertor: tableconvertor.NewTableConvertor(
@@ -151,6 +189,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage)
 				{Name: "Class", Type: "string"},
 				{Name: "Plan", Type: "string"},
 				{Name: "Status", Type: "string"},
+				{Name: "Last-Operation", Type: "string"},
 				{Name: "Age", Type: "string"},
 			},
 			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
@@ -171,9 +210,17 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage)
 				if instance.Spec.ClusterServiceClassSpecified() && instance.Spec.ClusterServicePlanSpecified() {
 					class = fmt.Sprintf("ClusterServiceClass/%s", instance.Spec.GetSpecifiedClusterServiceClass())
 					plan = instance.Spec.GetSpecifiedClusterServicePlan()
+					// Prefer the external name of the plan the broker has the
+					// instance on over a plan specified by ID or name.
+					if props := instance.Status.ExternalProperties; props != nil && props.ClusterServicePlanExternalName != "" {
+						plan = props.ClusterServicePlanExternalName
+					}
 				} else {
 					class = fmt.Sprintf("ServiceClass/%s", instance.Spec.GetSpecifiedServiceClass())
 					plan = instance.Spec.GetSpecifiedServicePlan()
+					if props := instance.Status.ExternalProperties; props != nil && props.ServicePlanExternalName != "" {
+						plan = props.ServicePlanExternalName
+					}
 				}
 
 				cells := []interface{}{
@@ -181,6 +228,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage)
 					class,
 					plan,
 					getStatus(instance.Status),
+					getLastOperation(instance.Status),
 					age,
 				}
 				return cells, nil
@@ -201,7 +249,11 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage)
 	referenceStore := store
 	referenceStore.UpdateStrategy = instanceReferenceUpdateStrategy
 
-	return &store, &StatusREST{&statusStore}, &ReferenceREST{&referenceStore}
+	// The lookups of instances by their external ID, which map an OSB
+	// instance_id back to its instance, are served from an index.
+	indexedStore := server.NewFieldIndexedStore(server.NewDiscoveryStore(&store, "si"), "spec.externalID")
+
+	return indexedStore, &StatusREST{&statusStore}, &ReferenceREST{&referenceStore}, &ForceDeleteREST{&statusStore}, &RollbackREST{&store}, &OperationsREST{&store}
 
 }
 
@@ -256,3 +308,26 @@ func (r *ReferenceREST) Get(ctx context.Context, name string, options *metav1.Ge
 func (r *ReferenceREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc) (runtime.Object, bool, error) {
 	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation)
 }
+
+// ForceDeleteREST defines the REST operations for the forcedelete
+// subresource. It supports the http verb POST.
+type ForceDeleteREST struct {
+	store *registry.Store
+}
+
+var (
+	_ rest.Storage      = &ForceDeleteREST{}
+	_ rest.NamedCreater = &ForceDeleteREST{}
+)
+
+// New returns a new ForceDeleteRequest.
+func (r *ForceDeleteREST) New() runtime.Object {
+	return &servicecatalog.ForceDeleteRequest{}
+}
+
+// Create removes the finalizers of the named instance and deletes it without
+// deprovisioning it at the broker. It implements the rest.NamedCreater
+// interface.
+func (r *ForceDeleteREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, includeUninitialized bool) (runtime.Object, error) {
+	return server.ForceDelete(ctx, r.store, name, obj)
+}
@@ -30,3 +30,62 @@ func TestNewListNilField(t *testing.T) {
 		t.Fatalf("nil incorrectly set on Items field")
 	}
 }
+
+func TestClusterServiceClassRefTriggerFunc(t *testing.T) {
+	instance := &servicecatalog.ServiceInstance{}
+	values := ClusterServiceClassRefTriggerFunc(instance)
+	if len(values) != 1 || values[0].IndexName != "spec.clusterServiceClassRef.name" || values[0].Value != "" {
+		t.Fatalf("unexpected trigger values for an unresolved instance: %+v", values)
+	}
+
+	instance.Spec.ClusterServiceClassRef = &servicecatalog.ClusterObjectReference{Name: "test-class"}
+	values = ClusterServiceClassRefTriggerFunc(instance)
+	if len(values) != 1 || values[0].Value != "test-class" {
+		t.Fatalf("unexpected trigger values: %+v", values)
+	}
+}
+
+func TestGetLastOperation(t *testing.T) {
+	operationKey := "test-operation-key"
+	cases := []struct {
+		name     string
+		status   servicecatalog.ServiceInstanceStatus
+		expected string
+	}{
+		{
+			name:     "no operation",
+			expected: "",
+		},
+		{
+			name: "synchronous operation in progress",
+			status: servicecatalog.ServiceInstanceStatus{
+				CurrentOperation: servicecatalog.ServiceInstanceOperationProvision,
+			},
+			expected: "Provision InProgress",
+		},
+		{
+			name: "asynchronous operation in progress",
+			status: servicecatalog.ServiceInstanceStatus{
+				CurrentOperation:  servicecatalog.ServiceInstanceOperationUpdate,
+				AsyncOpInProgress: true,
+				LastOperation:     &operationKey,
+			},
+			expected: "Update InProgress (test-operation-key)",
+		},
+		{
+			name: "completed operation",
+			status: servicecatalog.ServiceInstanceStatus{
+				Operations: []servicecatalog.ServiceInstanceOperationRecord{
+					{Operation: servicecatalog.ServiceInstanceOperationProvision, Result: servicecatalog.ServiceInstanceOperationResultSucceeded},
+					{Operation: servicecatalog.ServiceInstanceOperationUpdate, Result: servicecatalog.ServiceInstanceOperationResultFailed},
+				},
+			},
+			expected: "Update Failed",
+		},
+	}
+	for _, tc := range cases {
+		if e, a := tc.expected, getLastOperation(tc.status); e != a {
+			t.Errorf("%v: expected %q, got %q", tc.name, e, a)
+		}
+	}
+}
@@ -20,6 +20,7 @@ package instance
 
 import (
 	"context"
+	"fmt"
 
 	api "github.com/kubernetes-incubator/service-catalog/pkg/api"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -77,6 +78,7 @@ var (
 	_ rest.RESTUpdateStrategy         = instanceRESTStrategies
 	_ rest.RESTDeleteStrategy         = instanceRESTStrategies
 	_ rest.RESTGracefulDeleteStrategy = instanceRESTStrategies
+	_ rest.RESTExportStrategy         = instanceRESTStrategies
 
 	instanceStatusUpdateStrategy = instanceStatusRESTStrategy{
 		instanceRESTStrategies,
@@ -104,14 +106,15 @@ func (instanceRESTStrategy) NamespaceScoped() bool {
 
 // PrepareForCreate receives a the incoming ServiceInstance and clears it's
 // Status and Service[Class|Plan]Ref fields. These are not user settable fields.
-// It also creates a UUID if the user hasn't specified one.
+// It also creates a UUID if the user hasn't specified one, unless the instance
+// adopts an existing one.
 func (instanceRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	instance, ok := obj.(*sc.ServiceInstance)
 	if !ok {
 		glog.Fatal("received a non-instance object to create")
 	}
 
-	if instance.Spec.ExternalID == "" {
+	if instance.Spec.ExternalID == "" && !instance.Spec.Adopt {
 		instance.Spec.ExternalID = string(uuid.NewUUID())
 	}
 
@@ -177,8 +180,15 @@ func (instanceRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runti
 	}
 
 	// Spec updates bump the generation so that we can distinguish between
-	// spec changes and other changes to the object.
-	if !apiequality.Semantic.DeepEqual(oldServiceInstance.Spec, newServiceInstance.Spec) {
+	// spec changes and other changes to the object. The deletion policies
+	// only matter once the instance is deleted, and the automatic binding is
+	// not sent to the broker with the instance, so changing them alone does
+	// not require the instance to be updated at the broker.
+	oldSpec := oldServiceInstance.Spec
+	oldSpec.DeletionPolicy = newServiceInstance.Spec.DeletionPolicy
+	oldSpec.BindingDeletionPolicy = newServiceInstance.Spec.BindingDeletionPolicy
+	oldSpec.AutoBind = newServiceInstance.Spec.AutoBind
+	if !apiequality.Semantic.DeepEqual(oldSpec, newServiceInstance.Spec) {
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
 			setServiceInstanceUserInfo(ctx, newServiceInstance)
 		}
@@ -216,6 +226,34 @@ func (instanceRESTStrategy) CheckGracefulDelete(ctx context.Context, obj runtime
 	return false
 }
 
+// Export strips the fields of an instance that are not settable by the user,
+// so that it can be re-created in another cluster: its status, its resolved
+// class and plan references, its user info and its finalizers. Unless exact
+// is set, the ExternalID is stripped as well, so that the re-created instance
+// is provisioned anew instead of sharing the identity of the exported one at
+// the broker.
+func (instanceRESTStrategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	instance, ok := obj.(*sc.ServiceInstance)
+	if !ok {
+		return fmt.Errorf("received a non-instance object to export: %T", obj)
+	}
+
+	instance.Status = sc.ServiceInstanceStatus{}
+	instance.Spec.ClusterServiceClassRef = nil
+	instance.Spec.ClusterServicePlanRef = nil
+	instance.Spec.ServiceClassRef = nil
+	instance.Spec.ServicePlanRef = nil
+	instance.Spec.UserInfo = nil
+	instance.Finalizers = nil
+	instance.Generation = 0
+	if !exact {
+		instance.Spec.ExternalID = ""
+		// Adopting an existing instance requires its ExternalID.
+		instance.Spec.Adopt = false
+	}
+	return nil
+}
+
 func (instanceStatusRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
 	newServiceInstance, ok := new.(*sc.ServiceInstance)
 	if !ok {
@@ -93,6 +93,33 @@ func TestInstanceUpdate(t *testing.T) {
 			}(),
 			shouldGenerationIncrement: true,
 		},
+		{
+			name:  "deletion policy change",
+			older: getTestInstance(),
+			newer: func() *servicecatalog.ServiceInstance {
+				i := getTestInstance()
+				i.Spec.DeletionPolicy = servicecatalog.DeletionPolicyAbandon
+				return i
+			}(),
+		},
+		{
+			name:  "binding deletion policy change",
+			older: getTestInstance(),
+			newer: func() *servicecatalog.ServiceInstance {
+				i := getTestInstance()
+				i.Spec.BindingDeletionPolicy = servicecatalog.BindingDeletionPolicyCascade
+				return i
+			}(),
+		},
+		{
+			name:  "auto bind change",
+			older: getTestInstance(),
+			newer: func() *servicecatalog.ServiceInstance {
+				i := getTestInstance()
+				i.Spec.AutoBind = &servicecatalog.AutoBind{}
+				return i
+			}(),
+		},
 		{
 			name:  "external plan name change",
 			older: getTestInstance(),
@@ -278,3 +305,71 @@ func TestExternalIDUserProvided(t *testing.T) {
 	}
 
 }
+
+// TestExternalIDNotSetForAdoption makes sure we don't generate an ExternalID
+// for an instance that adopts an existing one, so that a missing ExternalID
+// fails validation.
+func TestExternalIDNotSetForAdoption(t *testing.T) {
+	createdInstance := getTestInstance()
+	createdInstance.Spec.Adopt = true
+	instanceRESTStrategies.PrepareForCreate(nil, createdInstance)
+
+	if createdInstance.Spec.ExternalID != "" {
+		t.Errorf("Expected no ExternalID to be set, but got %q", createdInstance.Spec.ExternalID)
+	}
+}
+
+// TestInstanceExport checks that exporting an instance strips the fields that
+// are not settable by the user, and the ExternalID unless the export is exact.
+func TestInstanceExport(t *testing.T) {
+	cases := []struct {
+		name               string
+		exact              bool
+		expectedExternalID string
+		expectedAdopt      bool
+	}{
+		{
+			name:               "not exact",
+			exact:              false,
+			expectedExternalID: "",
+			expectedAdopt:      false,
+		},
+		{
+			name:               "exact",
+			exact:              true,
+			expectedExternalID: "my-id",
+			expectedAdopt:      true,
+		},
+	}
+	for _, tc := range cases {
+		instance := getTestInstance()
+		instance.Finalizers = []string{servicecatalog.FinalizerServiceCatalog}
+		instance.Spec.ExternalID = "my-id"
+		instance.Spec.Adopt = true
+		if err := instanceRESTStrategies.Export(nil, instance, tc.exact); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+
+		if instance.Spec.ClusterServiceClassRef != nil || instance.Spec.ClusterServicePlanRef != nil {
+			t.Errorf("%s: expected the class and plan references to be stripped", tc.name)
+		}
+		if instance.Spec.UserInfo != nil {
+			t.Errorf("%s: expected the user info to be stripped, got %+v", tc.name, instance.Spec.UserInfo)
+		}
+		if len(instance.Status.Conditions) != 0 {
+			t.Errorf("%s: expected the status to be stripped, got %+v", tc.name, instance.Status)
+		}
+		if len(instance.Finalizers) != 0 || instance.Generation != 0 {
+			t.Errorf("%s: expected the finalizers and generation to be stripped, got %v and %v", tc.name, instance.Finalizers, instance.Generation)
+		}
+		if e, a := "test-clusterserviceplan", instance.Spec.ClusterServicePlanExternalName; e != a {
+			t.Errorf("%s: unexpected plan: expected %q, got %q", tc.name, e, a)
+		}
+		if e, a := tc.expectedExternalID, instance.Spec.ExternalID; e != a {
+			t.Errorf("%s: unexpected ExternalID: expected %q, got %q", tc.name, e, a)
+		}
+		if e, a := tc.expectedAdopt, instance.Spec.Adopt; e != a {
+			t.Errorf("%s: unexpected Adopt: expected %v, got %v", tc.name, e, a)
+		}
+	}
+}
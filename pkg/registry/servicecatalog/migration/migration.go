@@ -0,0 +1,132 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration rewrites the objects stored by the registries of the
+// service catalog API server, so that they are stored in the current storage
+// version of their API group.
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+// DefaultChunkSize is the default number of objects read from storage at a
+// time while migrating a resource.
+const DefaultChunkSize = 500
+
+// Result describes the migration of the objects of a resource.
+type Result struct {
+	// Objects is the number of objects that were found in storage.
+	Objects int
+	// Rewritten is the number of objects that were written back to storage
+	// because their stored encoding differed from their encoding in the
+	// storage version.
+	Rewritten int
+}
+
+// MigrateStore rewrites the objects of the given store that are not stored in
+// its current storage version.
+//
+// The objects are listed from storage chunkSize at a time. Each object is then
+// read again, decoded, encoded in the storage version and written back if its
+// encoding changed, with the same resource version precondition as any other
+// update, so the concurrent changes of the API server are not lost. Objects
+// already stored in the storage version are left untouched, and objects that
+// are deleted during the migration are skipped. The migration can therefore
+// be run again after a failure, and while the API server is running.
+func MigrateStore(ctx context.Context, store *registry.Store, chunkSize int64) (Result, error) {
+	result := Result{}
+	keyRoot := store.KeyRootFunc(ctx)
+
+	p := storage.Everything
+	p.Limit = chunkSize
+	for {
+		list := store.NewListFunc()
+		if err := store.Storage.List(ctx, keyRoot, "", p, list); err != nil {
+			return result, fmt.Errorf("error listing %v: %v", store.DefaultQualifiedResource, err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return result, err
+		}
+		for _, item := range items {
+			rewritten, err := migrateObject(ctx, store, item)
+			if err != nil {
+				return result, err
+			}
+			result.Objects++
+			if rewritten {
+				result.Rewritten++
+			}
+		}
+
+		listMeta, err := meta.ListAccessor(list)
+		if err != nil {
+			return result, err
+		}
+		if listMeta.GetContinue() == "" {
+			return result, nil
+		}
+		p.Continue = listMeta.GetContinue()
+	}
+}
+
+// migrateObject rewrites the given object in the storage version of the given
+// store, if it is not stored in it yet. It returns whether the object was
+// written back to storage.
+func migrateObject(ctx context.Context, store *registry.Store, obj runtime.Object) (bool, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false, err
+	}
+	key, err := store.KeyFunc(genericapirequest.WithNamespace(ctx, accessor.GetNamespace()), accessor.GetName())
+	if err != nil {
+		return false, err
+	}
+
+	out := store.NewFunc()
+	err = store.Storage.GuaranteedUpdate(ctx, key, out, false /* ignoreNotFound */, nil, func(existing runtime.Object, _ storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		// Returning the object unchanged has the storage encode it in the
+		// storage version, and write it only if that encoding differs from
+		// the stored one.
+		return existing, nil, nil
+	})
+	if storage.IsNotFound(err) {
+		glog.V(4).Infof("Skipping %v %q, which was deleted during the migration", store.DefaultQualifiedResource, key)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error migrating %v %q: %v", store.DefaultQualifiedResource, key, err)
+	}
+
+	outAccessor, err := meta.Accessor(out)
+	if err != nil {
+		return false, err
+	}
+	rewritten := outAccessor.GetResourceVersion() != accessor.GetResourceVersion()
+	if rewritten {
+		glog.V(4).Infof("Migrated %v %q", store.DefaultQualifiedResource, key)
+	}
+	return rewritten, nil
+}
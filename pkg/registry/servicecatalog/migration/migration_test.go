@@ -0,0 +1,166 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+const testPrefix = "/serviceinstances"
+
+// fakeStorage is a storage.Interface holding ServiceInstances in memory. The
+// instances whose keys are in stale are stored in an old version, and are
+// written back by an update that does not change them. It only implements
+// what MigrateStore uses.
+type fakeStorage struct {
+	storage.Interface
+
+	instances map[string]*servicecatalog.ServiceInstance
+	stale     map[string]bool
+	// deletedAfterList are deleted after the first list, as if they were
+	// deleted during the migration.
+	deletedAfterList []string
+	listCalls        int
+}
+
+func (f *fakeStorage) List(ctx context.Context, key, resourceVersion string, p storage.SelectionPredicate, listObj runtime.Object) error {
+	f.listCalls++
+
+	keys := []string{}
+	for k := range f.instances {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// Like etcd, continue from the first key after the last key listed, so
+	// that deletions do not make the next chunk skip keys.
+	start := sort.SearchStrings(keys, p.Continue)
+	end := len(keys)
+	continueToken := ""
+	if p.Limit > 0 && start+int(p.Limit) < end {
+		end = start + int(p.Limit)
+		continueToken = keys[end]
+	}
+
+	list := listObj.(*servicecatalog.ServiceInstanceList)
+	for _, k := range keys[start:end] {
+		list.Items = append(list.Items, *f.instances[k].DeepCopy())
+	}
+	list.Continue = continueToken
+
+	for _, k := range f.deletedAfterList {
+		delete(f.instances, k)
+	}
+	f.deletedAfterList = nil
+	return nil
+}
+
+func (f *fakeStorage) GuaranteedUpdate(ctx context.Context, key string, ptrToType runtime.Object, ignoreNotFound bool, precondtions *storage.Preconditions, tryUpdate storage.UpdateFunc, suggestion ...runtime.Object) error {
+	existing, ok := f.instances[key]
+	if !ok {
+		return storage.NewKeyNotFoundError(key, 0)
+	}
+	updated, _, err := tryUpdate(existing.DeepCopy(), storage.ResponseMeta{})
+	if err != nil {
+		return err
+	}
+	instance := updated.(*servicecatalog.ServiceInstance)
+	if f.stale[key] {
+		rv, err := strconv.Atoi(instance.ResourceVersion)
+		if err != nil {
+			return err
+		}
+		instance.ResourceVersion = strconv.Itoa(rv + 1)
+		f.instances[key] = instance
+		delete(f.stale, key)
+	}
+	*ptrToType.(*servicecatalog.ServiceInstance) = *f.instances[key].DeepCopy()
+	return nil
+}
+
+func newTestStore(f *fakeStorage) *registry.Store {
+	return &registry.Store{
+		NewFunc:     func() runtime.Object { return &servicecatalog.ServiceInstance{} },
+		NewListFunc: func() runtime.Object { return &servicecatalog.ServiceInstanceList{} },
+		KeyRootFunc: func(ctx context.Context) string {
+			return registry.NamespaceKeyRootFunc(ctx, testPrefix)
+		},
+		KeyFunc: func(ctx context.Context, name string) (string, error) {
+			return registry.NamespaceKeyFunc(ctx, testPrefix, name)
+		},
+		DefaultQualifiedResource: servicecatalog.Resource("serviceinstances"),
+		Storage:                  f,
+	}
+}
+
+func newTestInstance(namespace, name string) *servicecatalog.ServiceInstance {
+	return &servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, ResourceVersion: "1"},
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	f := &fakeStorage{
+		instances: map[string]*servicecatalog.ServiceInstance{
+			testPrefix + "/ns-a/instance-1": newTestInstance("ns-a", "instance-1"),
+			testPrefix + "/ns-a/instance-2": newTestInstance("ns-a", "instance-2"),
+			testPrefix + "/ns-b/instance-1": newTestInstance("ns-b", "instance-1"),
+			testPrefix + "/ns-b/instance-2": newTestInstance("ns-b", "instance-2"),
+			testPrefix + "/ns-c/instance-1": newTestInstance("ns-c", "instance-1"),
+		},
+		stale: map[string]bool{
+			testPrefix + "/ns-a/instance-2": true,
+			testPrefix + "/ns-b/instance-1": true,
+		},
+		deletedAfterList: []string{testPrefix + "/ns-a/instance-1"},
+	}
+
+	result, err := MigrateStore(context.Background(), newTestStore(f), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The deleted instance is skipped, but counted as found.
+	if e, a := (Result{Objects: 5, Rewritten: 2}), result; e != a {
+		t.Fatalf("unexpected result: expected %+v, got %+v", e, a)
+	}
+	if e, a := 3, f.listCalls; e != a {
+		t.Fatalf("expected the instances to be listed in %v chunks, got %v", e, a)
+	}
+	if len(f.stale) != 0 {
+		t.Fatalf("expected every instance to be migrated, got %v left", f.stale)
+	}
+	for key, instance := range f.instances {
+		expectedRV := "1"
+		if key == testPrefix+"/ns-a/instance-2" || key == testPrefix+"/ns-b/instance-1" {
+			expectedRV = "2"
+		}
+		if e, a := expectedRV, instance.ResourceVersion; e != a {
+			t.Errorf("%v: unexpected resource version: expected %v, got %v", key, e, a)
+		}
+	}
+}
@@ -21,12 +21,15 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	servicecatalogv1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/binding"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/brokertemplate"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/catalogprojection"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterservicebroker"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterserviceclass"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterserviceplan"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/instance"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/servicebroker"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/servicebrokersummary"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/serviceclass"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/serviceplan"
 	"github.com/kubernetes-incubator/service-catalog/pkg/storage/etcd"
@@ -36,6 +39,7 @@ import (
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
 	"k8s.io/apiserver/pkg/storage"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -47,6 +51,9 @@ type StorageProvider struct {
 	DefaultNamespace string
 	StorageType      server.StorageType
 	RESTClient       restclient.Interface
+	// EventRecorder records the events of the objects of the group. It is
+	// nil when the API server runs without a Kubernetes API server.
+	EventRecorder record.EventRecorder
 }
 
 // NewRESTStorage is a factory method to make a new APIGroupInfo for the
@@ -103,7 +110,7 @@ func (p StorageProvider) v1beta1Storage(
 			ScopeStrategy: clusterserviceclass.NewScopeStrategy(),
 			NewListFunc:   clusterserviceclass.NewList,
 			GetAttrsFunc:  clusterserviceclass.GetAttrs,
-			Trigger:       storage.NoTriggerPublisher,
+			Trigger:       clusterserviceclass.ClusterServiceBrokerNameTriggerFunc,
 		},
 		p.StorageType,
 	)
@@ -120,7 +127,7 @@ func (p StorageProvider) v1beta1Storage(
 			ScopeStrategy: clusterserviceplan.NewScopeStrategy(),
 			NewListFunc:   clusterserviceplan.NewList,
 			GetAttrsFunc:  clusterserviceplan.GetAttrs,
-			Trigger:       storage.NoTriggerPublisher,
+			Trigger:       clusterserviceplan.ClusterServiceBrokerNameTriggerFunc,
 		},
 		p.StorageType,
 	)
@@ -137,7 +144,7 @@ func (p StorageProvider) v1beta1Storage(
 			ScopeStrategy: instance.NewScopeStrategy(),
 			NewListFunc:   instance.NewList,
 			GetAttrsFunc:  instance.GetAttrs,
-			Trigger:       storage.NoTriggerPublisher,
+			Trigger:       instance.ClusterServiceClassRefTriggerFunc,
 		},
 		p.StorageType,
 	)
@@ -154,16 +161,16 @@ func (p StorageProvider) v1beta1Storage(
 			ScopeStrategy: binding.NewScopeStrategy(),
 			NewListFunc:   binding.NewList,
 			GetAttrsFunc:  binding.GetAttrs,
-			Trigger:       storage.NoTriggerPublisher,
+			Trigger:       binding.InstanceRefTriggerFunc,
 		},
 		p.StorageType,
 	)
 
-	clusterServiceBrokerStorage, clusterServiceBrokerStatusStorage := clusterservicebroker.NewStorage(*clusterServiceBrokerOpts)
+	clusterServiceBrokerStorage, clusterServiceBrokerStatusStorage, clusterServiceBrokerRelistStorage, clusterServiceBrokerCatalogStorage := clusterservicebroker.NewStorage(*clusterServiceBrokerOpts)
 	clusterServiceClassStorage, clusterServiceClassStatusStorage := clusterserviceclass.NewStorage(*clusterServiceClassOpts)
 	clusterServicePlanStorage, clusterServicePlanStatusStorage := clusterserviceplan.NewStorage(*clusterServicePlanOpts)
-	instanceStorage, instanceStatusStorage, instanceReferencesStorage := instance.NewStorage(*instanceOpts)
-	bindingStorage, bindingStatusStorage, err := binding.NewStorage(*bindingsOpts)
+	instanceStorage, instanceStatusStorage, instanceReferencesStorage, instanceForceDeleteStorage, instanceRollbackStorage, instanceOperationsStorage := instance.NewStorage(*instanceOpts)
+	bindingStorage, bindingStatusStorage, bindingForceDeleteStorage, bindingAdminUnbindStorage, err := binding.NewStorage(*bindingsOpts, p.EventRecorder)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +178,7 @@ func (p StorageProvider) v1beta1Storage(
 	storageMap := map[string]rest.Storage{
 		"clusterservicebrokers":        clusterServiceBrokerStorage,
 		"clusterservicebrokers/status": clusterServiceBrokerStatusStorage,
+		"clusterservicebrokers/relist": clusterServiceBrokerRelistStorage,
 		"clusterserviceclasses":        clusterServiceClassStorage,
 		"clusterserviceclasses/status": clusterServiceClassStatusStorage,
 		"clusterserviceplans":          clusterServicePlanStorage,
@@ -178,8 +186,16 @@ func (p StorageProvider) v1beta1Storage(
 		"serviceinstances":             instanceStorage,
 		"serviceinstances/status":      instanceStatusStorage,
 		"serviceinstances/reference":   instanceReferencesStorage,
+		"serviceinstances/forcedelete": instanceForceDeleteStorage,
+		"serviceinstances/rollback":    instanceRollbackStorage,
+		"serviceinstances/operations":  instanceOperationsStorage,
 		"servicebindings":              bindingStorage,
 		"servicebindings/status":       bindingStatusStorage,
+		"servicebindings/forcedelete":  bindingForceDeleteStorage,
+		"servicebindings/adminunbind":  bindingAdminUnbindStorage,
+	}
+	if clusterServiceBrokerCatalogStorage != nil {
+		storageMap["clusterservicebrokers/catalog"] = clusterServiceBrokerCatalogStorage
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
@@ -196,7 +212,7 @@ func (p StorageProvider) v1beta1Storage(
 				ScopeStrategy: serviceclass.NewScopeStrategy(),
 				NewListFunc:   serviceclass.NewList,
 				GetAttrsFunc:  serviceclass.GetAttrs,
-				Trigger:       storage.NoTriggerPublisher,
+				Trigger:       serviceclass.ServiceBrokerNameTriggerFunc,
 			},
 			p.StorageType,
 		)
@@ -219,6 +235,60 @@ func (p StorageProvider) v1beta1Storage(
 			p.StorageType,
 		)
 
+		brokerTemplateRESTOptions, err := restOptionsGetter.GetRESTOptions(servicecatalog.Resource("brokertemplates"))
+		if err != nil {
+			return nil, err
+		}
+
+		brokerTemplateOpts := server.NewOptions(
+			etcd.Options{
+				RESTOptions:   brokerTemplateRESTOptions,
+				Capacity:      1000,
+				ObjectType:    brokertemplate.EmptyObject(),
+				ScopeStrategy: brokertemplate.NewScopeStrategy(),
+				NewListFunc:   brokertemplate.NewList,
+				GetAttrsFunc:  brokertemplate.GetAttrs,
+				Trigger:       storage.NoTriggerPublisher,
+			},
+			p.StorageType,
+		)
+
+		catalogProjectionRESTOptions, err := restOptionsGetter.GetRESTOptions(servicecatalog.Resource("catalogprojections"))
+		if err != nil {
+			return nil, err
+		}
+
+		catalogProjectionOpts := server.NewOptions(
+			etcd.Options{
+				RESTOptions:   catalogProjectionRESTOptions,
+				Capacity:      1000,
+				ObjectType:    catalogprojection.EmptyObject(),
+				ScopeStrategy: catalogprojection.NewScopeStrategy(),
+				NewListFunc:   catalogprojection.NewList,
+				GetAttrsFunc:  catalogprojection.GetAttrs,
+				Trigger:       storage.NoTriggerPublisher,
+			},
+			p.StorageType,
+		)
+
+		serviceBrokerSummaryRESTOptions, err := restOptionsGetter.GetRESTOptions(servicecatalog.Resource("servicebrokersummaries"))
+		if err != nil {
+			return nil, err
+		}
+
+		serviceBrokerSummaryOpts := server.NewOptions(
+			etcd.Options{
+				RESTOptions:   serviceBrokerSummaryRESTOptions,
+				Capacity:      1000,
+				ObjectType:    servicebrokersummary.EmptyObject(),
+				ScopeStrategy: servicebrokersummary.NewScopeStrategy(),
+				NewListFunc:   servicebrokersummary.NewList,
+				GetAttrsFunc:  servicebrokersummary.GetAttrs,
+				Trigger:       storage.NoTriggerPublisher,
+			},
+			p.StorageType,
+		)
+
 		servicePlanRESTOptions, err := restOptionsGetter.GetRESTOptions(servicecatalog.Resource("serviceplans"))
 		if err != nil {
 			return nil, err
@@ -232,14 +302,17 @@ func (p StorageProvider) v1beta1Storage(
 				ScopeStrategy: serviceplan.NewScopeStrategy(),
 				NewListFunc:   serviceplan.NewList,
 				GetAttrsFunc:  serviceplan.GetAttrs,
-				Trigger:       storage.NoTriggerPublisher,
+				Trigger:       serviceplan.ServiceBrokerNameTriggerFunc,
 			},
 			p.StorageType,
 		)
 
 		serviceClassStorage, serviceClassStatusStorage := serviceclass.NewStorage(*serviceClassOpts)
 		servicePlanStorage, servicePlanStatusStorage := serviceplan.NewStorage(*servicePlanOpts)
-		serviceBrokerStorage, serviceBrokerStatusStorage := servicebroker.NewStorage(*serviceBrokerOpts)
+		serviceBrokerStorage, serviceBrokerStatusStorage, serviceBrokerCatalogStorage := servicebroker.NewStorage(*serviceBrokerOpts)
+		brokerTemplateStorage := brokertemplate.NewStorage(*brokerTemplateOpts)
+		catalogProjectionStorage := catalogprojection.NewStorage(*catalogProjectionOpts)
+		serviceBrokerSummaryStorage := servicebrokersummary.NewStorage(*serviceBrokerSummaryOpts)
 
 		storageMap["serviceclasses"] = serviceClassStorage
 		storageMap["serviceclasses/status"] = serviceClassStatusStorage
@@ -247,6 +320,12 @@ func (p StorageProvider) v1beta1Storage(
 		storageMap["serviceplans/status"] = servicePlanStatusStorage
 		storageMap["servicebrokers"] = serviceBrokerStorage
 		storageMap["servicebrokers/status"] = serviceBrokerStatusStorage
+		if serviceBrokerCatalogStorage != nil {
+			storageMap["servicebrokers/catalog"] = serviceBrokerCatalogStorage
+		}
+		storageMap["brokertemplates"] = brokerTemplateStorage
+		storageMap["catalogprojections"] = catalogProjectionStorage
+		storageMap["servicebrokersummaries"] = serviceBrokerSummaryStorage
 	}
 
 	return storageMap, nil
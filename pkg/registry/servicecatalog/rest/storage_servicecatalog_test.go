@@ -100,6 +100,9 @@ var _ = Describe("ensure that our storage types implement the appropriate interf
 			"serviceclasses",
 			"serviceplans",
 			"servicebrokers",
+			"brokertemplates",
+			"catalogprojections",
+			"servicebrokersummaries",
 		}
 
 		for _, storage := range storages {
@@ -157,6 +160,37 @@ var _ = Describe("ensure that our storage types implement the appropriate interf
 		checkStatusStorageType(GinkgoT(), &serviceplan.StatusREST{})
 		checkStatusStorageType(GinkgoT(), &instance.StatusREST{})
 		checkStatusStorageType(GinkgoT(), &binding.StatusREST{})
+		checkStatusStorageType(GinkgoT(), &server.CatalogREST{})
+	})
+
+	// The forcedelete, rollback and relist subresources only support POST.
+	It("checks v1beta1 ForceDeleteREST storage", func() {
+		checkForceDeleteStorageType := func(t GinkgoTInterface, s rest.Storage) {
+			if _, isStandardStorage := s.(rest.NamedCreater); !isStandardStorage {
+				t.Errorf("not compliant to named creater interface for %q", s)
+			}
+			// NONE of these things
+			if _, isStandardStorage := s.(rest.Getter); isStandardStorage {
+				t.Errorf("%q was a getter but should not be", s)
+			}
+			if _, isStandardStorage := s.(rest.Updater); isStandardStorage {
+				t.Errorf("%q was an updater but should not be", s)
+			}
+			if _, isStandardStorage := s.(rest.Lister); isStandardStorage {
+				t.Errorf("%q was a lister but should not be", s)
+			}
+			if _, isStandardStorage := s.(rest.GracefulDeleter); isStandardStorage {
+				t.Errorf("%q was a graceful delete but should not be", s)
+			}
+			if _, isStandardStorage := s.(rest.Watcher); isStandardStorage {
+				t.Errorf("%q was a watcher but should not be", s)
+			}
+		}
+
+		checkForceDeleteStorageType(GinkgoT(), &instance.ForceDeleteREST{})
+		checkForceDeleteStorageType(GinkgoT(), &binding.ForceDeleteREST{})
+		checkForceDeleteStorageType(GinkgoT(), &instance.RollbackREST{})
+		checkForceDeleteStorageType(GinkgoT(), &clusterservicebroker.RelistREST{})
 	})
 })
 
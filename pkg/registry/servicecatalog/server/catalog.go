@@ -0,0 +1,195 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+// CatalogREST defines the REST operations for the catalog subresource of a
+// broker. It supports the http verbs GET and PUT: the controller stores the
+// catalog it last fetched from the broker with PUT, and users read it with
+// GET. The catalog is stored under its own key, next to the key of the
+// broker, and is deleted with the broker.
+type CatalogREST struct {
+	brokers  *registry.Store
+	storage  storage.Interface
+	keyFunc  func(context.Context, string) (string, error)
+	resource schema.GroupResource
+}
+
+var (
+	_ rest.Storage = &CatalogREST{}
+	_ rest.Getter  = &CatalogREST{}
+	_ rest.Updater = &CatalogREST{}
+)
+
+// NewCatalogREST returns the catalog subresource of the brokers in the given
+// store, which is configured by the given options. It sets the AfterDelete
+// hook of the store to delete the catalog of a deleted broker, so it must be
+// called before the store is copied for other subresources.
+func NewCatalogREST(opts Options, brokers *registry.Store, namespaced bool) *CatalogREST {
+	prefix := catalogResourcePrefix(opts.ResourcePrefix())
+	storageInterface, _ := generic.NewRawStorage(opts.EtcdOptions.RESTOptions.StorageConfig)
+
+	r := &CatalogREST{
+		brokers: brokers,
+		storage: storageInterface,
+		keyFunc: func(ctx context.Context, name string) (string, error) {
+			if namespaced {
+				return registry.NamespaceKeyFunc(ctx, prefix, name)
+			}
+			return registry.NoNamespaceKeyFunc(ctx, prefix, name)
+		},
+		resource: schema.GroupResource{
+			Group:    brokers.DefaultQualifiedResource.Group,
+			Resource: brokers.DefaultQualifiedResource.Resource + "/catalog",
+		},
+	}
+
+	afterDelete := brokers.AfterDelete
+	brokers.AfterDelete = func(obj runtime.Object) error {
+		if afterDelete != nil {
+			if err := afterDelete(obj); err != nil {
+				return err
+			}
+		}
+		return r.deleteCatalog(obj)
+	}
+	return r
+}
+
+// catalogResourcePrefix returns the prefix of the keys of the catalogs of the
+// brokers stored under the given prefix, such as clusterservicebrokercatalogs
+// for clusterservicebrokers. It is not under the prefix of the brokers, so
+// that listing the brokers does not return their catalogs.
+func catalogResourcePrefix(brokerPrefix string) string {
+	return strings.TrimSuffix(brokerPrefix, "s") + "catalogs"
+}
+
+// New returns a new BrokerCatalog.
+func (r *CatalogREST) New() runtime.Object {
+	return &servicecatalog.BrokerCatalog{}
+}
+
+// Get returns the catalog stored for the named broker. It implements the
+// rest.Getter interface.
+func (r *CatalogREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	key, err := r.keyFunc(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	catalog := &servicecatalog.BrokerCatalog{}
+	if err := r.storage.Get(ctx, key, "", catalog, false); err != nil {
+		if storage.IsNotFound(err) {
+			return nil, apierrors.NewNotFound(r.resource, name)
+		}
+		return nil, apierrors.NewInternalError(err)
+	}
+	return catalog, nil
+}
+
+// Update stores the catalog of the named broker, which must exist. It
+// implements the rest.Updater interface.
+func (r *CatalogREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc) (runtime.Object, bool, error) {
+	broker, err := r.brokers.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	brokerMeta, err := meta.Accessor(broker)
+	if err != nil {
+		return nil, false, err
+	}
+
+	old, err := r.Get(ctx, name, &metav1.GetOptions{})
+	created := apierrors.IsNotFound(err)
+	if created {
+		old = &servicecatalog.BrokerCatalog{}
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	obj, err := objInfo.UpdatedObject(ctx, old)
+	if err != nil {
+		return nil, false, err
+	}
+	catalog, ok := obj.(*servicecatalog.BrokerCatalog)
+	if !ok {
+		return nil, false, apierrors.NewBadRequest(fmt.Sprintf("not a BrokerCatalog: %#v", obj))
+	}
+	if catalog.Name != "" && catalog.Name != name {
+		return nil, false, apierrors.NewBadRequest(fmt.Sprintf("the name of the catalog must be %q", name))
+	}
+	if catalog.Catalog == nil || len(catalog.Catalog.Raw) == 0 {
+		return nil, false, apierrors.NewBadRequest("catalog must be set")
+	}
+
+	toStore := &servicecatalog.BrokerCatalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      brokerMeta.GetName(),
+			Namespace: brokerMeta.GetNamespace(),
+		},
+		RetrievalTime: catalog.RetrievalTime,
+		Catalog:       catalog.Catalog,
+	}
+
+	key, err := r.keyFunc(ctx, name)
+	if err != nil {
+		return nil, false, err
+	}
+	out := &servicecatalog.BrokerCatalog{}
+	err = r.storage.GuaranteedUpdate(ctx, key, out, true, nil, func(existing runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		return toStore, nil, nil
+	})
+	if err != nil {
+		return nil, false, apierrors.NewInternalError(err)
+	}
+	glog.V(5).Infof("Stored the catalog of %v %q", r.brokers.DefaultQualifiedResource, name)
+	return out, created, nil
+}
+
+// deleteCatalog deletes the catalog of the given broker, if it has one.
+func (r *CatalogREST) deleteCatalog(broker runtime.Object) error {
+	accessor, err := meta.Accessor(broker)
+	if err != nil {
+		return err
+	}
+	ctx := genericapirequest.WithNamespace(genericapirequest.NewContext(), accessor.GetNamespace())
+	key, err := r.keyFunc(ctx, accessor.GetName())
+	if err != nil {
+		return err
+	}
+	if err := r.storage.Delete(ctx, key, &servicecatalog.BrokerCatalog{}, nil); err != nil && !storage.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
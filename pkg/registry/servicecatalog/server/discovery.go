@@ -0,0 +1,57 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// Category is the category of every resource of the servicecatalog API group,
+// so that `kubectl get servicecatalog` lists all of them.
+const Category = "servicecatalog"
+
+// DiscoveryStore is a registry.Store that publishes the short names of its
+// resource and the servicecatalog category in the API discovery, where clients
+// such as kubectl find them.
+type DiscoveryStore struct {
+	*registry.Store
+
+	shortNames []string
+}
+
+var _ rest.ShortNamesProvider = &DiscoveryStore{}
+var _ rest.CategoriesProvider = &DiscoveryStore{}
+
+// NewDiscoveryStore returns a DiscoveryStore publishing the given short names
+// for the resource of the given store.
+func NewDiscoveryStore(store *registry.Store, shortNames ...string) *DiscoveryStore {
+	return &DiscoveryStore{
+		Store:      store,
+		shortNames: shortNames,
+	}
+}
+
+// ShortNames implements the rest.ShortNamesProvider interface.
+func (s *DiscoveryStore) ShortNames() []string {
+	return s.shortNames
+}
+
+// Categories implements the rest.CategoriesProvider interface.
+func (s *DiscoveryStore) Categories() []string {
+	return []string{Category}
+}
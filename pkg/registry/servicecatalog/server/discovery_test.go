@@ -0,0 +1,45 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+func TestDiscoveryStore(t *testing.T) {
+	var s rest.Storage = NewDiscoveryStore(&registry.Store{}, "csc")
+
+	shortNames, ok := s.(rest.ShortNamesProvider)
+	if !ok {
+		t.Fatalf("store does not provide short names")
+	}
+	if e, a := []string{"csc"}, shortNames.ShortNames(); !reflect.DeepEqual(e, a) {
+		t.Errorf("unexpected short names: expected %v, got %v", e, a)
+	}
+
+	categories, ok := s.(rest.CategoriesProvider)
+	if !ok {
+		t.Fatalf("store does not provide categories")
+	}
+	if e, a := []string{"servicecatalog"}, categories.Categories(); !reflect.DeepEqual(e, a) {
+		t.Errorf("unexpected categories: expected %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/glog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/client-go/tools/cache"
+)
+
+// FieldIndexedStore is a registry.Store that serves the lists selecting
+// objects by a single value of a field, such as the lookup of a class by its
+// external name, from an in-memory index of the objects by that field, instead
+// of reading every object from storage and filtering them.
+//
+// The index is filled and kept up to date by watching the storage, starting
+// with the first list that can use it. Until it is, and for lists that ask for
+// a resource version or a chunk, lists are served from storage. The objects
+// found in the index are read from storage, so a list never returns an object
+// that no longer matches, but an object may be missing from a list for as long
+// as it takes the index to see the change that made it match.
+type FieldIndexedStore struct {
+	*DiscoveryStore
+
+	field string
+
+	startOnce  sync.Once
+	stopOnce   sync.Once
+	stopCh     chan struct{}
+	indexer    cache.Indexer
+	controller cache.Controller
+}
+
+// NewFieldIndexedStore returns a FieldIndexedStore serving the lists of the
+// given store that select objects by a single value of the given field. The
+// field must be one of the selectable fields of the objects.
+func NewFieldIndexedStore(store *DiscoveryStore, field string) *FieldIndexedStore {
+	s := &FieldIndexedStore{
+		DiscoveryStore: store,
+		field:          field,
+		stopCh:         make(chan struct{}),
+	}
+
+	destroy := store.DestroyFunc
+	store.DestroyFunc = func() {
+		s.stopOnce.Do(func() { close(s.stopCh) })
+		if destroy != nil {
+			destroy()
+		}
+	}
+
+	return s
+}
+
+// List returns the objects matching the given options. It implements the
+// rest.Lister interface.
+func (s *FieldIndexedStore) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	value, ok := s.indexedValue(options)
+	if !ok {
+		return s.Store.List(ctx, options)
+	}
+
+	s.startOnce.Do(s.startIndex)
+	if !s.controller.HasSynced() {
+		return s.Store.List(ctx, options)
+	}
+
+	label := labels.Everything()
+	if options.LabelSelector != nil {
+		label = options.LabelSelector
+	}
+	p := s.PredicateFunc(label, options.FieldSelector)
+	p.IncludeUninitialized = options.IncludeUninitialized
+
+	candidates, err := s.indexer.ByIndex(s.field, value)
+	if err != nil {
+		return nil, err
+	}
+	namespace := genericapirequest.NamespaceValue(ctx)
+	keys := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		key, err := cache.MetaNamespaceKeyFunc(candidate)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var items []runtime.Object
+	for _, key := range keys {
+		objNamespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if namespace != "" && objNamespace != namespace {
+			continue
+		}
+		obj, err := s.Store.Get(genericapirequest.WithNamespace(ctx, objNamespace), name, &metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		matches, err := p.Matches(obj)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			items = append(items, obj)
+		}
+	}
+
+	list := s.NewListFunc()
+	if err := meta.SetList(list, items); err != nil {
+		return nil, err
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return nil, err
+	}
+	listMeta.SetResourceVersion(s.controller.LastSyncResourceVersion())
+	if s.Decorator != nil {
+		if err := s.Decorator(list); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// indexedValue returns the value of the indexed field that the given options
+// select, if the list can be served from the index.
+func (s *FieldIndexedStore) indexedValue(options *metainternalversion.ListOptions) (string, bool) {
+	if options == nil || options.FieldSelector == nil {
+		return "", false
+	}
+	if options.ResourceVersion != "" || options.Limit > 0 || options.Continue != "" {
+		return "", false
+	}
+	return options.FieldSelector.RequiresExactMatch(s.field)
+}
+
+// startIndex starts to fill the index from storage and to keep it up to date.
+func (s *FieldIndexedStore) startIndex() {
+	glog.V(4).Infof("Starting the index of %v by %v", s.DefaultQualifiedResource, s.field)
+
+	ctx := genericapirequest.NewContext()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			p := storage.Everything
+			p.Limit = options.Limit
+			p.Continue = options.Continue
+			list := s.NewListFunc()
+			err := s.Storage.List(ctx, s.KeyRootFunc(ctx), options.ResourceVersion, p, list)
+			return list, err
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return s.Storage.WatchList(ctx, s.KeyRootFunc(ctx), options.ResourceVersion, storage.Everything)
+		},
+	}
+	getAttrs := s.PredicateFunc(labels.Everything(), fields.Everything()).GetAttrs
+	indexers := cache.Indexers{
+		s.field: func(obj interface{}) ([]string, error) {
+			runtimeObj, ok := obj.(runtime.Object)
+			if !ok {
+				return nil, fmt.Errorf("given object is not a runtime.Object: %#v", obj)
+			}
+			_, fieldSet, _, err := getAttrs(runtimeObj)
+			if err != nil {
+				return nil, err
+			}
+			return []string{fieldSet.Get(s.field)}, nil
+		},
+	}
+
+	s.indexer, s.controller = cache.NewIndexerInformer(lw, s.New(), 0, cache.ResourceEventHandlerFuncs{}, indexers)
+	go s.controller.Run(s.stopCh)
+}
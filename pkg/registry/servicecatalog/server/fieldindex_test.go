@@ -0,0 +1,203 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+// fakeStorage is a storage.Interface holding ClusterServiceClasses in memory.
+// It only implements what a FieldIndexedStore uses.
+type fakeStorage struct {
+	storage.Interface
+
+	lock      sync.Mutex
+	classes   map[string]*servicecatalog.ClusterServiceClass
+	listCalls int
+	watcher   *watch.FakeWatcher
+}
+
+func (f *fakeStorage) List(ctx context.Context, key, resourceVersion string, p storage.SelectionPredicate, listObj runtime.Object) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.listCalls++
+
+	var items []runtime.Object
+	for _, class := range f.classes {
+		matches, err := p.Matches(class)
+		if err != nil {
+			return err
+		}
+		if matches {
+			items = append(items, class.DeepCopy())
+		}
+	}
+	if err := meta.SetList(listObj, items); err != nil {
+		return err
+	}
+	listMeta, err := meta.ListAccessor(listObj)
+	if err != nil {
+		return err
+	}
+	listMeta.SetResourceVersion("1")
+	return nil
+}
+
+func (f *fakeStorage) WatchList(ctx context.Context, key, resourceVersion string, p storage.SelectionPredicate) (watch.Interface, error) {
+	return f.watcher, nil
+}
+
+func (f *fakeStorage) Get(ctx context.Context, key, resourceVersion string, objPtr runtime.Object, ignoreNotFound bool) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	class, ok := f.classes[key]
+	if !ok {
+		return storage.NewKeyNotFoundError(key, 0)
+	}
+	*objPtr.(*servicecatalog.ClusterServiceClass) = *class.DeepCopy()
+	return nil
+}
+
+func (f *fakeStorage) update(class *servicecatalog.ClusterServiceClass) {
+	f.lock.Lock()
+	f.classes[class.Name] = class
+	f.lock.Unlock()
+	f.watcher.Modify(class.DeepCopy())
+}
+
+func (f *fakeStorage) getListCalls() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.listCalls
+}
+
+func newTestClass(name, externalName string) *servicecatalog.ClusterServiceClass {
+	class := &servicecatalog.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name, ResourceVersion: "1"},
+	}
+	class.Spec.ExternalName = externalName
+	return class
+}
+
+func newTestFieldIndexedStore(f *fakeStorage) *FieldIndexedStore {
+	return NewFieldIndexedStore(NewDiscoveryStore(&registry.Store{
+		NewFunc:     func() runtime.Object { return &servicecatalog.ClusterServiceClass{} },
+		NewListFunc: func() runtime.Object { return &servicecatalog.ClusterServiceClassList{} },
+		KeyRootFunc: func(ctx context.Context) string { return "" },
+		KeyFunc:     func(ctx context.Context, name string) (string, error) { return name, nil },
+		PredicateFunc: func(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+			return storage.SelectionPredicate{
+				Label: label,
+				Field: field,
+				GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
+					class := obj.(*servicecatalog.ClusterServiceClass)
+					return labels.Set(class.Labels), fields.Set{"spec.externalName": class.Spec.ExternalName}, false, nil
+				},
+			}
+		},
+		DefaultQualifiedResource: servicecatalog.Resource("clusterserviceclasses"),
+		Storage:                  f,
+	}), "spec.externalName")
+}
+
+func listClassNames(t *testing.T, s *FieldIndexedStore, options *metainternalversion.ListOptions) []string {
+	list, err := s.List(context.Background(), options)
+	if err != nil {
+		t.Fatalf("unexpected error listing classes: %v", err)
+	}
+	var names []string
+	for _, class := range list.(*servicecatalog.ClusterServiceClassList).Items {
+		names = append(names, class.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestFieldIndexedStoreList(t *testing.T) {
+	f := &fakeStorage{
+		classes: map[string]*servicecatalog.ClusterServiceClass{
+			"a": newTestClass("a", "foo"),
+			"b": newTestClass("b", "bar"),
+			"c": newTestClass("c", "foo"),
+		},
+		watcher: watch.NewFake(),
+	}
+	s := newTestFieldIndexedStore(f)
+	defer s.DestroyFunc()
+
+	byFoo := &metainternalversion.ListOptions{FieldSelector: fields.OneTermEqualSelector("spec.externalName", "foo")}
+
+	// The first lookup starts the index and is served from storage, or from
+	// the index if it is already filled.
+	if names := listClassNames(t, s, byFoo); !reflect.DeepEqual([]string{"a", "c"}, names) {
+		t.Fatalf("expected to list a and c, got %v", names)
+	}
+	if err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return s.controller.HasSynced(), nil
+	}); err != nil {
+		t.Fatalf("the index did not fill: %v", err)
+	}
+
+	listCalls := f.getListCalls()
+	if names := listClassNames(t, s, byFoo); !reflect.DeepEqual([]string{"a", "c"}, names) {
+		t.Fatalf("expected to list a and c, got %v", names)
+	}
+	if f.getListCalls() != listCalls {
+		t.Fatal("expected the lookup to be served from the index")
+	}
+
+	// The index follows the changes in storage.
+	f.update(newTestClass("b", "foo"))
+	if err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return len(listClassNames(t, s, byFoo)) == 3, nil
+	}); err != nil {
+		t.Fatalf("expected b to be found by its new external name: %v", err)
+	}
+
+	// An object whose index entry is stale is not listed.
+	f.lock.Lock()
+	f.classes["c"] = newTestClass("c", "baz")
+	f.lock.Unlock()
+	if names := listClassNames(t, s, byFoo); !reflect.DeepEqual([]string{"a", "b"}, names) {
+		t.Fatalf("expected to list a and b, got %v", names)
+	}
+
+	// Other lists are served from storage.
+	listClassNames(t, s, &metainternalversion.ListOptions{})
+	if f.getListCalls() != listCalls+1 {
+		t.Fatal("expected a list without a field selector to be served from storage")
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const (
+	// ForceDeleteAuditAnnotation is the key of the audit annotation holding
+	// the reason given for a force deletion.
+	ForceDeleteAuditAnnotation = "servicecatalog.k8s.io/force-delete-reason"
+)
+
+// ForceDelete handles a ForceDeleteRequest for the named object in the given
+// store. The request must confirm the name of the object. The object is
+// deleted and its finalizers are removed, so that it is removed from storage
+// without waiting for the controller to clean up after it at the broker.
+func ForceDelete(ctx context.Context, store *registry.Store, name string, obj runtime.Object) (runtime.Object, error) {
+	request, ok := obj.(*servicecatalog.ForceDeleteRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ForceDeleteRequest: %#v", obj))
+	}
+	if request.Confirm != name {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("confirm must be set to %q to force delete it", name))
+	}
+
+	audit.LogAnnotation(genericapirequest.AuditEventFrom(ctx), ForceDeleteAuditAnnotation, request.Reason)
+	glog.Infof("Force deleting %v %q: %q", store.DefaultQualifiedResource, name, request.Reason)
+
+	if err := DeleteWithoutFinalizers(ctx, store, name); err != nil {
+		return nil, err
+	}
+	return &metav1.Status{Status: metav1.StatusSuccess}, nil
+}
+
+// DeleteWithoutFinalizers deletes the named object in the given store and
+// removes its finalizers, so that it is removed from storage without waiting
+// for the controller to clean up after it at the broker.
+func DeleteWithoutFinalizers(ctx context.Context, store *registry.Store, name string) error {
+	// Delete the object first, so that the controller does not add its
+	// finalizer back, then remove the finalizers to complete the deletion.
+	_, deleted, err := store.Delete(ctx, name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		_, _, err = store.Update(ctx, name, rest.DefaultUpdatedObjectInfo(nil, removeFinalizers), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeFinalizers is a rest.TransformFunc that returns a copy of the old
+// object without any finalizers.
+func removeFinalizers(ctx context.Context, newObj, oldObj runtime.Object) (runtime.Object, error) {
+	newObj = oldObj.DeepCopyObject()
+	accessor, err := meta.Accessor(newObj)
+	if err != nil {
+		return nil, err
+	}
+	accessor.SetFinalizers(nil)
+	return newObj, nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestForceDeleteRequiresConfirmation(t *testing.T) {
+	cases := []struct {
+		name string
+		obj  runtime.Object
+	}{
+		{
+			name: "not a force delete request",
+			obj:  &servicecatalog.ServiceInstance{},
+		},
+		{
+			name: "missing confirmation",
+			obj:  &servicecatalog.ForceDeleteRequest{},
+		},
+		{
+			name: "wrong confirmation",
+			obj:  &servicecatalog.ForceDeleteRequest{Confirm: "other-instance"},
+		},
+	}
+	for _, tc := range cases {
+		// The store is never reached for an unconfirmed request.
+		_, err := ForceDelete(context.Background(), nil, "test-instance", tc.obj)
+		if !apierrors.IsBadRequest(err) {
+			t.Errorf("%v: expected a bad request error, got %v", tc.name, err)
+		}
+	}
+}
@@ -23,9 +23,11 @@ import (
 
 	scmeta "github.com/kubernetes-incubator/service-catalog/pkg/api/meta"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/tableconvertor"
 
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 )
 
 var (
@@ -104,8 +107,9 @@ func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
 }
 
 // NewStorage creates a new rest.Storage responsible for accessing
-// ServiceBroker resources
-func NewStorage(opts server.Options) (serviceBrokers, serviceBrokerStatus rest.Storage) {
+// ServiceBroker resources. The catalog storage is nil unless the
+// BrokerCatalogSubresource feature is enabled.
+func NewStorage(opts server.Options) (serviceBrokers, serviceBrokerStatus, serviceBrokerCatalog rest.Storage) {
 	prefix := "/" + opts.ResourcePrefix()
 
 	storageInterface, dFunc := opts.GetStorage(
@@ -141,6 +145,8 @@ func NewStorage(opts server.Options) (serviceBrokers, serviceBrokerStatus rest.S
 				{Name: "Name", Type: "string", Format: "name"},
 				{Name: "URL", Type: "string"},
 				{Name: "Status", Type: "string"},
+				{Name: "Last Retrieval", Type: "string"},
+				{Name: "Relist", Type: "string", Priority: 1},
 				{Name: "Age", Type: "string"},
 			},
 			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
@@ -154,11 +160,25 @@ func NewStorage(opts server.Options) (serviceBrokers, serviceBrokerStatus rest.S
 					}
 					return ""
 				}
+				getLastCatalogRetrieval := func(status servicecatalog.CommonServiceBrokerStatus) string {
+					if status.LastCatalogRetrievalTime == nil {
+						return "<none>"
+					}
+					return metatable.ConvertToHumanReadableDateType(*status.LastCatalogRetrievalTime)
+				}
+				getRelist := func(spec servicecatalog.CommonServiceBrokerSpec) string {
+					if spec.RelistBehavior == servicecatalog.ServiceBrokerRelistBehaviorDuration && spec.RelistDuration != nil {
+						return fmt.Sprintf("%s (%v)", spec.RelistBehavior, spec.RelistDuration.Duration)
+					}
+					return string(spec.RelistBehavior)
+				}
 				broker := obj.(*servicecatalog.ServiceBroker)
 				cells := []interface{}{
 					name,
 					broker.Spec.URL,
 					getStatus(broker.Status.CommonServiceBrokerStatus),
+					getLastCatalogRetrieval(broker.Status.CommonServiceBrokerStatus),
+					getRelist(broker.Spec.CommonServiceBrokerSpec),
 					age,
 				}
 				return cells, nil
@@ -174,10 +194,14 @@ func NewStorage(opts server.Options) (serviceBrokers, serviceBrokerStatus rest.S
 		panic(err) // TODO: Propagate error up
 	}
 
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.BrokerCatalogSubresource) {
+		serviceBrokerCatalog = server.NewCatalogREST(opts, &store, true)
+	}
+
 	statusStore := store
 	statusStore.UpdateStrategy = serviceBrokerStatusUpdateStrategy
 
-	return &store, &StatusREST{&statusStore}
+	return server.NewDiscoveryStore(&store, "sbr"), &StatusREST{&statusStore}, serviceBrokerCatalog
 }
 
 // StatusREST defines the REST operations for the status subresource via
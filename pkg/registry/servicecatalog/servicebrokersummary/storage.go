@@ -0,0 +1,167 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebrokersummary
+
+import (
+	"errors"
+	"fmt"
+
+	scmeta "github.com/kubernetes-incubator/service-catalog/pkg/api/meta"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/tableconvertor"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+var (
+	errNotAServiceBrokerSummary = errors.New("not a servicebrokersummary")
+)
+
+// NewSingular returns a new shell of a service broker summary, according to the
+// given namespace and name
+func NewSingular(ns, name string) runtime.Object {
+	return &servicecatalog.ServiceBrokerSummary{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "ServiceBrokerSummary",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      name,
+		},
+	}
+}
+
+// EmptyObject returns an empty service broker summary
+func EmptyObject() runtime.Object {
+	return &servicecatalog.ServiceBrokerSummary{}
+}
+
+// NewList returns a new shell of a service broker summary list
+func NewList() runtime.Object {
+	return &servicecatalog.ServiceBrokerSummaryList{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "ServiceBrokerSummaryList",
+		},
+		Items: []servicecatalog.ServiceBrokerSummary{},
+	}
+}
+
+// CheckObject returns a non-nil error if obj is not a service broker summary object
+func CheckObject(obj runtime.Object) error {
+	_, ok := obj.(*servicecatalog.ServiceBrokerSummary)
+	if !ok {
+		return errNotAServiceBrokerSummary
+	}
+	return nil
+}
+
+// Match determines whether a ServiceBrokerSummary matches a field and label
+// selector.
+func Match(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// toSelectableFields returns a field set that represents the object for matching purposes.
+func toSelectableFields(summary *servicecatalog.ServiceBrokerSummary) fields.Set {
+	return generic.ObjectMetaFieldsSet(&summary.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
+	summary, ok := obj.(*servicecatalog.ServiceBrokerSummary)
+	if !ok {
+		return nil, nil, false, fmt.Errorf("given object is not a ServiceBrokerSummary")
+	}
+	return labels.Set(summary.ObjectMeta.Labels), toSelectableFields(summary), summary.Initializers != nil, nil
+}
+
+// NewStorage creates a new rest.Storage responsible for accessing
+// ServiceBrokerSummary resources
+func NewStorage(opts server.Options) rest.Storage {
+	prefix := "/" + opts.ResourcePrefix()
+
+	storageInterface, dFunc := opts.GetStorage(
+		&servicecatalog.ServiceBrokerSummary{},
+		prefix,
+		serviceBrokerSummaryRESTStrategies,
+		NewList,
+		nil,
+		storage.NoTriggerPublisher,
+	)
+
+	store := registry.Store{
+		NewFunc:     EmptyObject,
+		NewListFunc: NewList,
+		KeyRootFunc: opts.KeyRootFunc(),
+		KeyFunc:     opts.KeyFunc(true),
+		// Retrieve the name field of the resource.
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return scmeta.GetAccessor().Name(obj)
+		},
+		// Used to match objects based on labels/fields for list.
+		PredicateFunc: Match,
+		// DefaultQualifiedResource should always be plural
+		DefaultQualifiedResource: servicecatalog.Resource("servicebrokersummaries"),
+
+		CreateStrategy:          serviceBrokerSummaryRESTStrategies,
+		UpdateStrategy:          serviceBrokerSummaryRESTStrategies,
+		DeleteStrategy:          serviceBrokerSummaryRESTStrategies,
+		EnableGarbageCollection: true,
+
+		TableConvertor: tableconvertor.NewTableConvertor(
+			[]metav1beta1.TableColumnDefinition{
+				{Name: "Name", Type: "string", Format: "name"},
+				{Name: "Brokers", Type: "integer"},
+				{Name: "Ready", Type: "integer"},
+				{Name: "Age", Type: "string"},
+			},
+			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
+				summary := obj.(*servicecatalog.ServiceBrokerSummary)
+				cells := []interface{}{
+					name,
+					summary.TotalBrokers,
+					summary.ReadyBrokers,
+					age,
+				}
+				return cells, nil
+			},
+		),
+
+		Storage:     storageInterface,
+		DestroyFunc: dFunc,
+	}
+
+	options := &generic.StoreOptions{RESTOptions: opts.EtcdOptions.RESTOptions, AttrFunc: GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err) // TODO: Propagate error up
+	}
+
+	return server.NewDiscoveryStore(&store, "sbs")
+}
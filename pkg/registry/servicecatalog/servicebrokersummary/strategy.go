@@ -0,0 +1,112 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebrokersummary
+
+import (
+	"context"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/golang/glog"
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+)
+
+// NewScopeStrategy returns a new NamespaceScopedStrategy for service broker summarys
+func NewScopeStrategy() rest.NamespaceScopedStrategy {
+	return serviceBrokerSummaryRESTStrategies
+}
+
+// implements interfaces RESTCreateStrategy, RESTUpdateStrategy, RESTDeleteStrategy,
+// NamespaceScopedStrategy
+type serviceBrokerSummaryRESTStrategy struct {
+	runtime.ObjectTyper // inherit ObjectKinds method
+	names.NameGenerator // GenerateName method for CreateStrategy
+}
+
+var (
+	serviceBrokerSummaryRESTStrategies = serviceBrokerSummaryRESTStrategy{
+		ObjectTyper:   api.Scheme,
+		NameGenerator: names.SimpleNameGenerator,
+	}
+	_ rest.RESTCreateStrategy = serviceBrokerSummaryRESTStrategies
+	_ rest.RESTUpdateStrategy = serviceBrokerSummaryRESTStrategies
+	_ rest.RESTDeleteStrategy = serviceBrokerSummaryRESTStrategies
+)
+
+// Canonicalize does not transform a service broker summary.
+func (serviceBrokerSummaryRESTStrategy) Canonicalize(obj runtime.Object) {
+	_, ok := obj.(*sc.ServiceBrokerSummary)
+	if !ok {
+		glog.Fatal("received a non-servicebrokersummary object to create")
+	}
+}
+
+// NamespaceScoped returns true as servicebrokersummaries are scoped to a
+// namespace.
+func (serviceBrokerSummaryRESTStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate does not transform a service broker summary, which is
+// written as a whole by the controller.
+func (serviceBrokerSummaryRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, ok := obj.(*sc.ServiceBrokerSummary)
+	if !ok {
+		glog.Fatal("received a non-servicebrokersummary object to create")
+	}
+}
+
+func (serviceBrokerSummaryRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return scv.ValidateServiceBrokerSummary(obj.(*sc.ServiceBrokerSummary))
+}
+
+func (serviceBrokerSummaryRESTStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (serviceBrokerSummaryRESTStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (serviceBrokerSummaryRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
+	_, ok := new.(*sc.ServiceBrokerSummary)
+	if !ok {
+		glog.Fatal("received a non-servicebrokersummary object to update to")
+	}
+	_, ok = old.(*sc.ServiceBrokerSummary)
+	if !ok {
+		glog.Fatal("received a non-servicebrokersummary object to update from")
+	}
+}
+
+func (serviceBrokerSummaryRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+	newServiceBrokerSummary, ok := new.(*sc.ServiceBrokerSummary)
+	if !ok {
+		glog.Fatal("received a non-servicebrokersummary object to validate to")
+	}
+	oldServiceBrokerSummary, ok := old.(*sc.ServiceBrokerSummary)
+	if !ok {
+		glog.Fatal("received a non-servicebrokersummary object to validate from")
+	}
+
+	return scv.ValidateServiceBrokerSummaryUpdate(newServiceBrokerSummary, oldServiceBrokerSummary)
+}
@@ -83,12 +83,22 @@ func CheckObject(obj runtime.Object) error {
 // selector.
 func Match(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
 	return storage.SelectionPredicate{
-		Label:    label,
-		Field:    field,
-		GetAttrs: GetAttrs,
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"spec.serviceBrokerName"},
 	}
 }
 
+// ServiceBrokerNameTriggerFunc returns the value of the spec.serviceBrokerName
+// index of a ServiceClass, so that a watch of the classes of a single broker
+// only receives the events of that broker.
+func ServiceBrokerNameTriggerFunc(obj runtime.Object) []storage.MatchValue {
+	serviceClass := obj.(*servicecatalog.ServiceClass)
+	result := storage.MatchValue{IndexName: "spec.serviceBrokerName", Value: serviceClass.Spec.ServiceBrokerName}
+	return []storage.MatchValue{result}
+}
+
 // toSelectableFields returns a field set that represents the object for
 // matching purposes.
 func toSelectableFields(serviceClass *servicecatalog.ServiceClass) fields.Set {
@@ -125,7 +135,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage) {
 		serviceClassRESTStrategies,
 		NewList,
 		nil,
-		storage.NoTriggerPublisher,
+		ServiceBrokerNameTriggerFunc,
 	)
 
 	store := registry.Store{
@@ -153,6 +163,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage) {
 				{Name: "External-Name", Type: "string"},
 				{Name: "Broker", Type: "string"},
 				{Name: "Age", Type: "string"},
+				{Name: "Documentation", Type: "string", Priority: 1},
 			},
 			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
 				class := obj.(*servicecatalog.ServiceClass)
@@ -161,6 +172,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage) {
 					class.Spec.ExternalName,
 					class.Spec.ServiceBrokerName,
 					age,
+					class.Spec.DocumentationURL,
 				}
 				return cells, nil
 			},
@@ -178,7 +190,16 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage) {
 	statusStore := store
 	statusStore.UpdateStrategy = serviceClassStatusUpdateStrategy
 
-	return &store, &StatusREST{&statusStore}
+	// The lookups of classes by their external name are served from an index,
+	// which the strategy also uses to validate that external names are unique
+	// among the classes of a broker.
+	indexedStore := server.NewFieldIndexedStore(server.NewDiscoveryStore(&store, "scl"), "spec.externalName")
+	strategy := serviceClassRESTStrategies
+	strategy.classes = indexedStore
+	store.CreateStrategy = strategy
+	store.UpdateStrategy = strategy
+
+	return indexedStore, &StatusREST{&statusStore}
 }
 
 // StatusREST defines the REST operations for the status subresource via
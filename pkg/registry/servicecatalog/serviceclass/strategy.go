@@ -20,8 +20,11 @@ package serviceclass
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -43,6 +46,11 @@ func NewScopeStrategy() rest.NamespaceScopedStrategy {
 type serviceClassRESTStrategy struct {
 	runtime.ObjectTyper // inherit ObjectKinds method
 	names.NameGenerator // GenerateName method for CreateStrategy
+
+	// classes lists the existing classes, to validate that the external name
+	// of a class is unique among the classes of its broker. No class is
+	// listed if it is nil.
+	classes rest.Lister
 }
 
 // serviceClassStatusRESTStrategy implements interface
@@ -94,8 +102,10 @@ func (serviceClassRESTStrategy) PrepareForCreate(ctx context.Context, obj runtim
 	serviceClass.Status = sc.ServiceClassStatus{}
 }
 
-func (serviceClassRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return scv.ValidateServiceClass(obj.(*sc.ServiceClass))
+func (s serviceClassRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	class := obj.(*sc.ServiceClass)
+	allErrs := scv.ValidateServiceClass(class)
+	return append(allErrs, s.validateUniqueExternalName(ctx, class)...)
 }
 
 func (serviceClassRESTStrategy) AllowCreateOnUpdate() bool {
@@ -122,7 +132,7 @@ func (serviceClassRESTStrategy) PrepareForUpdate(ctx context.Context, new, old r
 	newServiceClass.Spec.ServiceBrokerName = oldServiceClass.Spec.ServiceBrokerName
 }
 
-func (serviceClassRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+func (s serviceClassRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
 	newServiceclass, ok := new.(*sc.ServiceClass)
 	if !ok {
 		glog.Fatal("received a non-serviceclass object to validate to")
@@ -132,7 +142,41 @@ func (serviceClassRESTStrategy) ValidateUpdate(ctx context.Context, new, old run
 		glog.Fatal("received a non-serviceclass object to validate from")
 	}
 
-	return scv.ValidateServiceClassUpdate(newServiceclass, oldServiceclass)
+	allErrs := scv.ValidateServiceClassUpdate(newServiceclass, oldServiceclass)
+	if newServiceclass.Spec.ExternalName != oldServiceclass.Spec.ExternalName {
+		allErrs = append(allErrs, s.validateUniqueExternalName(ctx, newServiceclass)...)
+	}
+	return allErrs
+}
+
+// validateUniqueExternalName validates that no other class of the broker of
+// the given class has the same external name, so that a class is found
+// unambiguously by its external name. The classes that have been removed from
+// the catalog of the broker are ignored, as the broker may have replaced them
+// with the given class.
+func (s serviceClassRESTStrategy) validateUniqueExternalName(ctx context.Context, class *sc.ServiceClass) field.ErrorList {
+	if s.classes == nil || class.Spec.ExternalName == "" {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "externalName")
+	list, err := s.classes.List(ctx, &metainternalversion.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{
+			"spec.externalName":      class.Spec.ExternalName,
+			"spec.serviceBrokerName": class.Spec.ServiceBrokerName,
+		}),
+	})
+	if err != nil {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+	for _, other := range list.(*sc.ServiceClassList).Items {
+		if other.Name == class.Name || other.Status.RemovedFromBrokerCatalog {
+			continue
+		}
+		return field.ErrorList{field.Invalid(fldPath, class.Spec.ExternalName,
+			fmt.Sprintf("the ServiceClass %q of the broker %q has the same external name", other.Name, class.Spec.ServiceBrokerName))}
+	}
+	return nil
 }
 
 func (serviceClassStatusRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
@@ -82,12 +82,22 @@ func CheckObject(obj runtime.Object) error {
 // selector.
 func Match(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
 	return storage.SelectionPredicate{
-		Label:    label,
-		Field:    field,
-		GetAttrs: GetAttrs,
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"spec.serviceBrokerName"},
 	}
 }
 
+// ServiceBrokerNameTriggerFunc returns the value of the spec.serviceBrokerName
+// index of a ServicePlan, so that a watch of the plans of a single broker only
+// receives the events of that broker.
+func ServiceBrokerNameTriggerFunc(obj runtime.Object) []storage.MatchValue {
+	servicePlan := obj.(*servicecatalog.ServicePlan)
+	result := storage.MatchValue{IndexName: "spec.serviceBrokerName", Value: servicePlan.Spec.ServiceBrokerName}
+	return []storage.MatchValue{result}
+}
+
 // toSelectableFields returns a field set that represents the object for matching purposes.
 func toSelectableFields(servicePlan *servicecatalog.ServicePlan) fields.Set {
 	// The purpose of allocation with a given number of elements is to reduce
@@ -124,7 +134,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage) {
 		servicePlanRESTStrategies,
 		NewList,
 		nil,
-		storage.NoTriggerPublisher,
+		ServiceBrokerNameTriggerFunc,
 	)
 
 	store := registry.Store{
@@ -152,6 +162,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage) {
 				{Name: "Broker", Type: "string"},
 				{Name: "Class", Type: "string"},
 				{Name: "Age", Type: "string"},
+				{Name: "Documentation", Type: "string", Priority: 1},
 			},
 			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
 				plan := obj.(*servicecatalog.ServicePlan)
@@ -161,6 +172,7 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage) {
 					plan.Spec.ServiceBrokerName,
 					plan.Spec.ServiceClassRef.Name,
 					age,
+					plan.Spec.DocumentationURL,
 				}
 				return cells, nil
 			},
@@ -173,7 +185,8 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage) {
 	statusStore := store
 	statusStore.UpdateStrategy = servicePlanStatusUpdateStrategy
 
-	return &store, &StatusREST{&statusStore}
+	// The lookups of plans by their external name are served from an index.
+	return server.NewFieldIndexedStore(server.NewDiscoveryStore(&store, "spl"), "spec.externalName"), &StatusREST{&statusStore}
 }
 
 // StatusREST defines the REST operations for the status subresource via
@@ -18,6 +18,7 @@ package servicecatalog
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -132,7 +133,10 @@ func (sdk *SDK) Sync(name string, retries int) error {
 			return err
 		}
 
-		catalog.Spec.RelistRequests = catalog.Spec.RelistRequests + 1
+		if catalog.Annotations == nil {
+			catalog.Annotations = map[string]string{}
+		}
+		catalog.Annotations[v1beta1.RelistRequestedAtAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
 
 		_, err = sdk.ServiceCatalog().ClusterServiceBrokers().Update(catalog)
 		if err == nil {
@@ -0,0 +1,278 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// BrokerManifest is a list of cluster-scoped brokers to register at once,
+// such as the brokers read by "svcat register -f".
+type BrokerManifest struct {
+	Brokers []BrokerManifestEntry `json:"brokers"`
+}
+
+// BrokerManifestEntry is a broker of a BrokerManifest.
+type BrokerManifestEntry struct {
+	// Name is the name of the ClusterServiceBroker.
+	Name string `json:"name"`
+	// URL is the address of the broker.
+	URL string `json:"url"`
+	// InsecureSkipTLSVerify disables the verification of the certificate of
+	// the broker.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// CABundle is a PEM encoded CA bundle used to verify the certificate of
+	// the broker.
+	CABundle []byte `json:"caBundle,omitempty"`
+	// AuthInfo references the secret holding the credentials of the broker.
+	AuthInfo *v1beta1.ClusterServiceBrokerAuthInfo `json:"authInfo,omitempty"`
+	// CatalogRestrictions restricts the classes and plans of the broker that
+	// are added to the catalog.
+	CatalogRestrictions *v1beta1.CatalogRestrictions `json:"catalogRestrictions,omitempty"`
+}
+
+// BrokerChangeAction is what registering a manifest does to a broker.
+type BrokerChangeAction string
+
+const (
+	// BrokerChangeCreate means that the broker does not exist and is created.
+	BrokerChangeCreate BrokerChangeAction = "Create"
+	// BrokerChangeUpdate means that the broker exists and differs from the
+	// manifest.
+	BrokerChangeUpdate BrokerChangeAction = "Update"
+	// BrokerChangeNone means that the broker exists as described by the
+	// manifest.
+	BrokerChangeNone BrokerChangeAction = "Unchanged"
+	// BrokerChangeNotInManifest means that the broker exists but is not in the
+	// manifest. It is left as it is.
+	BrokerChangeNotInManifest BrokerChangeAction = "NotInManifest"
+)
+
+// BrokerChange is the difference between a broker of a manifest and the
+// broker registered in the cluster.
+type BrokerChange struct {
+	Name   string
+	URL    string
+	Action BrokerChangeAction
+	// Fields are the fields of the spec of the broker that differ from the
+	// manifest, for BrokerChangeUpdate.
+	Fields []string
+}
+
+// ParseBrokerManifest reads a BrokerManifest from YAML or JSON. Unknown fields
+// are rejected, so that a misspelled field is not silently ignored.
+func ParseBrokerManifest(data []byte) (*BrokerManifest, error) {
+	j, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid broker manifest (%s)", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(j))
+	decoder.DisallowUnknownFields()
+	manifest := &BrokerManifest{}
+	if err := decoder.Decode(manifest); err != nil {
+		return nil, fmt.Errorf("invalid broker manifest (%s)", err)
+	}
+	return manifest, nil
+}
+
+// Validate checks that the manifest describes brokers that can be registered.
+func (m *BrokerManifest) Validate() error {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("brokers")
+
+	if len(m.Brokers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one broker is required"))
+	}
+	names := sets.NewString()
+	for i, b := range m.Brokers {
+		idxPath := fldPath.Index(i)
+		if b.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Subdomain(b.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), b.Name, msg))
+			}
+			if names.Has(b.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), b.Name))
+			}
+			names.Insert(b.Name)
+		}
+		allErrs = append(allErrs, validateBrokerManifestURL(b.URL, idxPath.Child("url"))...)
+		allErrs = append(allErrs, validateBrokerManifestAuthInfo(b.AuthInfo, idxPath.Child("authInfo"))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateBrokerManifestURL(u string, fldPath *field.Path) field.ErrorList {
+	if u == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, u, err.Error())}
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return field.ErrorList{field.Invalid(fldPath, u, "must be an http or https URL")}
+	}
+	if parsed.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, u, "must have a host")}
+	}
+	return nil
+}
+
+func validateBrokerManifestAuthInfo(authInfo *v1beta1.ClusterServiceBrokerAuthInfo, fldPath *field.Path) field.ErrorList {
+	if authInfo == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+
+	refs := map[string]*v1beta1.ObjectReference{}
+	if authInfo.Basic != nil {
+		refs["basic"] = authInfo.Basic.SecretRef
+	}
+	if authInfo.Bearer != nil {
+		refs["bearer"] = authInfo.Bearer.SecretRef
+	}
+	switch len(refs) {
+	case 0:
+		allErrs = append(allErrs, field.Required(fldPath, "one of basic or bearer is required"))
+	case 2:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of basic or bearer may be set"))
+	}
+	for method, ref := range refs {
+		refPath := fldPath.Child(method, "secretRef")
+		if ref == nil {
+			allErrs = append(allErrs, field.Required(refPath, ""))
+			continue
+		}
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), ""))
+		}
+		if ref.Namespace == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("namespace"), ""))
+		}
+	}
+
+	return allErrs
+}
+
+// broker returns the ClusterServiceBroker described by the entry.
+func (b *BrokerManifestEntry) broker() *v1beta1.ClusterServiceBroker {
+	broker := &v1beta1.ClusterServiceBroker{ObjectMeta: v1.ObjectMeta{Name: b.Name}}
+	b.applyTo(&broker.Spec)
+	return broker
+}
+
+// applyTo sets the fields of the spec that the entry describes.
+func (b *BrokerManifestEntry) applyTo(spec *v1beta1.ClusterServiceBrokerSpec) {
+	spec.URL = b.URL
+	spec.InsecureSkipTLSVerify = b.InsecureSkipTLSVerify
+	spec.CABundle = b.CABundle
+	spec.AuthInfo = b.AuthInfo
+	spec.CatalogRestrictions = b.CatalogRestrictions
+}
+
+// diff returns the fields of the spec that differ from the entry. The fields
+// that a manifest does not describe, such as the relist behavior, are ignored.
+func (b *BrokerManifestEntry) diff(spec v1beta1.ClusterServiceBrokerSpec) []string {
+	var fields []string
+	if spec.URL != b.URL {
+		fields = append(fields, "url")
+	}
+	if spec.InsecureSkipTLSVerify != b.InsecureSkipTLSVerify {
+		fields = append(fields, "insecureSkipTLSVerify")
+	}
+	if !bytes.Equal(spec.CABundle, b.CABundle) {
+		fields = append(fields, "caBundle")
+	}
+	if !reflect.DeepEqual(spec.AuthInfo, b.AuthInfo) {
+		fields = append(fields, "authInfo")
+	}
+	if !reflect.DeepEqual(spec.CatalogRestrictions, b.CatalogRestrictions) {
+		fields = append(fields, "catalogRestrictions")
+	}
+	return fields
+}
+
+// RegisterBrokers creates the brokers of the manifest that do not exist yet
+// and updates the ones that differ from it. The brokers that are not in the
+// manifest are reported but left as they are. With dryRun, the changes are
+// only reported. The changes made before an error are returned with it.
+func (sdk *SDK) RegisterBrokers(manifest *BrokerManifest, dryRun bool) ([]BrokerChange, error) {
+	list, err := sdk.ServiceCatalog().ClusterServiceBrokers().List(v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list cluster-scoped brokers (%s)", err)
+	}
+	existing := map[string]*v1beta1.ClusterServiceBroker{}
+	for i := range list.Items {
+		existing[list.Items[i].Name] = &list.Items[i]
+	}
+
+	var changes []BrokerChange
+	for _, entry := range manifest.Brokers {
+		change := BrokerChange{Name: entry.Name, URL: entry.URL}
+		broker, ok := existing[entry.Name]
+		delete(existing, entry.Name)
+		if !ok {
+			change.Action = BrokerChangeCreate
+			if !dryRun {
+				if _, err := sdk.ServiceCatalog().ClusterServiceBrokers().Create(entry.broker()); err != nil {
+					return changes, fmt.Errorf("register request for broker %s failed (%s)", entry.Name, err)
+				}
+			}
+			changes = append(changes, change)
+			continue
+		}
+
+		change.Fields = entry.diff(broker.Spec)
+		change.Action = BrokerChangeNone
+		if len(change.Fields) > 0 {
+			change.Action = BrokerChangeUpdate
+			if !dryRun {
+				entry.applyTo(&broker.Spec)
+				if _, err := sdk.ServiceCatalog().ClusterServiceBrokers().Update(broker); err != nil {
+					return changes, fmt.Errorf("update request for broker %s failed (%s)", entry.Name, err)
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	var others []string
+	for name := range existing {
+		others = append(others, name)
+	}
+	sort.Strings(others)
+	for _, name := range others {
+		changes = append(changes, BrokerChange{Name: name, URL: existing[name].Spec.URL, Action: BrokerChangeNotInManifest})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/testing"
+
+	. "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BrokerManifest", func() {
+	Describe("ParseBrokerManifest", func() {
+		It("reads the brokers of a YAML manifest", func() {
+			manifest, err := ParseBrokerManifest([]byte(`
+brokers:
+- name: mysql
+  url: https://mysql.example.com
+  authInfo:
+    basic:
+      secretRef:
+        namespace: brokers
+        name: mysql-auth
+  catalogRestrictions:
+    servicePlan:
+    - spec.free==true
+- name: redis
+  url: http://redis.example.com
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifest.Brokers).To(HaveLen(2))
+			Expect(manifest.Brokers[0].Name).To(Equal("mysql"))
+			Expect(manifest.Brokers[0].AuthInfo.Basic.SecretRef.Name).To(Equal("mysql-auth"))
+			Expect(manifest.Brokers[0].CatalogRestrictions.ServicePlan).To(Equal([]string{"spec.free==true"}))
+			Expect(manifest.Brokers[1].URL).To(Equal("http://redis.example.com"))
+		})
+		It("rejects unknown fields", func() {
+			_, err := ParseBrokerManifest([]byte(`
+brokers:
+- name: mysql
+  uri: https://mysql.example.com
+`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("uri"))
+		})
+	})
+	Describe("Validate", func() {
+		It("accepts a valid manifest", func() {
+			manifest := &BrokerManifest{Brokers: []BrokerManifestEntry{
+				{Name: "mysql", URL: "https://mysql.example.com"},
+			}}
+			Expect(manifest.Validate()).To(Succeed())
+		})
+		It("reports every invalid field", func() {
+			manifest := &BrokerManifest{Brokers: []BrokerManifestEntry{
+				{Name: "mysql", URL: "mysql.example.com"},
+				{Name: "mysql", URL: "https://other.example.com"},
+				{Name: "Redis", URL: "https://redis.example.com", AuthInfo: &v1beta1.ClusterServiceBrokerAuthInfo{
+					Bearer: &v1beta1.ClusterBearerTokenAuthConfig{SecretRef: &v1beta1.ObjectReference{Name: "redis-token"}},
+				}},
+			}}
+			err := manifest.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("brokers[0].url"))
+			Expect(err.Error()).To(ContainSubstring(`brokers[1].name: Duplicate value: "mysql"`))
+			Expect(err.Error()).To(ContainSubstring("brokers[2].name"))
+			Expect(err.Error()).To(ContainSubstring("brokers[2].authInfo.bearer.secretRef.namespace: Required value"))
+		})
+		It("requires a broker", func() {
+			Expect((&BrokerManifest{}).Validate()).NotTo(Succeed())
+		})
+	})
+	Describe("RegisterBrokers", func() {
+		var (
+			sdk          *SDK
+			svcCatClient *fake.Clientset
+			manifest     *BrokerManifest
+		)
+
+		BeforeEach(func() {
+			unchanged := &v1beta1.ClusterServiceBroker{ObjectMeta: metav1.ObjectMeta{Name: "redis"}}
+			unchanged.Spec.URL = "https://redis.example.com"
+			unchanged.Spec.RelistBehavior = v1beta1.ServiceBrokerRelistBehaviorManual
+			changed := &v1beta1.ClusterServiceBroker{ObjectMeta: metav1.ObjectMeta{Name: "mysql"}}
+			changed.Spec.URL = "https://old-mysql.example.com"
+			other := &v1beta1.ClusterServiceBroker{ObjectMeta: metav1.ObjectMeta{Name: "legacy"}}
+			other.Spec.URL = "https://legacy.example.com"
+			svcCatClient = fake.NewSimpleClientset(unchanged, changed, other)
+			sdk = &SDK{ServiceCatalogClient: svcCatClient}
+
+			manifest = &BrokerManifest{Brokers: []BrokerManifestEntry{
+				{Name: "mysql", URL: "https://mysql.example.com", CatalogRestrictions: &v1beta1.CatalogRestrictions{
+					ServicePlan: []string{"spec.free==true"},
+				}},
+				{Name: "redis", URL: "https://redis.example.com"},
+				{Name: "mongodb", URL: "https://mongodb.example.com"},
+			}}
+		})
+
+		It("creates and updates the brokers that differ from the manifest", func() {
+			changes, err := sdk.RegisterBrokers(manifest, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]BrokerChange{
+				{Name: "mysql", URL: "https://mysql.example.com", Action: BrokerChangeUpdate, Fields: []string{"url", "catalogRestrictions"}},
+				{Name: "redis", URL: "https://redis.example.com", Action: BrokerChangeNone},
+				{Name: "mongodb", URL: "https://mongodb.example.com", Action: BrokerChangeCreate},
+				{Name: "legacy", URL: "https://legacy.example.com", Action: BrokerChangeNotInManifest},
+			}))
+
+			actions := svcCatClient.Actions()
+			Expect(actions).To(HaveLen(3))
+			Expect(actions[1].Matches("update", "clusterservicebrokers")).To(BeTrue())
+			updated := actions[1].(testing.UpdateActionImpl).Object.(*v1beta1.ClusterServiceBroker)
+			Expect(updated.Spec.URL).To(Equal("https://mysql.example.com"))
+			Expect(updated.Spec.CatalogRestrictions.ServicePlan).To(Equal([]string{"spec.free==true"}))
+			Expect(actions[2].Matches("create", "clusterservicebrokers")).To(BeTrue())
+			created := actions[2].(testing.CreateActionImpl).Object.(*v1beta1.ClusterServiceBroker)
+			Expect(created.Name).To(Equal("mongodb"))
+		})
+		It("only reports the changes with dryRun", func() {
+			changes, err := sdk.RegisterBrokers(manifest, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(HaveLen(4))
+
+			actions := svcCatClient.Actions()
+			Expect(actions).To(HaveLen(1))
+			Expect(actions[0].Matches("list", "clusterservicebrokers")).To(BeTrue())
+		})
+	})
+})
@@ -224,7 +224,7 @@ var _ = Describe("Broker", func() {
 		})
 	})
 	Describe("Sync", func() {
-		It("Useds the generated v1beta1 Retrieve method to get the broker, and then updates it with a new RelistRequests", func() {
+		It("Useds the generated v1beta1 Retrieve method to get the broker, and then updates it with a new relist request", func() {
 			err := sdk.Sync(csb.Name, 3)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -234,7 +234,7 @@ var _ = Describe("Broker", func() {
 			Expect(actions[0].(testing.GetActionImpl).Name).To(Equal(csb.Name))
 
 			Expect(actions[1].Matches("update", "clusterservicebrokers")).To(BeTrue())
-			Expect(actions[1].(testing.UpdateActionImpl).Object.(*v1beta1.ClusterServiceBroker).Spec.RelistRequests).Should(BeNumerically(">", 0))
+			Expect(actions[1].(testing.UpdateActionImpl).Object.(*v1beta1.ClusterServiceBroker).Annotations).Should(HaveKey(v1beta1.RelistRequestedAtAnnotation))
 		})
 	})
 })
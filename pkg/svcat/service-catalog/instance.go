@@ -17,8 +17,10 @@ limitations under the License.
 package servicecatalog
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
@@ -26,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 const (
@@ -63,6 +66,16 @@ func (sdk *SDK) RetrieveInstances(ns, classFilter, planFilter string) (*v1beta1.
 	return &filtered, nil
 }
 
+// RetrieveInstancesBySelector lists the instances in a namespace that match
+// the given label selector.
+func (sdk *SDK) RetrieveInstancesBySelector(ns, selector string) (*v1beta1.ServiceInstanceList, error) {
+	instances, err := sdk.ServiceCatalog().ServiceInstances(ns).List(v1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list instances in %s (%s)", ns, err)
+	}
+	return instances, nil
+}
+
 // RetrieveInstance gets an instance by its name.
 func (sdk *SDK) RetrieveInstance(ns, name string) (*v1beta1.ServiceInstance, error) {
 	instance, err := sdk.ServiceCatalog().ServiceInstances(ns).Get(name, v1.GetOptions{})
@@ -225,6 +238,119 @@ func (sdk *SDK) TouchInstance(ns, name string, retries int) error {
 	return fmt.Errorf("could not sync service broker after %d tries", retries)
 }
 
+// ValidatePlanUpgrade returns the plan with the given external name of the
+// class of the instance, after checking that the plan the instance has
+// declares it as a valid upgrade path.
+func (sdk *SDK) ValidatePlanUpgrade(instance *v1beta1.ServiceInstance, planName string) (*v1beta1.ClusterServicePlan, error) {
+	if instance.Spec.ClusterServiceClassRef == nil {
+		return nil, fmt.Errorf("the class of instance %s/%s has not been resolved", instance.Namespace, instance.Name)
+	}
+
+	// The plan the broker has for the instance is the one that restricts
+	// where it may go, even if the spec already names another plan.
+	var currentPlanName string
+	if props := instance.Status.ExternalProperties; props != nil {
+		currentPlanName = props.ClusterServicePlanExternalID
+	} else if instance.Spec.ClusterServicePlanRef != nil {
+		currentPlanName = instance.Spec.ClusterServicePlanRef.Name
+	}
+
+	class := &v1beta1.ClusterServiceClass{
+		ObjectMeta: v1.ObjectMeta{Name: instance.Spec.ClusterServiceClassRef.Name},
+	}
+	plans, err := sdk.RetrievePlansByClass(class)
+	if err != nil {
+		return nil, err
+	}
+	var currentPlan, targetPlan *v1beta1.ClusterServicePlan
+	for i := range plans {
+		if plans[i].Spec.ExternalName == planName {
+			targetPlan = &plans[i]
+		}
+		if plans[i].Name == currentPlanName {
+			currentPlan = &plans[i]
+		}
+	}
+	if targetPlan == nil {
+		return nil, fmt.Errorf("plan not found '%s'", planName)
+	}
+	if currentPlan != nil && !currentPlan.Spec.AllowsUpgradeTo(&targetPlan.Spec.CommonServicePlanSpec) {
+		paths, _ := currentPlan.Spec.GetUpgradePaths()
+		return nil, fmt.Errorf("plan '%s' cannot be upgraded to '%s'; valid upgrade paths are: %s",
+			currentPlan.Spec.ExternalName, planName, strings.Join(paths, ", "))
+	}
+	return targetPlan, nil
+}
+
+// UpdateInstancePlan changes the plan of an instance to the given plan,
+// referring to it in the same way as the instance refers to its class.
+func (sdk *SDK) UpdateInstancePlan(ns, name string, plan *v1beta1.ClusterServicePlan, retries int) (*v1beta1.ServiceInstance, error) {
+	for j := 0; j < retries; j++ {
+		inst, err := sdk.RetrieveInstance(ns, name)
+		if err != nil {
+			return nil, err
+		}
+
+		inst.Spec.ClusterServicePlanExternalName = ""
+		inst.Spec.ClusterServicePlanExternalID = ""
+		inst.Spec.ClusterServicePlanName = ""
+		switch {
+		case inst.Spec.ClusterServiceClassExternalName != "":
+			inst.Spec.ClusterServicePlanExternalName = plan.Spec.ExternalName
+		case inst.Spec.ClusterServiceClassExternalID != "":
+			inst.Spec.ClusterServicePlanExternalID = plan.Spec.ExternalID
+		default:
+			inst.Spec.ClusterServicePlanName = plan.Name
+		}
+
+		result, err := sdk.ServiceCatalog().ServiceInstances(ns).Update(inst)
+		if err == nil {
+			return result, nil
+		}
+		// if we didn't get a conflict, no idea what happened
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("could not update the plan of the instance (%s)", err)
+		}
+	}
+
+	// conflict after `retries` tries
+	return nil, fmt.Errorf("could not update the plan of the instance after %d tries", retries)
+}
+
+// UpdateInstanceParameters merges the given parameters into the parameters
+// of an instance, replacing the values of the parameters that it already has.
+func (sdk *SDK) UpdateInstanceParameters(ns, name string, params map[string]interface{}, retries int) (*v1beta1.ServiceInstance, error) {
+	for j := 0; j < retries; j++ {
+		inst, err := sdk.RetrieveInstance(ns, name)
+		if err != nil {
+			return nil, err
+		}
+
+		merged := make(map[string]interface{})
+		if inst.Spec.Parameters != nil && len(inst.Spec.Parameters.Raw) > 0 {
+			if err := json.Unmarshal(inst.Spec.Parameters.Raw, &merged); err != nil {
+				return nil, fmt.Errorf("could not read the parameters of the instance (%s)", err)
+			}
+		}
+		for k, v := range params {
+			merged[k] = v
+		}
+		inst.Spec.Parameters = BuildParameters(merged)
+
+		result, err := sdk.ServiceCatalog().ServiceInstances(ns).Update(inst)
+		if err == nil {
+			return result, nil
+		}
+		// if we didn't get a conflict, no idea what happened
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("could not update the parameters of the instance (%s)", err)
+		}
+	}
+
+	// conflict after `retries` tries
+	return nil, fmt.Errorf("could not update the parameters of the instance after %d tries", retries)
+}
+
 // WaitForInstance waits for the instance to complete the current operation (or fail).
 func (sdk *SDK) WaitForInstance(ns, name string, interval time.Duration, timeout *time.Duration) (instance *v1beta1.ServiceInstance, err error) {
 	if timeout == nil {
@@ -254,6 +380,13 @@ func (sdk *SDK) WaitForInstance(ns, name string, interval time.Duration, timeout
 	return instance, err
 }
 
+// WatchInstance watches the instance for changes to its status.
+func (sdk *SDK) WatchInstance(ns, name string) (watch.Interface, error) {
+	return sdk.ServiceCatalog().ServiceInstances(ns).Watch(v1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+	})
+}
+
 // IsInstanceReady returns if the instance is in the Ready status.
 func (sdk *SDK) IsInstanceReady(instance *v1beta1.ServiceInstance) bool {
 	return sdk.InstanceHasStatus(instance, v1beta1.ServiceInstanceConditionReady)
@@ -194,6 +194,34 @@ var _ = Describe("Instances", func() {
 			Expect(actions[0].(testing.ListActionImpl).GetListRestrictions().Fields.Matches(opts)).To(BeTrue())
 		})
 	})
+	Describe("RetrieveInstancesBySelector", func() {
+		It("Calls the generated v1beta1 List method with the specified namespace and label selector", func() {
+			namespace := si.Namespace
+			selector := "app=shop"
+
+			_, err := sdk.RetrieveInstancesBySelector(namespace, selector)
+
+			Expect(err).NotTo(HaveOccurred())
+			actions := svcCatClient.Actions()
+			Expect(actions[0].Matches("list", "serviceinstances")).To(BeTrue())
+			list := actions[0].(testing.ListActionImpl)
+			Expect(list.Namespace).To(Equal(namespace))
+			Expect(list.GetListRestrictions().Labels.String()).To(Equal(selector))
+		})
+		It("Bubbles up errors", func() {
+			badClient := &fake.Clientset{}
+			errorMessage := "error retrieving list"
+			badClient.AddReactor("list", "serviceinstances", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, fmt.Errorf("%s", errorMessage)
+			})
+			sdk.ServiceCatalogClient = badClient
+
+			instances, err := sdk.RetrieveInstancesBySelector(si.Namespace, "app=shop")
+			Expect(instances).To(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(errorMessage))
+		})
+	})
 	Describe("UpdateInstance", func() {
 		It("Properly increments the update requests field", func() {
 			namespace := "cherry_namespace"
@@ -246,6 +274,22 @@ var _ = Describe("Instances", func() {
 			Expect(obj.Spec.UpdateRequests).To(Equal(int64(1)))
 		})
 	})
+	Describe("UpdateInstanceParameters", func() {
+		It("Merges the parameters into the parameters of the instance", func() {
+			si.Spec.Parameters = BuildParameters(map[string]interface{}{"tier": "silver", "region": "eu"})
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.UpdateInstanceParameters(si.Namespace, si.Name, map[string]interface{}{"tier": "gold"}, 3)
+			Expect(err).NotTo(HaveOccurred())
+
+			actions := svcCatClient.Actions()
+			Expect(len(actions)).To(Equal(2))
+			Expect(actions[1].Matches("update", "serviceinstances")).To(BeTrue())
+			obj := actions[1].(testing.UpdateActionImpl).Object.(*v1beta1.ServiceInstance)
+			Expect(string(obj.Spec.Parameters.Raw)).To(MatchJSON(`{"tier": "gold", "region": "eu"}`))
+		})
+	})
 	Describe("InstanceParentHierarchy", func() {
 		It("calls the v1beta1 generated Get function repeatedly to build the heirarchy of the passed in service isntance", func() {
 			broker := &v1beta1.ClusterServiceBroker{ObjectMeta: metav1.ObjectMeta{Name: "foobar_broker"}}
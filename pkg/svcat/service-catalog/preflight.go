@@ -0,0 +1,291 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreflightSeverity is how serious a PreflightIssue is.
+type PreflightSeverity string
+
+const (
+	// PreflightBlocker is an issue that must be fixed before upgrading.
+	PreflightBlocker PreflightSeverity = "Blocker"
+
+	// PreflightWarning is an issue that does not prevent an upgrade.
+	PreflightWarning PreflightSeverity = "Warning"
+)
+
+// PreflightIssue is a problem with a stored object found by Preflight.
+type PreflightIssue struct {
+	Severity  PreflightSeverity
+	Kind      string
+	Namespace string
+	Name      string
+	Message   string
+}
+
+// releaseVersion is the major and minor version of a Service Catalog release.
+type releaseVersion struct {
+	major, minor int
+}
+
+func (v releaseVersion) atLeast(other releaseVersion) bool {
+	return v.major > other.major || (v.major == other.major && v.minor >= other.minor)
+}
+
+var (
+	// reconciledGenerationRemovedIn is the release from which the controller
+	// no longer reads the deprecated status.reconciledGeneration of
+	// instances, and relies on status.observedGeneration instead.
+	reconciledGenerationRemovedIn = releaseVersion{0, 2}
+
+	// minOSBAPIVersions are the oldest versions of the Open Service Broker
+	// API supported from each release on, newest release first.
+	minOSBAPIVersions = []struct {
+		since   releaseVersion
+		version string
+	}{
+		{since: releaseVersion{0, 2}, version: "2.13"},
+		{since: releaseVersion{0, 1}, version: "2.11"},
+	}
+)
+
+// parseReleaseVersion parses a version such as "v0.2.0" or "0.2". The patch
+// version and any pre-release suffix are ignored.
+func parseReleaseVersion(s string) (releaseVersion, error) {
+	parts := strings.SplitN(strings.TrimPrefix(s, "v"), ".", 3)
+	if len(parts) < 2 {
+		return releaseVersion{}, fmt.Errorf("invalid version %q, expected MAJOR.MINOR[.PATCH]", s)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return releaseVersion{}, fmt.Errorf("invalid major version in %q (%s)", s, err)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return releaseVersion{}, fmt.Errorf("invalid minor version in %q (%s)", s, err)
+	}
+	return releaseVersion{major: major, minor: minor}, nil
+}
+
+// osbAPIVersionLess returns whether the OSB API version a, such as "2.12",
+// is older than b.
+func osbAPIVersionLess(a, b string) bool {
+	av, err := parseReleaseVersion(a)
+	if err != nil {
+		return true
+	}
+	bv, err := parseReleaseVersion(b)
+	if err != nil {
+		return false
+	}
+	return !av.atLeast(bv)
+}
+
+// Preflight inspects the stored objects for problems that would block an
+// upgrade to the given version of Service Catalog.
+func (sdk *SDK) Preflight(targetVersion string) ([]PreflightIssue, error) {
+	target, err := parseReleaseVersion(targetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []PreflightIssue
+	brokerIssues, err := sdk.preflightBrokers(target)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, brokerIssues...)
+
+	instanceIssues, err := sdk.preflightInstances(target)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, instanceIssues...)
+
+	return issues, nil
+}
+
+// preflightBrokers checks that the auth secrets of the brokers are usable
+// and that the brokers support the OSB API versions of the target release.
+func (sdk *SDK) preflightBrokers(target releaseVersion) ([]PreflightIssue, error) {
+	var minOSBAPIVersion string
+	for _, v := range minOSBAPIVersions {
+		if target.atLeast(v.since) {
+			minOSBAPIVersion = v.version
+			break
+		}
+	}
+
+	var issues []PreflightIssue
+	check := func(kind, namespace, name string, status v1beta1.CommonServiceBrokerStatus, authIssue string) {
+		issue := PreflightIssue{Kind: kind, Namespace: namespace, Name: name}
+		if authIssue != "" {
+			issue.Severity = PreflightBlocker
+			issue.Message = authIssue
+			issues = append(issues, issue)
+		}
+		if minOSBAPIVersion == "" {
+			return
+		}
+		switch {
+		case status.OSBAPIVersion == "":
+			issue.Severity = PreflightWarning
+			issue.Message = "the broker has not been relisted successfully, so its OSB API version is unknown"
+			issues = append(issues, issue)
+		case osbAPIVersionLess(status.OSBAPIVersion, minOSBAPIVersion):
+			issue.Severity = PreflightBlocker
+			issue.Message = fmt.Sprintf("the broker uses OSB API version %s, but at least %s is required", status.OSBAPIVersion, minOSBAPIVersion)
+			issues = append(issues, issue)
+		}
+	}
+
+	clusterBrokers, err := sdk.ServiceCatalog().ClusterServiceBrokers().List(v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list cluster-scoped brokers (%s)", err)
+	}
+	for _, b := range clusterBrokers.Items {
+		var authIssue string
+		if auth := b.Spec.AuthInfo; auth != nil {
+			switch {
+			case auth.Basic != nil && auth.Basic.SecretRef != nil:
+				authIssue = sdk.checkAuthSecret(auth.Basic.SecretRef.Namespace, auth.Basic.SecretRef.Name, "username", "password")
+			case auth.Bearer != nil && auth.Bearer.SecretRef != nil:
+				authIssue = sdk.checkAuthSecret(auth.Bearer.SecretRef.Namespace, auth.Bearer.SecretRef.Name, "token")
+			default:
+				authIssue = "the auth info of the broker does not reference a secret"
+			}
+		}
+		check("ClusterServiceBroker", "", b.Name, b.Status.CommonServiceBrokerStatus, authIssue)
+	}
+
+	brokers, err := sdk.ServiceCatalog().ServiceBrokers("").List(v1.ListOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to list brokers (%s)", err)
+	}
+	if err == nil {
+		for _, b := range brokers.Items {
+			secretNamespace := func(namespace string) string {
+				if namespace != "" {
+					return namespace
+				}
+				return b.Namespace
+			}
+			var authIssue string
+			if auth := b.Spec.AuthInfo; auth != nil {
+				switch {
+				case auth.Basic != nil && auth.Basic.SecretRef != nil:
+					authIssue = sdk.checkAuthSecret(secretNamespace(auth.Basic.SecretNamespace), auth.Basic.SecretRef.Name, "username", "password")
+				case auth.Bearer != nil && auth.Bearer.SecretRef != nil:
+					authIssue = sdk.checkAuthSecret(secretNamespace(auth.Bearer.SecretNamespace), auth.Bearer.SecretRef.Name, "token")
+				default:
+					authIssue = "the auth info of the broker does not reference a secret"
+				}
+			}
+			check("ServiceBroker", b.Namespace, b.Name, b.Status.CommonServiceBrokerStatus, authIssue)
+		}
+	}
+
+	return issues, nil
+}
+
+// checkAuthSecret returns why the given auth secret cannot be used, or "" if
+// it exists and holds all of the given keys.
+func (sdk *SDK) checkAuthSecret(namespace, name string, keys ...string) string {
+	secret, err := sdk.Core().Secrets(namespace).Get(name, v1.GetOptions{})
+	if err != nil {
+		return fmt.Sprintf("unable to get auth secret %s/%s (%s)", namespace, name, err)
+	}
+	for _, key := range keys {
+		if _, ok := secret.Data[key]; !ok {
+			return fmt.Sprintf("auth secret %s/%s does not contain %q", namespace, name, key)
+		}
+	}
+	return ""
+}
+
+// preflightInstances checks for instances that were last reconciled before
+// status.observedGeneration was introduced, and for instances with
+// parameters whose plan has no schema to validate them against.
+func (sdk *SDK) preflightInstances(target releaseVersion) ([]PreflightIssue, error) {
+	instances, err := sdk.ServiceCatalog().ServiceInstances("").List(v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list instances (%s)", err)
+	}
+
+	clusterPlans, err := sdk.ServiceCatalog().ClusterServicePlans().List(v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list cluster-scoped plans (%s)", err)
+	}
+	clusterPlansWithSchema := make(map[string]bool)
+	for _, p := range clusterPlans.Items {
+		clusterPlansWithSchema[p.Name] = p.Spec.ServiceInstanceCreateParameterSchema != nil
+	}
+	plans, err := sdk.ServiceCatalog().ServicePlans("").List(v1.ListOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to list plans (%s)", err)
+	}
+	plansWithSchema := make(map[string]bool)
+	if err == nil {
+		for _, p := range plans.Items {
+			plansWithSchema[p.Namespace+"/"+p.Name] = p.Spec.ServiceInstanceCreateParameterSchema != nil
+		}
+	}
+
+	var issues []PreflightIssue
+	for _, i := range instances.Items {
+		if target.atLeast(reconciledGenerationRemovedIn) && i.Status.ReconciledGeneration != 0 && i.Status.ObservedGeneration == 0 {
+			issues = append(issues, PreflightIssue{
+				Severity:  PreflightBlocker,
+				Kind:      "ServiceInstance",
+				Namespace: i.Namespace,
+				Name:      i.Name,
+				Message:   "the instance only has the deprecated status.reconciledGeneration set; it must be reconciled by the current controller first",
+			})
+		}
+
+		if i.Spec.Parameters == nil {
+			continue
+		}
+		var hasSchema, found bool
+		switch {
+		case i.Spec.ClusterServicePlanRef != nil:
+			hasSchema, found = clusterPlansWithSchema[i.Spec.ClusterServicePlanRef.Name]
+		case i.Spec.ServicePlanRef != nil:
+			hasSchema, found = plansWithSchema[i.Namespace+"/"+i.Spec.ServicePlanRef.Name]
+		}
+		if found && !hasSchema {
+			issues = append(issues, PreflightIssue{
+				Severity:  PreflightWarning,
+				Kind:      "ServiceInstance",
+				Namespace: i.Namespace,
+				Name:      i.Name,
+				Message:   "the instance has parameters, but its plan has no schema to validate them against",
+			})
+		}
+	}
+
+	return issues, nil
+}
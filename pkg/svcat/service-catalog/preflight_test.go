@@ -0,0 +1,158 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		sdk          *SDK
+		svcCatClient *fake.Clientset
+		k8sClient    *k8sfake.Clientset
+		csb          *v1beta1.ClusterServiceBroker
+		si           *v1beta1.ServiceInstance
+		csp          *v1beta1.ClusterServicePlan
+	)
+
+	BeforeEach(func() {
+		csb = &v1beta1.ClusterServiceBroker{
+			ObjectMeta: metav1.ObjectMeta{Name: "foobar"},
+			Spec: v1beta1.ClusterServiceBrokerSpec{
+				AuthInfo: &v1beta1.ClusterServiceBrokerAuthInfo{
+					Bearer: &v1beta1.ClusterBearerTokenAuthConfig{
+						SecretRef: &v1beta1.ObjectReference{Namespace: "default", Name: "auth"},
+					},
+				},
+			},
+			Status: v1beta1.ClusterServiceBrokerStatus{
+				CommonServiceBrokerStatus: v1beta1.CommonServiceBrokerStatus{OSBAPIVersion: "2.13"},
+			},
+		}
+		csp = &v1beta1.ClusterServicePlan{ObjectMeta: metav1.ObjectMeta{Name: "plan-id"}}
+		si = &v1beta1.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+			Spec: v1beta1.ServiceInstanceSpec{
+				ClusterServicePlanRef: &v1beta1.ClusterObjectReference{Name: "plan-id"},
+			},
+			Status: v1beta1.ServiceInstanceStatus{ReconciledGeneration: 1, ObservedGeneration: 1},
+		}
+		k8sClient = k8sfake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "auth", Namespace: "default"},
+			Data:       map[string][]byte{"token": []byte("token")},
+		})
+	})
+
+	JustBeforeEach(func() {
+		svcCatClient = fake.NewSimpleClientset(csb, csp, si)
+		sdk = &SDK{
+			K8sClient:            k8sClient,
+			ServiceCatalogClient: svcCatClient,
+		}
+	})
+
+	It("Finds no issues", func() {
+		issues, err := sdk.Preflight("v0.2.0")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issues).To(BeEmpty())
+	})
+	It("Rejects an invalid target version", func() {
+		_, err := sdk.Preflight("latest")
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid version"))
+	})
+
+	Context("With an incomplete auth secret", func() {
+		BeforeEach(func() {
+			csb.Spec.AuthInfo.Bearer = nil
+			csb.Spec.AuthInfo.Basic = &v1beta1.ClusterBasicAuthConfig{
+				SecretRef: &v1beta1.ObjectReference{Namespace: "default", Name: "auth"},
+			}
+		})
+		It("Reports a blocker", func() {
+			issues, err := sdk.Preflight("v0.1.0")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Severity).To(Equal(PreflightBlocker))
+			Expect(issues[0].Kind).To(Equal("ClusterServiceBroker"))
+			Expect(issues[0].Message).To(ContainSubstring(`does not contain "username"`))
+		})
+	})
+
+	Context("With a broker on an old OSB API version", func() {
+		BeforeEach(func() {
+			csb.Status.OSBAPIVersion = "2.12"
+		})
+		It("Reports a blocker for targets that require a newer version", func() {
+			issues, err := sdk.Preflight("v0.2.0")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Severity).To(Equal(PreflightBlocker))
+			Expect(issues[0].Message).To(ContainSubstring("at least 2.13 is required"))
+		})
+		It("Reports nothing for older targets", func() {
+			issues, err := sdk.Preflight("v0.1.10")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issues).To(BeEmpty())
+		})
+	})
+
+	Context("With an instance that only has the deprecated reconciled generation", func() {
+		BeforeEach(func() {
+			si.Status.ObservedGeneration = 0
+		})
+		It("Reports a blocker", func() {
+			issues, err := sdk.Preflight("v0.2.0")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Severity).To(Equal(PreflightBlocker))
+			Expect(issues[0].Kind).To(Equal("ServiceInstance"))
+			Expect(issues[0].Namespace).To(Equal("default"))
+		})
+	})
+
+	Context("With an instance with parameters and a plan without a schema", func() {
+		BeforeEach(func() {
+			si.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"a":"b"}`)}
+		})
+		It("Reports a warning", func() {
+			issues, err := sdk.Preflight("v0.2.0")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Severity).To(Equal(PreflightWarning))
+			Expect(issues[0].Message).To(ContainSubstring("no schema"))
+		})
+	})
+})
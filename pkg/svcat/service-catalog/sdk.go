@@ -25,6 +25,7 @@ import (
 	apicorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
@@ -49,6 +50,7 @@ type SvcatClient interface {
 	RetrieveBroker(string) (*apiv1beta1.ClusterServiceBroker, error)
 	RetrieveBrokerByClass(*apiv1beta1.ClusterServiceClass) (*apiv1beta1.ClusterServiceBroker, error)
 	Register(string, string) (*apiv1beta1.ClusterServiceBroker, error)
+	RegisterBrokers(*BrokerManifest, bool) ([]BrokerChange, error)
 	Sync(string, int) error
 
 	RetrieveClasses(ScopeOptions) ([]Class, error)
@@ -66,9 +68,14 @@ type SvcatClient interface {
 	RetrieveInstance(string, string) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstanceByBinding(*apiv1beta1.ServiceBinding) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstances(string, string, string) (*apiv1beta1.ServiceInstanceList, error)
+	RetrieveInstancesBySelector(string, string) (*apiv1beta1.ServiceInstanceList, error)
 	RetrieveInstancesByPlan(*apiv1beta1.ClusterServicePlan) ([]apiv1beta1.ServiceInstance, error)
 	TouchInstance(string, string, int) error
+	UpdateInstanceParameters(string, string, map[string]interface{}, int) (*apiv1beta1.ServiceInstance, error)
+	UpdateInstancePlan(string, string, *apiv1beta1.ClusterServicePlan, int) (*apiv1beta1.ServiceInstance, error)
+	ValidatePlanUpgrade(*apiv1beta1.ServiceInstance, string) (*apiv1beta1.ClusterServicePlan, error)
 	WaitForInstance(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceInstance, error)
+	WatchInstance(string, string) (watch.Interface, error)
 
 	RetrievePlans(*FilterOptions) ([]apiv1beta1.ClusterServicePlan, error)
 	RetrievePlanByName(string) (*apiv1beta1.ClusterServicePlan, error)
@@ -78,6 +85,7 @@ type SvcatClient interface {
 
 	RetrieveSecretByBinding(*apiv1beta1.ServiceBinding) (*apicorev1.Secret, error)
 
+	Preflight(string) ([]PreflightIssue, error)
 	ServerVersion() (*version.Info, error)
 }
 
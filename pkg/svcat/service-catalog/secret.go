@@ -29,14 +29,20 @@ import (
 // A nil secret is returned without error when the secret has not been created by Service Catalog yet.
 // An error is returned when the binding is Ready but the secret could not be retrieved.
 func (sdk *SDK) RetrieveSecretByBinding(binding *v1beta1.ServiceBinding) (*corev1.Secret, error) {
-	secret, err := sdk.Core().Secrets(binding.Namespace).Get(binding.Spec.SecretName, metav1.GetOptions{})
+	secretName := binding.Spec.SecretName
+	if binding.Status.SecretName != "" {
+		// The secret of a binding with an immutable secret is replaced
+		// whenever its credentials change.
+		secretName = binding.Status.SecretName
+	}
+	secret, err := sdk.Core().Secrets(binding.Namespace).Get(secretName, metav1.GetOptions{})
 	if err != nil {
 		// It's expected to not have the secret until the binding is ready
 		if !sdk.IsBindingReady(binding) && errors.IsNotFound(err) {
 			return nil, nil
 		}
 
-		return nil, fmt.Errorf("unable to get secret %s/%s (%s)", binding.Namespace, binding.Spec.SecretName, err)
+		return nil, fmt.Errorf("unable to get secret %s/%s (%s)", binding.Namespace, secretName, err)
 	}
 
 	return secret, nil
@@ -26,6 +26,7 @@ import (
 	apicorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 type FakeSvcatClient struct {
@@ -248,6 +249,20 @@ type FakeSvcatClient struct {
 		result1 *apiv1beta1.ClusterServiceBroker
 		result2 error
 	}
+	RegisterBrokersStub        func(*servicecatalog.BrokerManifest, bool) ([]servicecatalog.BrokerChange, error)
+	registerBrokersMutex       sync.RWMutex
+	registerBrokersArgsForCall []struct {
+		arg1 *servicecatalog.BrokerManifest
+		arg2 bool
+	}
+	registerBrokersReturns struct {
+		result1 []servicecatalog.BrokerChange
+		result2 error
+	}
+	registerBrokersReturnsOnCall map[int]struct {
+		result1 []servicecatalog.BrokerChange
+		result2 error
+	}
 	SyncStub        func(string, int) error
 	syncMutex       sync.RWMutex
 	syncArgsForCall []struct {
@@ -465,6 +480,20 @@ type FakeSvcatClient struct {
 		result1 []apiv1beta1.ServiceInstance
 		result2 error
 	}
+	RetrieveInstancesBySelectorStub        func(string, string) (*apiv1beta1.ServiceInstanceList, error)
+	retrieveInstancesBySelectorMutex       sync.RWMutex
+	retrieveInstancesBySelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	retrieveInstancesBySelectorReturns struct {
+		result1 *apiv1beta1.ServiceInstanceList
+		result2 error
+	}
+	retrieveInstancesBySelectorReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ServiceInstanceList
+		result2 error
+	}
 	TouchInstanceStub        func(string, string, int) error
 	touchInstanceMutex       sync.RWMutex
 	touchInstanceArgsForCall []struct {
@@ -478,6 +507,52 @@ type FakeSvcatClient struct {
 	touchInstanceReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateInstanceParametersStub        func(string, string, map[string]interface{}, int) (*apiv1beta1.ServiceInstance, error)
+	updateInstanceParametersMutex       sync.RWMutex
+	updateInstanceParametersArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]interface{}
+		arg4 int
+	}
+	updateInstanceParametersReturns struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	updateInstanceParametersReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	UpdateInstancePlanStub        func(string, string, *apiv1beta1.ClusterServicePlan, int) (*apiv1beta1.ServiceInstance, error)
+	updateInstancePlanMutex       sync.RWMutex
+	updateInstancePlanArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *apiv1beta1.ClusterServicePlan
+		arg4 int
+	}
+	updateInstancePlanReturns struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	updateInstancePlanReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	ValidatePlanUpgradeStub        func(*apiv1beta1.ServiceInstance, string) (*apiv1beta1.ClusterServicePlan, error)
+	validatePlanUpgradeMutex       sync.RWMutex
+	validatePlanUpgradeArgsForCall []struct {
+		arg1 *apiv1beta1.ServiceInstance
+		arg2 string
+	}
+	validatePlanUpgradeReturns struct {
+		result1 *apiv1beta1.ClusterServicePlan
+		result2 error
+	}
+	validatePlanUpgradeReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ClusterServicePlan
+		result2 error
+	}
 	WaitForInstanceStub        func(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceInstance, error)
 	waitForInstanceMutex       sync.RWMutex
 	waitForInstanceArgsForCall []struct {
@@ -494,6 +569,20 @@ type FakeSvcatClient struct {
 		result1 *apiv1beta1.ServiceInstance
 		result2 error
 	}
+	WatchInstanceStub        func(string, string) (watch.Interface, error)
+	watchInstanceMutex       sync.RWMutex
+	watchInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	watchInstanceReturns struct {
+		result1 watch.Interface
+		result2 error
+	}
+	watchInstanceReturnsOnCall map[int]struct {
+		result1 watch.Interface
+		result2 error
+	}
 	RetrievePlansStub        func(*servicecatalog.FilterOptions) ([]apiv1beta1.ClusterServicePlan, error)
 	retrievePlansMutex       sync.RWMutex
 	retrievePlansArgsForCall []struct {
@@ -573,6 +662,19 @@ type FakeSvcatClient struct {
 		result1 *apicorev1.Secret
 		result2 error
 	}
+	PreflightStub        func(string) ([]servicecatalog.PreflightIssue, error)
+	preflightMutex       sync.RWMutex
+	preflightArgsForCall []struct {
+		arg1 string
+	}
+	preflightReturns struct {
+		result1 []servicecatalog.PreflightIssue
+		result2 error
+	}
+	preflightReturnsOnCall map[int]struct {
+		result1 []servicecatalog.PreflightIssue
+		result2 error
+	}
 	ServerVersionStub        func() (*version.Info, error)
 	serverVersionMutex       sync.RWMutex
 	serverVersionArgsForCall []struct{}
@@ -1419,6 +1521,58 @@ func (fake *FakeSvcatClient) RegisterReturnsOnCall(i int, result1 *apiv1beta1.Cl
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RegisterBrokers(arg1 *servicecatalog.BrokerManifest, arg2 bool) ([]servicecatalog.BrokerChange, error) {
+	fake.registerBrokersMutex.Lock()
+	ret, specificReturn := fake.registerBrokersReturnsOnCall[len(fake.registerBrokersArgsForCall)]
+	fake.registerBrokersArgsForCall = append(fake.registerBrokersArgsForCall, struct {
+		arg1 *servicecatalog.BrokerManifest
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("RegisterBrokers", []interface{}{arg1, arg2})
+	fake.registerBrokersMutex.Unlock()
+	if fake.RegisterBrokersStub != nil {
+		return fake.RegisterBrokersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.registerBrokersReturns.result1, fake.registerBrokersReturns.result2
+}
+
+func (fake *FakeSvcatClient) RegisterBrokersCallCount() int {
+	fake.registerBrokersMutex.RLock()
+	defer fake.registerBrokersMutex.RUnlock()
+	return len(fake.registerBrokersArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RegisterBrokersArgsForCall(i int) (*servicecatalog.BrokerManifest, bool) {
+	fake.registerBrokersMutex.RLock()
+	defer fake.registerBrokersMutex.RUnlock()
+	return fake.registerBrokersArgsForCall[i].arg1, fake.registerBrokersArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) RegisterBrokersReturns(result1 []servicecatalog.BrokerChange, result2 error) {
+	fake.RegisterBrokersStub = nil
+	fake.registerBrokersReturns = struct {
+		result1 []servicecatalog.BrokerChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RegisterBrokersReturnsOnCall(i int, result1 []servicecatalog.BrokerChange, result2 error) {
+	fake.RegisterBrokersStub = nil
+	if fake.registerBrokersReturnsOnCall == nil {
+		fake.registerBrokersReturnsOnCall = make(map[int]struct {
+			result1 []servicecatalog.BrokerChange
+			result2 error
+		})
+	}
+	fake.registerBrokersReturnsOnCall[i] = struct {
+		result1 []servicecatalog.BrokerChange
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) Sync(arg1 string, arg2 int) error {
 	fake.syncMutex.Lock()
 	ret, specificReturn := fake.syncReturnsOnCall[len(fake.syncArgsForCall)]
@@ -2243,6 +2397,58 @@ func (fake *FakeSvcatClient) RetrieveInstancesByPlanReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetrieveInstancesBySelector(arg1 string, arg2 string) (*apiv1beta1.ServiceInstanceList, error) {
+	fake.retrieveInstancesBySelectorMutex.Lock()
+	ret, specificReturn := fake.retrieveInstancesBySelectorReturnsOnCall[len(fake.retrieveInstancesBySelectorArgsForCall)]
+	fake.retrieveInstancesBySelectorArgsForCall = append(fake.retrieveInstancesBySelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RetrieveInstancesBySelector", []interface{}{arg1, arg2})
+	fake.retrieveInstancesBySelectorMutex.Unlock()
+	if fake.RetrieveInstancesBySelectorStub != nil {
+		return fake.RetrieveInstancesBySelectorStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.retrieveInstancesBySelectorReturns.result1, fake.retrieveInstancesBySelectorReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesBySelectorCallCount() int {
+	fake.retrieveInstancesBySelectorMutex.RLock()
+	defer fake.retrieveInstancesBySelectorMutex.RUnlock()
+	return len(fake.retrieveInstancesBySelectorArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesBySelectorArgsForCall(i int) (string, string) {
+	fake.retrieveInstancesBySelectorMutex.RLock()
+	defer fake.retrieveInstancesBySelectorMutex.RUnlock()
+	return fake.retrieveInstancesBySelectorArgsForCall[i].arg1, fake.retrieveInstancesBySelectorArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesBySelectorReturns(result1 *apiv1beta1.ServiceInstanceList, result2 error) {
+	fake.RetrieveInstancesBySelectorStub = nil
+	fake.retrieveInstancesBySelectorReturns = struct {
+		result1 *apiv1beta1.ServiceInstanceList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesBySelectorReturnsOnCall(i int, result1 *apiv1beta1.ServiceInstanceList, result2 error) {
+	fake.RetrieveInstancesBySelectorStub = nil
+	if fake.retrieveInstancesBySelectorReturnsOnCall == nil {
+		fake.retrieveInstancesBySelectorReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ServiceInstanceList
+			result2 error
+		})
+	}
+	fake.retrieveInstancesBySelectorReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ServiceInstanceList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) TouchInstance(arg1 string, arg2 string, arg3 int) error {
 	fake.touchInstanceMutex.Lock()
 	ret, specificReturn := fake.touchInstanceReturnsOnCall[len(fake.touchInstanceArgsForCall)]
@@ -2293,6 +2499,166 @@ func (fake *FakeSvcatClient) TouchInstanceReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSvcatClient) UpdateInstanceParameters(arg1 string, arg2 string, arg3 map[string]interface{}, arg4 int) (*apiv1beta1.ServiceInstance, error) {
+	fake.updateInstanceParametersMutex.Lock()
+	ret, specificReturn := fake.updateInstanceParametersReturnsOnCall[len(fake.updateInstanceParametersArgsForCall)]
+	fake.updateInstanceParametersArgsForCall = append(fake.updateInstanceParametersArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]interface{}
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateInstanceParameters", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateInstanceParametersMutex.Unlock()
+	if fake.UpdateInstanceParametersStub != nil {
+		return fake.UpdateInstanceParametersStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateInstanceParametersReturns.result1, fake.updateInstanceParametersReturns.result2
+}
+
+func (fake *FakeSvcatClient) UpdateInstanceParametersCallCount() int {
+	fake.updateInstanceParametersMutex.RLock()
+	defer fake.updateInstanceParametersMutex.RUnlock()
+	return len(fake.updateInstanceParametersArgsForCall)
+}
+
+func (fake *FakeSvcatClient) UpdateInstanceParametersArgsForCall(i int) (string, string, map[string]interface{}, int) {
+	fake.updateInstanceParametersMutex.RLock()
+	defer fake.updateInstanceParametersMutex.RUnlock()
+	return fake.updateInstanceParametersArgsForCall[i].arg1, fake.updateInstanceParametersArgsForCall[i].arg2, fake.updateInstanceParametersArgsForCall[i].arg3, fake.updateInstanceParametersArgsForCall[i].arg4
+}
+
+func (fake *FakeSvcatClient) UpdateInstanceParametersReturns(result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.UpdateInstanceParametersStub = nil
+	fake.updateInstanceParametersReturns = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) UpdateInstanceParametersReturnsOnCall(i int, result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.UpdateInstanceParametersStub = nil
+	if fake.updateInstanceParametersReturnsOnCall == nil {
+		fake.updateInstanceParametersReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ServiceInstance
+			result2 error
+		})
+	}
+	fake.updateInstanceParametersReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) UpdateInstancePlan(arg1 string, arg2 string, arg3 *apiv1beta1.ClusterServicePlan, arg4 int) (*apiv1beta1.ServiceInstance, error) {
+	fake.updateInstancePlanMutex.Lock()
+	ret, specificReturn := fake.updateInstancePlanReturnsOnCall[len(fake.updateInstancePlanArgsForCall)]
+	fake.updateInstancePlanArgsForCall = append(fake.updateInstancePlanArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *apiv1beta1.ClusterServicePlan
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateInstancePlan", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateInstancePlanMutex.Unlock()
+	if fake.UpdateInstancePlanStub != nil {
+		return fake.UpdateInstancePlanStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateInstancePlanReturns.result1, fake.updateInstancePlanReturns.result2
+}
+
+func (fake *FakeSvcatClient) UpdateInstancePlanCallCount() int {
+	fake.updateInstancePlanMutex.RLock()
+	defer fake.updateInstancePlanMutex.RUnlock()
+	return len(fake.updateInstancePlanArgsForCall)
+}
+
+func (fake *FakeSvcatClient) UpdateInstancePlanArgsForCall(i int) (string, string, *apiv1beta1.ClusterServicePlan, int) {
+	fake.updateInstancePlanMutex.RLock()
+	defer fake.updateInstancePlanMutex.RUnlock()
+	return fake.updateInstancePlanArgsForCall[i].arg1, fake.updateInstancePlanArgsForCall[i].arg2, fake.updateInstancePlanArgsForCall[i].arg3, fake.updateInstancePlanArgsForCall[i].arg4
+}
+
+func (fake *FakeSvcatClient) UpdateInstancePlanReturns(result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.UpdateInstancePlanStub = nil
+	fake.updateInstancePlanReturns = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) UpdateInstancePlanReturnsOnCall(i int, result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.UpdateInstancePlanStub = nil
+	if fake.updateInstancePlanReturnsOnCall == nil {
+		fake.updateInstancePlanReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ServiceInstance
+			result2 error
+		})
+	}
+	fake.updateInstancePlanReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) ValidatePlanUpgrade(arg1 *apiv1beta1.ServiceInstance, arg2 string) (*apiv1beta1.ClusterServicePlan, error) {
+	fake.validatePlanUpgradeMutex.Lock()
+	ret, specificReturn := fake.validatePlanUpgradeReturnsOnCall[len(fake.validatePlanUpgradeArgsForCall)]
+	fake.validatePlanUpgradeArgsForCall = append(fake.validatePlanUpgradeArgsForCall, struct {
+		arg1 *apiv1beta1.ServiceInstance
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ValidatePlanUpgrade", []interface{}{arg1, arg2})
+	fake.validatePlanUpgradeMutex.Unlock()
+	if fake.ValidatePlanUpgradeStub != nil {
+		return fake.ValidatePlanUpgradeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.validatePlanUpgradeReturns.result1, fake.validatePlanUpgradeReturns.result2
+}
+
+func (fake *FakeSvcatClient) ValidatePlanUpgradeCallCount() int {
+	fake.validatePlanUpgradeMutex.RLock()
+	defer fake.validatePlanUpgradeMutex.RUnlock()
+	return len(fake.validatePlanUpgradeArgsForCall)
+}
+
+func (fake *FakeSvcatClient) ValidatePlanUpgradeArgsForCall(i int) (*apiv1beta1.ServiceInstance, string) {
+	fake.validatePlanUpgradeMutex.RLock()
+	defer fake.validatePlanUpgradeMutex.RUnlock()
+	return fake.validatePlanUpgradeArgsForCall[i].arg1, fake.validatePlanUpgradeArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) ValidatePlanUpgradeReturns(result1 *apiv1beta1.ClusterServicePlan, result2 error) {
+	fake.ValidatePlanUpgradeStub = nil
+	fake.validatePlanUpgradeReturns = struct {
+		result1 *apiv1beta1.ClusterServicePlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) ValidatePlanUpgradeReturnsOnCall(i int, result1 *apiv1beta1.ClusterServicePlan, result2 error) {
+	fake.ValidatePlanUpgradeStub = nil
+	if fake.validatePlanUpgradeReturnsOnCall == nil {
+		fake.validatePlanUpgradeReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ClusterServicePlan
+			result2 error
+		})
+	}
+	fake.validatePlanUpgradeReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ClusterServicePlan
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) WaitForInstance(arg1 string, arg2 string, arg3 time.Duration, arg4 *time.Duration) (*apiv1beta1.ServiceInstance, error) {
 	fake.waitForInstanceMutex.Lock()
 	ret, specificReturn := fake.waitForInstanceReturnsOnCall[len(fake.waitForInstanceArgsForCall)]
@@ -2347,6 +2713,58 @@ func (fake *FakeSvcatClient) WaitForInstanceReturnsOnCall(i int, result1 *apiv1b
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) WatchInstance(arg1 string, arg2 string) (watch.Interface, error) {
+	fake.watchInstanceMutex.Lock()
+	ret, specificReturn := fake.watchInstanceReturnsOnCall[len(fake.watchInstanceArgsForCall)]
+	fake.watchInstanceArgsForCall = append(fake.watchInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("WatchInstance", []interface{}{arg1, arg2})
+	fake.watchInstanceMutex.Unlock()
+	if fake.WatchInstanceStub != nil {
+		return fake.WatchInstanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.watchInstanceReturns.result1, fake.watchInstanceReturns.result2
+}
+
+func (fake *FakeSvcatClient) WatchInstanceCallCount() int {
+	fake.watchInstanceMutex.RLock()
+	defer fake.watchInstanceMutex.RUnlock()
+	return len(fake.watchInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) WatchInstanceArgsForCall(i int) (string, string) {
+	fake.watchInstanceMutex.RLock()
+	defer fake.watchInstanceMutex.RUnlock()
+	return fake.watchInstanceArgsForCall[i].arg1, fake.watchInstanceArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) WatchInstanceReturns(result1 watch.Interface, result2 error) {
+	fake.WatchInstanceStub = nil
+	fake.watchInstanceReturns = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) WatchInstanceReturnsOnCall(i int, result1 watch.Interface, result2 error) {
+	fake.WatchInstanceStub = nil
+	if fake.watchInstanceReturnsOnCall == nil {
+		fake.watchInstanceReturnsOnCall = make(map[int]struct {
+			result1 watch.Interface
+			result2 error
+		})
+	}
+	fake.watchInstanceReturnsOnCall[i] = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) RetrievePlans(arg1 *servicecatalog.FilterOptions) ([]apiv1beta1.ClusterServicePlan, error) {
 	fake.retrievePlansMutex.Lock()
 	ret, specificReturn := fake.retrievePlansReturnsOnCall[len(fake.retrievePlansArgsForCall)]
@@ -2654,6 +3072,57 @@ func (fake *FakeSvcatClient) RetrieveSecretByBindingReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) Preflight(arg1 string) ([]servicecatalog.PreflightIssue, error) {
+	fake.preflightMutex.Lock()
+	ret, specificReturn := fake.preflightReturnsOnCall[len(fake.preflightArgsForCall)]
+	fake.preflightArgsForCall = append(fake.preflightArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Preflight", []interface{}{arg1})
+	fake.preflightMutex.Unlock()
+	if fake.PreflightStub != nil {
+		return fake.PreflightStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.preflightReturns.result1, fake.preflightReturns.result2
+}
+
+func (fake *FakeSvcatClient) PreflightCallCount() int {
+	fake.preflightMutex.RLock()
+	defer fake.preflightMutex.RUnlock()
+	return len(fake.preflightArgsForCall)
+}
+
+func (fake *FakeSvcatClient) PreflightArgsForCall(i int) string {
+	fake.preflightMutex.RLock()
+	defer fake.preflightMutex.RUnlock()
+	return fake.preflightArgsForCall[i].arg1
+}
+
+func (fake *FakeSvcatClient) PreflightReturns(result1 []servicecatalog.PreflightIssue, result2 error) {
+	fake.PreflightStub = nil
+	fake.preflightReturns = struct {
+		result1 []servicecatalog.PreflightIssue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) PreflightReturnsOnCall(i int, result1 []servicecatalog.PreflightIssue, result2 error) {
+	fake.PreflightStub = nil
+	if fake.preflightReturnsOnCall == nil {
+		fake.preflightReturnsOnCall = make(map[int]struct {
+			result1 []servicecatalog.PreflightIssue
+			result2 error
+		})
+	}
+	fake.preflightReturnsOnCall[i] = struct {
+		result1 []servicecatalog.PreflightIssue
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) ServerVersion() (*version.Info, error) {
 	fake.serverVersionMutex.Lock()
 	ret, specificReturn := fake.serverVersionReturnsOnCall[len(fake.serverVersionArgsForCall)]
@@ -2732,6 +3201,8 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrieveBrokerByClassMutex.RUnlock()
 	fake.registerMutex.RLock()
 	defer fake.registerMutex.RUnlock()
+	fake.registerBrokersMutex.RLock()
+	defer fake.registerBrokersMutex.RUnlock()
 	fake.syncMutex.RLock()
 	defer fake.syncMutex.RUnlock()
 	fake.retrieveClassesMutex.RLock()
@@ -2762,10 +3233,20 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrieveInstancesMutex.RUnlock()
 	fake.retrieveInstancesByPlanMutex.RLock()
 	defer fake.retrieveInstancesByPlanMutex.RUnlock()
+	fake.retrieveInstancesBySelectorMutex.RLock()
+	defer fake.retrieveInstancesBySelectorMutex.RUnlock()
 	fake.touchInstanceMutex.RLock()
 	defer fake.touchInstanceMutex.RUnlock()
+	fake.updateInstanceParametersMutex.RLock()
+	defer fake.updateInstanceParametersMutex.RUnlock()
+	fake.updateInstancePlanMutex.RLock()
+	defer fake.updateInstancePlanMutex.RUnlock()
+	fake.validatePlanUpgradeMutex.RLock()
+	defer fake.validatePlanUpgradeMutex.RUnlock()
 	fake.waitForInstanceMutex.RLock()
 	defer fake.waitForInstanceMutex.RUnlock()
+	fake.watchInstanceMutex.RLock()
+	defer fake.watchInstanceMutex.RUnlock()
 	fake.retrievePlansMutex.RLock()
 	defer fake.retrievePlansMutex.RUnlock()
 	fake.retrievePlanByNameMutex.RLock()
@@ -2778,6 +3259,8 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrievePlanByClassAndPlanNamesMutex.RUnlock()
 	fake.retrieveSecretByBindingMutex.RLock()
 	defer fake.retrieveSecretByBindingMutex.RUnlock()
+	fake.preflightMutex.RLock()
+	defer fake.preflightMutex.RUnlock()
 	fake.serverVersionMutex.RLock()
 	defer fake.serverVersionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -72,9 +72,21 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
 	}
 	// only care about brokers
-	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("clusterservicebrokers") {
+	if a.GetResource().Group != servicecatalog.GroupName {
 		return nil
 	}
+	switch a.GetResource().GroupResource() {
+	case servicecatalog.Resource("clusterservicebrokers"):
+		return s.admitClusterServiceBroker(a)
+	case servicecatalog.Resource("servicebrokers"):
+		return s.admitServiceBroker(a)
+	}
+	return nil
+}
+
+// admitClusterServiceBroker checks that the creator of a ClusterServiceBroker
+// has access to its auth secret.
+func (s *sarcheck) admitClusterServiceBroker(a admission.Attributes) error {
 	clusterServiceBroker, ok := a.GetObject().(*servicecatalog.ClusterServiceBroker)
 	if !ok {
 		return errors.NewBadRequest("Resource was marked with kind ClusterServiceBroker, but was unable to be converted")
@@ -96,17 +108,54 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 		return nil
 	}
 	glog.V(5).Infof("ClusterServiceBroker %+v: evaluating auth secret ref, with authInfo %q", clusterServiceBroker, secretRef)
+	return s.checkSecretAccess(a, secretRef.Namespace, secretRef.Name)
+}
+
+// admitServiceBroker checks that the creator of a ServiceBroker whose auth
+// secret is in another namespace has access to that secret. Secrets in the
+// namespace of the broker are not checked.
+func (s *sarcheck) admitServiceBroker(a admission.Attributes) error {
+	serviceBroker, ok := a.GetObject().(*servicecatalog.ServiceBroker)
+	if !ok {
+		return errors.NewBadRequest("Resource was marked with kind ServiceBroker, but was unable to be converted")
+	}
+
+	if serviceBroker.Spec.AuthInfo == nil {
+		// no auth secret to check
+		return nil
+	}
+
+	var secretRef *servicecatalog.LocalObjectReference
+	var secretNamespace string
+	if serviceBroker.Spec.AuthInfo.Basic != nil {
+		secretRef = serviceBroker.Spec.AuthInfo.Basic.SecretRef
+		secretNamespace = serviceBroker.Spec.AuthInfo.Basic.SecretNamespace
+	} else if serviceBroker.Spec.AuthInfo.Bearer != nil {
+		secretRef = serviceBroker.Spec.AuthInfo.Bearer.SecretRef
+		secretNamespace = serviceBroker.Spec.AuthInfo.Bearer.SecretNamespace
+	}
+
+	if secretRef == nil || secretNamespace == "" || secretNamespace == a.GetNamespace() {
+		return nil
+	}
+	glog.V(5).Infof("ServiceBroker %+v: evaluating auth secret ref %q in namespace %q", serviceBroker, secretRef.Name, secretNamespace)
+	return s.checkSecretAccess(a, secretNamespace, secretRef.Name)
+}
+
+// checkSecretAccess returns a Forbidden error unless the user making the
+// request is allowed to get the given secret.
+func (s *sarcheck) checkSecretAccess(a admission.Attributes, namespace, name string) error {
 	userInfo := a.GetUserInfo()
 
 	sar := &authorizationapi.SubjectAccessReview{
 		Spec: authorizationapi.SubjectAccessReviewSpec{
 			ResourceAttributes: &authorizationapi.ResourceAttributes{
-				Namespace: secretRef.Namespace,
+				Namespace: namespace,
 				Verb:      "get",
 				Group:     corev1.SchemeGroupVersion.Group,
 				Version:   corev1.SchemeGroupVersion.Version,
 				Resource:  corev1.ResourceSecrets.String(),
-				Name:      secretRef.Name,
+				Name:      name,
 			},
 			User:   userInfo.GetName(),
 			Groups: userInfo.GetGroups(),
@@ -120,7 +169,7 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 	}
 
 	if !sar.Status.Allowed {
-		return admission.NewForbidden(a, fmt.Errorf("broker forbidden access to auth secret (%s): Reason: %s, EvaluationError: %s", secretRef.Name, sar.Status.Reason, sar.Status.EvaluationError))
+		return admission.NewForbidden(a, fmt.Errorf("broker forbidden access to auth secret (%s): Reason: %s, EvaluationError: %s", name, sar.Status.Reason, sar.Status.EvaluationError))
 	}
 	return nil
 }
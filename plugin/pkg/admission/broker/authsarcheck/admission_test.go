@@ -245,3 +245,105 @@ func TestAdmissionBroker(t *testing.T) {
 		}
 	}
 }
+
+// TestAdmissionServiceBroker tests that the SAR check is only made for
+// namespaced brokers whose auth secret is in another namespace.
+func TestAdmissionServiceBroker(t *testing.T) {
+	newServiceBroker := func(secretNamespace string) *servicecatalog.ServiceBroker {
+		return &servicecatalog.ServiceBroker{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-broker",
+				Namespace: "test-ns",
+			},
+			Spec: servicecatalog.ServiceBrokerSpec{
+				AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
+					Basic: &servicecatalog.BasicAuthConfig{
+						SecretRef: &servicecatalog.LocalObjectReference{
+							Name: "test-secret",
+						},
+						SecretNamespace: secretNamespace,
+					},
+				},
+				CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+					URL:            "http://example.com",
+					RelistBehavior: "Manual",
+				},
+			},
+		}
+	}
+	forbiddenUser := &user.DefaultInfo{
+		Name:   "system:serviceaccount:test-ns:forbidden",
+		Groups: []string{"system:serviceaccount", "system:serviceaccounts:test-ns"},
+	}
+	// Anonymous struct fields:
+	// name: short description of the testing
+	// broker: a fake broker object
+	// userInfo: the user creating the broker
+	// allowed: flag for whether or not the broker should be admitted
+	// sar: flag for whether or not a SAR check should be made
+	cases := []struct {
+		name     string
+		broker   *servicecatalog.ServiceBroker
+		userInfo *user.DefaultInfo
+		allowed  bool
+		sar      bool
+	}{
+		{
+			name:     "secret in broker namespace",
+			broker:   newServiceBroker(""),
+			userInfo: forbiddenUser,
+			allowed:  true,
+		},
+		{
+			name:     "secret namespace set to broker namespace",
+			broker:   newServiceBroker("test-ns"),
+			userInfo: forbiddenUser,
+			allowed:  true,
+		},
+		{
+			name:   "secret in central namespace, user authorized",
+			broker: newServiceBroker("broker-secrets"),
+			userInfo: &user.DefaultInfo{
+				Name:   "system:serviceaccount:test-ns:catalog",
+				Groups: []string{"system:serviceaccount", "system:serviceaccounts:test-ns"},
+			},
+			allowed: true,
+			sar:     true,
+		},
+		{
+			name:     "secret in central namespace, user unauthorized",
+			broker:   newServiceBroker("broker-secrets"),
+			userInfo: forbiddenUser,
+			allowed:  false,
+			sar:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		mockKubeClient := newMockKubeClientForTest(tc.userInfo)
+		handler, kubeInformerFactory, err := newHandlerForTest(mockKubeClient)
+		if err != nil {
+			t.Errorf("unexpected error initializing handler: %v", err)
+		}
+		kubeInformerFactory.Start(wait.NeverStop)
+
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(tc.broker, nil, servicecatalog.Kind("ServiceBroker").WithVersion("version"), tc.broker.Namespace, tc.broker.Name, servicecatalog.Resource("servicebrokers").WithVersion("version"), "", admission.Create, tc.userInfo))
+		if err != nil && tc.allowed || err == nil && !tc.allowed {
+			t.Errorf("Create test '%s' reports: Unexpected error returned from admission handler: %v", tc.name, err)
+		}
+
+		sarMade := false
+		for _, action := range mockKubeClient.Actions() {
+			if action.Matches("create", "subjectaccessreviews") {
+				sar := action.(core.CreateAction).GetObject().(*authorizationapi.SubjectAccessReview)
+				if e, a := "broker-secrets", sar.Spec.ResourceAttributes.Namespace; e != a {
+					t.Errorf("Create test '%s' reports: unexpected SAR namespace: expected %q, got %q", tc.name, e, a)
+				}
+				sarMade = true
+			}
+		}
+		if sarMade != tc.sar {
+			t.Errorf("Create test '%s' reports: expected SAR check %v, got %v", tc.name, tc.sar, sarMade)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readonly
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ReadOnlyBroker"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewDenyProvisionIfBrokerReadOnly()
+	})
+}
+
+// denyProvisionIfBrokerReadOnly is an implementation of admission.Interface.
+// It rejects the creation of a ServiceInstance of a class whose broker is
+// read-only. Instances whose class cannot be found are let through, so that
+// the controller reports the error as usual.
+type denyProvisionIfBrokerReadOnly struct {
+	*admission.Handler
+	cscLister internalversion.ClusterServiceClassLister
+	cbLister  internalversion.ClusterServiceBrokerLister
+	scLister  internalversion.ServiceClassLister
+	bLister   internalversion.ServiceBrokerLister
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&denyProvisionIfBrokerReadOnly{})
+
+func (d *denyProvisionIfBrokerReadOnly) Admit(a admission.Attributes) error {
+	// We only care about service Instances
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("serviceinstances") {
+		return nil
+	}
+
+	// We don't want to deal with any sub resources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	instance, ok := a.GetObject().(*servicecatalog.ServiceInstance)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind ServiceInstance but was unable to be converted")
+	}
+
+	// we need to wait for our caches to warm
+	if !d.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	var brokerKind, brokerName string
+	var readOnly bool
+	if instance.Spec.ClusterServiceClassSpecified() {
+		class, err := d.getClusterServiceClass(&instance.Spec.PlanReference)
+		if err != nil {
+			return admission.NewForbidden(a, err)
+		}
+		if class == nil {
+			return nil
+		}
+		broker, err := d.cbLister.Get(class.Spec.ClusterServiceBrokerName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return admission.NewForbidden(a, err)
+		}
+		brokerKind, brokerName, readOnly = "ClusterServiceBroker", broker.Name, broker.Spec.ReadOnly
+	} else if instance.Spec.ServiceClassSpecified() {
+		class, err := d.getServiceClass(instance.Namespace, &instance.Spec.PlanReference)
+		if err != nil {
+			return admission.NewForbidden(a, err)
+		}
+		if class == nil {
+			return nil
+		}
+		broker, err := d.bLister.ServiceBrokers(instance.Namespace).Get(class.Spec.ServiceBrokerName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return admission.NewForbidden(a, err)
+		}
+		brokerKind, brokerName, readOnly = "ServiceBroker", broker.Name, broker.Spec.ReadOnly
+	}
+
+	if !readOnly {
+		return nil
+	}
+	msg := fmt.Sprintf("%s %q is read-only and accepts no new ServiceInstances", brokerKind, brokerName)
+	glog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+	return admission.NewForbidden(a, errors.New(msg))
+}
+
+// getClusterServiceClass returns the ClusterServiceClass that the given plan
+// reference specifies, or nil if there is no single such class.
+func (d *denyProvisionIfBrokerReadOnly) getClusterServiceClass(ref *servicecatalog.PlanReference) (*servicecatalog.ClusterServiceClass, error) {
+	if ref.ClusterServiceClassName != "" {
+		class, err := d.cscLister.Get(ref.ClusterServiceClassName)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return class, err
+	}
+
+	classes, err := d.cscLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var found *servicecatalog.ClusterServiceClass
+	for _, class := range classes {
+		if (ref.ClusterServiceClassExternalName != "" && class.Spec.ExternalName == ref.ClusterServiceClassExternalName) ||
+			(ref.ClusterServiceClassExternalID != "" && class.Spec.ExternalID == ref.ClusterServiceClassExternalID) {
+			if found != nil {
+				return nil, nil
+			}
+			found = class
+		}
+	}
+	return found, nil
+}
+
+// getServiceClass returns the ServiceClass in the given namespace that the
+// given plan reference specifies, or nil if there is no single such class.
+func (d *denyProvisionIfBrokerReadOnly) getServiceClass(namespace string, ref *servicecatalog.PlanReference) (*servicecatalog.ServiceClass, error) {
+	lister := d.scLister.ServiceClasses(namespace)
+	if ref.ServiceClassName != "" {
+		class, err := lister.Get(ref.ServiceClassName)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return class, err
+	}
+
+	classes, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var found *servicecatalog.ServiceClass
+	for _, class := range classes {
+		if (ref.ServiceClassExternalName != "" && class.Spec.ExternalName == ref.ServiceClassExternalName) ||
+			(ref.ServiceClassExternalID != "" && class.Spec.ExternalID == ref.ServiceClassExternalID) {
+			if found != nil {
+				return nil, nil
+			}
+			found = class
+		}
+	}
+	return found, nil
+}
+
+// NewDenyProvisionIfBrokerReadOnly creates a new admission control handler
+// that rejects the creation of ServiceInstances of the classes of read-only
+// brokers.
+func NewDenyProvisionIfBrokerReadOnly() (admission.Interface, error) {
+	return &denyProvisionIfBrokerReadOnly{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+func (d *denyProvisionIfBrokerReadOnly) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	cscInformer := f.Servicecatalog().InternalVersion().ClusterServiceClasses()
+	cbInformer := f.Servicecatalog().InternalVersion().ClusterServiceBrokers()
+	scInformer := f.Servicecatalog().InternalVersion().ServiceClasses()
+	bInformer := f.Servicecatalog().InternalVersion().ServiceBrokers()
+	d.cscLister = cscInformer.Lister()
+	d.cbLister = cbInformer.Lister()
+	d.scLister = scInformer.Lister()
+	d.bLister = bInformer.Lister()
+
+	readyFunc := func() bool {
+		return cscInformer.Informer().HasSynced() && cbInformer.Informer().HasSynced() &&
+			scInformer.Informer().HasSynced() && bInformer.Informer().HasSynced()
+	}
+
+	d.SetReadyFunc(readyFunc)
+}
+
+func (d *denyProvisionIfBrokerReadOnly) ValidateInitialization() error {
+	if d.cscLister == nil {
+		return errors.New("missing cluster service class lister")
+	}
+	if d.cbLister == nil {
+		return errors.New("missing cluster service broker lister")
+	}
+	if d.scLister == nil {
+		return errors.New("missing service class lister")
+	}
+	if d.bLister == nil {
+		return errors.New("missing service broker lister")
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readonly
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface) (admission.Interface, informers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	handler, err := NewDenyProvisionIfBrokerReadOnly()
+	if err != nil {
+		return nil, f, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, err
+}
+
+// newFakeClient returns a fake client that lists a ClusterServiceClass
+// "foo" of the broker "cluster-broker" and a ServiceClass "bar" of the
+// broker "broker", which are read-only as given.
+func newFakeClient(clusterBrokerReadOnly, brokerReadOnly bool) *fake.Clientset {
+	fakeClient := &fake.Clientset{}
+	fakeClient.AddReactor("list", "clusterserviceclasses", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &servicecatalog.ClusterServiceClassList{
+			ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+			Items: []servicecatalog.ClusterServiceClass{{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo-id"},
+				Spec: servicecatalog.ClusterServiceClassSpec{
+					ClusterServiceBrokerName: "cluster-broker",
+					CommonServiceClassSpec:   servicecatalog.CommonServiceClassSpec{ExternalName: "foo", ExternalID: "foo-id"},
+				},
+			}},
+		}, nil
+	})
+	fakeClient.AddReactor("list", "clusterservicebrokers", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &servicecatalog.ClusterServiceBrokerList{
+			ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+			Items: []servicecatalog.ClusterServiceBroker{{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-broker"},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{ReadOnly: clusterBrokerReadOnly},
+				},
+			}},
+		}, nil
+	})
+	fakeClient.AddReactor("list", "serviceclasses", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &servicecatalog.ServiceClassList{
+			ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+			Items: []servicecatalog.ServiceClass{{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar-id", Namespace: "test-ns"},
+				Spec: servicecatalog.ServiceClassSpec{
+					ServiceBrokerName:      "broker",
+					CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{ExternalName: "bar", ExternalID: "bar-id"},
+				},
+			}},
+		}, nil
+	})
+	fakeClient.AddReactor("list", "servicebrokers", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &servicecatalog.ServiceBrokerList{
+			ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+			Items: []servicecatalog.ServiceBroker{{
+				ObjectMeta: metav1.ObjectMeta{Name: "broker", Namespace: "test-ns"},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{ReadOnly: brokerReadOnly},
+				},
+			}},
+		}, nil
+	})
+	return fakeClient
+}
+
+func TestDenyProvisionIfBrokerReadOnly(t *testing.T) {
+	cases := []struct {
+		name                  string
+		planReference         servicecatalog.PlanReference
+		clusterBrokerReadOnly bool
+		brokerReadOnly        bool
+		expectedErr           string
+	}{
+		{
+			name:          "cluster class by external name",
+			planReference: servicecatalog.PlanReference{ClusterServiceClassExternalName: "foo"},
+		},
+		{
+			name:                  "read-only cluster broker by external name",
+			planReference:         servicecatalog.PlanReference{ClusterServiceClassExternalName: "foo"},
+			clusterBrokerReadOnly: true,
+			expectedErr:           `ClusterServiceBroker "cluster-broker" is read-only`,
+		},
+		{
+			name:                  "read-only cluster broker by external ID",
+			planReference:         servicecatalog.PlanReference{ClusterServiceClassExternalID: "foo-id"},
+			clusterBrokerReadOnly: true,
+			expectedErr:           `ClusterServiceBroker "cluster-broker" is read-only`,
+		},
+		{
+			name:                  "read-only cluster broker by name",
+			planReference:         servicecatalog.PlanReference{ClusterServiceClassName: "foo-id"},
+			clusterBrokerReadOnly: true,
+			expectedErr:           `ClusterServiceBroker "cluster-broker" is read-only`,
+		},
+		{
+			name:                  "nonexistent cluster class",
+			planReference:         servicecatalog.PlanReference{ClusterServiceClassExternalName: "baz"},
+			clusterBrokerReadOnly: true,
+		},
+		{
+			name:                  "namespaced class of other broker",
+			planReference:         servicecatalog.PlanReference{ServiceClassExternalName: "bar"},
+			clusterBrokerReadOnly: true,
+		},
+		{
+			name:           "read-only namespaced broker",
+			planReference:  servicecatalog.PlanReference{ServiceClassExternalName: "bar"},
+			brokerReadOnly: true,
+			expectedErr:    `ServiceBroker "broker" is read-only`,
+		},
+	}
+
+	for _, tc := range cases {
+		fakeClient := newFakeClient(tc.clusterBrokerReadOnly, tc.brokerReadOnly)
+		handler, informerFactory, err := newHandlerForTest(fakeClient)
+		if err != nil {
+			t.Fatalf("%v: unexpected error initializing handler: %v", tc.name, err)
+		}
+		informerFactory.Start(wait.NeverStop)
+
+		instance := &servicecatalog.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "test-ns"},
+			Spec:       servicecatalog.ServiceInstanceSpec{PlanReference: tc.planReference},
+		}
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"),
+			"test-ns", "instance", servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, nil))
+		if tc.expectedErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("%v: expected error containing %q, got %v", tc.name, tc.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+		}
+	}
+}
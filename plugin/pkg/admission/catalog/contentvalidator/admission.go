@@ -0,0 +1,330 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contentvalidator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "CatalogContentValidator"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewCatalogContentValidator()
+	})
+}
+
+// validateCatalogContent is an implementation of admission.Interface.
+// It rejects classes and plans whose content would keep the controller from
+// using them: an external ID that another class or plan of the same broker
+// already has, a plan that refers to a class that does not exist or belongs
+// to another broker, or a parameter schema that is not a JSON Schema.
+//
+// The controller creates the classes and plans of brokers with valid content,
+// so the checks are meant for the classes and plans created by other clients.
+// On update, only the fields that change are checked, so that the controller
+// can always update the existing classes and plans.
+type validateCatalogContent struct {
+	*admission.Handler
+	client                    internalclientset.Interface
+	clusterServiceClassLister internalversion.ClusterServiceClassLister
+	clusterServicePlanLister  internalversion.ClusterServicePlanLister
+	serviceClassLister        internalversion.ServiceClassLister
+	servicePlanLister         internalversion.ServicePlanLister
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&validateCatalogContent{})
+var _ = scadmission.WantsInternalServiceCatalogClientSet(&validateCatalogContent{})
+
+func (v *validateCatalogContent) Admit(a admission.Attributes) error {
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetSubresource() != "" {
+		return nil
+	}
+
+	var validate func(obj, oldObj runtime.Object) (field.ErrorList, error)
+	switch a.GetResource().GroupResource() {
+	case servicecatalog.Resource("clusterserviceclasses"):
+		validate = v.validateClusterServiceClass
+	case servicecatalog.Resource("clusterserviceplans"):
+		validate = v.validateClusterServicePlan
+	case servicecatalog.Resource("serviceclasses"):
+		validate = v.validateServiceClass
+	case servicecatalog.Resource("serviceplans"):
+		validate = v.validateServicePlan
+	default:
+		return nil
+	}
+
+	// we need to wait for our caches to warm
+	if !v.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	var oldObj runtime.Object
+	if a.GetOperation() == admission.Update {
+		oldObj = a.GetOldObject()
+	}
+	allErrs, err := validate(a.GetObject(), oldObj)
+	if _, ok := err.(apierrors.APIStatus); ok {
+		return err
+	}
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	if len(allErrs) > 0 {
+		glog.V(4).Infof("Rejecting %v %q: %v", a.GetResource().Resource, a.GetName(), allErrs.ToAggregate())
+		return admission.NewForbidden(a, allErrs.ToAggregate())
+	}
+	return nil
+}
+
+func (v *validateCatalogContent) validateClusterServiceClass(obj, oldObj runtime.Object) (field.ErrorList, error) {
+	class, ok := obj.(*servicecatalog.ClusterServiceClass)
+	if !ok {
+		return nil, apierrors.NewBadRequest("Resource was marked with kind ClusterServiceClass but was unable to be converted")
+	}
+	if oldObj != nil {
+		return nil, nil
+	}
+
+	classes, err := v.clusterServiceClassLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range classes {
+		if other.Name != class.Name && other.Spec.ClusterServiceBrokerName == class.Spec.ClusterServiceBrokerName && other.Spec.ExternalID == class.Spec.ExternalID {
+			return duplicateExternalID(class.Spec.ExternalID, "ClusterServiceClass", other.Name), nil
+		}
+	}
+	return nil, nil
+}
+
+func (v *validateCatalogContent) validateClusterServicePlan(obj, oldObj runtime.Object) (field.ErrorList, error) {
+	plan, ok := obj.(*servicecatalog.ClusterServicePlan)
+	if !ok {
+		return nil, apierrors.NewBadRequest("Resource was marked with kind ClusterServicePlan but was unable to be converted")
+	}
+	var oldSpec *servicecatalog.CommonServicePlanSpec
+	if oldObj != nil {
+		oldSpec = &oldObj.(*servicecatalog.ClusterServicePlan).Spec.CommonServicePlanSpec
+	}
+	allErrs := validateParameterSchemas(&plan.Spec.CommonServicePlanSpec, oldSpec)
+	if oldObj != nil && oldObj.(*servicecatalog.ClusterServicePlan).Spec.ClusterServiceClassRef == plan.Spec.ClusterServiceClassRef {
+		return allErrs, nil
+	}
+
+	classRefPath := field.NewPath("spec").Child("clusterServiceClassRef", "name")
+	class, err := v.clusterServiceClassLister.Get(plan.Spec.ClusterServiceClassRef.Name)
+	if apierrors.IsNotFound(err) {
+		// The class may have just been created, before the plan.
+		class, err = v.client.Servicecatalog().ClusterServiceClasses().Get(plan.Spec.ClusterServiceClassRef.Name, metav1.GetOptions{})
+	}
+	switch {
+	case apierrors.IsNotFound(err):
+		allErrs = append(allErrs, field.NotFound(classRefPath, plan.Spec.ClusterServiceClassRef.Name))
+	case err != nil:
+		return nil, err
+	case class.Spec.ClusterServiceBrokerName != plan.Spec.ClusterServiceBrokerName:
+		allErrs = append(allErrs, field.Invalid(classRefPath, plan.Spec.ClusterServiceClassRef.Name,
+			fmt.Sprintf("the ClusterServiceClass belongs to the ClusterServiceBroker %q, not %q", class.Spec.ClusterServiceBrokerName, plan.Spec.ClusterServiceBrokerName)))
+	}
+	if oldObj != nil {
+		return allErrs, nil
+	}
+
+	plans, err := v.clusterServicePlanLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range plans {
+		if other.Name != plan.Name && other.Spec.ClusterServiceBrokerName == plan.Spec.ClusterServiceBrokerName && other.Spec.ExternalID == plan.Spec.ExternalID {
+			return append(allErrs, duplicateExternalID(plan.Spec.ExternalID, "ClusterServicePlan", other.Name)...), nil
+		}
+	}
+	return allErrs, nil
+}
+
+func (v *validateCatalogContent) validateServiceClass(obj, oldObj runtime.Object) (field.ErrorList, error) {
+	class, ok := obj.(*servicecatalog.ServiceClass)
+	if !ok {
+		return nil, apierrors.NewBadRequest("Resource was marked with kind ServiceClass but was unable to be converted")
+	}
+	if oldObj != nil {
+		return nil, nil
+	}
+
+	classes, err := v.serviceClassLister.ServiceClasses(class.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range classes {
+		if other.Name != class.Name && other.Spec.ServiceBrokerName == class.Spec.ServiceBrokerName && other.Spec.ExternalID == class.Spec.ExternalID {
+			return duplicateExternalID(class.Spec.ExternalID, "ServiceClass", other.Name), nil
+		}
+	}
+	return nil, nil
+}
+
+func (v *validateCatalogContent) validateServicePlan(obj, oldObj runtime.Object) (field.ErrorList, error) {
+	plan, ok := obj.(*servicecatalog.ServicePlan)
+	if !ok {
+		return nil, apierrors.NewBadRequest("Resource was marked with kind ServicePlan but was unable to be converted")
+	}
+	var oldSpec *servicecatalog.CommonServicePlanSpec
+	if oldObj != nil {
+		oldSpec = &oldObj.(*servicecatalog.ServicePlan).Spec.CommonServicePlanSpec
+	}
+	allErrs := validateParameterSchemas(&plan.Spec.CommonServicePlanSpec, oldSpec)
+	if oldObj != nil && oldObj.(*servicecatalog.ServicePlan).Spec.ServiceClassRef == plan.Spec.ServiceClassRef {
+		return allErrs, nil
+	}
+
+	classRefPath := field.NewPath("spec").Child("serviceClassRef", "name")
+	class, err := v.serviceClassLister.ServiceClasses(plan.Namespace).Get(plan.Spec.ServiceClassRef.Name)
+	if apierrors.IsNotFound(err) {
+		// The class may have just been created, before the plan.
+		class, err = v.client.Servicecatalog().ServiceClasses(plan.Namespace).Get(plan.Spec.ServiceClassRef.Name, metav1.GetOptions{})
+	}
+	switch {
+	case apierrors.IsNotFound(err):
+		allErrs = append(allErrs, field.NotFound(classRefPath, plan.Spec.ServiceClassRef.Name))
+	case err != nil:
+		return nil, err
+	case class.Spec.ServiceBrokerName != plan.Spec.ServiceBrokerName:
+		allErrs = append(allErrs, field.Invalid(classRefPath, plan.Spec.ServiceClassRef.Name,
+			fmt.Sprintf("the ServiceClass belongs to the ServiceBroker %q, not %q", class.Spec.ServiceBrokerName, plan.Spec.ServiceBrokerName)))
+	}
+	if oldObj != nil {
+		return allErrs, nil
+	}
+
+	plans, err := v.servicePlanLister.ServicePlans(plan.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range plans {
+		if other.Name != plan.Name && other.Spec.ServiceBrokerName == plan.Spec.ServiceBrokerName && other.Spec.ExternalID == plan.Spec.ExternalID {
+			return append(allErrs, duplicateExternalID(plan.Spec.ExternalID, "ServicePlan", other.Name)...), nil
+		}
+	}
+	return allErrs, nil
+}
+
+// duplicateExternalID returns the error for an external ID that the named
+// object of the given kind of the same broker already has.
+func duplicateExternalID(externalID, kind, name string) field.ErrorList {
+	return field.ErrorList{field.Invalid(field.NewPath("spec").Child("externalID"), externalID,
+		fmt.Sprintf("the %s %q of the same broker has the same external ID", kind, name))}
+}
+
+// validateParameterSchemas validates the parameter schemas of a plan that are
+// new or changed from the given old plan, if any.
+func validateParameterSchemas(spec, oldSpec *servicecatalog.CommonServicePlanSpec) field.ErrorList {
+	if oldSpec == nil {
+		oldSpec = &servicecatalog.CommonServicePlanSpec{}
+	}
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+	for _, s := range []struct {
+		name      string
+		schema    *runtime.RawExtension
+		oldSchema *runtime.RawExtension
+	}{
+		{"instanceCreateParameterSchema", spec.ServiceInstanceCreateParameterSchema, oldSpec.ServiceInstanceCreateParameterSchema},
+		{"instanceUpdateParameterSchema", spec.ServiceInstanceUpdateParameterSchema, oldSpec.ServiceInstanceUpdateParameterSchema},
+		{"serviceBindingCreateParameterSchema", spec.ServiceBindingCreateParameterSchema, oldSpec.ServiceBindingCreateParameterSchema},
+	} {
+		if s.schema != nil && s.oldSchema != nil && bytes.Equal(s.schema.Raw, s.oldSchema.Raw) {
+			continue
+		}
+		allErrs = append(allErrs, validateParameterSchema(s.schema, specPath.Child(s.name))...)
+	}
+	return allErrs
+}
+
+func (v *validateCatalogContent) SetInternalServiceCatalogClientSet(client internalclientset.Interface) {
+	v.client = client
+}
+
+func (v *validateCatalogContent) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	clusterServiceClassInformer := f.Servicecatalog().InternalVersion().ClusterServiceClasses()
+	v.clusterServiceClassLister = clusterServiceClassInformer.Lister()
+	clusterServicePlanInformer := f.Servicecatalog().InternalVersion().ClusterServicePlans()
+	v.clusterServicePlanLister = clusterServicePlanInformer.Lister()
+	serviceClassInformer := f.Servicecatalog().InternalVersion().ServiceClasses()
+	v.serviceClassLister = serviceClassInformer.Lister()
+	servicePlanInformer := f.Servicecatalog().InternalVersion().ServicePlans()
+	v.servicePlanLister = servicePlanInformer.Lister()
+
+	readyFunc := func() bool {
+		return clusterServiceClassInformer.Informer().HasSynced() &&
+			clusterServicePlanInformer.Informer().HasSynced() &&
+			serviceClassInformer.Informer().HasSynced() &&
+			servicePlanInformer.Informer().HasSynced()
+	}
+	v.SetReadyFunc(readyFunc)
+}
+
+func (v *validateCatalogContent) ValidateInitialization() error {
+	if v.client == nil {
+		return errors.New("missing client")
+	}
+	if v.clusterServiceClassLister == nil {
+		return errors.New("missing clusterServiceClassLister")
+	}
+	if v.clusterServicePlanLister == nil {
+		return errors.New("missing clusterServicePlanLister")
+	}
+	if v.serviceClassLister == nil {
+		return errors.New("missing serviceClassLister")
+	}
+	if v.servicePlanLister == nil {
+		return errors.New("missing servicePlanLister")
+	}
+	return nil
+}
+
+// NewCatalogContentValidator creates a new admission control handler that
+// rejects classes and plans whose content would keep the controller from using
+// them.
+func NewCatalogContentValidator() (admission.Interface, error) {
+	return &validateCatalogContent{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contentvalidator
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface) (admission.Interface, informers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	handler, err := NewCatalogContentValidator()
+	if err != nil {
+		return nil, f, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, err
+}
+
+// newClusterServiceClass returns a new ClusterServiceClass of the broker
+// "test-broker" for unit tests.
+func newClusterServiceClass(name, externalID string) servicecatalog.ClusterServiceClass {
+	class := servicecatalog.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	class.Spec.ClusterServiceBrokerName = "test-broker"
+	class.Spec.ExternalID = externalID
+	return class
+}
+
+// newClusterServicePlan returns a new ClusterServicePlan of the broker
+// "test-broker" and the class "test-class" for unit tests.
+func newClusterServicePlan(name, externalID string) *servicecatalog.ClusterServicePlan {
+	plan := &servicecatalog.ClusterServicePlan{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	plan.Spec.ClusterServiceBrokerName = "test-broker"
+	plan.Spec.ExternalID = externalID
+	plan.Spec.ClusterServiceClassRef.Name = "test-class"
+	return plan
+}
+
+func TestCatalogContentValidator(t *testing.T) {
+	otherBrokerClass := newClusterServiceClass("other-class", "other-class-id")
+	otherBrokerClass.Spec.ClusterServiceBrokerName = "other-broker"
+
+	cases := []struct {
+		name        string
+		classes     []servicecatalog.ClusterServiceClass
+		plans       []servicecatalog.ClusterServicePlan
+		resource    string
+		operation   admission.Operation
+		obj         runtime.Object
+		oldObj      runtime.Object
+		expectedErr string
+	}{
+		{
+			name:      "new class",
+			classes:   []servicecatalog.ClusterServiceClass{newClusterServiceClass("test-class", "test-class-id")},
+			resource:  "clusterserviceclasses",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ClusterServiceClass {
+				c := newClusterServiceClass("new-class", "new-class-id")
+				return &c
+			}(),
+		},
+		{
+			name:      "class with a duplicate external ID",
+			classes:   []servicecatalog.ClusterServiceClass{newClusterServiceClass("test-class", "test-class-id")},
+			resource:  "clusterserviceclasses",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ClusterServiceClass {
+				c := newClusterServiceClass("new-class", "test-class-id")
+				return &c
+			}(),
+			expectedErr: `the ClusterServiceClass "test-class" of the same broker has the same external ID`,
+		},
+		{
+			name:      "class with the external ID of a class of another broker",
+			classes:   []servicecatalog.ClusterServiceClass{otherBrokerClass},
+			resource:  "clusterserviceclasses",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ClusterServiceClass {
+				c := newClusterServiceClass("new-class", "other-class-id")
+				return &c
+			}(),
+		},
+		{
+			name:      "new plan",
+			classes:   []servicecatalog.ClusterServiceClass{newClusterServiceClass("test-class", "test-class-id")},
+			resource:  "clusterserviceplans",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ClusterServicePlan {
+				p := newClusterServicePlan("test-plan", "test-plan-id")
+				p.Spec.ServiceInstanceCreateParameterSchema = &runtime.RawExtension{Raw: []byte(`{"type": "object", "properties": {"name": {"type": "string", "pattern": "^[a-z]+$"}}}`)}
+				return p
+			}(),
+		},
+		{
+			name:        "plan with a duplicate external ID",
+			classes:     []servicecatalog.ClusterServiceClass{newClusterServiceClass("test-class", "test-class-id")},
+			plans:       []servicecatalog.ClusterServicePlan{*newClusterServicePlan("test-plan", "test-plan-id")},
+			resource:    "clusterserviceplans",
+			operation:   admission.Create,
+			obj:         newClusterServicePlan("new-plan", "test-plan-id"),
+			expectedErr: `the ClusterServicePlan "test-plan" of the same broker has the same external ID`,
+		},
+		{
+			name:        "plan of a missing class",
+			resource:    "clusterserviceplans",
+			operation:   admission.Create,
+			obj:         newClusterServicePlan("test-plan", "test-plan-id"),
+			expectedErr: `spec.clusterServiceClassRef.name: Not found: "test-class"`,
+		},
+		{
+			name:      "plan of a class of another broker",
+			classes:   []servicecatalog.ClusterServiceClass{otherBrokerClass},
+			resource:  "clusterserviceplans",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ClusterServicePlan {
+				p := newClusterServicePlan("test-plan", "test-plan-id")
+				p.Spec.ClusterServiceClassRef.Name = "other-class"
+				return p
+			}(),
+			expectedErr: `the ClusterServiceClass belongs to the ClusterServiceBroker "other-broker", not "test-broker"`,
+		},
+		{
+			name:      "plan with a schema that is not an object",
+			classes:   []servicecatalog.ClusterServiceClass{newClusterServiceClass("test-class", "test-class-id")},
+			resource:  "clusterserviceplans",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ClusterServicePlan {
+				p := newClusterServicePlan("test-plan", "test-plan-id")
+				p.Spec.ServiceBindingCreateParameterSchema = &runtime.RawExtension{Raw: []byte(`"object"`)}
+				return p
+			}(),
+			expectedErr: "spec.serviceBindingCreateParameterSchema: Invalid value",
+		},
+		{
+			name:      "plan with a schema of an unknown type",
+			classes:   []servicecatalog.ClusterServiceClass{newClusterServiceClass("test-class", "test-class-id")},
+			resource:  "clusterserviceplans",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ClusterServicePlan {
+				p := newClusterServicePlan("test-plan", "test-plan-id")
+				p.Spec.ServiceInstanceCreateParameterSchema = &runtime.RawExtension{Raw: []byte(`{"type": "object", "properties": {"size": {"type": "int"}}}`)}
+				return p
+			}(),
+			expectedErr: `spec.instanceCreateParameterSchema.properties[size].type: Unsupported value: "int"`,
+		},
+		{
+			name:      "plan with a schema with an invalid pattern",
+			classes:   []servicecatalog.ClusterServiceClass{newClusterServiceClass("test-class", "test-class-id")},
+			resource:  "clusterserviceplans",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ClusterServicePlan {
+				p := newClusterServicePlan("test-plan", "test-plan-id")
+				p.Spec.ServiceInstanceUpdateParameterSchema = &runtime.RawExtension{Raw: []byte(`{"type": "string", "pattern": "[a-z"}`)}
+				return p
+			}(),
+			expectedErr: "spec.instanceUpdateParameterSchema.pattern: Invalid value",
+		},
+		{
+			name:      "update of a plan of a missing class",
+			resource:  "clusterserviceplans",
+			operation: admission.Update,
+			obj: func() *servicecatalog.ClusterServicePlan {
+				p := newClusterServicePlan("test-plan", "test-plan-id")
+				p.Spec.Description = "new description"
+				return p
+			}(),
+			oldObj: newClusterServicePlan("test-plan", "test-plan-id"),
+		},
+		{
+			name:      "namespaced plan of a missing class",
+			resource:  "serviceplans",
+			operation: admission.Create,
+			obj: func() *servicecatalog.ServicePlan {
+				p := &servicecatalog.ServicePlan{ObjectMeta: metav1.ObjectMeta{Name: "test-plan", Namespace: "test-ns"}}
+				p.Spec.ServiceBrokerName = "test-broker"
+				p.Spec.ExternalID = "test-plan-id"
+				p.Spec.ServiceClassRef.Name = "test-class"
+				return p
+			}(),
+			expectedErr: `spec.serviceClassRef.name: Not found: "test-class"`,
+		},
+	}
+
+	for _, tc := range cases {
+		fakeClient := &fake.Clientset{}
+		handler, informerFactory, err := newHandlerForTest(fakeClient)
+		if err != nil {
+			t.Fatalf("%v: unexpected error initializing handler: %v", tc.name, err)
+		}
+
+		for resource, list := range map[string]runtime.Object{
+			"clusterserviceclasses": &servicecatalog.ClusterServiceClassList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: tc.classes},
+			"clusterserviceplans":   &servicecatalog.ClusterServicePlanList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: tc.plans},
+			"serviceclasses":        &servicecatalog.ServiceClassList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}},
+			"serviceplans":          &servicecatalog.ServicePlanList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}},
+		} {
+			list := list
+			fakeClient.AddReactor("list", resource, func(action core.Action) (bool, runtime.Object, error) {
+				return true, list, nil
+			})
+		}
+		fakeClient.AddReactor("get", "*", func(action core.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewNotFound(action.GetResource().GroupResource(), action.(core.GetAction).GetName())
+		})
+		informerFactory.Start(wait.NeverStop)
+
+		accessor, err := meta.Accessor(tc.obj)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tc.name, err)
+		}
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(tc.obj, tc.oldObj, servicecatalog.Kind("Unused").WithVersion("version"),
+			accessor.GetNamespace(), accessor.GetName(), servicecatalog.Resource(tc.resource).WithVersion("version"), "", tc.operation, nil))
+		if tc.expectedErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("%v: expected an error containing %q, got %v", tc.name, tc.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contentvalidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/go-openapi/spec"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// schemaTypes are the primitive types of JSON Schema.
+var schemaTypes = sets.NewString("array", "boolean", "integer", "null", "number", "object", "string")
+
+// validateParameterSchema validates that the given parameter schema of a plan
+// is a JSON Schema object.
+func validateParameterSchema(schema *runtime.RawExtension, fldPath *field.Path) field.ErrorList {
+	if schema == nil {
+		return nil
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(schema.Raw, &raw); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(schema.Raw), fmt.Sprintf("must be a JSON object: %v", err))}
+	}
+	var s spec.Schema
+	if err := json.Unmarshal(schema.Raw, &s); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(schema.Raw), fmt.Sprintf("must be a JSON Schema: %v", err))}
+	}
+	return validateSchema(&s, fldPath)
+}
+
+// validateSchema validates the given JSON Schema and its subschemas.
+func validateSchema(s *spec.Schema, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, t := range s.Type {
+		if !schemaTypes.Has(t) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), t, schemaTypes.List()))
+		}
+	}
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("pattern"), s.Pattern, err.Error()))
+		}
+	}
+	for name, count := range map[string]*int64{
+		"maxLength":     s.MaxLength,
+		"minLength":     s.MinLength,
+		"maxItems":      s.MaxItems,
+		"minItems":      s.MinItems,
+		"maxProperties": s.MaxProperties,
+		"minProperties": s.MinProperties,
+	} {
+		if count != nil && *count < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *count, "must be greater than or equal to 0"))
+		}
+	}
+
+	for name, property := range s.Properties {
+		property := property
+		allErrs = append(allErrs, validateSchema(&property, fldPath.Child("properties").Key(name))...)
+	}
+	for pattern, property := range s.PatternProperties {
+		if _, err := regexp.Compile(pattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("patternProperties"), pattern, err.Error()))
+		}
+		property := property
+		allErrs = append(allErrs, validateSchema(&property, fldPath.Child("patternProperties").Key(pattern))...)
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+		allErrs = append(allErrs, validateSchema(s.AdditionalProperties.Schema, fldPath.Child("additionalProperties"))...)
+	}
+	if s.Items != nil {
+		if s.Items.Schema != nil {
+			allErrs = append(allErrs, validateSchema(s.Items.Schema, fldPath.Child("items"))...)
+		}
+		for i := range s.Items.Schemas {
+			allErrs = append(allErrs, validateSchema(&s.Items.Schemas[i], fldPath.Child("items").Index(i))...)
+		}
+	}
+	for name, subschemas := range map[string][]spec.Schema{
+		"allOf": s.AllOf,
+		"anyOf": s.AnyOf,
+		"oneOf": s.OneOf,
+	} {
+		for i := range subschemas {
+			allErrs = append(allErrs, validateSchema(&subschemas[i], fldPath.Child(name).Index(i))...)
+		}
+	}
+	if s.Not != nil {
+		allErrs = append(allErrs, validateSchema(s.Not, fldPath.Child("not"))...)
+	}
+	for name, definition := range s.Definitions {
+		definition := definition
+		allErrs = append(allErrs, validateSchema(&definition, fldPath.Child("definitions").Key(name))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectcount
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceCatalogResourceQuota"
+
+	// maxQuotaUpdateAttempts is how many times the usage of a quota is
+	// updated when concurrent creations conflict on it.
+	maxQuotaUpdateAttempts = 5
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewObjectCountQuota()
+	})
+}
+
+// enforceObjectCountQuota is an implementation of admission.Interface.
+// If creating a new ServiceInstance or ServiceBinding, fail the operation if
+// a ResourceQuota of its namespace limits the number of objects of its
+// resource, through the standard count/<resource>.<group> resource name, and
+// the namespace already has that many. Otherwise the object is charged to the
+// used status of the quota, with an update that conflicts with concurrent
+// creations, as the ResourceQuota admission of the core API server does. The
+// quota controller of Kubernetes then recalculates the usage, which releases
+// the charge of objects that were deleted or not created after all.
+type enforceObjectCountQuota struct {
+	*admission.Handler
+	kubeClient     kubeclientset.Interface
+	quotaLister    corelisters.ResourceQuotaLister
+	instanceLister internalversion.ServiceInstanceLister
+	bindingLister  internalversion.ServiceBindingLister
+	synced         []func() bool
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&enforceObjectCountQuota{})
+var _ = scadmission.WantsKubeInformerFactory(&enforceObjectCountQuota{})
+var _ = scadmission.WantsKubeClientSet(&enforceObjectCountQuota{})
+
+func (q *enforceObjectCountQuota) Admit(a admission.Attributes) error {
+	if a.GetResource().Group != servicecatalog.GroupName {
+		return nil
+	}
+
+	// We don't want to deal with any sub resources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	var count func(namespace string) (int, error)
+	switch a.GetResource().GroupResource() {
+	case servicecatalog.Resource("serviceinstances"):
+		count = func(namespace string) (int, error) {
+			instances, err := q.instanceLister.ServiceInstances(namespace).List(labels.Everything())
+			return len(instances), err
+		}
+	case servicecatalog.Resource("servicebindings"):
+		count = func(namespace string) (int, error) {
+			bindings, err := q.bindingLister.ServiceBindings(namespace).List(labels.Everything())
+			return len(bindings), err
+		}
+	default:
+		return nil
+	}
+
+	// we need to wait for our caches to warm
+	if !q.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	quotas, err := q.quotaLister.ResourceQuotas(a.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return admission.NewForbidden(a, fmt.Errorf("error listing the resource quotas of namespace %q: %v", a.GetNamespace(), err))
+	}
+	resourceName := ObjectCountResourceName(a.GetResource().GroupResource())
+	for _, quota := range quotas {
+		if _, ok := quota.Spec.Hard[resourceName]; !ok {
+			continue
+		}
+		// As for the ResourceQuota of the core API server, quotas with
+		// scopes only match pods.
+		if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+			continue
+		}
+		if err := q.charge(a, quota.Name, resourceName, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// charge charges the object of the given request to the quota with the given
+// name, or returns an error if it would exceed the quota. The usage is read
+// from the quota itself rather than from a cache, and is updated with the
+// resource version it was read at, so that concurrent creations cannot all be
+// admitted. A quota whose usage the quota controller has not calculated yet
+// falls back to the given count.
+func (q *enforceObjectCountQuota) charge(a admission.Attributes, quotaName string, resourceName corev1.ResourceName, count func(namespace string) (int, error)) error {
+	quotas := q.kubeClient.CoreV1().ResourceQuotas(a.GetNamespace())
+	for attempt := 0; attempt < maxQuotaUpdateAttempts; attempt++ {
+		quota, err := quotas.Get(quotaName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("error getting the resource quota %q: %v", quotaName, err))
+		}
+		hard, ok := quota.Spec.Hard[resourceName]
+		if !ok {
+			return nil
+		}
+
+		var used int64
+		if quantity, ok := quota.Status.Used[resourceName]; ok {
+			used = quantity.Value()
+		} else {
+			counted, err := count(a.GetNamespace())
+			if err != nil {
+				return admission.NewForbidden(a, fmt.Errorf("error counting %v: %v", a.GetResource().Resource, err))
+			}
+			used = int64(counted)
+		}
+		if used+1 > hard.Value() {
+			msg := fmt.Sprintf("exceeded quota: %s, requested: %s=1, used: %s=%d, limited: %s=%s", quota.Name, resourceName, resourceName, used, resourceName, hard.String())
+			glog.V(4).Infof("%s %q in namespace %q: %s", a.GetKind().Kind, a.GetName(), a.GetNamespace(), msg)
+			return admission.NewForbidden(a, fmt.Errorf("%s", msg))
+		}
+
+		toUpdate := quota.DeepCopy()
+		if toUpdate.Status.Used == nil {
+			toUpdate.Status.Used = corev1.ResourceList{}
+		}
+		toUpdate.Status.Used[resourceName] = *resource.NewQuantity(used+1, resource.DecimalSI)
+		_, err = quotas.UpdateStatus(toUpdate)
+		if err == nil {
+			return nil
+		}
+		if !apierrors.IsConflict(err) {
+			return admission.NewForbidden(a, fmt.Errorf("error updating the usage of the resource quota %q: %v", quotaName, err))
+		}
+	}
+	return admission.NewForbidden(a, fmt.Errorf("too many concurrent updates of the resource quota %q, try again later", quotaName))
+}
+
+// ObjectCountResourceName returns the name under which ResourceQuotas limit
+// the number of objects of the given resource.
+func ObjectCountResourceName(resource schema.GroupResource) corev1.ResourceName {
+	return corev1.ResourceName(fmt.Sprintf("count/%s", resource.String()))
+}
+
+func (q *enforceObjectCountQuota) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	instanceInformer := f.Servicecatalog().InternalVersion().ServiceInstances()
+	q.instanceLister = instanceInformer.Lister()
+	bindingInformer := f.Servicecatalog().InternalVersion().ServiceBindings()
+	q.bindingLister = bindingInformer.Lister()
+	q.setReadyFunc(instanceInformer.Informer().HasSynced, bindingInformer.Informer().HasSynced)
+}
+
+func (q *enforceObjectCountQuota) SetKubeClientSet(client kubeclientset.Interface) {
+	q.kubeClient = client
+}
+
+func (q *enforceObjectCountQuota) SetKubeInformerFactory(f kubeinformers.SharedInformerFactory) {
+	quotaInformer := f.Core().V1().ResourceQuotas()
+	q.quotaLister = quotaInformer.Lister()
+	q.setReadyFunc(quotaInformer.Informer().HasSynced)
+}
+
+// setReadyFunc adds the given functions to those the handler waits for
+// before it handles requests, since the informers of the service catalog and
+// of the core API server are set separately.
+func (q *enforceObjectCountQuota) setReadyFunc(synced ...func() bool) {
+	q.synced = append(q.synced, synced...)
+	q.SetReadyFunc(func() bool {
+		for _, hasSynced := range q.synced {
+			if !hasSynced() {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (q *enforceObjectCountQuota) ValidateInitialization() error {
+	if q.kubeClient == nil {
+		return fmt.Errorf("missing kubeClient")
+	}
+	if q.quotaLister == nil {
+		return fmt.Errorf("missing resourceQuotaLister")
+	}
+	if q.instanceLister == nil {
+		return fmt.Errorf("missing serviceInstanceLister")
+	}
+	if q.bindingLister == nil {
+		return fmt.Errorf("missing serviceBindingLister")
+	}
+	return nil
+}
+
+// NewObjectCountQuota creates a new admission control handler that enforces
+// the object count quotas of ResourceQuotas on the creation of
+// ServiceInstances and ServiceBindings.
+func NewObjectCountQuota() (admission.Interface, error) {
+	return &enforceObjectCountQuota{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
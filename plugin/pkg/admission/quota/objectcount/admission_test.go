@@ -0,0 +1,259 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectcount
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface, kubeClient kubeclientset.Interface) (admission.Interface, informers.SharedInformerFactory, kubeinformers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	kf := kubeinformers.NewSharedInformerFactory(kubeClient, 5*time.Minute)
+	handler, err := NewObjectCountQuota()
+	if err != nil {
+		return nil, f, kf, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, kubeClient, kf)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, kf, err
+}
+
+// newResourceQuota returns a ResourceQuota of test-ns with the given hard
+// limits.
+func newResourceQuota(hard map[string]string) corev1.ResourceQuota {
+	quota := corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-quota", Namespace: "test-ns"},
+		Spec:       corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{}},
+	}
+	for name, value := range hard {
+		quota.Spec.Hard[corev1.ResourceName(name)] = resource.MustParse(value)
+	}
+	return quota
+}
+
+// withUsed returns the given quota with the given used status.
+func withUsed(quota corev1.ResourceQuota, used map[string]string) corev1.ResourceQuota {
+	quota.Status.Used = corev1.ResourceList{}
+	for name, value := range used {
+		quota.Status.Used[corev1.ResourceName(name)] = resource.MustParse(value)
+	}
+	return quota
+}
+
+// addResourceQuotaReactions makes the given client serve the given quotas,
+// and returns the quotas updated through it.
+func addResourceQuotaReactions(kubeClient *kubefake.Clientset, quotas []corev1.ResourceQuota) *[]*corev1.ResourceQuota {
+	updated := &[]*corev1.ResourceQuota{}
+	kubeClient.AddReactor("list", "resourcequotas", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &corev1.ResourceQuotaList{
+			ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+			Items:    quotas,
+		}, nil
+	})
+	kubeClient.AddReactor("get", "resourcequotas", func(action core.Action) (bool, runtime.Object, error) {
+		name := action.(core.GetAction).GetName()
+		for i := range quotas {
+			if quotas[i].Name == name {
+				return true, quotas[i].DeepCopy(), nil
+			}
+		}
+		return true, nil, apierrors.NewNotFound(corev1.Resource("resourcequotas"), name)
+	})
+	kubeClient.AddReactor("update", "resourcequotas", func(action core.Action) (bool, runtime.Object, error) {
+		quota := action.(core.UpdateAction).GetObject().(*corev1.ResourceQuota)
+		*updated = append(*updated, quota)
+		return true, quota, nil
+	})
+	return updated
+}
+
+func newServiceInstance(name string) servicecatalog.ServiceInstance {
+	return servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+	}
+}
+
+func TestEnforceObjectCountQuota(t *testing.T) {
+	cases := []struct {
+		name         string
+		quotas       []corev1.ResourceQuota
+		instances    []servicecatalog.ServiceInstance
+		expectedErr  string
+		expectedUsed string
+	}{
+		{
+			name:      "no quota",
+			instances: []servicecatalog.ServiceInstance{newServiceInstance("instance-1")},
+		},
+		{
+			name:      "quota of other resources",
+			quotas:    []corev1.ResourceQuota{newResourceQuota(map[string]string{"pods": "0"})},
+			instances: []servicecatalog.ServiceInstance{newServiceInstance("instance-1")},
+		},
+		{
+			name:         "under quota",
+			quotas:       []corev1.ResourceQuota{newResourceQuota(map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "2"})},
+			instances:    []servicecatalog.ServiceInstance{newServiceInstance("instance-1")},
+			expectedUsed: "2",
+		},
+		{
+			name: "under quota in status",
+			quotas: []corev1.ResourceQuota{withUsed(
+				newResourceQuota(map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "3"}),
+				map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "1"},
+			)},
+			expectedUsed: "2",
+		},
+		{
+			name: "at quota in status",
+			quotas: []corev1.ResourceQuota{withUsed(
+				newResourceQuota(map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "2"}),
+				map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "2"},
+			)},
+			expectedErr: "exceeded quota: test-quota",
+		},
+		{
+			name:        "at quota",
+			quotas:      []corev1.ResourceQuota{newResourceQuota(map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "1"})},
+			instances:   []servicecatalog.ServiceInstance{newServiceInstance("instance-1")},
+			expectedErr: "exceeded quota: test-quota, requested: count/serviceinstances.servicecatalog.k8s.io=1, used: count/serviceinstances.servicecatalog.k8s.io=1, limited: count/serviceinstances.servicecatalog.k8s.io=1",
+		},
+		{
+			name:        "zero quota",
+			quotas:      []corev1.ResourceQuota{newResourceQuota(map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "0"})},
+			expectedErr: "exceeded quota: test-quota",
+		},
+	}
+
+	for _, tc := range cases {
+		fakeClient := &fake.Clientset{}
+		fakeKubeClient := &kubefake.Clientset{}
+		handler, informerFactory, kubeInformerFactory, err := newHandlerForTest(fakeClient, fakeKubeClient)
+		if err != nil {
+			t.Fatalf("%v: unexpected error initializing handler: %v", tc.name, err)
+		}
+
+		instances := &servicecatalog.ServiceInstanceList{
+			ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+			Items:    tc.instances,
+		}
+		fakeClient.AddReactor("list", "serviceinstances", func(action core.Action) (bool, runtime.Object, error) {
+			return true, instances, nil
+		})
+		fakeClient.AddReactor("list", "servicebindings", func(action core.Action) (bool, runtime.Object, error) {
+			return true, &servicecatalog.ServiceBindingList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}, nil
+		})
+		updated := addResourceQuotaReactions(fakeKubeClient, tc.quotas)
+		informerFactory.Start(wait.NeverStop)
+		kubeInformerFactory.Start(wait.NeverStop)
+
+		instance := newServiceInstance("new-instance")
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(&instance, nil, servicecatalog.Kind("ServiceInstances").WithVersion("version"),
+			"test-ns", "new-instance", servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, nil))
+		if tc.expectedErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("%v: expected an error containing %q, got %v", tc.name, tc.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+		}
+		if tc.expectedUsed == "" {
+			if len(*updated) != 0 {
+				t.Errorf("%v: expected no quota to be updated", tc.name)
+			}
+			continue
+		}
+		if len(*updated) != 1 {
+			t.Fatalf("%v: expected the quota to be updated once, got %d updates", tc.name, len(*updated))
+		}
+		used := (*updated)[0].Status.Used[corev1.ResourceName("count/serviceinstances.servicecatalog.k8s.io")]
+		if e, a := tc.expectedUsed, used.String(); e != a {
+			t.Errorf("%v: expected used %v, got %v", tc.name, e, a)
+		}
+	}
+}
+
+// TestEnforceObjectCountQuotaConflict tests that the usage of a quota is read
+// again when a concurrent creation updated it first.
+func TestEnforceObjectCountQuotaConflict(t *testing.T) {
+	fakeClient := &fake.Clientset{}
+	fakeKubeClient := &kubefake.Clientset{}
+	handler, informerFactory, kubeInformerFactory, err := newHandlerForTest(fakeClient, fakeKubeClient)
+	if err != nil {
+		t.Fatalf("unexpected error initializing handler: %v", err)
+	}
+
+	fakeClient.AddReactor("list", "serviceinstances", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &servicecatalog.ServiceInstanceList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}, nil
+	})
+	fakeClient.AddReactor("list", "servicebindings", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &servicecatalog.ServiceBindingList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}, nil
+	})
+	quota := withUsed(
+		newResourceQuota(map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "2"}),
+		map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "1"},
+	)
+	quotas := []corev1.ResourceQuota{quota}
+	conflicts := 0
+	// The first update conflicts with a concurrent creation, which takes the
+	// last object of the quota.
+	fakeKubeClient.AddReactor("update", "resourcequotas", func(action core.Action) (bool, runtime.Object, error) {
+		if conflicts > 0 {
+			return false, nil, nil
+		}
+		conflicts++
+		quotas[0] = withUsed(quota, map[string]string{"count/serviceinstances.servicecatalog.k8s.io": "2"})
+		return true, nil, apierrors.NewConflict(corev1.Resource("resourcequotas"), quota.Name, nil)
+	})
+	updated := addResourceQuotaReactions(fakeKubeClient, quotas)
+	informerFactory.Start(wait.NeverStop)
+	kubeInformerFactory.Start(wait.NeverStop)
+
+	instance := newServiceInstance("new-instance")
+	err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(&instance, nil, servicecatalog.Kind("ServiceInstances").WithVersion("version"),
+		"test-ns", "new-instance", servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, nil))
+	if err == nil || !strings.Contains(err.Error(), "exceeded quota: test-quota") {
+		t.Fatalf("expected the quota to be exceeded, got %v", err)
+	}
+	if len(*updated) != 0 {
+		t.Fatalf("expected no quota to be updated, got %d updates", len(*updated))
+	}
+}
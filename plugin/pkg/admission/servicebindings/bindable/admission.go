@@ -0,0 +1,189 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindable
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceBindingBindablePlan"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewBindablePlan()
+	})
+}
+
+// enforceBindablePlan is an implementation of admission.Interface.
+// If creating a new ServiceBinding, fail the operation if the plan of its
+// ServiceInstance is not bindable, either because the plan says so or because
+// the plan does not say and its class is not bindable.
+type enforceBindablePlan struct {
+	*admission.Handler
+	instanceLister            internalversion.ServiceInstanceLister
+	clusterServiceClassLister internalversion.ClusterServiceClassLister
+	clusterServicePlanLister  internalversion.ClusterServicePlanLister
+	serviceClassLister        internalversion.ServiceClassLister
+	servicePlanLister         internalversion.ServicePlanLister
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&enforceBindablePlan{})
+
+func (b *enforceBindablePlan) Admit(a admission.Attributes) error {
+	// We only care about bindings
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("servicebindings") {
+		return nil
+	}
+
+	// We don't want to deal with any sub resources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	binding, ok := a.GetObject().(*servicecatalog.ServiceBinding)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind ServiceBinding but was unable to be converted")
+	}
+
+	if binding.Spec.ServiceInstanceRef.Name == "" {
+		return nil
+	}
+
+	// we need to wait for our caches to warm
+	if !b.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	// The controller reports instances, classes and plans that cannot be
+	// found, so the binding is only rejected when its plan is known not to
+	// be bindable.
+	instance, err := b.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.ServiceInstanceRef.Name)
+	if err != nil {
+		return nil
+	}
+
+	var bindable bool
+	var planDescription string
+	switch {
+	case instance.Spec.ClusterServiceClassRef != nil && instance.Spec.ClusterServicePlanRef != nil:
+		class, err := b.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return nil
+		}
+		plan, err := b.clusterServicePlanLister.Get(instance.Spec.ClusterServicePlanRef.Name)
+		if err != nil {
+			return nil
+		}
+		bindable = isPlanBindable(class.Spec.Bindable, plan.Spec.Bindable)
+		planDescription = fmt.Sprintf("ClusterServicePlan %q of ClusterServiceClass %q", plan.Spec.ExternalName, class.Spec.ExternalName)
+	case instance.Spec.ServiceClassRef != nil && instance.Spec.ServicePlanRef != nil:
+		class, err := b.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return nil
+		}
+		plan, err := b.servicePlanLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
+		if err != nil {
+			return nil
+		}
+		bindable = isPlanBindable(class.Spec.Bindable, plan.Spec.Bindable)
+		planDescription = fmt.Sprintf("ServicePlan %q of ServiceClass %q", plan.Spec.ExternalName, class.Spec.ExternalName)
+	default:
+		return nil
+	}
+
+	if !bindable {
+		msg := fmt.Sprintf("ServiceInstance %s/%s uses the %s, which is not bindable", instance.Namespace, instance.Name, planDescription)
+		glog.V(4).Infof(`ServiceBinding "%s/%s": %s`, binding.Namespace, binding.Name, msg)
+		return admission.NewForbidden(a, errors.New(msg))
+	}
+	return nil
+}
+
+// isPlanBindable returns whether a plan is bindable. A plan may override
+// whether its class is bindable.
+func isPlanBindable(classBindable bool, planBindable *bool) bool {
+	if planBindable != nil {
+		return *planBindable
+	}
+	return classBindable
+}
+
+func (b *enforceBindablePlan) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	instanceInformer := f.Servicecatalog().InternalVersion().ServiceInstances()
+	b.instanceLister = instanceInformer.Lister()
+	clusterServiceClassInformer := f.Servicecatalog().InternalVersion().ClusterServiceClasses()
+	b.clusterServiceClassLister = clusterServiceClassInformer.Lister()
+	clusterServicePlanInformer := f.Servicecatalog().InternalVersion().ClusterServicePlans()
+	b.clusterServicePlanLister = clusterServicePlanInformer.Lister()
+	serviceClassInformer := f.Servicecatalog().InternalVersion().ServiceClasses()
+	b.serviceClassLister = serviceClassInformer.Lister()
+	servicePlanInformer := f.Servicecatalog().InternalVersion().ServicePlans()
+	b.servicePlanLister = servicePlanInformer.Lister()
+
+	readyFunc := func() bool {
+		return instanceInformer.Informer().HasSynced() &&
+			clusterServiceClassInformer.Informer().HasSynced() &&
+			clusterServicePlanInformer.Informer().HasSynced() &&
+			serviceClassInformer.Informer().HasSynced() &&
+			servicePlanInformer.Informer().HasSynced()
+	}
+	b.SetReadyFunc(readyFunc)
+}
+
+func (b *enforceBindablePlan) ValidateInitialization() error {
+	if b.instanceLister == nil {
+		return fmt.Errorf("missing serviceInstanceLister")
+	}
+	if b.clusterServiceClassLister == nil {
+		return fmt.Errorf("missing clusterServiceClassLister")
+	}
+	if b.clusterServicePlanLister == nil {
+		return fmt.Errorf("missing clusterServicePlanLister")
+	}
+	if b.serviceClassLister == nil {
+		return fmt.Errorf("missing serviceClassLister")
+	}
+	if b.servicePlanLister == nil {
+		return fmt.Errorf("missing servicePlanLister")
+	}
+	return nil
+}
+
+// NewBindablePlan creates a new admission control handler that blocks the
+// creation of a ServiceBinding to a ServiceInstance whose plan is not
+// bindable.
+func NewBindablePlan() (admission.Interface, error) {
+	return &enforceBindablePlan{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindable
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface) (admission.Interface, informers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	handler, err := NewBindablePlan()
+	if err != nil {
+		return nil, f, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, err
+}
+
+// newClusterServiceInstance returns a new Service Instance of the cluster
+// class "foo" and plan "bar" for unit tests.
+func newClusterServiceInstance() servicecatalog.ServiceInstance {
+	return servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-ns"},
+		Spec: servicecatalog.ServiceInstanceSpec{
+			ClusterServiceClassRef: &servicecatalog.ClusterObjectReference{Name: "foo-id"},
+			ClusterServicePlanRef:  &servicecatalog.ClusterObjectReference{Name: "bar-id"},
+		},
+	}
+}
+
+// newServiceInstance returns a new Service Instance of the namespaced class
+// "foo" and plan "bar" for unit tests.
+func newServiceInstance() servicecatalog.ServiceInstance {
+	return servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-ns"},
+		Spec: servicecatalog.ServiceInstanceSpec{
+			ServiceClassRef: &servicecatalog.LocalObjectReference{Name: "foo-id"},
+			ServicePlanRef:  &servicecatalog.LocalObjectReference{Name: "bar-id"},
+		},
+	}
+}
+
+// newServiceBinding returns a new Service Binding to test-instance.
+func newServiceBinding() servicecatalog.ServiceBinding {
+	return servicecatalog.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-binding",
+			Namespace: "test-ns",
+		},
+		Spec: servicecatalog.ServiceBindingSpec{
+			ServiceInstanceRef: servicecatalog.LocalObjectReference{Name: "test-instance"},
+			SecretName:         "test-secret",
+		},
+	}
+}
+
+func TestEnforceBindablePlan(t *testing.T) {
+	trueVal := true
+	falseVal := false
+
+	cases := []struct {
+		name           string
+		instances      []servicecatalog.ServiceInstance
+		classBindable  bool
+		planBindable   *bool
+		expectedErr    string
+		namespacedPlan bool
+	}{
+		{
+			name:          "bindable class",
+			instances:     []servicecatalog.ServiceInstance{newClusterServiceInstance()},
+			classBindable: true,
+		},
+		{
+			name:          "non-bindable class",
+			instances:     []servicecatalog.ServiceInstance{newClusterServiceInstance()},
+			classBindable: false,
+			expectedErr:   `ServiceInstance test-ns/test-instance uses the ClusterServicePlan "bar" of ClusterServiceClass "foo", which is not bindable`,
+		},
+		{
+			name:          "bindable plan of non-bindable class",
+			instances:     []servicecatalog.ServiceInstance{newClusterServiceInstance()},
+			classBindable: false,
+			planBindable:  &trueVal,
+		},
+		{
+			name:          "non-bindable plan of bindable class",
+			instances:     []servicecatalog.ServiceInstance{newClusterServiceInstance()},
+			classBindable: true,
+			planBindable:  &falseVal,
+			expectedErr:   "which is not bindable",
+		},
+		{
+			name:           "non-bindable namespaced plan",
+			instances:      []servicecatalog.ServiceInstance{newServiceInstance()},
+			classBindable:  true,
+			planBindable:   &falseVal,
+			namespacedPlan: true,
+			expectedErr:    `uses the ServicePlan "bar" of ServiceClass "foo", which is not bindable`,
+		},
+		{
+			name:          "instance not found",
+			classBindable: false,
+		},
+	}
+
+	for _, tc := range cases {
+		fakeClient := &fake.Clientset{}
+		handler, informerFactory, err := newHandlerForTest(fakeClient)
+		if err != nil {
+			t.Fatalf("%v: unexpected error initializing handler: %v", tc.name, err)
+		}
+
+		instances := &servicecatalog.ServiceInstanceList{
+			ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+			Items:    tc.instances,
+		}
+		clusterServiceClasses := &servicecatalog.ClusterServiceClassList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+		clusterServicePlans := &servicecatalog.ClusterServicePlanList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+		serviceClasses := &servicecatalog.ServiceClassList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+		servicePlans := &servicecatalog.ServicePlanList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+		if tc.namespacedPlan {
+			class := servicecatalog.ServiceClass{ObjectMeta: metav1.ObjectMeta{Name: "foo-id", Namespace: "test-ns"}}
+			class.Spec.ExternalName = "foo"
+			class.Spec.Bindable = tc.classBindable
+			serviceClasses.Items = append(serviceClasses.Items, class)
+			plan := servicecatalog.ServicePlan{ObjectMeta: metav1.ObjectMeta{Name: "bar-id", Namespace: "test-ns"}}
+			plan.Spec.ExternalName = "bar"
+			plan.Spec.Bindable = tc.planBindable
+			servicePlans.Items = append(servicePlans.Items, plan)
+		} else {
+			class := servicecatalog.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: "foo-id"}}
+			class.Spec.ExternalName = "foo"
+			class.Spec.Bindable = tc.classBindable
+			clusterServiceClasses.Items = append(clusterServiceClasses.Items, class)
+			plan := servicecatalog.ClusterServicePlan{ObjectMeta: metav1.ObjectMeta{Name: "bar-id"}}
+			plan.Spec.ExternalName = "bar"
+			plan.Spec.Bindable = tc.planBindable
+			clusterServicePlans.Items = append(clusterServicePlans.Items, plan)
+		}
+		for resource, list := range map[string]runtime.Object{
+			"serviceinstances":      instances,
+			"clusterserviceclasses": clusterServiceClasses,
+			"clusterserviceplans":   clusterServicePlans,
+			"serviceclasses":        serviceClasses,
+			"serviceplans":          servicePlans,
+		} {
+			list := list
+			fakeClient.AddReactor("list", resource, func(action core.Action) (bool, runtime.Object, error) {
+				return true, list, nil
+			})
+		}
+		informerFactory.Start(wait.NeverStop)
+
+		binding := newServiceBinding()
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(&binding, nil, servicecatalog.Kind("ServiceBindings").WithVersion("version"),
+			"test-ns", "test-binding", servicecatalog.Resource("servicebindings").WithVersion("version"), "", admission.Create, nil))
+		if tc.expectedErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("%v: expected an error containing %q, got %v", tc.name, tc.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+		}
+	}
+}
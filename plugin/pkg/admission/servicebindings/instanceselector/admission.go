@@ -0,0 +1,143 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceselector
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceBindingInstanceSelector"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewInstanceSelector()
+	})
+}
+
+// resolveInstanceSelector is an implementation of admission.Interface.
+// When a ServiceBinding that selects its ServiceInstance by labels is
+// created, it sets the reference of the binding to the only instance in its
+// namespace that the selector matches, and fails the operation if there is
+// none or more than one.
+type resolveInstanceSelector struct {
+	*admission.Handler
+	instanceLister internalversion.ServiceInstanceLister
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&resolveInstanceSelector{})
+
+func (r *resolveInstanceSelector) Admit(a admission.Attributes) error {
+	// We only care about bindings
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("servicebindings") {
+		return nil
+	}
+
+	// We don't want to deal with any sub resources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	binding, ok := a.GetObject().(*servicecatalog.ServiceBinding)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind ServiceBinding but was unable to be converted")
+	}
+
+	// A binding that names its instance is left as it is, even if it also
+	// has a selector.
+	if binding.Spec.InstanceSelector == nil || binding.Spec.ServiceInstanceRef.Name != "" {
+		return nil
+	}
+
+	// we need to wait for our caches to warm
+	if !r.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(binding.Spec.InstanceSelector)
+	if err != nil {
+		return admission.NewForbidden(a, fmt.Errorf("invalid instanceSelector: %v", err))
+	}
+
+	instances, err := r.instanceLister.ServiceInstances(binding.Namespace).List(selector)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	var names []string
+	for _, instance := range instances {
+		if instance.DeletionTimestamp == nil {
+			names = append(names, instance.Name)
+		}
+	}
+
+	switch len(names) {
+	case 0:
+		msg := fmt.Sprintf("no ServiceInstance in namespace %q matches instanceSelector %q", binding.Namespace, selector)
+		glog.V(4).Infof(`ServiceBinding "%s/%s": %s`, binding.Namespace, binding.Name, msg)
+		return admission.NewForbidden(a, errors.New(msg))
+	case 1:
+		glog.V(4).Infof(`ServiceBinding "%s/%s": Using ServiceInstance %q matched by instanceSelector %q`,
+			binding.Namespace, binding.Name, names[0], selector)
+		binding.Spec.ServiceInstanceRef.Name = names[0]
+		return nil
+	default:
+		sort.Strings(names)
+		msg := fmt.Sprintf("more than one ServiceInstance in namespace %q matches instanceSelector %q: %s; set instanceRef.name", binding.Namespace, selector, strings.Join(names, ", "))
+		glog.V(4).Infof(`ServiceBinding "%s/%s": %s`, binding.Namespace, binding.Name, msg)
+		return admission.NewForbidden(a, errors.New(msg))
+	}
+}
+
+func (r *resolveInstanceSelector) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	instanceInformer := f.Servicecatalog().InternalVersion().ServiceInstances()
+	r.instanceLister = instanceInformer.Lister()
+	r.SetReadyFunc(instanceInformer.Informer().HasSynced)
+}
+
+func (r *resolveInstanceSelector) ValidateInitialization() error {
+	if r.instanceLister == nil {
+		return fmt.Errorf("missing serviceInstanceLister")
+	}
+	return nil
+}
+
+// NewInstanceSelector creates a new admission control handler that
+// resolves the instanceSelector of a ServiceBinding to the ServiceInstance
+// it selects when the binding is created.
+func NewInstanceSelector() (admission.Interface, error) {
+	return &resolveInstanceSelector{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceselector
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface) (admission.Interface, informers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	handler, err := NewInstanceSelector()
+	if err != nil {
+		return nil, f, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, err
+}
+
+// newServiceInstance returns a new Service Instance with the given name and
+// labels for unit tests
+func newServiceInstance(name string, labels map[string]string) servicecatalog.ServiceInstance {
+	return servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns", Labels: labels},
+	}
+}
+
+// newServiceBinding returns a new Service Binding that selects the instances
+// labeled app=postgres.
+func newServiceBinding() servicecatalog.ServiceBinding {
+	return servicecatalog.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-binding",
+			Namespace: "test-ns",
+		},
+		Spec: servicecatalog.ServiceBindingSpec{
+			InstanceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "postgres"},
+			},
+			SecretName: "test-secret",
+		},
+	}
+}
+
+func TestResolveInstanceSelector(t *testing.T) {
+	deleted := newServiceInstance("deleted-postgres", map[string]string{"app": "postgres"})
+	deleted.DeletionTimestamp = &metav1.Time{}
+
+	cases := []struct {
+		name         string
+		instanceName string
+		instances    []servicecatalog.ServiceInstance
+		expectedName string
+		expectedErr  string
+	}{
+		{
+			name: "single match",
+			instances: []servicecatalog.ServiceInstance{
+				newServiceInstance("postgres", map[string]string{"app": "postgres"}),
+				newServiceInstance("redis", map[string]string{"app": "redis"}),
+				deleted,
+			},
+			expectedName: "postgres",
+		},
+		{
+			name: "no match",
+			instances: []servicecatalog.ServiceInstance{
+				newServiceInstance("redis", map[string]string{"app": "redis"}),
+				deleted,
+			},
+			expectedErr: `no ServiceInstance in namespace "test-ns" matches instanceSelector "app=postgres"`,
+		},
+		{
+			name: "several matches",
+			instances: []servicecatalog.ServiceInstance{
+				newServiceInstance("postgres-b", map[string]string{"app": "postgres"}),
+				newServiceInstance("postgres-a", map[string]string{"app": "postgres"}),
+			},
+			expectedErr: "postgres-a, postgres-b",
+		},
+		{
+			name:         "instance named",
+			instanceName: "other",
+			instances: []servicecatalog.ServiceInstance{
+				newServiceInstance("postgres", map[string]string{"app": "postgres"}),
+			},
+			expectedName: "other",
+		},
+	}
+
+	for _, tc := range cases {
+		fakeClient := &fake.Clientset{}
+		handler, informerFactory, err := newHandlerForTest(fakeClient)
+		if err != nil {
+			t.Fatalf("%v: unexpected error initializing handler: %v", tc.name, err)
+		}
+		instances := &servicecatalog.ServiceInstanceList{
+			ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+			Items:    tc.instances,
+		}
+		fakeClient.AddReactor("list", "serviceinstances", func(action core.Action) (bool, runtime.Object, error) {
+			return true, instances, nil
+		})
+		informerFactory.Start(wait.NeverStop)
+
+		binding := newServiceBinding()
+		binding.Spec.ServiceInstanceRef.Name = tc.instanceName
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(&binding, nil, servicecatalog.Kind("ServiceBindings").WithVersion("version"),
+			"test-ns", "test-binding", servicecatalog.Resource("servicebindings").WithVersion("version"), "", admission.Create, nil))
+		if tc.expectedErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("%v: expected an error containing %q, got %v", tc.name, tc.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if e, a := tc.expectedName, binding.Spec.ServiceInstanceRef.Name; e != a {
+			t.Errorf("%v: expected instanceRef.name %q, got %q", tc.name, e, a)
+		}
+	}
+}
@@ -27,8 +27,11 @@ import (
 	"strings"
 	"testing"
 
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	// our versioned types
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
@@ -360,7 +363,149 @@ func testClusterServiceBrokerClient(sType server.StorageType, client servicecata
 		return fmt.Errorf("broker should be deleted (%v)", brokerDeleted)
 	}
 	return nil
-} // TestNamespacedServiceBrokerClient exercises the namespaced ServiceBroker client.
+} // TestClusterServiceBrokerRelist exercises the relist subresource of
+// ClusterServiceBrokers.
+func TestClusterServiceBrokerRelist(t *testing.T) {
+	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
+		return func(t *testing.T) {
+			const name = "test-broker"
+			client, _, shutdownServer := getFreshApiserverAndClient(t, sType.String(), func() runtime.Object {
+				return &servicecatalog.ClusterServiceBroker{}
+			})
+			defer shutdownServer()
+			if err := testClusterServiceBrokerRelist(client, name); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, sType := range storageTypes {
+		if !t.Run(sType.String(), rootTestFunc(sType)) {
+			t.Errorf("%q test failed", sType)
+		}
+	}
+}
+
+func testClusterServiceBrokerRelist(client servicecatalogclient.Interface, name string) error {
+	brokerClient := client.Servicecatalog().ClusterServiceBrokers()
+
+	broker := &v1beta1.ClusterServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.ClusterServiceBrokerSpec{
+			CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{
+				URL:            "https://example.com",
+				RelistBehavior: v1beta1.ServiceBrokerRelistBehaviorManual,
+			},
+		},
+	}
+	broker, err := brokerClient.Create(broker)
+	if err != nil {
+		return fmt.Errorf("error creating broker: %v", err)
+	}
+
+	relisted, err := brokerClient.Relist(name, &v1beta1.RelistRequest{Reason: "testing"})
+	if err != nil {
+		return fmt.Errorf("error relisting broker: %v", err)
+	}
+	if e, a := broker.Spec.RelistRequests+1, relisted.Spec.RelistRequests; e != a {
+		return fmt.Errorf("unexpected relist requests: expected %v, got %v", e, a)
+	}
+
+	if _, err := brokerClient.Relist("nonexistent", &v1beta1.RelistRequest{}); !apierrors.IsNotFound(err) {
+		return fmt.Errorf("expected a not found error, got %v", err)
+	}
+	return nil
+}
+
+// TestClusterServiceBrokerCatalog exercises the catalog subresource of
+// ClusterServiceBrokers.
+func TestClusterServiceBrokerCatalog(t *testing.T) {
+	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
+		return func(t *testing.T) {
+			const name = "test-broker"
+			if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.BrokerCatalogSubresource)); err != nil {
+				t.Fatalf("Failed to enable broker catalog subresource feature: %v", err)
+			}
+			defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BrokerCatalogSubresource))
+			client, _, shutdownServer := getFreshApiserverAndClient(t, sType.String(), func() runtime.Object {
+				return &servicecatalog.ClusterServiceBroker{}
+			})
+			defer shutdownServer()
+			if err := testClusterServiceBrokerCatalog(client, name); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, sType := range storageTypes {
+		if !t.Run(sType.String(), rootTestFunc(sType)) {
+			t.Errorf("%q test failed", sType)
+		}
+	}
+}
+
+func testClusterServiceBrokerCatalog(client servicecatalogclient.Interface, name string) error {
+	brokerClient := client.Servicecatalog().ClusterServiceBrokers()
+
+	catalog := &v1beta1.BrokerCatalog{
+		ObjectMeta:    metav1.ObjectMeta{Name: name},
+		RetrievalTime: metav1.Now(),
+		Catalog:       &runtime.RawExtension{Raw: []byte(`{"services":[{"id":"test-id","name":"test-service"}]}`)},
+	}
+	if _, err := brokerClient.UpdateCatalog(catalog); !apierrors.IsNotFound(err) {
+		return fmt.Errorf("expected a not found error storing the catalog of a nonexistent broker, got %v", err)
+	}
+
+	broker := &v1beta1.ClusterServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.ClusterServiceBrokerSpec{
+			CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{
+				URL: "https://example.com",
+			},
+		},
+	}
+	if _, err := brokerClient.Create(broker); err != nil {
+		return fmt.Errorf("error creating broker: %v", err)
+	}
+	if _, err := brokerClient.GetCatalog(name); !apierrors.IsNotFound(err) {
+		return fmt.Errorf("expected a not found error before the catalog is stored, got %v", err)
+	}
+
+	if _, err := brokerClient.UpdateCatalog(catalog); err != nil {
+		return fmt.Errorf("error storing catalog: %v", err)
+	}
+	stored, err := brokerClient.GetCatalog(name)
+	if err != nil {
+		return fmt.Errorf("error getting catalog: %v", err)
+	}
+	if e, a := string(catalog.Catalog.Raw), string(stored.Catalog.Raw); e != a {
+		return fmt.Errorf("unexpected stored catalog: expected %v, got %v", e, a)
+	}
+
+	brokers, err := brokerClient.List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing brokers: %v", err)
+	}
+	if e, a := 1, len(brokers.Items); e != a {
+		return fmt.Errorf("expected the catalog not to be listed as a broker: expected %v brokers, got %v", e, a)
+	}
+
+	if err := brokerClient.Delete(name, &metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("error deleting broker: %v", err)
+	}
+	brokerDeleted, err := brokerClient.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting deleted broker: %v", err)
+	}
+	brokerDeleted.Finalizers = nil
+	if _, err := brokerClient.UpdateStatus(brokerDeleted); err != nil {
+		return fmt.Errorf("error removing the finalizers of the broker: %v", err)
+	}
+	if _, err := brokerClient.GetCatalog(name); !apierrors.IsNotFound(err) {
+		return fmt.Errorf("expected the catalog to be deleted with the broker, got %v", err)
+	}
+	return nil
+}
+
+// TestNamespacedServiceBrokerClient exercises the namespaced ServiceBroker client.
 func TestNamespacedServiceBrokerClient(t *testing.T) {
 	const name = "test-broker"
 	const namespace = "test-namespace"
@@ -1411,6 +1556,148 @@ func testNamespacedServicePlanClient(sType server.StorageType, client servicecat
 	return nil
 }
 
+// TestClusterServiceClassListChunking tests that ClusterServiceClasses can be
+// listed in chunks.
+func TestClusterServiceClassListChunking(t *testing.T) {
+	client, _, shutdownServer := getFreshApiserverAndClient(t, server.StorageTypeEtcd.String(), func() runtime.Object {
+		return &servicecatalog.ClusterServiceClass{}
+	})
+	defer shutdownServer()
+
+	serviceClassClient := client.Servicecatalog().ClusterServiceClasses()
+	createServiceClass := func(i int) error {
+		name := fmt.Sprintf("test-serviceclass-%d", i)
+		_, err := serviceClassClient.Create(&v1beta1.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1beta1.ClusterServiceClassSpec{
+				ClusterServiceBrokerName: "test-broker",
+				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
+					ExternalName: name,
+					ExternalID:   name,
+					Description:  "test description",
+				},
+			},
+		})
+		return err
+	}
+	for i := 0; i < 5; i++ {
+		if err := createServiceClass(i); err != nil {
+			t.Fatalf("error creating ClusterServiceClass: %v", err)
+		}
+	}
+
+	names, err := listInChunks(2, func(opts metav1.ListOptions) ([]string, string, error) {
+		serviceClasses, err := serviceClassClient.List(opts)
+		if err != nil {
+			return nil, "", err
+		}
+		var names []string
+		for _, serviceClass := range serviceClasses.Items {
+			names = append(names, serviceClass.Name)
+		}
+		return names, serviceClasses.Continue, nil
+	}, func() error {
+		// A class created while listing is not part of the list, since
+		// the list continues at the resource version of its first chunk.
+		return createServiceClass(9)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"test-serviceclass-0", "test-serviceclass-1", "test-serviceclass-2", "test-serviceclass-3", "test-serviceclass-4"}
+	if !reflect.DeepEqual(expected, names) {
+		t.Fatalf("expected to list %v, got %v", expected, names)
+	}
+}
+
+// TestClusterServicePlanListChunking tests that ClusterServicePlans can be
+// listed in chunks, also when they are selected by broker.
+func TestClusterServicePlanListChunking(t *testing.T) {
+	client, _, shutdownServer := getFreshApiserverAndClient(t, server.StorageTypeEtcd.String(), func() runtime.Object {
+		return &servicecatalog.ClusterServicePlan{}
+	})
+	defer shutdownServer()
+
+	servicePlanClient := client.Servicecatalog().ClusterServicePlans()
+	createServicePlan := func(i int, brokerName string) error {
+		name := fmt.Sprintf("test-serviceplan-%d", i)
+		_, err := servicePlanClient.Create(&v1beta1.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1beta1.ClusterServicePlanSpec{
+				ClusterServiceBrokerName: brokerName,
+				CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+					ExternalName: name,
+					ExternalID:   name,
+					Description:  "test description",
+				},
+				ClusterServiceClassRef: v1beta1.ClusterObjectReference{
+					Name: "test-serviceclass",
+				},
+			},
+		})
+		return err
+	}
+	// Every other plan belongs to another broker.
+	for i := 0; i < 10; i++ {
+		brokerName := "test-broker"
+		if i%2 == 1 {
+			brokerName = "other-broker"
+		}
+		if err := createServicePlan(i, brokerName); err != nil {
+			t.Fatalf("error creating ClusterServicePlan: %v", err)
+		}
+	}
+
+	names, err := listInChunks(2, func(opts metav1.ListOptions) ([]string, string, error) {
+		opts.FieldSelector = "spec.clusterServiceBrokerName=test-broker"
+		servicePlans, err := servicePlanClient.List(opts)
+		if err != nil {
+			return nil, "", err
+		}
+		var names []string
+		for _, servicePlan := range servicePlans.Items {
+			names = append(names, servicePlan.Name)
+		}
+		return names, servicePlans.Continue, nil
+	}, func() error {
+		return createServicePlan(10, "test-broker")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"test-serviceplan-0", "test-serviceplan-2", "test-serviceplan-4", "test-serviceplan-6", "test-serviceplan-8"}
+	if !reflect.DeepEqual(expected, names) {
+		t.Fatalf("expected to list %v, got %v", expected, names)
+	}
+}
+
+// listInChunks lists the names of objects in chunks of at most limit objects
+// with the given list function, which returns the names and the continue
+// token of a chunk. afterFirstChunk is called once the first chunk is listed.
+func listInChunks(limit int64, list func(metav1.ListOptions) ([]string, string, error), afterFirstChunk func() error) ([]string, error) {
+	var names []string
+	opts := metav1.ListOptions{Limit: limit}
+	for chunk := 0; ; chunk++ {
+		chunkNames, continueToken, err := list(opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing chunk %d: %v", chunk, err)
+		}
+		if int64(len(chunkNames)) > limit {
+			return nil, fmt.Errorf("expected chunk %d to have at most %d objects, had %d", chunk, limit, len(chunkNames))
+		}
+		names = append(names, chunkNames...)
+		if continueToken == "" {
+			return names, nil
+		}
+		if chunk == 0 {
+			if err := afterFirstChunk(); err != nil {
+				return nil, err
+			}
+		}
+		opts.Continue = continueToken
+	}
+}
+
 // TestInstanceClient exercises the Instance client.
 func TestInstanceClient(t *testing.T) {
 	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
@@ -1635,6 +1922,25 @@ func testInstanceClient(sType server.StorageType, client servicecatalogclient.In
 		return fmt.Errorf("should have exactly one instance, had %v instances", len(instances.Items))
 	}
 
+	// an instance is found by its external ID across all namespaces
+	instances, err = client.Servicecatalog().ServiceInstances("").List(metav1.ListOptions{FieldSelector: "spec.externalID=" + osbGUID})
+	if err != nil {
+		return fmt.Errorf("error listing instances: %v", err)
+	}
+
+	if 1 != len(instances.Items) {
+		return fmt.Errorf("should have exactly one instance with external ID %q, had %v instances", osbGUID, len(instances.Items))
+	}
+
+	instances, err = instanceClient.List(metav1.ListOptions{FieldSelector: "spec.externalID=should-return-zero"})
+	if err != nil {
+		return fmt.Errorf("error listing instances: %v", err)
+	}
+
+	if 0 != len(instances.Items) {
+		return fmt.Errorf("should have exactly zero instances, had %v instances", len(instances.Items))
+	}
+
 	// update the instance's spec
 	updateRequests := instanceServer.Spec.UpdateRequests + 1
 	expectedGeneration := instanceServer.Generation + 1
@@ -1682,6 +1988,200 @@ func testInstanceClient(sType server.StorageType, client servicecatalogclient.In
 	return nil
 }
 
+// TestInstanceForceDelete exercises the forcedelete subresource of instances.
+func TestInstanceForceDelete(t *testing.T) {
+	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
+		return func(t *testing.T) {
+			const name = "test-instance"
+			client, _, shutdownServer := getFreshApiserverAndClient(t, sType.String(), func() runtime.Object {
+				return &servicecatalog.ServiceInstance{}
+			})
+			defer shutdownServer()
+			if err := testInstanceForceDelete(client, name); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, sType := range storageTypes {
+		if !t.Run(sType.String(), rootTestFunc(sType)) {
+			t.Errorf("%q test failed", sType)
+		}
+	}
+}
+
+func testInstanceForceDelete(client servicecatalogclient.Interface, name string) error {
+	instanceClient := client.Servicecatalog().ServiceInstances("test-namespace")
+
+	instance := &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.ServiceInstanceSpec{
+			PlanReference: v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "service-class-name",
+				ClusterServicePlanExternalName:  "plan-name",
+			},
+		},
+	}
+	if _, err := instanceClient.Create(instance); err != nil {
+		return fmt.Errorf("error creating instance: %v", err)
+	}
+
+	// a request that does not confirm the name of the instance is rejected
+	err := instanceClient.ForceDelete(name, &v1beta1.ForceDeleteRequest{Confirm: "other-instance"})
+	if !apierrors.IsBadRequest(err) {
+		return fmt.Errorf("expected a bad request error, got %v", err)
+	}
+	if _, err := instanceClient.Get(name, metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("instance should still exist: %v", err)
+	}
+
+	// the instance is removed despite its finalizer
+	err = instanceClient.ForceDelete(name, &v1beta1.ForceDeleteRequest{Confirm: name, Reason: "testing"})
+	if err != nil {
+		return fmt.Errorf("error force deleting instance: %v", err)
+	}
+	if instanceDeleted, err := instanceClient.Get(name, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		return fmt.Errorf("instance should be deleted (%#v): %v", instanceDeleted, err)
+	}
+	return nil
+}
+
+// TestBindingAdminUnbind exercises the adminunbind subresource of bindings.
+func TestBindingAdminUnbind(t *testing.T) {
+	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
+		return func(t *testing.T) {
+			const name = "test-binding"
+			client, _, shutdownServer := getFreshApiserverAndClient(t, sType.String(), func() runtime.Object {
+				return &servicecatalog.ServiceBinding{}
+			})
+			defer shutdownServer()
+			if err := testBindingAdminUnbind(client, name); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, sType := range storageTypes {
+		if !t.Run(sType.String(), rootTestFunc(sType)) {
+			t.Errorf("%q test failed", sType)
+		}
+	}
+}
+
+func testBindingAdminUnbind(client servicecatalogclient.Interface, name string) error {
+	bindingClient := client.Servicecatalog().ServiceBindings("test-namespace")
+
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.ServiceBindingSpec{
+			ServiceInstanceRef: v1beta1.LocalObjectReference{
+				Name: "bar",
+			},
+		},
+	}
+	if _, err := bindingClient.Create(binding); err != nil {
+		return fmt.Errorf("error creating binding: %v", err)
+	}
+
+	// a binding that is not being deleted cannot be abandoned
+	err := bindingClient.AdminUnbind(name, &v1beta1.AdminUnbindRequest{Confirm: name})
+	if !apierrors.IsConflict(err) {
+		return fmt.Errorf("expected a conflict error, got %v", err)
+	}
+
+	// the binding is kept by its finalizer once deleted
+	if err := bindingClient.Delete(name, &metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("error deleting binding: %v", err)
+	}
+	if _, err := bindingClient.Get(name, metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("binding should still exist: %v", err)
+	}
+
+	// a request that does not confirm the name of the binding is rejected
+	err = bindingClient.AdminUnbind(name, &v1beta1.AdminUnbindRequest{Confirm: "other-binding"})
+	if !apierrors.IsBadRequest(err) {
+		return fmt.Errorf("expected a bad request error, got %v", err)
+	}
+
+	// the binding is removed once its finalizer is removed
+	err = bindingClient.AdminUnbind(name, &v1beta1.AdminUnbindRequest{Confirm: name, Reason: "testing"})
+	if err != nil {
+		return fmt.Errorf("error abandoning binding: %v", err)
+	}
+	if bindingDeleted, err := bindingClient.Get(name, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		return fmt.Errorf("binding should be deleted (%#v): %v", bindingDeleted, err)
+	}
+	return nil
+}
+
+// TestInstanceRollback exercises the rollback subresource of instances.
+func TestInstanceRollback(t *testing.T) {
+	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
+		return func(t *testing.T) {
+			const name = "test-instance"
+			client, _, shutdownServer := getFreshApiserverAndClient(t, sType.String(), func() runtime.Object {
+				return &servicecatalog.ServiceInstance{}
+			})
+			defer shutdownServer()
+			if err := testInstanceRollback(client, name); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, sType := range storageTypes {
+		if !t.Run(sType.String(), rootTestFunc(sType)) {
+			t.Errorf("%q test failed", sType)
+		}
+	}
+}
+
+func testInstanceRollback(client servicecatalogclient.Interface, name string) error {
+	instanceClient := client.Servicecatalog().ServiceInstances("test-namespace")
+
+	instance := &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.ServiceInstanceSpec{
+			PlanReference: v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "service-class-name",
+				ClusterServicePlanExternalName:  "bad-plan-name",
+			},
+			Parameters: &runtime.RawExtension{Raw: []byte(`{"size":"huge"}`)},
+		},
+	}
+	instance, err := instanceClient.Create(instance)
+	if err != nil {
+		return fmt.Errorf("error creating instance: %v", err)
+	}
+
+	// an instance that was never provisioned cannot be rolled back
+	_, err = instanceClient.Rollback(name, &v1beta1.RollbackRequest{})
+	if !apierrors.IsBadRequest(err) {
+		return fmt.Errorf("expected a bad request error, got %v", err)
+	}
+
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: "plan-name",
+		ClusterServicePlanExternalID:   "plan-id",
+		Parameters:                     &runtime.RawExtension{Raw: []byte(`{"size":"small"}`)},
+	}
+	if _, err := instanceClient.UpdateStatus(instance); err != nil {
+		return fmt.Errorf("error updating instance status: %v", err)
+	}
+
+	rolledBack, err := instanceClient.Rollback(name, &v1beta1.RollbackRequest{Reason: "testing"})
+	if err != nil {
+		return fmt.Errorf("error rolling back instance: %v", err)
+	}
+	if e, a := "plan-name", rolledBack.Spec.ClusterServicePlanExternalName; e != a {
+		return fmt.Errorf("unexpected plan: expected %q, got %q", e, a)
+	}
+	if e, a := `{"size":"small"}`, string(rolledBack.Spec.Parameters.Raw); e != a {
+		return fmt.Errorf("unexpected parameters: expected %q, got %q", e, a)
+	}
+	if e, a := instance.Generation+1, rolledBack.Generation; e != a {
+		return fmt.Errorf("unexpected generation: expected %v, got %v", e, a)
+	}
+	return nil
+}
+
 // TestBindingClient exercises the Binding client.
 func TestBindingClient(t *testing.T) {
 	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
@@ -1773,6 +2273,26 @@ func testBindingClient(sType server.StorageType, client servicecatalogclient.Int
 		)
 	}
 
+	// a binding is found by its external ID across all namespaces
+	bindings, err = client.Servicecatalog().ServiceBindings("").List(metav1.ListOptions{FieldSelector: "spec.externalID=UUID-string"})
+	if err != nil {
+		return fmt.Errorf("error listing bindings (%s)", err)
+	}
+	if 1 != len(bindings.Items) {
+		return fmt.Errorf("should have exactly one binding with external ID %q, had %v bindings", "UUID-string", len(bindings.Items))
+	}
+	bindings, err = bindingClient.List(metav1.ListOptions{FieldSelector: "spec.externalID=should-return-zero"})
+	if err != nil {
+		return fmt.Errorf("error listing bindings (%s)", err)
+	}
+	if 0 != len(bindings.Items) {
+		return fmt.Errorf("should have exactly zero bindings, had %v bindings", len(bindings.Items))
+	}
+	bindings, err = bindingClient.List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing bindings (%s)", err)
+	}
+
 	bindingListed := &bindings.Items[0]
 	if !reflect.DeepEqual(bindingListed, bindingServer) {
 		return fmt.Errorf(
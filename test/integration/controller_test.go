@@ -778,6 +778,9 @@ func newControllerTestTestController(ct *controllerTest) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		serviceCatalogSharedInformers.BrokerTemplates(),
+		serviceCatalogSharedInformers.CatalogProjections(),
+		serviceCatalogSharedInformers.ServiceBrokerSummaries(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -786,6 +789,7 @@ func newControllerTestTestController(ct *controllerTest) (
 		7*24*time.Hour,
 		controller.DefaultClusterIDConfigMapName,
 		controller.DefaultClusterIDConfigMapNamespace,
+		controller.Options{},
 	)
 	t.Log("controller start")
 	if err != nil {
@@ -938,6 +942,9 @@ func newTestController(t *testing.T) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		serviceCatalogSharedInformers.BrokerTemplates(),
+		serviceCatalogSharedInformers.CatalogProjections(),
+		serviceCatalogSharedInformers.ServiceBrokerSummaries(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -946,6 +953,7 @@ func newTestController(t *testing.T) (
 		7*24*time.Hour,
 		controller.DefaultClusterIDConfigMapName,
 		controller.DefaultClusterIDConfigMapNamespace,
+		controller.Options{},
 	)
 	t.Log("controller start")
 	if err != nil {